@@ -43,9 +43,8 @@ import (
 	"compress/gzip"
 	"io/ioutil"
 
-	apiextinstall "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
-	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
-	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
 )
 
 var rawCRDs = [][]byte{
@@ -56,10 +55,10 @@ var rawCRDs = [][]byte{
 
 var CRDs = crds()
 
-func crds() []*apiextv1beta1.CustomResourceDefinition {
-	apiextinstall.Install(scheme.Scheme)
-	decode := scheme.Codecs.UniversalDeserializer().Decode
-	var objs []*apiextv1beta1.CustomResourceDefinition
+// crds returns the CRDs as unstructured objects, since the apiextensions.k8s.io/v1
+// types aren't available to decode them into.
+func crds() []*unstructured.Unstructured {
+	var objs []*unstructured.Unstructured
 	for _, crd := range rawCRDs {
 		gzr, err := gzip.NewReader(bytes.NewReader(crd))
 		if err != nil {
@@ -71,11 +70,16 @@ func crds() []*apiextv1beta1.CustomResourceDefinition {
 		}
 		gzr.Close()
 
-		obj, _, err := decode(bytes, nil, nil)
+		json, err := yaml.YAMLToJSON(bytes)
 		if err != nil {
 			panic(err)
 		}
-		objs = append(objs, obj.(*apiextv1beta1.CustomResourceDefinition))
+
+		obj := new(unstructured.Unstructured)
+		if err := obj.UnmarshalJSON(json); err != nil {
+			panic(err)
+		}
+		objs = append(objs, obj)
 	}
 	return objs
 }
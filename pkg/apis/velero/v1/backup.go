@@ -34,6 +34,15 @@ type BackupSpec struct {
 	// +nullable
 	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
 
+	// NamespaceSelector selects namespaces to include objects from by
+	// their labels. It's resolved when the backup is run, and the
+	// selected namespaces are included along with IncludedNamespaces.
+	// If IncludedNamespaces is empty or "*", only the selected namespaces
+	// are included.
+	// +optional
+	// +nullable
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
 	// IncludedResources is a slice of resource names to include
 	// in the backup. If empty, all resources are included.
 	// +optional
@@ -60,11 +69,65 @@ type BackupSpec struct {
 	// +nullable
 	SnapshotVolumes *bool `json:"snapshotVolumes,omitempty"`
 
+	// ExcludedSnapshotNamespaces is a list of namespaces whose PVs should
+	// not be snapshotted, even if SnapshotVolumes is true. The PVs are
+	// still included in the backup.
+	// +optional
+	// +nullable
+	ExcludedSnapshotNamespaces []string `json:"excludedSnapshotNamespaces,omitempty"`
+
+	// ExcludedSnapshotLabelSelector is a metav1.LabelSelector matching PVs,
+	// or the PVCs that claim them, that should not be snapshotted, even if
+	// SnapshotVolumes is true. The PVs are still included in the backup.
+	// +optional
+	// +nullable
+	ExcludedSnapshotLabelSelector *metav1.LabelSelector `json:"excludedSnapshotLabelSelector,omitempty"`
+
+	// PodVolumeBackupSelectors is a list of metav1.LabelSelectors matching
+	// pods whose volumes should be backed up with restic, in addition to
+	// the volumes listed in pods' backup.velero.io/backup-volumes
+	// annotations. All of a matching pod's volumes are backed up, except
+	// hostPath volumes and volumes projected from the Kubernetes API, unless
+	// it has the annotation, in which case only the listed volumes are.
+	// +optional
+	// +nullable
+	PodVolumeBackupSelectors []metav1.LabelSelector `json:"podVolumeBackupSelectors,omitempty"`
+
+	// DefaultVolumesToRestic specifies whether all of the volumes of the
+	// backup's pods should be backed up with restic by default, except
+	// hostPath volumes, volumes projected from the Kubernetes API, and
+	// volumes listed in pods' backup.velero.io/backup-volumes-excludes
+	// annotations. If unset, the server's default is used.
+	// +optional
+	// +nullable
+	DefaultVolumesToRestic *bool `json:"defaultVolumesToRestic,omitempty"`
+
+	// MaxItems is the maximum number of items that the backup may contain.
+	// If more items would be backed up, the backup is aborted and fails.
+	// If unset, the server's default is used. 0 means no limit.
+	// +optional
+	// +nullable
+	MaxItems *int `json:"maxItems,omitempty"`
+
+	// MaxBytes is the maximum size, in bytes, of the backup's compressed
+	// tarball. If the tarball grows past it, the backup is aborted and
+	// fails. If unset, the server's default is used. 0 means no limit.
+	// +optional
+	// +nullable
+	MaxBytes *int64 `json:"maxBytes,omitempty"`
+
 	// TTL is a time.Duration-parseable string describing how long
 	// the Backup should be retained for.
 	// +optional
 	TTL metav1.Duration `json:"ttl,omitempty"`
 
+	// LogTTL is a time.Duration-parseable string describing how long
+	// the Backup's logs, and the logs and results of restores from it,
+	// should be retained for. If unset, they're retained for as long as
+	// the Backup.
+	// +optional
+	LogTTL metav1.Duration `json:"logTTL,omitempty"`
+
 	// IncludeClusterResources specifies whether cluster-scoped resources
 	// should be included for consideration in the backup.
 	// +optional
@@ -82,6 +145,88 @@ type BackupSpec struct {
 	// VolumeSnapshotLocations is a list containing names of VolumeSnapshotLocations associated with this backup.
 	// +optional
 	VolumeSnapshotLocations []string `json:"volumeSnapshotLocations,omitempty"`
+
+	// AdditionalStorageLocations is a list containing names of BackupStorageLocations that the
+	// backup should be copied to after it has been successfully uploaded to its StorageLocation.
+	// +optional
+	// +nullable
+	AdditionalStorageLocations []string `json:"additionalStorageLocations,omitempty"`
+
+	// SearchIndex specifies whether to build an index of the resources, names, and labels
+	// of the items in the backup, and upload it alongside the backup, so that the backup
+	// can be searched for specific items.
+	// +optional
+	SearchIndex bool `json:"searchIndex,omitempty"`
+
+	// IncludeEventsAndPodLogs specifies whether to capture the events of the namespaces
+	// in the backup, and the most recent logs of the pods in it, in the backup's
+	// diagnostics directory. They're kept for troubleshooting and aren't restored.
+	// +optional
+	IncludeEventsAndPodLogs bool `json:"includeEventsAndPodLogs,omitempty"`
+
+	// ReplicaPolicies specifies, per resource, whether the replica counts of
+	// workloads are captured in the backup. The first policy whose resources
+	// include an item applies to it. Replica counts are captured for items
+	// that no policy applies to.
+	// +optional
+	// +nullable
+	ReplicaPolicies []ResourceReplicaPolicy `json:"replicaPolicies,omitempty"`
+
+	// OrderedResources specifies the order in which the items of resources
+	// are backed up, e.g. so that a primary database pod is backed up before
+	// its replicas. The keys are resources, e.g. pods or deployments.apps,
+	// and the values are comma-separated lists of item names, formatted as
+	// namespace/name for namespaced resources. The listed items of a
+	// resource are backed up first, in the order they're listed, followed by
+	// its other items.
+	// +optional
+	// +nullable
+	OrderedResources map[string]string `json:"orderedResources,omitempty"`
+
+	// IncludedResourceNames limits the items of resources that are backed
+	// up to the named ones. The keys are resources, e.g. secrets or
+	// deployments.apps, and the values are lists of item names, formatted
+	// as namespace/name for namespaced resources, which may contain
+	// wildcards, e.g. app/db-*. Items of resources that aren't listed
+	// aren't limited. If IncludedResources is empty, only the listed
+	// resources are included in the backup.
+	// +optional
+	// +nullable
+	IncludedResourceNames map[string][]string `json:"includedResourceNames,omitempty"`
+
+	// Cluster is the name of the member cluster to back up, if the Velero
+	// server runs in a management cluster that member clusters are
+	// registered with. The backup is stored under the clusters/<name>
+	// prefix of its storage locations. If empty, the cluster that the Velero
+	// server runs in is backed up.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// ReplicaPolicy is whether the replica counts of workloads are kept.
+// +kubebuilder:validation:Enum=Keep;Omit
+type ReplicaPolicy string
+
+const (
+	// ReplicaPolicyKeep keeps the replica counts of workloads.
+	ReplicaPolicyKeep ReplicaPolicy = "Keep"
+
+	// ReplicaPolicyOmit removes the replica counts of workloads, so that
+	// they're restored with the API server's default replica count, and
+	// scaled by their horizontal pod autoscalers, if any.
+	ReplicaPolicyOmit ReplicaPolicy = "Omit"
+)
+
+// ResourceReplicaPolicy is the replica policy of the workloads of a set of
+// resources.
+type ResourceReplicaPolicy struct {
+	// Resources are the resources that the policy applies to, e.g.
+	// deployments.apps. '*' applies it to all resources.
+	Resources []string `json:"resources"`
+
+	// Policy is whether the spec.replicas field of the resources' items is
+	// kept.
+	Policy ReplicaPolicy `json:"policy"`
 }
 
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
@@ -179,7 +324,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed;Deleting
 type BackupPhase string
 
 const (
@@ -194,6 +339,16 @@ const (
 	// BackupPhaseInProgress means the backup is currently executing.
 	BackupPhaseInProgress BackupPhase = "InProgress"
 
+	// BackupPhaseWaitingForPluginOperations means the backup's items have
+	// been backed up and uploaded, and the backup is waiting for operations
+	// started by backup item action plugins to finish.
+	BackupPhaseWaitingForPluginOperations BackupPhase = "WaitingForPluginOperations"
+
+	// BackupPhaseWaitingForPluginOperationsPartiallyFailed means the backup
+	// is waiting for operations started by backup item action plugins to
+	// finish, and it encountered 1+ errors backing up individual items.
+	BackupPhaseWaitingForPluginOperationsPartiallyFailed BackupPhase = "WaitingForPluginOperationsPartiallyFailed"
+
 	// BackupPhaseCompleted means the backup has run successfully without
 	// errors.
 	BackupPhaseCompleted BackupPhase = "Completed"
@@ -203,24 +358,228 @@ const (
 	BackupPhasePartiallyFailed BackupPhase = "PartiallyFailed"
 
 	// BackupPhaseFailed means the backup ran but encountered an error that
-	// prevented it from completing successfully.
+	// prevented it from completing successfully. The failing error is
+	// recorded in status.FailureReason.
 	BackupPhaseFailed BackupPhase = "Failed"
 
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
 )
 
+// BackupConditionType is the type of a condition of a backup.
+// +kubebuilder:validation:Enum=SpecDrifted;Orphaned
+type BackupConditionType string
+
+const (
+	// BackupConditionSpecDrifted means the backup's spec in the cluster
+	// doesn't match the spec that was stored with the backup in its backup
+	// storage location, i.e. the custom resource was changed after the
+	// backup was taken.
+	BackupConditionSpecDrifted BackupConditionType = "SpecDrifted"
+
+	// BackupConditionOrphaned means the backup's data is no longer in its
+	// backup storage location, e.g. because it was deleted from the object
+	// store outside of Velero.
+	BackupConditionOrphaned BackupConditionType = "Orphaned"
+)
+
+// ConditionStatus is the status of a condition.
+// +kubebuilder:validation:Enum=True;False;Unknown
+type ConditionStatus string
+
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// BackupCondition is an observation of the state of a backup.
+type BackupCondition struct {
+	// Type is the type of the condition.
+	Type BackupConditionType `json:"type"`
+
+	// Status is whether the condition holds: True, False, or Unknown.
+	Status ConditionStatus `json:"status"`
+
+	// LastTransitionTime is when the condition's status last changed.
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a brief, CamelCase reason for the condition's status.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable explanation of the condition's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// AdditionalStorageLocationPhase is a string representation of the
+// state of a backup's copy in one of its additional storage locations.
+// +kubebuilder:validation:Enum=Completed;Failed
+type AdditionalStorageLocationPhase string
+
+const (
+	// AdditionalStorageLocationPhaseCompleted means the backup was successfully
+	// copied to the additional storage location.
+	AdditionalStorageLocationPhaseCompleted AdditionalStorageLocationPhase = "Completed"
+
+	// AdditionalStorageLocationPhaseFailed means the backup could not be copied
+	// to the additional storage location.
+	AdditionalStorageLocationPhaseFailed AdditionalStorageLocationPhase = "Failed"
+)
+
+// AdditionalStorageLocationStatus captures the status of a backup's copy in
+// one of its additional storage locations.
+type AdditionalStorageLocationStatus struct {
+	// Name is the name of the BackupStorageLocation.
+	Name string `json:"name"`
+
+	// Phase is the state of the backup's copy in the location.
+	// +optional
+	Phase AdditionalStorageLocationPhase `json:"phase,omitempty"`
+
+	// Error is the error that occurred when copying the backup to the location, if any.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ItemTiming records how long it took to back up an item, or to execute a
+// backup item action on it.
+type ItemTiming struct {
+	// Resource is the group-qualified resource of the item.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item, or empty if it's cluster-scoped.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Action is the name of the backup item action that was executed on the
+	// item. It's empty if the timing is for backing up the item itself.
+	// +optional
+	Action string `json:"action,omitempty"`
+
+	// Duration is how long it took.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// PluginOperationPhase is the state of an operation started by a backup item
+// action plugin.
+// +kubebuilder:validation:Enum=InProgress;Completed;Failed
+type PluginOperationPhase string
+
+const (
+	// PluginOperationPhaseInProgress means the operation is still running.
+	PluginOperationPhaseInProgress PluginOperationPhase = "InProgress"
+
+	// PluginOperationPhaseCompleted means the operation finished successfully.
+	PluginOperationPhaseCompleted PluginOperationPhase = "Completed"
+
+	// PluginOperationPhaseFailed means the operation failed, or timed out and
+	// was canceled.
+	PluginOperationPhaseFailed PluginOperationPhase = "Failed"
+)
+
+// PluginOperation is a long-running operation started by a backup item action
+// plugin, which the backup waits for before it completes.
+type PluginOperation struct {
+	// Plugin is the name of the backup item action that started the
+	// operation.
+	Plugin string `json:"plugin"`
+
+	// OperationID identifies the operation to the plugin.
+	OperationID string `json:"operationID"`
+
+	// Resource is the group-qualified resource of the item that the
+	// operation was started for.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item, or empty if it's cluster-scoped.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Phase is the state of the operation.
+	// +optional
+	Phase PluginOperationPhase `json:"phase,omitempty"`
+
+	// Error is the error the operation failed with.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// NCompleted and NTotal are how many of the operation's units are done,
+	// and how many there are in total, if the plugin reports them.
+	// +optional
+	NCompleted int64 `json:"nCompleted,omitempty"`
+	// +optional
+	NTotal int64 `json:"nTotal,omitempty"`
+
+	// OperationUnits are the units of NCompleted and NTotal, e.g. "bytes".
+	// +optional
+	OperationUnits string `json:"operationUnits,omitempty"`
+
+	// Description is the plugin's description of the operation's status.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Created is when the operation was started.
+	// +optional
+	// +nullable
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// Updated is when the operation's progress was last checked.
+	// +optional
+	// +nullable
+	Updated *metav1.Time `json:"updated,omitempty"`
+}
+
+// BackupEncryption records the data key that encrypts a backup's artifacts,
+// wrapped by a KeyWrapper plugin with a key from a key management service.
+type BackupEncryption struct {
+	// KeyWrapper is the name of the KeyWrapper plugin that wrapped the data
+	// key.
+	KeyWrapper string `json:"keyWrapper"`
+
+	// WrappingKeyID is the fully-qualified ID of the key that wrapped the
+	// data key, as returned by the KeyWrapper plugin.
+	WrappingKeyID string `json:"wrappingKeyID"`
+
+	// WrappedDataKey is the base64-encoded wrapped data key.
+	WrappedDataKey string `json:"wrappedDataKey"`
+}
+
 // BackupStatus captures the current status of a Velero backup.
 type BackupStatus struct {
 	// Version is the backup format version.
 	// +optional
 	Version int `json:"version,omitempty"`
 
+	// VeleroVersion is the version of the Velero server that created the
+	// backup.
+	// +optional
+	VeleroVersion string `json:"veleroVersion,omitempty"`
+
 	// Expiration is when this Backup is eligible for garbage-collection.
 	// +optional
 	// +nullable
 	Expiration metav1.Time `json:"expiration,omitempty"`
 
+	// LogsExpiration is when this Backup's logs, and the logs and results
+	// of restores from it, are eligible for garbage-collection.
+	// +optional
+	// +nullable
+	LogsExpiration *metav1.Time `json:"logsExpiration,omitempty"`
+
+	// LogsDeleted is true if this Backup's logs have been garbage-collected.
+	// +optional
+	LogsDeleted bool `json:"logsDeleted,omitempty"`
+
 	// Phase is the current state of the Backup.
 	// +optional
 	Phase BackupPhase `json:"phase,omitempty"`
@@ -268,10 +627,76 @@ type BackupStatus struct {
 	// file in object storage.
 	// +optional
 	Errors int `json:"errors,omitempty"`
+
+	// FailureReason is an error that caused the entire backup to fail.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// TarballSizeBytes is the size, in bytes, of the backup's tarball
+	// in object storage.
+	// +optional
+	TarballSizeBytes int64 `json:"tarballSizeBytes,omitempty"`
+
+	// AdditionalStorageLocations records the status of the backup's copy in
+	// each of its additional storage locations.
+	// +optional
+	// +nullable
+	AdditionalStorageLocations []AdditionalStorageLocationStatus `json:"additionalStorageLocations,omitempty"`
+
+	// SlowestItems are the items that took the longest to back up, slowest
+	// first. An item's time includes executing its hooks and backup item
+	// actions, but not backing up its additional items.
+	// +optional
+	// +nullable
+	SlowestItems []ItemTiming `json:"slowestItems,omitempty"`
+
+	// SlowestActions are the backup item action executions that took the
+	// longest, slowest first.
+	// +optional
+	// +nullable
+	SlowestActions []ItemTiming `json:"slowestActions,omitempty"`
+
+	// HooksAttempted is the number of exec hooks that were run on pods
+	// during the backup.
+	// +optional
+	HooksAttempted int `json:"hooksAttempted,omitempty"`
+
+	// HooksFailed is the number of exec hooks that were run on pods during
+	// the backup and failed.
+	// +optional
+	HooksFailed int `json:"hooksFailed,omitempty"`
+
+	// PluginOperations are the operations started by backup item action
+	// plugins during the backup, which it waits for before it completes.
+	// +optional
+	// +nullable
+	PluginOperations []PluginOperation `json:"pluginOperations,omitempty"`
+
+	// Conditions are the latest observations of the backup's state, e.g.
+	// whether its spec has drifted from the spec in its backup storage
+	// location.
+	// +optional
+	// +nullable
+	Conditions []BackupCondition `json:"conditions,omitempty"`
+
+	// Encryption records how the key that encrypts the backup's artifacts
+	// was wrapped, so that it can be unwrapped to restore from the backup.
+	// +optional
+	// +nullable
+	Encryption *BackupEncryption `json:"encryption,omitempty"`
 }
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Status of the backup"
+// +kubebuilder:printcolumn:name="Errors",type="integer",JSONPath=".status.errors",description="Number of errors encountered during the backup"
+// +kubebuilder:printcolumn:name="Warnings",type="integer",JSONPath=".status.warnings",description="Number of warnings encountered during the backup"
+// +kubebuilder:printcolumn:name="Created",type="date",JSONPath=".metadata.creationTimestamp",description="Time the backup was created"
+// +kubebuilder:printcolumn:name="Expires",type="date",JSONPath=".status.expiration",description="Time after which the backup can be garbage collected"
+// +kubebuilder:printcolumn:name="Storage Location",type="string",JSONPath=".spec.storageLocation",description="Backup storage location the backup is stored in"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.failureReason",description="Reason the backup failed",priority=1
 
 // Backup is a Velero resource that respresents the capture of Kubernetes
 // cluster state at a point in time (API objects and associated volume state).
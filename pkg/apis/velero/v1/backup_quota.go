@@ -0,0 +1,77 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupQuotaSpec defines the limits on backups that include a namespace.
+type BackupQuotaSpec struct {
+	// Namespace is the name of the namespace whose backups are limited
+	// by this quota. A backup counts against the quota if the namespace
+	// is included in it.
+	Namespace string `json:"namespace"`
+
+	// MaxConcurrentBackups is the maximum number of new and in-progress
+	// backups that may include the namespace.
+	// +optional
+	// +nullable
+	MaxConcurrentBackups *int `json:"maxConcurrentBackups,omitempty"`
+
+	// MaxStoredBytes is the maximum total size, in bytes, of the backup
+	// tarballs in object storage that include the namespace.
+	// +optional
+	// +nullable
+	MaxStoredBytes *int64 `json:"maxStoredBytes,omitempty"`
+
+	// MaxSnapshots is the maximum total number of volume snapshots taken
+	// by backups that include the namespace.
+	// +optional
+	// +nullable
+	MaxSnapshots *int `json:"maxSnapshots,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// BackupQuota limits the backups that can be created for a namespace, so
+// that a single tenant can't consume all of the shared backup storage.
+// Quotas are enforced when backups are validated.
+type BackupQuota struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupQuotaSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupQuotaList is a list of BackupQuotas.
+type BackupQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupQuota `json:"items"`
+}
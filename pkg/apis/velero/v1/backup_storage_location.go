@@ -17,12 +17,21 @@ limitations under the License.
 package v1
 
 import (
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider",description="Object storage provider"
+// +kubebuilder:printcolumn:name="Bucket",type="string",JSONPath=".spec.objectStorage.bucket",description="Bucket backups are stored in"
+// +kubebuilder:printcolumn:name="Prefix",type="string",JSONPath=".spec.objectStorage.prefix",description="Prefix within the bucket"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Whether the backup storage location is available"
+// +kubebuilder:printcolumn:name="Last Validated",type="date",JSONPath=".status.lastValidationTime",description="The last time the backup storage location was checked to be available"
+// +kubebuilder:printcolumn:name="Access Mode",type="string",JSONPath=".spec.accessMode",description="Permissions for the backup storage location"
 
 // BackupStorageLocation is a location where Velero stores backup objects.
 type BackupStorageLocation struct {
@@ -80,6 +89,89 @@ type BackupStorageLocationSpec struct {
 	// AccessMode defines the permissions for the backup storage location.
 	// +optional
 	AccessMode BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
+
+	// Identity is how the provider's plugin authenticates to the backup
+	// storage. If it's not set, the plugin uses the credentials that the
+	// Velero server is configured with, e.g. a mounted secret.
+	// +optional
+	// +nullable
+	Identity *CloudIdentity `json:"identity,omitempty"`
+
+	// Credential is the key of a secret in the Velero server's namespace
+	// that holds the credentials that the provider's plugin authenticates to
+	// the backup storage with, so that locations can be in different
+	// accounts. If it's not set, the plugin uses the credentials that the
+	// Velero server is configured with.
+	// +optional
+	// +nullable
+	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
+
+	// OrphanedBackupPolicy is what's done with the custom resources of
+	// completed backups in this location whose data is no longer in the
+	// location. Defaults to Delete.
+	// +optional
+	OrphanedBackupPolicy OrphanedBackupPolicy `json:"orphanedBackupPolicy,omitempty"`
+
+	// ValidationFrequency is how often the location is checked to be
+	// available. If it's not set, the server's default frequency is used. A
+	// frequency of 0 disables the check.
+	// +optional
+	// +nullable
+	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+}
+
+// OrphanedBackupPolicy is what the backup sync controller does with the custom
+// resources of backups whose data is no longer in their backup storage
+// location.
+// +kubebuilder:validation:Enum=Delete;Mark;Ignore
+type OrphanedBackupPolicy string
+
+const (
+	// OrphanedBackupPolicyDelete deletes the custom resources of orphaned
+	// backups.
+	OrphanedBackupPolicyDelete OrphanedBackupPolicy = "Delete"
+
+	// OrphanedBackupPolicyMark keeps the custom resources of orphaned backups,
+	// and sets their Orphaned condition.
+	OrphanedBackupPolicyMark OrphanedBackupPolicy = "Mark"
+
+	// OrphanedBackupPolicyIgnore keeps the custom resources of orphaned
+	// backups as they are.
+	OrphanedBackupPolicyIgnore OrphanedBackupPolicy = "Ignore"
+)
+
+// CloudIdentityMode is a way of authenticating to a cloud provider.
+// +kubebuilder:validation:Enum=Secret;AWSIRSA;GCPWorkloadIdentity;AzureWorkloadIdentity
+type CloudIdentityMode string
+
+const (
+	// CloudIdentityModeSecret authenticates with long-lived credentials from
+	// a secret that's mounted into the Velero pods.
+	CloudIdentityModeSecret CloudIdentityMode = "Secret"
+
+	// CloudIdentityModeAWSIRSA authenticates as an AWS IAM role using IAM
+	// Roles for Service Accounts.
+	CloudIdentityModeAWSIRSA CloudIdentityMode = "AWSIRSA"
+
+	// CloudIdentityModeGCPWorkloadIdentity authenticates as a Google service
+	// account using GKE Workload Identity.
+	CloudIdentityModeGCPWorkloadIdentity CloudIdentityMode = "GCPWorkloadIdentity"
+
+	// CloudIdentityModeAzureWorkloadIdentity authenticates as an Azure
+	// managed identity or application using Azure AD Workload Identity.
+	CloudIdentityModeAzureWorkloadIdentity CloudIdentityMode = "AzureWorkloadIdentity"
+)
+
+// CloudIdentity describes how a plugin authenticates to a cloud provider.
+type CloudIdentity struct {
+	// Mode is how the plugin authenticates.
+	Mode CloudIdentityMode `json:"mode"`
+
+	// Identity is the cloud identity that's assumed: an IAM role ARN for
+	// AWSIRSA, a Google service account email for GCPWorkloadIdentity, or
+	// a client ID for AzureWorkloadIdentity. It's unused for Secret.
+	// +optional
+	Identity string `json:"identity,omitempty"`
 }
 
 // BackupStorageLocationPhase is the lifecyle phase of a Velero BackupStorageLocation.
@@ -94,6 +186,28 @@ const (
 	BackupStorageLocationPhaseUnavailable BackupStorageLocationPhase = "Unavailable"
 )
 
+// EncryptionStatus describes the provider-side encryption at rest of the
+// objects in a backup storage location.
+type EncryptionStatus struct {
+	// Enabled is whether objects written to the location are encrypted at rest.
+	Enabled bool `json:"enabled"`
+
+	// Algorithm is the provider-specific encryption algorithm or scheme,
+	// e.g. "aws:kms".
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// KeyID identifies the key that objects are encrypted with, if the key
+	// is managed by the user rather than by the provider.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// LastCheckedTime is the last time the encryption status was checked.
+	// +optional
+	// +nullable
+	LastCheckedTime metav1.Time `json:"lastCheckedTime,omitempty"`
+}
+
 // BackupStorageLocationAccessMode represents the permissions for a BackupStorageLocation.
 // +kubebuilder:validation:Enum=ReadOnly;ReadWrite
 type BackupStorageLocationAccessMode string
@@ -115,6 +229,17 @@ type BackupStorageLocationStatus struct {
 	// +optional
 	Phase BackupStorageLocationPhase `json:"phase,omitempty"`
 
+	// LastValidationTime is the last time the location was checked to be
+	// available.
+	// +optional
+	// +nullable
+	LastValidationTime metav1.Time `json:"lastValidationTime,omitempty"`
+
+	// Message is the reason the location was unavailable the last time it
+	// was checked.
+	// +optional
+	Message string `json:"message,omitempty"`
+
 	// LastSyncedTime is the last time the contents of the location were synced into
 	// the cluster.
 	// +optional
@@ -129,6 +254,13 @@ type BackupStorageLocationStatus struct {
 	// +optional
 	LastSyncedRevision types.UID `json:"lastSyncedRevision,omitempty"`
 
+	// Encryption is the provider-side encryption at rest of the objects in the
+	// location, as last reported by its object store plugin. It's not set if the
+	// plugin doesn't report it.
+	// +optional
+	// +nullable
+	Encryption *EncryptionStatus `json:"encryption,omitempty"`
+
 	// AccessMode is an unused field.
 	//
 	// Deprecated: there is now an AccessMode field on the Spec and this field
@@ -29,6 +29,10 @@ const (
 	// files that store metadata about the backup, such as the backup version.
 	MetadataDir = "metadata"
 
+	// DiagnosticsDir is a top-level directory in backups which contains the
+	// events and pod logs captured for troubleshooting. It isn't restored.
+	DiagnosticsDir = "diagnostics"
+
 	// ClusterScopedDir is the name of the directory containing cluster-scoped
 	// resources within a Velero backup.
 	ClusterScopedDir = "cluster"
@@ -36,4 +40,8 @@ const (
 	// NamespaceScopedDir is the name of the directory containing namespace-scoped
 	// resource within a Velero backup.
 	NamespaceScopedDir = "namespaces"
+
+	// CSIFeatureFlag is the name of the feature flag that enables backing
+	// up and restoring persistent volumes with CSI volume snapshots.
+	CSIFeatureFlag = "EnableCSI"
 )
@@ -0,0 +1,63 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// DeleteBackupApprovalSpec is the specification for which backup's deletion
+// is approved, and by whom.
+type DeleteBackupApprovalSpec struct {
+	// BackupName is the name of the backup whose deletion is approved.
+	BackupName string `json:"backupName"`
+
+	// Approver is the user or service account that approved the deletion.
+	// It must be different from the requester of the deletion. It's set by
+	// the server's admission webhook to the user that creates the approval.
+	// +optional
+	Approver string `json:"approver,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// DeleteBackupApproval is a second person's approval of the deletion of a
+// protected backup. Backups labeled velero.io/protected=true are only
+// deleted once a DeleteBackupApproval from someone other than the requester
+// of the deletion exists for them.
+type DeleteBackupApproval struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DeleteBackupApprovalSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeleteBackupApprovalList is a list of DeleteBackupApprovals.
+type DeleteBackupApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DeleteBackupApproval `json:"items"`
+}
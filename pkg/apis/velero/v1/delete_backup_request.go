@@ -21,16 +21,30 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // DeleteBackupRequestSpec is the specification for which backups to delete.
 type DeleteBackupRequestSpec struct {
 	BackupName string `json:"backupName"`
+
+	// Requester is the user or component that requested the deletion. It's
+	// set by the server's admission webhook to the user that creates the
+	// request.
+	// +optional
+	Requester string `json:"requester,omitempty"`
+
+	// Reason is why the deletion was requested.
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
 // DeleteBackupRequestPhase represents the lifecycle phase of a DeleteBackupRequest.
-// +kubebuilder:validation:Enum=New;InProgress;Processed
+// +kubebuilder:validation:Enum=New;PendingApproval;InProgress;Processed
 type DeleteBackupRequestPhase string
 
 const (
 	// DeleteBackupRequestPhaseNew means the DeleteBackupRequest has not been processed yet.
 	DeleteBackupRequestPhaseNew DeleteBackupRequestPhase = "New"
 
+	// DeleteBackupRequestPhasePendingApproval means the DeleteBackupRequest is
+	// for a protected backup, and is waiting for a DeleteBackupApproval.
+	DeleteBackupRequestPhasePendingApproval DeleteBackupRequestPhase = "PendingApproval"
+
 	// DeleteBackupRequestPhaseInProgress means the DeleteBackupRequest is being processed.
 	DeleteBackupRequestPhaseInProgress DeleteBackupRequestPhase = "InProgress"
 
@@ -52,6 +66,8 @@ type DeleteBackupRequestStatus struct {
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 
 // DeleteBackupRequest is a request to delete one or more backups.
 type DeleteBackupRequest struct {
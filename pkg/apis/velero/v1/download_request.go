@@ -25,16 +25,21 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshot;BackupResourceList;RestoreLog;RestoreResults
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupNamespaceContents;BackupIndex;BackupVolumeSnapshot;BackupResourceList;BackupSearchIndex;BackupResults;RestoreLog;RestoreResults;RestoreManifests
 type DownloadTargetKind string
 
 const (
-	DownloadTargetKindBackupLog             DownloadTargetKind = "BackupLog"
-	DownloadTargetKindBackupContents        DownloadTargetKind = "BackupContents"
-	DownloadTargetKindBackupVolumeSnapshots DownloadTargetKind = "BackupVolumeSnapshots"
-	DownloadTargetKindBackupResourceList    DownloadTargetKind = "BackupResourceList"
-	DownloadTargetKindRestoreLog            DownloadTargetKind = "RestoreLog"
-	DownloadTargetKindRestoreResults        DownloadTargetKind = "RestoreResults"
+	DownloadTargetKindBackupLog               DownloadTargetKind = "BackupLog"
+	DownloadTargetKindBackupContents          DownloadTargetKind = "BackupContents"
+	DownloadTargetKindBackupNamespaceContents DownloadTargetKind = "BackupNamespaceContents"
+	DownloadTargetKindBackupIndex             DownloadTargetKind = "BackupIndex"
+	DownloadTargetKindBackupVolumeSnapshots   DownloadTargetKind = "BackupVolumeSnapshots"
+	DownloadTargetKindBackupResourceList      DownloadTargetKind = "BackupResourceList"
+	DownloadTargetKindBackupSearchIndex       DownloadTargetKind = "BackupSearchIndex"
+	DownloadTargetKindBackupResults           DownloadTargetKind = "BackupResults"
+	DownloadTargetKindRestoreLog              DownloadTargetKind = "RestoreLog"
+	DownloadTargetKindRestoreResults          DownloadTargetKind = "RestoreResults"
+	DownloadTargetKindRestoreManifests        DownloadTargetKind = "RestoreManifests"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
@@ -45,6 +50,11 @@ type DownloadTarget struct {
 
 	// Name is the name of the kubernetes resource with which the file is associated.
 	Name string `json:"name"`
+
+	// Namespace is the namespace whose sub-archive to download, for targets
+	// of kind BackupNamespaceContents.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // DownloadRequestPhase represents the lifecycle phase of a DownloadRequest.
@@ -79,6 +89,8 @@ type DownloadRequestStatus struct {
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 
 // DownloadRequest is a request to download an artifact from backup object storage, such as a backup
 // log file.
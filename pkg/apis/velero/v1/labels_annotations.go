@@ -32,6 +32,10 @@ const (
 	// RestoreUIDLabel is the label key used to identify a restore by uid.
 	RestoreUIDLabel = "velero.io/restore-uid"
 
+	// RestoreIdempotencyTokenLabel is the label key used to identify the
+	// items created by the restores with an idempotency token.
+	RestoreIdempotencyTokenLabel = "velero.io/restore-idempotency-token"
+
 	// PodUIDLabel is the label key used to identify a pod by uid.
 	PodUIDLabel = "velero.io/pod-uid"
 
@@ -50,4 +54,138 @@ const (
 	// ResticVolumeNamespaceLabel is the label key used to identify which
 	// namespace a restic repository stores pod volume backups for.
 	ResticVolumeNamespaceLabel = "velero.io/volume-namespace"
+
+	// ScheduleRevisionAnnotation is the annotation key used on a backup
+	// created by a schedule for the revision of the schedule's backup
+	// template that it was created from.
+	ScheduleRevisionAnnotation = "velero.io/schedule-revision"
+
+	// VerifyBackupAnnotation is the annotation key used to indicate that
+	// a backup should be verified, by restoring it into scratch namespaces,
+	// once it has been uploaded to object storage.
+	VerifyBackupAnnotation = "velero.io/verify-backup"
+
+	// VerificationRestoreAnnotation is the annotation key used to record
+	// the name of the restore that verifies a backup.
+	VerificationRestoreAnnotation = "velero.io/verification-restore"
+
+	// VerificationResultAnnotation is the annotation key used to record
+	// the result of a backup's verification, "Passed", "Failed" or
+	// "Skipped" if the backup has no namespaces to restore.
+	VerificationResultAnnotation = "velero.io/verification-result"
+
+	// BackupVerificationLabel is the label key used to identify the
+	// restores that verify backups. Its value is "true".
+	BackupVerificationLabel = "velero.io/backup-verification"
+
+	// BackupWithAnnotation is the annotation key used on a custom resource
+	// definition to declare the objects that its custom resources depend on,
+	// and that should be backed up with them.
+	BackupWithAnnotation = "velero.io/backup-with"
+
+	// OriginalReclaimPolicyAnnotation is the annotation key used to record
+	// the original reclaim policy of a persistent volume whose reclaim policy
+	// was set to Retain while it was being restored.
+	OriginalReclaimPolicyAnnotation = "velero.io/original-reclaim-policy"
+
+	// OriginalFailurePoliciesAnnotation is the annotation key used to record
+	// the original failure policies of the webhooks of an admission webhook
+	// configuration whose failure policies were set to Ignore while it was
+	// being restored.
+	OriginalFailurePoliciesAnnotation = "velero.io/original-failure-policies"
+
+	// PruneIncompleteBackupsAnnotation is the annotation key used on a
+	// backup storage location to request that the backups in it whose upload
+	// didn't finish are removed the next time it's synced. Its value is the
+	// time of the request.
+	PruneIncompleteBackupsAnnotation = "velero.io/prune-incomplete-backups"
+
+	// SyncBackupsAnnotation is the annotation key used on a backup storage
+	// location to request that it's synced right away, rather than at the
+	// next periodic sync. Its value is the time of the request.
+	SyncBackupsAnnotation = "velero.io/sync-backups"
+
+	// RebuildBackupsAnnotation is the annotation key used on a backup storage
+	// location to request that it's synced right away, and that the custom
+	// resources of the backups in it that already exist in the cluster are
+	// deleted and recreated from their metadata in the location. Its value is
+	// the time of the request.
+	RebuildBackupsAnnotation = "velero.io/rebuild-backups"
+
+	// DefaultBackupLocationAnnotation is the annotation key used to mark a
+	// backup storage location as the one that backups without a storage
+	// location are stored in. Its value is "true". It takes precedence over
+	// the server's --default-backup-storage-location flag.
+	DefaultBackupLocationAnnotation = "velero.io/default-backup-storage-location"
+
+	// BackupSpecHashAnnotation is the annotation key used to record the hash
+	// of a backup's spec when it was taken. It's stored with the backup's
+	// metadata in object storage, so that changes to the spec of the
+	// backup's custom resource can be detected when it's synced.
+	BackupSpecHashAnnotation = "velero.io/backup-spec-hash"
+
+	// BackupSpecHashVersionAnnotation is the annotation key used to record
+	// the version of the hash in a backup's velero.io/backup-spec-hash
+	// annotation, which determines the fields of the spec that it's
+	// computed over.
+	BackupSpecHashVersionAnnotation = "velero.io/backup-spec-hash-version"
+
+	// CorrelationIDAnnotation is the annotation key used to record the
+	// correlation ID of a backup or restore, which is copied to the pod
+	// volume backups and restores created for it and added to their logs.
+	CorrelationIDAnnotation = "velero.io/correlation-id"
+
+	// PVCPhaseAnnotation is the annotation key used to record the phase of
+	// a persistent volume claim that wasn't bound to a persistent volume
+	// when it was backed up, so that it's restored unbound.
+	PVCPhaseAnnotation = "velero.io/pvc-phase"
+
+	// SourceClusterNameLabel is the label key used to identify the name of
+	// the cluster that a backup was taken in, as configured with the server's
+	// --cluster-name flag.
+	SourceClusterNameLabel = "velero.io/source-cluster-name"
+
+	// SourceClusterUIDLabel is the label key used to identify the UID of the
+	// cluster that a backup was taken in, which is the UID of the cluster's
+	// kube-system namespace.
+	SourceClusterUIDLabel = "velero.io/source-cluster-uid"
+
+	// MemberClusterLabel is the label key used to register a member cluster
+	// with a management cluster, on a secret in the Velero namespace whose
+	// kubeconfig key has the member cluster's kubeconfig. Its value is the
+	// name of the member cluster. Backups of member clusters are labeled
+	// with it too.
+	MemberClusterLabel = "velero.io/member-cluster"
+
+	// ProtectedBackupLabel is the label key used to mark a backup as
+	// protected. Deleting a backup whose label value is "true" requires a
+	// DeleteBackupApproval from someone other than the requester.
+	ProtectedBackupLabel = "velero.io/protected"
+
+	// IdentityVerifiedAnnotation is the annotation key that the server's
+	// admission webhook sets, on the DeleteBackupRequests and
+	// DeleteBackupApprovals whose requester or approver it set to, or checked
+	// against, the authenticated user that created them, to the user signed
+	// with a key that only the server has.
+	IdentityVerifiedAnnotation = "velero.io/identity-verified"
+
+	// VolumeSnapshotClassLabel is the label key used to mark the
+	// VolumeSnapshotClass used to take CSI snapshots of the volumes of a
+	// CSI driver, if there are several for the driver.
+	VolumeSnapshotClassLabel = "velero.io/csi-volumesnapshot-class"
+
+	// VolumeSnapshotNameAnnotation is the annotation key used to record
+	// the name of the CSI VolumeSnapshot taken of a persistent volume claim's
+	// volume on the backed up claim, and its namespace and name on the
+	// backed up volume, so that the claim is restored from the snapshot.
+	VolumeSnapshotNameAnnotation = "velero.io/csi-volumesnapshot-name"
+
+	// VolumeSnapshotHandleAnnotation is the annotation key used to record
+	// the CSI driver's handle of the snapshot of a backed up VolumeSnapshot,
+	// so that it's restored with a new VolumeSnapshotContent.
+	VolumeSnapshotHandleAnnotation = "velero.io/csi-volumesnapshot-handle"
+
+	// CSIDriverNameAnnotation is the annotation key used to record the name
+	// of the CSI driver that took the snapshot of a backed up VolumeSnapshot.
+	CSIDriverNameAnnotation = "velero.io/csi-driver-name"
 )
@@ -100,6 +100,8 @@ type PodVolumeBackupStatus struct {
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 
 type PodVolumeBackup struct {
 	metav1.TypeMeta `json:",inline"`
@@ -83,6 +83,8 @@ type PodVolumeRestoreStatus struct {
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 
 type PodVolumeRestore struct {
 	metav1.TypeMeta `json:",inline"`
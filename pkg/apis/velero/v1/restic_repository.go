@@ -66,6 +66,10 @@ type ResticRepositoryStatus struct {
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Status of the repository"
+// +kubebuilder:printcolumn:name="Last Maintenance",type="date",JSONPath=".status.lastMaintenanceTime",description="Time maintenance was last run on the repository"
 
 type ResticRepository struct {
 	metav1.TypeMeta `json:",inline"`
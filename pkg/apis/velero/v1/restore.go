@@ -30,6 +30,14 @@ type RestoreSpec struct {
 	// +optional
 	ScheduleName string `json:"scheduleName,omitempty"`
 
+	// PointInTime is the point in time to restore the schedule to. If
+	// specified along with ScheduleName, Velero will restore from the most
+	// recent successful backup created from the schedule that started at
+	// or before this time.
+	// +optional
+	// +nullable
+	PointInTime *metav1.Time `json:"pointInTime,omitempty"`
+
 	// IncludedNamespaces is a slice of namespace names to include objects
 	// from. If empty, all namespaces are included.
 	// +optional
@@ -57,7 +65,10 @@ type RestoreSpec struct {
 	// NamespaceMapping is a map of source namespace names
 	// to target namespace names to restore into. Any source
 	// namespaces not included in the map will be restored into
-	// namespaces of the same name.
+	// namespaces of the same name. Source names may contain '*'
+	// wildcards, which are substituted for the corresponding '*'
+	// in the target name, or be regular expressions surrounded by
+	// '/', whose target name may reference capture groups.
 	// +optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 
@@ -68,6 +79,20 @@ type RestoreSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// ZoneMapping is a map of availability zones that persistent
+	// volumes were snapshotted in to the availability zones to restore
+	// them into, for when the target cluster is in different zones than
+	// the source cluster. The zone labels and node affinity of the
+	// restored persistent volumes are updated to match.
+	// +optional
+	ZoneMapping map[string]string `json:"zoneMapping,omitempty"`
+
+	// VolumeOverrides overrides the volume type and IOPS that persistent
+	// volumes restored from snapshots are created with.
+	// +optional
+	// +nullable
+	VolumeOverrides *VolumeOverrides `json:"volumeOverrides,omitempty"`
+
 	// RestorePVs specifies whether to restore all included
 	// PVs from snapshot (via the cloudprovider).
 	// +optional
@@ -80,6 +105,238 @@ type RestoreSpec struct {
 	// +optional
 	// +nullable
 	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
+
+	// StorageLocation is the name of the BackupStorageLocation to restore the
+	// backup from. If empty, the backup's own storage location is used.
+	// +optional
+	StorageLocation string `json:"storageLocation,omitempty"`
+
+	// DataOnly specifies whether to restore only the volume data in the
+	// backup, into existing PVCs with the same names in the target
+	// namespaces, without creating or modifying any other Kubernetes
+	// objects. The LabelSelector, if any, is matched against the existing
+	// PVCs' labels.
+	// +optional
+	DataOnly bool `json:"dataOnly,omitempty"`
+
+	// NoApply specifies whether to write the manifests of the items that
+	// would be restored to object storage, instead of creating them in the
+	// cluster. Items are still processed by restore item actions and
+	// namespace mappings, but volumes aren't restored.
+	// +optional
+	NoApply bool `json:"noApply,omitempty"`
+
+	// SkipOwnerManaged specifies whether to skip items that have an owner
+	// reference to another item that's being restored, such as the objects
+	// that an operator creates for its custom resources, since the owner
+	// will recreate them.
+	// +optional
+	SkipOwnerManaged bool `json:"skipOwnerManaged,omitempty"`
+
+	// RetainRestoredPVs specifies whether to set the reclaim policy of
+	// restored persistent volumes to Retain while the restore is running,
+	// so that a failed restore can't cause their volumes to be deleted.
+	// Their original reclaim policies are restored once the restore
+	// completes without errors.
+	// +optional
+	RetainRestoredPVs bool `json:"retainRestoredPVs,omitempty"`
+
+	// WaitForUnboundPVCs specifies whether to wait, after restoring
+	// persistent volume claims that weren't bound when they were backed up,
+	// for them to be bound to dynamically provisioned volumes before
+	// restoring the pods that use them. Claims whose storage class waits for
+	// a consumer before binding aren't waited for.
+	// +optional
+	WaitForUnboundPVCs bool `json:"waitForUnboundPVCs,omitempty"`
+
+	// Strict specifies whether the restore should fail validation if the
+	// backup has items whose API versions aren't served by the cluster,
+	// instead of only reporting them in the restore's status.
+	// +optional
+	Strict bool `json:"strict,omitempty"`
+
+	// AllowNewerBackupFormat specifies whether to attempt the restore of a
+	// backup whose format version is newer than the ones that the Velero
+	// server supports, instead of failing the restore's validation.
+	// +optional
+	AllowNewerBackupFormat bool `json:"allowNewerBackupFormat,omitempty"`
+
+	// AdmissionWebhooks specifies how the validating and mutating admission
+	// webhook configurations in the backup are restored, since webhooks whose
+	// backends aren't running yet can reject the items restored after them.
+	// Defaults to InOrder.
+	// +optional
+	AdmissionWebhooks AdmissionWebhookPolicy `json:"admissionWebhooks,omitempty"`
+
+	// LoadBalancerServices specifies whether restored Services of type
+	// LoadBalancer keep the cloud provider annotations that claim static
+	// IPs, DNS names and other load balancer settings. If nil, all of their
+	// annotations are kept.
+	// +optional
+	// +nullable
+	LoadBalancerServices *LoadBalancerServiceOptions `json:"loadBalancerServices,omitempty"`
+
+	// ReplicaPolicies specifies, per resource, whether workloads are
+	// restored with the replica counts they were backed up with. The first
+	// policy whose resources include an item applies to it. Replica counts
+	// are restored for items that no policy applies to.
+	// +optional
+	// +nullable
+	ReplicaPolicies []ResourceReplicaPolicy `json:"replicaPolicies,omitempty"`
+
+	// AutoscaledReplicas specifies the replica counts that workloads are
+	// restored with if they're the scale target of a horizontal pod
+	// autoscaler in the backup. Keep, the default, leaves their replica
+	// counts to ReplicaPolicies.
+	// +optional
+	AutoscaledReplicas AutoscaledReplicaPolicy `json:"autoscaledReplicas,omitempty"`
+
+	// ResourcePriorities is the order that resources are restored in,
+	// formatted as resource.group, such as issuers.cert-manager.io. It
+	// replaces the server's --restore-resource-priorities for this restore.
+	// Resources that aren't in the list are restored alphabetically after
+	// the prioritized ones.
+	// +optional
+	// +nullable
+	ResourcePriorities []string `json:"resourcePriorities,omitempty"`
+
+	// ExistingResourcePolicy specifies what the restore does with items
+	// that already exist in the cluster and differ from their backed-up
+	// versions. Defaults to none.
+	// +optional
+	ExistingResourcePolicy ExistingResourcePolicy `json:"existingResourcePolicy,omitempty"`
+
+	// IdempotencyToken identifies the items that the restore creates, which
+	// are labeled with it. Restores with the same token, e.g. a restore and
+	// its retries, treat items that one of them created as their own: they're
+	// skipped if they match their backed-up versions, and patched to match
+	// them if they don't, without warnings. Must be a valid label value.
+	// Defaults to the restore's UID.
+	// +optional
+	IdempotencyToken string `json:"idempotencyToken,omitempty"`
+
+	// RetryOf is the name of a PartiallyFailed restore of the same backup
+	// whose failed items are restored again, instead of all of the items
+	// that the restore's filters include. The restore should have the same
+	// idempotency token as the one it retries.
+	// +optional
+	RetryOf string `json:"retryOf,omitempty"`
+}
+
+// ExistingResourcePolicy is what a restore does with items that already
+// exist in the cluster.
+// +kubebuilder:validation:Enum=none;update;patch
+type ExistingResourcePolicy string
+
+const (
+	// ExistingResourcePolicyNone leaves existing items as they are, with a
+	// warning if they differ from their backed-up versions.
+	ExistingResourcePolicyNone ExistingResourcePolicy = "none"
+
+	// ExistingResourcePolicyUpdate replaces existing items with their
+	// backed-up versions, so fields that were added since the backup are
+	// removed.
+	ExistingResourcePolicyUpdate ExistingResourcePolicy = "update"
+
+	// ExistingResourcePolicyPatch merges the backed-up versions of existing
+	// items into them, so fields that were added since the backup are kept.
+	ExistingResourcePolicyPatch ExistingResourcePolicy = "patch"
+)
+
+// AutoscaledReplicaPolicy is the replica count that a restore restores the
+// scale targets of horizontal pod autoscalers with.
+// +kubebuilder:validation:Enum=Keep;Omit;MinReplicas
+type AutoscaledReplicaPolicy string
+
+const (
+	// AutoscaledReplicaPolicyKeep restores autoscaled workloads with the
+	// replica counts they were backed up with, unless ReplicaPolicies omit
+	// them.
+	AutoscaledReplicaPolicyKeep AutoscaledReplicaPolicy = "Keep"
+
+	// AutoscaledReplicaPolicyOmit removes the replica counts of autoscaled
+	// workloads, so that they're created with the API server's default
+	// replica count and then scaled by their autoscalers.
+	AutoscaledReplicaPolicyOmit AutoscaledReplicaPolicy = "Omit"
+
+	// AutoscaledReplicaPolicyMinReplicas restores autoscaled workloads with
+	// the minimum replica counts of their autoscalers, which scale them up
+	// from there.
+	AutoscaledReplicaPolicyMinReplicas AutoscaledReplicaPolicy = "MinReplicas"
+)
+
+// LoadBalancerAnnotationPolicy is whether a restore keeps the cloud
+// provider annotations of Services of type LoadBalancer.
+// +kubebuilder:validation:Enum=Keep;Strip
+type LoadBalancerAnnotationPolicy string
+
+const (
+	// LoadBalancerAnnotationPolicyKeep keeps the provider annotations of
+	// restored Services of type LoadBalancer, so that they claim the same
+	// static IPs and DNS names as the backed up Services.
+	LoadBalancerAnnotationPolicyKeep LoadBalancerAnnotationPolicy = "Keep"
+
+	// LoadBalancerAnnotationPolicyStrip removes the provider annotations and
+	// the load balancer IP of restored Services of type LoadBalancer, so that
+	// they're given new IPs and DNS names.
+	LoadBalancerAnnotationPolicyStrip LoadBalancerAnnotationPolicy = "Strip"
+)
+
+// LoadBalancerServiceOptions are how a restore restores the annotations of
+// Services of type LoadBalancer.
+type LoadBalancerServiceOptions struct {
+	// AnnotationPolicy is whether the Services keep their provider
+	// annotations. Defaults to Keep.
+	// +optional
+	AnnotationPolicy LoadBalancerAnnotationPolicy `json:"annotationPolicy,omitempty"`
+
+	// AllowedAnnotations are the keys of annotations that are kept even if
+	// the policy is Strip. Keys may contain '*' wildcards, e.g.
+	// "service.beta.kubernetes.io/aws-load-balancer-*".
+	// +optional
+	// +nullable
+	AllowedAnnotations []string `json:"allowedAnnotations,omitempty"`
+
+	// DeniedAnnotations are the keys of annotations that are removed even if
+	// the policy is Keep. Keys may contain '*' wildcards.
+	// +optional
+	// +nullable
+	DeniedAnnotations []string `json:"deniedAnnotations,omitempty"`
+}
+
+// AdmissionWebhookPolicy is how a restore restores the admission webhook
+// configurations in its backup.
+// +kubebuilder:validation:Enum=InOrder;RestoreLast;IgnoreFailures
+type AdmissionWebhookPolicy string
+
+const (
+	// AdmissionWebhookPolicyInOrder restores admission webhook configurations
+	// in the same order as any other resource.
+	AdmissionWebhookPolicyInOrder AdmissionWebhookPolicy = "InOrder"
+
+	// AdmissionWebhookPolicyRestoreLast restores admission webhook
+	// configurations after all of the other resources in the backup.
+	AdmissionWebhookPolicyRestoreLast AdmissionWebhookPolicy = "RestoreLast"
+
+	// AdmissionWebhookPolicyIgnoreFailures restores admission webhook
+	// configurations in order, with the failure policies of their webhooks
+	// set to Ignore until the restore completes.
+	AdmissionWebhookPolicyIgnoreFailures AdmissionWebhookPolicy = "IgnoreFailures"
+)
+
+// VolumeOverrides are the volume type and IOPS to create persistent volumes
+// restored from snapshots with, instead of the ones they were snapshotted with.
+type VolumeOverrides struct {
+	// VolumeType is the volume type to create volumes with. If a volume type
+	// is set and IOPS aren't, volumes are created without provisioned IOPS,
+	// since they're specific to a volume type.
+	// +optional
+	VolumeType string `json:"volumeType,omitempty"`
+
+	// IOPS is the number of provisioned IOPS to create volumes with.
+	// +optional
+	// +nullable
+	IOPS *int64 `json:"iops,omitempty"`
 }
 
 // RestorePhase is a string representation of the lifecycle phase
@@ -134,13 +391,65 @@ type RestoreStatus struct {
 	// +optional
 	Errors int `json:"errors,omitempty"`
 
+	// SkippedItems is a count of the items that restore item actions chose
+	// not to restore. The actual items are stored in object storage with the
+	// restore's warnings and errors.
+	// +optional
+	SkippedItems int `json:"skippedItems,omitempty"`
+
 	// FailureReason is an error that caused the entire restore to fail.
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
+
+	// CompatibilityIssues lists the kinds of items in the backup whose
+	// API versions aren't served by the cluster, and that therefore
+	// can't be restored.
+	// +optional
+	// +nullable
+	CompatibilityIssues []RestoreCompatibilityIssue `json:"compatibilityIssues,omitempty"`
+}
+
+// RestoreCompatibilityIssueType is the type of a RestoreCompatibilityIssue.
+type RestoreCompatibilityIssueType string
+
+const (
+	// RestoreCompatibilityIssueMissingCRD means that the cluster doesn't
+	// serve the API group of the items, and the backup doesn't include a
+	// custom resource definition for it.
+	RestoreCompatibilityIssueMissingCRD RestoreCompatibilityIssueType = "MissingCustomResourceDefinition"
+
+	// RestoreCompatibilityIssueRemovedAPIVersion means that the cluster
+	// serves the API group of the items, but not the version or kind
+	// they were backed up with.
+	RestoreCompatibilityIssueRemovedAPIVersion RestoreCompatibilityIssueType = "RemovedAPIVersion"
+)
+
+// RestoreCompatibilityIssue is a kind of item in a backup that can't be
+// restored into the cluster.
+type RestoreCompatibilityIssue struct {
+	// Type is the type of the issue.
+	Type RestoreCompatibilityIssueType `json:"type"`
+
+	// APIVersion is the API version that the items were backed up with.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the items.
+	Kind string `json:"kind"`
+
+	// Items is the number of items in the backup that are affected.
+	Items int `json:"items"`
 }
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="Backup the restore is from"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Status of the restore"
+// +kubebuilder:printcolumn:name="Warnings",type="integer",JSONPath=".status.warnings",description="Number of warnings encountered during the restore"
+// +kubebuilder:printcolumn:name="Errors",type="integer",JSONPath=".status.errors",description="Number of errors encountered during the restore"
+// +kubebuilder:printcolumn:name="Created",type="date",JSONPath=".metadata.creationTimestamp",description="Time the restore was created"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.failureReason",description="Reason the restore failed",priority=1
 
 // Restore is a Velero resource that represents the application of
 // resources from a Velero backup to a target Kubernetes cluster.
@@ -27,6 +27,67 @@ type ScheduleSpec struct {
 	// Schedule is a Cron expression defining when to run
 	// the Backup.
 	Schedule string `json:"schedule"`
+
+	// Timezone is the name of the time zone, from the IANA time zone
+	// database, that Schedule is evaluated in, e.g. America/New_York. If
+	// unset, Schedule is evaluated in the Velero server's local time zone.
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// VerifyEvery, if greater than zero, causes every Nth Backup
+	// created from this schedule to be verified once it has been
+	// uploaded to object storage, by restoring its namespaces into
+	// scratch namespaces that are deleted after the restore. Volumes,
+	// pods, persistent volume claims, services and cluster-scoped
+	// resources aren't restored.
+	// +optional
+	VerifyEvery int `json:"verifyEvery,omitempty"`
+
+	// Clusters are the names of the member clusters to back up, if the
+	// Velero server runs in a management cluster that member clusters are
+	// registered with. Each time the schedule runs, a Backup of each of
+	// them is created from the template. '*' backs up all of the registered
+	// member clusters. If empty, a single Backup is created.
+	// +optional
+	// +nullable
+	Clusters []string `json:"clusters,omitempty"`
+
+	// Retention is how many of the schedule's backups are kept. If it's
+	// set, the schedule's completed and partially failed backups are
+	// deleted once the policy no longer keeps them, instead of when their
+	// TTL expires, so that a schedule that keeps failing doesn't lose all
+	// of its backups.
+	// +optional
+	// +nullable
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// Paused, if true, stops the schedule from creating Backups until it's
+	// unpaused. If runs were missed while it was paused, it creates a
+	// Backup once when it's unpaused, rather than one for each missed run.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// RetentionPolicy is how many of a schedule's backups are kept. A backup is
+// kept if any of the counts keeps it. Only completed and partially failed
+// backups are counted.
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast int `json:"keepLast,omitempty"`
+
+	// KeepDaily is the number of days, of the most recent days that have
+	// backups, to keep the last backup of.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepDaily int `json:"keepDaily,omitempty"`
+
+	// KeepWeekly is the number of weeks, of the most recent weeks that
+	// have backups, to keep the last backup of. Weeks start on Monday.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepWeekly int `json:"keepWeekly,omitempty"`
 }
 
 // SchedulePhase is a string representation of the lifecycle phase
@@ -64,10 +125,44 @@ type ScheduleStatus struct {
 	// applicable)
 	// +optional
 	ValidationErrors []string `json:"validationErrors,omitempty"`
+
+	// BackupCount is the number of Backups that have been created
+	// from this schedule. It is used to determine which Backups
+	// should be verified when VerifyEvery is set.
+	// +optional
+	BackupCount int `json:"backupCount,omitempty"`
+
+	// Revisions are the most recent revisions of the Schedule's backup
+	// template, oldest first. A revision is recorded each time the
+	// template is changed.
+	// +optional
+	// +nullable
+	Revisions []ScheduleRevision `json:"revisions,omitempty"`
+}
+
+// ScheduleRevision is a revision of a Schedule's backup template.
+type ScheduleRevision struct {
+	// Revision is the number of the revision. Revisions are numbered
+	// from 1, in the order that they're recorded in.
+	Revision int `json:"revision"`
+
+	// Timestamp is when the revision was recorded.
+	// +nullable
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// Template is the Schedule's backup template as of the revision.
+	Template BackupSpec `json:"template"`
 }
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Status of the schedule"
+// +kubebuilder:printcolumn:name="Created",type="date",JSONPath=".metadata.creationTimestamp",description="Time the schedule was created"
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="Cron expression the schedule runs on"
+// +kubebuilder:printcolumn:name="Backup TTL",type="string",JSONPath=".spec.template.ttl",description="How long the schedule's backups are retained for"
+// +kubebuilder:printcolumn:name="Last Backup",type="date",JSONPath=".status.lastBackup",description="Time the schedule last ran a backup"
 
 // Schedule is a Velero resource that represents a pre-scheduled or
 // periodic Backup that should be run.
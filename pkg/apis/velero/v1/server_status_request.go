@@ -22,6 +22,8 @@ import (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 
 // ServerStatusRequest is a request to access current status information about
 // the Velero server.
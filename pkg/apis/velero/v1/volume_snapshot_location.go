@@ -16,10 +16,16 @@ limitations under the License.
 
 package v1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider",description="Volume snapshot provider"
 
 // VolumeSnapshotLocation is a location where Velero stores volume snapshots.
 type VolumeSnapshotLocation struct {
@@ -55,6 +61,22 @@ type VolumeSnapshotLocationSpec struct {
 	// Config is for provider-specific configuration fields.
 	// +optional
 	Config map[string]string `json:"config,omitempty"`
+
+	// Identity is how the provider's plugin authenticates to the volume
+	// storage. If it's not set, the plugin uses the credentials that the
+	// Velero server is configured with, e.g. a mounted secret.
+	// +optional
+	// +nullable
+	Identity *CloudIdentity `json:"identity,omitempty"`
+
+	// Credential is the key of a secret in the Velero server's namespace
+	// that holds the credentials that the provider's plugin authenticates to
+	// the volume storage with, so that locations can be in different
+	// accounts. If it's not set, the plugin uses the credentials that the
+	// Velero server is configured with.
+	// +optional
+	// +nullable
+	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
 }
 
 // VolumeSnapshotLocationPhase is the lifecyle phase of a Velero VolumeSnapshotLocation.
@@ -21,10 +21,27 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalStorageLocationStatus) DeepCopyInto(out *AdditionalStorageLocationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalStorageLocationStatus.
+func (in *AdditionalStorageLocationStatus) DeepCopy() *AdditionalStorageLocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalStorageLocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
@@ -53,6 +70,39 @@ func (in *Backup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCondition) DeepCopyInto(out *BackupCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCondition.
+func (in *BackupCondition) DeepCopy() *BackupCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEncryption) DeepCopyInto(out *BackupEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEncryption.
+func (in *BackupEncryption) DeepCopy() *BackupEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupHooks) DeepCopyInto(out *BackupHooks) {
 	*out = *in
@@ -109,6 +159,97 @@ func (in *BackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupQuota) DeepCopyInto(out *BackupQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupQuota.
+func (in *BackupQuota) DeepCopy() *BackupQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupQuotaList) DeepCopyInto(out *BackupQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupQuotaList.
+func (in *BackupQuotaList) DeepCopy() *BackupQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupQuotaSpec) DeepCopyInto(out *BackupQuotaSpec) {
+	*out = *in
+	if in.MaxConcurrentBackups != nil {
+		in, out := &in.MaxConcurrentBackups, &out.MaxConcurrentBackups
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxStoredBytes != nil {
+		in, out := &in.MaxStoredBytes, &out.MaxStoredBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxSnapshots != nil {
+		in, out := &in.MaxSnapshots, &out.MaxSnapshots
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupQuotaSpec.
+func (in *BackupQuotaSpec) DeepCopy() *BackupQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupResourceHook) DeepCopyInto(out *BackupResourceHook) {
 	*out = *in
@@ -198,6 +339,11 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IncludedResources != nil {
 		in, out := &in.IncludedResources, &out.IncludedResources
 		*out = make([]string, len(*in))
@@ -218,7 +364,40 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ExcludedSnapshotNamespaces != nil {
+		in, out := &in.ExcludedSnapshotNamespaces, &out.ExcludedSnapshotNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedSnapshotLabelSelector != nil {
+		in, out := &in.ExcludedSnapshotLabelSelector, &out.ExcludedSnapshotLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodVolumeBackupSelectors != nil {
+		in, out := &in.PodVolumeBackupSelectors, &out.PodVolumeBackupSelectors
+		*out = make([]metav1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultVolumesToRestic != nil {
+		in, out := &in.DefaultVolumesToRestic, &out.DefaultVolumesToRestic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int64)
+		**out = **in
+	}
 	out.TTL = in.TTL
+	out.LogTTL = in.LogTTL
 	if in.IncludeClusterResources != nil {
 		in, out := &in.IncludeClusterResources, &out.IncludeClusterResources
 		*out = new(bool)
@@ -230,6 +409,40 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalStorageLocations != nil {
+		in, out := &in.AdditionalStorageLocations, &out.AdditionalStorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicaPolicies != nil {
+		in, out := &in.ReplicaPolicies, &out.ReplicaPolicies
+		*out = make([]ResourceReplicaPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrderedResources != nil {
+		in, out := &in.OrderedResources, &out.OrderedResources
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.IncludedResourceNames != nil {
+		in, out := &in.IncludedResourceNames, &out.IncludedResourceNames
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -247,6 +460,10 @@ func (in *BackupSpec) DeepCopy() *BackupSpec {
 func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	*out = *in
 	in.Expiration.DeepCopyInto(&out.Expiration)
+	if in.LogsExpiration != nil {
+		in, out := &in.LogsExpiration, &out.LogsExpiration
+		*out = (*in).DeepCopy()
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -254,6 +471,40 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	}
 	in.StartTimestamp.DeepCopyInto(&out.StartTimestamp)
 	in.CompletionTimestamp.DeepCopyInto(&out.CompletionTimestamp)
+	if in.AdditionalStorageLocations != nil {
+		in, out := &in.AdditionalStorageLocations, &out.AdditionalStorageLocations
+		*out = make([]AdditionalStorageLocationStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.SlowestItems != nil {
+		in, out := &in.SlowestItems, &out.SlowestItems
+		*out = make([]ItemTiming, len(*in))
+		copy(*out, *in)
+	}
+	if in.SlowestActions != nil {
+		in, out := &in.SlowestActions, &out.SlowestActions
+		*out = make([]ItemTiming, len(*in))
+		copy(*out, *in)
+	}
+	if in.PluginOperations != nil {
+		in, out := &in.PluginOperations, &out.PluginOperations
+		*out = make([]PluginOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]BackupCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BackupEncryption)
+		**out = **in
+	}
 	return
 }
 
@@ -339,6 +590,21 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 		}
 	}
 	in.StorageType.DeepCopyInto(&out.StorageType)
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(CloudIdentity)
+		**out = **in
+	}
+	if in.Credential != nil {
+		in, out := &in.Credential, &out.Credential
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValidationFrequency != nil {
+		in, out := &in.ValidationFrequency, &out.ValidationFrequency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -355,7 +621,13 @@ func (in *BackupStorageLocationSpec) DeepCopy() *BackupStorageLocationSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupStorageLocationStatus) DeepCopyInto(out *BackupStorageLocationStatus) {
 	*out = *in
+	in.LastValidationTime.DeepCopyInto(&out.LastValidationTime)
 	in.LastSyncedTime.DeepCopyInto(&out.LastSyncedTime)
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EncryptionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -369,6 +641,98 @@ func (in *BackupStorageLocationStatus) DeepCopy() *BackupStorageLocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudIdentity) DeepCopyInto(out *CloudIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudIdentity.
+func (in *CloudIdentity) DeepCopy() *CloudIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteBackupApproval) DeepCopyInto(out *DeleteBackupApproval) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupApproval.
+func (in *DeleteBackupApproval) DeepCopy() *DeleteBackupApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteBackupApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeleteBackupApproval) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteBackupApprovalList) DeepCopyInto(out *DeleteBackupApprovalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeleteBackupApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupApprovalList.
+func (in *DeleteBackupApprovalList) DeepCopy() *DeleteBackupApprovalList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteBackupApprovalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeleteBackupApprovalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteBackupApprovalSpec) DeepCopyInto(out *DeleteBackupApprovalSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupApprovalSpec.
+func (in *DeleteBackupApprovalSpec) DeepCopy() *DeleteBackupApprovalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteBackupApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeleteBackupRequest) DeepCopyInto(out *DeleteBackupRequest) {
 	*out = *in
@@ -578,6 +942,23 @@ func (in *DownloadTarget) DeepCopy() *DownloadTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionStatus) DeepCopyInto(out *EncryptionStatus) {
+	*out = *in
+	in.LastCheckedTime.DeepCopyInto(&out.LastCheckedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionStatus.
+func (in *EncryptionStatus) DeepCopy() *EncryptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecHook) DeepCopyInto(out *ExecHook) {
 	*out = *in
@@ -600,6 +981,49 @@ func (in *ExecHook) DeepCopy() *ExecHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemTiming) DeepCopyInto(out *ItemTiming) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemTiming.
+func (in *ItemTiming) DeepCopy() *ItemTiming {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemTiming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerServiceOptions) DeepCopyInto(out *LoadBalancerServiceOptions) {
+	*out = *in
+	if in.AllowedAnnotations != nil {
+		in, out := &in.AllowedAnnotations, &out.AllowedAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedAnnotations != nil {
+		in, out := &in.DeniedAnnotations, &out.DeniedAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerServiceOptions.
+func (in *LoadBalancerServiceOptions) DeepCopy() *LoadBalancerServiceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerServiceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 	*out = *in
@@ -632,6 +1056,30 @@ func (in *PluginInfo) DeepCopy() *PluginInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginOperation) DeepCopyInto(out *PluginOperation) {
+	*out = *in
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.Updated != nil {
+		in, out := &in.Updated, &out.Updated
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginOperation.
+func (in *PluginOperation) DeepCopy() *PluginOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodVolumeBackup) DeepCopyInto(out *PodVolumeBackup) {
 	*out = *in
@@ -849,6 +1297,27 @@ func (in *PodVolumeRestoreStatus) DeepCopy() *PodVolumeRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReplicaPolicy) DeepCopyInto(out *ResourceReplicaPolicy) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReplicaPolicy.
+func (in *ResourceReplicaPolicy) DeepCopy() *ResourceReplicaPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReplicaPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResticRepository) DeepCopyInto(out *ResticRepository) {
 	*out = *in
@@ -972,6 +1441,22 @@ func (in *Restore) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreCompatibilityIssue) DeepCopyInto(out *RestoreCompatibilityIssue) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreCompatibilityIssue.
+func (in *RestoreCompatibilityIssue) DeepCopy() *RestoreCompatibilityIssue {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreCompatibilityIssue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreList) DeepCopyInto(out *RestoreList) {
 	*out = *in
@@ -1008,6 +1493,10 @@ func (in *RestoreList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
+	if in.PointInTime != nil {
+		in, out := &in.PointInTime, &out.PointInTime
+		*out = (*in).DeepCopy()
+	}
 	if in.IncludedNamespaces != nil {
 		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
 		*out = make([]string, len(*in))
@@ -1040,6 +1529,18 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ZoneMapping != nil {
+		in, out := &in.ZoneMapping, &out.ZoneMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VolumeOverrides != nil {
+		in, out := &in.VolumeOverrides, &out.VolumeOverrides
+		*out = new(VolumeOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RestorePVs != nil {
 		in, out := &in.RestorePVs, &out.RestorePVs
 		*out = new(bool)
@@ -1050,6 +1551,23 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LoadBalancerServices != nil {
+		in, out := &in.LoadBalancerServices, &out.LoadBalancerServices
+		*out = new(LoadBalancerServiceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicaPolicies != nil {
+		in, out := &in.ReplicaPolicies, &out.ReplicaPolicies
+		*out = make([]ResourceReplicaPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourcePriorities != nil {
+		in, out := &in.ResourcePriorities, &out.ResourcePriorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1071,6 +1589,11 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CompatibilityIssues != nil {
+		in, out := &in.CompatibilityIssues, &out.CompatibilityIssues
+		*out = make([]RestoreCompatibilityIssue, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1084,6 +1607,22 @@ func (in *RestoreStatus) DeepCopy() *RestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicy) DeepCopyInto(out *RetentionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
+func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
@@ -1145,10 +1684,38 @@ func (in *ScheduleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleRevision) DeepCopyInto(out *ScheduleRevision) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRevision.
+func (in *ScheduleRevision) DeepCopy() *ScheduleRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -1171,6 +1738,13 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]ScheduleRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1304,6 +1878,27 @@ func (in *StorageType) DeepCopy() *StorageType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeOverrides) DeepCopyInto(out *VolumeOverrides) {
+	*out = *in
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeOverrides.
+func (in *VolumeOverrides) DeepCopy() *VolumeOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotLocation) DeepCopyInto(out *VolumeSnapshotLocation) {
 	*out = *in
@@ -1375,6 +1970,16 @@ func (in *VolumeSnapshotLocationSpec) DeepCopyInto(out *VolumeSnapshotLocationSp
 			(*out)[key] = val
 		}
 	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(CloudIdentity)
+		**out = **in
+	}
+	if in.Credential != nil {
+		in, out := &in.Credential, &out.Credential
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
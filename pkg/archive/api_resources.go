@@ -0,0 +1,95 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// APIResourcesFile is the name of the file, in a backup's metadata
+// directory, that the API resources of the backed up cluster are stored in.
+const APIResourcesFile = "api-resources.json"
+
+// APIResources are the API groups and resources that a cluster served when
+// it was backed up. The resources are the preferred versions of the ones
+// that could be backed up, which are the versions that the backup's items
+// are stored in.
+type APIResources struct {
+	Groups    []metav1.APIGroup        `json:"groups"`
+	Resources []metav1.APIResourceList `json:"resources"`
+}
+
+// ResourceFor returns the group/version/resource, and the API resource, that
+// a group resource was backed up as. The returned bool is false if the
+// cluster didn't serve the group resource.
+func (r *APIResources) ResourceFor(groupResource schema.GroupResource) (schema.GroupVersionResource, metav1.APIResource, bool) {
+	for _, list := range r.Resources {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil || gv.Group != groupResource.Group {
+			continue
+		}
+
+		for _, resource := range list.APIResources {
+			if resource.Name == groupResource.Resource {
+				return gv.WithResource(resource.Name), resource, true
+			}
+		}
+	}
+
+	return schema.GroupVersionResource{}, metav1.APIResource{}, false
+}
+
+// KindFor returns the group/version/kind that a group resource's items were
+// backed up as. The returned bool is false if the cluster didn't serve the
+// group resource.
+func (r *APIResources) KindFor(groupResource schema.GroupResource) (schema.GroupVersionKind, bool) {
+	gvr, resource, ok := r.ResourceFor(groupResource)
+	if !ok {
+		return schema.GroupVersionKind{}, false
+	}
+	return gvr.GroupVersion().WithKind(resource.Kind), true
+}
+
+// ParseAPIResources reads the API resources of the backed up cluster from an
+// extracted backup on the file system. It returns nil if the backup doesn't
+// have them, which is the case for backups taken by older versions of Velero.
+func (p *Parser) ParseAPIResources(dir string) (*APIResources, error) {
+	path := filepath.Join(dir, velerov1api.MetadataDir, APIResourcesFile)
+
+	data, err := p.fs.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading %s", filepath.Join(velerov1api.MetadataDir, APIResourcesFile))
+	}
+
+	resources := new(APIResources)
+	if err := json.Unmarshal(data, resources); err != nil {
+		return nil, errors.Wrapf(err, "error parsing %s", filepath.Join(velerov1api.MetadataDir, APIResourcesFile))
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+const apiResourcesJSON = `{
+	"groups": [
+		{"name": "apps", "versions": [{"groupVersion": "apps/v1", "version": "v1"}], "preferredVersion": {"groupVersion": "apps/v1", "version": "v1"}}
+	],
+	"resources": [
+		{"groupVersion": "v1", "resources": [{"name": "pods", "namespaced": true, "kind": "Pod", "verbs": ["list", "create"]}]},
+		{"groupVersion": "apps/v1", "resources": [{"name": "deployments", "namespaced": true, "kind": "Deployment", "verbs": ["list", "create"]}]}
+	]
+}`
+
+func TestParseAPIResources(t *testing.T) {
+	tests := []struct {
+		name    string
+		fs      *test.FakeFileSystem
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name:    "a backup without API resources returns nil",
+			fs:      test.NewFakeFileSystem().WithDirectory("root-dir/metadata"),
+			wantNil: true,
+		},
+		{
+			name: "a backup with API resources returns them",
+			fs:   test.NewFakeFileSystem().WithFile("root-dir/metadata/api-resources.json", []byte(apiResourcesJSON)),
+		},
+		{
+			name:    "invalid API resources return an error",
+			fs:      test.NewFakeFileSystem().WithFile("root-dir/metadata/api-resources.json", []byte("{")),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := NewParser(test.NewLogger(), tc.fs).ParseAPIResources("root-dir")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.wantNil {
+				assert.Nil(t, res)
+				return
+			}
+			require.NotNil(t, res)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, "apps", res.Groups[0].Name)
+			assert.Len(t, res.Resources, 2)
+		})
+	}
+}
+
+func TestAPIResourcesKindFor(t *testing.T) {
+	res, err := NewParser(test.NewLogger(), test.NewFakeFileSystem().WithFile("root-dir/metadata/api-resources.json", []byte(apiResourcesJSON))).ParseAPIResources("root-dir")
+	require.NoError(t, err)
+
+	gvk, ok := res.KindFor(schema.GroupResource{Group: "apps", Resource: "deployments"})
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, gvk)
+
+	gvk, ok = res.KindFor(schema.GroupResource{Resource: "pods"})
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, gvk)
+
+	gvr, resource, ok := res.ResourceFor(schema.GroupResource{Group: "apps", Resource: "deployments"})
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, gvr)
+	assert.True(t, resource.Namespaced)
+
+	_, ok = res.KindFor(schema.GroupResource{Group: "example.com", Resource: "widgets"})
+	assert.False(t, ok)
+}
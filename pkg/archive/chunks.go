@@ -0,0 +1,114 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+const (
+	// ItemChunkSize is the largest size, in bytes, of an item's file in a
+	// backup archive. The files of larger items are split into consecutive
+	// chunk files of at most this size, which are reassembled when the
+	// archive is extracted.
+	ItemChunkSize = 1024 * 1024
+
+	chunkSuffix = ".chunk-"
+)
+
+// ChunkFileName returns the name of the chunk of a file with the given index.
+func ChunkFileName(name string, index int) string {
+	return fmt.Sprintf("%s%s%04d", name, chunkSuffix, index)
+}
+
+// parseChunkFileName returns the name of the file that a chunk file is a
+// chunk of, and the chunk's index. ok is false if name isn't a chunk file.
+func parseChunkFileName(name string) (file string, index int, ok bool) {
+	i := strings.LastIndex(name, chunkSuffix)
+	if i < 0 {
+		return "", 0, false
+	}
+
+	index, err := strconv.Atoi(name[i+len(chunkSuffix):])
+	if err != nil || index < 0 {
+		return "", 0, false
+	}
+
+	return name[:i], index, true
+}
+
+// chunkAssembler reassembles the chunks of files that were split into chunks.
+// The chunks of a file are consecutive in an archive, so the file that they're
+// being reassembled into is kept open until a chunk of another file, or another
+// file, is read.
+type chunkAssembler struct {
+	fs filesystem.Interface
+
+	target    string
+	file      io.WriteCloser
+	nextIndex int
+}
+
+// write appends the chunk with the given index of the target file, which must
+// be the next one, to it.
+func (a *chunkAssembler) write(target string, index int, chunk io.Reader) error {
+	if target != a.target {
+		if err := a.close(); err != nil {
+			return err
+		}
+
+		if index != 0 {
+			return errors.Errorf("chunk %d of %s is out of order", index, target)
+		}
+
+		file, err := a.fs.Create(target)
+		if err != nil {
+			return err
+		}
+
+		a.target, a.file, a.nextIndex = target, file, 0
+	}
+
+	if index != a.nextIndex {
+		return errors.Errorf("chunk %d of %s is out of order", index, target)
+	}
+
+	if _, err := io.Copy(a.file, chunk); err != nil {
+		return err
+	}
+	a.nextIndex++
+
+	return nil
+}
+
+// close closes the file that chunks are being reassembled into, if any.
+func (a *chunkAssembler) close() error {
+	if a.file == nil {
+		return nil
+	}
+
+	err := a.file.Close()
+	a.target, a.file, a.nextIndex = "", nil, 0
+	return err
+}
@@ -73,6 +73,9 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 		return "", err
 	}
 
+	chunks := &chunkAssembler{fs: e.fs}
+	defer chunks.close()
+
 	for {
 		header, err := tarRdr.Next()
 
@@ -102,6 +105,20 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 				return "", err
 			}
 
+			// reassemble the file from its chunks, if it was split into them
+			if file, index, ok := parseChunkFileName(target); ok {
+				if err := chunks.write(file, index, tarRdr); err != nil {
+					e.log.Infof("error reassembling chunk: %v", err)
+					return "", err
+				}
+				continue
+			}
+
+			if err := chunks.close(); err != nil {
+				e.log.Infof("error closing reassembled file: %v", err)
+				return "", err
+			}
+
 			// create the file
 			if err := e.writeFile(target, tarRdr); err != nil {
 				e.log.Infof("error copying: %v", err)
@@ -110,5 +127,10 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 		}
 	}
 
+	if err := chunks.close(); err != nil {
+		e.log.Infof("error closing reassembled file: %v", err)
+		return "", err
+	}
+
 	return dir, nil
 }
@@ -0,0 +1,130 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type tarFile struct {
+	name     string
+	contents string
+}
+
+func newTarball(t *testing.T, files ...tarFile) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+
+	for _, file := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     file.name,
+			Size:     int64(len(file.contents)),
+			Typeflag: tar.TypeReg,
+			Mode:     0755,
+		}))
+		_, err := tw.Write([]byte(file.contents))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return buf
+}
+
+func TestUnzipAndExtractBackupChunks(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   []tarFile
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "files that weren't split into chunks are extracted as they are",
+			files: []tarFile{
+				{name: "resources/pods/namespaces/ns-1/pod-1.json", contents: "pod-1"},
+			},
+			want: map[string]string{
+				"resources/pods/namespaces/ns-1/pod-1.json": "pod-1",
+			},
+		},
+		{
+			name: "files that were split into chunks are reassembled",
+			files: []tarFile{
+				{name: "resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0000", contents: "big-"},
+				{name: "resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0001", contents: "config"},
+				{name: "resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0002", contents: "map"},
+				{name: "resources/configmaps/namespaces/ns-1/cm-2.json", contents: "cm-2"},
+				{name: "resources/configmaps/namespaces/ns-1/cm-3.json.chunk-0000", contents: "cm-"},
+				{name: "resources/configmaps/namespaces/ns-1/cm-3.json.chunk-0001", contents: "3"},
+			},
+			want: map[string]string{
+				"resources/configmaps/namespaces/ns-1/cm-1.json": "big-configmap",
+				"resources/configmaps/namespaces/ns-1/cm-2.json": "cm-2",
+				"resources/configmaps/namespaces/ns-1/cm-3.json": "cm-3",
+			},
+		},
+		{
+			name: "chunks that are out of order are an error",
+			files: []tarFile{
+				{name: "resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0000", contents: "big-"},
+				{name: "resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0002", contents: "map"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "chunks without a first chunk are an error",
+			files: []tarFile{
+				{name: "resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0001", contents: "config"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := test.NewFakeFileSystem()
+
+			dir, err := NewExtractor(test.NewLogger(), fs).UnzipAndExtractBackup(newTarball(t, tc.files...))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for name, contents := range tc.want {
+				data, err := fs.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, contents, string(data))
+			}
+
+			files, err := fs.ReadDir(filepath.Join(dir, filepath.Dir(tc.files[0].name)))
+			require.NoError(t, err)
+			assert.Len(t, files, len(tc.want))
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// SplitFormatVersion is the version of the backup archive format whose
+// archives are split into a main archive and per-namespace sub-archives.
+const SplitFormatVersion = 2
+
+// Index is the index of a backup archive that's split into a main archive,
+// which has the backup's metadata and cluster-scoped items, and a sub-archive
+// for each namespace, which has the namespace's items. Sub-archives have the
+// same layout as the main archive, so extracting all of them into the same
+// directory results in the layout of an archive that isn't split.
+type Index struct {
+	// Version is the archive format version.
+	Version int `json:"version"`
+
+	// ClusterItems is the number of cluster-scoped items in the main archive.
+	ClusterItems int `json:"clusterItems"`
+
+	// Namespaces are the namespaces that have sub-archives, sorted by name.
+	Namespaces []IndexNamespace `json:"namespaces"`
+}
+
+// IndexNamespace is a namespace's sub-archive.
+type IndexNamespace struct {
+	// Name is the name of the namespace.
+	Name string `json:"name"`
+
+	// Items is the number of items in the sub-archive.
+	Items int `json:"items"`
+}
+
+// HasNamespace returns whether the archive has a sub-archive for a namespace.
+func (i *Index) HasNamespace(name string) bool {
+	for _, ns := range i.Namespaces {
+		if ns.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// itemNamespace returns the namespace of the item whose file, or chunk of a file,
+// is at a path in an archive. The namespace is empty if the item is cluster-scoped,
+// or if the file isn't an item's, in which case isItem is false. isItem is also
+// false for chunk files other than the first, so that each item is counted once.
+func itemNamespace(name string) (namespace string, isItem bool) {
+	parts := strings.Split(name, "/")
+	if len(parts) < 4 || parts[0] != velerov1api.ResourcesDir {
+		return "", false
+	}
+
+	_, index, isChunk := parseChunkFileName(name)
+	isItem = !isChunk || index == 0
+
+	if parts[2] == velerov1api.NamespaceScopedDir && len(parts) >= 5 {
+		return parts[3], isItem
+	}
+
+	return "", isItem
+}
+
+// tarballWriter writes a gzipped tarball.
+type tarballWriter struct {
+	gzw *gzip.Writer
+	tw  *tar.Writer
+}
+
+func newTarballWriter(w io.Writer) *tarballWriter {
+	gzw := gzip.NewWriter(w)
+	return &tarballWriter{gzw: gzw, tw: tar.NewWriter(gzw)}
+}
+
+func (t *tarballWriter) copyFile(hdr *tar.Header, r io.Reader) error {
+	if err := t.tw.WriteHeader(hdr); err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.Copy(t.tw, r); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (t *tarballWriter) close() error {
+	if err := t.tw.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(t.gzw.Close())
+}
+
+// Split splits a backup tarball into a main tarball, which is written to main,
+// and a tarball for each namespace that has items, which is written to the
+// writer returned by newNamespaceWriter for it. It returns the index of the
+// split archive.
+func Split(src io.Reader, main io.Writer, newNamespaceWriter func(namespace string) (io.Writer, error)) (*Index, error) {
+	gzr, err := gzip.NewReader(src)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	index := &Index{Version: SplitFormatVersion}
+	mainTarball := newTarballWriter(main)
+	namespaceTarballs := make(map[string]*tarballWriter)
+	namespaceItems := make(map[string]int)
+
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		namespace, isItem := itemNamespace(hdr.Name)
+		if namespace == "" {
+			if isItem {
+				index.ClusterItems++
+			}
+			if err := mainTarball.copyFile(hdr, tr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		nsTarball, ok := namespaceTarballs[namespace]
+		if !ok {
+			w, err := newNamespaceWriter(namespace)
+			if err != nil {
+				return nil, err
+			}
+			nsTarball = newTarballWriter(w)
+			namespaceTarballs[namespace] = nsTarball
+		}
+
+		if isItem {
+			namespaceItems[namespace]++
+		}
+		if err := nsTarball.copyFile(hdr, tr); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mainTarball.close(); err != nil {
+		return nil, err
+	}
+
+	for namespace, nsTarball := range namespaceTarballs {
+		if err := nsTarball.close(); err != nil {
+			return nil, err
+		}
+		index.Namespaces = append(index.Namespaces, IndexNamespace{Name: namespace, Items: namespaceItems[namespace]})
+	}
+
+	sort.Slice(index.Namespaces, func(i, j int) bool {
+		return index.Namespaces[i].Name < index.Namespaces[j].Name
+	})
+
+	return index, nil
+}
+
+// Merge merges the files of gzipped tarballs, in order, into one gzipped
+// tarball, which is written to dst.
+func Merge(dst io.Writer, srcs ...io.Reader) error {
+	merged := newTarballWriter(dst)
+
+	for _, src := range srcs {
+		gzr, err := gzip.NewReader(src)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		tr := tar.NewReader(gzr)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				gzr.Close()
+				return errors.WithStack(err)
+			}
+
+			if err := merged.copyFile(hdr, tr); err != nil {
+				gzr.Close()
+				return err
+			}
+		}
+
+		if err := gzr.Close(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return merged.close()
+}
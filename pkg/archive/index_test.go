@@ -0,0 +1,92 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tarballFiles(t *testing.T, r io.Reader) []tarFile {
+	gzr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+	defer gzr.Close()
+
+	var files []tarFile
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		files = append(files, tarFile{name: hdr.Name, contents: string(data)})
+	}
+
+	return files
+}
+
+func TestSplitAndMerge(t *testing.T) {
+	files := []tarFile{
+		{name: "metadata/version", contents: "2\n"},
+		{name: "resources/persistentvolumes/cluster/pv-1.json", contents: "pv-1"},
+		{name: "resources/pods/namespaces/ns-1/pod-1.json", contents: "pod-1"},
+		{name: "resources/pods/namespaces/ns-2/pod-2.json", contents: "pod-2"},
+		{name: "resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0000", contents: "big-"},
+		{name: "resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0001", contents: "configmap"},
+		{name: "resources/namespaces/cluster/ns-1.json", contents: "ns-1"},
+	}
+
+	main := new(bytes.Buffer)
+	namespaces := make(map[string]*bytes.Buffer)
+
+	index, err := Split(newTarball(t, files...), main, func(namespace string) (io.Writer, error) {
+		namespaces[namespace] = new(bytes.Buffer)
+		return namespaces[namespace], nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, &Index{
+		Version:      SplitFormatVersion,
+		ClusterItems: 2,
+		Namespaces: []IndexNamespace{
+			{Name: "ns-1", Items: 2},
+			{Name: "ns-2", Items: 1},
+		},
+	}, index)
+	assert.True(t, index.HasNamespace("ns-1"))
+	assert.False(t, index.HasNamespace("ns-3"))
+
+	assert.Equal(t, []tarFile{files[0], files[1], files[6]}, tarballFiles(t, bytes.NewReader(main.Bytes())))
+	assert.Equal(t, []tarFile{files[2], files[4], files[5]}, tarballFiles(t, bytes.NewReader(namespaces["ns-1"].Bytes())))
+	assert.Equal(t, []tarFile{files[3]}, tarballFiles(t, bytes.NewReader(namespaces["ns-2"].Bytes())))
+
+	merged := new(bytes.Buffer)
+	require.NoError(t, Merge(merged, main, namespaces["ns-1"]))
+
+	assert.Equal(t, []tarFile{files[0], files[1], files[6], files[2], files[4], files[5]}, tarballFiles(t, merged))
+}
@@ -20,6 +20,7 @@ import (
 	"archive/tar"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -30,18 +31,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 // BackupVersion is the current backup version for Velero.
-const BackupVersion = 1
+const BackupVersion = 2
 
 // Backupper performs backups.
 type Backupper interface {
@@ -58,11 +62,31 @@ type kubernetesBackupper struct {
 	groupBackupperFactory  groupBackupperFactory
 	resticBackupperFactory restic.BackupperFactory
 	resticTimeout          time.Duration
+	diagnosticsGetter      DiagnosticsGetter
 }
 
 type resolvedAction struct {
 	velero.BackupItemAction
+	actionSelector
+}
+
+// resolvedActionV2 is a backup item action that can start asynchronous
+// operations, with the items it applies to resolved.
+type resolvedActionV2 struct {
+	velero.BackupItemActionV2
+	actionSelector
+}
 
+// resolvedItemTransformer is an item transformer, with the items it applies
+// to resolved.
+type resolvedItemTransformer struct {
+	velero.ItemTransformer
+	actionSelector
+}
+
+// actionSelector is a backup item action's ResourceSelector, resolved
+// against the cluster's resources.
+type actionSelector struct {
 	resourceIncludesExcludes  *collections.IncludesExcludes
 	namespaceIncludesExcludes *collections.IncludesExcludes
 	selector                  labels.Selector
@@ -89,6 +113,7 @@ func NewKubernetesBackupper(
 	podCommandExecutor podexec.PodCommandExecutor,
 	resticBackupperFactory restic.BackupperFactory,
 	resticTimeout time.Duration,
+	diagnosticsGetter DiagnosticsGetter,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
 		discoveryHelper:        discoveryHelper,
@@ -97,6 +122,7 @@ func NewKubernetesBackupper(
 		groupBackupperFactory:  &defaultGroupBackupperFactory{},
 		resticBackupperFactory: resticBackupperFactory,
 		resticTimeout:          resticTimeout,
+		diagnosticsGetter:      diagnosticsGetter,
 	}, nil
 }
 
@@ -109,29 +135,76 @@ func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper)
 			return nil, err
 		}
 
-		resources := getResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
-		namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
+		selector, err := resolveActionSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, err
+		}
 
-		selector := labels.Everything()
-		if resourceSelector.LabelSelector != "" {
-			if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
-				return nil, err
-			}
+		resolved = append(resolved, resolvedAction{BackupItemAction: action, actionSelector: selector})
+	}
+
+	return resolved, nil
+}
+
+func resolveActionsV2(actions []velero.BackupItemActionV2, helper discovery.Helper) ([]resolvedActionV2, error) {
+	var resolved []resolvedActionV2
+
+	for _, action := range actions {
+		resourceSelector, err := action.AppliesTo()
+		if err != nil {
+			return nil, err
+		}
+
+		selector, err := resolveActionSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = append(resolved, resolvedActionV2{BackupItemActionV2: action, actionSelector: selector})
+	}
+
+	return resolved, nil
+}
+
+func resolveItemTransformers(transformers []velero.ItemTransformer, helper discovery.Helper) ([]resolvedItemTransformer, error) {
+	var resolved []resolvedItemTransformer
+
+	for _, transformer := range transformers {
+		resourceSelector, err := transformer.AppliesTo()
+		if err != nil {
+			return nil, err
 		}
 
-		res := resolvedAction{
-			BackupItemAction:          action,
-			resourceIncludesExcludes:  resources,
-			namespaceIncludesExcludes: namespaces,
-			selector:                  selector,
+		selector, err := resolveActionSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, err
 		}
 
-		resolved = append(resolved, res)
+		resolved = append(resolved, resolvedItemTransformer{ItemTransformer: transformer, actionSelector: selector})
 	}
 
 	return resolved, nil
 }
 
+func resolveActionSelector(resourceSelector velero.ResourceSelector, helper discovery.Helper) (actionSelector, error) {
+	resources := getResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
+	namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
+
+	selector := labels.Everything()
+	if resourceSelector.LabelSelector != "" {
+		var err error
+		if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
+			return actionSelector{}, err
+		}
+	}
+
+	return actionSelector{
+		resourceIncludesExcludes:  resources,
+		namespaceIncludesExcludes: namespaces,
+		selector:                  selector,
+	}, nil
+}
+
 // getResourceIncludesExcludes takes the lists of resources to include and exclude, uses the
 // discovery helper to resolve them to fully-qualified group-resource names, and returns an
 // IncludesExcludes list.
@@ -139,20 +212,27 @@ func getResourceIncludesExcludes(helper discovery.Helper, includes, excludes []s
 	resources := collections.GenerateIncludesExcludes(
 		includes,
 		excludes,
-		func(item string) string {
-			gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
-			if err != nil {
-				return ""
-			}
-
-			gr := gvr.GroupResource()
-			return gr.String()
-		},
+		groupResourceResolver(helper),
 	)
 
 	return resources
 }
 
+// groupResourceResolver returns a function that uses the discovery helper to
+// resolve a resource name to its fully-qualified group-resource name, or to ""
+// if it isn't a known resource.
+func groupResourceResolver(helper discovery.Helper) func(string) string {
+	return func(item string) string {
+		gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
+		if err != nil {
+			return ""
+		}
+
+		gr := gvr.GroupResource()
+		return gr.String()
+	}
+}
+
 // getNamespaceIncludesExcludes returns an IncludesExcludes list containing which namespaces to
 // include and exclude from the backup.
 func getNamespaceIncludesExcludes(backup *api.Backup) *collections.IncludesExcludes {
@@ -204,7 +284,9 @@ type VolumeSnapshotterGetter interface {
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
 // to the backup log.
 func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
-	gzippedData := gzip.NewWriter(backupFile)
+	backupRequest.limits = newBackupLimits(backupRequest.Spec)
+
+	gzippedData := gzip.NewWriter(backupRequest.limits.writer(backupFile))
 	defer gzippedData.Close()
 
 	tw := tar.NewWriter(gzippedData)
@@ -215,14 +297,43 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		return errors.WithStack(err)
 	}
 
+	log.Info("Writing API resources file")
+	if err := kb.writeAPIResources(tw); err != nil {
+		return err
+	}
+
 	backupRequest.NamespaceIncludesExcludes = getNamespaceIncludesExcludes(backupRequest.Backup)
+	if selector := backupRequest.Spec.NamespaceSelector; selector != nil {
+		selected, err := kb.selectNamespaces(selector)
+		if err != nil {
+			return err
+		}
+		log.Infof("Namespaces selected by namespace selector %s: %v", metav1.FormatLabelSelector(selector), selected)
+
+		included := includeSelectedNamespaces(backupRequest.Spec.IncludedNamespaces, selected)
+		if len(included) == 0 {
+			return errors.Errorf("no namespaces match namespace selector %s", metav1.FormatLabelSelector(selector))
+		}
+		backupRequest.NamespaceIncludesExcludes = collections.NewIncludesExcludes().Includes(included...).Excludes(backupRequest.Spec.ExcludedNamespaces...)
+	}
 	log.Infof("Including namespaces: %s", backupRequest.NamespaceIncludesExcludes.IncludesString())
 	log.Infof("Excluding namespaces: %s", backupRequest.NamespaceIncludesExcludes.ExcludesString())
 
-	backupRequest.ResourceIncludesExcludes = getResourceIncludesExcludes(kb.discoveryHelper, backupRequest.Spec.IncludedResources, backupRequest.Spec.ExcludedResources)
+	// if the backup names items without including resources explicitly,
+	// only the resources of the named items are included.
+	includedResources := backupRequest.Spec.IncludedResources
+	if len(includedResources) == 0 && len(backupRequest.Spec.IncludedResourceNames) > 0 {
+		includedResources = namedResources(backupRequest.Spec.IncludedResourceNames)
+	}
+
+	backupRequest.ResourceIncludesExcludes = getResourceIncludesExcludes(kb.discoveryHelper, includedResources, backupRequest.Spec.ExcludedResources)
 	log.Infof("Including resources: %s", backupRequest.ResourceIncludesExcludes.IncludesString())
 	log.Infof("Excluding resources: %s", backupRequest.ResourceIncludesExcludes.ExcludesString())
 
+	backupRequest.ReplicaPolicies = kubeutil.NewReplicaPolicies(backupRequest.Spec.ReplicaPolicies, groupResourceResolver(kb.discoveryHelper))
+	backupRequest.ResourceOrders = getResourceOrders(log, backupRequest.Spec.OrderedResources, groupResourceResolver(kb.discoveryHelper))
+	backupRequest.ResourceNames = getResourceNames(log, backupRequest.Spec.IncludedResourceNames, groupResourceResolver(kb.discoveryHelper))
+
 	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
 	if err != nil {
@@ -234,7 +345,26 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		return err
 	}
 
+	backupRequest.ResolvedActionsV2, err = resolveActionsV2(backupRequest.ActionsV2, kb.discoveryHelper)
+	if err != nil {
+		return err
+	}
+
+	backupRequest.ResolvedItemTransformers, err = resolveItemTransformers(backupRequest.ItemTransformers, kb.discoveryHelper)
+	if err != nil {
+		return err
+	}
+
 	backupRequest.BackedUpItems = map[itemKey]struct{}{}
+	backupRequest.CSISnapshots = map[string]string{}
+	if backupRequest.ItemTimings == nil {
+		backupRequest.ItemTimings = NewItemTimings(clock.RealClock{})
+	}
+
+	backupRequest.SnapshotExclusions, err = newSnapshotExclusions(backupRequest.Spec)
+	if err != nil {
+		return err
+	}
 
 	podVolumeTimeout := kb.resticTimeout
 	if val := backupRequest.Annotations[api.PodVolumeOperationTimeoutAnnotation]; val != "" {
@@ -274,6 +404,20 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		if err := gb.backupGroup(group); err != nil {
 			log.WithError(err).WithField("apiGroup", group.String()).Error("Error backing up API group")
 		}
+		if err := backupRequest.limits.exceeded(); err != nil {
+			return err
+		}
+	}
+
+	if backupRequest.Spec.IncludeEventsAndPodLogs {
+		if kb.diagnosticsGetter == nil {
+			log.Warn("Unable to include events and pod logs in the backup: no diagnostics getter is configured")
+		} else {
+			log.Info("Writing events and pod logs")
+			if err := writeDiagnostics(log, kb.diagnosticsGetter, backupRequest, tw); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -299,6 +443,38 @@ func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
 	return nil
 }
 
+// writeAPIResources writes the API groups and resources that the cluster
+// serves to the backup, so that the versions and kinds that its items were
+// backed up as can be resolved without the cluster.
+func (kb *kubernetesBackupper) writeAPIResources(tw *tar.Writer) error {
+	resources := archive.APIResources{
+		Groups: kb.discoveryHelper.APIGroups(),
+	}
+	for _, list := range kb.discoveryHelper.Resources() {
+		resources.Resources = append(resources.Resources, *list)
+	}
+
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return errors.Wrap(err, "error marshalling API resources")
+	}
+
+	hdr := &tar.Header{
+		Name:     filepath.Join(api.MetadataDir, archive.APIResourcesFile),
+		Size:     int64(len(data)),
+		Typeflag: tar.TypeReg,
+		Mode:     0755,
+		ModTime:  time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
 type tarWriter interface {
 	io.Closer
 	Write([]byte) (int, error)
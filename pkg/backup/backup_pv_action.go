@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -54,6 +55,25 @@ func (a *PVCAction) Execute(item runtime.Unstructured, backup *v1.Backup) (runti
 	}
 
 	if pvc.Status.Phase != corev1api.ClaimBound || pvc.Spec.VolumeName == "" {
+		phase := pvc.Status.Phase
+		if phase == "" || phase == corev1api.ClaimBound {
+			phase = corev1api.ClaimPending
+		}
+		a.log.Infof("PersistentVolumeClaim %s/%s is %s, so it has no persistent volume to back up", pvc.Namespace, pvc.Name, phase)
+
+		// record the claim's binding state, so that it's restored unbound
+		// rather than waiting for a volume that isn't in the backup.
+		metadata, err := meta.Accessor(item)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		annotations := metadata.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[v1.PVCPhaseAnnotation] = string(phase)
+		metadata.SetAnnotations(annotations)
+
 		return item, nil, nil
 	}
 
@@ -58,9 +58,10 @@ func TestBackupPVAction(t *testing.T) {
 	// non-empty spec.volumeName when status.phase is empty
 	// should result in no error and no additional items
 	pvc.Object["spec"].(map[string]interface{})["volumeName"] = "myVolume"
-	_, additional, err = a.Execute(pvc, backup)
+	updated, additional, err := a.Execute(pvc, backup)
 	require.NoError(t, err)
 	require.Len(t, additional, 0)
+	assert.Equal(t, "Pending", updated.(*unstructured.Unstructured).GetAnnotations()[v1.PVCPhaseAnnotation])
 
 	// non-empty spec.volumeName when status.phase is 'Pending'
 	// should result in no error and no additional items
@@ -72,9 +73,10 @@ func TestBackupPVAction(t *testing.T) {
 	// non-empty spec.volumeName when status.phase is 'Lost'
 	// should result in no error and no additional items
 	pvc.Object["status"].(map[string]interface{})["phase"] = corev1api.ClaimLost
-	_, additional, err = a.Execute(pvc, backup)
+	updated, additional, err = a.Execute(pvc, backup)
 	require.NoError(t, err)
 	require.Len(t, additional, 0)
+	assert.Equal(t, "Lost", updated.(*unstructured.Unstructured).GetAnnotations()[v1.PVCPhaseAnnotation])
 
 	// non-empty spec.volumeName when status.phase is 'Bound'
 	// should result in no error and one additional item for the PV
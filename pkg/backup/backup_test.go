@@ -39,11 +39,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
 
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
@@ -100,7 +102,199 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 		expectedFiles = append(expectedFiles, file)
 	}
 
-	assertTarballContents(t, backupFile, append(expectedFiles, "metadata/version")...)
+	assertTarballContents(t, backupFile, append(expectedFiles, "metadata/version", "metadata/api-resources.json")...)
+}
+
+// TestBackupSearchIndex verifies that a search index entry is built for each
+// item in the tarball if the backup's search index flag is set, and not
+// otherwise.
+func TestBackupSearchIndex(t *testing.T) {
+	tests := []struct {
+		name            string
+		backup          *velerov1.Backup
+		expectedEntries []SearchIndexEntry
+	}{
+		{
+			name:   "search index is not built by default",
+			backup: defaultBackup().Result(),
+		},
+		{
+			name:   "search index is built when enabled",
+			backup: defaultBackup().SearchIndex(true).Result(),
+			expectedEntries: []SearchIndexEntry{
+				{Resource: "persistentvolumes", Kind: "PersistentVolume", Name: "bar", Path: "resources/persistentvolumes/cluster/bar.json"},
+				{Resource: "pods", Kind: "Pod", Namespace: "foo", Name: "bar", Labels: map[string]string{"app": "db"}, Path: "resources/pods/namespaces/foo/bar.json"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			req := &Request{Backup: tc.backup}
+			backupFile := bytes.NewBuffer([]byte{})
+
+			h.addItems(t, test.Pods(builder.ForPod("foo", "bar").ObjectMeta(builder.WithLabels("app", "db")).Result()))
+			h.addItems(t, test.PVs(builder.ForPersistentVolume("bar").Result()))
+
+			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+
+			sort.Slice(req.SearchIndex, func(i, j int) bool { return req.SearchIndex[i].Path < req.SearchIndex[j].Path })
+			assert.Equal(t, tc.expectedEntries, req.SearchIndex)
+		})
+	}
+}
+
+// fakeDiagnosticsGetter is a DiagnosticsGetter that returns canned events and
+// pod logs.
+type fakeDiagnosticsGetter struct {
+	events map[string][]corev1.Event
+	logs   map[string]map[string][]byte
+}
+
+func (g *fakeDiagnosticsGetter) ListEvents(namespace string) ([]corev1.Event, error) {
+	return g.events[namespace], nil
+}
+
+func (g *fakeDiagnosticsGetter) GetPodLogs(namespace, name string) (map[string][]byte, error) {
+	logs, ok := g.logs[namespace+"/"+name]
+	if !ok {
+		return nil, errors.New("pod not found")
+	}
+	return logs, nil
+}
+
+// TestBackupWithEventsAndPodLogs verifies that the events of the backed up
+// namespaces and the logs of the backed up pods are written to the tarball's
+// diagnostics directory if the backup's events and pod logs flag is set, and
+// not otherwise.
+func TestBackupWithEventsAndPodLogs(t *testing.T) {
+	tests := []struct {
+		name   string
+		backup *velerov1.Backup
+		want   []string
+	}{
+		{
+			name:   "events and pod logs are not included by default",
+			backup: defaultBackup().Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+				"resources/persistentvolumes/cluster/pv-1.json",
+			},
+		},
+		{
+			name:   "events and logs of backed up namespaces and pods are included when enabled",
+			backup: defaultBackup().IncludedNamespaces("ns-1").IncludeEventsAndPodLogs(true).Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"diagnostics/namespaces/ns-1/events.json",
+				"diagnostics/namespaces/ns-1/pods/pod-1/app.log",
+				"diagnostics/namespaces/ns-1/pods/pod-1/sidecar.log",
+			},
+		},
+		{
+			name:   "pods whose logs can't be got are skipped",
+			backup: defaultBackup().IncludedNamespaces("ns-2").IncludeEventsAndPodLogs(true).Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-2/pod-2.json",
+				"diagnostics/namespaces/ns-2/events.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.backupper.diagnosticsGetter = &fakeDiagnosticsGetter{
+				events: map[string][]corev1.Event{
+					"ns-1": {{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-1.1"}, Reason: "Started"}},
+				},
+				logs: map[string]map[string][]byte{
+					"ns-1/pod-1": {"app": []byte("starting\n"), "sidecar": []byte("ready\n")},
+				},
+			}
+			req := &Request{Backup: tc.backup}
+			backupFile := bytes.NewBuffer([]byte{})
+
+			h.addItems(t, test.Pods(
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-2", "pod-2").Result(),
+			))
+			h.addItems(t, test.PVs(builder.ForPersistentVolume("pv-1").Result()))
+
+			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version", "metadata/api-resources.json")...)
+		})
+	}
+}
+
+// TestBackupLimits verifies that a backup is aborted with an error once it
+// would exceed its maximum number of items or tarball size.
+func TestBackupLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		backup  *velerov1.Backup
+		want    []string
+		wantErr string
+	}{
+		{
+			name:   "a backup within its limits isn't aborted",
+			backup: defaultBackup().MaxItems(3).MaxBytes(1 << 20).Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-1/pod-2.json",
+				"resources/persistentvolumes/cluster/pv-1.json",
+			},
+		},
+		{
+			name:   "a max items of 0 means no limit",
+			backup: defaultBackup().MaxItems(0).Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-1/pod-2.json",
+				"resources/persistentvolumes/cluster/pv-1.json",
+			},
+		},
+		{
+			name:   "a backup that would exceed its max items is aborted",
+			backup: defaultBackup().MaxItems(2).Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-1/pod-2.json",
+			},
+			wantErr: "backup aborted because it exceeded its limit of 2 items",
+		},
+		{
+			name:    "a backup whose tarball exceeds its max bytes is aborted",
+			backup:  defaultBackup().MaxBytes(1).Result(),
+			wantErr: "backup aborted because its tarball exceeded its limit of 1 bytes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			req := &Request{Backup: tc.backup}
+			backupFile := bytes.NewBuffer([]byte{})
+
+			h.addItems(t, test.Pods(
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			))
+			h.addItems(t, test.PVs(builder.ForPersistentVolume("pv-1").Result()))
+
+			err := h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version", "metadata/api-resources.json")...)
+		})
+	}
 }
 
 // TestBackupResourceFiltering runs backups with different combinations
@@ -591,7 +785,7 @@ func TestBackupResourceFiltering(t *testing.T) {
 
 			h.backupper.Backup(h.log, req, backupFile, nil, nil)
 
-			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version", "metadata/api-resources.json")...)
 		})
 	}
 }
@@ -655,7 +849,7 @@ func TestBackupResourceCohabitation(t *testing.T) {
 
 			h.backupper.Backup(h.log, req, backupFile, nil, nil)
 
-			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version", "metadata/api-resources.json")...)
 		})
 	}
 }
@@ -678,7 +872,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 
 	h.backupper.Backup(h.log, backup1, backup1File, nil, nil)
 
-	assertTarballContents(t, backup1File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json")
+	assertTarballContents(t, backup1File, "metadata/version", "metadata/api-resources.json", "resources/deployments.apps/namespaces/ns-1/deploy-1.json")
 
 	// run and verify backup 2
 	backup2 := &Request{
@@ -688,7 +882,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 
 	h.backupper.Backup(h.log, backup2, backup2File, nil, nil)
 
-	assertTarballContents(t, backup2File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json")
+	assertTarballContents(t, backup2File, "metadata/version", "metadata/api-resources.json", "resources/deployments.apps/namespaces/ns-1/deploy-1.json")
 }
 
 // TestBackupResourceOrdering runs backups of the core API group and ensures that items are backed
@@ -745,6 +939,234 @@ func TestBackupResourceOrdering(t *testing.T) {
 	}
 }
 
+// TestBackupOrderedResources runs backups with spec.orderedResources, and
+// verifies that the listed items of each resource are backed up first, in
+// order, followed by the resource's other items.
+func TestBackupOrderedResources(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		want         []string
+	}{
+		{
+			name:   "items are backed up in the order they're listed in, across namespaces",
+			backup: defaultBackup().OrderedResources(map[string]string{"pods": "ns-2/db-0, ns-1/db-1"}).Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "db-0").Result(),
+					builder.ForPod("ns-1", "db-1").Result(),
+					builder.ForPod("ns-2", "db-0").Result(),
+					builder.ForPod("ns-2", "web").Result(),
+				),
+			},
+			want: []string{"ns-2/db-0", "ns-1/db-1", "ns-1/db-0", "ns-2/web"},
+		},
+		{
+			name:   "items of included namespaces that are listed separately are ordered across them",
+			backup: defaultBackup().IncludedNamespaces("ns-1", "ns-2").OrderedResources(map[string]string{"pods": "ns-2/db-0"}).Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "db-0").Result(),
+					builder.ForPod("ns-2", "db-0").Result(),
+				),
+			},
+			want: []string{"ns-2/db-0", "ns-1/db-0"},
+		},
+		{
+			name:   "resources can be fully-qualified, and missing items are ignored",
+			backup: defaultBackup().OrderedResources(map[string]string{"deployments.apps": "ns-1/deploy-3,ns-1/missing,ns-1/deploy-2"}).Result(),
+			apiResources: []*test.APIResource{
+				test.Deployments(
+					builder.ForDeployment("ns-1", "deploy-1").Result(),
+					builder.ForDeployment("ns-1", "deploy-2").Result(),
+					builder.ForDeployment("ns-1", "deploy-3").Result(),
+				),
+			},
+			want: []string{"ns-1/deploy-3", "ns-1/deploy-2", "ns-1/deploy-1"},
+		},
+		{
+			name:   "cluster-scoped items are listed by name",
+			backup: defaultBackup().OrderedResources(map[string]string{"persistentvolumes": "pv-2"}).Result(),
+			apiResources: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				),
+			},
+			want: []string{"pv-2", "pv-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+				action     = new(recordResourcesAction).ForResource("pods").ForResource("deployments.apps").ForResource("persistentvolumes")
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, []velero.BackupItemAction{action}, nil))
+
+			assert.Equal(t, tc.want, action.ids)
+		})
+	}
+}
+
+// TestBackupIncludedResourceNames verifies that only the named items of
+// resources in a backup's included resource names are backed up, and that
+// only those resources are backed up if it doesn't include resources.
+func TestBackupIncludedResourceNames(t *testing.T) {
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("ns-1", "db-0").Result(),
+			builder.ForPod("ns-1", "db-1").Result(),
+			builder.ForPod("ns-1", "web").ObjectMeta(builder.WithLabels("app", "web")).Result(),
+			builder.ForPod("ns-2", "db-0").Result(),
+		),
+		test.Secrets(
+			builder.ForSecret("ns-1", "creds").Result(),
+			builder.ForSecret("ns-1", "other").Result(),
+		),
+		test.PVs(
+			builder.ForPersistentVolume("pv-1").Result(),
+			builder.ForPersistentVolume("pv-2").Result(),
+		),
+	}
+
+	tests := []struct {
+		name   string
+		backup *velerov1.Backup
+		want   []string
+	}{
+		{
+			name:   "only named items of the named resources are backed up",
+			backup: defaultBackup().IncludedResourceNames(map[string][]string{"secrets": {"ns-1/creds"}}).Result(),
+			want: []string{
+				"resources/secrets/namespaces/ns-1/creds.json",
+			},
+		},
+		{
+			name:   "names can contain wildcards, and missing items are ignored",
+			backup: defaultBackup().IncludedResourceNames(map[string][]string{"pods": {"*/db-*"}, "persistentvolumes": {"pv-2", "pv-3"}}).Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-1/db-0.json",
+				"resources/pods/namespaces/ns-1/db-1.json",
+				"resources/pods/namespaces/ns-2/db-0.json",
+				"resources/persistentvolumes/cluster/pv-2.json",
+			},
+		},
+		{
+			name:   "other included resources aren't limited",
+			backup: defaultBackup().IncludedResources("pods", "secrets").IncludedResourceNames(map[string][]string{"pods": {"ns-1/web"}}).Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-1/web.json",
+				"resources/secrets/namespaces/ns-1/creds.json",
+				"resources/secrets/namespaces/ns-1/other.json",
+			},
+		},
+		{
+			name:   "named items must match the label selector and included namespaces",
+			backup: defaultBackup().IncludedNamespaces("ns-1").LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}).IncludedResourceNames(map[string][]string{"pods": {"ns-1/web", "ns-1/db-0", "ns-2/db-0"}}).Result(),
+			want: []string{
+				"resources/pods/namespaces/ns-1/web.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			req := &Request{Backup: tc.backup}
+			backupFile := bytes.NewBuffer([]byte{})
+
+			for _, resource := range apiResources {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version", "metadata/api-resources.json")...)
+		})
+	}
+}
+
+func TestBackupNamespaceSelector(t *testing.T) {
+	apiResources := []*test.APIResource{
+		test.Namespaces(
+			builder.ForNamespace("ns-1").ObjectMeta(builder.WithLabels("backup", "true")).Result(),
+			builder.ForNamespace("ns-2").Result(),
+			builder.ForNamespace("ns-3").ObjectMeta(builder.WithLabels("backup", "true")).Result(),
+		),
+		test.Pods(
+			builder.ForPod("ns-1", "pod-1").Result(),
+			builder.ForPod("ns-2", "pod-1").Result(),
+			builder.ForPod("ns-3", "pod-1").Result(),
+		),
+	}
+
+	backupTrue := &metav1.LabelSelector{MatchLabels: map[string]string{"backup": "true"}}
+
+	tests := []struct {
+		name    string
+		backup  *velerov1.Backup
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "only selected namespaces are backed up",
+			backup: defaultBackup().NamespaceSelector(backupTrue).Result(),
+			want: []string{
+				"resources/namespaces/cluster/ns-1.json",
+				"resources/namespaces/cluster/ns-3.json",
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-3/pod-1.json",
+			},
+		},
+		{
+			name:   "selected namespaces are backed up along with included namespaces",
+			backup: defaultBackup().IncludedNamespaces("ns-2").NamespaceSelector(backupTrue).ExcludedNamespaces("ns-3").Result(),
+			want: []string{
+				"resources/namespaces/cluster/ns-1.json",
+				"resources/namespaces/cluster/ns-2.json",
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-2/pod-1.json",
+			},
+		},
+		{
+			name:    "a selector that matches no namespaces fails the backup",
+			backup:  defaultBackup().NamespaceSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"backup": "never"}}).Result(),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			req := &Request{Backup: tc.backup}
+			backupFile := bytes.NewBuffer([]byte{})
+
+			for _, resource := range apiResources {
+				h.addItems(t, resource)
+			}
+
+			err := h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version", "metadata/api-resources.json")...)
+		})
+	}
+}
+
 // recordResourcesAction is a backup item action that can be configured
 // to run for specific resources/namespaces and simply records the items
 // that it is executed for.
@@ -1177,6 +1599,187 @@ func TestBackupActionModifications(t *testing.T) {
 // additional items to be backed up, and verifies that those items are included in the
 // backup tarball as appropriate. Verification is done by looking at the files that exist
 // in the backup tarball.
+// TestBackupReplicaPolicies runs backups with replica policies, and verifies
+// that the replica counts of the items whose resources they omit aren't
+// captured.
+func TestBackupReplicaPolicies(t *testing.T) {
+	// deployment returns a backed up deployment, whose replica count is a
+	// float64 since it's been decoded from JSON.
+	deployment := func(name string, replicas float64) unstructuredObject {
+		obj := toUnstructuredOrFail(t, builder.ForDeployment("ns-1", name).Result())
+		if replicas > 0 {
+			obj["spec"].(map[string]interface{})["replicas"] = replicas
+		}
+		return obj
+	}
+
+	tests := []struct {
+		name   string
+		backup *velerov1.Backup
+		want   map[string]unstructuredObject
+	}{
+		{
+			name:   "no policies keeps replica counts",
+			backup: defaultBackup().Result(),
+			want: map[string]unstructuredObject{
+				"resources/deployments.apps/namespaces/ns-1/deploy-1.json": deployment("deploy-1", 3),
+				"resources/deployments.apps/namespaces/ns-1/deploy-2.json": deployment("deploy-2", 5),
+			},
+		},
+		{
+			name: "omit policy removes replica counts",
+			backup: defaultBackup().ReplicaPolicies(
+				velerov1.ResourceReplicaPolicy{Resources: []string{"deployments"}, Policy: velerov1.ReplicaPolicyOmit},
+			).Result(),
+			want: map[string]unstructuredObject{
+				"resources/deployments.apps/namespaces/ns-1/deploy-1.json": deployment("deploy-1", 0),
+				"resources/deployments.apps/namespaces/ns-1/deploy-2.json": deployment("deploy-2", 0),
+			},
+		},
+		{
+			name: "first policy that includes a resource applies to it",
+			backup: defaultBackup().ReplicaPolicies(
+				velerov1.ResourceReplicaPolicy{Resources: []string{"deployments.apps"}, Policy: velerov1.ReplicaPolicyKeep},
+				velerov1.ResourceReplicaPolicy{Resources: []string{"*"}, Policy: velerov1.ReplicaPolicyOmit},
+			).Result(),
+			want: map[string]unstructuredObject{
+				"resources/deployments.apps/namespaces/ns-1/deploy-1.json": deployment("deploy-1", 3),
+				"resources/deployments.apps/namespaces/ns-1/deploy-2.json": deployment("deploy-2", 5),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			h.addItems(t, test.Deployments(
+				builder.ForDeployment("ns-1", "deploy-1").Replicas(3).Result(),
+				builder.ForDeployment("ns-1", "deploy-2").Replicas(5).Result(),
+			))
+
+			err := h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			assert.NoError(t, err)
+
+			assertTarballFileContents(t, backupFile, tc.want)
+		})
+	}
+}
+
+func TestBackupCSISnapshots(t *testing.T) {
+	features.NewFeatureFlagSet(velerov1.CSIFeatureFlag)
+	defer features.NewFeatureFlagSet()
+
+	snapshotClass := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion":     "snapshot.storage.k8s.io/v1beta1",
+		"kind":           "VolumeSnapshotClass",
+		"metadata":       map[string]interface{}{"name": "csi-snapclass"},
+		"driver":         "csi.example.com",
+		"deletionPolicy": "Delete",
+	}}
+	otherSnapshotClass := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion":     "snapshot.storage.k8s.io/v1beta1",
+		"kind":           "VolumeSnapshotClass",
+		"metadata":       map[string]interface{}{"name": "other-snapclass"},
+		"driver":         "other.csi.example.com",
+		"deletionPolicy": "Delete",
+	}}
+	content := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+		"kind":       "VolumeSnapshotContent",
+		"metadata":   map[string]interface{}{"name": "snapcontent-1"},
+		"spec":       map[string]interface{}{"driver": "csi.example.com"},
+		"status":     map[string]interface{}{"snapshotHandle": "snap-handle-1", "readyToUse": true},
+	}}
+
+	h := newHarness(t)
+
+	// there's no snapshot controller in the fake API server, so volume
+	// snapshots are named, and are ready to use, as soon as they're created.
+	h.DynamicClient.PrependReactor("create", "volumesnapshots", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		snapshot := action.(kubetesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		snapshot.SetName(snapshot.GetGenerateName() + "abcde")
+		snapshot.Object["status"] = map[string]interface{}{
+			"readyToUse":                     true,
+			"boundVolumeSnapshotContentName": "snapcontent-1",
+		}
+		return false, nil, nil
+	})
+
+	h.addItems(t, test.PVCs(
+		builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+		builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+	))
+	h.addItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").CSI("csi.example.com", "vol-1").Result(),
+		builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").AWSEBSVolumeID("vol-2").Result(),
+	))
+	h.addItems(t, test.VolumeSnapshotClasses(snapshotClass, otherSnapshotClass))
+	h.addItems(t, test.VolumeSnapshotContents(content))
+	h.addItems(t, test.VolumeSnapshots())
+
+	req := &Request{Backup: defaultBackup().Result()}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	assert.Equal(t, map[string]string{"pv-1": "ns-1/velero-pvc-1-abcde"}, req.CSISnapshots)
+
+	assertTarballContents(t, bytes.NewReader(backupFile.Bytes()),
+		"metadata/api-resources.json",
+		"metadata/version",
+		"resources/persistentvolumeclaims/namespaces/ns-1/pvc-1.json",
+		"resources/persistentvolumeclaims/namespaces/ns-1/pvc-2.json",
+		"resources/persistentvolumes/cluster/pv-1.json",
+		"resources/persistentvolumes/cluster/pv-2.json",
+		"resources/volumesnapshotclasses.snapshot.storage.k8s.io/cluster/csi-snapclass.json",
+		"resources/volumesnapshotclasses.snapshot.storage.k8s.io/cluster/other-snapclass.json",
+		"resources/volumesnapshotcontents.snapshot.storage.k8s.io/cluster/snapcontent-1.json",
+		"resources/volumesnapshots.snapshot.storage.k8s.io/namespaces/ns-1/velero-pvc-1-abcde.json",
+	)
+
+	assertTarballFileContents(t, bytes.NewReader(backupFile.Bytes()), map[string]unstructuredObject{
+		"resources/persistentvolumeclaims/namespaces/ns-1/pvc-1.json": toUnstructuredOrFail(t, builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+			ObjectMeta(builder.WithAnnotations(velerov1.VolumeSnapshotNameAnnotation, "velero-pvc-1-abcde")).
+			VolumeName("pv-1").
+			Result()),
+		"resources/persistentvolumeclaims/namespaces/ns-1/pvc-2.json": toUnstructuredOrFail(t, builder.ForPersistentVolumeClaim("ns-1", "pvc-2").
+			VolumeName("pv-2").
+			Result()),
+		"resources/persistentvolumes/cluster/pv-1.json": toUnstructuredOrFail(t, builder.ForPersistentVolume("pv-1").
+			ObjectMeta(builder.WithAnnotations(velerov1.VolumeSnapshotNameAnnotation, "ns-1/velero-pvc-1-abcde")).
+			ClaimRef("ns-1", "pvc-1").
+			CSI("csi.example.com", "vol-1").
+			Result()),
+		"resources/volumesnapshots.snapshot.storage.k8s.io/namespaces/ns-1/velero-pvc-1-abcde.json": {
+			"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+			"kind":       "VolumeSnapshot",
+			"metadata": map[string]interface{}{
+				"generateName": "velero-pvc-1-",
+				"name":         "velero-pvc-1-abcde",
+				"namespace":    "ns-1",
+				"labels":       map[string]interface{}{velerov1.BackupNameLabel: "backup-1"},
+				"annotations": map[string]interface{}{
+					velerov1.VolumeSnapshotHandleAnnotation: "snap-handle-1",
+					velerov1.CSIDriverNameAnnotation:        "csi.example.com",
+				},
+			},
+			"spec": map[string]interface{}{
+				"volumeSnapshotClassName": "csi-snapclass",
+				"source":                  map[string]interface{}{"persistentVolumeClaimName": "pvc-1"},
+			},
+			"status": map[string]interface{}{
+				"readyToUse":                     true,
+				"boundVolumeSnapshotContentName": "snapcontent-1",
+			},
+		},
+	})
+}
+
 func TestBackupActionAdditionalItems(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1404,11 +2007,261 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
 			assert.NoError(t, err)
 
-			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version", "metadata/api-resources.json")...)
+		})
+	}
+}
+
+// operationAction is a BackupItemActionV2 that starts an operation for each
+// item it's executed on, unless the item has the "sync" label.
+type operationAction struct {
+	selector velero.ResourceSelector
+}
+
+func (a *operationAction) AppliesTo() (velero.ResourceSelector, error) {
+	return a.selector, nil
+}
+
+func (a *operationAction) Execute(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, error) {
+	metadata, err := meta.Accessor(item)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if metadata.GetLabels()["sync"] == "true" {
+		return item, nil, "", nil
+	}
+	return item, nil, "op-" + metadata.GetName(), nil
+}
+
+func (a *operationAction) Progress(operationID string, backup *velerov1.Backup) (velero.OperationProgress, error) {
+	panic("Progress should not be used during backups")
+}
+
+func (a *operationAction) Cancel(operationID string, backup *velerov1.Backup) error {
+	panic("Cancel should not be used during backups")
+}
+
+// TestBackupActionV2Operations runs backups with backup item actions that can
+// start asynchronous operations, and verifies that the operations they start
+// are recorded in the backup's status.
+func TestBackupActionV2Operations(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		actions      []velero.BackupItemActionV2
+		want         []velerov1.PluginOperation
+	}{
+		{
+			name:   "operations are recorded for the items the action applies to",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").Result(),
+				),
+			},
+			actions: []velero.BackupItemActionV2{
+				&operationAction{selector: velero.ResourceSelector{IncludedResources: []string{"pods"}}},
+			},
+			want: []velerov1.PluginOperation{
+				{OperationID: "op-pod-1", Resource: "pods", Namespace: "ns-1", Name: "pod-1", Phase: velerov1.PluginOperationPhaseInProgress},
+				{OperationID: "op-pod-2", Resource: "pods", Namespace: "ns-2", Name: "pod-2", Phase: velerov1.PluginOperationPhaseInProgress},
+			},
+		},
+		{
+			name:   "no operation is recorded when the action returns an empty operation ID",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("sync", "true")).Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				),
+			},
+			actions: []velero.BackupItemActionV2{
+				&operationAction{},
+			},
+			want: []velerov1.PluginOperation{
+				{OperationID: "op-pod-2", Resource: "pods", Namespace: "ns-2", Name: "pod-2", Phase: velerov1.PluginOperationPhaseInProgress},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup, ActionsV2: tc.actions}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			var got []velerov1.PluginOperation
+			for _, operation := range req.Status.PluginOperations {
+				assert.Equal(t, "*backup.operationAction", operation.Plugin)
+				assert.NotNil(t, operation.Created)
+
+				operation.Plugin = ""
+				operation.Created = nil
+				operation.Updated = nil
+				got = append(got, operation)
+			}
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// fakeItemTransformer is an item transformer that calls its transform
+// function on the items that its selector applies to.
+type fakeItemTransformer struct {
+	selector  velero.ResourceSelector
+	transform func(*unstructured.Unstructured)
+}
+
+func (t *fakeItemTransformer) AppliesTo() (velero.ResourceSelector, error) {
+	return t.selector, nil
+}
+
+func (t *fakeItemTransformer) Transform(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, error) {
+	res := item.(*unstructured.Unstructured).DeepCopy()
+	t.transform(res)
+	return res, nil
+}
+
+// TestBackupItemTransformers runs backups with item transformers, and
+// verifies that they're invoked in order after the backup item actions, on
+// the items they apply to.
+func TestBackupItemTransformers(t *testing.T) {
+	setLabel := func(key, value string) func(*unstructured.Unstructured) {
+		return func(item *unstructured.Unstructured) {
+			labels := item.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+			labels[key] = value
+			item.SetLabels(labels)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		apiResources []*test.APIResource
+		actions      []velero.BackupItemAction
+		transformers []velero.ItemTransformer
+		want         map[string]unstructuredObject
+	}{
+		{
+			name: "transformers are invoked in order after the actions",
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+				),
+			},
+			actions: []velero.BackupItemAction{
+				&pluggableAction{
+					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+						res := item.(*unstructured.Unstructured).DeepCopy()
+						setLabel("order", "action")(res)
+						return res, nil, nil
+					},
+				},
+			},
+			transformers: []velero.ItemTransformer{
+				&fakeItemTransformer{transform: func(item *unstructured.Unstructured) {
+					setLabel("order", item.GetLabels()["order"]+"-first")(item)
+				}},
+				&fakeItemTransformer{transform: func(item *unstructured.Unstructured) {
+					setLabel("order", item.GetLabels()["order"]+"-second")(item)
+				}},
+			},
+			want: map[string]unstructuredObject{
+				"resources/pods/namespaces/ns-1/pod-1.json": toUnstructuredOrFail(t, builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("order", "action-first-second")).Result()),
+			},
+		},
+		{
+			name: "transformers are only invoked on the items they apply to",
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				),
+				test.Secrets(
+					builder.ForSecret("ns-1", "secret-1").Result(),
+				),
+			},
+			transformers: []velero.ItemTransformer{
+				&fakeItemTransformer{
+					selector:  velero.ResourceSelector{IncludedResources: []string{"secrets"}},
+					transform: func(item *unstructured.Unstructured) { item.Object["data"] = map[string]interface{}{"key": "bWFza2Vk"} },
+				},
+				&fakeItemTransformer{
+					selector:  velero.ResourceSelector{IncludedNamespaces: []string{"ns-2"}},
+					transform: setLabel("transformed", "true"),
+				},
+			},
+			want: map[string]unstructuredObject{
+				"resources/pods/namespaces/ns-1/pod-1.json":       toUnstructuredOrFail(t, builder.ForPod("ns-1", "pod-1").Result()),
+				"resources/pods/namespaces/ns-2/pod-2.json":       toUnstructuredOrFail(t, builder.ForPod("ns-2", "pod-2").ObjectMeta(builder.WithLabels("transformed", "true")).Result()),
+				"resources/secrets/namespaces/ns-1/secret-1.json": toUnstructuredOrFail(t, builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key": []byte("masked")}).Result()),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: defaultBackup().Result(), ItemTransformers: tc.transformers}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, tc.actions, nil))
+
+			assertTarballFileContents(t, backupFile, tc.want)
 		})
 	}
 }
 
+// TestBackupItemTransformerRenamingItem verifies that items whose kind,
+// namespace or name are changed by an item transformer aren't backed up.
+func TestBackupItemTransformerRenamingItem(t *testing.T) {
+	var (
+		h   = newHarness(t)
+		req = &Request{
+			Backup: defaultBackup().Result(),
+			ItemTransformers: []velero.ItemTransformer{
+				&fakeItemTransformer{
+					selector:  velero.ResourceSelector{LabelSelector: "rename=true"},
+					transform: func(item *unstructured.Unstructured) { item.SetName(item.GetName() + "-renamed") },
+				},
+			},
+		}
+		backupFile = bytes.NewBuffer([]byte{})
+	)
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").Result(),
+		builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("rename", "true")).Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	assertTarballContents(t, backupFile, "metadata/version", "metadata/api-resources.json", "resources/pods/namespaces/ns-1/pod-1.json")
+}
+
 // volumeSnapshotterGetter is a simple implementation of the VolumeSnapshotterGetter
 // interface that returns velero.VolumeSnapshotters from a map if they exist.
 type volumeSnapshotterGetter map[string]velero.VolumeSnapshotter
@@ -1665,6 +2518,60 @@ func TestBackupWithSnapshots(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "persistent volumes claimed in excluded snapshot namespaces are not snapshotted",
+			req: &Request{
+				Backup: defaultBackup().ExcludedSnapshotNamespaces("ns-1").Result(),
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+					newSnapshotLocation("velero", "default", "default"),
+				},
+			},
+			apiResources: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+					builder.ForPersistentVolume("pv-2").ClaimRef("ns-2", "pvc-2").Result(),
+				),
+			},
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).
+					WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+					WithVolume("pv-2", "vol-2", "", "type-2", 100, false),
+			},
+			want: []*volume.Snapshot{
+				{
+					Spec: volume.SnapshotSpec{
+						BackupName:           "backup-1",
+						Location:             "default",
+						PersistentVolumeName: "pv-2",
+						ProviderVolumeID:     "vol-2",
+						VolumeType:           "type-2",
+						VolumeIOPS:           int64Ptr(100),
+					},
+					Status: volume.SnapshotStatus{
+						Phase:              volume.SnapshotPhaseCompleted,
+						ProviderSnapshotID: "vol-2-snapshot",
+					},
+				},
+			},
+		},
+		{
+			name: "persistent volumes matching the excluded snapshot label selector are not snapshotted",
+			req: &Request{
+				Backup: defaultBackup().ExcludedSnapshotLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"snapshot": "false"}}).Result(),
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+					newSnapshotLocation("velero", "default", "default"),
+				},
+			},
+			apiResources: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("snapshot", "false")).Result(),
+				),
+			},
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "", "type-1", 100, false),
+			},
+			want: nil,
+		},
 		{
 			name: "backup with no volume snapshot locations does not create any snapshots",
 			req: &Request{
@@ -2094,7 +3001,7 @@ func TestBackupWithHooks(t *testing.T) {
 
 			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
 
-			assertTarballContents(t, backupFile, append(tc.wantBackedUp, "metadata/version")...)
+			assertTarballContents(t, backupFile, append(tc.wantBackedUp, "metadata/version", "metadata/api-resources.json")...)
 		})
 	}
 }
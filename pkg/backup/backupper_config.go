@@ -0,0 +1,132 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kubediscovery "k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+// DefaultResticTimeout is how long restic backups of pod volumes are allowed to
+// run before timing out, if BackupperConfig.ResticTimeout isn't set.
+const DefaultResticTimeout = 60 * time.Minute
+
+// BackupperConfig contains the dependencies of a Backupper created by NewBackupper.
+// Only the Kubernetes clients are required, so that Velero's backup engine can be
+// embedded in other programs without running the Velero server.
+type BackupperConfig struct {
+	// DiscoveryClient is used to find the resources in the cluster that can
+	// be backed up. Required.
+	DiscoveryClient kubediscovery.DiscoveryInterface
+
+	// DynamicClient is used to list and get the items to back up. Required.
+	DynamicClient dynamic.Interface
+
+	// RESTConfig, if set, is used to list and get the items of Kubernetes'
+	// built-in resources with protobuf rather than JSON, which is much
+	// cheaper for the API server. Fields of built-in resources that Velero's
+	// Kubernetes types don't define are dropped from backed up items, so it
+	// should only be set for clusters whose version matches them.
+	RESTConfig *rest.Config
+
+	// PodCommandExecutor executes backup hooks in pods. If it's nil,
+	// backups fail to run any hooks.
+	PodCommandExecutor podexec.PodCommandExecutor
+
+	// ResticBackupperFactory creates restic backuppers for pod volumes. If
+	// it's nil, pod volumes aren't backed up with restic.
+	ResticBackupperFactory restic.BackupperFactory
+
+	// CoreClient is used to get the events and pod logs that backups include
+	// when their spec.includeEventsAndPodLogs is true. If it's nil, they
+	// aren't included.
+	CoreClient corev1client.CoreV1Interface
+
+	// ResticTimeout is how long restic backups of pod volumes are allowed to
+	// run before timing out. Defaults to DefaultResticTimeout.
+	ResticTimeout time.Duration
+
+	// Logger is used while discovering the cluster's resources. Defaults to
+	// the logrus standard logger.
+	Logger logrus.FieldLogger
+}
+
+// NewBackupper creates a Backupper from the given config. Backup item actions
+// are passed to each call of the Backupper's Backup method, rather than here.
+func NewBackupper(config BackupperConfig) (Backupper, error) {
+	if config.DiscoveryClient == nil {
+		return nil, errors.New("a discovery client is required")
+	}
+	if config.DynamicClient == nil {
+		return nil, errors.New("a dynamic client is required")
+	}
+
+	if config.PodCommandExecutor == nil {
+		config.PodCommandExecutor = unavailablePodCommandExecutor{}
+	}
+	if config.ResticTimeout == 0 {
+		config.ResticTimeout = DefaultResticTimeout
+	}
+	if config.Logger == nil {
+		config.Logger = logrus.StandardLogger()
+	}
+
+	var diagnosticsGetter DiagnosticsGetter
+	if config.CoreClient != nil {
+		diagnosticsGetter = NewDiagnosticsGetter(config.CoreClient)
+	}
+
+	discoveryHelper, err := discovery.NewHelper(config.DiscoveryClient, config.Logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "error discovering the cluster's resources")
+	}
+
+	dynamicFactory := client.NewPagedDynamicFactory(config.DynamicClient)
+	if config.RESTConfig != nil {
+		dynamicFactory = client.NewProtobufDynamicFactory(config.DynamicClient, config.RESTConfig)
+	}
+
+	return NewKubernetesBackupper(
+		discoveryHelper,
+		dynamicFactory,
+		config.PodCommandExecutor,
+		config.ResticBackupperFactory,
+		config.ResticTimeout,
+		diagnosticsGetter,
+	)
+}
+
+// unavailablePodCommandExecutor is the PodCommandExecutor for Backuppers created
+// without one. It fails every command, so that hooks are reported as errors
+// rather than skipped.
+type unavailablePodCommandExecutor struct{}
+
+func (unavailablePodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error {
+	return errors.Errorf("unable to run hook %s in pod %s/%s: no pod command executor is configured", hookName, namespace, name)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewBackupperRequiresClients(t *testing.T) {
+	apiServer := test.NewAPIServer(t)
+
+	tests := []struct {
+		name    string
+		config  BackupperConfig
+		wantErr string
+	}{
+		{
+			name:    "missing discovery client returns an error",
+			config:  BackupperConfig{DynamicClient: apiServer.DynamicClient},
+			wantErr: "a discovery client is required",
+		},
+		{
+			name:    "missing dynamic client returns an error",
+			config:  BackupperConfig{DiscoveryClient: apiServer.DiscoveryClient},
+			wantErr: "a dynamic client is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backupper, err := NewBackupper(tc.config)
+			assert.Nil(t, backupper)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestNewBackupperDefaults(t *testing.T) {
+	apiServer := test.NewAPIServer(t)
+
+	backupper, err := NewBackupper(BackupperConfig{
+		DiscoveryClient: apiServer.DiscoveryClient,
+		DynamicClient:   apiServer.DynamicClient,
+	})
+	require.NoError(t, err)
+
+	kb := backupper.(*kubernetesBackupper)
+	assert.Equal(t, DefaultResticTimeout, kb.resticTimeout)
+	assert.Nil(t, kb.resticBackupperFactory)
+	assert.Error(t, kb.podCommandExecutor.ExecutePodCommand(logrus.StandardLogger(), nil, "ns-1", "pod-1", "hook-1", nil))
+}
+
+func TestNewBackupperBacksUpItems(t *testing.T) {
+	apiServer := test.NewAPIServer(t)
+
+	pods := test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	)
+	apiServer.DiscoveryClient.WithAPIResource(pods)
+	for _, item := range pods.Items {
+		obj := &unstructured.Unstructured{Object: toUnstructuredOrFail(t, item)}
+		_, err := apiServer.DynamicClient.Resource(pods.GVR()).Namespace(item.GetNamespace()).Create(obj, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	backupper, err := NewBackupper(BackupperConfig{
+		DiscoveryClient: apiServer.DiscoveryClient,
+		DynamicClient:   apiServer.DynamicClient,
+	})
+	require.NoError(t, err)
+
+	req := &Request{Backup: defaultBackup().Result()}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	require.NoError(t, backupper.Backup(logrus.StandardLogger(), req, backupFile, nil, nil))
+
+	assertTarballContents(t, backupFile,
+		"metadata/api-resources.json",
+		"metadata/version",
+		"resources/pods/namespaces/foo/bar.json",
+		"resources/pods/namespaces/zoo/raz.json",
+	)
+}
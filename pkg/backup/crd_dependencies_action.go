@@ -0,0 +1,230 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// crdDependencies are the objects that a custom resource depends on, as declared
+// by the velero.io/backup-with annotation on its custom resource definition.
+type crdDependencies struct {
+	resources []string
+	selector  labels.Selector
+}
+
+// parseBackupWith parses the value of a velero.io/backup-with annotation, which is
+// a comma-separated list of resources, optionally followed by "selector=<label selector>",
+// e.g. "secrets,configmaps selector=app=foo".
+func parseBackupWith(value string) (*crdDependencies, error) {
+	resources, selector := value, ""
+	if i := strings.Index(value, "selector="); i >= 0 {
+		resources, selector = value[:i], value[i+len("selector="):]
+	}
+
+	deps := &crdDependencies{selector: labels.Everything()}
+	for _, resource := range strings.Split(resources, ",") {
+		resource = strings.TrimSpace(resource)
+		if resource == "" {
+			continue
+		}
+		if strings.ContainsAny(resource, " =") {
+			return nil, errors.Errorf("invalid resource %q", resource)
+		}
+		deps.resources = append(deps.resources, resource)
+	}
+	if len(deps.resources) == 0 {
+		return nil, errors.New("no resources are listed")
+	}
+
+	if selector != "" {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid label selector")
+		}
+		deps.selector = parsed
+	}
+
+	return deps, nil
+}
+
+// CRDDependenciesAction is a backup item action that backs up the objects that
+// custom resources depend on, as declared by the velero.io/backup-with annotation
+// on their custom resource definitions, so that operators can integrate with
+// Velero without a plugin.
+type CRDDependenciesAction struct {
+	log             logrus.FieldLogger
+	discoveryHelper velerodiscovery.Helper
+	dynamicFactory  client.DynamicFactory
+	dependencies    map[schema.GroupKind]*crdDependencies
+	resources       []string
+}
+
+// NewCRDDependenciesAction creates a new CRDDependenciesAction, reading the
+// dependencies declared by the cluster's custom resource definitions.
+func NewCRDDependenciesAction(logger logrus.FieldLogger, discoveryHelper velerodiscovery.Helper, dynamicFactory client.DynamicFactory) (*CRDDependenciesAction, error) {
+	a := &CRDDependenciesAction{
+		log:             logger,
+		discoveryHelper: discoveryHelper,
+		dynamicFactory:  dynamicFactory,
+		dependencies:    make(map[schema.GroupKind]*crdDependencies),
+	}
+
+	gvr, resource, err := discoveryHelper.ResourceFor(kuberesource.CustomResourceDefinitions.WithVersion(""))
+	if err != nil {
+		logger.WithError(err).Info("Unable to find custom resource definitions API, not backing up custom resource dependencies")
+		return a, nil
+	}
+
+	crdClient, err := dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, "")
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := crdClient.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	crds, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, obj := range crds {
+		crd, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+
+		value, ok := crd.GetAnnotations()[v1.BackupWithAnnotation]
+		if !ok {
+			continue
+		}
+
+		deps, err := parseBackupWith(value)
+		if err != nil {
+			logger.WithError(err).WithField("customResourceDefinition", crd.GetName()).Warnf("Ignoring invalid %s annotation", v1.BackupWithAnnotation)
+			continue
+		}
+
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+		plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+
+		a.dependencies[schema.GroupKind{Group: group, Kind: kind}] = deps
+		a.resources = append(a.resources, schema.GroupResource{Group: group, Resource: plural}.String())
+	}
+
+	return a, nil
+}
+
+// AppliesTo returns a ResourceSelector that applies to the custom resources whose
+// definitions declare dependencies.
+func (a *CRDDependenciesAction) AppliesTo() (velero.ResourceSelector, error) {
+	// a ResourceSelector without included resources applies to all resources,
+	// so if no custom resource definitions declare dependencies, apply to
+	// custom resource definitions themselves, which never have dependencies.
+	if len(a.resources) == 0 {
+		return velero.ResourceSelector{
+			IncludedResources: []string{kuberesource.CustomResourceDefinitions.String()},
+		}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedResources: a.resources,
+	}, nil
+}
+
+// Execute adds the objects that the custom resource depends on to the list of
+// additional items to be backed up. Namespaced dependencies are looked up in the
+// custom resource's namespace, or in all namespaces if it's cluster-scoped.
+func (a *CRDDependenciesAction) Execute(item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	a.log.Info("Running CRDDependenciesAction")
+	defer a.log.Info("Done running CRDDependenciesAction")
+
+	deps, ok := a.dependencies[item.GetObjectKind().GroupVersionKind().GroupKind()]
+	if !ok {
+		return item, nil, nil
+	}
+
+	objectMeta, err := meta.Accessor(item)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	var additionalItems []velero.ResourceIdentifier
+	for _, resource := range deps.resources {
+		gvr, apiResource, err := a.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			a.log.WithError(err).Warnf("Unable to find resource %s, not backing up dependencies of %s/%s", resource, objectMeta.GetNamespace(), objectMeta.GetName())
+			continue
+		}
+
+		var namespace string
+		if apiResource.Namespaced {
+			namespace = objectMeta.GetNamespace()
+		}
+
+		resourceClient, err := a.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), apiResource, namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		list, err := resourceClient.List(metav1.ListOptions{LabelSelector: deps.selector.String()})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error listing %s", gvr.GroupResource())
+		}
+
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		for _, obj := range objs {
+			dep, err := meta.Accessor(obj)
+			if err != nil {
+				return nil, nil, errors.WithStack(err)
+			}
+
+			a.log.Infof("Adding %s %s/%s to additionalItems since %s/%s depends on it", gvr.GroupResource(), dep.GetNamespace(), dep.GetName(), objectMeta.GetNamespace(), objectMeta.GetName())
+
+			additionalItems = append(additionalItems, velero.ResourceIdentifier{
+				GroupResource: gvr.GroupResource(),
+				Namespace:     dep.GetNamespace(),
+				Name:          dep.GetName(),
+			})
+		}
+	}
+
+	return item, additionalItems, nil
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestParseBackupWith(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		wantResources []string
+		wantSelector  string
+		wantErr       bool
+	}{
+		{
+			name:          "resources without a selector select everything",
+			value:         "secrets, configmaps",
+			wantResources: []string{"secrets", "configmaps"},
+			wantSelector:  "",
+		},
+		{
+			name:          "resources with a selector",
+			value:         "secrets,configmaps selector=app=foo,tier in (db, cache)",
+			wantResources: []string{"secrets", "configmaps"},
+			wantSelector:  "app=foo,tier in (cache,db)",
+		},
+		{
+			name:    "no resources is an error",
+			value:   " selector=app=foo",
+			wantErr: true,
+		},
+		{
+			name:    "invalid resource is an error",
+			value:   "secrets names=foo",
+			wantErr: true,
+		},
+		{
+			name:    "invalid selector is an error",
+			value:   "secrets selector=app=foo=bar",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps, err := parseBackupWith(tc.value)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResources, deps.resources)
+			assert.Equal(t, tc.wantSelector, deps.selector.String())
+		})
+	}
+}
+
+// newCRD returns an unstructured custom resource definition for the example.io
+// group with the given kind and plural, and annotations.
+func newCRD(kind, plural string, annotations ...string) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1beta1",
+			"kind":       "CustomResourceDefinition",
+			"spec": map[string]interface{}{
+				"group": "example.io",
+				"names": map[string]interface{}{
+					"kind":   kind,
+					"plural": plural,
+				},
+			},
+		},
+	}
+	crd.SetName(plural + ".example.io")
+	builder.WithAnnotations(annotations...)(crd)
+
+	return crd
+}
+
+func newWidget(ns, name string) *unstructured.Unstructured {
+	widget := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.io/v1",
+			"kind":       "Widget",
+		},
+	}
+	widget.SetNamespace(ns)
+	widget.SetName(name)
+
+	return widget
+}
+
+func TestCRDDependenciesAction(t *testing.T) {
+	tests := []struct {
+		name                string
+		apiResources        []*test.APIResource
+		item                *unstructured.Unstructured
+		wantAppliesTo       []string
+		wantAdditionalItems []velero.ResourceIdentifier
+	}{
+		{
+			name: "no annotated CRDs only applies to CRDs",
+			apiResources: []*test.APIResource{
+				test.CRDs(newCRD("Widget", "widgets")),
+			},
+			item:          newWidget("ns-1", "widget-1"),
+			wantAppliesTo: []string{kuberesource.CustomResourceDefinitions.String()},
+		},
+		{
+			name: "annotated CRD without a selector adds all dependencies in the item's namespace",
+			apiResources: []*test.APIResource{
+				test.CRDs(newCRD("Widget", "widgets", velerov1.BackupWithAnnotation, "secrets,configmaps")),
+				test.Secrets(
+					builder.ForSecret("ns-1", "secret-1").Result(),
+					builder.ForSecret("ns-2", "secret-2").Result(),
+				),
+				test.ConfigMaps(
+					builder.ForConfigMap("ns-1", "cm-1").Result(),
+				),
+			},
+			item:          newWidget("ns-1", "widget-1"),
+			wantAppliesTo: []string{"widgets.example.io"},
+			wantAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.Secrets, Namespace: "ns-1", Name: "secret-1"},
+				{GroupResource: kuberesource.ConfigMaps, Namespace: "ns-1", Name: "cm-1"},
+			},
+		},
+		{
+			name: "annotated CRD with a selector adds matching dependencies",
+			apiResources: []*test.APIResource{
+				test.CRDs(newCRD("Widget", "widgets", velerov1.BackupWithAnnotation, "secrets selector=app=widget")),
+				test.Secrets(
+					builder.ForSecret("ns-1", "secret-1").ObjectMeta(builder.WithLabels("app", "widget")).Result(),
+					builder.ForSecret("ns-1", "secret-2").ObjectMeta(builder.WithLabels("app", "other")).Result(),
+				),
+			},
+			item:          newWidget("ns-1", "widget-1"),
+			wantAppliesTo: []string{"widgets.example.io"},
+			wantAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.Secrets, Namespace: "ns-1", Name: "secret-1"},
+			},
+		},
+		{
+			name: "CRD with an invalid annotation is ignored",
+			apiResources: []*test.APIResource{
+				test.CRDs(newCRD("Widget", "widgets", velerov1.BackupWithAnnotation, "secrets names=foo")),
+				test.Secrets(
+					builder.ForSecret("ns-1", "secret-1").Result(),
+				),
+			},
+			item:          newWidget("ns-1", "widget-1"),
+			wantAppliesTo: []string{kuberesource.CustomResourceDefinitions.String()},
+		},
+		{
+			name: "unknown dependency resources are skipped",
+			apiResources: []*test.APIResource{
+				test.CRDs(newCRD("Widget", "widgets", velerov1.BackupWithAnnotation, "gadgets,secrets")),
+				test.Secrets(
+					builder.ForSecret("ns-1", "secret-1").Result(),
+				),
+			},
+			item:          newWidget("ns-1", "widget-1"),
+			wantAppliesTo: []string{"widgets.example.io"},
+			wantAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.Secrets, Namespace: "ns-1", Name: "secret-1"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			apiServer := test.NewAPIServer(t)
+			for _, resource := range tc.apiResources {
+				apiServer.DiscoveryClient.WithAPIResource(resource)
+				for _, item := range resource.Items {
+					obj := &unstructured.Unstructured{Object: toUnstructuredOrFail(t, item)}
+					_, err := apiServer.DynamicClient.Resource(resource.GVR()).Namespace(item.GetNamespace()).Create(obj, metav1.CreateOptions{})
+					require.NoError(t, err)
+				}
+			}
+
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logrus.StandardLogger())
+			require.NoError(t, err)
+
+			action, err := NewCRDDependenciesAction(logrus.StandardLogger(), discoveryHelper, client.NewDynamicFactory(apiServer.DynamicClient))
+			require.NoError(t, err)
+
+			selector, err := action.AppliesTo()
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAppliesTo, selector.IncludedResources)
+
+			_, additionalItems, err := action.Execute(tc.item, defaultBackup().Result())
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAdditionalItems, additionalItems)
+		})
+	}
+}
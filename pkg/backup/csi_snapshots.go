@@ -0,0 +1,290 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+const (
+	// csiSnapshotTimeout is how long backups wait for the CSI volume
+	// snapshots they take to be ready to use.
+	csiSnapshotTimeout = 10 * time.Minute
+
+	// csiSnapshotPollInterval is how often backups check whether the CSI
+	// volume snapshots they take are ready to use.
+	csiSnapshotPollInterval = time.Second
+
+	// defaultVolumeSnapshotClassAnnotation is the annotation of the
+	// default VolumeSnapshotClass of a CSI driver.
+	defaultVolumeSnapshotClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+)
+
+// resourceClient returns a client for a resource in a namespace, using the
+// version of the resource preferred by the cluster.
+func (ib *defaultItemBackupper) resourceClient(groupResource schema.GroupResource, namespace string) (client.Dynamic, error) {
+	gvr, resource, err := ib.discoveryHelper.ResourceFor(groupResource.WithVersion(""))
+	if err != nil {
+		return nil, err
+	}
+
+	return ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, namespace)
+}
+
+// takeCSISnapshot snapshots the volume of a bound persistent volume claim
+// with the CSI driver that provisioned it, if the backup has volume snapshots
+// enabled and there's a VolumeSnapshotClass for the driver. It annotates the
+// claim with the name of the VolumeSnapshot, so that the claim is restored
+// from it, and returns the VolumeSnapshot, its VolumeSnapshotContent and its
+// VolumeSnapshotClass to be backed up as additional items. If the claim's
+// volume isn't snapshotted with CSI, it returns nothing, and the volume may
+// be snapshotted by a volume snapshotter instead.
+func (ib *defaultItemBackupper) takeCSISnapshot(log logrus.FieldLogger, obj runtime.Unstructured) ([]velero.ResourceIdentifier, error) {
+	if boolptr.IsSetToFalse(ib.backupRequest.Spec.SnapshotVolumes) {
+		return nil, nil
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if pvc.Spec.VolumeName == "" {
+		log.Info("Not taking a CSI snapshot of persistent volume claim because it isn't bound")
+		return nil, nil
+	}
+
+	if ib.resticSnapshotTracker.Has(pvc.Namespace, pvc.Name) {
+		log.Info("Not taking a CSI snapshot of persistent volume claim because its volume is backed up with restic")
+		return nil, nil
+	}
+
+	pvClient, err := ib.resourceClient(kuberesource.PersistentVolumes, "")
+	if err != nil {
+		return nil, err
+	}
+	pvObj, err := pvClient.Get(pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting persistent volume %s", pvc.Spec.VolumeName)
+	}
+	pv := new(corev1api.PersistentVolume)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(pvObj.UnstructuredContent(), pv); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if pv.Spec.CSI == nil {
+		log.Info("Not taking a CSI snapshot of persistent volume claim because its volume wasn't provisioned by a CSI driver")
+		return nil, nil
+	}
+	if excluded, reason := ib.backupRequest.SnapshotExclusions.Excludes(pv); excluded {
+		log.Infof("Not taking a CSI snapshot of persistent volume claim because %s.", reason)
+		return nil, nil
+	}
+
+	log = log.WithField("csiDriver", pv.Spec.CSI.Driver)
+
+	classClient, err := ib.resourceClient(kuberesource.VolumeSnapshotClasses, "")
+	if err != nil {
+		log.WithError(err).Warn("Not taking a CSI snapshot of persistent volume claim because the cluster doesn't serve CSI volume snapshots")
+		return nil, nil
+	}
+	classList, err := classClient.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing volume snapshot classes")
+	}
+	classes, err := meta.ExtractList(classList)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	class := selectVolumeSnapshotClass(classes, pv.Spec.CSI.Driver)
+	if class == "" {
+		log.Info("Not taking a CSI snapshot of persistent volume claim because there's no volume snapshot class for its CSI driver")
+		return nil, nil
+	}
+
+	gvr, resource, err := ib.discoveryHelper.ResourceFor(kuberesource.VolumeSnapshots.WithVersion(""))
+	if err != nil {
+		return nil, err
+	}
+	snapshotClient, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, pvc.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &unstructured.Unstructured{}
+	snapshot.SetAPIVersion(gvr.GroupVersion().String())
+	snapshot.SetKind("VolumeSnapshot")
+	snapshot.SetGenerateName("velero-" + pvc.Name + "-")
+	snapshot.SetNamespace(pvc.Namespace)
+	snapshot.SetLabels(map[string]string{velerov1api.BackupNameLabel: label.GetValidName(ib.backupRequest.Name)})
+	snapshot.Object["spec"] = map[string]interface{}{
+		"volumeSnapshotClassName": class,
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": pvc.Name,
+		},
+	}
+
+	log.Info("Taking a CSI snapshot of persistent volume claim")
+	if snapshot, err = snapshotClient.Create(snapshot); err != nil {
+		return nil, errors.Wrap(err, "error creating volume snapshot")
+	}
+
+	log = log.WithField("volumeSnapshot", snapshot.GetName())
+
+	var content string
+	err = wait.PollImmediate(csiSnapshotPollInterval, csiSnapshotTimeout, func() (bool, error) {
+		res, err := snapshotClient.Get(snapshot.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "error getting volume snapshot %s", snapshot.GetName())
+		}
+
+		if message, _, _ := unstructured.NestedString(res.Object, "status", "error", "message"); message != "" {
+			return false, errors.Errorf("volume snapshot %s failed: %s", snapshot.GetName(), message)
+		}
+
+		ready, _, _ := unstructured.NestedBool(res.Object, "status", "readyToUse")
+		content, _, _ = unstructured.NestedString(res.Object, "status", "boundVolumeSnapshotContentName")
+		return ready && content != "", nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, errors.Errorf("timed out after %v waiting for volume snapshot %s to be ready to use", csiSnapshotTimeout, snapshot.GetName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("CSI snapshot of persistent volume claim is ready to use")
+
+	metadata, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	annotations := metadata.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[velerov1api.VolumeSnapshotNameAnnotation] = snapshot.GetName()
+	metadata.SetAnnotations(annotations)
+
+	ib.backupRequest.CSISnapshots[pv.Name] = pvc.Namespace + "/" + snapshot.GetName()
+
+	return []velero.ResourceIdentifier{
+		{GroupResource: kuberesource.VolumeSnapshotClasses, Name: class},
+		{GroupResource: kuberesource.VolumeSnapshots, Namespace: pvc.Namespace, Name: snapshot.GetName()},
+		{GroupResource: kuberesource.VolumeSnapshotContents, Name: content},
+	}, nil
+}
+
+// selectVolumeSnapshotClass returns the name of the VolumeSnapshotClass to
+// take CSI snapshots of a CSI driver's volumes with: the one labeled with
+// velero.io/csi-volumesnapshot-class=true, otherwise the driver's default
+// one, otherwise the first one by name. It returns "" if there's no
+// VolumeSnapshotClass for the driver.
+func selectVolumeSnapshotClass(classes []runtime.Object, driver string) string {
+	var labeled, defaults, others []string
+	for _, obj := range classes {
+		class, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+
+		// VolumeSnapshotClasses have their driver at the top level,
+		// rather than in a spec.
+		if classDriver, _, _ := unstructured.NestedString(class.Object, "driver"); classDriver != driver {
+			continue
+		}
+
+		switch {
+		case class.GetLabels()[velerov1api.VolumeSnapshotClassLabel] == "true":
+			labeled = append(labeled, class.GetName())
+		case class.GetAnnotations()[defaultVolumeSnapshotClassAnnotation] == "true":
+			defaults = append(defaults, class.GetName())
+		default:
+			others = append(others, class.GetName())
+		}
+	}
+
+	for _, names := range [][]string{labeled, defaults, others} {
+		if len(names) > 0 {
+			sort.Strings(names)
+			return names[0]
+		}
+	}
+
+	return ""
+}
+
+// addCSISnapshotHandle annotates a VolumeSnapshot with the CSI driver and the
+// snapshot handle of the VolumeSnapshotContent it's bound to, so that it can
+// be restored with a new VolumeSnapshotContent, including into other
+// clusters. VolumeSnapshots that aren't bound yet aren't annotated.
+func (ib *defaultItemBackupper) addCSISnapshotHandle(log logrus.FieldLogger, obj runtime.Unstructured) error {
+	content, _, _ := unstructured.NestedString(obj.UnstructuredContent(), "status", "boundVolumeSnapshotContentName")
+	if content == "" {
+		log.Info("Volume snapshot isn't bound to a volume snapshot content, so it can't be restored")
+		return nil
+	}
+
+	contentClient, err := ib.resourceClient(kuberesource.VolumeSnapshotContents, "")
+	if err != nil {
+		return err
+	}
+	contentObj, err := contentClient.Get(content, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error getting volume snapshot content %s", content)
+	}
+
+	handle, _, _ := unstructured.NestedString(contentObj.Object, "status", "snapshotHandle")
+	driver, _, _ := unstructured.NestedString(contentObj.Object, "spec", "driver")
+	if handle == "" {
+		log.Infof("Volume snapshot content %s has no snapshot handle yet, so the volume snapshot can't be restored", content)
+		return nil
+	}
+
+	metadata, err := meta.Accessor(obj)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	annotations := metadata.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[velerov1api.VolumeSnapshotHandleAnnotation] = handle
+	annotations[velerov1api.CSIDriverNameAnnotation] = driver
+	metadata.SetAnnotations(annotations)
+
+	return nil
+}
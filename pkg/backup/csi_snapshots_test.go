@@ -0,0 +1,92 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func newVolumeSnapshotClass(name, driver string, labels, annotations map[string]string) runtime.Object {
+	class := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+		"kind":       "VolumeSnapshotClass",
+		"driver":     driver,
+	}}
+	class.SetName(name)
+	class.SetLabels(labels)
+	class.SetAnnotations(annotations)
+
+	return class
+}
+
+func TestSelectVolumeSnapshotClass(t *testing.T) {
+	labeled := map[string]string{velerov1api.VolumeSnapshotClassLabel: "true"}
+	isDefault := map[string]string{defaultVolumeSnapshotClassAnnotation: "true"}
+
+	tests := []struct {
+		name    string
+		classes []runtime.Object
+		want    string
+	}{
+		{
+			name: "no classes",
+		},
+		{
+			name: "classes for other drivers aren't selected",
+			classes: []runtime.Object{
+				newVolumeSnapshotClass("class-1", "other.csi.example.com", labeled, nil),
+			},
+		},
+		{
+			name: "the first class by name is selected",
+			classes: []runtime.Object{
+				newVolumeSnapshotClass("class-2", "csi.example.com", nil, nil),
+				newVolumeSnapshotClass("class-1", "csi.example.com", nil, nil),
+			},
+			want: "class-1",
+		},
+		{
+			name: "the default class is selected over other classes",
+			classes: []runtime.Object{
+				newVolumeSnapshotClass("class-1", "csi.example.com", nil, nil),
+				newVolumeSnapshotClass("class-2", "csi.example.com", nil, isDefault),
+			},
+			want: "class-2",
+		},
+		{
+			name: "the labeled class is selected over the default class",
+			classes: []runtime.Object{
+				newVolumeSnapshotClass("class-1", "csi.example.com", nil, isDefault),
+				newVolumeSnapshotClass("class-2", "csi.example.com", labeled, nil),
+				newVolumeSnapshotClass("class-3", "other.csi.example.com", labeled, nil),
+			},
+			want: "class-2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, selectVolumeSnapshotClass(tc.classes, "csi.example.com"))
+		})
+	}
+}
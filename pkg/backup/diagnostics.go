@@ -0,0 +1,191 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// podLogTailLines and maxPodLogBytes bound the logs captured for each
+	// container: its last podLogTailLines lines, cut off after maxPodLogBytes.
+	podLogTailLines = 1000
+	maxPodLogBytes  = 1024 * 1024
+
+	// maxDiagnosticsBytes is the most pod logs captured for a backup. Once
+	// it's reached, the logs of the remaining pods are skipped.
+	maxDiagnosticsBytes = 64 * 1024 * 1024
+)
+
+// DiagnosticsGetter gets the events and pod logs that backups capture when
+// their spec.includeEventsAndPodLogs is true.
+type DiagnosticsGetter interface {
+	// ListEvents returns the events in a namespace.
+	ListEvents(namespace string) ([]corev1api.Event, error)
+
+	// GetPodLogs returns the most recent logs of each of a pod's containers,
+	// keyed by container name.
+	GetPodLogs(namespace, name string) (map[string][]byte, error)
+}
+
+// NewDiagnosticsGetter returns a DiagnosticsGetter that gets events and pod
+// logs from the Kubernetes API.
+func NewDiagnosticsGetter(client corev1client.CoreV1Interface) DiagnosticsGetter {
+	return &kubeDiagnosticsGetter{client: client}
+}
+
+type kubeDiagnosticsGetter struct {
+	client corev1client.CoreV1Interface
+}
+
+func (g *kubeDiagnosticsGetter) ListEvents(namespace string) ([]corev1api.Event, error) {
+	list, err := g.client.Events(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return list.Items, nil
+}
+
+func (g *kubeDiagnosticsGetter) GetPodLogs(namespace, name string) (map[string][]byte, error) {
+	pod, err := g.client.Pods(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	tailLines := int64(podLogTailLines)
+	limitBytes := int64(maxPodLogBytes)
+
+	logs := make(map[string][]byte)
+	for _, container := range pod.Spec.Containers {
+		stream, err := g.client.Pods(namespace).GetLogs(name, &corev1api.PodLogOptions{
+			Container:  container.Name,
+			TailLines:  &tailLines,
+			LimitBytes: &limitBytes,
+		}).Stream()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting logs of container %s", container.Name)
+		}
+
+		data, err := ioutil.ReadAll(stream)
+		stream.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading logs of container %s", container.Name)
+		}
+
+		logs[container.Name] = data
+	}
+
+	return logs, nil
+}
+
+// diagnosticsEventsPath and diagnosticsPodLogPath are the paths in a backup
+// archive of a namespace's events and a pod container's logs.
+func diagnosticsEventsPath(namespace string) string {
+	return filepath.Join(api.DiagnosticsDir, api.NamespaceScopedDir, namespace, "events.json")
+}
+
+func diagnosticsPodLogPath(namespace, pod, container string) string {
+	return filepath.Join(api.DiagnosticsDir, api.NamespaceScopedDir, namespace, "pods", pod, container+".log")
+}
+
+// writeDiagnostics writes the events of the namespaces of the backed up items,
+// and the logs of the backed up pods, to the backup's diagnostics directory.
+// Failing to get them is logged as a warning, since they're only kept for
+// troubleshooting; only errors writing to the archive are returned.
+func writeDiagnostics(log logrus.FieldLogger, getter DiagnosticsGetter, backupRequest *Request, tw tarWriter) error {
+	namespaces := make(map[string]struct{})
+	var pods []itemKey
+	for item := range backupRequest.BackedUpItems {
+		if item.namespace == "" {
+			continue
+		}
+		namespaces[item.namespace] = struct{}{}
+		// backed up items are keyed by their resourceKey.
+		if item.resource == "v1/Pod" {
+			pods = append(pods, item)
+		}
+	}
+
+	var sortedNamespaces []string
+	for namespace := range namespaces {
+		sortedNamespaces = append(sortedNamespaces, namespace)
+	}
+	sort.Strings(sortedNamespaces)
+
+	for _, namespace := range sortedNamespaces {
+		events, err := getter.ListEvents(namespace)
+		if err != nil {
+			log.WithError(err).WithField("namespace", namespace).Warn("Unable to list events for backup diagnostics")
+			continue
+		}
+
+		data, err := json.Marshal(events)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := writeTarFile(tw, diagnosticsEventsPath(namespace), data); err != nil {
+			return err
+		}
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].namespace != pods[j].namespace {
+			return pods[i].namespace < pods[j].namespace
+		}
+		return pods[i].name < pods[j].name
+	})
+
+	var total int
+	for i, pod := range pods {
+		if total >= maxDiagnosticsBytes {
+			log.Warnf("Captured %d bytes of pod logs, which is the limit for a backup, so the logs of %d pods were skipped", total, len(pods)-i)
+			break
+		}
+
+		logs, err := getter.GetPodLogs(pod.namespace, pod.name)
+		if err != nil {
+			log.WithError(err).WithField("pod", pod.namespace+"/"+pod.name).Warn("Unable to get pod logs for backup diagnostics")
+			continue
+		}
+
+		var containers []string
+		for container := range logs {
+			containers = append(containers, container)
+		}
+		sort.Strings(containers)
+
+		for _, container := range containers {
+			if err := writeTarFile(tw, diagnosticsPodLogPath(pod.namespace, pod.name, container), logs[container]); err != nil {
+				return err
+			}
+			total += len(logs[container])
+		}
+	}
+
+	return nil
+}
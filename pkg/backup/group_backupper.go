@@ -126,6 +126,9 @@ func (gb *defaultGroupBackupper) backupGroup(group *metav1.APIResourceList) erro
 
 	for _, resource := range group.APIResources {
 		if err := rb.backupResource(group, resource); err != nil {
+			if limitErr := gb.backupRequest.limits.exceeded(); limitErr != nil {
+				return limitErr
+			}
 			log.WithError(err).WithField("resource", resource.String()).Error("Error backing up API resource")
 		}
 	}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,12 +36,17 @@ import (
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -80,6 +86,9 @@ func (f *defaultItemBackupperFactory) newItemBackupper(
 
 		itemHookHandler: &defaultItemHookHandler{
 			podCommandExecutor: podCommandExecutor,
+			recordExecution: func(execution HookExecution) {
+				backupRequest.HookExecutions = append(backupRequest.HookExecutions, execution)
+			},
 		},
 	}
 
@@ -161,8 +170,13 @@ func (ib *defaultItemBackupper) backupItem(logger logrus.FieldLogger, obj runtim
 		log.Info("Skipping item because it's already been backed up.")
 		return nil
 	}
+	if err := ib.backupRequest.limits.addItem(); err != nil {
+		return err
+	}
 	ib.backupRequest.BackedUpItems[key] = struct{}{}
 
+	defer ib.backupRequest.ItemTimings.startItem(groupResource.String(), namespace, name)()
+
 	log.Info("Backing up item")
 
 	log.Debug("Executing pre hooks")
@@ -187,12 +201,36 @@ func (ib *defaultItemBackupper) backupItem(logger logrus.FieldLogger, obj runtim
 			// get the volumes to backup using restic, and add any of them that are PVCs to the pvc snapshot
 			// tracker, so that when we backup PVCs/PVs via an item action in the next step, we don't snapshot
 			// PVs that will have their data backed up with restic.
-			resticVolumesToBackup = restic.GetVolumesToBackup(pod)
+			resticVolumesToBackup = restic.GetPodVolumesToBackup(ib.backupRequest.Backup, pod)
 
 			ib.resticSnapshotTracker.Track(pod, resticVolumesToBackup)
 		}
 	}
 
+	if groupResource == kuberesource.PersistentVolumeClaims {
+		// track the PVC before executing actions, since they back up the PV it claims
+		// as an additional item.
+		ib.backupRequest.SnapshotExclusions.TrackClaim(metadata)
+
+		// take the CSI snapshot before executing actions, so that the PV
+		// isn't snapshotted by a volume snapshotter when it's backed up as
+		// an additional item.
+		if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+			additionalItems, err := ib.takeCSISnapshot(log, obj)
+			if err != nil {
+				backupErrs = append(backupErrs, errors.WithMessage(err, "error taking CSI snapshot"))
+			} else if err := ib.backupAdditionalItems(log, additionalItems); err != nil {
+				backupErrs = append(backupErrs, err)
+			}
+		}
+	}
+
+	if groupResource == kuberesource.VolumeSnapshots && features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		if err := ib.addCSISnapshotHandle(log, obj); err != nil {
+			backupErrs = append(backupErrs, err)
+		}
+	}
+
 	updatedObj, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata)
 	if err != nil {
 		backupErrs = append(backupErrs, err)
@@ -214,7 +252,16 @@ func (ib *defaultItemBackupper) backupItem(logger logrus.FieldLogger, obj runtim
 	namespace = metadata.GetNamespace()
 
 	if groupResource == kuberesource.PersistentVolumes {
-		if err := ib.takePVSnapshot(obj, log); err != nil {
+		if snapshot, ok := ib.backupRequest.CSISnapshots[name]; ok {
+			log.Infof("Skipping persistent volume snapshot because its claim's volume was snapshotted with CSI volume snapshot %s.", snapshot)
+
+			annotations := metadata.GetAnnotations()
+			if annotations == nil {
+				annotations = make(map[string]string)
+			}
+			annotations[velerov1api.VolumeSnapshotNameAnnotation] = snapshot
+			metadata.SetAnnotations(annotations)
+		} else if err := ib.takePVSnapshot(obj, log); err != nil {
 			backupErrs = append(backupErrs, err)
 		}
 	}
@@ -244,24 +291,82 @@ func (ib *defaultItemBackupper) backupItem(logger logrus.FieldLogger, obj runtim
 		filePath = filepath.Join(api.ResourcesDir, groupResource.String(), api.ClusterScopedDir, name+".json")
 	}
 
+	if ib.backupRequest.ReplicaPolicies.OmitsReplicas(groupResource.String()) && kubeutil.RemoveReplicas(obj.UnstructuredContent()) {
+		log.Info("Omitting replica count from backup")
+	}
+
+	if obj, err = ib.transformItem(log, obj, groupResource, name, namespace, metadata); err != nil {
+		return err
+	}
+	if metadata, err = meta.Accessor(obj); err != nil {
+		return errors.WithStack(err)
+	}
+
 	itemBytes, err := json.Marshal(obj.UnstructuredContent())
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
+	chunks, err := writeItemFile(ib.tarWriter, filePath, itemBytes, archive.ItemChunkSize)
+	if err != nil {
+		return err
+	}
+	if chunks > 1 {
+		log.Warnf("Item is %d bytes, which is larger than the archive's limit of %d bytes per file, so it was split into %d chunks", len(itemBytes), archive.ItemChunkSize, chunks)
+	}
+
+	if ib.backupRequest.Spec.SearchIndex {
+		ib.backupRequest.SearchIndex = append(ib.backupRequest.SearchIndex, SearchIndexEntry{
+			Resource:  groupResource.String(),
+			Kind:      obj.GetObjectKind().GroupVersionKind().Kind,
+			Namespace: namespace,
+			Name:      name,
+			Labels:    metadata.GetLabels(),
+			Path:      filePath,
+		})
+	}
+
+	return nil
+}
+
+// writeItemFile writes an item's file to a backup tarball. If it's larger than
+// chunkSize, it's split into consecutive chunk files of at most chunkSize bytes.
+// It returns the number of files written.
+func writeItemFile(tw tarWriter, filePath string, itemBytes []byte, chunkSize int) (int, error) {
+	if len(itemBytes) <= chunkSize {
+		return 1, writeTarFile(tw, filePath, itemBytes)
+	}
+
+	var chunks int
+	for start := 0; start < len(itemBytes); start += chunkSize {
+		end := start + chunkSize
+		if end > len(itemBytes) {
+			end = len(itemBytes)
+		}
+
+		if err := writeTarFile(tw, archive.ChunkFileName(filePath, chunks), itemBytes[start:end]); err != nil {
+			return chunks, err
+		}
+		chunks++
+	}
+
+	return chunks, nil
+}
+
+func writeTarFile(tw tarWriter, name string, data []byte) error {
 	hdr := &tar.Header{
-		Name:     filePath,
-		Size:     int64(len(itemBytes)),
+		Name:     name,
+		Size:     int64(len(data)),
 		Typeflag: tar.TypeReg,
 		Mode:     0755,
 		ModTime:  time.Now(),
 	}
 
-	if err := ib.tarWriter.WriteHeader(hdr); err != nil {
+	if err := tw.WriteHeader(hdr); err != nil {
 		return errors.WithStack(err)
 	}
 
-	if _, err := ib.tarWriter.Write(itemBytes); err != nil {
+	if _, err := tw.Write(data); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -291,59 +396,175 @@ func (ib *defaultItemBackupper) executeActions(
 	metadata metav1.Object,
 ) (runtime.Unstructured, error) {
 	for _, action := range ib.backupRequest.ResolvedActions {
-		if !action.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
-			log.Debug("Skipping action because it does not apply to this resource")
+		if !action.appliesTo(log, groupResource, namespace, metadata) {
 			continue
 		}
 
-		if namespace != "" && !action.namespaceIncludesExcludes.ShouldInclude(namespace) {
-			log.Debug("Skipping action because it does not apply to this namespace")
-			continue
+		log.Info("Executing custom action")
+
+		actionDone := ib.backupRequest.ItemTimings.startAction(actionName(action.BackupItemAction), groupResource.String(), namespace, name)
+		span := tracing.StartSpan(ib.backupRequest.Span, "BackupItemAction.Execute",
+			trace.StringAttribute("action", actionName(action.BackupItemAction)),
+			trace.StringAttribute("resource", groupResource.String()),
+			trace.StringAttribute("namespace", namespace),
+			trace.StringAttribute("name", name),
+		)
+		updatedItem, additionalItemIdentifiers, err := action.Execute(obj, ib.backupRequest.Backup)
+		tracing.EndSpan(span, err)
+		actionDone()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 		}
+		obj = updatedItem
 
-		if namespace == "" && !action.namespaceIncludesExcludes.IncludeEverything() {
-			log.Debug("Skipping action because resource is cluster-scoped and action only applies to specific namespaces")
-			continue
+		if err := ib.backupAdditionalItems(log, additionalItemIdentifiers); err != nil {
+			return nil, err
 		}
+	}
 
-		if !action.selector.Matches(labels.Set(metadata.GetLabels())) {
-			log.Debug("Skipping action because label selector does not match")
+	for _, action := range ib.backupRequest.ResolvedActionsV2 {
+		if !action.appliesTo(log, groupResource, namespace, metadata) {
 			continue
 		}
 
 		log.Info("Executing custom action")
 
-		updatedItem, additionalItemIdentifiers, err := action.Execute(obj, ib.backupRequest.Backup)
+		actionDone := ib.backupRequest.ItemTimings.startAction(actionName(action.BackupItemActionV2), groupResource.String(), namespace, name)
+		span := tracing.StartSpan(ib.backupRequest.Span, "BackupItemActionV2.Execute",
+			trace.StringAttribute("action", actionName(action.BackupItemActionV2)),
+			trace.StringAttribute("resource", groupResource.String()),
+			trace.StringAttribute("namespace", namespace),
+			trace.StringAttribute("name", name),
+		)
+		updatedItem, additionalItemIdentifiers, operationID, err := action.Execute(obj, ib.backupRequest.Backup)
+		tracing.EndSpan(span, err)
+		actionDone()
 		if err != nil {
 			return nil, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 		}
 		obj = updatedItem
 
-		for _, additionalItem := range additionalItemIdentifiers {
-			gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
-			if err != nil {
-				return nil, err
-			}
+		if operationID != "" {
+			log.WithField("operationID", operationID).Info("Custom action started an asynchronous operation")
+
+			now := metav1.Now()
+			ib.backupRequest.Status.PluginOperations = append(ib.backupRequest.Status.PluginOperations, api.PluginOperation{
+				Plugin:      actionName(action.BackupItemActionV2),
+				OperationID: operationID,
+				Resource:    groupResource.String(),
+				Namespace:   namespace,
+				Name:        name,
+				Phase:       api.PluginOperationPhaseInProgress,
+				Created:     &now,
+				Updated:     &now,
+			})
+		}
 
-			client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
-			if err != nil {
-				return nil, err
-			}
+		if err := ib.backupAdditionalItems(log, additionalItemIdentifiers); err != nil {
+			return nil, err
+		}
+	}
 
-			additionalItem, err := client.Get(additionalItem.Name, metav1.GetOptions{})
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
+	return obj, nil
+}
 
-			if err = ib.additionalItemBackupper.backupItem(log, additionalItem, gvr.GroupResource()); err != nil {
-				return nil, err
-			}
+// transformItem invokes the item transformers that apply to an item, in
+// order, passing each one the item returned by the previous one, and returns
+// the item to write to the backup. Transformers can't change the item's kind,
+// namespace or name, since they determine where it's written.
+func (ib *defaultItemBackupper) transformItem(
+	log logrus.FieldLogger,
+	obj runtime.Unstructured,
+	groupResource schema.GroupResource,
+	name, namespace string,
+	metadata metav1.Object,
+) (runtime.Unstructured, error) {
+	kind := obj.GetObjectKind().GroupVersionKind()
+
+	for _, transformer := range ib.backupRequest.ResolvedItemTransformers {
+		if !transformer.appliesTo(log, groupResource, namespace, metadata) {
+			continue
 		}
+
+		log.WithField("transformer", actionName(transformer.ItemTransformer)).Debug("Executing item transformer")
+
+		transformerDone := ib.backupRequest.ItemTimings.startAction(actionName(transformer.ItemTransformer), groupResource.String(), namespace, name)
+		transformedItem, err := transformer.Transform(obj, ib.backupRequest.Backup)
+		transformerDone()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error executing item transformer %s (groupResource=%s, namespace=%s, name=%s)", actionName(transformer.ItemTransformer), groupResource.String(), namespace, name)
+		}
+		if transformedItem == nil {
+			continue
+		}
+
+		transformedMetadata, err := meta.Accessor(transformedItem)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if transformedItem.GetObjectKind().GroupVersionKind() != kind || transformedMetadata.GetNamespace() != namespace || transformedMetadata.GetName() != name {
+			return nil, errors.Errorf("item transformer %s changed the kind, namespace or name of the item (groupResource=%s, namespace=%s, name=%s)", actionName(transformer.ItemTransformer), groupResource.String(), namespace, name)
+		}
+
+		obj, metadata = transformedItem, transformedMetadata
 	}
 
 	return obj, nil
 }
 
+// appliesTo returns whether a backup item action with this selector should
+// be executed on an item.
+func (s actionSelector) appliesTo(log logrus.FieldLogger, groupResource schema.GroupResource, namespace string, metadata metav1.Object) bool {
+	if !s.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
+		log.Debug("Skipping action because it does not apply to this resource")
+		return false
+	}
+
+	if namespace != "" && !s.namespaceIncludesExcludes.ShouldInclude(namespace) {
+		log.Debug("Skipping action because it does not apply to this namespace")
+		return false
+	}
+
+	if namespace == "" && !s.namespaceIncludesExcludes.IncludeEverything() {
+		log.Debug("Skipping action because resource is cluster-scoped and action only applies to specific namespaces")
+		return false
+	}
+
+	if !s.selector.Matches(labels.Set(metadata.GetLabels())) {
+		log.Debug("Skipping action because label selector does not match")
+		return false
+	}
+
+	return true
+}
+
+// backupAdditionalItems backs up the additional items returned by a backup
+// item action.
+func (ib *defaultItemBackupper) backupAdditionalItems(log logrus.FieldLogger, additionalItemIdentifiers []velero.ResourceIdentifier) error {
+	for _, additionalItem := range additionalItemIdentifiers {
+		gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
+		if err != nil {
+			return err
+		}
+
+		client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
+		if err != nil {
+			return err
+		}
+
+		additionalItem, err := client.Get(additionalItem.Name, metav1.GetOptions{})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err = ib.additionalItemBackupper.backupItem(log, additionalItem, gvr.GroupResource()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
 // or returns an existing one if one's already been initialized for the location.
 func (ib *defaultItemBackupper) volumeSnapshotter(snapshotLocation *api.VolumeSnapshotLocation) (velero.VolumeSnapshotter, error) {
@@ -356,7 +577,12 @@ func (ib *defaultItemBackupper) volumeSnapshotter(snapshotLocation *api.VolumeSn
 		return nil, err
 	}
 
-	if err := bs.Init(snapshotLocation.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentialsFile(velero.ConfigWithIdentity(snapshotLocation.Spec.Config, snapshotLocation.Spec.Identity), snapshotLocation.Spec.Credential, ib.volumeSnapshotterGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bs.Init(config); err != nil {
 		return nil, err
 	}
 
@@ -390,6 +616,11 @@ func (ib *defaultItemBackupper) takePVSnapshot(obj runtime.Unstructured, log log
 
 	log = log.WithField("persistentVolume", pv.Name)
 
+	if excluded, reason := ib.backupRequest.SnapshotExclusions.Excludes(pv); excluded {
+		log.Infof("Skipping persistent volume snapshot because %s.", reason)
+		return nil
+	}
+
 	// If this PV is claimed, see if we've already taken a (restic) snapshot of the contents
 	// of this PV. If so, don't take a snapshot.
 	if pv.Spec.ClaimRef != nil {
@@ -455,9 +686,17 @@ func (ib *defaultItemBackupper) takePVSnapshot(obj runtime.Unstructured, log log
 
 	log.Info("Snapshotting persistent volume")
 	snapshot := volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, volumeType, pvFailureDomainZone, location, iops)
+	if capacity, ok := pv.Spec.Capacity[corev1api.ResourceStorage]; ok {
+		snapshot.Spec.VolumeSizeBytes = capacity.Value()
+	}
 
 	var errs []error
+	span := tracing.StartSpan(ib.backupRequest.Span, "VolumeSnapshotter.CreateSnapshot",
+		trace.StringAttribute("persistentVolume", pv.Name),
+		trace.StringAttribute("volumeSnapshotLocation", location),
+	)
 	snapshotID, err := volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		errs = append(errs, errors.Wrap(err, "error taking snapshot of volume"))
 		snapshot.Status.Phase = volume.SnapshotPhaseFailed
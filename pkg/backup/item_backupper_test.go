@@ -17,9 +17,14 @@ limitations under the License.
 package backup
 
 import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -45,3 +50,57 @@ func Test_resourceKey(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteItemFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		itemBytes  string
+		wantFiles  map[string]string
+		wantChunks int
+	}{
+		{
+			name:       "items no larger than the chunk size are written to one file",
+			itemBytes:  "0123456789",
+			wantFiles:  map[string]string{"resources/configmaps/namespaces/ns-1/cm-1.json": "0123456789"},
+			wantChunks: 1,
+		},
+		{
+			name:      "items larger than the chunk size are split into chunks",
+			itemBytes: "0123456789abcdefghijk",
+			wantFiles: map[string]string{
+				"resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0000": "0123456789",
+				"resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0001": "abcdefghij",
+				"resources/configmaps/namespaces/ns-1/cm-1.json.chunk-0002": "k",
+			},
+			wantChunks: 3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			tw := tar.NewWriter(buf)
+
+			chunks, err := writeItemFile(tw, "resources/configmaps/namespaces/ns-1/cm-1.json", []byte(tc.itemBytes), 10)
+			require.NoError(t, err)
+			require.NoError(t, tw.Close())
+
+			files := make(map[string]string)
+			tr := tar.NewReader(buf)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+
+				data, err := ioutil.ReadAll(tr)
+				require.NoError(t, err)
+				files[hdr.Name] = string(data)
+			}
+
+			assert.Equal(t, tc.wantChunks, chunks)
+			assert.Equal(t, tc.wantFiles, files)
+		})
+	}
+}
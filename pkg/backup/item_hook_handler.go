@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
@@ -60,6 +61,32 @@ type itemHookHandler interface {
 // defaultItemHookHandler is the default itemHookHandler.
 type defaultItemHookHandler struct {
 	podCommandExecutor podexec.PodCommandExecutor
+	clock              clock.Clock
+
+	// recordExecution, if set, is called with each hook that's run.
+	recordExecution func(HookExecution)
+}
+
+// executeHook runs an exec hook on a pod, and records it.
+func (h *defaultItemHookHandler) executeHook(
+	log logrus.FieldLogger,
+	obj runtime.Unstructured,
+	namespace, name, hookName string,
+	hook *api.ExecHook,
+	phase hookPhase,
+) error {
+	if h.clock == nil {
+		h.clock = clock.RealClock{}
+	}
+
+	start := h.clock.Now()
+	err := h.podCommandExecutor.ExecutePodCommand(log, obj.UnstructuredContent(), namespace, name, hookName, hook)
+
+	if h.recordExecution != nil {
+		h.recordExecution(newHookExecution(namespace, name, hookName, phase, hook, h.clock.Since(start), err))
+	}
+
+	return err
 }
 
 func (h *defaultItemHookHandler) handleHooks(
@@ -96,7 +123,7 @@ func (h *defaultItemHookHandler) handleHooks(
 				"hookPhase":  phase,
 			},
 		)
-		if err := h.podCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", hookFromAnnotations); err != nil {
+		if err := h.executeHook(hookLog, obj, namespace, name, "<from-annotation>", hookFromAnnotations, phase); err != nil {
 			hookLog.WithError(err).Error("Error executing hook")
 			if hookFromAnnotations.OnError == api.HookErrorModeFail {
 				return err
@@ -129,7 +156,7 @@ func (h *defaultItemHookHandler) handleHooks(
 							"hookPhase":  phase,
 						},
 					)
-					err := h.podCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.name, hook.Exec)
+					err := h.executeHook(hookLog, obj, namespace, name, resourceHook.name, hook.Exec, phase)
 					if err != nil {
 						hookLog.WithError(err).Error("Error executing hook")
 						if hook.Exec.OnError == api.HookErrorModeFail {
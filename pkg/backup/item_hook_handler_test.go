@@ -30,8 +30,11 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
@@ -502,6 +505,53 @@ func TestHandleHooks(t *testing.T) {
 	}
 }
 
+func TestHandleHooksRecordsExecutions(t *testing.T) {
+	podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+	defer podCommandExecutor.AssertExpectations(t)
+
+	var executions []HookExecution
+	h := &defaultItemHookHandler{
+		podCommandExecutor: podCommandExecutor,
+		clock:              clock.NewFakeClock(time.Now()),
+		recordExecution: func(execution HookExecution) {
+			executions = append(executions, execution)
+		},
+	}
+
+	item := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "v1",
+		"kind": "Pod",
+		"metadata": {
+			"namespace": "ns",
+			"name": "name"
+		}
+	}`)
+
+	freeze := &v1.ExecHook{Container: "db", Command: []string{"freeze"}}
+	flush := &v1.ExecHook{Container: "db", Command: []string{"flush"}}
+	hooks := []resourceHook{
+		{
+			name: "hook1",
+			pre:  []v1.BackupResourceHook{{Exec: freeze}, {Exec: flush}},
+		},
+	}
+
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", "hook1", freeze).Return(nil)
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", "hook1", flush).Return(&podexec.CommandError{Err: errors.New("flush failed"), Stderr: "disk full"})
+
+	// the failed hook's error mode is continue, so it's recorded but doesn't
+	// fail the item.
+	require.NoError(t, h.handleHooks(velerotest.NewLogger(), kuberesource.Pods, item, hooks, hookPhasePre))
+
+	require.Len(t, executions, 2)
+	assert.Equal(t, []string{"freeze"}, executions[0].Command)
+	assert.False(t, executions[0].Failed())
+	assert.Equal(t, []string{"flush"}, executions[1].Command)
+	assert.Equal(t, "flush failed", executions[1].Error)
+	assert.Equal(t, "disk full", executions[1].Stderr)
+}
+
 func TestGetPodExecHookFromAnnotations(t *testing.T) {
 	phases := []hookPhase{"", hookPhasePre, hookPhasePost}
 	for _, phase := range phases {
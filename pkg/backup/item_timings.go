@@ -0,0 +1,140 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// slowestItemsCount is the number of slowest items, and slowest backup item
+// action executions, that are recorded for a backup.
+const slowestItemsCount = 10
+
+// ItemTimings records how long backing up each item, and executing each
+// backup item action, takes. It keeps the slowest of each, and the total
+// time spent per resource and per action.
+type ItemTimings struct {
+	clock clock.Clock
+
+	// nested is the time spent backing up additional items of each item
+	// that's currently being backed up, innermost last.
+	nested []time.Duration
+
+	// SlowestItems are the items that took the longest to back up, slowest first.
+	SlowestItems []velerov1api.ItemTiming
+
+	// SlowestActions are the backup item action executions that took the
+	// longest, slowest first.
+	SlowestActions []velerov1api.ItemTiming
+
+	// ResourceDurations is the total time spent backing up items, keyed by resource.
+	ResourceDurations map[string]time.Duration
+
+	// ActionDurations is the total time spent executing backup item actions, keyed
+	// by action.
+	ActionDurations map[string]time.Duration
+}
+
+// NewItemTimings returns an ItemTimings that uses clock to measure durations.
+func NewItemTimings(clock clock.Clock) *ItemTimings {
+	return &ItemTimings{
+		clock:             clock,
+		ResourceDurations: make(map[string]time.Duration),
+		ActionDurations:   make(map[string]time.Duration),
+	}
+}
+
+// startItem records that an item has started being backed up. The returned func must
+// be called when it's done. Time spent backing up additional items of the item is not
+// included in the item's time.
+func (t *ItemTimings) startItem(resource, namespace, name string) func() {
+	start := t.clock.Now()
+	t.nested = append(t.nested, 0)
+
+	return func() {
+		total := t.clock.Since(start)
+
+		last := len(t.nested) - 1
+		duration := total - t.nested[last]
+		t.nested = t.nested[:last]
+		if last > 0 {
+			t.nested[last-1] += total
+		}
+
+		t.ResourceDurations[resource] += duration
+		t.SlowestItems = insertTiming(t.SlowestItems, velerov1api.ItemTiming{
+			Resource:  resource,
+			Namespace: namespace,
+			Name:      name,
+			Duration:  metav1.Duration{Duration: duration},
+		})
+	}
+}
+
+// startAction records that a backup item action has started executing on an item.
+// The returned func must be called when it's done.
+func (t *ItemTimings) startAction(action, resource, namespace, name string) func() {
+	start := t.clock.Now()
+
+	return func() {
+		duration := t.clock.Since(start)
+
+		t.ActionDurations[action] += duration
+		t.SlowestActions = insertTiming(t.SlowestActions, velerov1api.ItemTiming{
+			Resource:  resource,
+			Namespace: namespace,
+			Name:      name,
+			Action:    action,
+			Duration:  metav1.Duration{Duration: duration},
+		})
+	}
+}
+
+// insertTiming inserts timing into timings, which are sorted slowest first, and
+// drops the fastest timing if there are more than slowestItemsCount.
+func insertTiming(timings []velerov1api.ItemTiming, timing velerov1api.ItemTiming) []velerov1api.ItemTiming {
+	i := sort.Search(len(timings), func(i int) bool {
+		return timings[i].Duration.Duration < timing.Duration.Duration
+	})
+	if i >= slowestItemsCount {
+		return timings
+	}
+
+	timings = append(timings, velerov1api.ItemTiming{})
+	copy(timings[i+1:], timings[i:])
+	timings[i] = timing
+
+	if len(timings) > slowestItemsCount {
+		timings = timings[:slowestItemsCount]
+	}
+	return timings
+}
+
+// actionName returns the name of a backup item action, for reporting its timings.
+func actionName(action interface{}) string {
+	if named, ok := action.(interface{ Name() string }); ok {
+		return named.Name()
+	}
+	return fmt.Sprintf("%T", action)
+}
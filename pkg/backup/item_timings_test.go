@@ -0,0 +1,80 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestItemTimingsExcludeAdditionalItems(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	timings := NewItemTimings(fakeClock)
+
+	podDone := timings.startItem("pods", "ns-1", "pod-1")
+	fakeClock.Step(time.Second)
+
+	actionDone := timings.startAction("velero.io/pod", "pods", "ns-1", "pod-1")
+	fakeClock.Step(2 * time.Second)
+	actionDone()
+
+	pvcDone := timings.startItem("persistentvolumeclaims", "ns-1", "pvc-1")
+	fakeClock.Step(5 * time.Second)
+	pvcDone()
+
+	fakeClock.Step(time.Second)
+	podDone()
+
+	assert.Equal(t, []velerov1api.ItemTiming{
+		{Resource: "persistentvolumeclaims", Namespace: "ns-1", Name: "pvc-1", Duration: metav1.Duration{Duration: 5 * time.Second}},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Duration: metav1.Duration{Duration: 4 * time.Second}},
+	}, timings.SlowestItems)
+
+	assert.Equal(t, []velerov1api.ItemTiming{
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Action: "velero.io/pod", Duration: metav1.Duration{Duration: 2 * time.Second}},
+	}, timings.SlowestActions)
+
+	assert.Equal(t, map[string]time.Duration{"pods": 4 * time.Second, "persistentvolumeclaims": 5 * time.Second}, timings.ResourceDurations)
+	assert.Equal(t, map[string]time.Duration{"velero.io/pod": 2 * time.Second}, timings.ActionDurations)
+}
+
+func TestItemTimingsKeepsSlowest(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	timings := NewItemTimings(fakeClock)
+
+	for i := 1; i <= slowestItemsCount*2; i++ {
+		done := timings.startItem("secrets", "ns-1", fmt.Sprintf("secret-%d", i))
+		// alternate between fast and slow items
+		fakeClock.Step(time.Duration(i%2*100+i) * time.Millisecond)
+		done()
+	}
+
+	require.Len(t, timings.SlowestItems, slowestItemsCount)
+	for i, timing := range timings.SlowestItems {
+		// the slowest items are the odd ones, in descending order
+		assert.Equal(t, fmt.Sprintf("secret-%d", slowestItemsCount*2-1-2*i), timing.Name)
+	}
+	assert.Equal(t, 1210*time.Millisecond, timings.ResourceDurations["secrets"])
+}
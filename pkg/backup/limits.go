@@ -0,0 +1,102 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// backupLimits enforces a backup's maximum number of items and tarball
+// size. Once a limit is exceeded, the backup is aborted.
+type backupLimits struct {
+	maxItems int
+	maxBytes int64
+
+	items int
+	bytes int64
+	err   error
+}
+
+// newBackupLimits returns the limits of a backup spec, or nil if it has none.
+func newBackupLimits(spec velerov1api.BackupSpec) *backupLimits {
+	limits := new(backupLimits)
+	if spec.MaxItems != nil {
+		limits.maxItems = *spec.MaxItems
+	}
+	if spec.MaxBytes != nil {
+		limits.maxBytes = *spec.MaxBytes
+	}
+
+	if limits.maxItems <= 0 && limits.maxBytes <= 0 {
+		return nil
+	}
+	return limits
+}
+
+// addItem counts an item that's about to be backed up, returning an error
+// if backing it up would exceed the backup's limits.
+func (l *backupLimits) addItem() error {
+	if l == nil {
+		return nil
+	}
+	if l.err != nil {
+		return l.err
+	}
+
+	switch {
+	case l.maxItems > 0 && l.items >= l.maxItems:
+		l.err = errors.Errorf("backup aborted because it exceeded its limit of %d items", l.maxItems)
+	case l.maxBytes > 0 && l.bytes > l.maxBytes:
+		l.err = errors.Errorf("backup aborted because its tarball exceeded its limit of %d bytes", l.maxBytes)
+	default:
+		l.items++
+	}
+
+	return l.err
+}
+
+// exceeded returns the error of the limit that the backup exceeded, if any.
+func (l *backupLimits) exceeded() error {
+	if l == nil {
+		return nil
+	}
+	return l.err
+}
+
+// writer returns a writer that counts the bytes written to w towards the
+// backup's size limit.
+func (l *backupLimits) writer(w io.Writer) io.Writer {
+	if l == nil || l.maxBytes <= 0 {
+		return w
+	}
+	return &countingWriter{w: w, count: &l.bytes}
+}
+
+type countingWriter struct {
+	w     io.Writer
+	count *int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	*w.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// selectNamespaces returns the names, sorted, of the namespaces whose labels
+// match a backup's spec.namespaceSelector.
+func (kb *kubernetesBackupper) selectNamespaces(selector *metav1.LabelSelector) ([]string, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing namespace selector")
+	}
+
+	gvr, resource, err := kb.discoveryHelper.ResourceFor(kuberesource.Namespaces.WithVersion(""))
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting namespaces resource")
+	}
+
+	client, err := kb.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting namespaces client")
+	}
+
+	list, err := client.List(metav1.ListOptions{LabelSelector: labelSelector.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing namespaces")
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		metadata, err := meta.Accessor(item)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		names = append(names, metadata.GetName())
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// includeSelectedNamespaces returns the namespaces to include in a backup
+// whose spec.namespaceSelector selected the specified namespaces: the
+// selected namespaces, and the backup's included namespaces unless they
+// include all namespaces.
+func includeSelectedNamespaces(included, selected []string) []string {
+	var namespaces []string
+	for _, namespace := range included {
+		if namespace != "*" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return append(namespaces, selected...)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// getResourceOrders resolves the resources of a backup's spec.orderedResources
+// to fully-qualified group-resource names with resolve, and splits their
+// comma-separated lists of item names. Resources that can't be resolved are
+// logged and ignored.
+func getResourceOrders(log logrus.FieldLogger, orderedResources map[string]string, resolve func(string) string) map[string][]string {
+	orders := make(map[string][]string)
+	for resource, names := range orderedResources {
+		groupResource := resolve(resource)
+		if groupResource == "" {
+			log.Warnf("Ignoring the order of resource %s because it isn't a known resource", resource)
+			continue
+		}
+
+		var order []string
+		for _, name := range strings.Split(names, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				order = append(order, name)
+			}
+		}
+		orders[groupResource] = order
+	}
+
+	return orders
+}
+
+// orderItems returns items with the ones named in order first, in the order
+// they're named, followed by the others in their original order. Namespaced
+// items are named namespace/name.
+func orderItems(items []runtime.Object, order []string) []runtime.Object {
+	if len(order) == 0 {
+		return items
+	}
+
+	positions := make(map[string]int, len(order))
+	for i, name := range order {
+		if _, found := positions[name]; !found {
+			positions[name] = i
+		}
+	}
+
+	ordered := make([]runtime.Object, len(order))
+	var others []runtime.Object
+	for _, item := range items {
+		position, found := -1, false
+		if metadata, err := meta.Accessor(item); err == nil {
+			name := metadata.GetName()
+			if metadata.GetNamespace() != "" {
+				name = metadata.GetNamespace() + "/" + name
+			}
+			position, found = positions[name]
+		}
+
+		if found && ordered[position] == nil {
+			ordered[position] = item
+		} else {
+			others = append(others, item)
+		}
+	}
+
+	res := make([]runtime.Object, 0, len(items))
+	for _, item := range ordered {
+		if item != nil {
+			res = append(res, item)
+		}
+	}
+
+	return append(res, others...)
+}
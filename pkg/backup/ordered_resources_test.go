@@ -0,0 +1,42 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetResourceOrders(t *testing.T) {
+	resolve := func(resource string) string {
+		return map[string]string{"pods": "pods", "deploy": "deployments.apps"}[resource]
+	}
+
+	orders := getResourceOrders(test.NewLogger(), map[string]string{
+		"pods":    "ns-1/db-0, ns-1/db-1,,",
+		"deploy":  "ns-1/web",
+		"unknown": "foo",
+	}, resolve)
+
+	assert.Equal(t, map[string][]string{
+		"pods":             {"ns-1/db-0", "ns-1/db-1"},
+		"deployments.apps": {"ns-1/web"},
+	}, orders)
+}
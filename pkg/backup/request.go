@@ -20,8 +20,12 @@ import (
 	"fmt"
 	"sort"
 
+	"go.opencensus.io/trace"
+
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -36,16 +40,67 @@ type itemKey struct {
 type Request struct {
 	*velerov1api.Backup
 
-	StorageLocation           *velerov1api.BackupStorageLocation
-	SnapshotLocations         []*velerov1api.VolumeSnapshotLocation
-	NamespaceIncludesExcludes *collections.IncludesExcludes
-	ResourceIncludesExcludes  *collections.IncludesExcludes
-	ResourceHooks             []resourceHook
-	ResolvedActions           []resolvedAction
+	StorageLocation            *velerov1api.BackupStorageLocation
+	AdditionalStorageLocations []*velerov1api.BackupStorageLocation
+	SnapshotLocations          []*velerov1api.VolumeSnapshotLocation
+	NamespaceIncludesExcludes  *collections.IncludesExcludes
+	ResourceIncludesExcludes   *collections.IncludesExcludes
+	ResourceHooks              []resourceHook
+	ResolvedActions            []resolvedAction
+
+	// ActionsV2 are the backup item actions that can start asynchronous
+	// operations. The operations they start are recorded in the backup's
+	// status.pluginOperations, for the caller to wait for.
+	ActionsV2         []velero.BackupItemActionV2
+	ResolvedActionsV2 []resolvedActionV2
+
+	// ItemTransformers rewrite the content of items after the backup item
+	// actions, in the order that they're listed in.
+	ItemTransformers         []velero.ItemTransformer
+	ResolvedItemTransformers []resolvedItemTransformer
 
 	VolumeSnapshots  []*volume.Snapshot
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	BackedUpItems    map[itemKey]struct{}
+
+	// SearchIndex is the search index of the items in the backup. It's
+	// only built if the backup's spec.searchIndex is true.
+	SearchIndex []SearchIndexEntry
+
+	// ItemTimings records how long backing up items, and executing backup
+	// item actions on them, took.
+	ItemTimings *ItemTimings
+
+	// HookExecutions are the exec hooks that were run on pods, in the order
+	// they were run.
+	HookExecutions []HookExecution
+
+	SnapshotExclusions *snapshotExclusions
+
+	// CSISnapshots maps the names of the persistent volumes whose claims'
+	// volumes were snapshotted with CSI to the namespace and name of their
+	// VolumeSnapshots. They aren't snapshotted by volume snapshotters.
+	CSISnapshots map[string]string
+
+	// ReplicaPolicies are the backup's replica policies, with their
+	// resources resolved.
+	ReplicaPolicies kubeutil.ReplicaPolicies
+
+	// ResourceOrders are the names of the items of resources that are
+	// backed up first, in order, by fully-qualified group-resource name.
+	ResourceOrders map[string][]string
+
+	// ResourceNames are the names of the items that are backed up, by
+	// fully-qualified group-resource name, for resources whose items are
+	// limited to named ones.
+	ResourceNames map[string][]string
+
+	// limits are the backup's maximum number of items and tarball size.
+	limits *backupLimits
+
+	// Span is the backup's trace span. The spans of the plugin calls made
+	// while backing up items are recorded as its children.
+	Span *trace.Span
 }
 
 // BackupResourceList returns the list of backed up resources grouped by the API
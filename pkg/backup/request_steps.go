@@ -0,0 +1,70 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RequestStep is a step in preparing a backup request to be run. Steps set
+// defaults on the request and validate it, and are run in order, each seeing
+// the changes made by the steps before it.
+type RequestStep struct {
+	// Name identifies the step. It must be unique.
+	Name string
+
+	// Prepare sets defaults on the request and validates it, returning the
+	// request's validation errors, if any.
+	Prepare func(request *Request) []string
+}
+
+var (
+	requestStepsLock sync.Mutex
+	requestSteps     []RequestStep
+)
+
+// RegisterRequestStep registers a step to be run when preparing every backup
+// request, after the steps that the server itself runs and the steps that
+// were registered before it. It's meant to be called from the init functions
+// of packages that add validations to backups, and panics if the step doesn't
+// have a name and a Prepare func, or if a step with the same name has already
+// been registered.
+func RegisterRequestStep(step RequestStep) {
+	requestStepsLock.Lock()
+	defer requestStepsLock.Unlock()
+
+	if step.Name == "" || step.Prepare == nil {
+		panic("backup request steps must have a name and a Prepare func")
+	}
+	for _, registered := range requestSteps {
+		if registered.Name == step.Name {
+			panic(fmt.Sprintf("backup request step %s is already registered", step.Name))
+		}
+	}
+
+	requestSteps = append(requestSteps, step)
+}
+
+// RegisteredRequestSteps returns the steps registered with RegisterRequestStep,
+// in the order they were registered in.
+func RegisteredRequestSteps() []RequestStep {
+	requestStepsLock.Lock()
+	defer requestStepsLock.Unlock()
+
+	return append([]RequestStep(nil), requestSteps...)
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package backup
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -162,6 +165,7 @@ func (rb *defaultResourceBackupper) backupResource(group *metav1.APIResourceList
 	)
 
 	namespacesToList := getNamespacesToList(rb.backupRequest.NamespaceIncludesExcludes)
+	names, limitedToNames := rb.backupRequest.ResourceNames[gr.String()]
 
 	// Check if we're backing up namespaces, and only certain ones
 	if gr == kuberesource.Namespaces && namespacesToList[0] != "" {
@@ -193,7 +197,15 @@ func (rb *defaultResourceBackupper) backupResource(group *metav1.APIResourceList
 					continue
 				}
 
+				if limitedToNames && !matchesResourceNames(names, "", ns) {
+					log.Info("Skipping namespace because it isn't named by the backup")
+					continue
+				}
+
 				if err := itemBackupper.backupItem(log, unstructured, gr); err != nil {
+					if limitErr := rb.backupRequest.limits.exceeded(); limitErr != nil {
+						return limitErr
+					}
 					log.WithError(errors.WithStack(err)).Error("Error backing up namespace")
 				}
 			}
@@ -207,8 +219,75 @@ func (rb *defaultResourceBackupper) backupResource(group *metav1.APIResourceList
 		namespacesToList = []string{""}
 	}
 
-	for _, namespace := range namespacesToList {
-		log = log.WithField("namespace", namespace)
+	// list the items in all of the namespaces before backing any of them
+	// up, so that they're ordered across namespaces. If the resource's
+	// items are limited to names without wildcards, they're got instead.
+	var items []runtime.Object
+	if limitedToNames && exactResourceNames(names) {
+		items = rb.getNamedItems(log, gv, resource, names)
+	} else {
+		items = rb.listItems(log, gv, resource, namespacesToList)
+	}
+
+	if limitedToNames {
+		items = filterItemsByName(items, names)
+		log.Infof("Backing up %d items named by the backup", len(items))
+	}
+
+	if order := rb.backupRequest.ResourceOrders[gr.String()]; len(order) > 0 {
+		log.Infof("Backing up items in order: %s", strings.Join(order, ", "))
+		items = orderItems(items, order)
+	}
+
+	// do the backup
+	for _, item := range items {
+		unstructured, ok := item.(runtime.Unstructured)
+		if !ok {
+			log.Errorf("Unexpected type %T", item)
+			continue
+		}
+
+		metadata, err := meta.Accessor(unstructured)
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error getting a metadata accessor")
+			continue
+		}
+		log := log.WithField("namespace", metadata.GetNamespace())
+
+		if gr == kuberesource.Namespaces && !rb.backupRequest.NamespaceIncludesExcludes.ShouldInclude(metadata.GetName()) {
+			log.WithField("name", metadata.GetName()).Info("Skipping namespace because it's excluded")
+			continue
+		}
+
+		err = itemBackupper.backupItem(log, unstructured, gr)
+		if limitErr := rb.backupRequest.limits.exceeded(); limitErr != nil {
+			return limitErr
+		}
+		if aggregate, ok := err.(kubeerrs.Aggregate); ok {
+			log.WithField("name", metadata.GetName()).Infof("%d errors encountered backup up item", len(aggregate.Errors()))
+			// log each error separately so we get error location info in the log, and an
+			// accurate count of errors
+			for _, err = range aggregate.Errors() {
+				log.WithError(err).WithField("name", metadata.GetName()).Error("Error backing up item")
+			}
+
+			continue
+		}
+		if err != nil {
+			log.WithError(err).WithField("name", metadata.GetName()).Error("Error backing up item")
+			continue
+		}
+	}
+
+	return nil
+}
+
+// listItems lists the items of a resource in namespaces, which are all
+// namespaces if they're [""], that match the backup's label selector.
+func (rb *defaultResourceBackupper) listItems(log logrus.FieldLogger, gv schema.GroupVersion, resource metav1.APIResource, namespaces []string) []runtime.Object {
+	var items []runtime.Object
+	for _, namespace := range namespaces {
+		log := log.WithField("namespace", namespace)
 
 		resourceClient, err := rb.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
 		if err != nil {
@@ -228,52 +307,85 @@ func (rb *defaultResourceBackupper) backupResource(group *metav1.APIResourceList
 			continue
 		}
 
-		// do the backup
-		items, err := meta.ExtractList(unstructuredList)
+		namespaceItems, err := meta.ExtractList(unstructuredList)
 		if err != nil {
 			log.WithError(errors.WithStack(err)).Error("Error extracting list")
 			continue
 		}
 
-		log.Infof("Retrieved %d items", len(items))
+		log.Infof("Retrieved %d items", len(namespaceItems))
+		items = append(items, namespaceItems...)
+	}
 
-		for _, item := range items {
-			unstructured, ok := item.(runtime.Unstructured)
-			if !ok {
-				log.Errorf("Unexpected type %T", item)
-				continue
-			}
+	return items
+}
 
-			metadata, err := meta.Accessor(unstructured)
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error getting a metadata accessor")
-				continue
-			}
+// getNamedItems gets the items of a resource named by names, formatted as
+// namespace/name for namespaced items, that match the backup's label
+// selector. Items that don't exist are skipped.
+func (rb *defaultResourceBackupper) getNamedItems(log logrus.FieldLogger, gv schema.GroupVersion, resource metav1.APIResource, names []string) []runtime.Object {
+	var labelSelector labels.Selector
+	if selector := rb.backupRequest.Spec.LabelSelector; selector != nil {
+		var err error
+		if labelSelector, err = metav1.LabelSelectorAsSelector(selector); err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error parsing label selector")
+			return nil
+		}
+	}
 
-			if gr == kuberesource.Namespaces && !rb.backupRequest.NamespaceIncludesExcludes.ShouldInclude(metadata.GetName()) {
-				log.WithField("name", metadata.GetName()).Info("Skipping namespace because it's excluded")
-				continue
-			}
+	var items []runtime.Object
+	for _, fullName := range names {
+		namespace, name := splitResourceName(fullName)
+		if resource.Namespaced != (namespace != "") {
+			log.Warnf("Skipping item %s because its name doesn't match the scope of its resource", fullName)
+			continue
+		}
 
-			err = itemBackupper.backupItem(log, unstructured, gr)
-			if aggregate, ok := err.(kubeerrs.Aggregate); ok {
-				log.WithField("name", metadata.GetName()).Infof("%d errors encountered backup up item", len(aggregate.Errors()))
-				// log each error separately so we get error location info in the log, and an
-				// accurate count of errors
-				for _, err = range aggregate.Errors() {
-					log.WithError(err).WithField("name", metadata.GetName()).Error("Error backing up item")
-				}
+		log := log.WithField("namespace", namespace).WithField("name", name)
 
-				continue
-			}
-			if err != nil {
-				log.WithError(err).WithField("name", metadata.GetName()).Error("Error backing up item")
-				continue
-			}
+		resourceClient, err := rb.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
+		if err != nil {
+			log.WithError(err).Error("Error getting dynamic client")
+			continue
 		}
+
+		log.Info("Getting item")
+		item, err := resourceClient.Get(name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			log.Info("Skipping item because it doesn't exist")
+			continue
+		}
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error getting item")
+			continue
+		}
+
+		if labelSelector != nil && !labelSelector.Matches(labels.Set(item.GetLabels())) {
+			log.Info("Skipping item because it does not match the backup's label selector")
+			continue
+		}
+
+		items = append(items, item)
 	}
 
-	return nil
+	return items
+}
+
+// filterItemsByName returns the items that are named by names, formatted as
+// namespace/name for namespaced items, which may contain wildcards.
+func filterItemsByName(items []runtime.Object, names []string) []runtime.Object {
+	var res []runtime.Object
+	for _, item := range items {
+		metadata, err := meta.Accessor(item)
+		if err != nil {
+			continue
+		}
+		if matchesResourceNames(names, metadata.GetNamespace(), metadata.GetName()) {
+			res = append(res, item)
+		}
+	}
+
+	return res
 }
 
 // getNamespacesToList examines ie and resolves the includes and excludes to a full list of
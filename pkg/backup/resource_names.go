@@ -0,0 +1,115 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// getResourceNames resolves the resources of a backup's
+// spec.includedResourceNames to fully-qualified group-resource names with
+// resolve. Resources that can't be resolved are logged and ignored.
+func getResourceNames(log logrus.FieldLogger, includedResourceNames map[string][]string, resolve func(string) string) map[string][]string {
+	names := make(map[string][]string)
+	for resource, patterns := range includedResourceNames {
+		groupResource := resolve(resource)
+		if groupResource == "" {
+			log.Warnf("Ignoring the included names of resource %s because it isn't a known resource", resource)
+			continue
+		}
+		names[groupResource] = append(names[groupResource], patterns...)
+	}
+
+	return names
+}
+
+// namedResources returns the resources of a backup's
+// spec.includedResourceNames, sorted, to include in the backup if it doesn't
+// include resources explicitly.
+func namedResources(includedResourceNames map[string][]string) []string {
+	resources := make([]string, 0, len(includedResourceNames))
+	for resource := range includedResourceNames {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	return resources
+}
+
+// matchesResourceNames returns whether an item is named by one of patterns,
+// which are formatted as namespace/name for namespaced items and may contain
+// wildcards.
+func matchesResourceNames(patterns []string, namespace, name string) bool {
+	if namespace != "" {
+		name = namespace + "/" + name
+	}
+
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// exactResourceNames returns whether none of patterns contain wildcards, so
+// that the items they name can be got instead of listing all of the items of
+// their resource.
+func exactResourceNames(patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.ContainsAny(pattern, `*?[\`) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitResourceName splits an item name formatted as namespace/name into its
+// namespace and name. Names of cluster-scoped items have no namespace.
+func splitResourceName(name string) (namespace, itemName string) {
+	if i := strings.Index(name, "/"); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// validateResourceNames returns an error for each invalid pattern in a
+// backup's spec.includedResourceNames.
+func validateResourceNames(includedResourceNames map[string][]string) []error {
+	var errs []error
+	for _, resource := range namedResources(includedResourceNames) {
+		if resource == "" {
+			errs = append(errs, errors.New("resources must not be empty"))
+		}
+		for _, pattern := range includedResourceNames[resource] {
+			if pattern == "" {
+				errs = append(errs, errors.Errorf("names of resource %s must not be empty", resource))
+				continue
+			}
+			if _, err := path.Match(pattern, ""); err != nil {
+				errs = append(errs, errors.Errorf("name %q of resource %s is an invalid pattern", pattern, resource))
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesResourceNames(t *testing.T) {
+	patterns := []string{"ns-1/creds", "*/db-*", "pv-?"}
+
+	assert.True(t, matchesResourceNames(patterns, "ns-1", "creds"))
+	assert.False(t, matchesResourceNames(patterns, "ns-2", "creds"))
+	assert.True(t, matchesResourceNames(patterns, "ns-2", "db-0"))
+	assert.True(t, matchesResourceNames(patterns, "", "pv-1"))
+	assert.False(t, matchesResourceNames(patterns, "", "pv-10"))
+	assert.False(t, matchesResourceNames(patterns, "", "creds"))
+}
+
+func TestExactResourceNames(t *testing.T) {
+	assert.True(t, exactResourceNames([]string{"ns-1/creds", "pv-1"}))
+	assert.False(t, exactResourceNames([]string{"ns-1/creds", "ns-1/db-*"}))
+	assert.False(t, exactResourceNames([]string{"pv-[12]"}))
+}
+
+func TestSplitResourceName(t *testing.T) {
+	namespace, name := splitResourceName("ns-1/creds")
+	assert.Equal(t, "ns-1", namespace)
+	assert.Equal(t, "creds", name)
+
+	namespace, name = splitResourceName("pv-1")
+	assert.Equal(t, "", namespace)
+	assert.Equal(t, "pv-1", name)
+}
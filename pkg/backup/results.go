@@ -0,0 +1,112 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+)
+
+// maxHookStderrLength is the maximum length of the excerpt of a hook's
+// stderr that's recorded in a backup's results.
+const maxHookStderrLength = 1024
+
+// Results are the details of a backup that are uploaded to object storage
+// alongside it, and shown by `velero backup describe --details`.
+type Results struct {
+	// Hooks are the exec hooks that were run on pods, in the order they
+	// were run.
+	Hooks []HookExecution `json:"hooks"`
+}
+
+// HookExecution records an exec hook that was run on a pod during a backup.
+type HookExecution struct {
+	// Namespace and Pod identify the pod that the hook was run on.
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+
+	// Hook is the name of the backup spec's resource hook that the hook is
+	// part of, or <from-annotation> if it's from the pod's annotations.
+	Hook string `json:"hook"`
+
+	// Phase is pre or post.
+	Phase string `json:"phase"`
+
+	Container string   `json:"container"`
+	Command   []string `json:"command"`
+
+	// Duration is how long the hook took to run.
+	Duration metav1.Duration `json:"duration"`
+
+	// ExitStatus is the command's exit status. It's nil if it isn't known,
+	// e.g. because the hook timed out.
+	ExitStatus *int `json:"exitStatus,omitempty"`
+
+	// Error is why the hook failed, if it did.
+	Error string `json:"error,omitempty"`
+
+	// Stderr is the end of what a failed hook wrote to stderr.
+	Stderr string `json:"stderr,omitempty"`
+}
+
+// Failed returns whether the hook failed.
+func (e *HookExecution) Failed() bool {
+	return e.Error != ""
+}
+
+// newHookExecution returns the record of a hook that was run on a pod, and
+// returned err.
+func newHookExecution(namespace, pod, hookName string, phase hookPhase, hook *velerov1api.ExecHook, duration time.Duration, err error) HookExecution {
+	execution := HookExecution{
+		Namespace: namespace,
+		Pod:       pod,
+		Hook:      hookName,
+		Phase:     string(phase),
+		Container: hook.Container,
+		Command:   hook.Command,
+		Duration:  metav1.Duration{Duration: duration},
+	}
+
+	if err == nil {
+		exitStatus := 0
+		execution.ExitStatus = &exitStatus
+		return execution
+	}
+
+	execution.Error = err.Error()
+	if commandErr, ok := err.(*podexec.CommandError); ok {
+		if exitStatus, known := commandErr.ExitStatus(); known {
+			execution.ExitStatus = &exitStatus
+		}
+		execution.Stderr = stderrExcerpt(commandErr.Stderr)
+	}
+
+	return execution
+}
+
+// stderrExcerpt returns the end of stderr, which is where errors are usually
+// written, if it's too long to record in full.
+func stderrExcerpt(stderr string) string {
+	if len(stderr) <= maxHookStderrLength {
+		return stderr
+	}
+	return "..." + stderr[len(stderr)-maxHookStderrLength:]
+}
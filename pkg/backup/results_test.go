@@ -0,0 +1,90 @@
+/*
+Copyright 2017 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	utilexec "k8s.io/client-go/util/exec"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+)
+
+func TestNewHookExecution(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name     string
+		err      error
+		expected HookExecution
+	}{
+		{
+			name: "hook that succeeded has a zero exit status",
+			expected: HookExecution{
+				ExitStatus: intPtr(0),
+			},
+		},
+		{
+			name: "hook that exited with a non-zero status has its status and stderr",
+			err:  &podexec.CommandError{Err: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 2"), Code: 2}, Stderr: "no such file\n"},
+			expected: HookExecution{
+				ExitStatus: intPtr(2),
+				Error:      "command terminated with exit code 2",
+				Stderr:     "no such file\n",
+			},
+		},
+		{
+			name: "hook that timed out has no exit status",
+			err:  errors.New("timed out after 30s"),
+			expected: HookExecution{
+				Error: "timed out after 30s",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hook := &v1.ExecHook{Container: "c", Command: []string{"/bin/fsfreeze", "--freeze"}}
+
+			tc.expected.Namespace = "ns"
+			tc.expected.Pod = "pod"
+			tc.expected.Hook = "hook"
+			tc.expected.Phase = "pre"
+			tc.expected.Container = "c"
+			tc.expected.Command = []string{"/bin/fsfreeze", "--freeze"}
+			tc.expected.Duration.Duration = time.Second
+
+			execution := newHookExecution("ns", "pod", "hook", hookPhasePre, hook, time.Second, tc.err)
+			assert.Equal(t, tc.expected, execution)
+			assert.Equal(t, tc.err != nil, execution.Failed())
+		})
+	}
+}
+
+func TestStderrExcerpt(t *testing.T) {
+	assert.Equal(t, "short", stderrExcerpt("short"))
+
+	long := strings.Repeat("a", maxHookStderrLength) + "the end"
+	excerpt := stderrExcerpt(long)
+	assert.Equal(t, "..."+long[len(long)-maxHookStderrLength:], excerpt)
+	assert.True(t, strings.HasSuffix(excerpt, "the end"))
+}
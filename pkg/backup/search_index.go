@@ -0,0 +1,108 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SearchIndexEntry is an entry in a backup's search index, describing an item
+// in the backup and where it's stored in the backup tarball.
+type SearchIndexEntry struct {
+	// Resource is the group-qualified resource of the item, e.g. "deployments.apps".
+	Resource string `json:"resource"`
+
+	// Kind is the kind of the item, e.g. "Deployment".
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the item, or empty if it's cluster-scoped.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Labels are the item's labels.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Path is the path of the item in the backup tarball.
+	Path string `json:"path"`
+}
+
+// SearchQuery matches entries in backup search indexes.
+type SearchQuery struct {
+	// Kind matches the entry's kind, resource, or resource without its
+	// group, case-insensitively. An empty Kind matches all entries.
+	Kind string
+
+	// Name is a glob pattern matching the entry's name. An empty Name
+	// matches all entries.
+	Name string
+
+	// Namespace is a glob pattern matching the entry's namespace. An empty
+	// Namespace matches all entries.
+	Namespace string
+
+	// Selector matches the entry's labels. A nil Selector matches all entries.
+	Selector labels.Selector
+}
+
+// Validate returns an error if the query's patterns are invalid.
+func (q *SearchQuery) Validate() error {
+	for _, pattern := range []string{q.Name, q.Namespace} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if entry matches all of the query's criteria.
+func (q *SearchQuery) Matches(entry SearchIndexEntry) bool {
+	if q.Kind != "" && !matchesKind(q.Kind, entry) {
+		return false
+	}
+
+	if q.Name != "" {
+		if match, _ := path.Match(q.Name, entry.Name); !match {
+			return false
+		}
+	}
+
+	if q.Namespace != "" {
+		if match, _ := path.Match(q.Namespace, entry.Namespace); !match {
+			return false
+		}
+	}
+
+	if q.Selector != nil && !q.Selector.Matches(labels.Set(entry.Labels)) {
+		return false
+	}
+
+	return true
+}
+
+func matchesKind(kind string, entry SearchIndexEntry) bool {
+	resource := strings.SplitN(entry.Resource, ".", 2)[0]
+
+	return strings.EqualFold(kind, entry.Kind) ||
+		strings.EqualFold(kind, entry.Resource) ||
+		strings.EqualFold(kind, resource)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestSearchQueryMatches(t *testing.T) {
+	entry := SearchIndexEntry{
+		Resource:  "deployments.apps",
+		Kind:      "Deployment",
+		Namespace: "prod",
+		Name:      "mysql-db",
+		Labels:    map[string]string{"app": "mysql"},
+	}
+
+	tests := []struct {
+		name     string
+		query    SearchQuery
+		expected bool
+	}{
+		{
+			name:     "empty query matches everything",
+			expected: true,
+		},
+		{
+			name:     "kind matches case-insensitively",
+			query:    SearchQuery{Kind: "deployment"},
+			expected: true,
+		},
+		{
+			name:     "kind matches group-qualified resource",
+			query:    SearchQuery{Kind: "deployments.apps"},
+			expected: true,
+		},
+		{
+			name:     "kind matches resource without group",
+			query:    SearchQuery{Kind: "deployments"},
+			expected: true,
+		},
+		{
+			name:     "different kind doesn't match",
+			query:    SearchQuery{Kind: "secret"},
+			expected: false,
+		},
+		{
+			name:     "name glob matches",
+			query:    SearchQuery{Name: "*db*"},
+			expected: true,
+		},
+		{
+			name:     "name glob doesn't match",
+			query:    SearchQuery{Name: "redis-*"},
+			expected: false,
+		},
+		{
+			name:     "namespace glob matches",
+			query:    SearchQuery{Namespace: "pr?d"},
+			expected: true,
+		},
+		{
+			name:     "namespace glob doesn't match",
+			query:    SearchQuery{Namespace: "staging"},
+			expected: false,
+		},
+		{
+			name:     "selector matches",
+			query:    SearchQuery{Selector: labels.SelectorFromSet(labels.Set{"app": "mysql"})},
+			expected: true,
+		},
+		{
+			name:     "selector doesn't match",
+			query:    SearchQuery{Selector: labels.SelectorFromSet(labels.Set{"app": "redis"})},
+			expected: false,
+		},
+		{
+			name:     "all criteria must match",
+			query:    SearchQuery{Kind: "deployment", Name: "*db*", Namespace: "staging"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.query.Validate())
+			assert.Equal(t, tc.expected, tc.query.Matches(entry))
+		})
+	}
+}
+
+func TestSearchQueryValidate(t *testing.T) {
+	assert.Error(t, (&SearchQuery{Name: "[db"}).Validate())
+	assert.Error(t, (&SearchQuery{Namespace: "[prod"}).Validate())
+}
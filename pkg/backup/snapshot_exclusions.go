@@ -0,0 +1,88 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// snapshotExclusions keeps track of which persistent volumes should not be snapshotted,
+// based on a backup's excluded snapshot namespaces and label selector.
+type snapshotExclusions struct {
+	namespaces sets.String
+	selector   labels.Selector
+	// claims are the PVCs that match the selector and have been backed up.
+	claims sets.String
+}
+
+func newSnapshotExclusions(spec velerov1api.BackupSpec) (*snapshotExclusions, error) {
+	// a nil label selector means nothing is excluded by label, which is what
+	// metav1.LabelSelectorAsSelector returns for it.
+	selector, err := metav1.LabelSelectorAsSelector(spec.ExcludedSnapshotLabelSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid excluded snapshot label selector")
+	}
+
+	return &snapshotExclusions{
+		namespaces: sets.NewString(spec.ExcludedSnapshotNamespaces...),
+		selector:   selector,
+		claims:     sets.NewString(),
+	}, nil
+}
+
+// TrackClaim records a PVC that's being backed up, so that the PV it claims
+// isn't snapshotted if the PVC matches the excluded snapshot label selector.
+func (e *snapshotExclusions) TrackClaim(pvc metav1.Object) {
+	if e == nil {
+		return
+	}
+
+	if e.selector.Matches(labels.Set(pvc.GetLabels())) {
+		e.claims.Insert(key(pvc.GetNamespace(), pvc.GetName()))
+	}
+}
+
+// Excludes returns true, along with the reason, if the PV should not be snapshotted.
+func (e *snapshotExclusions) Excludes(pv *corev1api.PersistentVolume) (bool, string) {
+	if e == nil {
+		return false, ""
+	}
+
+	if e.selector.Matches(labels.Set(pv.Labels)) {
+		return true, "persistent volume matches the backup's excluded snapshot label selector"
+	}
+
+	if pv.Spec.ClaimRef == nil {
+		return false, ""
+	}
+
+	if e.namespaces.Has(pv.Spec.ClaimRef.Namespace) {
+		return true, "persistent volume is claimed by a PVC in an excluded snapshot namespace"
+	}
+
+	if e.claims.Has(key(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)) {
+		return true, "persistent volume is claimed by a PVC that matches the backup's excluded snapshot label selector"
+	}
+
+	return false, ""
+}
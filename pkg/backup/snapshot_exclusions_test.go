@@ -0,0 +1,99 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestSnapshotExclusions(t *testing.T) {
+	spec := velerov1api.BackupSpec{
+		ExcludedSnapshotNamespaces: []string{"ns-1"},
+		ExcludedSnapshotLabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"snapshot": "false"},
+		},
+	}
+
+	exclusions, err := newSnapshotExclusions(spec)
+	require.NoError(t, err)
+
+	exclusions.TrackClaim(builder.ForPersistentVolumeClaim("ns-2", "pvc-excluded").ObjectMeta(builder.WithLabels("snapshot", "false")).Result())
+	exclusions.TrackClaim(builder.ForPersistentVolumeClaim("ns-2", "pvc-included").Result())
+
+	tests := []struct {
+		name string
+		pv   *builder.PersistentVolumeBuilder
+		want bool
+	}{
+		{
+			name: "unclaimed PV without labels is snapshotted",
+			pv:   builder.ForPersistentVolume("pv-1"),
+			want: false,
+		},
+		{
+			name: "PV matching the label selector is excluded",
+			pv:   builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("snapshot", "false")),
+			want: true,
+		},
+		{
+			name: "PV claimed in an excluded namespace is excluded",
+			pv:   builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1"),
+			want: true,
+		},
+		{
+			name: "PV claimed by a PVC matching the label selector is excluded",
+			pv:   builder.ForPersistentVolume("pv-1").ClaimRef("ns-2", "pvc-excluded"),
+			want: true,
+		},
+		{
+			name: "PV claimed by a PVC not matching the label selector is snapshotted",
+			pv:   builder.ForPersistentVolume("pv-1").ClaimRef("ns-2", "pvc-included"),
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			excluded, _ := exclusions.Excludes(tc.pv.Result())
+			assert.Equal(t, tc.want, excluded)
+		})
+	}
+}
+
+func TestSnapshotExclusionsWithoutSelector(t *testing.T) {
+	exclusions, err := newSnapshotExclusions(velerov1api.BackupSpec{})
+	require.NoError(t, err)
+
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels("foo", "bar")).Result()
+	exclusions.TrackClaim(pvc)
+
+	excluded, _ := exclusions.Excludes(builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("foo", "bar")).ClaimRef("ns-1", "pvc-1").Result())
+	assert.False(t, excluded)
+
+	// a nil exclusions excludes nothing
+	var nilExclusions *snapshotExclusions
+	nilExclusions.TrackClaim(pvc)
+	excluded, _ = nilExclusions.Excludes(builder.ForPersistentVolume("pv-1").Result())
+	assert.False(t, excluded)
+}
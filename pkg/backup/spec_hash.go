@@ -0,0 +1,109 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// SpecHashVersion is the version of the spec hashes that SpecHash returns,
+// which is stored in a backup's velero.io/backup-spec-hash-version annotation
+// next to its hash, so that the hash can be recomputed the same way even
+// after the fields that are hashed change.
+const SpecHashVersion = "1"
+
+// specHashV1 is the subset of a backup's spec that version 1 spec hashes are
+// computed over. Fields that are added to BackupSpec later aren't hashed
+// unless they're added to a new version, so that adding them doesn't change
+// the hashes of existing backups. The storage location isn't included, since
+// it's rewritten when the backup is synced into a cluster whose name for the
+// location is different.
+type specHashV1 struct {
+	IncludedNamespaces            []string                             `json:"includedNamespaces"`
+	ExcludedNamespaces            []string                             `json:"excludedNamespaces"`
+	NamespaceSelector             *metav1.LabelSelector                `json:"namespaceSelector"`
+	IncludedResources             []string                             `json:"includedResources"`
+	ExcludedResources             []string                             `json:"excludedResources"`
+	IncludedResourceNames         map[string][]string                  `json:"includedResourceNames"`
+	LabelSelector                 *metav1.LabelSelector                `json:"labelSelector"`
+	IncludeClusterResources       *bool                                `json:"includeClusterResources"`
+	OrderedResources              map[string]string                    `json:"orderedResources"`
+	SnapshotVolumes               *bool                                `json:"snapshotVolumes"`
+	ExcludedSnapshotNamespaces    []string                             `json:"excludedSnapshotNamespaces"`
+	ExcludedSnapshotLabelSelector *metav1.LabelSelector                `json:"excludedSnapshotLabelSelector"`
+	VolumeSnapshotLocations       []string                             `json:"volumeSnapshotLocations"`
+	PodVolumeBackupSelectors      []metav1.LabelSelector               `json:"podVolumeBackupSelectors"`
+	DefaultVolumesToRestic        *bool                                `json:"defaultVolumesToRestic"`
+	Hooks                         []velerov1api.BackupResourceHookSpec `json:"hooks"`
+	TTL                           metav1.Duration                      `json:"ttl"`
+	Cluster                       string                               `json:"cluster"`
+}
+
+// SpecHash returns the hex-encoded SHA-256 hash of a backup's spec, of the
+// current SpecHashVersion, which is stored in the backup's
+// velero.io/backup-spec-hash annotation to detect changes to the spec after
+// the backup is taken.
+func SpecHash(spec velerov1api.BackupSpec) (string, error) {
+	return SpecHashForVersion(spec, SpecHashVersion)
+}
+
+// SpecHashForVersion returns the hex-encoded SHA-256 hash of a backup's spec,
+// computed the way the given version of spec hashes are.
+func SpecHashForVersion(spec velerov1api.BackupSpec, version string) (string, error) {
+	var fields interface{}
+
+	switch version {
+	case "1":
+		fields = specHashV1{
+			IncludedNamespaces:            spec.IncludedNamespaces,
+			ExcludedNamespaces:            spec.ExcludedNamespaces,
+			NamespaceSelector:             spec.NamespaceSelector,
+			IncludedResources:             spec.IncludedResources,
+			ExcludedResources:             spec.ExcludedResources,
+			IncludedResourceNames:         spec.IncludedResourceNames,
+			LabelSelector:                 spec.LabelSelector,
+			IncludeClusterResources:       spec.IncludeClusterResources,
+			OrderedResources:              spec.OrderedResources,
+			SnapshotVolumes:               spec.SnapshotVolumes,
+			ExcludedSnapshotNamespaces:    spec.ExcludedSnapshotNamespaces,
+			ExcludedSnapshotLabelSelector: spec.ExcludedSnapshotLabelSelector,
+			VolumeSnapshotLocations:       spec.VolumeSnapshotLocations,
+			PodVolumeBackupSelectors:      spec.PodVolumeBackupSelectors,
+			DefaultVolumesToRestic:        spec.DefaultVolumesToRestic,
+			Hooks:                         spec.Hooks.Resources,
+			TTL:                           spec.TTL,
+			Cluster:                       spec.Cluster,
+		}
+	default:
+		return "", errors.Errorf("unknown backup spec hash version %q", version)
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", errors.Wrap(err, "error encoding backup spec")
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
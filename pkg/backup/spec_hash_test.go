@@ -0,0 +1,48 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestSpecHashForVersion(t *testing.T) {
+	spec := builder.ForBackup("ns-1", "backup").IncludedNamespaces("ns-a").TTL(time.Hour).Result().Spec
+
+	// version 1 hashes must never change, or every existing backup would
+	// be flagged as drifted.
+	hash, err := SpecHashForVersion(spec, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "8d4059d81308202d13d8ac953236c455f3abf395649a6fc69895ba83be0b6dfa", hash)
+
+	// fields outside of the hashed subset don't change the hash.
+	spec.StorageLocation = "location-1"
+	spec.SearchIndex = true
+	spec.IncludeEventsAndPodLogs = true
+	unchanged, err := SpecHashForVersion(spec, "1")
+	require.NoError(t, err)
+	assert.Equal(t, hash, unchanged)
+
+	_, err = SpecHashForVersion(spec, "0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// ValidateSpec validates the parts of a backup spec that don't depend on the
+// state of the cluster, returning a message for each problem found.
+func ValidateSpec(spec velerov1api.BackupSpec) []string {
+	var errs []string
+
+	for _, err := range collections.ValidateIncludesExcludes(spec.IncludedResources, spec.ExcludedResources) {
+		errs = append(errs, fmt.Sprintf("Invalid included/excluded resource lists: %v", err))
+	}
+
+	for _, err := range collections.ValidateIncludesExcludes(spec.IncludedNamespaces, spec.ExcludedNamespaces) {
+		errs = append(errs, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
+	}
+
+	for _, err := range validateResourceNames(spec.IncludedResourceNames) {
+		errs = append(errs, fmt.Sprintf("Invalid included resource names: %v", err))
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(spec.LabelSelector); err != nil {
+		errs = append(errs, fmt.Sprintf("Invalid label selector: %v", err))
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector); err != nil {
+		errs = append(errs, fmt.Sprintf("Invalid namespace selector: %v", err))
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(spec.ExcludedSnapshotLabelSelector); err != nil {
+		errs = append(errs, fmt.Sprintf("Invalid excluded snapshot label selector: %v", err))
+	}
+
+	for i := range spec.PodVolumeBackupSelectors {
+		if _, err := metav1.LabelSelectorAsSelector(&spec.PodVolumeBackupSelectors[i]); err != nil {
+			errs = append(errs, fmt.Sprintf("Invalid pod volume backup selector: %v", err))
+		}
+	}
+
+	if spec.TTL.Duration < 0 {
+		errs = append(errs, "TTL must not be negative")
+	}
+
+	if spec.LogTTL.Duration < 0 {
+		errs = append(errs, "Log TTL must not be negative")
+	}
+
+	if spec.MaxItems != nil && *spec.MaxItems < 0 {
+		errs = append(errs, "Max items must not be negative")
+	}
+
+	if spec.MaxBytes != nil && *spec.MaxBytes < 0 {
+		errs = append(errs, "Max bytes must not be negative")
+	}
+
+	return errs
+}
+
+// ParseCronSchedule parses a schedule's cron expression.
+func ParseCronSchedule(expression string) (schedule cron.Schedule, err error) {
+	// cron.Parse panics if schedule is empty
+	if len(expression) == 0 {
+		return nil, errors.New("Schedule must be a non-empty valid Cron expression")
+	}
+
+	// adding a recover() around cron.Parse because it panics on empty string and is possible
+	// that it panics under other scenarios as well.
+	defer func() {
+		if r := recover(); r != nil {
+			schedule, err = nil, errors.Errorf("invalid schedule: %v", r)
+		}
+	}()
+
+	res, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, errors.Errorf("invalid schedule: %v", err)
+	}
+
+	return res, nil
+}
+
+// ParseTimezone parses a schedule's time zone, which is a name from the IANA
+// time zone database. The empty time zone is the local time zone.
+func ParseTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.Local, nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.Errorf("invalid timezone %q: %v", name, err)
+	}
+
+	return location, nil
+}
+
+// InLocation returns a cron schedule whose times are evaluated in a location,
+// so that e.g. "0 9 * * *" is 9am there.
+func InLocation(schedule cron.Schedule, location *time.Location) cron.Schedule {
+	return &locatedSchedule{schedule: schedule, location: location}
+}
+
+type locatedSchedule struct {
+	schedule cron.Schedule
+	location *time.Location
+}
+
+func (s *locatedSchedule) Next(t time.Time) time.Time {
+	return s.schedule.Next(t.In(s.location))
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestValidateSpec(t *testing.T) {
+	tests := []struct {
+		name string
+		spec velerov1api.BackupSpec
+		want []string
+	}{
+		{
+			name: "empty spec is valid",
+			spec: velerov1api.BackupSpec{},
+			want: nil,
+		},
+		{
+			name: "resource in both includes and excludes is invalid",
+			spec: velerov1api.BackupSpec{
+				IncludedResources: []string{"pods"},
+				ExcludedResources: []string{"pods"},
+			},
+			want: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: pods"},
+		},
+		{
+			name: "invalid selectors are invalid",
+			spec: velerov1api.BackupSpec{
+				LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bogus"}},
+				},
+				ExcludedSnapshotLabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "not valid"},
+				},
+			},
+			want: []string{
+				`Invalid label selector: "Bogus" is not a valid pod selector operator`,
+				`Invalid excluded snapshot label selector: invalid label value: "not valid": at key: "app": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')`,
+			},
+		},
+		{
+			name: "negative TTLs are invalid",
+			spec: velerov1api.BackupSpec{
+				TTL:    metav1.Duration{Duration: -time.Hour},
+				LogTTL: metav1.Duration{Duration: -time.Hour},
+			},
+			want: []string{"TTL must not be negative", "Log TTL must not be negative"},
+		},
+		{
+			name: "invalid included resource names",
+			spec: velerov1api.BackupSpec{
+				IncludedResourceNames: map[string][]string{
+					"":        {"ns-1/creds"},
+					"secrets": {"", "ns-1/[creds"},
+				},
+			},
+			want: []string{
+				"Invalid included resource names: resources must not be empty",
+				"Invalid included resource names: names of resource secrets must not be empty",
+				`Invalid included resource names: name "ns-1/[creds" of resource secrets is an invalid pattern`,
+			},
+		},
+		{
+			name: "negative limits are invalid",
+			spec: builder.ForBackup("velero", "backup-1").MaxItems(-1).MaxBytes(-1).Result().Spec,
+			want: []string{"Max items must not be negative", "Max bytes must not be negative"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ValidateSpec(tc.spec))
+		})
+	}
+}
+
+func TestParseCronSchedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{
+			name:       "standard cron expression is valid",
+			expression: "0 */6 * * *",
+		},
+		{
+			name:       "@every expression is valid",
+			expression: "@every 2h30m",
+		},
+		{
+			name:       "empty expression is invalid",
+			expression: "",
+			wantErr:    "Schedule must be a non-empty valid Cron expression",
+		},
+		{
+			name:       "malformed expression is invalid",
+			expression: "0 * * *",
+			wantErr:    "invalid schedule: Expected exactly 5 fields, found 4: 0 * * *",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := ParseCronSchedule(tc.expression)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.Nil(t, schedule)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, schedule)
+		})
+	}
+}
+
+func TestParseTimezone(t *testing.T) {
+	location, err := ParseTimezone("")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Local, location)
+
+	location, err = ParseTimezone("Europe/Berlin")
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", location.String())
+
+	_, err = ParseTimezone("Europe/Atlantis")
+	assert.EqualError(t, err, `invalid timezone "Europe/Atlantis": unknown time zone Europe/Atlantis`)
+}
+
+func TestInLocation(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	schedule, err := ParseCronSchedule("0 3 * * *")
+	require.NoError(t, err)
+
+	// 2019-10-01 00:00 UTC is 09:00 in Tokyo, so 3am Tokyo time is next at
+	// 18:00 UTC.
+	next := InLocation(schedule, tokyo).Next(time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC))
+	assert.True(t, next.Equal(time.Date(2019, 10, 1, 18, 0, 0, 0, time.UTC)), "next is %v", next)
+}
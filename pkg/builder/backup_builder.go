@@ -122,6 +122,12 @@ func (b *BackupBuilder) LabelSelector(selector *metav1.LabelSelector) *BackupBui
 	return b
 }
 
+// NamespaceSelector sets the Backup's namespace selector.
+func (b *BackupBuilder) NamespaceSelector(selector *metav1.LabelSelector) *BackupBuilder {
+	b.object.Spec.NamespaceSelector = selector
+	return b
+}
+
 // SnapshotVolumes sets the Backup's "snapshot volumes" flag.
 func (b *BackupBuilder) SnapshotVolumes(val bool) *BackupBuilder {
 	b.object.Spec.SnapshotVolumes = &val
@@ -140,30 +146,162 @@ func (b *BackupBuilder) StorageLocation(location string) *BackupBuilder {
 	return b
 }
 
+// Cluster sets the Backup's member cluster.
+func (b *BackupBuilder) Cluster(cluster string) *BackupBuilder {
+	b.object.Spec.Cluster = cluster
+	return b
+}
+
 // VolumeSnapshotLocations sets the Backup's volume snapshot locations.
 func (b *BackupBuilder) VolumeSnapshotLocations(locations ...string) *BackupBuilder {
 	b.object.Spec.VolumeSnapshotLocations = locations
 	return b
 }
 
+// ExcludedSnapshotNamespaces sets the Backup's namespaces whose PVs are not snapshotted.
+func (b *BackupBuilder) ExcludedSnapshotNamespaces(namespaces ...string) *BackupBuilder {
+	b.object.Spec.ExcludedSnapshotNamespaces = namespaces
+	return b
+}
+
+// ExcludedSnapshotLabelSelector sets the Backup's label selector for PVs that are not snapshotted.
+func (b *BackupBuilder) ExcludedSnapshotLabelSelector(selector *metav1.LabelSelector) *BackupBuilder {
+	b.object.Spec.ExcludedSnapshotLabelSelector = selector
+	return b
+}
+
+// PodVolumeBackupSelectors sets the Backup's label selectors for pods whose volumes are backed up with restic.
+func (b *BackupBuilder) PodVolumeBackupSelectors(selectors ...metav1.LabelSelector) *BackupBuilder {
+	b.object.Spec.PodVolumeBackupSelectors = selectors
+	return b
+}
+
+// DefaultVolumesToRestic sets the Backup's "default volumes to restic" flag.
+func (b *BackupBuilder) DefaultVolumesToRestic(val bool) *BackupBuilder {
+	b.object.Spec.DefaultVolumesToRestic = &val
+	return b
+}
+
+// AdditionalStorageLocations sets the Backup's additional storage locations.
+func (b *BackupBuilder) AdditionalStorageLocations(locations ...string) *BackupBuilder {
+	b.object.Spec.AdditionalStorageLocations = locations
+	return b
+}
+
+// Version sets the Backup's format version.
+func (b *BackupBuilder) Version(val int) *BackupBuilder {
+	b.object.Status.Version = val
+	return b
+}
+
+// VeleroVersion sets the version of Velero that created the Backup.
+func (b *BackupBuilder) VeleroVersion(val string) *BackupBuilder {
+	b.object.Status.VeleroVersion = val
+	return b
+}
+
+// TarballSizeBytes sets the Backup's tarball size.
+func (b *BackupBuilder) TarballSizeBytes(val int64) *BackupBuilder {
+	b.object.Status.TarballSizeBytes = val
+	return b
+}
+
+// VolumeSnapshotsCompleted sets the Backup's number of completed volume snapshots.
+func (b *BackupBuilder) VolumeSnapshotsCompleted(val int) *BackupBuilder {
+	b.object.Status.VolumeSnapshotsCompleted = val
+	return b
+}
+
 // TTL sets the Backup's TTL.
 func (b *BackupBuilder) TTL(ttl time.Duration) *BackupBuilder {
 	b.object.Spec.TTL.Duration = ttl
 	return b
 }
 
+// MaxItems sets the Backup's maximum number of items.
+func (b *BackupBuilder) MaxItems(val int) *BackupBuilder {
+	b.object.Spec.MaxItems = &val
+	return b
+}
+
+// MaxBytes sets the Backup's maximum tarball size, in bytes.
+func (b *BackupBuilder) MaxBytes(val int64) *BackupBuilder {
+	b.object.Spec.MaxBytes = &val
+	return b
+}
+
+// SearchIndex sets the Backup's search index flag.
+func (b *BackupBuilder) SearchIndex(val bool) *BackupBuilder {
+	b.object.Spec.SearchIndex = val
+	return b
+}
+
+// OrderedResources sets the Backup's ordered resources.
+func (b *BackupBuilder) OrderedResources(orders map[string]string) *BackupBuilder {
+	b.object.Spec.OrderedResources = orders
+	return b
+}
+
+// IncludedResourceNames sets the Backup's included resource names.
+func (b *BackupBuilder) IncludedResourceNames(names map[string][]string) *BackupBuilder {
+	b.object.Spec.IncludedResourceNames = names
+	return b
+}
+
+// ReplicaPolicies sets the Backup's replica policies.
+func (b *BackupBuilder) ReplicaPolicies(policies ...velerov1api.ResourceReplicaPolicy) *BackupBuilder {
+	b.object.Spec.ReplicaPolicies = policies
+	return b
+}
+
+// IncludeEventsAndPodLogs sets the Backup's events and pod logs flag.
+func (b *BackupBuilder) IncludeEventsAndPodLogs(val bool) *BackupBuilder {
+	b.object.Spec.IncludeEventsAndPodLogs = val
+	return b
+}
+
+// LogTTL sets the Backup's log TTL.
+func (b *BackupBuilder) LogTTL(ttl time.Duration) *BackupBuilder {
+	b.object.Spec.LogTTL.Duration = ttl
+	return b
+}
+
 // Expiration sets the Backup's expiration.
 func (b *BackupBuilder) Expiration(val time.Time) *BackupBuilder {
 	b.object.Status.Expiration.Time = val
 	return b
 }
 
+// LogsExpiration sets the Backup's logs expiration.
+func (b *BackupBuilder) LogsExpiration(val time.Time) *BackupBuilder {
+	b.object.Status.LogsExpiration = &metav1.Time{Time: val}
+	return b
+}
+
+// LogsDeleted sets the Backup's logs deleted status.
+func (b *BackupBuilder) LogsDeleted(val bool) *BackupBuilder {
+	b.object.Status.LogsDeleted = val
+	return b
+}
+
 // StartTimestamp sets the Backup's start timestamp.
 func (b *BackupBuilder) StartTimestamp(val time.Time) *BackupBuilder {
 	b.object.Status.StartTimestamp.Time = val
 	return b
 }
 
+// PluginOperations sets the Backup's plugin operations.
+func (b *BackupBuilder) PluginOperations(operations ...velerov1api.PluginOperation) *BackupBuilder {
+	b.object.Status.PluginOperations = append(b.object.Status.PluginOperations, operations...)
+	return b
+}
+
+// Conditions sets the Backup's conditions.
+func (b *BackupBuilder) Conditions(conditions ...velerov1api.BackupCondition) *BackupBuilder {
+	b.object.Status.Conditions = append(b.object.Status.Conditions, conditions...)
+	return b
+}
+
 // Hooks sets the Backup's hooks.
 func (b *BackupBuilder) Hooks(hooks velerov1api.BackupHooks) *BackupBuilder {
 	b.object.Spec.Hooks = hooks
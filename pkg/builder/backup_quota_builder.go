@@ -0,0 +1,82 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupQuotaBuilder builds BackupQuota objects.
+type BackupQuotaBuilder struct {
+	object *velerov1api.BackupQuota
+}
+
+// ForBackupQuota is the constructor for a BackupQuotaBuilder.
+func ForBackupQuota(ns, name string) *BackupQuotaBuilder {
+	return &BackupQuotaBuilder{
+		object: &velerov1api.BackupQuota{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "BackupQuota",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built BackupQuota.
+func (b *BackupQuotaBuilder) Result() *velerov1api.BackupQuota {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the BackupQuota's ObjectMeta.
+func (b *BackupQuotaBuilder) ObjectMeta(opts ...ObjectMetaOpt) *BackupQuotaBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Namespace sets the namespace that the BackupQuota applies to.
+func (b *BackupQuotaBuilder) Namespace(namespace string) *BackupQuotaBuilder {
+	b.object.Spec.Namespace = namespace
+	return b
+}
+
+// MaxConcurrentBackups sets the BackupQuota's maximum number of new and in-progress backups.
+func (b *BackupQuotaBuilder) MaxConcurrentBackups(val int) *BackupQuotaBuilder {
+	b.object.Spec.MaxConcurrentBackups = &val
+	return b
+}
+
+// MaxStoredBytes sets the BackupQuota's maximum number of stored bytes.
+func (b *BackupQuotaBuilder) MaxStoredBytes(val int64) *BackupQuotaBuilder {
+	b.object.Spec.MaxStoredBytes = &val
+	return b
+}
+
+// MaxSnapshots sets the BackupQuota's maximum number of volume snapshots.
+func (b *BackupQuotaBuilder) MaxSnapshots(val int) *BackupQuotaBuilder {
+	b.object.Spec.MaxSnapshots = &val
+	return b
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package builder
 
 import (
+	"time"
+
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -86,3 +89,37 @@ func (b *BackupStorageLocationBuilder) AccessMode(accessMode velerov1api.BackupS
 	b.object.Spec.AccessMode = accessMode
 	return b
 }
+
+// OrphanedBackupPolicy sets the BackupStorageLocation's orphaned backup policy.
+func (b *BackupStorageLocationBuilder) OrphanedBackupPolicy(policy velerov1api.OrphanedBackupPolicy) *BackupStorageLocationBuilder {
+	b.object.Spec.OrphanedBackupPolicy = policy
+	return b
+}
+
+// ValidationFrequency sets the BackupStorageLocation's validation frequency.
+func (b *BackupStorageLocationBuilder) ValidationFrequency(frequency time.Duration) *BackupStorageLocationBuilder {
+	b.object.Spec.ValidationFrequency = &metav1.Duration{Duration: frequency}
+	return b
+}
+
+// Phase sets the BackupStorageLocation's status phase.
+func (b *BackupStorageLocationBuilder) Phase(phase velerov1api.BackupStorageLocationPhase) *BackupStorageLocationBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
+// LastValidationTime sets the BackupStorageLocation's last validation time.
+func (b *BackupStorageLocationBuilder) LastValidationTime(lastValidated time.Time) *BackupStorageLocationBuilder {
+	b.object.Status.LastValidationTime = metav1.Time{Time: lastValidated}
+	return b
+}
+
+// Credential sets the BackupStorageLocation's credential to key of the
+// secret named name.
+func (b *BackupStorageLocationBuilder) Credential(name, key string) *BackupStorageLocationBuilder {
+	b.object.Spec.Credential = &corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+		Key:                  key,
+	}
+	return b
+}
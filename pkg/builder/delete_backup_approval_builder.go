@@ -0,0 +1,70 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// DeleteBackupApprovalBuilder builds DeleteBackupApproval objects.
+type DeleteBackupApprovalBuilder struct {
+	object *velerov1api.DeleteBackupApproval
+}
+
+// ForDeleteBackupApproval is the constructor for a DeleteBackupApprovalBuilder.
+func ForDeleteBackupApproval(ns, name string) *DeleteBackupApprovalBuilder {
+	return &DeleteBackupApprovalBuilder{
+		object: &velerov1api.DeleteBackupApproval{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "DeleteBackupApproval",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built DeleteBackupApproval.
+func (b *DeleteBackupApprovalBuilder) Result() *velerov1api.DeleteBackupApproval {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the DeleteBackupApproval's ObjectMeta.
+func (b *DeleteBackupApprovalBuilder) ObjectMeta(opts ...ObjectMetaOpt) *DeleteBackupApprovalBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// BackupName sets the name of the backup whose deletion the DeleteBackupApproval approves.
+func (b *DeleteBackupApprovalBuilder) BackupName(name string) *DeleteBackupApprovalBuilder {
+	b.object.Spec.BackupName = name
+	return b
+}
+
+// Approver sets the DeleteBackupApproval's approver.
+func (b *DeleteBackupApprovalBuilder) Approver(approver string) *DeleteBackupApprovalBuilder {
+	b.object.Spec.Approver = approver
+	return b
+}
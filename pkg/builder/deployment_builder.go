@@ -55,3 +55,9 @@ func (b *DeploymentBuilder) ObjectMeta(opts ...ObjectMetaOpt) *DeploymentBuilder
 
 	return b
 }
+
+// Replicas sets the Deployment's replica count.
+func (b *DeploymentBuilder) Replicas(val int32) *DeploymentBuilder {
+	b.object.Spec.Replicas = &val
+	return b
+}
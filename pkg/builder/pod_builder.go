@@ -76,3 +76,17 @@ func (b *PodBuilder) InitContainers(containers ...*corev1api.Container) *PodBuil
 	}
 	return b
 }
+
+// Containers appends to the pod's containers.
+func (b *PodBuilder) Containers(containers ...*corev1api.Container) *PodBuilder {
+	for _, c := range containers {
+		b.object.Spec.Containers = append(b.object.Spec.Containers, *c)
+	}
+	return b
+}
+
+// RestartPolicy sets the pod's restart policy.
+func (b *PodBuilder) RestartPolicy(val corev1api.RestartPolicy) *PodBuilder {
+	b.object.Spec.RestartPolicy = val
+	return b
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package builder
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -63,12 +65,24 @@ func (b *RestoreBuilder) Backup(name string) *RestoreBuilder {
 	return b
 }
 
+// StorageLocation sets the name of the storage location the Restore's backup is restored from.
+func (b *RestoreBuilder) StorageLocation(name string) *RestoreBuilder {
+	b.object.Spec.StorageLocation = name
+	return b
+}
+
 // Schedule sets the Restore's schedule name.
 func (b *RestoreBuilder) Schedule(name string) *RestoreBuilder {
 	b.object.Spec.ScheduleName = name
 	return b
 }
 
+// PointInTime sets the point in time the Restore's schedule is restored to.
+func (b *RestoreBuilder) PointInTime(val time.Time) *RestoreBuilder {
+	b.object.Spec.PointInTime = &metav1.Time{Time: val}
+	return b
+}
+
 // IncludedNamespaces appends to the Restore's included namespaces.
 func (b *RestoreBuilder) IncludedNamespaces(namespaces ...string) *RestoreBuilder {
 	b.object.Spec.IncludedNamespaces = append(b.object.Spec.IncludedNamespaces, namespaces...)
@@ -122,12 +136,123 @@ func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	return b
 }
 
+// ZoneMappings sets the Restore's zone mappings.
+func (b *RestoreBuilder) ZoneMappings(mapping ...string) *RestoreBuilder {
+	if b.object.Spec.ZoneMapping == nil {
+		b.object.Spec.ZoneMapping = make(map[string]string)
+	}
+
+	if len(mapping)%2 != 0 {
+		panic("mapping must contain an even number of values")
+	}
+
+	for i := 0; i < len(mapping); i += 2 {
+		b.object.Spec.ZoneMapping[mapping[i]] = mapping[i+1]
+	}
+
+	return b
+}
+
+// VolumeOverrides sets the Restore's volume type and IOPS overrides.
+func (b *RestoreBuilder) VolumeOverrides(volumeType string, iops *int64) *RestoreBuilder {
+	b.object.Spec.VolumeOverrides = &velerov1api.VolumeOverrides{
+		VolumeType: volumeType,
+		IOPS:       iops,
+	}
+	return b
+}
+
 // Phase sets the Restore's phase.
 func (b *RestoreBuilder) Phase(phase velerov1api.RestorePhase) *RestoreBuilder {
 	b.object.Status.Phase = phase
 	return b
 }
 
+// DataOnly sets the Restore's data-only flag.
+func (b *RestoreBuilder) DataOnly(val bool) *RestoreBuilder {
+	b.object.Spec.DataOnly = val
+	return b
+}
+
+// NoApply sets the Restore's no-apply flag.
+func (b *RestoreBuilder) NoApply(val bool) *RestoreBuilder {
+	b.object.Spec.NoApply = val
+	return b
+}
+
+// AllowNewerBackupFormat sets the Restore's allow-newer-backup-format flag.
+func (b *RestoreBuilder) AllowNewerBackupFormat(val bool) *RestoreBuilder {
+	b.object.Spec.AllowNewerBackupFormat = val
+	return b
+}
+
+// SkipOwnerManaged sets the Restore's skip-owner-managed flag.
+func (b *RestoreBuilder) SkipOwnerManaged(val bool) *RestoreBuilder {
+	b.object.Spec.SkipOwnerManaged = val
+	return b
+}
+
+// WaitForUnboundPVCs sets the Restore's wait for unbound PVCs flag.
+func (b *RestoreBuilder) WaitForUnboundPVCs(val bool) *RestoreBuilder {
+	b.object.Spec.WaitForUnboundPVCs = val
+	return b
+}
+
+// RetainRestoredPVs sets the Restore's retain restored PVs flag.
+func (b *RestoreBuilder) RetainRestoredPVs(val bool) *RestoreBuilder {
+	b.object.Spec.RetainRestoredPVs = val
+	return b
+}
+
+// AdmissionWebhooks sets the Restore's admission webhook policy.
+func (b *RestoreBuilder) AdmissionWebhooks(policy velerov1api.AdmissionWebhookPolicy) *RestoreBuilder {
+	b.object.Spec.AdmissionWebhooks = policy
+	return b
+}
+
+// LoadBalancerServices sets the Restore's options for restoring the
+// annotations of Services of type LoadBalancer.
+func (b *RestoreBuilder) LoadBalancerServices(options *velerov1api.LoadBalancerServiceOptions) *RestoreBuilder {
+	b.object.Spec.LoadBalancerServices = options
+	return b
+}
+
+// ResourcePriorities sets the Restore's resource priorities.
+func (b *RestoreBuilder) ResourcePriorities(resources ...string) *RestoreBuilder {
+	b.object.Spec.ResourcePriorities = resources
+	return b
+}
+
+// ExistingResourcePolicy sets the Restore's existing resource policy.
+func (b *RestoreBuilder) ExistingResourcePolicy(policy velerov1api.ExistingResourcePolicy) *RestoreBuilder {
+	b.object.Spec.ExistingResourcePolicy = policy
+	return b
+}
+
+// IdempotencyToken sets the Restore's idempotency token.
+func (b *RestoreBuilder) IdempotencyToken(token string) *RestoreBuilder {
+	b.object.Spec.IdempotencyToken = token
+	return b
+}
+
+// RetryOf sets the name of the restore whose failed items the Restore retries.
+func (b *RestoreBuilder) RetryOf(name string) *RestoreBuilder {
+	b.object.Spec.RetryOf = name
+	return b
+}
+
+// AutoscaledReplicas sets the Restore's autoscaled replica policy.
+func (b *RestoreBuilder) AutoscaledReplicas(policy velerov1api.AutoscaledReplicaPolicy) *RestoreBuilder {
+	b.object.Spec.AutoscaledReplicas = policy
+	return b
+}
+
+// ReplicaPolicies sets the Restore's replica policies.
+func (b *RestoreBuilder) ReplicaPolicies(policies ...velerov1api.ResourceReplicaPolicy) *RestoreBuilder {
+	b.object.Spec.ReplicaPolicies = policies
+	return b
+}
+
 // RestorePVs sets the Restore's restore PVs.
 func (b *RestoreBuilder) RestorePVs(val bool) *RestoreBuilder {
 	b.object.Spec.RestorePVs = &val
@@ -77,6 +77,12 @@ func (b *ScheduleBuilder) CronSchedule(expression string) *ScheduleBuilder {
 	return b
 }
 
+// Timezone sets the Schedule's time zone.
+func (b *ScheduleBuilder) Timezone(name string) *ScheduleBuilder {
+	b.object.Spec.Timezone = name
+	return b
+}
+
 // LastBackupTime sets the Schedule's last backup time.
 func (b *ScheduleBuilder) LastBackupTime(val string) *ScheduleBuilder {
 	t, _ := time.Parse("2006-01-02 15:04:05", val)
@@ -89,3 +95,39 @@ func (b *ScheduleBuilder) Template(spec velerov1api.BackupSpec) *ScheduleBuilder
 	b.object.Spec.Template = spec
 	return b
 }
+
+// VerifyEvery sets the Schedule's verification interval.
+func (b *ScheduleBuilder) VerifyEvery(val int) *ScheduleBuilder {
+	b.object.Spec.VerifyEvery = val
+	return b
+}
+
+// BackupCount sets the Schedule's count of created backups.
+func (b *ScheduleBuilder) BackupCount(val int) *ScheduleBuilder {
+	b.object.Status.BackupCount = val
+	return b
+}
+
+// Clusters sets the Schedule's member clusters.
+func (b *ScheduleBuilder) Clusters(clusters ...string) *ScheduleBuilder {
+	b.object.Spec.Clusters = clusters
+	return b
+}
+
+// Retention sets the Schedule's retention policy.
+func (b *ScheduleBuilder) Retention(policy velerov1api.RetentionPolicy) *ScheduleBuilder {
+	b.object.Spec.Retention = &policy
+	return b
+}
+
+// Revisions appends to the Schedule's revisions of its backup template.
+func (b *ScheduleBuilder) Revisions(revisions ...velerov1api.ScheduleRevision) *ScheduleBuilder {
+	b.object.Status.Revisions = append(b.object.Status.Revisions, revisions...)
+	return b
+}
+
+// Paused sets whether the Schedule is paused.
+func (b *ScheduleBuilder) Paused(val bool) *ScheduleBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// DryRunConfig returns a copy of config whose clients send all mutating requests to
+// the Kubernetes API server as server-side dry-run requests, so that they're validated
+// and admitted but never persisted. Each mutating request is logged.
+func DryRunConfig(config *rest.Config, log logrus.FieldLogger) *rest.Config {
+	dryRunConfig := rest.CopyConfig(config)
+
+	wrapTransport := dryRunConfig.WrapTransport
+	dryRunConfig.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrapTransport != nil {
+			rt = wrapTransport(rt)
+		}
+		return &dryRunRoundTripper{delegate: rt, log: log}
+	}
+
+	return dryRunConfig
+}
+
+// dryRunRoundTripper adds the dryRun query parameter to mutating requests.
+type dryRunRoundTripper struct {
+	delegate http.RoundTripper
+	log      logrus.FieldLogger
+}
+
+func (rt *dryRunRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return rt.delegate.RoundTrip(req)
+	}
+
+	rt.log.Infof("Dry run: not persisting %s %s", req.Method, req.URL.Path)
+
+	// round trippers must not modify the original request.
+	dryRunReq := new(http.Request)
+	*dryRunReq = *req
+
+	dryRunURL := *req.URL
+	query := dryRunURL.Query()
+	query.Set("dryRun", metav1.DryRunAll)
+	dryRunURL.RawQuery = query.Encode()
+	dryRunReq.URL = &dryRunURL
+
+	return rt.delegate.RoundTrip(dryRunReq)
+}
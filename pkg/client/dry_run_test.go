@@ -0,0 +1,75 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDryRunConfig(t *testing.T) {
+	tests := []struct {
+		method        string
+		expectedQuery string
+	}{
+		{method: http.MethodGet, expectedQuery: "labelSelector=foo%3Dbar"},
+		{method: http.MethodPost, expectedQuery: "dryRun=All&labelSelector=foo%3Dbar"},
+		{method: http.MethodPut, expectedQuery: "dryRun=All&labelSelector=foo%3Dbar"},
+		{method: http.MethodPatch, expectedQuery: "dryRun=All&labelSelector=foo%3Dbar"},
+		{method: http.MethodDelete, expectedQuery: "dryRun=All&labelSelector=foo%3Dbar"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method, func(t *testing.T) {
+			var wrapped bool
+			config := &rest.Config{
+				WrapTransport: func(rt http.RoundTripper) http.RoundTripper {
+					wrapped = true
+					return rt
+				},
+			}
+
+			var sent *http.Request
+			rt := DryRunConfig(config, logrus.New()).WrapTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				sent = req
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			}))
+			assert.True(t, wrapped, "expected the original transport wrapper to be called")
+
+			req, err := http.NewRequest(tc.method, "https://example.com/api/v1/pods?labelSelector=foo%3Dbar", nil)
+			require.NoError(t, err)
+
+			_, err = rt.RoundTrip(req)
+			require.NoError(t, err)
+
+			require.NotNil(t, sent)
+			assert.Equal(t, tc.expectedQuery, sent.URL.RawQuery)
+			assert.Equal(t, "labelSelector=foo%3Dbar", req.URL.RawQuery, "expected the original request not to be modified")
+		})
+	}
+}
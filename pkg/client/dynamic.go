@@ -81,6 +81,19 @@ type Patcher interface {
 	Patch(name string, data []byte) (*unstructured.Unstructured, error)
 }
 
+// Updater updates an object.
+type Updater interface {
+	// Update replaces an object. Its resource version must match the
+	// cluster's, or a conflict error is returned.
+	Update(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+}
+
+// Deletor deletes an object.
+type Deletor interface {
+	// Delete deletes the named object.
+	Delete(name string, opts *metav1.DeleteOptions) error
+}
+
 // Dynamic contains client methods that Velero needs for backing up and restoring resources.
 type Dynamic interface {
 	Creator
@@ -88,6 +101,8 @@ type Dynamic interface {
 	Watcher
 	Getter
 	Patcher
+	Updater
+	Deletor
 }
 
 // dynamicResourceClient implements Dynamic.
@@ -116,3 +131,11 @@ func (d *dynamicResourceClient) Get(name string, opts metav1.GetOptions) (*unstr
 func (d *dynamicResourceClient) Patch(name string, data []byte) (*unstructured.Unstructured, error) {
 	return d.resourceClient.Patch(name, types.MergePatchType, data, metav1.PatchOptions{})
 }
+
+func (d *dynamicResourceClient) Update(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return d.resourceClient.Update(obj, metav1.UpdateOptions{})
+}
+
+func (d *dynamicResourceClient) Delete(name string, opts *metav1.DeleteOptions) error {
+	return d.resourceClient.Delete(name, opts)
+}
@@ -87,6 +87,9 @@ func NewFactory(baseName string, config VeleroConfig) Factory {
 	f.flags.StringVar(&f.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file to use to talk to the Kubernetes apiserver. If unset, try the environment variable KUBECONFIG, as well as in-cluster configuration")
 	f.flags.StringVarP(&f.namespace, "namespace", "n", f.namespace, "The namespace in which Velero should operate")
 	f.flags.StringVar(&f.kubecontext, "kubecontext", "", "The context to use to talk to the Kubernetes apiserver. If unset defaults to whatever your current-context is (kubectl config current-context)")
+	// --context is kubectl's name for --kubecontext, so that the same flags
+	// work when the CLI is run as a kubectl plugin.
+	f.flags.StringVar(&f.kubecontext, "context", "", "The context to use to talk to the Kubernetes apiserver, like kubectl's --context. Same as --kubecontext")
 
 	return f
 }
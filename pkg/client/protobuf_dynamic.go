@@ -0,0 +1,252 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/pager"
+)
+
+// pagedDynamicFactory implements DynamicFactory. Its clients list all
+// resources in pages, so that large lists don't have to be encoded in a
+// single response.
+type pagedDynamicFactory struct {
+	dynamicFactory DynamicFactory
+}
+
+// NewPagedDynamicFactory returns a DynamicFactory whose clients list
+// resources in pages, as JSON, with dynamicClient.
+func NewPagedDynamicFactory(dynamicClient dynamic.Interface) DynamicFactory {
+	return &pagedDynamicFactory{dynamicFactory: NewDynamicFactory(dynamicClient)}
+}
+
+func (f *pagedDynamicFactory) ClientForGroupVersionResource(gv schema.GroupVersion, resource metav1.APIResource, namespace string) (Dynamic, error) {
+	dynamicClient, err := f.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagedResourceClient{Dynamic: dynamicClient}, nil
+}
+
+// protobufDynamicFactory implements DynamicFactory. Its clients list and get
+// the resources that are built into Kubernetes with protobuf, which is much
+// cheaper for the API server to encode and for Velero to decode than JSON,
+// and list all resources in pages, so that large lists don't have to be
+// encoded in a single response.
+//
+// Items decoded from protobuf only have the fields of Velero's vendored
+// Kubernetes types, so any field that the API server returns that they
+// don't define, e.g. one added in a newer Kubernetes version, is dropped.
+// It should only be used with clusters whose version matches them.
+type protobufDynamicFactory struct {
+	dynamicFactory DynamicFactory
+	config         *rest.Config
+
+	lock        sync.Mutex
+	restClients map[schema.GroupVersion]rest.Interface
+}
+
+// NewProtobufDynamicFactory returns a DynamicFactory whose clients list and
+// get built-in resources with protobuf, using clients created from config,
+// and list all resources in pages. Custom resources are listed and gotten
+// with dynamicClient, as JSON, and all clients use dynamicClient for
+// everything else. Fields of built-in resources that Velero's Kubernetes
+// types don't define are dropped from their items.
+func NewProtobufDynamicFactory(dynamicClient dynamic.Interface, config *rest.Config) DynamicFactory {
+	return &protobufDynamicFactory{
+		dynamicFactory: NewDynamicFactory(dynamicClient),
+		config:         config,
+		restClients:    make(map[schema.GroupVersion]rest.Interface),
+	}
+}
+
+func (f *protobufDynamicFactory) ClientForGroupVersionResource(gv schema.GroupVersion, resource metav1.APIResource, namespace string) (Dynamic, error) {
+	dynamicClient, err := f.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isBuiltInResource(gv, resource) {
+		return &pagedResourceClient{Dynamic: dynamicClient}, nil
+	}
+
+	restClient, err := f.restClientFor(gv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobufResourceClient{
+		Dynamic:    dynamicClient,
+		restClient: restClient,
+		gvk:        gv.WithKind(resource.Kind),
+		resource:   resource,
+		namespace:  namespace,
+	}, nil
+}
+
+// isBuiltInResource returns whether a resource is one of Kubernetes' built-in
+// resources, whose types, unlike custom resources', can be decoded from
+// protobuf.
+func isBuiltInResource(gv schema.GroupVersion, resource metav1.APIResource) bool {
+	return scheme.Scheme.Recognizes(gv.WithKind(resource.Kind)) && scheme.Scheme.Recognizes(gv.WithKind(resource.Kind+"List"))
+}
+
+// restClientFor returns a client for a group version that requests
+// protobuf, creating it the first time it's needed.
+func (f *protobufDynamicFactory) restClientFor(gv schema.GroupVersion) (rest.Interface, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if restClient, ok := f.restClients[gv]; ok {
+		return restClient, nil
+	}
+
+	config := rest.CopyConfig(f.config)
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	if gv.Group == "" {
+		config.APIPath = "/api"
+	}
+	config.ContentType = runtime.ContentTypeProtobuf
+	config.AcceptContentTypes = runtime.ContentTypeProtobuf + "," + runtime.ContentTypeJSON
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	restClient, err := rest.RESTClientFor(config)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	f.restClients[gv] = restClient
+
+	return restClient, nil
+}
+
+// pagedResourceClient is a Dynamic that lists items in pages.
+type pagedResourceClient struct {
+	Dynamic
+}
+
+func (c *pagedResourceClient) List(options metav1.ListOptions) (runtime.Object, error) {
+	return listPages(c.Dynamic.List, options, func(item runtime.Object) (unstructured.Unstructured, error) {
+		obj, ok := item.(*unstructured.Unstructured)
+		if !ok {
+			return unstructured.Unstructured{}, errors.Errorf("expected *unstructured.Unstructured, got %T", item)
+		}
+		return *obj, nil
+	})
+}
+
+// protobufResourceClient is a Dynamic that lists, in pages, and gets a
+// built-in resource's items with protobuf.
+type protobufResourceClient struct {
+	Dynamic
+
+	restClient rest.Interface
+	gvk        schema.GroupVersionKind
+	resource   metav1.APIResource
+	namespace  string
+}
+
+func (c *protobufResourceClient) List(options metav1.ListOptions) (runtime.Object, error) {
+	listPage := func(options metav1.ListOptions) (runtime.Object, error) {
+		return c.restClient.Get().
+			NamespaceIfScoped(c.namespace, c.namespace != "").
+			Resource(c.resource.Name).
+			VersionedParams(&options, scheme.ParameterCodec).
+			Do().
+			Get()
+	}
+
+	return listPages(listPage, options, c.toUnstructured)
+}
+
+func (c *protobufResourceClient) Get(name string, options metav1.GetOptions) (*unstructured.Unstructured, error) {
+	obj, err := c.restClient.Get().
+		NamespaceIfScoped(c.namespace, c.namespace != "").
+		Resource(c.resource.Name).
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Get()
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := c.toUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// toUnstructured converts a typed item to the unstructured item that the
+// dynamic client would have returned for it. Items decoded from protobuf
+// don't have an API version or kind, so they're set from the resource's.
+func (c *protobufResourceClient) toUnstructured(item runtime.Object) (unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+	if err != nil {
+		return unstructured.Unstructured{}, errors.WithStack(err)
+	}
+
+	obj := unstructured.Unstructured{Object: content}
+	obj.SetGroupVersionKind(c.gvk)
+	return obj, nil
+}
+
+// listPages lists all the items of a list, a page at a time, and returns
+// them, converted with convert, as an unstructured list. If the list changes
+// so much while it's being paged through that the API server can't continue
+// it, it's listed again in full.
+func listPages(listPage func(metav1.ListOptions) (runtime.Object, error), options metav1.ListOptions, convert func(runtime.Object) (unstructured.Unstructured, error)) (runtime.Object, error) {
+	list, err := pager.New(pager.SimplePageFunc(listPage)).List(context.Background(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	unstructuredList := new(unstructured.UnstructuredList)
+	unstructuredList.SetResourceVersion(listMeta.GetResourceVersion())
+
+	err = meta.EachListItem(list, func(item runtime.Object) error {
+		obj, err := convert(item)
+		if err != nil {
+			return err
+		}
+		unstructuredList.Items = append(unstructuredList.Items, obj)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unstructuredList, nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+func newConfigMap(name string) corev1api.ConfigMap {
+	return corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: name},
+		Data:       map[string]string{"key": name},
+	}
+}
+
+// newProtobufServer returns an API server that serves the config maps cm-1
+// and cm-2 in namespace ns-1 as protobuf, listing them a page at a time.
+func newProtobufServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	info, ok := runtime.SerializerInfoForMediaType(scheme.Codecs.SupportedMediaTypes(), runtime.ContentTypeProtobuf)
+	require.True(t, ok)
+	encoder := scheme.Codecs.EncoderForVersion(info.Serializer, corev1api.SchemeGroupVersion)
+
+	write := func(w http.ResponseWriter, obj runtime.Object) {
+		data, err := runtime.Encode(encoder, obj)
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", runtime.ContentTypeProtobuf)
+		w.Write(data)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/namespaces/ns-1/configmaps", func(w http.ResponseWriter, req *http.Request) {
+		assert.Contains(t, req.Header.Get("Accept"), runtime.ContentTypeProtobuf)
+		assert.Equal(t, "500", req.URL.Query().Get("limit"))
+
+		list := &corev1api.ConfigMapList{ListMeta: metav1.ListMeta{ResourceVersion: "10"}}
+		switch req.URL.Query().Get("continue") {
+		case "":
+			list.Continue = "page-2"
+			list.Items = []corev1api.ConfigMap{newConfigMap("cm-1")}
+		case "page-2":
+			list.Items = []corev1api.ConfigMap{newConfigMap("cm-2")}
+		default:
+			t.Errorf("unexpected continue token %q", req.URL.Query().Get("continue"))
+		}
+		write(w, list)
+	})
+	mux.HandleFunc("/api/v1/namespaces/ns-1/configmaps/cm-1", func(w http.ResponseWriter, req *http.Request) {
+		cm := newConfigMap("cm-1")
+		write(w, &cm)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestProtobufDynamicFactoryBuiltInResource(t *testing.T) {
+	server := newProtobufServer(t)
+	defer server.Close()
+
+	factory := NewProtobufDynamicFactory(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), &rest.Config{Host: server.URL})
+	resource := metav1.APIResource{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}
+	client, err := factory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, resource, "ns-1")
+	require.NoError(t, err)
+
+	list, err := client.List(metav1.ListOptions{})
+	require.NoError(t, err)
+
+	items, err := meta.ExtractList(list)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	for i, name := range []string{"cm-1", "cm-2"} {
+		item := items[i].(*unstructured.Unstructured)
+		assert.Equal(t, "v1", item.GetAPIVersion())
+		assert.Equal(t, "ConfigMap", item.GetKind())
+		assert.Equal(t, name, item.GetName())
+		assert.Equal(t, map[string]interface{}{"key": name}, item.Object["data"])
+	}
+
+	item, err := client.Get("cm-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "ConfigMap", item.GetKind())
+	assert.Equal(t, "cm-1", item.GetName())
+}
+
+func TestProtobufDynamicFactoryCustomResource(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("velero.io/v1")
+	obj.SetKind("Backup")
+	obj.SetNamespace("velero")
+	obj.SetName("backup-1")
+
+	// custom resources aren't requested from the API server with protobuf.
+	factory := NewProtobufDynamicFactory(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), obj), &rest.Config{Host: "http://127.0.0.1:0"})
+	resource := metav1.APIResource{Name: "backups", Kind: "Backup", Namespaced: true}
+	client, err := factory.ClientForGroupVersionResource(schema.GroupVersion{Group: "velero.io", Version: "v1"}, resource, "velero")
+	require.NoError(t, err)
+
+	list, err := client.List(metav1.ListOptions{})
+	require.NoError(t, err)
+
+	items, err := meta.ExtractList(list)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "backup-1", items[0].(*unstructured.Unstructured).GetName())
+}
+
+func TestPagedDynamicFactoryKeepsUnknownFields(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Pod")
+	obj.SetNamespace("ns-1")
+	obj.SetName("pod-1")
+	// a field that Velero's vendored Kubernetes types don't define
+	require.NoError(t, unstructured.SetNestedSlice(obj.Object, []interface{}{map[string]interface{}{"name": "debugger"}}, "spec", "ephemeralContainers"))
+
+	factory := NewPagedDynamicFactory(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), obj))
+	resource := metav1.APIResource{Name: "pods", Kind: "Pod", Namespaced: true}
+	client, err := factory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, resource, "ns-1")
+	require.NoError(t, err)
+
+	list, err := client.List(metav1.ListOptions{})
+	require.NoError(t, err)
+
+	items, err := meta.ExtractList(list)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, obj.Object, items[0].(*unstructured.Unstructured).Object)
+
+	item, err := client.Get("pod-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, obj.Object, item.Object)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/vmware-tanzu/velero/pkg/cloudprovider"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const (
@@ -55,6 +56,7 @@ type s3Interface interface {
 	ListObjectsV2Pages(input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool) error
 	DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
 	GetObjectRequest(input *s3.GetObjectInput) (req *request.Request, output *s3.GetObjectOutput)
+	GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error)
 }
 
 type ObjectStore struct {
@@ -90,6 +92,7 @@ func (o *ObjectStore) Init(config map[string]string) error {
 		credentialProfileKey,
 		serverSideEncryptionKey,
 		insecureSkipTLSVerifyKey,
+		velero.CredentialsFileConfigKey,
 	); err != nil {
 		return err
 	}
@@ -104,6 +107,7 @@ func (o *ObjectStore) Init(config map[string]string) error {
 		credentialProfile        = config[credentialProfileKey]
 		serverSideEncryption     = config[serverSideEncryptionKey]
 		insecureSkipTLSVerifyVal = config[insecureSkipTLSVerifyKey]
+		credentialsFile          = config[velero.CredentialsFileConfigKey]
 
 		// note that bucket is automatically added to the config map
 		// by the server from the ObjectStorageProviderConfig so
@@ -151,7 +155,7 @@ func (o *ObjectStore) Init(config map[string]string) error {
 		}
 	}
 
-	serverSession, err := getSession(serverConfig, credentialProfile)
+	serverSession, err := getSession(serverConfig, credentialProfile, credentialsFile)
 	if err != nil {
 		return err
 	}
@@ -173,7 +177,7 @@ func (o *ObjectStore) Init(config map[string]string) error {
 		if err != nil {
 			return err
 		}
-		publicSession, err := getSession(publicConfig, credentialProfile)
+		publicSession, err := getSession(publicConfig, credentialProfile, credentialsFile)
 		if err != nil {
 			return err
 		}
@@ -361,3 +365,55 @@ func (o *ObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (st
 
 	return req.Presign(ttl)
 }
+
+const (
+	// encryptionConfigNotFoundCode is the error code returned when a bucket
+	// doesn't have a default encryption configuration.
+	encryptionConfigNotFoundCode = "ServerSideEncryptionConfigurationNotFoundError"
+	accessDeniedCode             = "AccessDenied"
+)
+
+// GetEncryptionStatus returns the server-side encryption of objects written to
+// the bucket. If the location's config specifies server-side encryption, it's
+// returned; otherwise, the bucket's default encryption is returned.
+func (o *ObjectStore) GetEncryptionStatus(bucket string) (*velero.EncryptionStatus, error) {
+	switch {
+	case o.kmsKeyID != "":
+		return &velero.EncryptionStatus{Enabled: true, Algorithm: "aws:kms", KeyID: o.kmsKeyID}, nil
+	case o.serverSideEncryption != "":
+		return &velero.EncryptionStatus{Enabled: true, Algorithm: o.serverSideEncryption}, nil
+	}
+
+	res, err := o.s3.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: aws.String(bucket)})
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case encryptionConfigNotFoundCode:
+			return &velero.EncryptionStatus{Enabled: false}, nil
+		case accessDeniedCode:
+			// reading the bucket's encryption configuration requires the
+			// s3:GetEncryptionConfiguration permission, which Velero
+			// doesn't otherwise need.
+			o.log.WithField("bucket", bucket).Debug("Not allowed to get bucket's encryption configuration")
+			return nil, nil
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting encryption configuration of bucket %s", bucket)
+	}
+
+	if res.ServerSideEncryptionConfiguration != nil {
+		for _, rule := range res.ServerSideEncryptionConfiguration.Rules {
+			if rule.ApplyServerSideEncryptionByDefault == nil {
+				continue
+			}
+
+			return &velero.EncryptionStatus{
+				Enabled:   true,
+				Algorithm: aws.StringValue(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm),
+				KeyID:     aws.StringValue(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
+			}, nil
+		}
+	}
+
+	return &velero.EncryptionStatus{Enabled: false}, nil
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -66,6 +67,11 @@ func (m *mockS3) GetObjectRequest(input *s3.GetObjectInput) (req *request.Reques
 	return args.Get(0).(*request.Request), args.Get(1).(*s3.GetObjectOutput)
 }
 
+func (m *mockS3) GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*s3.GetBucketEncryptionOutput), args.Error(1)
+}
+
 func TestObjectExists(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -123,3 +129,88 @@ func TestObjectExists(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEncryptionStatus(t *testing.T) {
+	kmsBucketEncryption := &s3.GetBucketEncryptionOutput{
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{
+				{
+					ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+						SSEAlgorithm:   aws.String("aws:kms"),
+						KMSMasterKeyID: aws.String("bucket-key"),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		kmsKeyID             string
+		serverSideEncryption string
+		bucketEncryption     *s3.GetBucketEncryptionOutput
+		bucketEncryptionErr  error
+		want                 *velero.EncryptionStatus
+		wantErr              string
+	}{
+		{
+			name:     "KMS key in config is reported",
+			kmsKeyID: "config-key",
+			want:     &velero.EncryptionStatus{Enabled: true, Algorithm: "aws:kms", KeyID: "config-key"},
+		},
+		{
+			name:                 "server-side encryption in config is reported",
+			serverSideEncryption: "AES256",
+			want:                 &velero.EncryptionStatus{Enabled: true, Algorithm: "AES256"},
+		},
+		{
+			name:             "bucket default encryption is reported",
+			bucketEncryption: kmsBucketEncryption,
+			want:             &velero.EncryptionStatus{Enabled: true, Algorithm: "aws:kms", KeyID: "bucket-key"},
+		},
+		{
+			name:                "bucket without default encryption is reported as not enabled",
+			bucketEncryption:    &s3.GetBucketEncryptionOutput{},
+			bucketEncryptionErr: awserr.New(encryptionConfigNotFoundCode, "not found", nil),
+			want:                &velero.EncryptionStatus{Enabled: false},
+		},
+		{
+			name:                "access denied to bucket encryption returns nil",
+			bucketEncryption:    &s3.GetBucketEncryptionOutput{},
+			bucketEncryptionErr: awserr.New(accessDeniedCode, "access denied", nil),
+			want:                nil,
+		},
+		{
+			name:                "other errors are returned",
+			bucketEncryption:    &s3.GetBucketEncryptionOutput{},
+			bucketEncryptionErr: errors.New("bad"),
+			wantErr:             "error getting encryption configuration of bucket b: bad",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := new(mockS3)
+			defer s.AssertExpectations(t)
+
+			o := &ObjectStore{
+				log:                  test.NewLogger(),
+				s3:                   s,
+				kmsKeyID:             tc.kmsKeyID,
+				serverSideEncryption: tc.serverSideEncryption,
+			}
+
+			if tc.bucketEncryption != nil {
+				s.On("GetBucketEncryption", &s3.GetBucketEncryptionInput{Bucket: aws.String("b")}).Return(tc.bucketEncryption, tc.bucketEncryptionErr)
+			}
+
+			res, err := o.GetEncryptionStatus("b")
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
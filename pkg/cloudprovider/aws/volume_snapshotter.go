@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/velero/pkg/cloudprovider"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const regionKey = "region"
@@ -48,9 +49,13 @@ type VolumeSnapshotter struct {
 	ec2 *ec2.EC2
 }
 
-// takes AWS credential config & a profile to create a new session
-func getSession(config *aws.Config, profile string) (*session.Session, error) {
+// takes AWS credential config, a profile & an optional credentials file to
+// create a new session
+func getSession(config *aws.Config, profile, credentialsFile string) (*session.Session, error) {
 	sessionOptions := session.Options{Config: *config, Profile: profile}
+	if credentialsFile != "" {
+		sessionOptions.SharedConfigFiles = []string{credentialsFile}
+	}
 	sess, err := session.NewSessionWithOptions(sessionOptions)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -68,19 +73,20 @@ func NewVolumeSnapshotter(logger logrus.FieldLogger) *VolumeSnapshotter {
 }
 
 func (b *VolumeSnapshotter) Init(config map[string]string) error {
-	if err := cloudprovider.ValidateVolumeSnapshotterConfigKeys(config, regionKey, credentialProfileKey); err != nil {
+	if err := cloudprovider.ValidateVolumeSnapshotterConfigKeys(config, regionKey, credentialProfileKey, velero.CredentialsFileConfigKey); err != nil {
 		return err
 	}
 
 	region := config[regionKey]
 	credentialProfile := config[credentialProfileKey]
+	credentialsFile := config[velero.CredentialsFileConfigKey]
 	if region == "" {
 		return errors.Errorf("missing %s in aws configuration", regionKey)
 	}
 
 	awsConfig := aws.NewConfig().WithRegion(region)
 
-	sess, err := getSession(awsConfig, credentialProfile)
+	sess, err := getSession(awsConfig, credentialProfile, credentialsFile)
 	if err != nil {
 		return err
 	}
@@ -19,6 +19,7 @@ package azure
 import (
 	"context"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -240,6 +241,26 @@ func (o *ObjectStore) PutObject(bucket, key string, body io.Reader) error {
 	return errors.WithStack(blob.CreateBlockBlobFromReader(body, nil))
 }
 
+// PutObjectIfNotExists creates a new object using the data in body, unless there's
+// already an object with the key.
+func (o *ObjectStore) PutObjectIfNotExists(bucket, key string, body io.Reader) (bool, error) {
+	blob, err := o.blobGetter.getBlob(bucket, key)
+	if err != nil {
+		return false, err
+	}
+
+	err = blob.CreateBlockBlobFromReader(body, &storage.PutBlobOptions{IfNoneMatch: "*"})
+	if storageErr, ok := err.(storage.AzureStorageServiceError); ok &&
+		(storageErr.StatusCode == http.StatusConflict || storageErr.StatusCode == http.StatusPreconditionFailed) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return true, nil
+}
+
 func (o *ObjectStore) ObjectExists(bucket, key string) (bool, error) {
 	blob, err := o.blobGetter.getBlob(bucket, key)
 	if err != nil {
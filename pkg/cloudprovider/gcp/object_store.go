@@ -20,30 +20,42 @@ import (
 	"context"
 	"encoding/base64"
 	"io"
+	"net/http"
 	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iamcredentials/v1"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 
 	"github.com/vmware-tanzu/velero/pkg/cloudprovider"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const (
 	credentialsEnvVar    = "GOOGLE_APPLICATION_CREDENTIALS"
 	kmsKeyNameConfigKey  = "kmsKeyName"
 	serviceAccountConfig = "serviceAccount"
+
+	// kmsAlgorithm and googleManagedAlgorithm describe the encryption of
+	// objects with a Cloud KMS key, and with a Google-managed key.
+	kmsAlgorithm           = "cloud-kms"
+	googleManagedAlgorithm = "google-managed"
 )
 
 // bucketWriter wraps the GCP SDK functions for accessing object store so they can be faked for testing.
 type bucketWriter interface {
 	// getWriteCloser returns an io.WriteCloser that can be used to upload data to the specified bucket for the specified key.
 	getWriteCloser(bucket, key string) io.WriteCloser
+	// getNewObjectWriteCloser returns an io.WriteCloser like getWriteCloser's, whose upload
+	// fails with a precondition error if there's already an object with the key.
+	getNewObjectWriteCloser(bucket, key string) io.WriteCloser
 	getAttrs(bucket, key string) (*storage.ObjectAttrs, error)
+	getBucketAttrs(bucket string) (*storage.BucketAttrs, error)
 }
 
 type writer struct {
@@ -58,10 +70,21 @@ func (w *writer) getWriteCloser(bucket, key string) io.WriteCloser {
 	return writer
 }
 
+func (w *writer) getNewObjectWriteCloser(bucket, key string) io.WriteCloser {
+	writer := w.client.Bucket(bucket).Object(key).If(storage.Conditions{DoesNotExist: true}).NewWriter(context.Background())
+	writer.KMSKeyName = w.kmsKeyName
+
+	return writer
+}
+
 func (w *writer) getAttrs(bucket, key string) (*storage.ObjectAttrs, error) {
 	return w.client.Bucket(bucket).Object(key).Attrs(context.Background())
 }
 
+func (w *writer) getBucketAttrs(bucket string) (*storage.BucketAttrs, error) {
+	return w.client.Bucket(bucket).Attrs(context.Background())
+}
+
 type ObjectStore struct {
 	log            logrus.FieldLogger
 	client         *storage.Client
@@ -69,6 +92,7 @@ type ObjectStore struct {
 	privateKey     []byte
 	bucketWriter   bucketWriter
 	iamSvc         *iamcredentials.Service
+	kmsKeyName     string
 }
 
 func NewObjectStore(logger logrus.FieldLogger) *ObjectStore {
@@ -104,6 +128,7 @@ func (o *ObjectStore) Init(config map[string]string) error {
 		return errors.WithStack(err)
 	}
 	o.client = client
+	o.kmsKeyName = config[kmsKeyNameConfigKey]
 
 	o.bucketWriter = &writer{
 		client:     o.client,
@@ -156,6 +181,28 @@ func (o *ObjectStore) PutObject(bucket, key string, body io.Reader) error {
 	return closeErr
 }
 
+// PutObjectIfNotExists creates a new object using the data in body, unless there's
+// already an object with the key.
+func (o *ObjectStore) PutObjectIfNotExists(bucket, key string, body io.Reader) (bool, error) {
+	w := o.bucketWriter.getNewObjectWriteCloser(bucket, key)
+
+	_, copyErr := io.Copy(w, body)
+
+	closeErr := w.Close()
+	if copyErr != nil {
+		return false, copyErr
+	}
+
+	if apiErr, ok := closeErr.(*googleapi.Error); ok && apiErr.Code == http.StatusPreconditionFailed {
+		return false, nil
+	}
+	if closeErr != nil {
+		return false, closeErr
+	}
+
+	return true, nil
+}
+
 func (o *ObjectStore) ObjectExists(bucket, key string) (bool, error) {
 	if _, err := o.bucketWriter.getAttrs(bucket, key); err != nil {
 		if err == storage.ErrObjectNotExist {
@@ -259,3 +306,24 @@ func (o *ObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (st
 
 	return storage.SignedURL(bucket, key, &options)
 }
+
+// GetEncryptionStatus returns the encryption of objects written to the bucket.
+// Cloud Storage always encrypts objects at rest, with a Google-managed key
+// unless a Cloud KMS key is specified in the location's config or is the
+// bucket's default key.
+func (o *ObjectStore) GetEncryptionStatus(bucket string) (*velero.EncryptionStatus, error) {
+	if o.kmsKeyName != "" {
+		return &velero.EncryptionStatus{Enabled: true, Algorithm: kmsAlgorithm, KeyID: o.kmsKeyName}, nil
+	}
+
+	attrs, err := o.bucketWriter.getBucketAttrs(bucket)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting attributes of bucket %s", bucket)
+	}
+
+	if attrs.Encryption != nil && attrs.Encryption.DefaultKMSKeyName != "" {
+		return &velero.EncryptionStatus{Enabled: true, Algorithm: kmsAlgorithm, KeyID: attrs.Encryption.DefaultKMSKeyName}, nil
+	}
+
+	return &velero.EncryptionStatus{Enabled: true, Algorithm: googleManagedAlgorithm}, nil
+}
@@ -18,13 +18,16 @@ package gcp
 import (
 	"errors"
 	"io"
+	"net/http"
 	"strings"
 	"testing"
 
 	"cloud.google.com/go/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
 
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -48,7 +51,8 @@ func newMockWriteCloser(writeErr, closeErr error) *mockWriteCloser {
 type fakeWriter struct {
 	wc *mockWriteCloser
 
-	attrsErr error
+	attrsErr    error
+	bucketAttrs *storage.BucketAttrs
 }
 
 func newFakeWriter(wc *mockWriteCloser) *fakeWriter {
@@ -59,10 +63,18 @@ func (fw *fakeWriter) getWriteCloser(bucket, name string) io.WriteCloser {
 	return fw.wc
 }
 
+func (fw *fakeWriter) getNewObjectWriteCloser(bucket, name string) io.WriteCloser {
+	return fw.wc
+}
+
 func (fw *fakeWriter) getAttrs(bucket, key string) (*storage.ObjectAttrs, error) {
 	return new(storage.ObjectAttrs), fw.attrsErr
 }
 
+func (fw *fakeWriter) getBucketAttrs(bucket string) (*storage.BucketAttrs, error) {
+	return fw.bucketAttrs, fw.attrsErr
+}
+
 func TestPutObject(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -106,6 +118,46 @@ func TestPutObject(t *testing.T) {
 	}
 }
 
+func TestPutObjectIfNotExists(t *testing.T) {
+	tests := []struct {
+		name        string
+		writeErr    error
+		closeErr    error
+		wantCreated bool
+		wantErr     error
+	}{
+		{
+			name:        "object is created",
+			wantCreated: true,
+		},
+		{
+			name:     "precondition failure means the object exists",
+			closeErr: &googleapi.Error{Code: http.StatusPreconditionFailed},
+		},
+		{
+			name:     "other Close() errors are returned",
+			closeErr: errors.New("error closing"),
+			wantErr:  errors.New("error closing"),
+		},
+		{
+			name:     "Write() errors are returned",
+			writeErr: errors.New("error writing"),
+			wantErr:  errors.New("error writing"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o := NewObjectStore(velerotest.NewLogger())
+			o.bucketWriter = newFakeWriter(newMockWriteCloser(test.writeErr, test.closeErr))
+
+			created, err := o.PutObjectIfNotExists("bucket", "key", strings.NewReader("contents"))
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.wantCreated, created)
+		})
+	}
+}
+
 func TestObjectExists(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -152,3 +204,51 @@ func TestObjectExists(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEncryptionStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		kmsKeyName  string
+		bucketAttrs *storage.BucketAttrs
+		attrsErr    error
+		want        *velero.EncryptionStatus
+		wantErr     string
+	}{
+		{
+			name:       "KMS key in config is reported",
+			kmsKeyName: "config-key",
+			want:       &velero.EncryptionStatus{Enabled: true, Algorithm: kmsAlgorithm, KeyID: "config-key"},
+		},
+		{
+			name:        "bucket's default KMS key is reported",
+			bucketAttrs: &storage.BucketAttrs{Encryption: &storage.BucketEncryption{DefaultKMSKeyName: "bucket-key"}},
+			want:        &velero.EncryptionStatus{Enabled: true, Algorithm: kmsAlgorithm, KeyID: "bucket-key"},
+		},
+		{
+			name:        "bucket without a default KMS key uses a Google-managed key",
+			bucketAttrs: &storage.BucketAttrs{},
+			want:        &velero.EncryptionStatus{Enabled: true, Algorithm: googleManagedAlgorithm},
+		},
+		{
+			name:     "errors getting bucket attributes are returned",
+			attrsErr: errors.New("bad"),
+			wantErr:  "error getting attributes of bucket b: bad",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			o := NewObjectStore(velerotest.NewLogger())
+			o.kmsKeyName = tc.kmsKeyName
+			o.bucketWriter = &fakeWriter{bucketAttrs: tc.bucketAttrs, attrsErr: tc.attrsErr}
+
+			res, err := o.GetEncryptionStatus("b")
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+// NewApproveDeletionCommand creates a new command that approves the deletion
+// of protected backups.
+func NewApproveDeletionCommand(f client.Factory, use string) *cobra.Command {
+	o := NewApproveDeletionOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME [NAME...]",
+		Short: "Approve the deletion of protected backups",
+		Long: `Approve the deletion of protected backups.
+
+Backups labeled velero.io/protected=true are only deleted once someone other than the requester
+of the deletion approves it. The approver is the user that runs this command, as authenticated by
+the Kubernetes API server and recorded by the Velero server's admission webhook. Approvals are valid
+for the server's --delete-backup-approval-ttl, 24 hours by default.`,
+		Example: `  # approve the deletion of a backup named "backup-1"
+  velero backup approve-deletion backup-1`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	return c
+}
+
+// ApproveDeletionOptions contains parameters used for approving the deletion
+// of protected backups.
+type ApproveDeletionOptions struct {
+	Names []string
+}
+
+// NewApproveDeletionOptions returns an ApproveDeletionOptions with default values.
+func NewApproveDeletionOptions() *ApproveDeletionOptions {
+	return &ApproveDeletionOptions{}
+}
+
+// Complete fills in the correct values for all the options.
+func (o *ApproveDeletionOptions) Complete(f client.Factory, args []string) error {
+	o.Names = args
+	return nil
+}
+
+// Run creates a DeleteBackupApproval for each backup.
+func (o *ApproveDeletionOptions) Run(f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range o.Names {
+		if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(name, metav1.GetOptions{}); err != nil {
+			return errors.WithStack(err)
+		}
+
+		approval := builder.ForDeleteBackupApproval(f.Namespace(), "").
+			ObjectMeta(
+				builder.WithGenerateName(name+"-"),
+				builder.WithLabels(velerov1api.BackupNameLabel, label.GetValidName(name)),
+			).
+			BackupName(name).
+			Result()
+
+		created, err := veleroClient.VeleroV1().DeleteBackupApprovals(f.Namespace()).Create(approval)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if created.Spec.Approver == "" {
+			fmt.Printf("Deletion of backup %q approved, but the approval won't be used because the server's admission webhook didn't record the approver.\n", name)
+			continue
+		}
+		fmt.Printf("Deletion of backup %q approved by %s.\n", name, created.Spec.Approver)
+	}
+
+	return nil
+}
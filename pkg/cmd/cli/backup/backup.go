@@ -36,6 +36,11 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewApproveDeletionCommand(f, "approve-deletion"),
+		NewPruneCommand(f, "prune"),
+		NewCostCommand(f, "cost"),
+		NewSearchCommand(f, "search"),
+		NewSyncCommand(f, "sync"),
 	)
 
 	return c
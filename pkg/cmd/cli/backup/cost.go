@@ -0,0 +1,331 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+const (
+	// DefaultCostConfigMap is the name of the ConfigMap in the Velero namespace
+	// that contains the price hints used to estimate backup costs.
+	DefaultCostConfigMap = "velero-cost-hints"
+
+	objectStoragePriceKeyPrefix = "objectStorage."
+	snapshotPriceKeyPrefix      = "snapshots."
+
+	bytesPerGiB = 1 << 30
+
+	costDownloadRequestTimeout = 30 * time.Second
+)
+
+func NewCostCommand(f client.Factory, use string) *cobra.Command {
+	o := NewCostOptions()
+
+	c := &cobra.Command{
+		Use:   use + " [NAME1] [NAME2] [NAME...]",
+		Short: "Estimate the monthly storage cost of backups",
+		Long: `Estimate the monthly storage cost of backups, individually and aggregated per schedule.
+
+The estimate uses the size of each backup's tarball in object storage and the provisioned size
+of each of its volume snapshots, priced using the per-provider price hints in a ConfigMap in the
+Velero namespace. Prices are per GiB per month, keyed by "objectStorage.<provider>" for backup
+storage locations and "snapshots.<provider>" for volume snapshot locations, for example:
+
+  apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: velero-cost-hints
+    namespace: velero
+  data:
+    objectStorage.aws: "0.023"
+    snapshots.aws: "0.05"
+
+Costs for providers without a price hint are reported as unknown.`,
+		Example: `	velero backup cost backup-1 backup-2
+	velero backup cost --selector velero.io/schedule-name=daily`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type CostOptions struct {
+	Names                 []string
+	Selector              string
+	ConfigMap             string
+	InsecureSkipTLSVerify bool
+}
+
+func NewCostOptions() *CostOptions {
+	return &CostOptions{
+		ConfigMap: DefaultCostConfigMap,
+	}
+}
+
+func (o *CostOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&o.Selector, "selector", "l", o.Selector, "only estimate the cost of backups matching this label selector")
+	flags.StringVar(&o.ConfigMap, "price-hints-configmap", o.ConfigMap, "name of the ConfigMap in the Velero namespace containing per-provider price hints")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+}
+
+func (o *CostOptions) Complete(args []string, f client.Factory) error {
+	o.Names = args
+	return nil
+}
+
+func (o *CostOptions) Run(c *cobra.Command, f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := f.KubeClient()
+	if err != nil {
+		return err
+	}
+
+	var backups []velerov1api.Backup
+	if len(o.Names) > 0 {
+		for _, name := range o.Names {
+			backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			backups = append(backups, *backup)
+		}
+	} else {
+		list, err := veleroClient.VeleroV1().Backups(f.Namespace()).List(metav1.ListOptions{LabelSelector: o.Selector})
+		if err != nil {
+			return err
+		}
+		backups = list.Items
+	}
+
+	hints := costHints{}
+	configMap, err := kubeClient.CoreV1().ConfigMaps(f.Namespace()).Get(o.ConfigMap, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		fmt.Printf("Price hints ConfigMap %s/%s not found, costs will be reported as unknown.\n\n", f.Namespace(), o.ConfigMap)
+	case err != nil:
+		return errors.Wrap(err, "error getting price hints ConfigMap")
+	default:
+		if hints, err = parseCostHints(configMap.Data); err != nil {
+			return err
+		}
+	}
+
+	storageProviders := make(map[string]string)
+	backupLocations, err := veleroClient.VeleroV1().BackupStorageLocations(f.Namespace()).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, location := range backupLocations.Items {
+		storageProviders[location.Name] = location.Spec.Provider
+	}
+
+	snapshotProviders := make(map[string]string)
+	snapshotLocations, err := veleroClient.VeleroV1().VolumeSnapshotLocations(f.Namespace()).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, location := range snapshotLocations.Items {
+		snapshotProviders[location.Name] = location.Spec.Provider
+	}
+
+	var costs []backupCost
+	for _, backup := range backups {
+		var snapshots []*volume.Snapshot
+		if backup.Status.VolumeSnapshotsAttempted > 0 {
+			buf := new(bytes.Buffer)
+			if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupVolumeSnapshots, buf, costDownloadRequestTimeout, o.InsecureSkipTLSVerify); err != nil {
+				return errors.Wrapf(err, "error getting volume snapshots for backup %s", backup.Name)
+			}
+			if err := json.NewDecoder(buf).Decode(&snapshots); err != nil {
+				return errors.Wrapf(err, "error decoding volume snapshots for backup %s", backup.Name)
+			}
+		}
+
+		costs = append(costs, estimateBackupCost(&backup, storageProviders[backup.Spec.StorageLocation], snapshots, snapshotProviders, hints))
+	}
+
+	fmt.Print(describeBackupCosts(costs))
+	return nil
+}
+
+// costHints are the prices, per GiB per month, of backup storage and volume
+// snapshots, keyed by provider.
+type costHints struct {
+	objectStorage map[string]float64
+	snapshots     map[string]float64
+}
+
+// parseCostHints parses the price hints from the data of a price hints ConfigMap.
+func parseCostHints(data map[string]string) (costHints, error) {
+	hints := costHints{
+		objectStorage: make(map[string]float64),
+		snapshots:     make(map[string]float64),
+	}
+
+	for key, val := range data {
+		var prices map[string]float64
+		var provider string
+
+		switch {
+		case strings.HasPrefix(key, objectStoragePriceKeyPrefix):
+			prices, provider = hints.objectStorage, strings.TrimPrefix(key, objectStoragePriceKeyPrefix)
+		case strings.HasPrefix(key, snapshotPriceKeyPrefix):
+			prices, provider = hints.snapshots, strings.TrimPrefix(key, snapshotPriceKeyPrefix)
+		default:
+			continue
+		}
+
+		price, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		if err != nil {
+			return costHints{}, errors.Wrapf(err, "invalid price hint %s", key)
+		}
+		prices[provider] = price
+	}
+
+	return hints, nil
+}
+
+// backupCost is the estimated monthly storage cost of a backup.
+type backupCost struct {
+	name          string
+	schedule      string
+	storageBytes  int64
+	snapshotBytes int64
+	cost          float64
+	// costKnown is false if there's no price hint for the provider
+	// of the backup's storage location or any of its snapshots.
+	costKnown bool
+}
+
+// estimateBackupCost estimates the monthly storage cost of a backup whose tarball is stored
+// with the given provider, and whose snapshots are stored in the given locations.
+func estimateBackupCost(backup *velerov1api.Backup, storageProvider string, snapshots []*volume.Snapshot, snapshotProviders map[string]string, hints costHints) backupCost {
+	cost := backupCost{
+		name:         backup.Name,
+		schedule:     backup.Labels[velerov1api.ScheduleNameLabel],
+		storageBytes: backup.Status.TarballSizeBytes,
+		costKnown:    true,
+	}
+
+	if price, ok := hints.objectStorage[storageProvider]; ok {
+		cost.cost += price * float64(cost.storageBytes) / bytesPerGiB
+	} else {
+		cost.costKnown = false
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseCompleted {
+			continue
+		}
+
+		cost.snapshotBytes += snapshot.Spec.VolumeSizeBytes
+
+		if price, ok := hints.snapshots[snapshotProviders[snapshot.Spec.Location]]; ok {
+			cost.cost += price * float64(snapshot.Spec.VolumeSizeBytes) / bytesPerGiB
+		} else {
+			cost.costKnown = false
+		}
+	}
+
+	return cost
+}
+
+// describeBackupCosts returns a description of the estimated costs of a set of backups,
+// followed by their totals per schedule.
+func describeBackupCosts(costs []backupCost) string {
+	return output.Describe(func(d *output.Describer) {
+		d.Printf("NAME\tSCHEDULE\tSTORAGE\tSNAPSHOTS\tEST. MONTHLY COST\n")
+		for _, cost := range costs {
+			d.Printf("%s\t%s\t%s\t%s\t%s\n", cost.name, scheduleOrNone(cost.schedule), formatGiB(cost.storageBytes), formatGiB(cost.snapshotBytes), formatCost(cost.cost, cost.costKnown))
+		}
+
+		totals := make(map[string]*backupCost)
+		counts := make(map[string]int)
+		for _, cost := range costs {
+			total, ok := totals[cost.schedule]
+			if !ok {
+				total = &backupCost{schedule: cost.schedule, costKnown: true}
+				totals[cost.schedule] = total
+			}
+
+			counts[cost.schedule]++
+			total.storageBytes += cost.storageBytes
+			total.snapshotBytes += cost.snapshotBytes
+			total.cost += cost.cost
+			total.costKnown = total.costKnown && cost.costKnown
+		}
+
+		schedules := make([]string, 0, len(totals))
+		for schedule := range totals {
+			schedules = append(schedules, schedule)
+		}
+		sort.Strings(schedules)
+
+		d.Println()
+		d.Printf("SCHEDULE\tBACKUPS\tSTORAGE\tSNAPSHOTS\tEST. MONTHLY COST\n")
+		for _, schedule := range schedules {
+			total := totals[schedule]
+			d.Printf("%s\t%d\t%s\t%s\t%s\n", scheduleOrNone(schedule), counts[schedule], formatGiB(total.storageBytes), formatGiB(total.snapshotBytes), formatCost(total.cost, total.costKnown))
+		}
+	})
+}
+
+func scheduleOrNone(schedule string) string {
+	if schedule == "" {
+		return "<none>"
+	}
+	return schedule
+}
+
+func formatGiB(bytes int64) string {
+	return fmt.Sprintf("%.2f GiB", float64(bytes)/bytesPerGiB)
+}
+
+func formatCost(cost float64, known bool) string {
+	if !known {
+		return "<unknown>"
+	}
+	return fmt.Sprintf("%.2f", cost)
+}
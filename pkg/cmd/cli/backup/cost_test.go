@@ -0,0 +1,101 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestParseCostHints(t *testing.T) {
+	hints, err := parseCostHints(map[string]string{
+		"objectStorage.aws": "0.023",
+		"snapshots.aws":     " 0.05 ",
+		"unrelated":         "foo",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"aws": 0.023}, hints.objectStorage)
+	assert.Equal(t, map[string]float64{"aws": 0.05}, hints.snapshots)
+
+	_, err = parseCostHints(map[string]string{"snapshots.aws": "cheap"})
+	assert.Error(t, err)
+}
+
+func TestEstimateBackupCost(t *testing.T) {
+	hints := costHints{
+		objectStorage: map[string]float64{"aws": 0.02},
+		snapshots:     map[string]float64{"aws": 0.05},
+	}
+
+	snapshot := func(location string, phase volume.SnapshotPhase, sizeBytes int64) *volume.Snapshot {
+		return &volume.Snapshot{
+			Spec:   volume.SnapshotSpec{Location: location, VolumeSizeBytes: sizeBytes},
+			Status: volume.SnapshotStatus{Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		backup          *velerov1api.Backup
+		storageProvider string
+		snapshots       []*volume.Snapshot
+		want            backupCost
+	}{
+		{
+			name:            "backup without snapshots is priced by tarball size",
+			backup:          builder.ForBackup("velero", "backup-1").TarballSizeBytes(10 * bytesPerGiB).Result(),
+			storageProvider: "aws",
+			want:            backupCost{name: "backup-1", storageBytes: 10 * bytesPerGiB, cost: 0.2, costKnown: true},
+		},
+		{
+			name:            "completed snapshots are priced by volume size",
+			backup:          builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).TarballSizeBytes(bytesPerGiB).Result(),
+			storageProvider: "aws",
+			snapshots: []*volume.Snapshot{
+				snapshot("aws-default", volume.SnapshotPhaseCompleted, 100*bytesPerGiB),
+				snapshot("aws-default", volume.SnapshotPhaseFailed, 100*bytesPerGiB),
+			},
+			want: backupCost{name: "backup-1", schedule: "daily", storageBytes: bytesPerGiB, snapshotBytes: 100 * bytesPerGiB, cost: 5.02, costKnown: true},
+		},
+		{
+			name:            "missing price hint makes cost unknown",
+			backup:          builder.ForBackup("velero", "backup-1").TarballSizeBytes(bytesPerGiB).Result(),
+			storageProvider: "aws",
+			snapshots: []*volume.Snapshot{
+				snapshot("gcp-default", volume.SnapshotPhaseCompleted, bytesPerGiB),
+			},
+			want: backupCost{name: "backup-1", storageBytes: bytesPerGiB, snapshotBytes: bytesPerGiB, cost: 0.02, costKnown: false},
+		},
+	}
+
+	snapshotProviders := map[string]string{"aws-default": "aws", "gcp-default": "gcp"}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := estimateBackupCost(tc.backup, tc.storageProvider, tc.snapshots, snapshotProviders, hints)
+			assert.InDelta(t, tc.want.cost, got.cost, 0.0001)
+			got.cost = tc.want.cost
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
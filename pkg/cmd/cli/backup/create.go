@@ -18,17 +18,22 @@ package backup
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
@@ -75,20 +80,36 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name                    string
-	TTL                     time.Duration
-	SnapshotVolumes         flag.OptionalBool
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	Labels                  flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
-	StorageLocation         string
-	SnapshotLocations       []string
-	FromSchedule            string
+	Name                      string
+	TTL                       time.Duration
+	LogTTL                    time.Duration
+	SnapshotVolumes           flag.OptionalBool
+	ExcludeSnapshotNamespaces flag.StringArray
+	ExcludeSnapshotSelector   flag.LabelSelector
+	PodVolumeBackupSelectors  flag.LabelSelectorArray
+	DefaultVolumesToRestic    flag.OptionalBool
+	MaxItems                  flag.OptionalInt
+	MaxSize                   flag.Quantity
+	IncludeNamespaces         flag.StringArray
+	ExcludeNamespaces         flag.StringArray
+	IncludeResources          flag.StringArray
+	ExcludeResources          flag.StringArray
+	Labels                    flag.Map
+	Selector                  flag.LabelSelector
+	NamespaceSelector         flag.LabelSelector
+	IncludeClusterResources   flag.OptionalBool
+	Wait                      bool
+	StorageLocation           string
+	Cluster                   string
+	SnapshotLocations         []string
+	AdditionalLocations       []string
+	FromSchedule              string
+	SearchIndex               bool
+	IncludeEventsAndPodLogs   bool
+	ReplicaPolicies           cli.ReplicaPolicyOptions
+	OrderedResources          flag.Map
+	IncludeResourceNames      flag.Map
+	ValidateOnly              bool
 
 	client veleroclient.Interface
 }
@@ -98,28 +119,53 @@ func NewCreateOptions() *CreateOptions {
 		TTL:                     DefaultBackupTTL,
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		Labels:                  flag.NewMap(),
+		OrderedResources:        flag.NewMap().WithEntryDelimiter(";"),
+		IncludeResourceNames:    flag.NewMap().WithEntryDelimiter(";"),
 		SnapshotVolumes:         flag.NewOptionalBool(nil),
+		DefaultVolumesToRestic:  flag.NewOptionalBool(nil),
+		MaxItems:                flag.NewOptionalInt(nil),
+		MaxSize:                 flag.NewQuantity(),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
 	}
 }
 
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.DurationVar(&o.TTL, "ttl", o.TTL, "how long before the backup can be garbage collected")
+	flags.DurationVar(&o.LogTTL, "log-ttl", o.LogTTL, "how long before the backup's logs, and the logs and results of restores from it, can be garbage collected. If unset, they're kept for as long as the backup")
+	flags.BoolVar(&o.SearchIndex, "search-index", o.SearchIndex, "build a search index of the backup's contents, so it can be searched with 'velero backup search'")
+	flags.BoolVar(&o.IncludeEventsAndPodLogs, "include-events-and-pod-logs", o.IncludeEventsAndPodLogs, "capture the events of the backed up namespaces and the most recent logs of the backed up pods, for troubleshooting. They aren't restored")
+	o.ReplicaPolicies.BindFlags(flags)
 	flags.Var(&o.IncludeNamespaces, "include-namespaces", "namespaces to include in the backup (use '*' for all namespaces)")
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "namespaces to exclude from the backup")
 	flags.Var(&o.IncludeResources, "include-resources", "resources to include in the backup, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources)")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "resources to exclude from the backup, formatted as resource.group, such as storageclasses.storage.k8s.io")
+	flags.Var(&o.IncludeResourceNames, "include-resource-names", "names of the items of resources to include in the backup, formatted as resource=item1,item2;resource2=item3, with namespaced items named namespace/name and '*' wildcards allowed, such as 'secrets=app/db-creds;persistentvolumes=pv-*'. If --include-resources isn't set, only these resources are included")
 	flags.Var(&o.Labels, "labels", "labels to apply to the backup")
+	flags.Var(&o.OrderedResources, "ordered-resources", "items of resources to back up first, in order, formatted as resource=item1,item2;resource2=item3, with namespaced items named namespace/name, such as 'pods=ns1/db-0,ns1/db-1;persistentvolumes=pv-1'")
 	flags.StringVar(&o.StorageLocation, "storage-location", "", "location in which to store the backup")
+	flags.StringVar(&o.Cluster, "cluster", "", "name of the registered member cluster to back up, if the Velero server runs in a management cluster. If unset, the cluster that the Velero server runs in is backed up")
+	flags.StringSliceVar(&o.AdditionalLocations, "additional-storage-locations", o.AdditionalLocations, "list of locations to copy the backup to after it is stored in its storage location")
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "list of locations (at most one per provider) where volume snapshots should be stored")
 	flags.VarP(&o.Selector, "selector", "l", "only back up resources matching this label selector")
+	flags.Var(&o.NamespaceSelector, "namespace-selector", "also back up the namespaces matching this label selector when the backup is run. If --include-namespaces isn't set, only these namespaces are backed up")
 	f := flags.VarPF(&o.SnapshotVolumes, "snapshot-volumes", "", "take snapshots of PersistentVolumes as part of the backup")
 	// this allows the user to just specify "--snapshot-volumes" as shorthand for "--snapshot-volumes=true"
 	// like a normal bool flag
 	f.NoOptDefVal = "true"
+	flags.Var(&o.ExcludeSnapshotNamespaces, "exclude-snapshot-namespaces", "namespaces whose PersistentVolumes should not be snapshotted, even if volume snapshots are enabled")
+	flags.Var(&o.ExcludeSnapshotSelector, "exclude-snapshot-selector", "don't snapshot PersistentVolumes matching this label selector, or claimed by PersistentVolumeClaims matching it")
+	flags.Var(&o.PodVolumeBackupSelectors, "pod-volume-backup-selector", "back up the volumes of pods matching this label selector with restic, in addition to the volumes listed in pods' backup.velero.io/backup-volumes annotations. May be specified multiple times; pods matching any selector are selected")
+
+	f = flags.VarPF(&o.DefaultVolumesToRestic, "default-volumes-to-restic", "", "back up all pod volumes with restic, except those listed in pods' backup.velero.io/backup-volumes-excludes annotations. If unset, the server's default is used")
+	f.NoOptDefVal = "true"
+
+	flags.Var(&o.MaxItems, "max-items", "the maximum number of items in the backup. If it would exceed it, the backup is aborted and fails. 0 means no limit. If unset, the server's default is used")
+	flags.Var(&o.MaxSize, "max-size", "the maximum size of the backup's compressed tarball, e.g. 10Gi. If it grows past it, the backup is aborted and fails. 0 means no limit. If unset, the server's default is used")
 
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "include cluster-scoped resources in the backup")
 	f.NoOptDefVal = "true"
+
+	flags.BoolVar(&o.ValidateOnly, "validate-only", o.ValidateOnly, "validate the spec against the server's validation rules and print any problems, without creating it")
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -139,10 +185,28 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return err
 	}
 
-	if o.StorageLocation != "" {
-		if _, err := o.client.VeleroV1().BackupStorageLocations(f.Namespace()).Get(o.StorageLocation, metav1.GetOptions{}); err != nil {
+	if o.ValidateOnly {
+		if o.Wait {
+			return errors.New("--validate-only can't be used with --wait")
+		}
+
+		// the storage and snapshot locations are checked along with the rest
+		// of the spec, so that all of its problems are printed together.
+		return nil
+	}
+
+	for _, name := range append([]string{o.StorageLocation}, o.AdditionalLocations...) {
+		if name == "" {
+			continue
+		}
+
+		location, err := o.client.VeleroV1().BackupStorageLocations(f.Namespace()).Get(name, metav1.GetOptions{})
+		if err != nil {
 			return err
 		}
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			return &cmd.ReadOnlyLocationError{Namespace: location.Namespace, Name: location.Name}
+		}
 	}
 
 	for _, loc := range o.SnapshotLocations {
@@ -170,6 +234,10 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return err
 	}
 
+	if o.ValidateOnly {
+		return PrintValidationProblems("Backup", o.ValidateSpec(f.Namespace(), backup.Spec))
+	}
+
 	if printed, err := output.PrintWithFormat(c, backup); printed || err != nil {
 		return err
 	}
@@ -239,7 +307,11 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 					return nil
 				}
 
-				if backup.Status.Phase != velerov1api.BackupPhaseNew && backup.Status.Phase != velerov1api.BackupPhaseInProgress {
+				switch backup.Status.Phase {
+				case velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress,
+					velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
+					// the backup hasn't finished yet.
+				default:
 					fmt.Printf("\nBackup completed with status: %s. You may check for more information using the commands `velero backup describe %s` and `velero backup logs %s`.\n", backup.Status.Phase, backup.Name, backup.Name)
 					return nil
 				}
@@ -254,6 +326,88 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 	return nil
 }
 
+// ValidateSpec runs the validation that the server runs on a new backup against
+// a backup spec, including checking its storage and volume snapshot locations,
+// and returns a message for each problem found.
+func (o *CreateOptions) ValidateSpec(namespace string, spec velerov1api.BackupSpec) []string {
+	problems := pkgbackup.ValidateSpec(spec)
+
+	for i, name := range append([]string{spec.StorageLocation}, spec.AdditionalStorageLocations...) {
+		if name == "" {
+			continue
+		}
+		if i > 0 && name == spec.StorageLocation {
+			problems = append(problems, fmt.Sprintf("additional storage location %s is the backup's storage location", name))
+			continue
+		}
+
+		location, err := o.client.VeleroV1().BackupStorageLocations(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				problems = append(problems, fmt.Sprintf("backup storage location %s does not exist", name))
+			} else {
+				problems = append(problems, fmt.Sprintf("error getting backup storage location %s: %v", name, err))
+			}
+			continue
+		}
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			problems = append(problems, fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", name))
+		}
+	}
+
+	providerLocations := make(map[string]string)
+	for _, name := range spec.VolumeSnapshotLocations {
+		location, err := o.client.VeleroV1().VolumeSnapshotLocations(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				problems = append(problems, fmt.Sprintf("volume snapshot location %s does not exist", name))
+			} else {
+				problems = append(problems, fmt.Sprintf("error getting volume snapshot location %s: %v", name, err))
+			}
+			continue
+		}
+
+		if other, ok := providerLocations[location.Spec.Provider]; ok && other != name {
+			problems = append(problems, fmt.Sprintf("more than one VolumeSnapshotLocation name specified for provider %s: %s; unexpected name was %s", location.Spec.Provider, name, other))
+			continue
+		}
+		providerLocations[location.Spec.Provider] = name
+	}
+
+	return problems
+}
+
+// PrintValidationProblems prints the problems found by validating a spec, one
+// per line, and returns an error if there are any.
+func PrintValidationProblems(kind string, problems []string) error {
+	if len(problems) == 0 {
+		fmt.Printf("%s spec is valid.\n", kind)
+		return nil
+	}
+
+	fmt.Printf("%s spec has %d problem(s):\n", kind, len(problems))
+	for _, problem := range problems {
+		fmt.Printf("  - %s\n", problem)
+	}
+
+	return errors.Errorf("%s spec is invalid", strings.ToLower(kind))
+}
+
+// ResourceNames returns the names of the items of resources to include in
+// the backup, from the --include-resource-names flag.
+func (o *CreateOptions) ResourceNames() map[string][]string {
+	names := make(map[string][]string)
+	for resource, items := range o.IncludeResourceNames.Data() {
+		for _, item := range strings.Split(items, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				names[resource] = append(names[resource], item)
+			}
+		}
+	}
+
+	return names
+}
+
 func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, error) {
 	backupBuilder := builder.ForBackup(namespace, o.Name)
 
@@ -270,13 +424,38 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			IncludedResources(o.IncludeResources...).
 			ExcludedResources(o.ExcludeResources...).
 			LabelSelector(o.Selector.LabelSelector).
+			NamespaceSelector(o.NamespaceSelector.LabelSelector).
 			TTL(o.TTL).
+			LogTTL(o.LogTTL).
+			SearchIndex(o.SearchIndex).
+			IncludeEventsAndPodLogs(o.IncludeEventsAndPodLogs).
+			ReplicaPolicies(o.ReplicaPolicies.Policies()...).
 			StorageLocation(o.StorageLocation).
-			VolumeSnapshotLocations(o.SnapshotLocations...)
-
+			Cluster(o.Cluster).
+			AdditionalStorageLocations(o.AdditionalLocations...).
+			VolumeSnapshotLocations(o.SnapshotLocations...).
+			ExcludedSnapshotNamespaces(o.ExcludeSnapshotNamespaces...).
+			ExcludedSnapshotLabelSelector(o.ExcludeSnapshotSelector.LabelSelector).
+			PodVolumeBackupSelectors(o.PodVolumeBackupSelectors.LabelSelectors...)
+
+		if len(o.OrderedResources.Data()) > 0 {
+			backupBuilder.OrderedResources(o.OrderedResources.Data())
+		}
+		if names := o.ResourceNames(); len(names) > 0 {
+			backupBuilder.IncludedResourceNames(names)
+		}
 		if o.SnapshotVolumes.Value != nil {
 			backupBuilder.SnapshotVolumes(*o.SnapshotVolumes.Value)
 		}
+		if o.DefaultVolumesToRestic.Value != nil {
+			backupBuilder.DefaultVolumesToRestic(*o.DefaultVolumesToRestic.Value)
+		}
+		if o.MaxItems.Value != nil {
+			backupBuilder.MaxItems(*o.MaxItems.Value)
+		}
+		if maxBytes := o.MaxSize.Bytes(); maxBytes != nil {
+			backupBuilder.MaxBytes(*maxBytes)
+		}
 		if o.IncludeClusterResources.Value != nil {
 			backupBuilder.IncludeClusterResources(*o.IncludeClusterResources.Value)
 		}
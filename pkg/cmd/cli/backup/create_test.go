@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -48,6 +49,33 @@ func TestCreateOptions_BuildBackup(t *testing.T) {
 	}, backup.GetLabels())
 }
 
+func TestCreateOptions_BuildBackupWithLimits(t *testing.T) {
+	o := NewCreateOptions()
+	require.NoError(t, o.MaxItems.Set("500"))
+	require.NoError(t, o.MaxSize.Set("10Gi"))
+
+	backup, err := o.BuildBackup(testNamespace)
+	require.NoError(t, err)
+
+	require.NotNil(t, backup.Spec.MaxItems)
+	require.NotNil(t, backup.Spec.MaxBytes)
+	assert.Equal(t, 500, *backup.Spec.MaxItems)
+	assert.Equal(t, int64(10<<30), *backup.Spec.MaxBytes)
+}
+
+func TestCreateOptions_BuildBackupWithResourceNames(t *testing.T) {
+	o := NewCreateOptions()
+	require.NoError(t, o.IncludeResourceNames.Set("secrets=app/db-creds, app/tls;persistentvolumes=pv-*"))
+
+	backup, err := o.BuildBackup(testNamespace)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"secrets":           {"app/db-creds", "app/tls"},
+		"persistentvolumes": {"pv-*"},
+	}, backup.Spec.IncludedResourceNames)
+}
+
 func TestCreateOptions_BuildBackupFromSchedule(t *testing.T) {
 	o := NewCreateOptions()
 	o.FromSchedule = "test"
@@ -86,3 +114,48 @@ func TestCreateOptions_BuildBackupFromSchedule(t *testing.T) {
 		}, backup.GetLabels())
 	})
 }
+
+func TestCreateOptions_ValidateSpec(t *testing.T) {
+	o := NewCreateOptions()
+	o.client = fake.NewSimpleClientset(
+		builder.ForBackupStorageLocation(testNamespace, "default").Result(),
+		builder.ForBackupStorageLocation(testNamespace, "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+		builder.ForVolumeSnapshotLocation(testNamespace, "aws-1").Provider("aws").Result(),
+		builder.ForVolumeSnapshotLocation(testNamespace, "aws-2").Provider("aws").Result(),
+	)
+
+	tests := []struct {
+		name string
+		spec velerov1api.BackupSpec
+		want []string
+	}{
+		{
+			name: "valid spec has no problems",
+			spec: builder.ForBackup(testNamespace, "backup-1").StorageLocation("default").VolumeSnapshotLocations("aws-1").Result().Spec,
+			want: nil,
+		},
+		{
+			name: "all problems are returned",
+			spec: builder.ForBackup(testNamespace, "backup-1").
+				IncludedNamespaces("ns-1").
+				ExcludedNamespaces("ns-1").
+				StorageLocation("missing").
+				AdditionalStorageLocations("read-only", "missing").
+				VolumeSnapshotLocations("aws-1", "aws-2").
+				Result().Spec,
+			want: []string{
+				"Invalid included/excluded namespace lists: excludes list cannot contain an item in the includes list: ns-1",
+				"backup storage location missing does not exist",
+				"backup can't be created because backup storage location read-only is currently in read-only mode",
+				"additional storage location missing is the backup's storage location",
+				"more than one VolumeSnapshotLocation name specified for provider aws: aws-2; unexpected name was aws-1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, o.ValidateSpec(testNamespace, tc.spec))
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
@@ -34,7 +35,7 @@ import (
 
 // NewDeleteCommand creates a new command that deletes a backup.
 func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
-	o := cli.NewDeleteOptions("backup")
+	o := NewDeleteOptions()
 
 	c := &cobra.Command{
 		Use:   fmt.Sprintf("%s [NAMES]", use),
@@ -53,6 +54,9 @@ func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
  
   # delete all backups
   velero backup delete --all
+
+  # delete a backup named "backup-1", recording why it was deleted
+  velero backup delete backup-1 --reason "CHG-1234: decommissioning the cluster"
   `,
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(f, args))
@@ -66,8 +70,33 @@ func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
 	return c
 }
 
+// DeleteOptions contains parameters used for deleting backups.
+type DeleteOptions struct {
+	*cli.DeleteOptions
+
+	Reason string
+}
+
+// NewDeleteOptions returns a DeleteOptions with default values.
+func NewDeleteOptions() *DeleteOptions {
+	return &DeleteOptions{
+		DeleteOptions: cli.NewDeleteOptions("backup"),
+	}
+}
+
+// BindFlags binds options for this command to flags.
+func (o *DeleteOptions) BindFlags(flags *pflag.FlagSet) {
+	o.DeleteOptions.BindFlags(flags)
+	flags.StringVar(&o.Reason, "reason", o.Reason, "why the backup is being deleted, recorded in the deletion request and in an event on the backup")
+}
+
+// Complete fills in the correct values for all the options.
+func (o *DeleteOptions) Complete(f client.Factory, args []string) error {
+	return o.DeleteOptions.Complete(f, args)
+}
+
 // Run performs the delete backup operation.
-func Run(o *cli.DeleteOptions) error {
+func Run(o *DeleteOptions) error {
 	if !o.Confirm && !cli.GetConfirmation() {
 		// Don't do anything unless we get confirmation
 		return nil
@@ -113,6 +142,7 @@ func Run(o *cli.DeleteOptions) error {
 	// create a backup deletion request for each
 	for _, b := range backups {
 		deleteRequest := backup.NewDeleteBackupRequest(b.Name, string(b.UID))
+		deleteRequest.Spec.Reason = o.Reason
 
 		if _, err := o.Client.VeleroV1().DeleteBackupRequests(o.Namespace).Create(deleteRequest); err != nil {
 			errs = append(errs, err)
@@ -120,6 +150,9 @@ func Run(o *cli.DeleteOptions) error {
 		}
 
 		fmt.Printf("Request to delete backup %q submitted successfully.\nThe backup will be fully deleted after all associated data (disk snapshots, backup files, restores) are removed.\n", b.Name)
+		if b.Labels[velerov1api.ProtectedBackupLabel] == "true" {
+			fmt.Printf("Backup %q is protected, so it won't be deleted until someone else approves the deletion with `velero backup approve-deletion %s`.\n", b.Name, b.Name)
+		}
 	}
 
 	return kubeerrs.NewAggregate(errs)
@@ -28,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 )
 
@@ -42,6 +43,8 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		Use:   use + " [NAME1] [NAME2] [NAME...]",
 		Short: "Describe backups",
 		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(output.ValidateFlags(c))
+
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
@@ -58,6 +61,11 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				cmd.CheckError(err)
 			}
 
+			if printed, err := output.PrintWithFormat(c, backups); printed || err != nil {
+				cmd.CheckError(err)
+				return
+			}
+
 			first := true
 			for _, backup := range backups.Items {
 				deleteRequestListOptions := pkgbackup.NewDeleteBackupRequestListOptions(backup.Name, string(backup.UID))
@@ -72,7 +80,13 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					fmt.Fprintf(os.Stderr, "error getting PodVolumeBackups for backup %s: %v\n", backup.Name, err)
 				}
 
-				s := output.DescribeBackup(&backup, deleteRequestList.Items, podVolumeBackupList.Items, details, veleroClient, insecureSkipTLSVerify)
+				restoreListOptions := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", v1.BackupNameLabel, label.GetValidName(backup.Name))}
+				restoreList, err := veleroClient.VeleroV1().Restores(f.Namespace()).List(restoreListOptions)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "error getting Restores for backup %s: %v\n", backup.Name, err)
+				}
+
+				s := output.DescribeBackup(&backup, deleteRequestList.Items, podVolumeBackupList.Items, restoreList.Items, details, veleroClient, insecureSkipTLSVerify)
 				if first {
 					first = false
 					fmt.Print(s)
@@ -85,6 +99,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	}
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "only show items matching this label selector")
+	output.BindDescribeFlags(c.Flags())
 	c.Flags().BoolVar(&details, "details", details, "display additional detail in the command output")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 
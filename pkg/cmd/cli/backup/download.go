@@ -17,7 +17,11 @@ limitations under the License.
 package backup
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"time"
@@ -26,11 +30,16 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
 func NewDownloadCommand(f client.Factory) *cobra.Command {
@@ -57,12 +66,15 @@ type DownloadOptions struct {
 	Force                 bool
 	Timeout               time.Duration
 	InsecureSkipTLSVerify bool
+	IncludeNamespaces     flag.StringArray
+	ExcludeNamespaces     flag.StringArray
 	writeOptions          int
 }
 
 func NewDownloadOptions() *DownloadOptions {
 	return &DownloadOptions{
-		Timeout: time.Minute,
+		Timeout:           time.Minute,
+		IncludeNamespaces: flag.NewStringArray("*"),
 	}
 }
 
@@ -71,6 +83,8 @@ func (o *DownloadOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.Force, "force", o.Force, "forces the download and will overwrite file if it exists already")
 	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "maximum time to wait to process download request")
 	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.Var(&o.IncludeNamespaces, "include-namespaces", "namespaces whose items to download (use '*' for all namespaces). Only applies to backups whose archives are split by namespace")
+	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "namespaces whose items not to download. Only applies to backups whose archives are split by namespace")
 }
 
 func (o *DownloadOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -81,6 +95,10 @@ func (o *DownloadOptions) Validate(c *cobra.Command, args []string, f client.Fac
 		return err
 	}
 
+	if errs := collections.ValidateIncludesExcludes(o.IncludeNamespaces, o.ExcludeNamespaces); len(errs) > 0 {
+		return kubeerrs.NewAggregate(errs)
+	}
+
 	return nil
 }
 
@@ -113,7 +131,7 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	}
 	defer backupDest.Close()
 
-	err = downloadrequest.Stream(veleroClient.VeleroV1(), f.Namespace(), o.Name, v1.DownloadTargetKindBackupContents, backupDest, o.Timeout, o.InsecureSkipTLSVerify)
+	err = o.download(veleroClient.VeleroV1(), f.Namespace(), backupDest)
 	if err != nil {
 		os.Remove(o.Output)
 		cmd.CheckError(err)
@@ -122,3 +140,52 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Backup %s has been successfully downloaded to %s\n", o.Name, backupDest.Name())
 	return nil
 }
+
+// download writes the backup's archive to w. If the archive is split into a
+// main archive and per-namespace sub-archives, the sub-archives of the included
+// namespaces are downloaded and merged with the main archive.
+func (o *DownloadOptions) download(client velerov1client.DownloadRequestsGetter, namespace string, w io.Writer) error {
+	indexBuf := new(bytes.Buffer)
+	err := downloadrequest.Stream(client, namespace, o.Name, v1.DownloadTargetKindBackupIndex, indexBuf, o.Timeout, o.InsecureSkipTLSVerify)
+	if err == downloadrequest.ErrNotFound {
+		// backups whose archives aren't split don't have an index.
+		return downloadrequest.Stream(client, namespace, o.Name, v1.DownloadTargetKindBackupContents, w, o.Timeout, o.InsecureSkipTLSVerify)
+	}
+	if err != nil {
+		return err
+	}
+
+	index := new(archive.Index)
+	if err := json.NewDecoder(indexBuf).Decode(index); err != nil {
+		return errors.Wrap(err, "error decoding backup index")
+	}
+
+	targets := []v1.DownloadTarget{{Kind: v1.DownloadTargetKindBackupContents, Name: o.Name}}
+	namespaces := collections.NewIncludesExcludes().Includes(o.IncludeNamespaces...).Excludes(o.ExcludeNamespaces...)
+	for _, ns := range index.Namespaces {
+		if namespaces.ShouldInclude(ns.Name) {
+			targets = append(targets, v1.DownloadTarget{Kind: v1.DownloadTargetKindBackupNamespaceContents, Name: o.Name, Namespace: ns.Name})
+		}
+	}
+
+	var srcs []io.Reader
+	for _, target := range targets {
+		file, err := ioutil.TempFile("", o.Name)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer os.Remove(file.Name())
+		defer file.Close()
+
+		if err := downloadrequest.StreamTarget(client, namespace, target, file, o.Timeout, o.InsecureSkipTLSVerify); err != nil {
+			return err
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return errors.WithStack(err)
+		}
+
+		srcs = append(srcs, file)
+	}
+
+	return archive.Merge(w, srcs...)
+}
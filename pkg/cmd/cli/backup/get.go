@@ -17,49 +17,217 @@ limitations under the License.
 package backup
 
 import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
 )
 
 func NewGetCommand(f client.Factory, use string) *cobra.Command {
-	var listOptions metav1.ListOptions
+	o := NewGetOptions()
 
 	c := &cobra.Command{
 		Use:   use,
 		Short: "Get backups",
+		Example: `	velero backup get --schedule daily --status Failed,PartiallyFailed
+	velero backup get --storage-location default -l app=nginx
+	velero backup get --since 24h --limit 20`,
 		Run: func(c *cobra.Command, args []string) {
 			err := output.ValidateFlags(c)
 			cmd.CheckError(err)
 
+			cmd.CheckError(o.Complete())
+
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
-			var backups *api.BackupList
-			if len(args) > 0 {
-				backups = new(api.BackupList)
-				for _, name := range args {
-					backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(name, metav1.GetOptions{})
-					cmd.CheckError(err)
-					backups.Items = append(backups.Items, *backup)
-				}
-			} else {
-				backups, err = veleroClient.VeleroV1().Backups(f.Namespace()).List(listOptions)
-				cmd.CheckError(err)
-			}
+			backups, err := o.getBackups(veleroClient.VeleroV1(), f.Namespace(), args, time.Now())
+			cmd.CheckError(err)
 
 			_, err = output.PrintWithFormat(c, backups)
 			cmd.CheckError(err)
 		},
 	}
 
-	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "only show items matching this label selector")
-
+	o.BindFlags(c.Flags())
 	output.BindFlags(c.Flags())
 
 	return c
 }
+
+// GetOptions are the options for filtering the backups listed by
+// `velero backup get`.
+type GetOptions struct {
+	Selector        string
+	Schedule        string
+	StorageLocation string
+	Statuses        flag.StringArray
+	output.ListLimit
+
+	listOptions metav1.ListOptions
+	selector    labels.Selector
+	phases      map[api.BackupPhase]bool
+}
+
+func NewGetOptions() *GetOptions {
+	return &GetOptions{}
+}
+
+func (o *GetOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&o.Selector, "selector", "l", o.Selector, "only show items matching this label selector")
+	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "only show backups created by this schedule")
+	flags.StringVar(&o.StorageLocation, "storage-location", o.StorageLocation, "only show backups in this backup storage location")
+	flags.Var(&o.Statuses, "status", "only show backups with one of these statuses, e.g. Completed or Failed")
+	o.ListLimit.BindFlags(flags)
+}
+
+// getBackups gets the backups with the given names, or lists the backups
+// if there are none, and returns the ones that match the filters, newest
+// first.
+func (o *GetOptions) getBackups(client velerov1client.BackupsGetter, namespace string, names []string, now time.Time) (*api.BackupList, error) {
+	backups := new(api.BackupList)
+	if len(names) > 0 {
+		for _, name := range names {
+			backup, err := client.Backups(namespace).Get(name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			backups.Items = append(backups.Items, *backup)
+		}
+	} else {
+		err := output.ListPages(o.listOptions, func(listOptions metav1.ListOptions) (string, error) {
+			page, err := client.Backups(namespace).List(listOptions)
+			if err != nil {
+				return "", err
+			}
+			backups.Items = append(backups.Items, page.Items...)
+			return page.Continue, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	backups.Items = o.filter(backups.Items, now)
+	return backups, nil
+}
+
+// backupPhases are the statuses that can be passed to --status.
+var backupPhases = []api.BackupPhase{
+	api.BackupPhaseNew,
+	api.BackupPhaseFailedValidation,
+	api.BackupPhaseInProgress,
+	api.BackupPhaseWaitingForPluginOperations,
+	api.BackupPhaseWaitingForPluginOperationsPartiallyFailed,
+	api.BackupPhaseCompleted,
+	api.BackupPhasePartiallyFailed,
+	api.BackupPhaseFailed,
+	api.BackupPhaseDeleting,
+}
+
+// Complete validates the filters and builds the label selector that's used to
+// list backups. The schedule and storage location filters are label
+// selections, which the API server applies; the statuses are filtered
+// client-side since custom resources can't be listed by status.
+func (o *GetOptions) Complete() error {
+	if err := o.ListLimit.Validate(); err != nil {
+		return err
+	}
+
+	selector, err := labels.Parse(o.Selector)
+	if err != nil {
+		return errors.Wrap(err, "invalid label selector")
+	}
+
+	set := labels.Set{}
+	if o.Schedule != "" {
+		set[api.ScheduleNameLabel] = o.Schedule
+	}
+	if o.StorageLocation != "" {
+		set[api.StorageLocationLabel] = label.GetValidName(o.StorageLocation)
+	}
+
+	o.selector = selector
+	requirements, _ := set.AsSelector().Requirements()
+	o.listOptions.LabelSelector = selector.Add(requirements...).String()
+
+	o.phases = nil
+	for _, status := range o.Statuses {
+		phase, ok := parseBackupPhase(status)
+		if !ok {
+			return errors.Errorf("invalid status %q, valid statuses are %v", status, backupPhases)
+		}
+
+		if o.phases == nil {
+			o.phases = make(map[api.BackupPhase]bool)
+		}
+		o.phases[phase] = true
+	}
+
+	return nil
+}
+
+func parseBackupPhase(status string) (api.BackupPhase, bool) {
+	for _, phase := range backupPhases {
+		if strings.EqualFold(status, string(phase)) {
+			return phase, true
+		}
+	}
+
+	return "", false
+}
+
+// filter returns the backups that match the filters, newest first. Backups
+// that were listed by label selection already match the labels, but backups
+// that were gotten by name haven't been filtered yet. The storage location is
+// matched against the backup's spec, since older backups may not have its label.
+func (o *GetOptions) filter(backups []api.Backup, now time.Time) []api.Backup {
+	res := make([]api.Backup, 0, len(backups))
+	for _, backup := range backups {
+		if !o.selector.Matches(labels.Set(backup.Labels)) {
+			continue
+		}
+		if o.Schedule != "" && backup.Labels[api.ScheduleNameLabel] != o.Schedule {
+			continue
+		}
+		if o.StorageLocation != "" && backup.Spec.StorageLocation != o.StorageLocation {
+			continue
+		}
+		if o.phases != nil && !o.phases[phaseOf(backup)] {
+			continue
+		}
+		if !o.ListLimit.Includes(backup.CreationTimestamp, now) {
+			continue
+		}
+
+		res = append(res, backup)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return output.NewestFirst(res[i].CreationTimestamp, res[j].CreationTimestamp)
+	})
+
+	return res[:o.ListLimit.Truncate(len(res))]
+}
+
+// phaseOf returns a backup's phase, treating backups that haven't been
+// processed yet as New.
+func phaseOf(backup api.Backup) api.BackupPhase {
+	if backup.Status.Phase == "" {
+		return api.BackupPhaseNew
+	}
+	return backup.Status.Phase
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+)
+
+func TestGetOptionsComplete(t *testing.T) {
+	tests := []struct {
+		name         string
+		options      *GetOptions
+		wantSelector string
+		wantErr      bool
+	}{
+		{
+			name:    "no filters",
+			options: &GetOptions{},
+		},
+		{
+			name: "schedule and storage location are added to the label selector",
+			options: &GetOptions{
+				Selector:        "app=nginx",
+				Schedule:        "daily",
+				StorageLocation: "default",
+			},
+			wantSelector: "app=nginx,velero.io/schedule-name=daily,velero.io/storage-location=default",
+		},
+		{
+			name:    "invalid label selector is an error",
+			options: &GetOptions{Selector: "app in ("},
+			wantErr: true,
+		},
+		{
+			name:    "invalid status is an error",
+			options: &GetOptions{Statuses: flag.NewStringArray("Done")},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.options.Complete()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantSelector, tc.options.listOptions.LabelSelector)
+		})
+	}
+}
+
+func TestGetOptionsFilter(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	backups := []velerov1api.Backup{
+		*builder.ForBackup("velero", "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily"), builder.WithCreationTimestamp(now.Add(-4*time.Hour))).Result(),
+		*builder.ForBackup("velero", "backup-2").StorageLocation("default").Phase(velerov1api.BackupPhaseFailed).
+			ObjectMeta(builder.WithCreationTimestamp(now.Add(-3 * time.Hour))).Result(),
+		*builder.ForBackup("velero", "backup-3").StorageLocation("secondary").Phase(velerov1api.BackupPhasePartiallyFailed).
+			ObjectMeta(builder.WithCreationTimestamp(now.Add(-2 * time.Hour))).Result(),
+		*builder.ForBackup("velero", "backup-4").StorageLocation("default").
+			ObjectMeta(builder.WithCreationTimestamp(now.Add(-1 * time.Hour))).Result(),
+	}
+
+	tests := []struct {
+		name    string
+		options *GetOptions
+		want    []string
+	}{
+		{
+			name:    "no filters shows all backups newest first",
+			options: &GetOptions{},
+			want:    []string{"backup-4", "backup-3", "backup-2", "backup-1"},
+		},
+		{
+			name:    "schedule",
+			options: &GetOptions{Schedule: "daily"},
+			want:    []string{"backup-1"},
+		},
+		{
+			name:    "storage location",
+			options: &GetOptions{StorageLocation: "secondary"},
+			want:    []string{"backup-3"},
+		},
+		{
+			name:    "statuses are case-insensitive",
+			options: &GetOptions{Statuses: flag.NewStringArray("failed", "PartiallyFailed")},
+			want:    []string{"backup-3", "backup-2"},
+		},
+		{
+			name:    "backups without a phase are New",
+			options: &GetOptions{Statuses: flag.NewStringArray("New")},
+			want:    []string{"backup-4"},
+		},
+		{
+			name:    "since",
+			options: &GetOptions{ListLimit: output.ListLimit{Since: 150 * time.Minute}},
+			want:    []string{"backup-4", "backup-3"},
+		},
+		{
+			name:    "limit",
+			options: &GetOptions{ListLimit: output.ListLimit{Limit: 3}, StorageLocation: "default"},
+			want:    []string{"backup-4", "backup-2", "backup-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.options.Complete())
+
+			var names []string
+			for _, backup := range tc.options.filter(backups, now) {
+				names = append(names, backup.Name)
+			}
+
+			assert.Equal(t, tc.want, names)
+		})
+	}
+}
+
+func TestGetBackups(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// the backups are listed in name order, which isn't the order they were
+	// created in.
+	client := fake.NewSimpleClientset(
+		builder.ForBackup("velero", "backup-a").ObjectMeta(builder.WithCreationTimestamp(now.Add(-2*time.Hour))).Result(),
+		builder.ForBackup("velero", "backup-b").ObjectMeta(builder.WithCreationTimestamp(now.Add(-4*time.Hour))).Result(),
+		builder.ForBackup("velero", "backup-c").ObjectMeta(builder.WithCreationTimestamp(now.Add(-1*time.Hour))).Result(),
+		builder.ForBackup("velero", "backup-d").ObjectMeta(builder.WithCreationTimestamp(now.Add(-3*time.Hour))).Result(),
+	)
+
+	tests := []struct {
+		name  string
+		args  []string
+		flags []string
+		want  []string
+	}{
+		{
+			name: "backups are shown newest first",
+			want: []string{"backup-c", "backup-a", "backup-d", "backup-b"},
+		},
+		{
+			name:  "--limit shows the newest backups",
+			flags: []string{"--limit", "2"},
+			want:  []string{"backup-c", "backup-a"},
+		},
+		{
+			name:  "--limit and --since",
+			flags: []string{"--limit", "3", "--since", "150m"},
+			want:  []string{"backup-c", "backup-a"},
+		},
+		{
+			name:  "backups gotten by name are shown newest first and limited too",
+			args:  []string{"backup-b", "backup-d", "backup-c"},
+			flags: []string{"--limit", "2"},
+			want:  []string{"backup-c", "backup-d"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			o := NewGetOptions()
+			flags := pflag.NewFlagSet("get", pflag.ContinueOnError)
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse(tc.flags))
+			require.NoError(t, o.Complete())
+
+			backups, err := o.getBackups(client.VeleroV1(), "velero", tc.args, now)
+			require.NoError(t, err)
+
+			var names []string
+			for _, backup := range backups.Items {
+				names = append(names, backup.Name)
+			}
+			assert.Equal(t, tc.want, names)
+		})
+	}
+}
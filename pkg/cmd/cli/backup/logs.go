@@ -52,8 +52,9 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 			}
 
 			switch backup.Status.Phase {
-			case v1.BackupPhaseCompleted, v1.BackupPhasePartiallyFailed, v1.BackupPhaseFailed:
-				// terminal phases, do nothing.
+			case v1.BackupPhaseCompleted, v1.BackupPhasePartiallyFailed, v1.BackupPhaseFailed,
+				v1.BackupPhaseWaitingForPluginOperations, v1.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
+				// terminal phases, and phases after the log is uploaded, do nothing.
 			default:
 				cmd.Exit("Logs for backup %q are not available until it's finished processing. Please wait "+
 					"until the backup has a phase of Completed or Failed and try again.", backupName)
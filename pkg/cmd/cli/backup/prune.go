@@ -0,0 +1,208 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+)
+
+// NewPruneCommand creates a new command that deletes the oldest backups of a
+// schedule.
+func NewPruneCommand(f client.Factory, use string) *cobra.Command {
+	o := NewPruneOptions()
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Delete the oldest backups of a schedule",
+		Long: `Delete the oldest backups of a schedule, keeping its most recent ones.
+
+Completed and partially failed backups are counted, newest first, and a deletion request is
+submitted for each of them beyond the number to keep. Backups of member clusters are counted
+separately for each cluster. Backups that are still running, failed or already being deleted
+are left alone.`,
+		Example: `  # list the backups of schedule "daily" that would be deleted to keep its 14 most recent backups
+  velero backup prune --schedule daily --keep 14 --dry-run
+
+  # delete the backups of schedule "daily" beyond its 14 most recent backups, without prompting for confirmation
+  velero backup prune --schedule daily --keep 14 --confirm`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run())
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// PruneOptions contains parameters used for pruning the backups of a schedule.
+type PruneOptions struct {
+	Schedule string
+	Keep     int
+	DryRun   bool
+	Confirm  bool
+
+	client    clientset.Interface
+	namespace string
+}
+
+// NewPruneOptions returns a PruneOptions with default values.
+func NewPruneOptions() *PruneOptions {
+	return &PruneOptions{Keep: -1}
+}
+
+// BindFlags binds options for this command to flags.
+func (o *PruneOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "schedule whose backups to prune. Required")
+	flags.IntVar(&o.Keep, "keep", o.Keep, "number of the schedule's most recent backups to keep. Required")
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "list the backups that would be deleted, without deleting them")
+	flags.BoolVar(&o.Confirm, "confirm", o.Confirm, "Confirm deletion")
+}
+
+// Complete fills in the correct values for all the options.
+func (o *PruneOptions) Complete(f client.Factory) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.client = client
+	o.namespace = f.Namespace()
+
+	return nil
+}
+
+// Validate validates the options.
+func (o *PruneOptions) Validate() error {
+	if o.Schedule == "" {
+		return errors.New("--schedule is required")
+	}
+	if o.Keep < 0 {
+		return errors.New("--keep is required, and must be non-negative")
+	}
+	return nil
+}
+
+// Run submits deletion requests for the schedule's backups beyond the
+// number to keep, or lists them if it's a dry run.
+func (o *PruneOptions) Run() error {
+	res, err := o.client.VeleroV1().Backups(o.namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{velerov1api.ScheduleNameLabel: o.Schedule}).String(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	backups := backupsToPrune(res.Items, o.Keep)
+	if len(backups) == 0 {
+		fmt.Printf("Schedule %q has no backups beyond its %d most recent ones\n", o.Schedule, o.Keep)
+		return nil
+	}
+
+	fmt.Printf("Backups of schedule %q beyond its %d most recent ones:\n", o.Schedule, o.Keep)
+	for _, b := range backups {
+		fmt.Printf("  %s\t(started %s)\n", b.Name, pruneBackupTime(b).Format(time.RFC3339))
+	}
+
+	if o.DryRun {
+		return nil
+	}
+
+	if !o.Confirm && !cli.GetConfirmation() {
+		// Don't do anything unless we get confirmation
+		return nil
+	}
+
+	var errs []error
+	for _, b := range backups {
+		deleteRequest := backup.NewDeleteBackupRequest(b.Name, string(b.UID))
+		deleteRequest.Spec.Reason = fmt.Sprintf("pruned to the %d most recent backups of schedule %s", o.Keep, o.Schedule)
+
+		if _, err := o.client.VeleroV1().DeleteBackupRequests(o.namespace).Create(deleteRequest); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		fmt.Printf("Request to delete backup %q submitted successfully.\n", b.Name)
+		if b.Labels[velerov1api.ProtectedBackupLabel] == "true" {
+			fmt.Printf("Backup %q is protected, so it won't be deleted until someone else approves the deletion with `velero backup approve-deletion %s`.\n", b.Name, b.Name)
+		}
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
+
+// pruneBackupTime returns the time that a backup was started, or created if
+// it hasn't been started.
+func pruneBackupTime(backup *velerov1api.Backup) time.Time {
+	if !backup.Status.StartTimestamp.IsZero() {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
+
+// backupsToPrune returns the completed and partially failed backups beyond the
+// keep most recent ones of each cluster, oldest first.
+func backupsToPrune(backups []velerov1api.Backup, keep int) []*velerov1api.Backup {
+	byCluster := make(map[string][]*velerov1api.Backup)
+	for i := range backups {
+		b := &backups[i]
+		if b.Status.Phase != velerov1api.BackupPhaseCompleted && b.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+			continue
+		}
+		byCluster[b.Spec.Cluster] = append(byCluster[b.Spec.Cluster], b)
+	}
+
+	var prune []*velerov1api.Backup
+	for _, clusterBackups := range byCluster {
+		// newest first.
+		sort.SliceStable(clusterBackups, func(i, j int) bool {
+			return pruneBackupTime(clusterBackups[i]).After(pruneBackupTime(clusterBackups[j]))
+		})
+		if len(clusterBackups) > keep {
+			prune = append(prune, clusterBackups[keep:]...)
+		}
+	}
+
+	// oldest first.
+	sort.SliceStable(prune, func(i, j int) bool {
+		if t1, t2 := pruneBackupTime(prune[i]), pruneBackupTime(prune[j]); !t1.Equal(t2) {
+			return t1.Before(t2)
+		}
+		return prune[i].Name < prune[j].Name
+	})
+
+	return prune
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestBackupsToPrune(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	backup := func(name string, hoursAgo int, phase velerov1api.BackupPhase) *builder.BackupBuilder {
+		return builder.ForBackup("velero", name).Phase(phase).StartTimestamp(now.Add(time.Duration(-hoursAgo) * time.Hour))
+	}
+
+	backups := []velerov1api.Backup{
+		*backup("daily-1", 4, velerov1api.BackupPhaseCompleted).Result(),
+		*backup("daily-2", 3, velerov1api.BackupPhasePartiallyFailed).Result(),
+		*backup("daily-3", 2, velerov1api.BackupPhaseFailed).Result(),
+		*backup("daily-4", 1, velerov1api.BackupPhaseCompleted).Result(),
+		*backup("daily-5", 0, velerov1api.BackupPhaseInProgress).Result(),
+		*backup("daily-cluster-b-1", 4, velerov1api.BackupPhaseCompleted).Cluster("cluster-b").Result(),
+		*backup("daily-cluster-b-2", 1, velerov1api.BackupPhaseCompleted).Cluster("cluster-b").Result(),
+	}
+
+	tests := []struct {
+		name string
+		keep int
+		want []string
+	}{
+		{
+			name: "backups beyond the most recent ones of each cluster are pruned, oldest first",
+			keep: 1,
+			want: []string{"daily-1", "daily-cluster-b-1", "daily-2"},
+		},
+		{
+			name: "keeping as many backups as there are prunes none",
+			keep: 3,
+			want: nil,
+		},
+		{
+			name: "keeping none prunes all completed and partially failed backups",
+			keep: 0,
+			want: []string{"daily-1", "daily-cluster-b-1", "daily-2", "daily-4", "daily-cluster-b-2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var names []string
+			for _, backup := range backupsToPrune(backups, tc.keep) {
+				names = append(names, backup.Name)
+			}
+			assert.Equal(t, tc.want, names)
+		})
+	}
+}
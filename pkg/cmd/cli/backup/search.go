@@ -0,0 +1,189 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+const searchDownloadRequestTimeout = 30 * time.Second
+
+func NewSearchCommand(f client.Factory, use string) *cobra.Command {
+	o := NewSearchOptions()
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Search backups for an object",
+		Long: `Search the search indexes of backups to find which backups contain an object.
+
+Only completed backups that were created with --search-index are searched. The --name and
+--namespace-pattern flags accept glob patterns, and --kind matches an object's kind or resource,
+case-insensitively.`,
+		Example: `	velero backup search --kind secret --name '*db*'
+	velero backup search --kind deployments --namespace-pattern 'prod-*' --storage-location default`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type SearchOptions struct {
+	Kind                  string
+	Name                  string
+	Namespace             string
+	Selector              string
+	StorageLocation       string
+	InsecureSkipTLSVerify bool
+
+	query pkgbackup.SearchQuery
+}
+
+func NewSearchOptions() *SearchOptions {
+	return &SearchOptions{}
+}
+
+func (o *SearchOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Kind, "kind", o.Kind, "only show objects of this kind or resource")
+	flags.StringVar(&o.Name, "name", o.Name, "only show objects whose name matches this glob pattern")
+	flags.StringVar(&o.Namespace, "namespace-pattern", o.Namespace, "only show objects whose namespace matches this glob pattern")
+	flags.StringVarP(&o.Selector, "selector", "l", o.Selector, "only show objects matching this label selector")
+	flags.StringVar(&o.StorageLocation, "storage-location", o.StorageLocation, "only search backups in this backup storage location")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+}
+
+func (o *SearchOptions) Validate() error {
+	o.query = pkgbackup.SearchQuery{
+		Kind:      o.Kind,
+		Name:      o.Name,
+		Namespace: o.Namespace,
+	}
+
+	if o.Selector != "" {
+		selector, err := labels.Parse(o.Selector)
+		if err != nil {
+			return errors.Wrap(err, "invalid label selector")
+		}
+		o.query.Selector = selector
+	}
+
+	return o.query.Validate()
+}
+
+func (o *SearchOptions) Run(c *cobra.Command, f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	listOptions := metav1.ListOptions{}
+	if o.StorageLocation != "" {
+		listOptions.LabelSelector = labels.Set{velerov1api.StorageLocationLabel: o.StorageLocation}.AsSelector().String()
+	}
+
+	backups, err := veleroClient.VeleroV1().Backups(f.Namespace()).List(listOptions)
+	if err != nil {
+		return err
+	}
+
+	var results []searchResult
+	for _, backup := range backups.Items {
+		if !backup.Spec.SearchIndex || backup.Status.Phase != velerov1api.BackupPhaseCompleted {
+			continue
+		}
+
+		buf := new(bytes.Buffer)
+		if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupSearchIndex, buf, searchDownloadRequestTimeout, o.InsecureSkipTLSVerify); err != nil {
+			if err == downloadrequest.ErrNotFound {
+				fmt.Printf("Search index for backup %s not found, skipping.\n", backup.Name)
+				continue
+			}
+			return errors.Wrapf(err, "error getting search index for backup %s", backup.Name)
+		}
+
+		var entries []pkgbackup.SearchIndexEntry
+		if err := json.NewDecoder(buf).Decode(&entries); err != nil {
+			return errors.Wrapf(err, "error decoding search index for backup %s", backup.Name)
+		}
+
+		results = append(results, searchIndex(backup.Name, entries, o.query)...)
+	}
+
+	if len(results) == 0 {
+		fmt.Println("No matching objects found.")
+		return nil
+	}
+
+	fmt.Print(describeSearchResults(results))
+	return nil
+}
+
+// searchResult is an object found in a backup's search index.
+type searchResult struct {
+	backup string
+	entry  pkgbackup.SearchIndexEntry
+}
+
+// searchIndex returns the entries in a backup's search index that match query.
+func searchIndex(backup string, entries []pkgbackup.SearchIndexEntry, query pkgbackup.SearchQuery) []searchResult {
+	var results []searchResult
+	for _, entry := range entries {
+		if query.Matches(entry) {
+			results = append(results, searchResult{backup: backup, entry: entry})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].entry.Path < results[j].entry.Path
+	})
+
+	return results
+}
+
+func describeSearchResults(results []searchResult) string {
+	return output.Describe(func(d *output.Describer) {
+		d.Printf("BACKUP\tRESOURCE\tNAMESPACE\tNAME\tPATH\n")
+		for _, result := range results {
+			namespace := result.entry.Namespace
+			if namespace == "" {
+				namespace = "<cluster>"
+			}
+			d.Printf("%s\t%s\t%s\t%s\t%s\n", result.backup, result.entry.Resource, namespace, result.entry.Name, result.entry.Path)
+		}
+	})
+}
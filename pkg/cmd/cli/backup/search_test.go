@@ -0,0 +1,56 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+)
+
+func TestSearchOptionsValidate(t *testing.T) {
+	o := &SearchOptions{Kind: "secret", Name: "*db*", Selector: "app=db"}
+	require.NoError(t, o.Validate())
+	assert.Equal(t, "secret", o.query.Kind)
+	assert.Equal(t, "*db*", o.query.Name)
+	assert.Equal(t, "app=db", o.query.Selector.String())
+
+	o = &SearchOptions{Selector: "app in (db"}
+	assert.Error(t, o.Validate())
+
+	o = &SearchOptions{Name: "[db"}
+	assert.Error(t, o.Validate())
+}
+
+func TestSearchIndex(t *testing.T) {
+	entries := []pkgbackup.SearchIndexEntry{
+		{Resource: "secrets", Kind: "Secret", Namespace: "ns-1", Name: "web-tls", Path: "resources/secrets/namespaces/ns-1/web-tls.json"},
+		{Resource: "secrets", Kind: "Secret", Namespace: "ns-2", Name: "postgres-db", Path: "resources/secrets/namespaces/ns-2/postgres-db.json"},
+		{Resource: "secrets", Kind: "Secret", Namespace: "ns-1", Name: "mysql-db", Path: "resources/secrets/namespaces/ns-1/mysql-db.json"},
+		{Resource: "configmaps", Kind: "ConfigMap", Namespace: "ns-1", Name: "db-config", Path: "resources/configmaps/namespaces/ns-1/db-config.json"},
+	}
+
+	results := searchIndex("backup-1", entries, pkgbackup.SearchQuery{Kind: "secret", Name: "*db*"})
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "backup-1", results[0].backup)
+	assert.Equal(t, "mysql-db", results[0].entry.Name)
+	assert.Equal(t, "postgres-db", results[1].entry.Name)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// NewSyncCommand creates a new command that requests a sync of the backups in
+// a backup storage location.
+func NewSyncCommand(f client.Factory, use string) *cobra.Command {
+	o := NewSyncOptions()
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Sync backups from a backup storage location into the cluster",
+		Long: `Sync backups from a backup storage location into the cluster right away, rather than at the
+Velero server's next periodic sync.
+
+With --force-rebuild, the custom resources of the backups in the location that already exist in
+the cluster are deleted and recreated from the backups' metadata in the location, keeping their
+labels, along with their pod volume backups. Use it to recover backups whose custom resources
+were lost or corrupted, e.g. by restoring etcd. Backups that are being processed or deleted, and
+backups in other locations, are left as they are.`,
+		Example: `  # sync the backups in the "default" location
+  velero backup sync --location default
+
+  # rebuild the custom resources of all backups in the "default" location
+  velero backup sync --location default --force-rebuild`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// SyncOptions contains parameters used for requesting a sync of a backup
+// storage location.
+type SyncOptions struct {
+	Location     string
+	ForceRebuild bool
+}
+
+// NewSyncOptions returns a SyncOptions with default values.
+func NewSyncOptions() *SyncOptions {
+	return &SyncOptions{}
+}
+
+// BindFlags binds options for this command to flags.
+func (o *SyncOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Location, "location", o.Location, "the backup storage location to sync. Required")
+	flags.BoolVar(&o.ForceRebuild, "force-rebuild", o.ForceRebuild, "delete and recreate the custom resources of backups that already exist in the cluster from their metadata in the location")
+}
+
+// Validate validates the fields of the SyncOptions.
+func (o *SyncOptions) Validate() error {
+	if o.Location == "" {
+		return errors.New("--location is required")
+	}
+	return nil
+}
+
+// Run requests the sync.
+func (o *SyncOptions) Run(f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	if err := requestSync(veleroClient.VeleroV1(), f.Namespace(), o.Location, o.ForceRebuild, time.Now()); err != nil {
+		return err
+	}
+
+	if o.ForceRebuild {
+		fmt.Printf("Requested a rebuild of the backups in backup storage location %q. Use 'velero backup get --storage-location %s' to see them once it's synced.\n", o.Location, o.Location)
+	} else {
+		fmt.Printf("Requested a sync of backup storage location %q. Use 'velero backup get --storage-location %s' to see its backups once it's synced.\n", o.Location, o.Location)
+	}
+
+	return nil
+}
+
+// requestSync annotates a backup storage location to request that it's synced
+// right away, rebuilding the custom resources of its backups if rebuild is true.
+func requestSync(client velerov1client.BackupStorageLocationsGetter, namespace, name string, rebuild bool, now time.Time) error {
+	location, err := client.BackupStorageLocations(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	annotation := velerov1api.SyncBackupsAnnotation
+	if rebuild {
+		annotation = velerov1api.RebuildBackupsAnnotation
+	}
+
+	updated := location.DeepCopy()
+	if updated.Annotations == nil {
+		updated.Annotations = make(map[string]string)
+	}
+	updated.Annotations[annotation] = now.UTC().Format(time.RFC3339)
+
+	return kube.Patch(location, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+		_, err := client.BackupStorageLocations(namespace).Patch(name, patchType, data)
+		return err
+	})
+}
@@ -32,6 +32,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 	c.AddCommand(
 		NewCreateCommand(f, "create"),
 		NewGetCommand(f, "get"),
+		NewPruneCommand(f, "prune"),
+		NewSetCommand(f, "set"),
 	)
 
 	return c
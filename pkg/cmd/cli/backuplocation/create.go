@@ -19,10 +19,12 @@ package backuplocation
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -54,23 +56,43 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name       string
-	Provider   string
-	Bucket     string
-	Prefix     string
-	Config     flag.Map
-	Labels     flag.Map
-	AccessMode *flag.Enum
+	Name                 string
+	Provider             string
+	Bucket               string
+	Prefix               string
+	Config               flag.Map
+	Labels               flag.Map
+	AccessMode           *flag.Enum
+	IdentityMode         *flag.Enum
+	Identity             string
+	Credential           flag.Map
+	OrphanedBackupPolicy *flag.Enum
+	ValidationFrequency  time.Duration
+	Default              bool
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Config: flag.NewMap(),
+		Config:     flag.NewMap(),
+		Credential: flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
 		),
+		IdentityMode: flag.NewEnum(
+			"",
+			string(velerov1api.CloudIdentityModeSecret),
+			string(velerov1api.CloudIdentityModeAWSIRSA),
+			string(velerov1api.CloudIdentityModeGCPWorkloadIdentity),
+			string(velerov1api.CloudIdentityModeAzureWorkloadIdentity),
+		),
+		OrphanedBackupPolicy: flag.NewEnum(
+			string(velerov1api.OrphanedBackupPolicyDelete),
+			string(velerov1api.OrphanedBackupPolicyDelete),
+			string(velerov1api.OrphanedBackupPolicyMark),
+			string(velerov1api.OrphanedBackupPolicyIgnore),
+		),
 	}
 }
 
@@ -85,6 +107,20 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 		"access-mode",
 		fmt.Sprintf("access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.Var(
+		o.IdentityMode,
+		"identity-mode",
+		fmt.Sprintf("how the provider's plugin authenticates to the backup storage. Valid values are %s. Optional.", strings.Join(o.IdentityMode.AllowedValues(), ",")),
+	)
+	flags.StringVar(&o.Identity, "identity", o.Identity, "the cloud identity that the plugin authenticates as with --identity-mode. Optional.")
+	flags.Var(&o.Credential, "credential", "the secret in the Velero namespace, and its key, that holds the credentials the plugin authenticates to the backup storage with, as <secret-name>=<key>. Optional.")
+	flags.Var(
+		o.OrphanedBackupPolicy,
+		"orphaned-backup-policy",
+		fmt.Sprintf("what's done with completed backups in the cluster whose data is no longer in the backup storage location. Valid values are %s", strings.Join(o.OrphanedBackupPolicy.AllowedValues(), ",")),
+	)
+	flags.BoolVar(&o.Default, "default", o.Default, "set the backup storage location as the default location, which backups that don't specify a storage location are stored in. Optional.")
+	flags.DurationVar(&o.ValidationFrequency, "validation-frequency", o.ValidationFrequency, "how often to check that the backup storage location is available. Set this to 0s to disable the check. Optional; defaults to the server's --store-validation-frequency.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -100,6 +136,18 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--bucket is required")
 	}
 
+	if err := validateIdentity(o.IdentityMode.String(), o.Identity); err != nil {
+		return err
+	}
+
+	if len(o.Credential.Data()) > 1 {
+		return errors.New("--credential can only have one secret and key")
+	}
+
+	if o.ValidationFrequency < 0 {
+		return errors.New("--validation-frequency must be non-negative")
+	}
+
 	return nil
 }
 
@@ -123,11 +171,22 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 					Prefix: o.Prefix,
 				},
 			},
-			Config:     o.Config.Data(),
-			AccessMode: velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Config:               o.Config.Data(),
+			AccessMode:           velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Identity:             identity(o.IdentityMode.String(), o.Identity),
+			Credential:           credential(o.Credential.Data()),
+			OrphanedBackupPolicy: velerov1api.OrphanedBackupPolicy(o.OrphanedBackupPolicy.String()),
 		},
 	}
 
+	if o.Default {
+		backupStorageLocation.Annotations = map[string]string{velerov1api.DefaultBackupLocationAnnotation: "true"}
+	}
+
+	if c.Flags().Changed("validation-frequency") {
+		backupStorageLocation.Spec.ValidationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
+	}
+
 	if printed, err := output.PrintWithFormat(c, backupStorageLocation); printed || err != nil {
 		return err
 	}
@@ -141,6 +200,46 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return errors.WithStack(err)
 	}
 
+	if o.Default {
+		if err := setDefault(client.VeleroV1(), backupStorageLocation.Namespace, backupStorageLocation.Name, true); err != nil {
+			return err
+		}
+	}
+
 	fmt.Printf("Backup storage location %q configured successfully.\n", backupStorageLocation.Name)
 	return nil
 }
+
+// validateIdentity returns an error if --identity is used without an
+// --identity-mode, or an --identity-mode other than Secret is used without
+// --identity.
+func validateIdentity(mode, identity string) error {
+	switch {
+	case mode == "" && identity != "":
+		return errors.New("--identity requires --identity-mode")
+	case mode != "" && mode != string(velerov1api.CloudIdentityModeSecret) && identity == "":
+		return errors.Errorf("--identity is required with --identity-mode %s", mode)
+	}
+	return nil
+}
+
+// identity returns the identity that the location's plugin authenticates
+// as, or nil if there's no --identity-mode.
+func identity(mode, id string) *velerov1api.CloudIdentity {
+	if mode == "" {
+		return nil
+	}
+	return &velerov1api.CloudIdentity{Mode: velerov1api.CloudIdentityMode(mode), Identity: id}
+}
+
+// credential returns the secret key that the location's plugin authenticates
+// with, or nil if there's no --credential.
+func credential(secretKeys map[string]string) *corev1api.SecretKeySelector {
+	for name, key := range secretKeys {
+		return &corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+			Key:                  key,
+		}
+	}
+	return nil
+}
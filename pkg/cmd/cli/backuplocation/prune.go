@@ -0,0 +1,83 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func NewPruneCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " NAME [NAME...]",
+		Short: "Remove backups whose upload didn't finish from backup storage locations",
+		Long: `Remove backups whose upload didn't finish, such as backups whose upload was interrupted by
+the Velero server restarting, from backup storage locations.
+
+The backups are removed by the Velero server the next time it syncs the locations. Backups that
+are still being processed, and backups whose upload started less than an hour ago, are never
+removed. The server also does this hourly on its own.`,
+		Example: `	velero backup-location prune default`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			veleroClient, err := f.Client()
+			cmd.CheckError(err)
+
+			for _, name := range args {
+				cmd.CheckError(requestPrune(veleroClient.VeleroV1(), f.Namespace(), name, time.Now()))
+				fmt.Printf("Requested pruning of incomplete backups from backup storage location %q. It will run the next time the location is synced.\n", name)
+			}
+		},
+	}
+
+	return c
+}
+
+// requestPrune annotates a backup storage location to request that the backups
+// in it whose upload didn't finish are pruned.
+func requestPrune(client velerov1client.BackupStorageLocationsGetter, namespace, name string, now time.Time) error {
+	location, err := client.BackupStorageLocations(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("backup storage location %q is read-only", name)
+	}
+
+	updated := location.DeepCopy()
+	if updated.Annotations == nil {
+		updated.Annotations = make(map[string]string)
+	}
+	updated.Annotations[velerov1api.PruneIncompleteBackupsAnnotation] = now.UTC().Format(time.RFC3339)
+
+	return kube.Patch(location, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+		_, err := client.BackupStorageLocations(namespace).Patch(name, patchType, data)
+		return err
+	})
+}
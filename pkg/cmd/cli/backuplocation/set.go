@@ -0,0 +1,153 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/storage"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func NewSetCommand(f client.Factory, use string) *cobra.Command {
+	o := NewSetOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Set specific features for a backup storage location",
+		Long: `Set specific features for a backup storage location.
+
+Setting a location as the default makes backups that don't specify a storage location be stored
+in it, instead of in the location named by the server's --default-backup-storage-location flag.
+The change takes effect without restarting the server. Only one location is the default at a
+time, so any other location that's set as the default no longer is.`,
+		Example: `	velero backup-location set loc-2 --default`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type SetOptions struct {
+	Name    string
+	Default bool
+}
+
+func NewSetOptions() *SetOptions {
+	return &SetOptions{}
+}
+
+func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Default, "default", o.Default, "set the backup storage location as the default location. Use --default=false to stop it being the default.")
+}
+
+func (o *SetOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if !c.Flags().Changed("default") {
+		return errors.New("--default is required")
+	}
+	return nil
+}
+
+func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	if err := setDefault(veleroClient.VeleroV1(), f.Namespace(), o.Name, o.Default); err != nil {
+		return err
+	}
+
+	if o.Default {
+		fmt.Printf("Backup storage location %q set as the default location.\n", o.Name)
+	} else {
+		fmt.Printf("Backup storage location %q is no longer the default location.\n", o.Name)
+	}
+	return nil
+}
+
+// setDefault annotates a backup storage location as the default location, and
+// removes the annotation from the other locations, if isDefault is true, or
+// removes the annotation from the location otherwise.
+func setDefault(client velerov1client.BackupStorageLocationsGetter, namespace, name string, isDefault bool) error {
+	location, err := client.BackupStorageLocations(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if isDefault {
+		locations, err := client.BackupStorageLocations(namespace).List(metav1.ListOptions{})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for i := range locations.Items {
+			if other := &locations.Items[i]; other.Name != name {
+				if err := annotateDefault(client, other, false); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return annotateDefault(client, location, isDefault)
+}
+
+// annotateDefault adds or removes a backup storage location's default
+// location annotation, unless it's already the default or not as requested.
+func annotateDefault(client velerov1client.BackupStorageLocationsGetter, location *velerov1api.BackupStorageLocation, isDefault bool) error {
+	if storage.IsDefaultBackupLocation(location) == isDefault {
+		return nil
+	}
+
+	updated := location.DeepCopy()
+	if isDefault {
+		if updated.Annotations == nil {
+			updated.Annotations = make(map[string]string)
+		}
+		updated.Annotations[velerov1api.DefaultBackupLocationAnnotation] = "true"
+	} else {
+		delete(updated.Annotations, velerov1api.DefaultBackupLocationAnnotation)
+	}
+
+	return kube.Patch(location, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+		_, err := client.BackupStorageLocations(location.Namespace).Patch(location.Name, patchType, data)
+		return errors.WithStack(err)
+	})
+}
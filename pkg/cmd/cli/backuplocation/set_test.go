@@ -0,0 +1,110 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+)
+
+func TestSetDefault(t *testing.T) {
+	defaultAnnotation := builder.WithAnnotations(velerov1api.DefaultBackupLocationAnnotation, "true")
+
+	tests := []struct {
+		name        string
+		locations   []*velerov1api.BackupStorageLocation
+		location    string
+		isDefault   bool
+		wantPatches map[string]string
+		wantErr     bool
+	}{
+		{
+			name: "setting a location as the default unsets the previous default",
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "loc-1").ObjectMeta(defaultAnnotation).Result(),
+				builder.ForBackupStorageLocation("velero", "loc-2").Result(),
+			},
+			location:  "loc-2",
+			isDefault: true,
+			wantPatches: map[string]string{
+				"loc-1": `{"metadata":{"annotations":null}}`,
+				"loc-2": `{"metadata":{"annotations":{"velero.io/default-backup-storage-location":"true"}}}`,
+			},
+		},
+		{
+			name: "setting the default location as the default keeps it",
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "loc-1").ObjectMeta(defaultAnnotation).Result(),
+			},
+			location:  "loc-1",
+			isDefault: true,
+		},
+		{
+			name: "unsetting the default location",
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "loc-1").ObjectMeta(defaultAnnotation).Result(),
+				builder.ForBackupStorageLocation("velero", "loc-2").Result(),
+			},
+			location: "loc-1",
+			wantPatches: map[string]string{
+				"loc-1": `{"metadata":{"annotations":null}}`,
+			},
+		},
+		{
+			name:      "location that doesn't exist is an error",
+			locations: []*velerov1api.BackupStorageLocation{builder.ForBackupStorageLocation("velero", "loc-1").Result()},
+			location:  "loc-2",
+			isDefault: true,
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			for _, location := range tc.locations {
+				_, err := client.VeleroV1().BackupStorageLocations("velero").Create(location)
+				require.NoError(t, err)
+			}
+
+			err := setDefault(client.VeleroV1(), "velero", tc.location, tc.isDefault)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var patches map[string]string
+			for _, action := range client.Actions() {
+				if patch, ok := action.(core.PatchAction); ok {
+					if patches == nil {
+						patches = make(map[string]string)
+					}
+					patches[patch.GetName()] = string(patch.GetPatch())
+				}
+			}
+			assert.Equal(t, tc.wantPatches, patches)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -34,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/install"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -61,17 +63,23 @@ type InstallOptions struct {
 	BackupStorageConfig               flag.Map
 	VolumeSnapshotConfig              flag.Map
 	UseRestic                         bool
+	DefaultVolumesToRestic            bool
 	Wait                              bool
 	UseVolumeSnapshots                bool
 	DefaultResticMaintenanceFrequency time.Duration
 	Plugins                           flag.StringArray
+	ResticCacheSizeLimit              string
+	ResticCommandOptions              restic.CommandOptions
+	ResticConcurrentBackups           int
+	IdentityMode                      string
+	Identity                          string
 }
 
 // BindFlags adds command line values to the options struct.
 func (o *InstallOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.ProviderName, "provider", o.ProviderName, "provider name for backup and volume storage")
 	flags.StringVar(&o.BucketName, "bucket", o.BucketName, "name of the object storage bucket where backups should be stored")
-	flags.StringVar(&o.SecretFile, "secret-file", o.SecretFile, "file containing credentials for backup and volume provider. If not specified, --no-secret must be used for confirmation. Optional.")
+	flags.StringVar(&o.SecretFile, "secret-file", o.SecretFile, "file containing credentials for backup and volume provider. If not specified, --no-secret or an --identity-mode other than Secret must be used. Optional.")
 	flags.BoolVar(&o.NoSecret, "no-secret", o.NoSecret, "flag indicating if a secret should be created. Must be used as confirmation if --secret-file is not provided. Optional.")
 	flags.StringVar(&o.Image, "image", o.Image, "image to use for the Velero and restic server pods. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "prefix under which all Velero data should be stored within the bucket. Optional.")
@@ -91,9 +99,19 @@ func (o *InstallOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.RestoreOnly, "restore-only", o.RestoreOnly, "run the server in restore-only mode. Optional.")
 	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "generate resources, but don't send them to the cluster. Use with -o. Optional.")
 	flags.BoolVar(&o.UseRestic, "use-restic", o.UseRestic, "create restic deployment. Optional.")
+	flags.BoolVar(&o.DefaultVolumesToRestic, "default-volumes-to-restic", o.DefaultVolumesToRestic, "back up all pod volumes with restic by default. Requires --use-restic. Optional.")
 	flags.BoolVar(&o.Wait, "wait", o.Wait, "wait for Velero deployment to be ready. Optional.")
 	flags.DurationVar(&o.DefaultResticMaintenanceFrequency, "default-restic-prune-frequency", o.DefaultResticMaintenanceFrequency, "how often 'restic prune' is run for restic repositories by default. Optional.")
+	flags.StringVar(&o.ResticCacheSizeLimit, "restic-cache-size-limit", o.ResticCacheSizeLimit, `size limit of the scratch volume that restic pods keep restic's cache in, e.g. "5Gi". Optional.`)
+	flags.IntVar(&o.ResticCommandOptions.LimitUpload, "restic-limit-upload", o.ResticCommandOptions.LimitUpload, "limit restic's upload bandwidth when backing up pod volumes, in KiB/s. 0 means unlimited. Optional.")
+	flags.IntVar(&o.ResticCommandOptions.LimitDownload, "restic-limit-download", o.ResticCommandOptions.LimitDownload, "limit restic's download bandwidth when restoring pod volumes, in KiB/s. 0 means unlimited. Optional.")
+	flags.IntVar(&o.ResticCommandOptions.Nice, "restic-nice", o.ResticCommandOptions.Nice, "the niceness, from -20 to 19, that restic runs with. Optional.")
+	flags.StringVar(&o.ResticCommandOptions.IONiceClass, "restic-ionice-class", o.ResticCommandOptions.IONiceClass, "the I/O scheduling class that restic runs with. Valid values are best-effort, idle. Optional.")
+	flags.IntVar(&o.ResticCommandOptions.IONiceLevel, "restic-ionice-level", o.ResticCommandOptions.IONiceLevel, "the priority, from 0 (highest) to 7 (lowest), that restic runs with within the best-effort I/O scheduling class. Optional.")
+	flags.IntVar(&o.ResticConcurrentBackups, "restic-concurrent-backups", o.ResticConcurrentBackups, "the number of pod volume backups that each restic pod runs at once. Optional.")
 	flags.Var(&o.Plugins, "plugins", "Plugin container images to install into the Velero Deployment. Optional.")
+	flags.StringVar(&o.IdentityMode, "identity-mode", o.IdentityMode, "how Velero and its plugins authenticate to the provider. Valid values are Secret (the default), AWSIRSA, GCPWorkloadIdentity, AzureWorkloadIdentity. Modes other than Secret authenticate as --identity without a secret. Optional.")
+	flags.StringVar(&o.Identity, "identity", o.Identity, "the cloud identity to authenticate as with --identity-mode: an IAM role ARN for AWSIRSA, a Google service account email for GCPWorkloadIdentity, or a client ID for AzureWorkloadIdentity. Optional.")
 }
 
 // NewInstallOptions instantiates a new, default InstallOptions struct.
@@ -113,6 +131,8 @@ func NewInstallOptions() *InstallOptions {
 		ResticPodMemRequest:       install.DefaultResticPodMemRequest,
 		ResticPodCPULimit:         install.DefaultResticPodCPULimit,
 		ResticPodMemLimit:         install.DefaultResticPodMemLimit,
+		ResticCommandOptions:      restic.CommandOptions{IONiceLevel: restic.DefaultIONiceLevel},
+		ResticConcurrentBackups:   1,
 		// Default to creating a VSL unless we're told otherwise
 		UseVolumeSnapshots: true,
 	}
@@ -139,6 +159,12 @@ func (o *InstallOptions) AsVeleroOptions() (*install.VeleroOptions, error) {
 	if err != nil {
 		return nil, err
 	}
+	var resticCacheSizeLimit resource.Quantity
+	if o.ResticCacheSizeLimit != "" {
+		if resticCacheSizeLimit, err = resource.ParseQuantity(o.ResticCacheSizeLimit); err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse restic cache size limit %q", o.ResticCacheSizeLimit)
+		}
+	}
 
 	return &install.VeleroOptions{
 		Namespace:                         o.Namespace,
@@ -153,11 +179,17 @@ func (o *InstallOptions) AsVeleroOptions() (*install.VeleroOptions, error) {
 		SecretData:                        secretData,
 		RestoreOnly:                       o.RestoreOnly,
 		UseRestic:                         o.UseRestic,
+		DefaultVolumesToRestic:            o.DefaultVolumesToRestic,
 		UseVolumeSnapshots:                o.UseVolumeSnapshots,
 		BSLConfig:                         o.BackupStorageConfig.Data(),
 		VSLConfig:                         o.VolumeSnapshotConfig.Data(),
 		DefaultResticMaintenanceFrequency: o.DefaultResticMaintenanceFrequency,
 		Plugins:                           o.Plugins,
+		ResticCacheSizeLimit:              resticCacheSizeLimit,
+		ResticCommandOptions:              o.ResticCommandOptions,
+		ResticConcurrentBackups:           o.ResticConcurrentBackups,
+		IdentityMode:                      velerov1api.CloudIdentityMode(o.IdentityMode),
+		Identity:                          o.Identity,
 	}, nil
 }
 
@@ -197,6 +229,10 @@ This is useful as a starting point for more customized installations.
 
 	# velero install --bucket backups --provider aws --backup-location-config region=us-west-2 --snapshot-location-config region=us-west-2 --no-secret --pod-annotations iam.amazonaws.com/role=arn:aws:iam::<AWS_ACCOUNT_ID>:role/<VELERO_ROLE_NAME>
 
+	# velero install --bucket backups --provider aws --backup-location-config region=us-west-2 --snapshot-location-config region=us-west-2 --identity-mode AWSIRSA --identity arn:aws:iam::<AWS_ACCOUNT_ID>:role/<VELERO_ROLE_NAME>
+
+	# velero install --bucket gcp-backups --provider gcp --identity-mode GCPWorkloadIdentity --identity <GSA_NAME>@<PROJECT_NAME>.iam.gserviceaccount.com
+
 	# velero install --bucket gcp-backups --provider gcp --secret-file ./gcp-creds.json --velero-pod-cpu-request=1000m --velero-pod-cpu-limit=5000m --velero-pod-mem-request=512Mi --velero-pod-mem-limit=1024Mi
 
 	# velero install --bucket gcp-backups --provider gcp --secret-file ./gcp-creds.json --restic-pod-cpu-request=1000m --restic-pod-cpu-limit=5000m --restic-pod-mem-request=512Mi --restic-pod-mem-limit=1024Mi
@@ -261,14 +297,14 @@ func (o *InstallOptions) Run(c *cobra.Command, f client.Factory) error {
 			}
 		}
 	}
-	if o.SecretFile == "" {
+	if o.SecretFile == "" && o.Identity == "" {
 		fmt.Printf("\nNo secret file was specified, no Secret created.\n\n")
 	}
 	fmt.Printf("Velero is installed! ⛵ Use 'kubectl logs deployment/velero -n %s' to view the status.\n", o.Namespace)
 	return nil
 }
 
-//Complete completes options for a command.
+// Complete completes options for a command.
 func (o *InstallOptions) Complete(args []string, f client.Factory) error {
 	o.Namespace = f.Namespace()
 	return nil
@@ -295,16 +331,42 @@ func (o *InstallOptions) Validate(c *cobra.Command, args []string, f client.Fact
 		return errors.New("--provider is required")
 	}
 
-	switch {
-	case o.SecretFile == "" && !o.NoSecret:
-		return errors.New("One of --secret-file or --no-secret is required")
-	case o.SecretFile != "" && o.NoSecret:
-		return errors.New("Cannot use both --secret-file and --no-secret")
+	switch velerov1api.CloudIdentityMode(o.IdentityMode) {
+	case "", velerov1api.CloudIdentityModeSecret:
+		switch {
+		case o.SecretFile == "" && !o.NoSecret:
+			return errors.New("One of --secret-file or --no-secret is required")
+		case o.SecretFile != "" && o.NoSecret:
+			return errors.New("Cannot use both --secret-file and --no-secret")
+		}
+	case velerov1api.CloudIdentityModeAWSIRSA, velerov1api.CloudIdentityModeGCPWorkloadIdentity, velerov1api.CloudIdentityModeAzureWorkloadIdentity:
+		// no secret is needed to authenticate as a cloud identity.
+		if o.SecretFile != "" {
+			return errors.Errorf("Cannot use --secret-file with --identity-mode %s", o.IdentityMode)
+		}
+		if o.Identity == "" {
+			return errors.Errorf("--identity is required with --identity-mode %s", o.IdentityMode)
+		}
+	default:
+		return errors.Errorf("Invalid --identity-mode %q, valid values are %s, %s, %s, %s", o.IdentityMode,
+			velerov1api.CloudIdentityModeSecret, velerov1api.CloudIdentityModeAWSIRSA, velerov1api.CloudIdentityModeGCPWorkloadIdentity, velerov1api.CloudIdentityModeAzureWorkloadIdentity)
+	}
+
+	if o.DefaultVolumesToRestic && !o.UseRestic {
+		return errors.New("--use-restic is required with --default-volumes-to-restic")
 	}
 
 	if o.DefaultResticMaintenanceFrequency < 0 {
 		return errors.New("--default-restic-prune-frequency must be non-negative")
 	}
 
+	if err := o.ResticCommandOptions.Validate(); err != nil {
+		return err
+	}
+
+	if o.ResticConcurrentBackups < 1 {
+		return errors.New("--restic-concurrent-backups must be positive")
+	}
+
 	return nil
 }
@@ -0,0 +1,50 @@
+/*
+Copyright 2018 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"github.com/spf13/pflag"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+)
+
+// ReplicaPolicyOptions are the resources whose workloads' replica counts a
+// backup or restore keeps or omits.
+type ReplicaPolicyOptions struct {
+	KeepReplicas flag.StringArray
+	OmitReplicas flag.StringArray
+}
+
+// BindFlags binds the --keep-replicas and --omit-replicas flags.
+func (o *ReplicaPolicyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.Var(&o.OmitReplicas, "omit-replicas", "resources whose replica counts are omitted, so that they're restored with the default replica count and scaled by their horizontal pod autoscalers, formatted as resource.group, such as deployments.apps (use '*' for all resources)")
+	flags.Var(&o.KeepReplicas, "keep-replicas", "resources whose replica counts are kept even if they're included by --omit-replicas, formatted as resource.group, such as statefulsets.apps")
+}
+
+// Policies returns the replica policies of the options. Kept resources take
+// precedence over omitted ones.
+func (o *ReplicaPolicyOptions) Policies() []velerov1api.ResourceReplicaPolicy {
+	var policies []velerov1api.ResourceReplicaPolicy
+	if len(o.KeepReplicas) > 0 {
+		policies = append(policies, velerov1api.ResourceReplicaPolicy{Resources: o.KeepReplicas, Policy: velerov1api.ReplicaPolicyKeep})
+	}
+	if len(o.OmitReplicas) > 0 {
+		policies = append(policies, velerov1api.ResourceReplicaPolicy{Resources: o.OmitReplicas, Policy: velerov1api.ReplicaPolicyOmit})
+	}
+	return policies
+}
@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-    http://www.apache.org/licenses/LICENSE-2.0
+	http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -18,11 +18,12 @@ package restic
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
-	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	v1 "k8s.io/api/core/v1"
@@ -31,6 +32,7 @@ import (
 	kubeinformers "k8s.io/client-go/informers"
 	corev1informers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
@@ -40,14 +42,23 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/controller"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
+const (
+	// the port where prometheus metrics are exposed
+	defaultMetricsAddress = ":8085"
+)
+
 func NewServerCommand(f client.Factory) *cobra.Command {
 	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
 	formatFlag := logging.NewFormatFlag()
+	commandOptions := restic.CommandOptions{IONiceLevel: restic.DefaultIONiceLevel}
+	metricsAddress := defaultMetricsAddress
+	concurrentBackups := 1
 
 	command := &cobra.Command{
 		Use:    "server",
@@ -61,8 +72,13 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 			logger := logging.DefaultLogger(logLevel, formatFlag.Parse())
 			logger.Infof("Starting Velero restic server %s (%s)", buildinfo.Version, buildinfo.FormattedGitSHA())
 
+			cmd.CheckError(commandOptions.Validate())
+			if concurrentBackups < 1 {
+				cmd.CheckError(errors.New("concurrent-backups must be positive"))
+			}
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
-			s, err := newResticServer(logger, f)
+			s, err := newResticServer(logger, f, commandOptions, metricsAddress, concurrentBackups)
 			cmd.CheckError(err)
 
 			s.run()
@@ -71,11 +87,19 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("the level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("the format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+	command.Flags().StringVar(&metricsAddress, "metrics-address", metricsAddress, "the address to expose prometheus metrics")
+	command.Flags().IntVar(&concurrentBackups, "concurrent-backups", concurrentBackups, "the number of pod volume backups that are run at once on this node")
+	command.Flags().IntVar(&commandOptions.LimitUpload, "limit-upload", commandOptions.LimitUpload, "limit restic's upload bandwidth when backing up pod volumes, in KiB/s. 0 means unlimited")
+	command.Flags().IntVar(&commandOptions.LimitDownload, "limit-download", commandOptions.LimitDownload, "limit restic's download bandwidth when restoring pod volumes, in KiB/s. 0 means unlimited")
+	command.Flags().IntVar(&commandOptions.Nice, "nice", commandOptions.Nice, "the niceness, from -20 to 19, that restic runs with. 0 leaves it unchanged")
+	command.Flags().StringVar(&commandOptions.IONiceClass, "ionice-class", commandOptions.IONiceClass, "the I/O scheduling class that restic runs with. Valid values are best-effort, idle. If empty, it's left unchanged")
+	command.Flags().IntVar(&commandOptions.IONiceLevel, "ionice-level", commandOptions.IONiceLevel, "the priority, from 0 (highest) to 7 (lowest), that restic runs with within the best-effort I/O scheduling class")
 
 	return command
 }
 
 type resticServer struct {
+	kubeClientConfig      *rest.Config
 	kubeClient            kubernetes.Interface
 	veleroClient          clientset.Interface
 	veleroInformerFactory informers.SharedInformerFactory
@@ -86,9 +110,18 @@ type resticServer struct {
 	ctx                   context.Context
 	cancelFunc            context.CancelFunc
 	fileSystem            filesystem.Interface
+	commandOptions        restic.CommandOptions
+	metricsAddress        string
+	metrics               *metrics.ServerMetrics
+	concurrentBackups     int
 }
 
-func newResticServer(logger logrus.FieldLogger, factory client.Factory) (*resticServer, error) {
+func newResticServer(logger logrus.FieldLogger, factory client.Factory, commandOptions restic.CommandOptions, metricsAddress string, concurrentBackups int) (*resticServer, error) {
+
+	clientConfig, err := factory.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
 
 	kubeClient, err := factory.KubeClient()
 	if err != nil {
@@ -131,6 +164,7 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory) (*restic
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	s := &resticServer{
+		kubeClientConfig:      clientConfig,
 		kubeClient:            kubeClient,
 		veleroClient:          veleroClient,
 		veleroInformerFactory: informers.NewFilteredSharedInformerFactory(veleroClient, 0, factory.Namespace(), nil),
@@ -141,6 +175,9 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory) (*restic
 		ctx:                   ctx,
 		cancelFunc:            cancelFunc,
 		fileSystem:            filesystem.NewFileSystem(),
+		commandOptions:        commandOptions,
+		metricsAddress:        metricsAddress,
+		concurrentBackups:     concurrentBackups,
 	}
 
 	if err := s.validatePodVolumesHostPath(); err != nil {
@@ -153,9 +190,23 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory) (*restic
 func (s *resticServer) run() {
 	signals.CancelOnShutdown(s.cancelFunc, s.logger)
 
+	go func() {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		s.logger.Infof("Starting metric server at address [%s]", s.metricsAddress)
+		if err := http.ListenAndServe(s.metricsAddress, metricsMux); err != nil {
+			s.logger.Fatalf("Failed to start metric server at [%s]: %v", s.metricsAddress, err)
+		}
+	}()
+	s.metrics = metrics.NewResticServerMetrics()
+	s.metrics.RegisterAllMetrics()
+
 	s.logger.Info("Starting controllers")
 
-	var wg sync.WaitGroup
+	mgr, err := controller.NewManager(s.kubeClientConfig)
+	if err != nil {
+		s.logger.WithError(err).Fatal("Failed to create controller manager")
+	}
 
 	backupController := controller.NewPodVolumeBackupController(
 		s.logger,
@@ -167,12 +218,12 @@ func (s *resticServer) run() {
 		s.kubeInformerFactory.Core().V1().PersistentVolumes(),
 		s.veleroInformerFactory.Velero().V1().BackupStorageLocations(),
 		os.Getenv("NODE_NAME"),
+		s.commandOptions,
+		s.metrics,
 	)
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		backupController.Run(s.ctx, 1)
-	}()
+	if err := backupController.SetupWithManager(mgr, s.concurrentBackups); err != nil {
+		s.logger.WithError(err).Fatal("Failed to set up pod volume backup controller")
+	}
 
 	restoreController := controller.NewPodVolumeRestoreController(
 		s.logger,
@@ -184,12 +235,11 @@ func (s *resticServer) run() {
 		s.kubeInformerFactory.Core().V1().PersistentVolumes(),
 		s.veleroInformerFactory.Velero().V1().BackupStorageLocations(),
 		os.Getenv("NODE_NAME"),
+		s.commandOptions,
 	)
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		restoreController.Run(s.ctx, 1)
-	}()
+	if err := restoreController.SetupWithManager(mgr, 1); err != nil {
+		s.logger.WithError(err).Fatal("Failed to set up pod volume restore controller")
+	}
 
 	go s.veleroInformerFactory.Start(s.ctx.Done())
 	go s.kubeInformerFactory.Start(s.ctx.Done())
@@ -198,10 +248,11 @@ func (s *resticServer) run() {
 
 	s.logger.Info("Controllers started successfully")
 
-	<-s.ctx.Done()
+	if err := mgr.Start(s.ctx.Done()); err != nil {
+		s.logger.WithError(err).Fatal("Failed to run controllers")
+	}
 
-	s.logger.Info("Waiting for all controllers to shut down gracefully")
-	wg.Wait()
+	s.logger.Info("All controllers have shut down")
 }
 
 // validatePodVolumesHostPath validates that the pod volumes path contains a
@@ -18,6 +18,7 @@ package restore
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,6 +30,7 @@ import (
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
@@ -50,8 +52,14 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   # create a restore from the latest successful backup triggered by schedule "schedule-1"
   velero restore create --from-schedule schedule-1
 
+  # create a restore from the latest successful backup triggered by schedule "schedule-1" that started at or before midnight UTC on May 1st
+  velero restore create --from-schedule schedule-1 --at 2020-05-01T00:00Z
+
   # create a restore for only persistentvolumeclaims and persistentvolumes within a backup
   velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # write the manifests that a restore from backup "backup-1" would create to ./manifests, without applying them
+  velero restore create --from-backup backup-1 --no-apply --output-dir ./manifests
   `,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
@@ -69,19 +77,41 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	BackupName              string
-	ScheduleName            string
-	RestoreName             string
-	RestoreVolumes          flag.OptionalBool
-	Labels                  flag.Map
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	NamespaceMappings       flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
+	BackupName                   string
+	ScheduleName                 string
+	At                           flag.Time
+	FromLocation                 string
+	RestoreName                  string
+	RestoreVolumes               flag.OptionalBool
+	Labels                       flag.Map
+	IncludeNamespaces            flag.StringArray
+	ExcludeNamespaces            flag.StringArray
+	IncludeResources             flag.StringArray
+	ExcludeResources             flag.StringArray
+	NamespaceMappings            flag.Map
+	ZoneMappings                 flag.Map
+	VolumeType                   string
+	VolumeIOPS                   int64
+	Selector                     flag.LabelSelector
+	IncludeClusterResources      flag.OptionalBool
+	DataOnly                     bool
+	NoApply                      bool
+	SkipOwnerManaged             bool
+	RetainRestoredPVs            bool
+	WaitForUnboundPVCs           bool
+	Strict                       bool
+	AllowNewerBackupFormat       bool
+	AdmissionWebhooks            *flag.Enum
+	LoadBalancerAnnotations      *flag.Enum
+	AllowLoadBalancerAnnotations flag.StringArray
+	DenyLoadBalancerAnnotations  flag.StringArray
+	ReplicaPolicies              cli.ReplicaPolicyOptions
+	AutoscaledReplicas           *flag.Enum
+	ResourcePriorities           []string
+	ExistingResourcePolicy       *flag.Enum
+	OutputDir                    string
+	InsecureSkipTLSVerify        bool
+	Wait                         bool
 
 	client veleroclient.Interface
 }
@@ -91,17 +121,46 @@ func NewCreateOptions() *CreateOptions {
 		Labels:                  flag.NewMap(),
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
+		ZoneMappings:            flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		AdmissionWebhooks: flag.NewEnum(
+			string(api.AdmissionWebhookPolicyInOrder),
+			string(api.AdmissionWebhookPolicyInOrder),
+			string(api.AdmissionWebhookPolicyRestoreLast),
+			string(api.AdmissionWebhookPolicyIgnoreFailures),
+		),
+		LoadBalancerAnnotations: flag.NewEnum(
+			"",
+			string(api.LoadBalancerAnnotationPolicyKeep),
+			string(api.LoadBalancerAnnotationPolicyStrip),
+		),
+		AutoscaledReplicas: flag.NewEnum(
+			string(api.AutoscaledReplicaPolicyKeep),
+			string(api.AutoscaledReplicaPolicyKeep),
+			string(api.AutoscaledReplicaPolicyOmit),
+			string(api.AutoscaledReplicaPolicyMinReplicas),
+		),
+		ExistingResourcePolicy: flag.NewEnum(
+			string(api.ExistingResourcePolicyNone),
+			string(api.ExistingResourcePolicyNone),
+			string(api.ExistingResourcePolicyUpdate),
+			string(api.ExistingResourcePolicyPatch),
+		),
 	}
 }
 
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.BackupName, "from-backup", "", "backup to restore from")
 	flags.StringVar(&o.ScheduleName, "from-schedule", "", "schedule to restore from")
+	flags.Var(&o.At, "at", "point in time to restore the schedule to, in RFC 3339 format (e.g. 2020-05-01T00:00:00Z). The most recent backup from the schedule that started at or before this time is restored. Requires --from-schedule")
+	flags.StringVar(&o.FromLocation, "from-location", "", "backup storage location to restore the backup from, if different from the backup's storage location (e.g. a replica of the backup's bucket)")
 	flags.Var(&o.IncludeNamespaces, "include-namespaces", "namespaces to include in the restore (use '*' for all namespaces)")
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "namespaces to exclude from the restore")
-	flags.Var(&o.NamespaceMappings, "namespace-mappings", "namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,...")
+	flags.Var(&o.NamespaceMappings, "namespace-mappings", "namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,... Source names may contain '*' wildcards (e.g. team-*:team-*-restored) or be regular expressions surrounded by '/' (e.g. /^team-(.*)$/:${1}-restored)")
+	flags.Var(&o.ZoneMappings, "zone-mappings", "availability zone mappings from the zone a volume was snapshotted in to the zone to restore it into, in the form src1:dst1,src2:dst2,...")
+	flags.StringVar(&o.VolumeType, "volume-type", o.VolumeType, "volume type to create volumes restored from snapshots with, instead of the volume type they were snapshotted with (e.g. gp2)")
+	flags.Int64Var(&o.VolumeIOPS, "volume-iops", o.VolumeIOPS, "provisioned IOPS to create volumes restored from snapshots with, instead of the IOPS they were snapshotted with")
 	flags.Var(&o.Labels, "labels", "labels to apply to the restore")
 	flags.Var(&o.IncludeResources, "include-resources", "resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources)")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io")
@@ -114,6 +173,23 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "include cluster-scoped resources in the restore")
 	f.NoOptDefVal = "true"
 
+	flags.BoolVar(&o.DataOnly, "data-only", o.DataOnly, "only restore volume data from restic backups into existing PVCs with the same names, without creating or modifying any other resources")
+	flags.BoolVar(&o.NoApply, "no-apply", o.NoApply, "write the manifests of the resources that would be restored to object storage, instead of creating them in the cluster")
+	flags.BoolVar(&o.SkipOwnerManaged, "skip-owner-managed", o.SkipOwnerManaged, "skip resources that have an owner reference to another resource being restored, since the owner (e.g. an operator's custom resource) will recreate them")
+	flags.BoolVar(&o.WaitForUnboundPVCs, "wait-for-unbound-pvcs", o.WaitForUnboundPVCs, "wait for persistent volume claims that weren't bound when they were backed up to be bound to dynamically provisioned volumes before restoring the pods that use them")
+	flags.BoolVar(&o.RetainRestoredPVs, "retain-restored-pvs", o.RetainRestoredPVs, "set the reclaim policy of restored persistent volumes to Retain until the restore completes without errors, so that a failed restore can't cause their volumes to be deleted")
+	flags.BoolVar(&o.AllowNewerBackupFormat, "allow-newer-backup-format", o.AllowNewerBackupFormat, "attempt the restore even if the backup was created by a newer version of Velero with a backup format that this server doesn't support")
+	flags.BoolVar(&o.Strict, "strict", o.Strict, "fail the restore if the backup has resources whose API versions aren't served by the cluster, instead of only reporting them")
+	flags.Var(o.AdmissionWebhooks, "admission-webhooks", fmt.Sprintf("how to restore the backup's admission webhook configurations, so that webhooks whose backends aren't running yet don't reject other items: in order with other resources, last, or with their failure policies set to Ignore until the restore completes. Valid values are %s", strings.Join(o.AdmissionWebhooks.AllowedValues(), ",")))
+	flags.Var(o.LoadBalancerAnnotations, "load-balancer-annotations", fmt.Sprintf("whether restored services of type LoadBalancer keep the cloud provider annotations that claim static IPs and DNS names, or have them and their load balancer IP stripped. Valid values are %s", strings.Join(o.LoadBalancerAnnotations.AllowedValues(), ",")))
+	flags.Var(&o.AllowLoadBalancerAnnotations, "allow-load-balancer-annotations", "annotations of services of type LoadBalancer to keep even with --load-balancer-annotations=Strip. Keys may contain '*' wildcards")
+	flags.Var(&o.DenyLoadBalancerAnnotations, "deny-load-balancer-annotations", "annotations of services of type LoadBalancer to remove even with --load-balancer-annotations=Keep. Keys may contain '*' wildcards")
+	o.ReplicaPolicies.BindFlags(flags)
+	flags.Var(o.AutoscaledReplicas, "autoscaled-replicas", fmt.Sprintf("the replica counts to restore the scale targets of the backup's horizontal pod autoscalers with, so that their autoscalers resume control of them: the backed up ones, the default one, or their autoscalers' minimum. Valid values are %s", strings.Join(o.AutoscaledReplicas.AllowedValues(), ",")))
+	flags.StringSliceVar(&o.ResourcePriorities, "resource-priorities", o.ResourcePriorities, "order to restore resources in, formatted as resource.group, such as issuers.cert-manager.io, instead of the server's --restore-resource-priorities. Resources that aren't listed are restored alphabetically after the listed ones")
+	flags.Var(o.ExistingResourcePolicy, "existing-resource-policy", fmt.Sprintf("what to do with items that already exist in the cluster and differ from their backed-up versions: leave them, replace them with the backed-up versions, or merge the backed-up versions into them. Valid values are %s", strings.Join(o.ExistingResourcePolicy.AllowedValues(), ",")))
+	flags.StringVar(&o.OutputDir, "output-dir", o.OutputDir, "directory to download the manifests of a --no-apply restore to, once it's completed. Implies --wait")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity when downloading manifests. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "wait for the operation to complete")
 }
 
@@ -147,6 +223,26 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("either a backup or schedule must be specified, but not both")
 	}
 
+	if o.At.Value != nil && o.ScheduleName == "" {
+		return errors.New("--at can only be used with --from-schedule")
+	}
+
+	if o.OutputDir != "" && !o.NoApply {
+		return errors.New("--output-dir can only be used with --no-apply")
+	}
+
+	if o.NoApply && o.DataOnly {
+		return errors.New("--no-apply can't be used with --data-only")
+	}
+
+	if o.SkipOwnerManaged && o.DataOnly {
+		return errors.New("--skip-owner-managed can't be used with --data-only")
+	}
+
+	if o.VolumeIOPS < 0 {
+		return errors.New("--volume-iops must be a positive number")
+	}
+
 	if err := output.ValidateFlags(c); err != nil {
 		return err
 	}
@@ -167,6 +263,12 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		}
 	}
 
+	if o.FromLocation != "" {
+		if _, err := o.client.VeleroV1().BackupStorageLocations(f.Namespace()).Get(o.FromLocation, metav1.GetOptions{}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -190,16 +292,53 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			IncludedResources:       o.IncludeResources,
 			ExcludedResources:       o.ExcludeResources,
 			NamespaceMapping:        o.NamespaceMappings.Data(),
+			ZoneMapping:             o.ZoneMappings.Data(),
 			LabelSelector:           o.Selector.LabelSelector,
 			RestorePVs:              o.RestoreVolumes.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			StorageLocation:         o.FromLocation,
+			DataOnly:                o.DataOnly,
+			NoApply:                 o.NoApply,
+			SkipOwnerManaged:        o.SkipOwnerManaged,
+			RetainRestoredPVs:       o.RetainRestoredPVs,
+			WaitForUnboundPVCs:      o.WaitForUnboundPVCs,
+			Strict:                  o.Strict,
+			AllowNewerBackupFormat:  o.AllowNewerBackupFormat,
+			AdmissionWebhooks:       api.AdmissionWebhookPolicy(o.AdmissionWebhooks.String()),
+			ReplicaPolicies:         o.ReplicaPolicies.Policies(),
+			AutoscaledReplicas:      api.AutoscaledReplicaPolicy(o.AutoscaledReplicas.String()),
+			ResourcePriorities:      o.ResourcePriorities,
+			ExistingResourcePolicy:  api.ExistingResourcePolicy(o.ExistingResourcePolicy.String()),
 		},
 	}
 
+	if o.At.Value != nil {
+		restore.Spec.PointInTime = &metav1.Time{Time: *o.At.Value}
+	}
+
+	if o.LoadBalancerAnnotations.String() != "" || len(o.AllowLoadBalancerAnnotations) > 0 || len(o.DenyLoadBalancerAnnotations) > 0 {
+		restore.Spec.LoadBalancerServices = &api.LoadBalancerServiceOptions{
+			AnnotationPolicy:   api.LoadBalancerAnnotationPolicy(o.LoadBalancerAnnotations.String()),
+			AllowedAnnotations: o.AllowLoadBalancerAnnotations,
+			DeniedAnnotations:  o.DenyLoadBalancerAnnotations,
+		}
+	}
+
+	if o.VolumeType != "" || o.VolumeIOPS > 0 {
+		restore.Spec.VolumeOverrides = &api.VolumeOverrides{VolumeType: o.VolumeType}
+		if o.VolumeIOPS > 0 {
+			restore.Spec.VolumeOverrides.IOPS = &o.VolumeIOPS
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
 
+	if o.OutputDir != "" {
+		o.Wait = true
+	}
+
 	var restoreInformer cache.SharedIndexInformer
 	var updates chan *api.Restore
 	if o.Wait {
@@ -263,6 +402,10 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 
 				if restore.Status.Phase != api.RestorePhaseNew && restore.Status.Phase != api.RestorePhaseInProgress {
 					fmt.Printf("\nRestore completed with status: %s. You may check for more information using the commands `velero restore describe %s` and `velero restore logs %s`.\n", restore.Status.Phase, restore.Name, restore.Name)
+
+					if o.OutputDir != "" && (restore.Status.Phase == api.RestorePhaseCompleted || restore.Status.Phase == api.RestorePhasePartiallyFailed) {
+						return o.downloadManifests(restore, f)
+					}
 					return nil
 				}
 			}
@@ -41,6 +41,8 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		Use:   use + " [NAME1] [NAME2] [NAME...]",
 		Short: "Describe restores",
 		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(output.ValidateFlags(c))
+
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
@@ -57,6 +59,11 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				cmd.CheckError(err)
 			}
 
+			if printed, err := output.PrintWithFormat(c, restores); printed || err != nil {
+				cmd.CheckError(err)
+				return
+			}
+
 			first := true
 			for _, restore := range restores.Items {
 				opts := restic.NewPodVolumeRestoreListOptions(restore.Name)
@@ -78,6 +85,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	}
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "only show items matching this label selector")
+	output.BindDescribeFlags(c.Flags())
 	c.Flags().BoolVar(&details, "details", details, "display additional detail in the command output")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 
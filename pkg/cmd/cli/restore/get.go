@@ -17,6 +17,9 @@ limitations under the License.
 package restore
 
 import (
+	"sort"
+	"time"
+
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -28,6 +31,7 @@ import (
 
 func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	var listOptions metav1.ListOptions
+	var limit output.ListLimit
 
 	c := &cobra.Command{
 		Use:   use,
@@ -35,6 +39,7 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 		Run: func(c *cobra.Command, args []string) {
 			err := output.ValidateFlags(c)
 			cmd.CheckError(err)
+			cmd.CheckError(limit.Validate())
 
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
@@ -48,10 +53,20 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 					restores.Items = append(restores.Items, *restore)
 				}
 			} else {
-				restores, err = veleroClient.VeleroV1().Restores(f.Namespace()).List(listOptions)
+				restores = new(api.RestoreList)
+				err = output.ListPages(listOptions, func(listOptions metav1.ListOptions) (string, error) {
+					page, err := veleroClient.VeleroV1().Restores(f.Namespace()).List(listOptions)
+					if err != nil {
+						return "", err
+					}
+					restores.Items = append(restores.Items, page.Items...)
+					return page.Continue, nil
+				})
 				cmd.CheckError(err)
 			}
 
+			restores.Items = limitRestores(restores.Items, limit, time.Now())
+
 			if printed, err := output.PrintWithFormat(c, restores); printed || err != nil {
 				cmd.CheckError(err)
 				return
@@ -63,8 +78,26 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	}
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "only show items matching this label selector")
+	limit.BindFlags(c.Flags())
 
 	output.BindFlags(c.Flags())
 
 	return c
 }
+
+// limitRestores returns the restores created within --since, newest first,
+// limited to --limit.
+func limitRestores(restores []api.Restore, limit output.ListLimit, now time.Time) []api.Restore {
+	res := make([]api.Restore, 0, len(restores))
+	for _, restore := range restores {
+		if limit.Includes(restore.CreationTimestamp, now) {
+			res = append(res, restore)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return output.NewestFirst(res[i].CreationTimestamp, res[j].CreationTimestamp)
+	})
+
+	return res[:limit.Truncate(len(res))]
+}
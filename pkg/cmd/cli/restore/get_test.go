@@ -0,0 +1,69 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+func TestLimitRestores(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	restores := []velerov1api.Restore{
+		*builder.ForRestore("velero", "restore-a").ObjectMeta(builder.WithCreationTimestamp(now.Add(-2 * time.Hour))).Result(),
+		*builder.ForRestore("velero", "restore-b").ObjectMeta(builder.WithCreationTimestamp(now.Add(-4 * time.Hour))).Result(),
+		*builder.ForRestore("velero", "restore-c").ObjectMeta(builder.WithCreationTimestamp(now.Add(-1 * time.Hour))).Result(),
+		*builder.ForRestore("velero", "restore-d").ObjectMeta(builder.WithCreationTimestamp(now.Add(-3 * time.Hour))).Result(),
+	}
+
+	tests := []struct {
+		name  string
+		limit output.ListLimit
+		want  []string
+	}{
+		{
+			name: "restores are shown newest first",
+			want: []string{"restore-c", "restore-a", "restore-d", "restore-b"},
+		},
+		{
+			name:  "limit shows the newest restores",
+			limit: output.ListLimit{Limit: 2},
+			want:  []string{"restore-c", "restore-a"},
+		},
+		{
+			name:  "limit and since",
+			limit: output.ListLimit{Limit: 3, Since: 150 * time.Minute},
+			want:  []string{"restore-c", "restore-a"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var names []string
+			for _, restore := range limitRestores(restores, tc.limit, now) {
+				names = append(names, restore.Name)
+			}
+			assert.Equal(t, tc.want, names)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+)
+
+const manifestsDownloadRequestTimeout = time.Minute
+
+// downloadManifests downloads the manifests of a restore with spec.noApply set
+// to the output directory.
+func (o *CreateOptions) downloadManifests(restore *api.Restore, f client.Factory) error {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(o.client.VeleroV1(), f.Namespace(), restore.Name, api.DownloadTargetKindRestoreManifests, buf, manifestsDownloadRequestTimeout, o.InsecureSkipTLSVerify); err != nil {
+		return errors.Wrap(err, "error downloading restore manifests")
+	}
+
+	count, err := extractManifests(buf, o.OutputDir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote %d manifests to %s.\n", count, o.OutputDir)
+	return nil
+}
+
+// extractManifests extracts the manifests in a restore's manifests tarball into dir,
+// and returns the number of manifests extracted.
+func extractManifests(r io.Reader, dir string) (int, error) {
+	var count int
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, errors.Wrap(err, "error reading restore manifests")
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// guard against entries that would be written outside of dir.
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return count, errors.Errorf("invalid path %q in restore manifests", hdr.Name)
+		}
+
+		target := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return count, errors.WithStack(err)
+		}
+
+		file, err := os.Create(target)
+		if err != nil {
+			return count, errors.WithStack(err)
+		}
+
+		_, err = io.Copy(file, tr)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return count, errors.Wrapf(err, "error writing %s", target)
+		}
+
+		count++
+	}
+}
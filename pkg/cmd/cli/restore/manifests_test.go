@@ -0,0 +1,83 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newManifestsTarball(t *testing.T, files map[string]string) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+			Mode:     0644,
+		}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	return buf
+}
+
+func TestExtractManifests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tarball := newManifestsTarball(t, map[string]string{
+		"namespaces/ns-1/pods/pod-1.yaml":     "kind: Pod",
+		"cluster/persistentvolumes/pv-1.yaml": "kind: PersistentVolume",
+	})
+
+	count, err := extractManifests(tarball, dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "namespaces", "ns-1", "pods", "pod-1.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: Pod", string(contents))
+
+	contents, err = ioutil.ReadFile(filepath.Join(dir, "cluster", "persistentvolumes", "pv-1.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: PersistentVolume", string(contents))
+}
+
+func TestExtractManifestsRejectsPathsOutsideDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tarball := newManifestsTarball(t, map[string]string{
+		"../evil.yaml": "kind: Pod",
+	})
+
+	_, err = extractManifests(tarball, dir)
+	assert.Error(t, err)
+}
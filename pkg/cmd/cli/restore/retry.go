@@ -0,0 +1,151 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+func NewRetryCommand(f client.Factory, use string) *cobra.Command {
+	o := NewRetryOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Retry the failed items of a partially failed restore",
+		Long: `Retry the failed items of a partially failed restore.
+
+The retry is a new restore of the same backup, with the same settings, that only restores the items that failed
+to restore the first time. Items that the first restore created are recognized by the retry, since it has the
+same idempotency token, so they aren't reported as already existing.`,
+		Example: `  # retry the failed items of restore "restore-1", in a restore named "restore-1-retry-<timestamp>"
+  velero restore retry restore-1
+
+  # retry the failed items of restore "restore-1" in a restore named "restore-1-retry"
+  velero restore retry restore-1 --name restore-1-retry`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
+
+	return c
+}
+
+type RetryOptions struct {
+	Name      string
+	RetryName string
+
+	original *api.Restore
+}
+
+func NewRetryOptions() *RetryOptions {
+	return &RetryOptions{}
+}
+
+func (o *RetryOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.RetryName, "name", o.RetryName, "name of the retry. Defaults to the restore's name, followed by -retry- and a timestamp.")
+}
+
+func (o *RetryOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	if o.RetryName == "" {
+		o.RetryName = fmt.Sprintf("%s-retry-%s", o.Name, time.Now().Format("20060102150405"))
+	}
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	o.original, err = client.VeleroV1().Restores(f.Namespace()).Get(o.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (o *RetryOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if err := output.ValidateFlags(c); err != nil {
+		return err
+	}
+
+	if o.original.Status.Phase != api.RestorePhasePartiallyFailed {
+		return errors.Errorf("restore %s can't be retried because its phase is %s, not %s", o.original.Name, o.original.Status.Phase, api.RestorePhasePartiallyFailed)
+	}
+
+	return nil
+}
+
+func (o *RetryOptions) Run(c *cobra.Command, f client.Factory) error {
+	retry := newRetry(o.original, o.RetryName)
+
+	if printed, err := output.PrintWithFormat(c, retry); printed || err != nil {
+		return err
+	}
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.VeleroV1().Restores(retry.Namespace).Create(retry); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Restore request %q submitted successfully.\n", retry.Name)
+	fmt.Printf("Run `velero restore describe %s` or `velero restore logs %s` for more details.\n", retry.Name, retry.Name)
+	return nil
+}
+
+// newRetry returns a restore named name that retries the failed items of
+// restore, with the same spec and idempotency token.
+func newRetry(restore *api.Restore, name string) *api.Restore {
+	retry := &api.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: restore.Namespace,
+			Name:      name,
+		},
+		Spec: *restore.Spec.DeepCopy(),
+	}
+	retry.Spec.RetryOf = restore.Name
+
+	// the restore's token is defaulted to its UID by the server, so the
+	// retry is given it explicitly in case the restore's spec is older.
+	if retry.Spec.IdempotencyToken == "" {
+		retry.Spec.IdempotencyToken = string(restore.UID)
+	}
+
+	return retry
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestNewRetry(t *testing.T) {
+	restore := builder.ForRestore("velero", "restore-1").
+		Backup("backup-1").
+		IncludedNamespaces("ns-1").
+		IdempotencyToken("token-1").
+		ObjectMeta(builder.WithLabels("app", "nginx")).
+		Phase(velerov1api.RestorePhasePartiallyFailed).
+		Result()
+
+	retry := newRetry(restore, "restore-1-retry")
+
+	assert.Equal(t, "velero", retry.Namespace)
+	assert.Equal(t, "restore-1-retry", retry.Name)
+	assert.Empty(t, retry.Labels)
+	assert.Equal(t, velerov1api.RestoreStatus{}, retry.Status)
+	assert.Equal(t, "backup-1", retry.Spec.BackupName)
+	assert.Equal(t, []string{"ns-1"}, retry.Spec.IncludedNamespaces)
+	assert.Equal(t, "restore-1", retry.Spec.RetryOf)
+	assert.Equal(t, "token-1", retry.Spec.IdempotencyToken)
+
+	// restores from before idempotency tokens were defaulted use their UID.
+	restore.Spec.IdempotencyToken = ""
+	restore.UID = types.UID("uid-1")
+	assert.Equal(t, "uid-1", newRetry(restore, "restore-1-retry").Spec.IdempotencyToken)
+}
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
@@ -81,6 +82,13 @@ example: "@every 2h30m".`,
 type CreateOptions struct {
 	BackupOptions *backup.CreateOptions
 	Schedule      string
+	Timezone      string
+	VerifyEvery   int
+	Clusters      []string
+	KeepLast      int
+	KeepDaily     int
+	KeepWeekly    int
+	Paused        bool
 
 	labelSelector *metav1.LabelSelector
 }
@@ -94,13 +102,40 @@ func NewCreateOptions() *CreateOptions {
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "a cron expression specifying a recurring schedule for this backup to run")
+	flags.StringVar(&o.Timezone, "timezone", o.Timezone, "the IANA time zone database name of the time zone to evaluate the schedule in, e.g. America/New_York. Optional; defaults to the Velero server's local time zone")
+	flags.IntVar(&o.VerifyEvery, "verify-every", o.VerifyEvery, "verify every Nth backup created by this schedule, by restoring its namespaces into scratch namespaces that are deleted afterwards. Optional; zero disables verification.")
+	flags.StringSliceVar(&o.Clusters, "clusters", o.Clusters, "registered member clusters to back up, each in its own backup, if the Velero server runs in a management cluster (use '*' for all member clusters)")
+	flags.IntVar(&o.KeepLast, "keep-last", o.KeepLast, "number of the most recent backups to keep. If any --keep flag is set, completed backups are deleted once none of them keep them, instead of when their TTL expires")
+	flags.IntVar(&o.KeepDaily, "keep-daily", o.KeepDaily, "number of days, of the most recent days with backups, to keep the last backup of")
+	flags.IntVar(&o.KeepWeekly, "keep-weekly", o.KeepWeekly, "number of weeks, of the most recent weeks with backups, to keep the last backup of")
+	flags.BoolVar(&o.Paused, "paused", o.Paused, "create the schedule paused, so that it doesn't create backups until it's unpaused")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
-	if len(o.Schedule) == 0 {
+	if len(o.Schedule) == 0 && !o.BackupOptions.ValidateOnly {
 		return errors.New("--schedule is required")
 	}
 
+	if o.VerifyEvery < 0 {
+		return errors.New("--verify-every must be zero or a positive number")
+	}
+
+	if o.KeepLast < 0 || o.KeepDaily < 0 || o.KeepWeekly < 0 {
+		return errors.New("--keep-last, --keep-daily and --keep-weekly must be zero or positive numbers")
+	}
+
+	if len(o.Clusters) > 0 && o.BackupOptions.Cluster != "" {
+		return errors.New("either --cluster or --clusters can be used, but not both")
+	}
+
+	// with --validate-only, an invalid timezone is printed along with the
+	// rest of the schedule's problems.
+	if !o.BackupOptions.ValidateOnly {
+		if _, err := pkgbackup.ParseTimezone(o.Timezone); err != nil {
+			return err
+		}
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -122,20 +157,63 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: api.ScheduleSpec{
 			Template: api.BackupSpec{
-				IncludedNamespaces:      o.BackupOptions.IncludeNamespaces,
-				ExcludedNamespaces:      o.BackupOptions.ExcludeNamespaces,
-				IncludedResources:       o.BackupOptions.IncludeResources,
-				ExcludedResources:       o.BackupOptions.ExcludeResources,
-				IncludeClusterResources: o.BackupOptions.IncludeClusterResources.Value,
-				LabelSelector:           o.BackupOptions.Selector.LabelSelector,
-				SnapshotVolumes:         o.BackupOptions.SnapshotVolumes.Value,
-				TTL:                     metav1.Duration{Duration: o.BackupOptions.TTL},
-				StorageLocation:         o.BackupOptions.StorageLocation,
-				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
+				IncludedNamespaces:            o.BackupOptions.IncludeNamespaces,
+				ExcludedNamespaces:            o.BackupOptions.ExcludeNamespaces,
+				NamespaceSelector:             o.BackupOptions.NamespaceSelector.LabelSelector,
+				IncludedResources:             o.BackupOptions.IncludeResources,
+				ExcludedResources:             o.BackupOptions.ExcludeResources,
+				IncludeClusterResources:       o.BackupOptions.IncludeClusterResources.Value,
+				LabelSelector:                 o.BackupOptions.Selector.LabelSelector,
+				SnapshotVolumes:               o.BackupOptions.SnapshotVolumes.Value,
+				ExcludedSnapshotNamespaces:    o.BackupOptions.ExcludeSnapshotNamespaces,
+				ExcludedSnapshotLabelSelector: o.BackupOptions.ExcludeSnapshotSelector.LabelSelector,
+				PodVolumeBackupSelectors:      o.BackupOptions.PodVolumeBackupSelectors.LabelSelectors,
+				DefaultVolumesToRestic:        o.BackupOptions.DefaultVolumesToRestic.Value,
+				MaxItems:                      o.BackupOptions.MaxItems.Value,
+				MaxBytes:                      o.BackupOptions.MaxSize.Bytes(),
+				TTL:                           metav1.Duration{Duration: o.BackupOptions.TTL},
+				LogTTL:                        metav1.Duration{Duration: o.BackupOptions.LogTTL},
+				SearchIndex:                   o.BackupOptions.SearchIndex,
+				IncludeEventsAndPodLogs:       o.BackupOptions.IncludeEventsAndPodLogs,
+				ReplicaPolicies:               o.BackupOptions.ReplicaPolicies.Policies(),
+				StorageLocation:               o.BackupOptions.StorageLocation,
+				Cluster:                       o.BackupOptions.Cluster,
+				AdditionalStorageLocations:    o.BackupOptions.AdditionalLocations,
+				VolumeSnapshotLocations:       o.BackupOptions.SnapshotLocations,
 			},
-			Schedule: o.Schedule,
+			Schedule:    o.Schedule,
+			Timezone:    o.Timezone,
+			VerifyEvery: o.VerifyEvery,
+			Clusters:    o.Clusters,
+			Paused:      o.Paused,
 		},
 	}
+	if len(o.BackupOptions.OrderedResources.Data()) > 0 {
+		schedule.Spec.Template.OrderedResources = o.BackupOptions.OrderedResources.Data()
+	}
+	if names := o.BackupOptions.ResourceNames(); len(names) > 0 {
+		schedule.Spec.Template.IncludedResourceNames = names
+	}
+	if o.KeepLast > 0 || o.KeepDaily > 0 || o.KeepWeekly > 0 {
+		schedule.Spec.Retention = &api.RetentionPolicy{
+			KeepLast:   o.KeepLast,
+			KeepDaily:  o.KeepDaily,
+			KeepWeekly: o.KeepWeekly,
+		}
+	}
+
+	if o.BackupOptions.ValidateOnly {
+		var problems []string
+		if _, err := pkgbackup.ParseCronSchedule(schedule.Spec.Schedule); err != nil {
+			problems = append(problems, err.Error())
+		}
+		if _, err := pkgbackup.ParseTimezone(schedule.Spec.Timezone); err != nil {
+			problems = append(problems, err.Error())
+		}
+		problems = append(problems, o.BackupOptions.ValidateSpec(schedule.Namespace, schedule.Spec.Template)...)
+
+		return backup.PrintValidationProblems("Schedule", problems)
+	}
 
 	if printed, err := output.PrintWithFormat(c, schedule); printed || err != nil {
 		return err
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+func NewHistoryCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Show the revisions of a schedule's backup template",
+		Long: `Show the revisions of a schedule's backup template, newest first, with the
+changes that each revision made to the template and the backups that were
+created from it. Only the most recent revisions are kept.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			veleroClient, err := f.Client()
+			cmd.CheckError(err)
+
+			schedule, err := veleroClient.VeleroV1().Schedules(f.Namespace()).Get(args[0], metav1.GetOptions{})
+			cmd.CheckError(err)
+
+			listOptions := metav1.ListOptions{LabelSelector: labels.SelectorFromSet(labels.Set{v1.ScheduleNameLabel: schedule.Name}).String()}
+			backups, err := veleroClient.VeleroV1().Backups(f.Namespace()).List(listOptions)
+			cmd.CheckError(err)
+
+			fmt.Print(output.DescribeScheduleHistory(schedule, backups.Items))
+		},
+	}
+
+	return c
+}
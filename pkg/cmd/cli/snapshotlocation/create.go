@@ -18,10 +18,12 @@ package snapshotlocation
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -53,15 +55,26 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name     string
-	Provider string
-	Config   flag.Map
-	Labels   flag.Map
+	Name         string
+	Provider     string
+	Config       flag.Map
+	Labels       flag.Map
+	IdentityMode *flag.Enum
+	Identity     string
+	Credential   flag.Map
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Config: flag.NewMap(),
+		Config:     flag.NewMap(),
+		Credential: flag.NewMap(),
+		IdentityMode: flag.NewEnum(
+			"",
+			string(api.CloudIdentityModeSecret),
+			string(api.CloudIdentityModeAWSIRSA),
+			string(api.CloudIdentityModeGCPWorkloadIdentity),
+			string(api.CloudIdentityModeAzureWorkloadIdentity),
+		),
 	}
 }
 
@@ -69,6 +82,13 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", o.Provider, "name of the volume snapshot provider (e.g. aws, azure, gcp)")
 	flags.Var(&o.Config, "config", "configuration key-value pairs")
 	flags.Var(&o.Labels, "labels", "labels to apply to the volume snapshot location")
+	flags.Var(
+		o.IdentityMode,
+		"identity-mode",
+		fmt.Sprintf("how the provider's plugin authenticates to the volume storage. Valid values are %s. Optional.", strings.Join(o.IdentityMode.AllowedValues(), ",")),
+	)
+	flags.StringVar(&o.Identity, "identity", o.Identity, "the cloud identity that the plugin authenticates as with --identity-mode. Optional.")
+	flags.Var(&o.Credential, "credential", "the secret in the Velero namespace, and its key, that holds the credentials the plugin authenticates to the volume storage with, as <secret-name>=<key>. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -80,6 +100,14 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--provider is required")
 	}
 
+	if err := validateIdentity(o.IdentityMode.String(), o.Identity); err != nil {
+		return err
+	}
+
+	if len(o.Credential.Data()) > 1 {
+		return errors.New("--credential can only have one secret and key")
+	}
+
 	return nil
 }
 
@@ -96,8 +124,10 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			Labels:    o.Labels.Data(),
 		},
 		Spec: api.VolumeSnapshotLocationSpec{
-			Provider: o.Provider,
-			Config:   o.Config.Data(),
+			Provider:   o.Provider,
+			Config:     o.Config.Data(),
+			Identity:   identity(o.IdentityMode.String(), o.Identity),
+			Credential: credential(o.Credential.Data()),
 		},
 	}
 
@@ -117,3 +147,37 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Snapshot volume location %q configured successfully.\n", volumeSnapshotLocation.Name)
 	return nil
 }
+
+// validateIdentity returns an error if --identity is used without an
+// --identity-mode, or an --identity-mode other than Secret is used without
+// --identity.
+func validateIdentity(mode, identity string) error {
+	switch {
+	case mode == "" && identity != "":
+		return errors.New("--identity requires --identity-mode")
+	case mode != "" && mode != string(api.CloudIdentityModeSecret) && identity == "":
+		return errors.Errorf("--identity is required with --identity-mode %s", mode)
+	}
+	return nil
+}
+
+// identity returns the identity that the location's plugin authenticates
+// as, or nil if there's no --identity-mode.
+func identity(mode, id string) *api.CloudIdentity {
+	if mode == "" {
+		return nil
+	}
+	return &api.CloudIdentity{Mode: api.CloudIdentityMode(mode), Identity: id}
+}
+
+// credential returns the secret key that the location's plugin authenticates
+// with, or nil if there's no --credential.
+func credential(secretKeys map[string]string) *corev1api.SecretKeySelector {
+	for name, key := range secretKeys {
+		return &corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+			Key:                  key,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/install"
+)
+
+// UninstallOptions collects all the options for uninstalling Velero from a Kubernetes cluster.
+type UninstallOptions struct {
+	Namespace             string
+	DeleteCustomResources bool
+	Wait                  bool
+	Confirm               bool
+}
+
+// BindFlags adds command line values to the options struct.
+func (o *UninstallOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.DeleteCustomResources, "delete-custom-resources", o.DeleteCustomResources, "delete Velero's CustomResourceDefinitions even if there are custom resources of them, such as backups and schedules, deleting the custom resources too. Optional.")
+	flags.BoolVar(&o.Wait, "wait", o.Wait, "wait for Velero's resources to be deleted. Optional.")
+	flags.BoolVar(&o.Confirm, "confirm", o.Confirm, "confirm uninstalling Velero without being prompted. Optional.")
+}
+
+// NewCommand creates a cobra command.
+func NewCommand(f client.Factory) *cobra.Command {
+	o := &UninstallOptions{}
+	c := &cobra.Command{
+		Use:   "uninstall",
+		Short: "Uninstall Velero",
+		Long: `
+Uninstall Velero from a Kubernetes cluster, deleting the Velero Deployment, the associated
+Restic DaemonSet, the Velero ClusterRoleBinding and the Velero CustomResourceDefinitions.
+
+Deleting a CustomResourceDefinition deletes all of its custom resources, such as backups,
+restores, schedules and backup storage locations, so CustomResourceDefinitions that still
+have custom resources are kept unless '--delete-custom-resources' is used. Backups that are
+stored in object storage aren't deleted.
+
+Velero is uninstalled from the 'velero' namespace by default. The '--namespace' flag can be
+used to uninstall it from a different namespace. The namespace itself isn't deleted.
+
+Use '--wait' to wait for the resources to be deleted before proceeding.
+		`,
+		Example: `	# velero uninstall
+
+	# velero uninstall --delete-custom-resources --wait --confirm`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// Complete completes options for a command.
+func (o *UninstallOptions) Complete(f client.Factory) error {
+	o.Namespace = f.Namespace()
+	return nil
+}
+
+// Run uninstalls Velero, after getting confirmation from the user.
+func (o *UninstallOptions) Run(f client.Factory) error {
+	if !o.Confirm {
+		fmt.Printf("You are about to uninstall Velero from namespace %s.\n", o.Namespace)
+		if o.DeleteCustomResources {
+			fmt.Println("All of Velero's custom resources, including backups, restores and schedules, will be deleted from the cluster.")
+		}
+		if !cli.GetConfirmation() {
+			// Don't do anything unless we get confirmation
+			return nil
+		}
+	}
+
+	dynamicClient, err := f.DynamicClient()
+	if err != nil {
+		return err
+	}
+	factory := client.NewDynamicFactory(dynamicClient)
+
+	err = install.Uninstall(factory, install.UninstallOptions{
+		Namespace:             o.Namespace,
+		DeleteCustomResources: o.DeleteCustomResources,
+		Wait:                  o.Wait,
+	}, os.Stdout)
+	if err != nil {
+		return errors.Wrap(err, "\n\nError uninstalling Velero")
+	}
+
+	fmt.Printf("Velero is uninstalled from namespace %s.\n", o.Namespace)
+	return nil
+}
@@ -17,24 +17,149 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
-// CheckError prints err to stderr and exits with code 1 if err is not nil. Otherwise, it is a
+// Exit codes returned by CheckError, so that scripts can handle common
+// failures without parsing error messages.
+const (
+	// ExitCodeError is the exit code for errors that don't have a more
+	// specific exit code.
+	ExitCodeError = 1
+
+	// ExitCodeNotFound is the exit code when an object wasn't found.
+	ExitCodeNotFound = 3
+
+	// ExitCodeForbidden is the exit code when the user isn't authenticated,
+	// or isn't authorized to perform an operation.
+	ExitCodeForbidden = 4
+
+	// ExitCodeAlreadyExists is the exit code when an object can't be created
+	// because it already exists.
+	ExitCodeAlreadyExists = 5
+
+	// ExitCodeReadOnlyLocation is the exit code when an operation needs to write
+	// to a backup storage location that's in read-only mode.
+	ExitCodeReadOnlyLocation = 6
+)
+
+// verboseErrors is whether CheckError prints the underlying cause of errors.
+var verboseErrors bool
+
+// BindErrorFlags binds the flags that control how errors are presented to the
+// passed-in FlagSet.
+func BindErrorFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&verboseErrors, "verbose", verboseErrors, "show the underlying cause of errors")
+}
+
+// ReadOnlyLocationError is returned when an operation needs to write to a
+// backup storage location that's in read-only mode.
+type ReadOnlyLocationError struct {
+	Namespace string
+	Name      string
+}
+
+func (e *ReadOnlyLocationError) Error() string {
+	return fmt.Sprintf("backup storage location %q is in read-only mode", e.Name)
+}
+
+// CheckError prints err, and a hint for resolving it if there is one, to stderr and
+// exits with an exit code for the kind of error if err is not nil. Otherwise, it is a
 // no-op.
 func CheckError(err error) {
 	if err != nil {
-		if err != context.Canceled {
-			fmt.Fprintf(os.Stderr, "An error occurred: %v\n", err)
+		if err == context.Canceled {
+			os.Exit(ExitCodeError)
 		}
-		os.Exit(1)
+
+		message, exitCode := presentError(err, verboseErrors)
+		fmt.Fprint(os.Stderr, message)
+		os.Exit(exitCode)
 	}
 }
 
 // Exit prints msg (with optional args), plus a newline, to stderr and exits with code 1.
 func Exit(msg string, args ...interface{}) {
 	fmt.Fprintf(os.Stderr, msg+"\n", args...)
-	os.Exit(1)
+	os.Exit(ExitCodeError)
+}
+
+// presentError returns the message to print for err, and the exit code to
+// exit with. If verbose is true, the message includes the underlying cause of
+// the error.
+func presentError(err error, verbose bool) (string, int) {
+	hint, exitCode := errorHint(errors.Cause(err))
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "An error occurred: %v\n", err)
+	if hint != "" {
+		fmt.Fprintf(buf, "Hint: %s\n", hint)
+	}
+
+	if verbose {
+		cause := errors.Cause(err)
+		if status, ok := cause.(apierrors.APIStatus); ok {
+			s := status.Status()
+			fmt.Fprintf(buf, "Cause: %s (reason: %s, code: %d)\n", s.Message, s.Reason, s.Code)
+		} else {
+			fmt.Fprintf(buf, "Cause: %v\n", cause)
+		}
+		fmt.Fprintf(buf, "Details: %+v\n", err)
+	} else if hint != "" {
+		fmt.Fprintln(buf, "Run with --verbose for more details.")
+	}
+
+	return buf.String(), exitCode
+}
+
+// notFoundHints are hints for finding the right name of Velero objects,
+// keyed by resource.
+var notFoundHints = map[string]string{
+	"backups":                 "Run 'velero backup get' to list the available backups.",
+	"restores":                "Run 'velero restore get' to list the available restores.",
+	"schedules":               "Run 'velero schedule get' to list the available schedules.",
+	"backupstoragelocations":  "Run 'velero backup-location get' to list the available backup storage locations.",
+	"volumesnapshotlocations": "Run 'velero snapshot-location get' to list the available volume snapshot locations.",
+	"namespaces":              "Velero may not be installed in this namespace. Use the --namespace flag, or run 'velero client config set namespace=<NAMESPACE>', to use the namespace Velero is installed in.",
+}
+
+// errorHint returns an actionable hint for resolving err, if there is one, and
+// the exit code for it.
+func errorHint(err error) (string, int) {
+	if readOnly, ok := err.(*ReadOnlyLocationError); ok {
+		return fmt.Sprintf("Use a backup storage location that's in read-write mode, or change the access mode of %q with 'kubectl -n %s patch backupstoragelocation %s --type merge -p '{\"spec\":{\"accessMode\":\"ReadWrite\"}}''.", readOnly.Name, readOnly.Namespace, readOnly.Name), ExitCodeReadOnlyLocation
+	}
+
+	switch {
+	case apierrors.IsNotFound(err):
+		var resource string
+		if status, ok := err.(apierrors.APIStatus); ok && status.Status().Details != nil {
+			resource = status.Status().Details.Kind
+		}
+
+		if hint, ok := notFoundHints[resource]; ok {
+			return hint, ExitCodeNotFound
+		}
+		if resource == "" {
+			// the API server returns a not found error without any details
+			// if the resource type doesn't exist.
+			return "Velero's custom resource definitions may not be installed in the cluster. Run 'velero install', or check that the current --kubecontext is for the right cluster.", ExitCodeNotFound
+		}
+		return "Check the name, and that the --namespace flag is set to the namespace Velero is installed in.", ExitCodeNotFound
+	case apierrors.IsUnauthorized(err):
+		return "Your Kubernetes credentials were rejected. Check that your kubeconfig is valid and its credentials haven't expired.", ExitCodeForbidden
+	case apierrors.IsForbidden(err):
+		return "Your Kubernetes user isn't allowed to do this. Ask a cluster administrator to grant you access to Velero's resources in its namespace, or use the --kubeconfig or --kubecontext flags to use a different user.", ExitCodeForbidden
+	case apierrors.IsAlreadyExists(err):
+		return "Choose a different name, or delete the existing object first.", ExitCodeAlreadyExists
+	}
+
+	return "", ExitCodeError
 }
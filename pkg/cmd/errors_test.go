@@ -0,0 +1,88 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestPresentError(t *testing.T) {
+	tests := []struct {
+		name             string
+		err              error
+		verbose          bool
+		expectedMessage  string
+		expectedExitCode int
+	}{
+		{
+			name:             "error without a hint",
+			err:              errors.New("foo"),
+			expectedMessage:  "An error occurred: foo\n",
+			expectedExitCode: ExitCodeError,
+		},
+		{
+			name:             "velero object not found",
+			err:              apierrors.NewNotFound(schema.GroupResource{Group: "velero.io", Resource: "backupstoragelocations"}, "foo"),
+			expectedMessage:  "An error occurred: backupstoragelocations.velero.io \"foo\" not found\nHint: Run 'velero backup-location get' to list the available backup storage locations.\nRun with --verbose for more details.\n",
+			expectedExitCode: ExitCodeNotFound,
+		},
+		{
+			name:             "wrapped error is classified by its cause",
+			err:              errors.Wrap(apierrors.NewNotFound(schema.GroupResource{Group: "velero.io", Resource: "backups"}, "foo"), "error getting backup"),
+			expectedMessage:  "An error occurred: error getting backup: backups.velero.io \"foo\" not found\nHint: Run 'velero backup get' to list the available backups.\nRun with --verbose for more details.\n",
+			expectedExitCode: ExitCodeNotFound,
+		},
+		{
+			name:             "other object not found",
+			err:              apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "foo"),
+			expectedMessage:  "An error occurred: pods \"foo\" not found\nHint: Check the name, and that the --namespace flag is set to the namespace Velero is installed in.\nRun with --verbose for more details.\n",
+			expectedExitCode: ExitCodeNotFound,
+		},
+		{
+			name:             "forbidden",
+			err:              apierrors.NewForbidden(schema.GroupResource{Group: "velero.io", Resource: "backups"}, "foo", errors.New("no RBAC policy matched")),
+			expectedMessage:  "An error occurred: backups.velero.io \"foo\" is forbidden: no RBAC policy matched\nHint: Your Kubernetes user isn't allowed to do this. Ask a cluster administrator to grant you access to Velero's resources in its namespace, or use the --kubeconfig or --kubecontext flags to use a different user.\nRun with --verbose for more details.\n",
+			expectedExitCode: ExitCodeForbidden,
+		},
+		{
+			name:             "read-only location",
+			err:              &ReadOnlyLocationError{Namespace: "velero", Name: "default"},
+			expectedMessage:  "An error occurred: backup storage location \"default\" is in read-only mode\nHint: Use a backup storage location that's in read-write mode, or change the access mode of \"default\" with 'kubectl -n velero patch backupstoragelocation default --type merge -p '{\"spec\":{\"accessMode\":\"ReadWrite\"}}''.\nRun with --verbose for more details.\n",
+			expectedExitCode: ExitCodeReadOnlyLocation,
+		},
+		{
+			name:             "verbose shows the cause",
+			err:              apierrors.NewAlreadyExists(schema.GroupResource{Group: "velero.io", Resource: "backups"}, "foo"),
+			verbose:          true,
+			expectedMessage:  "An error occurred: backups.velero.io \"foo\" already exists\nHint: Choose a different name, or delete the existing object first.\nCause: backups.velero.io \"foo\" already exists (reason: AlreadyExists, code: 409)\nDetails: backups.velero.io \"foo\" already exists\n",
+			expectedExitCode: ExitCodeAlreadyExists,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			message, exitCode := presentError(test.err, test.verbose)
+			assert.Equal(t, test.expectedMessage, message)
+			assert.Equal(t, test.expectedExitCode, exitCode)
+		})
+	}
+}
@@ -47,6 +47,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterBackupItemAction("velero.io/pv", newPVBackupItemAction).
 				RegisterBackupItemAction("velero.io/pod", newPodBackupItemAction).
 				RegisterBackupItemAction("velero.io/service-account", newServiceAccountBackupItemAction(f)).
+				RegisterBackupItemAction("velero.io/crd-dependencies", newCRDDependenciesBackupItemAction(f)).
 				RegisterRestoreItemAction("velero.io/job", newJobRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/pod", newPodRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/restic", newResticRestoreItemAction(f)).
@@ -121,6 +122,32 @@ func newServiceAccountBackupItemAction(f client.Factory) veleroplugin.HandlerIni
 	}
 }
 
+func newCRDDependenciesBackupItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		clientset, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		dynamicClient, err := f.DynamicClient()
+		if err != nil {
+			return nil, err
+		}
+
+		discoveryHelper, err := velerodiscovery.NewHelper(clientset.Discovery(), logger)
+		if err != nil {
+			return nil, err
+		}
+
+		action, err := backup.NewCRDDependenciesAction(logger, discoveryHelper, client.NewDynamicFactory(dynamicClient))
+		if err != nil {
+			return nil, err
+		}
+
+		return action, nil
+	}
+}
+
 func newJobRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return restore.NewJobAction(logger), nil
 }
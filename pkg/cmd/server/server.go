@@ -23,18 +23,21 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"path/filepath"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"golang.org/x/time/rate"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -42,6 +45,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/backup"
@@ -51,17 +55,27 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/signals"
 	"github.com/vmware-tanzu/velero/pkg/controller"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/downloadproxy"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/identity"
+	"github.com/vmware-tanzu/velero/pkg/leaderelection"
+	"github.com/vmware-tanzu/velero/pkg/membercluster"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/storage"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
+	"github.com/vmware-tanzu/velero/pkg/webhook"
 )
 
 const (
@@ -69,6 +83,7 @@ const (
 	defaultMetricsAddress = ":8085"
 
 	defaultBackupSyncPeriod           = time.Minute
+	defaultStoreValidationFrequency   = time.Minute
 	defaultPodVolumeOperationTimeout  = 60 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
 
@@ -78,48 +93,116 @@ const (
 
 	defaultProfilerAddress = "localhost:6060"
 
+	// the port where the download proxy serves downloads, if it's enabled
+	defaultDownloadProxyAddress = ":8086"
+
+	// the port where the validation webhook serves admission reviews, if
+	// it's enabled
+	defaultWebhookAddress = ":9443"
+
+	// the name of the lease that the server's replicas elect a leader with,
+	// and the defaults for leader election, which match the Kubernetes
+	// controller manager's.
+	leaderElectionLeaseName            = "velero"
+	defaultLeaderElectionLeaseDuration = 15 * time.Second
+	defaultLeaderElectionRenewDeadline = 10 * time.Second
+	defaultLeaderElectionRetryPeriod   = 2 * time.Second
+
+	// the directory that the credentials of locations with a credential are
+	// written to for their plugins
+	credentialsDirectory = "/tmp/credentials"
+
 	// keys used to map out available controllers with disable-controllers flag
-	BackupControllerKey              = "backup"
-	BackupSyncControllerKey          = "backup-sync"
-	ScheduleControllerKey            = "schedule"
-	GcControllerKey                  = "gc"
-	BackupDeletionControllerKey      = "backup-deletion"
-	RestoreControllerKey             = "restore"
-	DownloadRequestControllerKey     = "download-request"
-	ResticRepoControllerKey          = "restic-repo"
-	ServerStatusRequestControllerKey = "server-status-request"
+	BackupControllerKey                = "backup"
+	BackupSyncControllerKey            = "backup-sync"
+	BackupOperationsControllerKey      = "backup-operations"
+	BackupVerificationControllerKey    = "backup-verification"
+	ScheduleControllerKey              = "schedule"
+	GcControllerKey                    = "gc"
+	RetentionControllerKey             = "retention"
+	BackupDeletionControllerKey        = "backup-deletion"
+	RestoreControllerKey               = "restore"
+	DownloadRequestControllerKey       = "download-request"
+	ResticRepoControllerKey            = "restic-repo"
+	ServerStatusRequestControllerKey   = "server-status-request"
+	BackupStorageLocationControllerKey = "backup-storage-location"
 
 	defaultControllerWorkers = 1
+
+	// the defaults for the rate limiter used to retry items in controllers' work queues,
+	// which match client-go's workqueue.DefaultControllerRateLimiter.
+	defaultControllerRateLimiterBaseDelay         = 5 * time.Millisecond
+	defaultControllerRateLimiterMaxDelay          = 1000 * time.Second
+	defaultControllerRateLimiterQPS       float32 = 10.0
+	defaultControllerRateLimiterBurst     int     = 100
+
 	// the default TTL for a backup
 	defaultBackupTTL = 30 * 24 * time.Hour
+
+	// how long deletions of protected backups wait for approval, and how
+	// long approvals are valid for, by default
+	defaultDeleteBackupApprovalTTL = 24 * time.Hour
+
+	// how often the cluster's resources are refreshed from discovery at most
+	// when they've been invalidated, and how long they're cached for
+	// otherwise
+	discoveryMinRefreshInterval = 30 * time.Second
+	discoveryMaxAge             = 30 * time.Minute
 )
 
 // list of available controllers for input validation
 var disableControllerList = []string{
 	BackupControllerKey,
 	BackupSyncControllerKey,
+	BackupOperationsControllerKey,
+	BackupVerificationControllerKey,
 	ScheduleControllerKey,
 	GcControllerKey,
+	RetentionControllerKey,
 	BackupDeletionControllerKey,
 	RestoreControllerKey,
 	DownloadRequestControllerKey,
 	ResticRepoControllerKey,
 	ServerStatusRequestControllerKey,
+	BackupStorageLocationControllerKey,
 }
 
 type serverConfig struct {
 	pluginDir, metricsAddress, defaultBackupLocation                        string
 	backupSyncPeriod, podVolumeOperationTimeout, resourceTerminatingTimeout time.Duration
-	defaultBackupTTL                                                        time.Duration
+	storeValidationFrequency                                                time.Duration
+	defaultBackupTTL, deleteBackupApprovalTTL                               time.Duration
 	restoreResourcePriorities                                               []string
 	defaultVolumeSnapshotLocations                                          map[string]string
 	restoreOnly                                                             bool
+	protobufBackups                                                         bool
 	disabledControllers                                                     []string
 	clientQPS                                                               float32
 	clientBurst                                                             int
 	profilerAddress                                                         string
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
+	controllerResyncPeriods                                                 map[string]time.Duration
+	controllerRateLimiterBaseDelay, controllerRateLimiterMaxDelay           time.Duration
+	controllerRateLimiterQPS                                                float32
+	controllerRateLimiterBurst                                              int
+	dryRun                                                                  bool
+	tracingEndpoint                                                         string
+	clusterName                                                             string
+	crdEstablishedTimeout, pvcBindingTimeout                                time.Duration
+	storageLocationWriteQuorum                                              int
+	resticMaxConcurrentBackupsPerNode                                       int
+	defaultPodVolumeBackupSelectors                                         []metav1.LabelSelector
+	defaultVolumesToRestic                                                  bool
+	defaultBackupMaxItems                                                   int
+	defaultBackupMaxBytes                                                   int64
+	protectedNamespaces                                                     []string
+	pluginOperationTimeout                                                  time.Duration
+	downloadProxyURL, downloadProxyAddress                                  string
+	webhookAddress, webhookCertDir                                          string
+	leaderElect                                                             bool
+	leaderElectionLeaseDuration, leaderElectionRenewDeadline                time.Duration
+	leaderElectionRetryPeriod                                               time.Duration
 }
 
 type controllerRunInfo struct {
@@ -129,23 +212,41 @@ type controllerRunInfo struct {
 
 func NewCommand(f client.Factory) *cobra.Command {
 	var (
-		volumeSnapshotLocations = flag.NewMap().WithKeyValueDelimiter(":")
-		logLevelFlag            = logging.LogLevelFlag(logrus.InfoLevel)
-		config                  = serverConfig{
+		volumeSnapshotLocations  = flag.NewMap().WithKeyValueDelimiter(":")
+		controllerResyncPeriods  = flag.NewMap()
+		podVolumeBackupSelectors []string
+		backupMaxSize            = flag.NewQuantity()
+		logLevelFlag             = logging.LogLevelFlag(logrus.InfoLevel)
+		config                   = serverConfig{
 			pluginDir:                         "/plugins",
 			metricsAddress:                    defaultMetricsAddress,
 			defaultBackupLocation:             "default",
 			defaultVolumeSnapshotLocations:    make(map[string]string),
 			backupSyncPeriod:                  defaultBackupSyncPeriod,
+			storeValidationFrequency:          defaultStoreValidationFrequency,
 			defaultBackupTTL:                  defaultBackupTTL,
+			deleteBackupApprovalTTL:           defaultDeleteBackupApprovalTTL,
 			podVolumeOperationTimeout:         defaultPodVolumeOperationTimeout,
-			restoreResourcePriorities:         defaultRestorePriorities,
+			restoreResourcePriorities:         restore.DefaultResourcePriorities,
 			clientQPS:                         defaultClientQPS,
 			clientBurst:                       defaultClientBurst,
 			profilerAddress:                   defaultProfilerAddress,
 			resourceTerminatingTimeout:        defaultResourceTerminatingTimeout,
+			crdEstablishedTimeout:             restore.DefaultCRDEstablishedTimeout,
+			pvcBindingTimeout:                 restore.DefaultPVCBindingTimeout,
+			storageLocationWriteQuorum:        1,
 			formatFlag:                        logging.NewFormatFlag(),
 			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
+			controllerRateLimiterBaseDelay:    defaultControllerRateLimiterBaseDelay,
+			controllerRateLimiterMaxDelay:     defaultControllerRateLimiterMaxDelay,
+			controllerRateLimiterQPS:          defaultControllerRateLimiterQPS,
+			controllerRateLimiterBurst:        defaultControllerRateLimiterBurst,
+			pluginOperationTimeout:            controller.DefaultPluginOperationTimeout,
+			downloadProxyAddress:              defaultDownloadProxyAddress,
+			webhookAddress:                    defaultWebhookAddress,
+			leaderElectionLeaseDuration:       defaultLeaderElectionLeaseDuration,
+			leaderElectionRenewDeadline:       defaultLeaderElectionRenewDeadline,
+			leaderElectionRetryPeriod:         defaultLeaderElectionRetryPeriod,
 		}
 	)
 
@@ -177,10 +278,29 @@ func NewCommand(f client.Factory) *cobra.Command {
 				logger.Info("No feature flags enabled")
 			}
 
+			if !c.Flags().Changed("protected-namespaces") {
+				config.protectedNamespaces = []string{"kube-system", f.Namespace()}
+			}
+
 			if volumeSnapshotLocations.Data() != nil {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
 
+			resyncPeriods, err := parseControllerResyncPeriods(controllerResyncPeriods.Data())
+			cmd.CheckError(err)
+			config.controllerResyncPeriods = resyncPeriods
+
+			selectors, err := parsePodVolumeBackupSelectors(podVolumeBackupSelectors)
+			cmd.CheckError(err)
+			config.defaultPodVolumeBackupSelectors = selectors
+
+			if maxBytes := backupMaxSize.Bytes(); maxBytes != nil {
+				config.defaultBackupMaxBytes = *maxBytes
+			}
+			if config.defaultBackupMaxItems < 0 || config.defaultBackupMaxBytes < 0 {
+				cmd.CheckError(errors.New("--default-backup-max-items and --default-backup-max-size must not be negative"))
+			}
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
 			s, err := newServer(f, config, logger)
@@ -195,9 +315,12 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().StringVar(&config.pluginDir, "plugin-dir", config.pluginDir, "directory containing Velero plugins")
 	command.Flags().StringVar(&config.metricsAddress, "metrics-address", config.metricsAddress, "the address to expose prometheus metrics")
 	command.Flags().DurationVar(&config.backupSyncPeriod, "backup-sync-period", config.backupSyncPeriod, "how often to ensure all Velero backups in object storage exist as Backup API objects in the cluster")
+	command.Flags().DurationVar(&config.storeValidationFrequency, "store-validation-frequency", config.storeValidationFrequency, "how often to check that backup storage locations are available, for locations that don't set their own frequency. Set this to 0s to disable the check")
 	command.Flags().DurationVar(&config.podVolumeOperationTimeout, "restic-timeout", config.podVolumeOperationTimeout, "how long backups/restores of pod volumes should be allowed to run before timing out")
 	command.Flags().BoolVar(&config.restoreOnly, "restore-only", config.restoreOnly, "run in a mode where only restores are allowed; backups, schedules, and garbage-collection are all disabled. DEPRECATED: this flag will be removed in v2.0. Use read-only backup storage locations instead.")
+	command.Flags().BoolVar(&config.protobufBackups, "protobuf-backups", config.protobufBackups, "list and get the items of Kubernetes' built-in resources with protobuf rather than JSON during backups, which is cheaper for the API server. Fields that Velero's Kubernetes types don't define, e.g. ones added in newer Kubernetes versions, are dropped from backed up items, so only enable it for clusters whose version matches them")
 	command.Flags().StringSliceVar(&config.disabledControllers, "disable-controllers", config.disabledControllers, fmt.Sprintf("list of controllers to disable on startup. Valid values are %s", strings.Join(disableControllerList, ",")))
+	command.Flags().StringSliceVar(&config.protectedNamespaces, "protected-namespaces", config.protectedNamespaces, "list of namespaces that are never backed up or restored into. Backups and restores that include them by name fail validation. Defaults to kube-system and the server's namespace")
 	command.Flags().StringSliceVar(&config.restoreResourcePriorities, "restore-resource-priorities", config.restoreResourcePriorities, "desired order of resource restores; any resource not in the list will be restored alphabetically after the prioritized resources")
 	command.Flags().StringVar(&config.defaultBackupLocation, "default-backup-storage-location", config.defaultBackupLocation, "name of the default backup storage location")
 	command.Flags().Var(&volumeSnapshotLocations, "default-volume-snapshot-locations", "list of unique volume providers and default volume snapshot location (provider1:location-01,provider2:location-02,...)")
@@ -205,8 +328,34 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().IntVar(&config.clientBurst, "client-burst", config.clientBurst, "maximum number of requests by the server to the Kubernetes API in a short period of time")
 	command.Flags().StringVar(&config.profilerAddress, "profiler-address", config.profilerAddress, "the address to expose the pprof profiler")
 	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "how long to wait on persistent volumes and namespaces to terminate during a restore before timing out")
+	command.Flags().DurationVar(&config.crdEstablishedTimeout, "crd-established-timeout", config.crdEstablishedTimeout, "how long to wait on restored custom resource definitions to be established before restoring their custom resources")
+	command.Flags().DurationVar(&config.pvcBindingTimeout, "pvc-binding-timeout", config.pvcBindingTimeout, "how long restores with --wait-for-unbound-pvcs wait on persistent volume claims that were restored unbound to be bound before restoring the pods that use them")
+	command.Flags().IntVar(&config.resticMaxConcurrentBackupsPerNode, "restic-max-concurrent-backups-per-node", config.resticMaxConcurrentBackupsPerNode, "the maximum number of pod volume backups that run at once on each node. 0 means no limit")
+	command.Flags().IntVar(&config.storageLocationWriteQuorum, "storage-location-write-quorum", config.storageLocationWriteQuorum, "the number of storage locations, counting a backup's storage location and its additional storage locations, that a backup must be uploaded to before it's marked Completed rather than Failed")
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "how long to wait by default before backups can be garbage collected")
+	command.Flags().StringArrayVar(&podVolumeBackupSelectors, "default-pod-volume-backup-selector", podVolumeBackupSelectors, "label selector matching pods whose volumes are backed up with restic by backups that don't specify their own pod volume backup selectors. May be specified multiple times; pods matching any selector are selected")
+	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "back up all pod volumes with restic by default in backups that don't specify otherwise, except volumes listed in pods' backup.velero.io/backup-volumes-excludes annotations")
+	command.Flags().IntVar(&config.defaultBackupMaxItems, "default-backup-max-items", config.defaultBackupMaxItems, "the maximum number of items in backups that don't specify their own maximum. Backups that would exceed it are aborted and fail. 0 means no limit")
+	command.Flags().Var(&backupMaxSize, "default-backup-max-size", "the maximum size of the compressed tarballs of backups that don't specify their own maximum, e.g. 10Gi. Backups whose tarballs grow past it are aborted and fail. If unset, there's no limit")
+	command.Flags().DurationVar(&config.deleteBackupApprovalTTL, "delete-backup-approval-ttl", config.deleteBackupApprovalTTL, "how long deletions of protected backups wait to be approved before failing, and how long DeleteBackupApprovals are valid for")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "how often 'restic prune' is run for restic repositories by default")
+	command.Flags().Var(&controllerResyncPeriods, "controller-resync-periods", fmt.Sprintf("how often controllers periodically resync, in the form controller1=period1,controller2=period2,... Valid controllers are %s", strings.Join(disableControllerList, ",")))
+	command.Flags().DurationVar(&config.controllerRateLimiterBaseDelay, "controller-rate-limiter-base-delay", config.controllerRateLimiterBaseDelay, "the initial delay before a controller retries an item that failed to process; the delay doubles after each failure")
+	command.Flags().DurationVar(&config.controllerRateLimiterMaxDelay, "controller-rate-limiter-max-delay", config.controllerRateLimiterMaxDelay, "the maximum delay before a controller retries an item that failed to process")
+	command.Flags().Float32Var(&config.controllerRateLimiterQPS, "controller-rate-limiter-qps", config.controllerRateLimiterQPS, "the overall number of retries per second of failed items per controller once the burst limit has been reached")
+	command.Flags().IntVar(&config.controllerRateLimiterBurst, "controller-rate-limiter-burst", config.controllerRateLimiterBurst, "the maximum number of retries of failed items per controller in a short period of time")
+	command.Flags().StringVar(&config.tracingEndpoint, "tracing-otlp-endpoint", config.tracingEndpoint, "the OTLP/HTTP endpoint of an OpenTelemetry collector to export trace spans of backups and restores to, e.g. http://otel-collector:4318. If empty, traces aren't exported")
+	command.Flags().StringVar(&config.clusterName, "cluster-name", config.clusterName, "the name of the cluster the server runs in, which is added to backups as a label so that restores into other clusters can tell where they came from. Must be a valid label value")
+	command.Flags().DurationVar(&config.pluginOperationTimeout, "plugin-operation-timeout", config.pluginOperationTimeout, "how long backups wait for operations started by backup item action plugins to finish before canceling them. 0 means no timeout")
+	command.Flags().StringVar(&config.downloadProxyURL, "download-proxy-url", config.downloadProxyURL, "the external URL of the server's download proxy, e.g. https://velero.example.com. If set, the CLI downloads logs and backup contents through the proxy with short-lived tokens instead of from the object store's signed URLs, for object stores that the CLI can't reach")
+	command.Flags().StringVar(&config.downloadProxyAddress, "download-proxy-address", config.downloadProxyAddress, "the address to serve downloads through the download proxy at, if --download-proxy-url is set")
+	command.Flags().StringVar(&config.webhookCertDir, "webhook-cert-dir", config.webhookCertDir, "the directory with the tls.crt and tls.key files of the server's validation webhook. If set, the server validates Backups, Restores, Schedules and BackupStorageLocations when they're created, if it's registered with a ValidatingWebhookConfiguration")
+	command.Flags().StringVar(&config.webhookAddress, "webhook-address", config.webhookAddress, "the address to serve the validation webhook at, if --webhook-cert-dir is set")
+	command.Flags().BoolVar(&config.leaderElect, "leader-elect", config.leaderElect, "elect a leader among the server's replicas with a Lease in the server's namespace, and only run controllers on the leader. Use this to run more than one replica of the server")
+	command.Flags().DurationVar(&config.leaderElectionLeaseDuration, "leader-elect-lease-duration", config.leaderElectionLeaseDuration, "how long replicas wait after the leader last renewed its lease before they take it over, if --leader-elect is set")
+	command.Flags().DurationVar(&config.leaderElectionRenewDeadline, "leader-elect-renew-deadline", config.leaderElectionRenewDeadline, "how long the leader keeps trying to renew its lease before it exits, if --leader-elect is set. Must be less than the lease duration")
+	command.Flags().DurationVar(&config.leaderElectionRetryPeriod, "leader-elect-retry-period", config.leaderElectionRetryPeriod, "how long replicas wait between tries to acquire or renew the lease, if --leader-elect is set. Must be less than the renew deadline divided by 1.2, since retries are jittered by up to 20%")
+	command.Flags().BoolVar(&config.dryRun, "dry-run", config.dryRun, "run all controllers without persisting anything: changes to Kubernetes objects are sent as server-side dry-run requests, and object storage writes, volume snapshots, hooks, and restic backups and restores are logged but not performed")
 
 	return command
 }
@@ -227,9 +376,13 @@ type server struct {
 	logLevel              logrus.Level
 	pluginRegistry        clientmgmt.Registry
 	pluginManager         clientmgmt.Manager
+	credentialFileStore   credentials.FileStore
 	resticManager         restic.RepositoryManager
 	metrics               *metrics.ServerMetrics
 	config                serverConfig
+	clusterIdentity       kubeutil.ClusterIdentity
+	downloadProxy         *downloadproxy.Signer
+	identitySigner        *identity.Signer
 }
 
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
@@ -241,38 +394,99 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 	if config.clientBurst <= 0 {
 		return nil, errors.New("client-burst must be positive")
 	}
+
+	if config.controllerRateLimiterQPS <= 0.0 {
+		return nil, errors.New("controller-rate-limiter-qps must be positive")
+	}
+
+	if config.controllerRateLimiterBurst <= 0 {
+		return nil, errors.New("controller-rate-limiter-burst must be positive")
+	}
+
+	if config.storageLocationWriteQuorum <= 0 {
+		return nil, errors.New("storage-location-write-quorum must be positive")
+	}
+
+	if config.resticMaxConcurrentBackupsPerNode < 0 {
+		return nil, errors.New("restic-max-concurrent-backups-per-node must be non-negative")
+	}
+
+	if config.deleteBackupApprovalTTL <= 0 {
+		return nil, errors.New("delete-backup-approval-ttl must be positive")
+	}
 	f.SetClientBurst(config.clientBurst)
 
-	kubeClient, err := f.KubeClient()
-	if err != nil {
-		return nil, err
+	if errs := validation.IsValidLabelValue(config.clusterName); len(errs) > 0 {
+		return nil, errors.Errorf("invalid cluster-name %q: %s", config.clusterName, strings.Join(errs, "; "))
 	}
 
-	veleroClient, err := f.Client()
+	clientConfig, err := f.ClientConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	dynamicClient, err := f.DynamicClient()
+	if config.dryRun {
+		logger.Warn("Running in dry-run mode; no changes will be persisted")
+		clientConfig = client.DryRunConfig(clientConfig, logger)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(clientConfig)
 	if err != nil {
-		return nil, err
+		return nil, errors.WithStack(err)
 	}
 
-	pluginRegistry := clientmgmt.NewRegistry(config.pluginDir, logger, logger.Level)
-	if err := pluginRegistry.DiscoverPlugins(); err != nil {
-		return nil, err
+	veleroClient, err := clientset.NewForConfig(clientConfig)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	pluginManager := clientmgmt.NewManager(logger, logger.Level, pluginRegistry)
+
+	clusterIdentity, err := kubeutil.GetClusterIdentity(config.clusterName, kubeClient.CoreV1())
 	if err != nil {
-		return nil, err
+		logger.WithError(err).Warn("Unable to get the cluster's UID, backups won't be labeled with it")
 	}
 
-	ctx, cancelFunc := context.WithCancel(context.Background())
+	var downloadProxy *downloadproxy.Signer
+	if config.downloadProxyURL != "" {
+		key, err := downloadproxy.SigningKey(kubeClient.CoreV1().Secrets(f.Namespace()))
+		if err != nil {
+			return nil, err
+		}
+		if downloadProxy, err = downloadproxy.NewSigner(config.downloadProxyURL, key); err != nil {
+			return nil, err
+		}
+	}
 
-	clientConfig, err := f.ClientConfig()
+	// the webhook signs the requesters and approvers of backup deletions
+	// that it verifies, and the deletion controller refuses to delete
+	// protected backups without them, so the key is only needed with it.
+	var identitySigner *identity.Signer
+	if config.webhookCertDir != "" {
+		key, err := identity.SigningKey(kubeClient.CoreV1().Secrets(f.Namespace()))
+		if err != nil {
+			return nil, err
+		}
+		if identitySigner, err = identity.NewSigner(key); err != nil {
+			return nil, err
+		}
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(clientConfig)
 	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	pluginRegistry := clientmgmt.NewRegistry(config.pluginDir, logger, logger.Level)
+	if err := pluginRegistry.DiscoverPlugins(); err != nil {
 		return nil, err
 	}
+	credentialFileStore := credentials.NewNamespacedFileStore(kubeClient.CoreV1(), f.Namespace(), credentialsDirectory, filesystem.NewFileSystem())
+
+	pluginManager := clientmgmt.NewManager(logger, logger.Level, pluginRegistry, credentialFileStore)
+	if config.dryRun {
+		pluginManager = clientmgmt.NewDryRunManager(pluginManager, logger)
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	s := &server{
 		namespace:             f.Namespace(),
@@ -289,7 +503,12 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		logLevel:              logger.Level,
 		pluginRegistry:        pluginRegistry,
 		pluginManager:         pluginManager,
+		credentialFileStore:   credentialFileStore,
+		metrics:               metrics.NewServerMetrics(),
 		config:                config,
+		clusterIdentity:       clusterIdentity,
+		downloadProxy:         downloadProxy,
+		identitySigner:        identitySigner,
 	}
 
 	return s, nil
@@ -304,6 +523,11 @@ func (s *server) run() error {
 		go s.runProfiler()
 	}
 
+	if s.config.tracingEndpoint != "" {
+		s.logger.Infof("Exporting trace spans to %s", s.config.tracingEndpoint)
+		defer tracing.Init(s.config.tracingEndpoint, "velero", s.logger)()
+	}
+
 	// Since s.namespace, which specifies where backups/restores/schedules/etc. should live,
 	// *could* be different from the namespace where the Velero server pod runs, check to make
 	// sure it exists, and fail fast if it doesn't.
@@ -323,20 +547,57 @@ func (s *server) run() error {
 		return err
 	}
 
-	if _, err := s.veleroClient.VeleroV1().BackupStorageLocations(s.namespace).Get(s.config.defaultBackupLocation, metav1.GetOptions{}); err != nil {
-		s.logger.WithError(errors.WithStack(err)).
-			Warnf("A backup storage location named %s has been specified for the server to use by default, but no corresponding backup storage location exists. Backups with a location not matching the default will need to explicitly specify an existing location", s.config.defaultBackupLocation)
+	s.checkDefaultBackupStorageLocation()
+
+	// every replica serves these, not only the leader, so that their
+	// services can send requests to any replica.
+	s.runServers()
+
+	run := func(ctx context.Context) error {
+		if s.config.dryRun {
+			s.logger.Info("Dry-run mode - restic backups and restores of pod volumes are disabled")
+		} else if err := s.initRestic(); err != nil {
+			return err
+		}
+
+		return s.runControllers(ctx, s.config.defaultVolumeSnapshotLocations)
 	}
 
-	if err := s.initRestic(); err != nil {
-		return err
+	if !s.config.leaderElect {
+		return run(s.ctx)
 	}
 
-	if err := s.runControllers(s.config.defaultVolumeSnapshotLocations); err != nil {
-		return err
+	if s.config.dryRun {
+		s.logger.Info("Dry-run mode - leader election is disabled")
+		return run(s.ctx)
 	}
 
-	return nil
+	return s.runWithLeaderElection(run)
+}
+
+// runWithLeaderElection waits until this replica of the server is elected
+// leader, then calls run. If the replica loses its lease, it returns an error
+// right away, so that the server exits rather than keep running controllers
+// alongside the new leader.
+func (s *server) runWithLeaderElection(run func(ctx context.Context) error) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	elector, err := leaderelection.NewElector(s.kubeClient.CoordinationV1(), leaderelection.Config{
+		Namespace:     s.namespace,
+		Name:          leaderElectionLeaseName,
+		Identity:      hostname + "_" + uuid.NewV4().String(),
+		LeaseDuration: s.config.leaderElectionLeaseDuration,
+		RenewDeadline: s.config.leaderElectionRenewDeadline,
+		RetryPeriod:   s.config.leaderElectionRetryPeriod,
+	}, s.logger)
+	if err != nil {
+		return errors.Wrap(err, "invalid leader election configuration")
+	}
+
+	return elector.Run(s.ctx, run)
 }
 
 // namespaceExists returns nil if namespace can be successfully
@@ -352,22 +613,63 @@ func (s *server) namespaceExists(namespace string) error {
 	return nil
 }
 
-// initDiscoveryHelper instantiates the server's discovery helper and spawns a
-// goroutine to call Refresh() every 5 minutes.
+// newMemberClusterBackupper returns a backupper for the member cluster that
+// config connects to. Member clusters don't run velero's restic daemonset, so
+// pod volumes can't be backed up with restic.
+func (s *server) newMemberClusterBackupper(config *rest.Config) (backup.Backupper, error) {
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	discoveryHelper, err := velerodiscovery.NewHelper(kubeClient.Discovery(), s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return backup.NewKubernetesBackupper(
+		discoveryHelper,
+		s.newBackupDynamicFactory(dynamicClient, config),
+		podexec.NewPodCommandExecutor(config, kubeClient.CoreV1().RESTClient()),
+		nil,
+		s.config.podVolumeOperationTimeout,
+		backup.NewDiagnosticsGetter(kubeClient.CoreV1()),
+	)
+}
+
+// newBackupDynamicFactory returns the DynamicFactory that backups list and
+// get items with, which lists them in pages, and uses protobuf for built-in
+// resources if --protobuf-backups is set.
+func (s *server) newBackupDynamicFactory(dynamicClient dynamic.Interface, config *rest.Config) client.DynamicFactory {
+	if s.config.protobufBackups {
+		return client.NewProtobufDynamicFactory(dynamicClient, config)
+	}
+	return client.NewPagedDynamicFactory(dynamicClient)
+}
+
+// initDiscoveryHelper instantiates the server's discovery helper, which
+// caches the cluster's resources until custom resource definitions or API
+// services change or the resources are older than discoveryMaxAge, and spawns
+// a goroutine to refresh them when they're stale.
 func (s *server) initDiscoveryHelper() error {
-	discoveryHelper, err := velerodiscovery.NewHelper(s.discoveryClient, s.logger)
+	discoveryHelper, err := velerodiscovery.NewCachedHelper(s.discoveryClient, s.logger, discoveryMinRefreshInterval, discoveryMaxAge, s.metrics)
 	if err != nil {
 		return err
 	}
 	s.discoveryHelper = discoveryHelper
 
+	velerodiscovery.InvalidateOnChanges(discoveryHelper, s.dynamicClient, s.ctx.Done())
+
 	go wait.Until(
 		func() {
-			if err := discoveryHelper.Refresh(); err != nil {
+			if err := discoveryHelper.RefreshIfStale(); err != nil {
 				s.logger.WithError(err).Error("Error refreshing discovery")
 			}
 		},
-		5*time.Minute,
+		discoveryMinRefreshInterval,
 		s.ctx.Done(),
 	)
 
@@ -445,30 +747,32 @@ func (s *server) validateBackupStorageLocations() error {
 	return nil
 }
 
-// - Namespaces go first because all namespaced resources depend on them.
-// - Storage Classes are needed to create PVs and PVCs correctly.
-// - PVs go before PVCs because PVCs depend on them.
-// - PVCs go before pods or controllers so they can be mounted as volumes.
-// - Secrets and config maps go before pods or controllers so they can be mounted
-// 	 as volumes.
-// - Service accounts go before pods or controllers so pods can use them.
-// - Limit ranges go before pods or controllers so pods can use them.
-// - Pods go before controllers so they can be explicitly restored and potentially
-//	 have restic restores run before controllers adopt the pods.
-// - Custom Resource Definitions come before Custom Resource so that they can be
-//   restored with their corresponding CRD.
-var defaultRestorePriorities = []string{
-	"namespaces",
-	"storageclasses",
-	"persistentvolumes",
-	"persistentvolumeclaims",
-	"secrets",
-	"configmaps",
-	"serviceaccounts",
-	"limitranges",
-	"pods",
-	"replicaset",
-	"customresourcedefinitions",
+// checkDefaultBackupStorageLocation warns if no backup storage location is
+// annotated as the default, and the server's default location doesn't exist.
+func (s *server) checkDefaultBackupStorageLocation() {
+	list, err := s.veleroClient.VeleroV1().BackupStorageLocations(s.namespace).List(metav1.ListOptions{})
+	if err != nil {
+		s.logger.WithError(errors.WithStack(err)).Warn("Error listing backup storage locations to check the default location")
+		return
+	}
+
+	var locations []*api.BackupStorageLocation
+	for i := range list.Items {
+		locations = append(locations, &list.Items[i])
+	}
+
+	if name := storage.DefaultBackupLocationName(locations, s.config.defaultBackupLocation); name != s.config.defaultBackupLocation {
+		s.logger.Infof("Backup storage location %s is annotated as the default, so it's used instead of %s for backups without a location", name, s.config.defaultBackupLocation)
+		return
+	}
+
+	for _, location := range locations {
+		if location.Name == s.config.defaultBackupLocation {
+			return
+		}
+	}
+
+	s.logger.Warnf("A backup storage location named %s has been specified for the server to use by default, but no corresponding backup storage location exists. Backups with a location not matching the default will need to explicitly specify an existing location, or a location must be set as the default with velero backup-location set --default", s.config.defaultBackupLocation)
 }
 
 func (s *server) initRestic() error {
@@ -511,6 +815,8 @@ func (s *server) initRestic() error {
 		s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
 		s.kubeClient.CoreV1(),
 		s.kubeClient.CoreV1(),
+		s.kubeClient.CoreV1(),
+		s.config.resticMaxConcurrentBackupsPerNode,
 		s.logger,
 	)
 	if err != nil {
@@ -521,28 +827,10 @@ func (s *server) initRestic() error {
 	return nil
 }
 
-func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string) error {
+func (s *server) runControllers(ctx context.Context, defaultVolumeSnapshotLocations map[string]string) error {
 	s.logger.Info("Starting controllers")
 
-	ctx := s.ctx
-	var wg sync.WaitGroup
-
-	go func() {
-		metricsMux := http.NewServeMux()
-		metricsMux.Handle("/metrics", promhttp.Handler())
-		s.logger.Infof("Starting metric server at address [%s]", s.metricsAddress)
-		if err := http.ListenAndServe(s.metricsAddress, metricsMux); err != nil {
-			s.logger.Fatalf("Failed to start metric server at [%s]: %v", s.metricsAddress, err)
-		}
-	}()
-	s.metrics = metrics.NewServerMetrics()
-	s.metrics.RegisterAllMetrics()
-	// Initialize manual backup metrics
-	s.metrics.InitSchedule("")
-
-	newPluginManager := func(logger logrus.FieldLogger) clientmgmt.Manager {
-		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
-	}
+	newPluginManager := s.newPluginManager
 
 	backupSyncControllerRunInfo := func() controllerRunInfo {
 		backupSyncContoller := controller.NewBackupSyncController(
@@ -556,6 +844,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.namespace,
 			s.config.defaultBackupLocation,
 			newPluginManager,
+			s.metrics,
 			s.logger,
 		)
 
@@ -568,12 +857,18 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	backupTracker := controller.NewBackupTracker()
 
 	backupControllerRunInfo := func() controllerRunInfo {
+		podCommandExecutor := podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient())
+		if s.config.dryRun {
+			podCommandExecutor = podexec.NewDryRunPodCommandExecutor()
+		}
+
 		backupper, err := backup.NewKubernetesBackupper(
 			s.discoveryHelper,
-			client.NewDynamicFactory(s.dynamicClient),
-			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
+			s.newBackupDynamicFactory(s.dynamicClient, s.kubeClientConfig),
+			podCommandExecutor,
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
+			backup.NewDiagnosticsGetter(s.kubeClient.CoreV1()),
 		)
 		cmd.CheckError(err)
 
@@ -588,10 +883,20 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
 			s.config.defaultBackupLocation,
 			s.config.defaultBackupTTL,
+			s.config.defaultPodVolumeBackupSelectors,
+			s.config.defaultVolumesToRestic,
+			s.config.defaultBackupMaxItems,
+			s.config.defaultBackupMaxBytes,
+			s.config.protectedNamespaces,
+			s.config.storageLocationWriteQuorum,
+			s.sharedInformerFactory.Velero().V1().BackupQuotas(),
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations(),
 			defaultVolumeSnapshotLocations,
 			s.metrics,
 			s.config.formatFlag.Parse(),
+			s.clusterIdentity,
+			membercluster.NewRegistry(s.kubeClient.CoreV1(), s.namespace),
+			s.newMemberClusterBackupper,
 		)
 
 		return controllerRunInfo{
@@ -608,6 +913,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().Schedules(),
 			s.logger,
 			s.metrics,
+			membercluster.NewRegistry(s.kubeClient.CoreV1(), s.namespace),
 		)
 
 		return controllerRunInfo{
@@ -620,9 +926,13 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		gcController := controller.NewGCController(
 			s.logger,
 			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.veleroClient.VeleroV1(),
 			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests(),
 			s.veleroClient.VeleroV1(),
 			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			s.sharedInformerFactory.Velero().V1().Restores(),
+			s.sharedInformerFactory.Velero().V1().Schedules(),
+			newPluginManager,
 		)
 
 		return controllerRunInfo{
@@ -631,12 +941,69 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	retentionControllerRunInfo := func() controllerRunInfo {
+		retentionController := controller.NewRetentionController(
+			s.logger,
+			s.sharedInformerFactory.Velero().V1().Schedules(),
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests(),
+			s.veleroClient.VeleroV1(),
+		)
+
+		return controllerRunInfo{
+			controller: retentionController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
+	backupOperationsControllerRunInfo := func() controllerRunInfo {
+		backupOperationsController := controller.NewBackupOperationsController(
+			s.logger,
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			newPluginManager,
+			s.config.pluginOperationTimeout,
+			s.metrics,
+		)
+
+		return controllerRunInfo{
+			controller: backupOperationsController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
+	backupVerificationControllerRunInfo := func() controllerRunInfo {
+		backupVerificationController := controller.NewBackupVerificationController(
+			s.logger,
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().Restores(),
+			s.veleroClient.VeleroV1(),
+			s.kubeClient.CoreV1().Namespaces(),
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			newPluginManager,
+			s.metrics,
+		)
+
+		return controllerRunInfo{
+			controller: backupVerificationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	deletionControllerRunInfo := func() controllerRunInfo {
 		deletionController := controller.NewBackupDeletionController(
 			s.logger,
 			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests(),
 			s.veleroClient.VeleroV1(), // deleteBackupRequestClient
+			s.sharedInformerFactory.Velero().V1().DeleteBackupApprovals(),
+			s.veleroClient.VeleroV1(), // approvalClient
+			s.config.deleteBackupApprovalTTL,
+			s.identitySigner,
 			s.veleroClient.VeleroV1(), // backupClient
+			s.kubeClient.CoreV1(),     // eventClient
 			s.sharedInformerFactory.Velero().V1().Restores(),
 			s.veleroClient.VeleroV1(), // restoreClient
 			backupTracker,
@@ -644,6 +1011,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().PodVolumeBackups(),
 			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations(),
+			client.NewDynamicFactory(s.dynamicClient),
+			s.discoveryHelper,
 			newPluginManager,
 			s.metrics,
 		)
@@ -664,6 +1033,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
 			s.config.resourceTerminatingTimeout,
+			s.config.crdEstablishedTimeout,
+			s.config.pvcBindingTimeout,
 			s.logger,
 		)
 		cmd.CheckError(err)
@@ -681,8 +1052,11 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logLevel,
 			newPluginManager,
 			s.config.defaultBackupLocation,
+			s.config.protectedNamespaces,
 			s.metrics,
 			s.config.formatFlag.Parse(),
+			s.clusterIdentity,
+			s.discoveryHelper,
 		)
 
 		return controllerRunInfo{
@@ -715,6 +1089,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
 			s.sharedInformerFactory.Velero().V1().Backups(),
 			newPluginManager,
+			s.downloadProxy,
 			s.logger,
 		)
 
@@ -738,28 +1113,55 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	backupStorageLocationControllerRunInfo := func() controllerRunInfo {
+		backupStorageLocationController := controller.NewBackupStorageLocationController(
+			s.namespace,
+			s.config.storeValidationFrequency,
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			s.veleroClient.VeleroV1(),
+			newPluginManager,
+			s.logger,
+		)
+
+		return controllerRunInfo{
+			controller: backupStorageLocationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	enabledControllers := map[string]func() controllerRunInfo{
-		BackupSyncControllerKey:          backupSyncControllerRunInfo,
-		BackupControllerKey:              backupControllerRunInfo,
-		ScheduleControllerKey:            scheduleControllerRunInfo,
-		GcControllerKey:                  gcControllerRunInfo,
-		BackupDeletionControllerKey:      deletionControllerRunInfo,
-		RestoreControllerKey:             restoreControllerRunInfo,
-		ResticRepoControllerKey:          resticRepoControllerRunInfo,
-		DownloadRequestControllerKey:     downloadrequestControllerRunInfo,
-		ServerStatusRequestControllerKey: serverStatusRequestControllerRunInfo,
+		BackupSyncControllerKey:            backupSyncControllerRunInfo,
+		BackupControllerKey:                backupControllerRunInfo,
+		BackupOperationsControllerKey:      backupOperationsControllerRunInfo,
+		BackupVerificationControllerKey:    backupVerificationControllerRunInfo,
+		ScheduleControllerKey:              scheduleControllerRunInfo,
+		GcControllerKey:                    gcControllerRunInfo,
+		RetentionControllerKey:             retentionControllerRunInfo,
+		BackupDeletionControllerKey:        deletionControllerRunInfo,
+		RestoreControllerKey:               restoreControllerRunInfo,
+		ResticRepoControllerKey:            resticRepoControllerRunInfo,
+		DownloadRequestControllerKey:       downloadrequestControllerRunInfo,
+		ServerStatusRequestControllerKey:   serverStatusRequestControllerRunInfo,
+		BackupStorageLocationControllerKey: backupStorageLocationControllerRunInfo,
 	}
 
 	if s.config.restoreOnly {
-		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
+		s.logger.Info("Restore only mode - not starting the backup, backup operations, schedule, delete-backup, GC, or retention controllers")
 		s.config.disabledControllers = append(s.config.disabledControllers,
 			BackupControllerKey,
+			BackupOperationsControllerKey,
 			ScheduleControllerKey,
 			GcControllerKey,
+			RetentionControllerKey,
 			BackupDeletionControllerKey,
 		)
 	}
 
+	if s.config.dryRun && !sets.NewString(s.config.disabledControllers...).Has(ResticRepoControllerKey) {
+		s.logger.Info("Dry-run mode - not starting the restic repository controller")
+		s.config.disabledControllers = append(s.config.disabledControllers, ResticRepoControllerKey)
+	}
+
 	// remove disabled controllers
 	for _, controllerName := range s.config.disabledControllers {
 		if _, ok := enabledControllers[controllerName]; ok {
@@ -770,13 +1172,26 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
-	for _, newController := range enabledControllers {
+	mgr, err := controller.NewManager(s.kubeClientConfig)
+	if err != nil {
+		return err
+	}
+
+	rateLimiter := s.controllerRateLimiter()
+
+	for controllerName, newController := range enabledControllers {
 		controllerRunInfo := newController()
-		wg.Add(1)
-		go func() {
-			controllerRunInfo.controller.Run(ctx, controllerRunInfo.numWorkers)
-			wg.Done()
-		}()
+
+		if configurable, ok := controllerRunInfo.controller.(controller.Configurable); ok {
+			configurable.Configure(controller.Options{
+				ResyncPeriod: s.config.controllerResyncPeriods[controllerName],
+				RateLimiter:  rateLimiter(),
+			})
+		}
+
+		if err := controllerRunInfo.controller.SetupWithManager(mgr, controllerRunInfo.numWorkers); err != nil {
+			return errors.Wrapf(err, "error setting up controller %s", controllerName)
+		}
 	}
 
 	// SHARED INFORMERS HAVE TO BE STARTED AFTER ALL CONTROLLERS
@@ -784,14 +1199,148 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 
 	s.logger.Info("Server started successfully")
 
-	<-ctx.Done()
+	if err := mgr.Start(ctx.Done()); err != nil {
+		return errors.Wrap(err, "error running controllers")
+	}
 
-	s.logger.Info("Waiting for all controllers to shut down gracefully")
-	wg.Wait()
+	s.logger.Info("All controllers have shut down")
 
 	return nil
 }
 
+// controllerRateLimiter returns a function that returns a new work queue rate limiter,
+// configured using the server's flags, for each controller.
+func (s *server) controllerRateLimiter() func() workqueue.RateLimiter {
+	return func() workqueue.RateLimiter {
+		return workqueue.NewMaxOfRateLimiter(
+			workqueue.NewItemExponentialFailureRateLimiter(s.config.controllerRateLimiterBaseDelay, s.config.controllerRateLimiterMaxDelay),
+			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(s.config.controllerRateLimiterQPS), s.config.controllerRateLimiterBurst)},
+		)
+	}
+}
+
+// parseControllerResyncPeriods parses the value of the --controller-resync-periods flag
+// into a map of controller name to resync period.
+func parseControllerResyncPeriods(data map[string]string) (map[string]time.Duration, error) {
+	periods := make(map[string]time.Duration)
+
+	for name, val := range data {
+		valid := false
+		for _, controllerName := range disableControllerList {
+			if name == controllerName {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, errors.Errorf("invalid controller %q in --controller-resync-periods. Valid controllers are %s", name, strings.Join(disableControllerList, ","))
+		}
+
+		period, err := time.ParseDuration(val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid resync period for controller %q", name)
+		}
+		if period <= 0 {
+			return nil, errors.Errorf("resync period for controller %q must be positive", name)
+		}
+
+		periods[name] = period
+	}
+
+	return periods, nil
+}
+
+func parsePodVolumeBackupSelectors(selectors []string) ([]metav1.LabelSelector, error) {
+	var parsed []metav1.LabelSelector
+
+	for _, selector := range selectors {
+		labelSelector, err := metav1.ParseToLabelSelector(selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid --default-pod-volume-backup-selector %q", selector)
+		}
+		parsed = append(parsed, *labelSelector)
+	}
+
+	return parsed, nil
+}
+
+// newPluginManager returns a plugin manager for the server's controllers,
+// which doesn't persist changes in dry-run mode.
+func (s *server) newPluginManager(logger logrus.FieldLogger) clientmgmt.Manager {
+	manager := clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry, s.credentialFileStore)
+	if s.config.dryRun {
+		manager = clientmgmt.NewDryRunManager(manager, logger)
+	}
+	return manager
+}
+
+// runServers starts the server's metrics endpoint, its download proxy and
+// validation webhook if they're enabled, and the informers they read. The
+// controllers, which only run on the leader, record the metrics, so the
+// metrics of the other replicas are empty.
+func (s *server) runServers() {
+	s.metrics.RegisterAllMetrics()
+	// Initialize manual backup metrics
+	s.metrics.InitSchedule("")
+
+	go func() {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		s.logger.Infof("Starting metric server at address [%s]", s.metricsAddress)
+		if err := http.ListenAndServe(s.metricsAddress, metricsMux); err != nil {
+			s.logger.Fatalf("Failed to start metric server at [%s]: %v", s.metricsAddress, err)
+		}
+	}()
+
+	if s.downloadProxy != nil {
+		go s.runDownloadProxy(controller.NewDownloadGetter(
+			s.sharedInformerFactory.Velero().V1().DownloadRequests(),
+			s.sharedInformerFactory.Velero().V1().Restores(),
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.newPluginManager,
+			s.logger,
+		))
+	}
+
+	if s.config.webhookCertDir != "" {
+		go s.runWebhook(webhook.NewHandler(
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations().Lister(),
+			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
+			s.identitySigner,
+			s.logger,
+		))
+	}
+
+	// the informers that the controllers use are started once they're
+	// created, on the leader.
+	s.sharedInformerFactory.Start(s.ctx.Done())
+}
+
+// runDownloadProxy serves the objects of download requests through the
+// server's download proxy.
+func (s *server) runDownloadProxy(getter downloadproxy.DownloadGetter) {
+	mux := http.NewServeMux()
+	mux.Handle("/", downloadproxy.NewHandler(s.downloadProxy, getter, s.logger))
+
+	s.logger.Infof("Starting download proxy at address [%s] for URL [%s]", s.config.downloadProxyAddress, s.config.downloadProxyURL)
+	if err := http.ListenAndServe(s.config.downloadProxyAddress, mux); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("error running download proxy http server")
+	}
+}
+
+// runWebhook serves the validation webhook over TLS, with the certificate
+// in the webhook certificate directory.
+func (s *server) runWebhook(handler http.Handler) {
+	certFile := filepath.Join(s.config.webhookCertDir, "tls.crt")
+	keyFile := filepath.Join(s.config.webhookCertDir, "tls.key")
+
+	s.logger.Infof("Starting validation webhook at address [%s]", s.config.webhookAddress)
+	if err := http.ListenAndServeTLS(s.config.webhookAddress, certFile, keyFile, handler); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("error running validation webhook https server")
+	}
+}
+
 func (s *server) runProfiler() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
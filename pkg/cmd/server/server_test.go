@@ -18,6 +18,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,3 +70,67 @@ func TestVeleroResourcesExist(t *testing.T) {
 	veleroAPIResourceList.APIResources = veleroAPIResourceList.APIResources[:3]
 	assert.Error(t, server.veleroResourcesExist())
 }
+
+func TestParseControllerResyncPeriods(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    map[string]time.Duration
+		wantErr bool
+	}{
+		{
+			name: "no periods",
+			data: nil,
+			want: map[string]time.Duration{},
+		},
+		{
+			name: "valid periods are parsed",
+			data: map[string]string{BackupControllerKey: "30s", ScheduleControllerKey: "2m"},
+			want: map[string]time.Duration{BackupControllerKey: 30 * time.Second, ScheduleControllerKey: 2 * time.Minute},
+		},
+		{
+			name:    "unknown controller is an error",
+			data:    map[string]string{"foo": "30s"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid duration is an error",
+			data:    map[string]string{BackupControllerKey: "soon"},
+			wantErr: true,
+		},
+		{
+			name:    "non-positive duration is an error",
+			data:    map[string]string{BackupControllerKey: "0s"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseControllerResyncPeriods(tc.data)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestParsePodVolumeBackupSelectors(t *testing.T) {
+	res, err := parsePodVolumeBackupSelectors(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	res, err = parsePodVolumeBackupSelectors([]string{"app=db", "tier in (storage)"})
+	assert.NoError(t, err)
+	if assert.Len(t, res, 2) {
+		assert.Equal(t, "app=db", metav1.FormatLabelSelector(&res[0]))
+		assert.Equal(t, "tier in (storage)", metav1.FormatLabelSelector(&res[1]))
+	}
+
+	_, err = parsePodVolumeBackupSelectors([]string{"app in ("})
+	assert.Error(t, err)
+}
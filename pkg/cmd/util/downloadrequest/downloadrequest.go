@@ -40,16 +40,24 @@ import (
 var ErrNotFound = errors.New("file not found")
 
 func Stream(client velerov1client.DownloadRequestsGetter, namespace, name string, kind v1.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool) error {
+	return StreamTarget(client, namespace, v1.DownloadTarget{Kind: kind, Name: name}, w, timeout, insecureSkipTLSVerify)
+}
+
+// StreamTarget is like Stream, but downloads a target that may also have a
+// namespace, such as a namespace's sub-archive of a backup.
+func StreamTarget(client velerov1client.DownloadRequestsGetter, namespace string, target v1.DownloadTarget, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool) error {
+	name := target.Name
+	if target.Namespace != "" {
+		name = fmt.Sprintf("%s-%s", name, target.Namespace)
+	}
+
 	req := &v1.DownloadRequest{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      fmt.Sprintf("%s-%s", name, time.Now().Format("20060102150405")),
 		},
 		Spec: v1.DownloadRequestSpec{
-			Target: v1.DownloadTarget{
-				Kind: kind,
-				Name: name,
-			},
+			Target: target,
 		},
 	}
 
@@ -149,7 +157,7 @@ Loop:
 	}
 
 	reader := resp.Body
-	if kind != v1.DownloadTargetKindBackupContents {
+	if target.Kind != v1.DownloadTargetKindBackupContents && target.Kind != v1.DownloadTargetKindBackupNamespaceContents {
 		// need to decompress logs
 		gzipReader, err := gzip.NewReader(resp.Body)
 		if err != nil {
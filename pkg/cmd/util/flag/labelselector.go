@@ -17,6 +17,8 @@ limitations under the License.
 package flag
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -49,3 +51,38 @@ func (ls *LabelSelector) Set(s string) error {
 func (ls *LabelSelector) Type() string {
 	return "labelSelector"
 }
+
+// LabelSelectorArray is a Cobra-compatible wrapper for defining a
+// Kubernetes label-selector flag that can be specified multiple
+// times.
+type LabelSelectorArray struct {
+	LabelSelectors []metav1.LabelSelector
+}
+
+// String returns a semicolon-separated list of the label
+// selectors, since the selectors themselves contain commas.
+func (lsa *LabelSelectorArray) String() string {
+	var selectors []string
+	for i := range lsa.LabelSelectors {
+		selectors = append(selectors, metav1.FormatLabelSelector(&lsa.LabelSelectors[i]))
+	}
+	return strings.Join(selectors, "; ")
+}
+
+// Set parses the provided string and appends the result
+// to the receiver's label selectors. It returns an error if
+// the string is not parseable.
+func (lsa *LabelSelectorArray) Set(s string) error {
+	parsed, err := metav1.ParseToLabelSelector(s)
+	if err != nil {
+		return err
+	}
+	lsa.LabelSelectors = append(lsa.LabelSelectors, *parsed)
+	return nil
+}
+
+// Type returns a string representation of the
+// LabelSelectorArray type.
+func (lsa *LabelSelectorArray) Type() string {
+	return "labelSelector"
+}
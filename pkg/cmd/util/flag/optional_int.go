@@ -0,0 +1,61 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flag
+
+import "strconv"
+
+// OptionalInt is an int flag that may be unset.
+type OptionalInt struct {
+	Value *int
+}
+
+func NewOptionalInt(defaultValue *int) OptionalInt {
+	return OptionalInt{
+		Value: defaultValue,
+	}
+}
+
+// String returns a string representation of the
+// optional int flag.
+func (f *OptionalInt) String() string {
+	switch f.Value {
+	case nil:
+		return "<nil>"
+	default:
+		return strconv.Itoa(*f.Value)
+	}
+}
+
+func (f *OptionalInt) Set(val string) error {
+	if val == "" {
+		f.Value = nil
+		return nil
+	}
+
+	parsed, err := strconv.Atoi(val)
+	if err != nil {
+		return err
+	}
+
+	f.Value = &parsed
+
+	return nil
+}
+
+func (f *OptionalInt) Type() string {
+	return "optionalInt"
+}
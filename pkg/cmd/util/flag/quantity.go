@@ -0,0 +1,72 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flag
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Quantity is a flag for a resource quantity, such as a size in bytes
+// like "10Gi", that may be unset.
+type Quantity struct {
+	Value *resource.Quantity
+}
+
+// NewQuantity returns an unset Quantity flag.
+func NewQuantity() Quantity {
+	return Quantity{}
+}
+
+// String returns a string representation of the
+// quantity flag.
+func (q *Quantity) String() string {
+	if q.Value == nil {
+		return ""
+	}
+	return q.Value.String()
+}
+
+// Set parses a quantity, unsetting the flag if it's empty.
+func (q *Quantity) Set(val string) error {
+	if val == "" {
+		q.Value = nil
+		return nil
+	}
+
+	parsed, err := resource.ParseQuantity(val)
+	if err != nil {
+		return err
+	}
+	q.Value = &parsed
+
+	return nil
+}
+
+// Type returns a string representation of the
+// Quantity type.
+func (q *Quantity) Type() string {
+	return "quantity"
+}
+
+// Bytes returns the quantity as a number of bytes, or nil if it's unset.
+func (q *Quantity) Bytes() *int64 {
+	if q.Value == nil {
+		return nil
+	}
+	bytes := q.Value.Value()
+	return &bytes
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flag
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeLayouts are the layouts that Time flags are parsed with, in the
+// order they're tried in. Times without a time zone are in UTC.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Time is a flag for a point in time, such as "2020-05-01T00:00Z", that may
+// be unset.
+type Time struct {
+	Value *time.Time
+}
+
+// NewTime returns an unset Time flag.
+func NewTime() Time {
+	return Time{}
+}
+
+// String returns a string representation of the
+// time flag.
+func (t *Time) String() string {
+	if t.Value == nil {
+		return ""
+	}
+	return t.Value.Format(time.RFC3339)
+}
+
+// Set parses a time, unsetting the flag if it's empty.
+func (t *Time) Set(val string) error {
+	if val == "" {
+		t.Value = nil
+		return nil
+	}
+
+	for _, layout := range timeLayouts {
+		if parsed, err := time.ParseInLocation(layout, val, time.UTC); err == nil {
+			t.Value = &parsed
+			return nil
+		}
+	}
+
+	return errors.Errorf("invalid time %q, times must be in RFC 3339 format, e.g. 2020-05-01T00:00:00Z", val)
+}
+
+// Type returns a string representation of the
+// Time type.
+func (t *Time) Type() string {
+	return "time"
+}
@@ -23,9 +23,11 @@ import (
 	"sort"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -36,6 +38,7 @@ func DescribeBackup(
 	backup *velerov1api.Backup,
 	deleteRequests []velerov1api.DeleteBackupRequest,
 	podVolumeBackups []velerov1api.PodVolumeBackup,
+	restores []velerov1api.Restore,
 	details bool,
 	veleroClient clientset.Interface,
 	insecureSkipTLSVerify bool,
@@ -57,6 +60,10 @@ func DescribeBackup(
 		d.Printf("Phase:\t%s%s\n", phase, logsNote)
 
 		status := backup.Status
+		if status.FailureReason != "" {
+			d.Printf("Failure reason:\t%s\n", status.FailureReason)
+		}
+
 		if len(status.ValidationErrors) > 0 {
 			d.Println()
 			d.Printf("Validation errors:")
@@ -65,6 +72,15 @@ func DescribeBackup(
 			}
 		}
 
+		for _, condition := range status.Conditions {
+			if condition.Type == velerov1api.BackupConditionSpecDrifted && condition.Status == velerov1api.ConditionTrue {
+				d.Printf("Spec drifted:\t%s (since %s)\n", condition.Message, condition.LastTransitionTime.Time)
+			}
+			if condition.Type == velerov1api.BackupConditionOrphaned && condition.Status == velerov1api.ConditionTrue {
+				d.Printf("Orphaned:\t%s (since %s)\n", condition.Message, condition.LastTransitionTime.Time)
+			}
+		}
+
 		if status.Phase == velerov1api.BackupPhasePartiallyFailed {
 			d.Println()
 			d.Printf("Errors:\t%d\n", status.Errors)
@@ -86,6 +102,11 @@ func DescribeBackup(
 			d.Println()
 			DescribePodVolumeBackups(d, podVolumeBackups, details)
 		}
+
+		if len(restores) > 0 {
+			d.Println()
+			DescribeRestoreHistory(d, restores)
+		}
 	})
 }
 
@@ -106,13 +127,24 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 		s = strings.Join(spec.ExcludedNamespaces, ", ")
 	}
 	d.Printf("\tExcluded:\t%s\n", s)
+	if spec.NamespaceSelector != nil {
+		d.Printf("\tSelector:\t%s\n", metav1.FormatLabelSelector(spec.NamespaceSelector))
+	}
 
 	d.Println()
 	d.Printf("Resources:\n")
-	if len(spec.IncludedResources) == 0 {
-		s = "*"
-	} else {
+	var namedResources []string
+	for resource := range spec.IncludedResourceNames {
+		namedResources = append(namedResources, resource)
+	}
+	sort.Strings(namedResources)
+	switch {
+	case len(spec.IncludedResources) > 0:
 		s = strings.Join(spec.IncludedResources, ", ")
+	case len(namedResources) > 0:
+		s = strings.Join(namedResources, ", ")
+	default:
+		s = "*"
 	}
 	d.Printf("\tIncluded:\t%s\n", s)
 	if len(spec.ExcludedResources) == 0 {
@@ -124,6 +156,13 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 	d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(spec.IncludeClusterResources, "excluded", "included", "auto"))
 
+	if len(namedResources) > 0 {
+		d.Printf("\tNamed items:\n")
+		for _, resource := range namedResources {
+			d.Printf("\t\t%s:\t%s\n", resource, strings.Join(spec.IncludedResourceNames[resource], ", "))
+		}
+	}
+
 	d.Println()
 	s = "<none>"
 	if spec.LabelSelector != nil {
@@ -131,14 +170,76 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	}
 	d.Printf("Label selector:\t%s\n", s)
 
+	if spec.Cluster != "" {
+		d.Println()
+		d.Printf("Cluster:\t%s\n", spec.Cluster)
+	}
+
 	d.Println()
 	d.Printf("Storage Location:\t%s\n", spec.StorageLocation)
+	if len(spec.AdditionalStorageLocations) > 0 {
+		d.Printf("Additional Storage Locations:\t%s\n", strings.Join(spec.AdditionalStorageLocations, ", "))
+	}
 
 	d.Println()
 	d.Printf("Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
+	if len(spec.ExcludedSnapshotNamespaces) > 0 {
+		d.Printf("\tExcluded namespaces:\t%s\n", strings.Join(spec.ExcludedSnapshotNamespaces, ", "))
+	}
+	if spec.ExcludedSnapshotLabelSelector != nil {
+		d.Printf("\tExcluded label selector:\t%s\n", metav1.FormatLabelSelector(spec.ExcludedSnapshotLabelSelector))
+	}
+
+	d.Println()
+	d.Printf("Default Volumes to Restic:\t%s\n", BoolPointerString(spec.DefaultVolumesToRestic, "false", "true", "auto"))
+
+	if len(spec.PodVolumeBackupSelectors) > 0 {
+		d.Printf("Pod volume backup selectors:\n")
+		for i := range spec.PodVolumeBackupSelectors {
+			d.Printf("\t%s\n", metav1.FormatLabelSelector(&spec.PodVolumeBackupSelectors[i]))
+		}
+	}
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
+	if spec.LogTTL.Duration > 0 {
+		d.Printf("Log TTL:\t%s\n", spec.LogTTL.Duration)
+	}
+
+	if spec.MaxItems != nil && *spec.MaxItems > 0 {
+		d.Printf("Max items:\t%d\n", *spec.MaxItems)
+	}
+	if spec.MaxBytes != nil && *spec.MaxBytes > 0 {
+		d.Printf("Max size:\t%s\n", resource.NewQuantity(*spec.MaxBytes, resource.BinarySI))
+	}
+
+	if spec.SearchIndex {
+		d.Println()
+		d.Printf("Search index:\tenabled\n")
+	}
+
+	if spec.IncludeEventsAndPodLogs {
+		d.Println()
+		d.Printf("Events and pod logs:\tincluded\n")
+	}
+
+	if len(spec.ReplicaPolicies) > 0 {
+		d.Println()
+		describeReplicaPolicies(d, spec.ReplicaPolicies)
+	}
+
+	if len(spec.OrderedResources) > 0 {
+		d.Println()
+		d.Printf("Ordered Resources:\n")
+		var resources []string
+		for resource := range spec.OrderedResources {
+			resources = append(resources, resource)
+		}
+		sort.Strings(resources)
+		for _, resource := range resources {
+			d.Printf("\t%s:\t%s\n", resource, spec.OrderedResources[resource])
+		}
+	}
 
 	d.Println()
 	if len(spec.Hooks.Resources) == 0 {
@@ -216,6 +317,9 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 	status := backup.Status
 
 	d.Printf("Backup Format Version:\t%d\n", status.Version)
+	if status.VeleroVersion != "" {
+		d.Printf("Velero Version:\t%s\n", status.VeleroVersion)
+	}
 
 	d.Println()
 	// "<n/a>" output should only be applicable for backups that failed validation
@@ -232,11 +336,62 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 
 	d.Println()
 	d.Printf("Expiration:\t%s\n", status.Expiration.Time)
+	if status.LogsExpiration != nil {
+		if status.LogsDeleted {
+			d.Printf("Logs Expiration:\t%s (logs deleted)\n", status.LogsExpiration.Time)
+		} else {
+			d.Printf("Logs Expiration:\t%s\n", status.LogsExpiration.Time)
+		}
+	}
 	d.Println()
 
+	if len(status.AdditionalStorageLocations) > 0 {
+		d.Printf("Additional Storage Locations:\n")
+		for _, location := range status.AdditionalStorageLocations {
+			if location.Error != "" {
+				d.Printf("\t%s:\t%s (%s)\n", location.Name, location.Phase, location.Error)
+			} else {
+				d.Printf("\t%s:\t%s\n", location.Name, location.Phase)
+			}
+		}
+		d.Println()
+	}
+
 	if details {
 		describeBackupResourceList(d, backup, veleroClient, insecureSkipTLSVerify)
 		d.Println()
+
+		if len(status.SlowestItems) > 0 || len(status.SlowestActions) > 0 {
+			describeItemTimings(d, status.SlowestItems, status.SlowestActions)
+			d.Println()
+		}
+	}
+
+	if status.HooksAttempted > 0 {
+		if details {
+			describeBackupHooks(d, backup, veleroClient, insecureSkipTLSVerify)
+		} else {
+			d.Printf("Hooks Executed:\t%d, %d failed (specify --details for more information)\n", status.HooksAttempted, status.HooksFailed)
+		}
+		d.Println()
+	}
+
+	if len(status.PluginOperations) > 0 {
+		if details {
+			describePluginOperations(d, status.PluginOperations)
+		} else {
+			var completed, failed int
+			for _, operation := range status.PluginOperations {
+				switch operation.Phase {
+				case velerov1api.PluginOperationPhaseCompleted:
+					completed++
+				case velerov1api.PluginOperationPhaseFailed:
+					failed++
+				}
+			}
+			d.Printf("Plugin Operations:\t%d of %d completed, %d failed (specify --details for more information)\n", completed, len(status.PluginOperations), failed)
+		}
+		d.Println()
 	}
 
 	if status.VolumeSnapshotsAttempted > 0 {
@@ -267,6 +422,49 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 	d.Printf("Persistent Volumes: <none included>\n")
 }
 
+func describeItemTimings(d *Describer, slowestItems, slowestActions []velerov1api.ItemTiming) {
+	if len(slowestItems) > 0 {
+		d.Println("Slowest Items:")
+		for _, timing := range slowestItems {
+			d.Printf("\t%s\t%s\t%s\n", timing.Duration.Duration, timing.Resource, itemTimingName(timing))
+		}
+	}
+
+	if len(slowestActions) > 0 {
+		d.Println("Slowest Backup Item Actions:")
+		for _, timing := range slowestActions {
+			d.Printf("\t%s\t%s\t%s\t%s\n", timing.Duration.Duration, timing.Action, timing.Resource, itemTimingName(timing))
+		}
+	}
+}
+
+func describePluginOperations(d *Describer, operations []velerov1api.PluginOperation) {
+	d.Println("Plugin Operations:")
+	for _, operation := range operations {
+		name := operation.Name
+		if operation.Namespace != "" {
+			name = fmt.Sprintf("%s/%s", operation.Namespace, operation.Name)
+		}
+
+		phase := string(operation.Phase)
+		if operation.NTotal > 0 {
+			phase = fmt.Sprintf("%s (%d of %d %s)", phase, operation.NCompleted, operation.NTotal, operation.OperationUnits)
+		}
+		if operation.Error != "" {
+			phase = fmt.Sprintf("%s: %s", phase, operation.Error)
+		}
+
+		d.Printf("\t%s\t%s\t%s\t%s\n", operation.Plugin, operation.Resource, name, phase)
+	}
+}
+
+func itemTimingName(timing velerov1api.ItemTiming) string {
+	if timing.Namespace == "" {
+		return timing.Name
+	}
+	return fmt.Sprintf("%s/%s", timing.Namespace, timing.Name)
+}
+
 func describeBackupResourceList(d *Describer, backup *velerov1api.Backup, veleroClient clientset.Interface, insecureSkipTLSVerify bool) {
 	buf := new(bytes.Buffer)
 	if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
@@ -298,6 +496,46 @@ func describeBackupResourceList(d *Describer, backup *velerov1api.Backup, velero
 	}
 }
 
+func describeBackupHooks(d *Describer, backup *velerov1api.Backup, veleroClient clientset.Interface, insecureSkipTLSVerify bool) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResults, buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
+		d.Printf("Hooks Executed:\t<error getting backup results: %v>\n", err)
+		return
+	}
+
+	var results pkgbackup.Results
+	if err := json.NewDecoder(buf).Decode(&results); err != nil {
+		d.Printf("Hooks Executed:\t<error reading backup results: %v>\n", err)
+		return
+	}
+
+	describeHookExecutions(d, results.Hooks)
+}
+
+func describeHookExecutions(d *Describer, hooks []pkgbackup.HookExecution) {
+	d.Println("Hooks Executed:")
+	for _, hook := range hooks {
+		d.Printf("\t%s/%s:\n", hook.Namespace, hook.Pod)
+		d.Printf("\t\tHook:\t%s (%s)\n", hook.Hook, hook.Phase)
+		d.Printf("\t\tContainer:\t%s\n", hook.Container)
+		d.Printf("\t\tCommand:\t%s\n", strings.Join(hook.Command, " "))
+		d.Printf("\t\tDuration:\t%s\n", hook.Duration.Duration)
+
+		exitStatus := "<unknown>"
+		if hook.ExitStatus != nil {
+			exitStatus = fmt.Sprint(*hook.ExitStatus)
+		}
+		d.Printf("\t\tExit Status:\t%s\n", exitStatus)
+
+		if hook.Failed() {
+			d.Printf("\t\tError:\t%s\n", hook.Error)
+		}
+		if hook.Stderr != "" {
+			d.Printf("\t\tStderr:\n\t\t\t%s\n", strings.Join(strings.Split(strings.TrimRight(hook.Stderr, "\n"), "\n"), "\n\t\t\t"))
+		}
+	}
+}
+
 func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ string, iops *int64) {
 	d.Printf("\t%s:\n", pvName)
 	d.Printf("\t\tSnapshot ID:\t%s\n", snapshotID)
@@ -327,6 +565,12 @@ func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBac
 		}
 
 		d.Printf("\t%s: %s\n", req.CreationTimestamp.String(), req.Status.Phase)
+		if req.Spec.Requester != "" {
+			d.Printf("\tRequester:\t%s\n", req.Spec.Requester)
+		}
+		if req.Spec.Reason != "" {
+			d.Printf("\tReason:\t%s\n", req.Spec.Reason)
+		}
 		if len(req.Status.Errors) > 0 {
 			d.Printf("\tErrors:\n")
 			for _, err := range req.Status.Errors {
@@ -346,6 +590,44 @@ func failedDeletionCount(requests []velerov1api.DeleteBackupRequest) int {
 	return count
 }
 
+// DescribeRestoreHistory describes the restores created from a backup, and
+// their outcomes, in human-readable format.
+func DescribeRestoreHistory(d *Describer, restores []velerov1api.Restore) {
+	sort.Slice(restores, func(i, j int) bool {
+		return restores[i].CreationTimestamp.Before(&restores[j].CreationTimestamp)
+	})
+
+	d.Printf("Restores")
+	if count := unsuccessfulRestoreCount(restores); count > 0 {
+		d.Printf(" (%d not completed successfully)", count)
+	}
+	d.Println(":")
+
+	for _, restore := range restores {
+		phase := restore.Status.Phase
+		if phase == "" {
+			phase = velerov1api.RestorePhaseNew
+		}
+
+		d.Printf("\t%s:\t%s (created %s", restore.Name, phase, restore.CreationTimestamp.String())
+		if restore.Status.Errors > 0 || restore.Status.Warnings > 0 {
+			d.Printf(", %d errors, %d warnings", restore.Status.Errors, restore.Status.Warnings)
+		}
+		d.Println(")")
+	}
+}
+
+func unsuccessfulRestoreCount(restores []velerov1api.Restore) int {
+	var count int
+	for _, restore := range restores {
+		switch restore.Status.Phase {
+		case velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
+			count++
+		}
+	}
+	return count
+}
+
 // DescribePodVolumeBackups describes pod volume backups in human-readable format.
 func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBackup, details bool) {
 	if details {
@@ -431,9 +713,9 @@ func (v *volumesByPod) Add(namespace, name, volume, phase string, progress veler
 
 	key := fmt.Sprintf("%s/%s", namespace, name)
 
-	// append backup progress percentage if backup is in progress
+	// append the progress of the volume's transfer if it's in progress
 	if phase == "In Progress" && progress != (velerov1api.PodVolumeOperationProgress{}) {
-		volume = fmt.Sprintf("%s (%.2f%%)", volume, float64(progress.BytesDone)/float64(progress.TotalBytes)*100)
+		volume = fmt.Sprintf("%s (%s)", volume, describePodVolumeProgress(progress))
 	}
 
 	if group, ok := v.volumesByPodMap[key]; !ok {
@@ -449,6 +731,41 @@ func (v *volumesByPod) Add(namespace, name, volume, phase string, progress veler
 	}
 }
 
+// describePodVolumeProgress describes the progress of a pod volume backup
+// or restore, e.g. "45.00%, 450.0 MiB of 1000.0 MiB". Restic may not know the
+// total size of a volume yet, in which case only the bytes transferred are
+// described.
+func describePodVolumeProgress(progress velerov1api.PodVolumeOperationProgress) string {
+	if progress.TotalBytes <= 0 {
+		return fmt.Sprintf("%s done", formatBytes(progress.BytesDone))
+	}
+
+	// restores measure the size of the volume, which may be larger than the
+	// snapshot being restored to it.
+	percent := float64(progress.BytesDone) / float64(progress.TotalBytes) * 100
+	if percent > 100 {
+		percent = 100
+	}
+
+	return fmt.Sprintf("%.2f%%, %s of %s", percent, formatBytes(progress.BytesDone), formatBytes(progress.TotalBytes))
+}
+
+// formatBytes formats a number of bytes in binary units, e.g. "1.5 GiB".
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit && exp < 4; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTP"[exp])
+}
+
 // Sorted returns a slice of all pod volume groups, ordered by
 // label.
 func (v *volumesByPod) Sorted() []*podVolumeGroup {
@@ -458,3 +775,12 @@ func (v *volumesByPod) Sorted() []*podVolumeGroup {
 
 	return v.volumesByPodSlice
 }
+
+// describeReplicaPolicies describes the replica policies of a backup or
+// restore, in the order they're applied.
+func describeReplicaPolicies(d *Describer, policies []velerov1api.ResourceReplicaPolicy) {
+	d.Printf("Replica Policies:\n")
+	for _, policy := range policies {
+		d.Printf("\t%s:\t%s\n", policy.Policy, strings.Join(policy.Resources, ", "))
+	}
+}
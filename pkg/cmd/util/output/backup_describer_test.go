@@ -0,0 +1,70 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestDescribePodVolumeProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		progress velerov1api.PodVolumeOperationProgress
+		want     string
+	}{
+		{
+			name:     "percentage and bytes",
+			progress: velerov1api.PodVolumeOperationProgress{TotalBytes: 4 << 30, BytesDone: 1 << 30},
+			want:     "25.00%, 1.0 GiB of 4.0 GiB",
+		},
+		{
+			name:     "unknown total",
+			progress: velerov1api.PodVolumeOperationProgress{BytesDone: 1536},
+			want:     "1.5 KiB done",
+		},
+		{
+			name:     "restored volume larger than its snapshot",
+			progress: velerov1api.PodVolumeOperationProgress{TotalBytes: 100, BytesDone: 120},
+			want:     "100.00%, 120 B of 100 B",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, describePodVolumeProgress(tc.progress))
+		})
+	}
+}
+
+func TestDescribePodVolumeBackupsProgress(t *testing.T) {
+	backups := []velerov1api.PodVolumeBackup{
+		*builder.ForPodVolumeBackup("velero", "pvb-1").PodNamespace("ns-1").PodName("pod-1").Volume("data").
+			Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+	}
+	backups[0].Status.Progress = velerov1api.PodVolumeOperationProgress{TotalBytes: 2 << 20, BytesDone: 1 << 20}
+
+	got := Describe(func(d *Describer) {
+		DescribePodVolumeBackups(d, backups, true)
+	})
+
+	assert.Equal(t, "Restic Backups:\n  In Progress:\n    ns-1/pod-1: data (50.00%, 1.0 MiB of 2.0 MiB)\n", got)
+}
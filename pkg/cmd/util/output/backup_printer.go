@@ -18,8 +18,7 @@ package output
 
 import (
 	"fmt"
-	"regexp"
-	"sort"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,11 +39,14 @@ var (
 		{Name: "Expires"},
 		{Name: "Storage Location"},
 		{Name: "Selector"},
+		{Name: "Cluster", Priority: 1},
+		{Name: "Reason", Priority: 1},
 	}
 )
 
+// printBackupList prints backups in the order they're listed in, which is
+// newest first for `velero backup get`.
 func printBackupList(list *velerov1api.BackupList, options printers.PrintOptions) ([]metav1.TableRow, error) {
-	sortBackupsByPrefixAndTimestamp(list)
 	rows := make([]metav1.TableRow, 0, len(list.Items))
 
 	for i := range list.Items {
@@ -57,41 +59,12 @@ func printBackupList(list *velerov1api.BackupList, options printers.PrintOptions
 	return rows, nil
 }
 
-// sort by default alphabetically, but if backups stem from a common schedule
-// (detected by the presence of a 14-digit timestamp suffix), then within that
-// group, sort by newest to oldest (i.e. prefix ASC, suffix DESC)
-var timestampSuffix = regexp.MustCompile("-[0-9]{14}$")
-
-func sortBackupsByPrefixAndTimestamp(list *velerov1api.BackupList) {
-
-	sort.Slice(list.Items, func(i, j int) bool {
-		iSuffixIndex := timestampSuffix.FindStringIndex(list.Items[i].Name)
-		jSuffixIndex := timestampSuffix.FindStringIndex(list.Items[j].Name)
-
-		// one/both don't have a timestamp suffix, so sort alphabetically
-		if iSuffixIndex == nil || jSuffixIndex == nil {
-			return list.Items[i].Name < list.Items[j].Name
-		}
-
-		// different prefixes, so sort alphabetically
-		if list.Items[i].Name[0:iSuffixIndex[0]] != list.Items[j].Name[0:jSuffixIndex[0]] {
-			return list.Items[i].Name < list.Items[j].Name
-		}
-
-		// same prefixes, so sort based on suffix (desc)
-		return list.Items[i].Name[iSuffixIndex[0]:] >= list.Items[j].Name[jSuffixIndex[0]:]
-	})
-}
-
 func printBackup(backup *velerov1api.Backup, options printers.PrintOptions) ([]metav1.TableRow, error) {
 	row := metav1.TableRow{
 		Object: runtime.RawExtension{Object: backup},
 	}
 
-	expiration := backup.Status.Expiration.Time
-	if expiration.IsZero() && backup.Spec.TTL.Duration > 0 {
-		expiration = backup.CreationTimestamp.Add(backup.Spec.TTL.Duration)
-	}
+	expiration := backupExpiration(backup)
 
 	status := string(backup.Status.Phase)
 	if status == "" {
@@ -113,9 +86,42 @@ func printBackup(backup *velerov1api.Backup, options printers.PrintOptions) ([]m
 
 	row.Cells = append(row.Cells, backup.Name, status, backup.Status.StartTimestamp.Time, humanReadableTimeFromNow(expiration), location, metav1.FormatLabelSelector(backup.Spec.LabelSelector))
 
+	if options.Wide {
+		cluster := backup.Labels[velerov1api.SourceClusterNameLabel]
+		if cluster == "" {
+			cluster = backup.Labels[velerov1api.SourceClusterUIDLabel]
+		}
+		if cluster == "" {
+			cluster = "<unknown>"
+		}
+		row.Cells = append(row.Cells, cluster, failureReason(backup.Status.FailureReason, backup.Status.ValidationErrors))
+	}
+
 	return []metav1.TableRow{row}, nil
 }
 
+// failureReason returns why a backup or restore failed, for printing in a
+// single cell: its failure reason if it has one, or else its first validation
+// error and how many more there are.
+func failureReason(reason string, validationErrors []string) string {
+	if reason == "" && len(validationErrors) > 0 {
+		reason = validationErrors[0]
+		if more := len(validationErrors) - 1; more > 0 {
+			reason = fmt.Sprintf("%s (and %d more)", reason, more)
+		}
+	}
+
+	// only the first line fits in a table
+	if i := strings.IndexByte(reason, '\n'); i >= 0 {
+		reason = reason[:i]
+	}
+
+	if reason == "" {
+		return "<none>"
+	}
+	return reason
+}
+
 func humanReadableTimeFromNow(when time.Time) string {
 	if when.IsZero() {
 		return "n/a"
@@ -17,72 +17,157 @@ limitations under the License.
 package output
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/printers"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
-func TestSortBackups(t *testing.T) {
+func TestPrintBackupSourceCluster(t *testing.T) {
 	tests := []struct {
-		name       string
-		backupList *v1.BackupList
-		expected   []v1.Backup
+		name        string
+		labels      []string
+		wantCluster string
 	}{
 		{
-			name: "non-timestamped backups",
-			backupList: &v1.BackupList{Items: []v1.Backup{
-				{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "c"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
-			}},
-			expected: []v1.Backup{
-				{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "c"}},
+			name:        "cluster name is printed if it's known",
+			labels:      []string{v1.SourceClusterNameLabel, "cluster-1", v1.SourceClusterUIDLabel, "uid-1"},
+			wantCluster: "cluster-1",
+		},
+		{
+			name:        "cluster UID is printed if the name isn't known",
+			labels:      []string{v1.SourceClusterUIDLabel, "uid-1"},
+			wantCluster: "uid-1",
+		},
+		{
+			name:        "unknown cluster is printed if neither is known",
+			wantCluster: "<unknown>",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(tc.labels...)).Result()
+
+			printer := printers.NewTablePrinter(printers.PrintOptions{Wide: true})
+			printer.TableHandler(backupColumns, printBackup)
+
+			buf := new(bytes.Buffer)
+			require.NoError(t, printer.PrintObj(backup, buf))
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			require.Len(t, lines, 2)
+			fields := strings.Fields(lines[1])
+			// the cluster is followed by the reason, which is <none>
+			assert.Equal(t, tc.wantCluster, fields[len(fields)-2])
+		})
+	}
+}
+
+func TestFailureReason(t *testing.T) {
+	tests := []struct {
+		name             string
+		reason           string
+		validationErrors []string
+		want             string
+	}{
+		{
+			name: "no failure reason or validation errors",
+			want: "<none>",
+		},
+		{
+			name:             "failure reason is preferred over validation errors",
+			reason:           "error getting backup storage location",
+			validationErrors: []string{"invalid included namespaces"},
+			want:             "error getting backup storage location",
+		},
+		{
+			name:             "a single validation error",
+			validationErrors: []string{"backup storage location \"default\" doesn't exist"},
+			want:             "backup storage location \"default\" doesn't exist",
+		},
+		{
+			name:             "multiple validation errors",
+			validationErrors: []string{"first error", "second error", "third error"},
+			want:             "first error (and 2 more)",
+		},
+		{
+			name:   "only the first line is used",
+			reason: "first line\nsecond line",
+			want:   "first line",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, failureReason(tc.reason, tc.validationErrors))
+		})
+	}
+}
+
+func TestPrintReasonColumn(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").Phase(v1.BackupPhaseFailedValidation).Result()
+	backup.Status.ValidationErrors = []string{"invalid included namespaces", "invalid excluded namespaces"}
+	restore := builder.ForRestore("velero", "restore-1").Phase(v1.RestorePhaseFailed).Result()
+	restore.Status.FailureReason = "error downloading backup"
+
+	tests := []struct {
+		name    string
+		obj     runtime.Object
+		wide    bool
+		want    string
+		handler func(*printers.HumanReadablePrinter)
+	}{
+		{
+			name: "backup",
+			obj:  backup,
+			wide: true,
+			want: "invalid included namespaces (and 1 more)",
+			handler: func(p *printers.HumanReadablePrinter) {
+				p.TableHandler(backupColumns, printBackup)
 			},
 		},
 		{
-			name: "timestamped backups",
-			backupList: &v1.BackupList{Items: []v1.Backup{
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030405"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030406"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030407"}},
-			}},
-			expected: []v1.Backup{
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030407"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030406"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030405"}},
+			name: "restore",
+			obj:  restore,
+			wide: true,
+			want: "error downloading backup",
+			handler: func(p *printers.HumanReadablePrinter) {
+				p.TableHandler(restoreColumns, printRestore)
 			},
 		},
 		{
-			name: "non-timestamped and timestamped backups",
-			backupList: &v1.BackupList{Items: []v1.Backup{
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030405"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030406"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030407"}},
-			}},
-			expected: []v1.Backup{
-				{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030407"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030406"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "schedule-20170102030405"}},
+			name: "reason isn't printed without wide output",
+			obj:  restore,
+			handler: func(p *printers.HumanReadablePrinter) {
+				p.TableHandler(restoreColumns, printRestore)
 			},
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			sortBackupsByPrefixAndTimestamp(test.backupList)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			printer := printers.NewTablePrinter(printers.PrintOptions{Wide: tc.wide})
+			tc.handler(printer)
+
+			buf := new(bytes.Buffer)
+			require.NoError(t, printer.PrintObj(tc.obj, buf))
 
-			if assert.Equal(t, len(test.backupList.Items), len(test.expected)) {
-				for i := range test.expected {
-					assert.Equal(t, test.expected[i].Name, test.backupList.Items[i].Name)
-				}
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			require.Len(t, lines, 2)
+			if tc.want == "" {
+				assert.NotContains(t, lines[0], "REASON")
+				return
 			}
+			assert.True(t, strings.HasSuffix(lines[0], "REASON"), lines[0])
+			assert.True(t, strings.HasSuffix(lines[1], tc.want), lines[1])
 		})
 	}
 }
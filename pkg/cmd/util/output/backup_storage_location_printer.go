@@ -22,6 +22,7 @@ import (
 	"k8s.io/kubernetes/pkg/printers"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/storage"
 )
 
 var (
@@ -31,7 +32,12 @@ var (
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Provider"},
 		{Name: "Bucket/Prefix"},
+		{Name: "Phase"},
+		{Name: "Last Validated"},
 		{Name: "Access Mode"},
+		{Name: "Default"},
+		{Name: "Encryption", Priority: 1},
+		{Name: "Encryption Key", Priority: 1},
 	}
 )
 
@@ -63,12 +69,35 @@ func printBackupStorageLocation(location *v1.BackupStorageLocation, options prin
 		accessMode = v1.BackupStorageLocationAccessModeReadWrite
 	}
 
+	phase := location.Status.Phase
+	if phase == "" {
+		phase = "Unknown"
+	}
+
 	row.Cells = append(row.Cells,
 		location.Name,
 		location.Spec.Provider,
 		bucketAndPrefix,
+		phase,
+		humanReadableTimeFromNow(location.Status.LastValidationTime.Time),
 		accessMode,
+		storage.IsDefaultBackupLocation(location),
 	)
 
+	if options.Wide {
+		encryption, encryptionKey := "<unknown>", ""
+		if location.Status.Encryption != nil {
+			encryption = "Disabled"
+			if location.Status.Encryption.Enabled {
+				encryption = location.Status.Encryption.Algorithm
+				if encryption == "" {
+					encryption = "Enabled"
+				}
+			}
+			encryptionKey = location.Status.Encryption.KeyID
+		}
+		row.Cells = append(row.Cells, encryption, encryptionKey)
+	}
+
 	return []metav1.TableRow{row}, nil
 }
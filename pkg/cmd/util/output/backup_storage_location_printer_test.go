@@ -0,0 +1,92 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/kubernetes/pkg/printers"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestPrintBackupStorageLocationEncryption(t *testing.T) {
+	tests := []struct {
+		name       string
+		encryption *v1.EncryptionStatus
+		wide       bool
+		wantFields []string
+	}{
+		{
+			name:       "encryption columns aren't printed without wide output",
+			encryption: &v1.EncryptionStatus{Enabled: true, Algorithm: "aws:kms", KeyID: "key-1"},
+			wantFields: []string{"loc-1", "aws", "bucket-1", "Unknown", "n/a", "ReadWrite", "false"},
+		},
+		{
+			name:       "algorithm and key are printed with wide output",
+			encryption: &v1.EncryptionStatus{Enabled: true, Algorithm: "aws:kms", KeyID: "key-1"},
+			wide:       true,
+			wantFields: []string{"loc-1", "aws", "bucket-1", "Unknown", "n/a", "ReadWrite", "false", "aws:kms", "key-1"},
+		},
+		{
+			name:       "disabled encryption is printed with wide output",
+			encryption: &v1.EncryptionStatus{Enabled: false},
+			wide:       true,
+			wantFields: []string{"loc-1", "aws", "bucket-1", "Unknown", "n/a", "ReadWrite", "false", "Disabled"},
+		},
+		{
+			name:       "unknown encryption is printed with wide output",
+			wide:       true,
+			wantFields: []string{"loc-1", "aws", "bucket-1", "Unknown", "n/a", "ReadWrite", "false", "<unknown>"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation("velero", "loc-1").Provider("aws").Bucket("bucket-1").Result()
+			location.Status.Encryption = tc.encryption
+
+			printer := printers.NewTablePrinter(printers.PrintOptions{Wide: tc.wide})
+			printer.TableHandler(backupStorageLocationColumns, printBackupStorageLocation)
+
+			buf := new(bytes.Buffer)
+			require.NoError(t, printer.PrintObj(location, buf))
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			require.Len(t, lines, 2)
+			assert.Equal(t, tc.wantFields, strings.Fields(lines[1]))
+		})
+	}
+}
+
+func TestPrintBackupStorageLocationPhase(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "loc-1").Provider("aws").Bucket("bucket-1").
+		Phase(v1.BackupStorageLocationPhaseUnavailable).LastValidationTime(time.Now().Add(-2 * time.Minute)).Result()
+
+	rows, err := printBackupStorageLocation(location, printers.PrintOptions{})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+
+	assert.Equal(t, v1.BackupStorageLocationPhaseUnavailable, rows[0].Cells[3])
+	assert.Equal(t, "2m ago", rows[0].Cells[4])
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
+)
+
+// jsonPathPrefix is the prefix of the output format that prints objects
+// with a JSONPath template, e.g. 'jsonpath={.status.phase}'.
+const jsonPathPrefix = "jsonpath="
+
+// parseJSONPath parses a JSONPath template. Like kubectl's, fields that
+// objects don't have are printed as empty rather than being errors.
+func parseJSONPath(template string) (*jsonpath.JSONPath, error) {
+	if template == "" {
+		return nil, errors.New("a template is required with the 'jsonpath' output format, e.g. 'jsonpath={.status.phase}'")
+	}
+
+	j := jsonpath.New("output").AllowMissingKeys(true)
+	if err := j.Parse(template); err != nil {
+		return nil, errors.Wrapf(err, "error parsing JSONPath template %q", template)
+	}
+	return j, nil
+}
+
+// printJSONPath prints the provided object with a JSONPath template. The
+// template is applied to the object as it's printed in the 'json' format, so
+// a list with one item is printed as that item.
+func printJSONPath(w io.Writer, obj runtime.Object, template string) (bool, error) {
+	j, err := parseJSONPath(template)
+	if err != nil {
+		return false, err
+	}
+
+	// objects from the API server don't have their kind set, so it's set
+	// from the scheme, as it is when they're printed as JSON.
+	toPrint := encodedObject(obj).DeepCopyObject()
+	if toPrint.GetObjectKind().GroupVersionKind().Empty() {
+		if gvks, _, err := scheme.Scheme.ObjectKinds(toPrint); err == nil {
+			toPrint.GetObjectKind().SetGroupVersionKind(gvks[0])
+		}
+	}
+
+	encoded, err := json.Marshal(toPrint)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(encoded, &data); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	if err := j.Execute(w, data); err != nil {
+		return false, errors.Wrapf(err, "error executing JSONPath template %q", template)
+	}
+
+	return true, nil
+}
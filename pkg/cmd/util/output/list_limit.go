@@ -0,0 +1,92 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListPageSize is the number of items that get commands request per page
+// when listing, so that huge lists are fetched in chunks.
+const ListPageSize = 500
+
+// ListPages calls list with listOptions, and again with each continue token
+// that it returns, until all pages have been listed.
+func ListPages(listOptions metav1.ListOptions, list func(metav1.ListOptions) (continueToken string, err error)) error {
+	listOptions.Limit = ListPageSize
+
+	for {
+		continueToken, err := list(listOptions)
+		if err != nil {
+			return err
+		}
+		if continueToken == "" {
+			return nil
+		}
+
+		listOptions.Continue = continueToken
+	}
+}
+
+// ListLimit limits the items printed by get commands to the most recent ones.
+type ListLimit struct {
+	Limit int
+	Since time.Duration
+}
+
+// BindFlags defines the --limit and --since flags within the provided
+// FlagSet.
+func (l *ListLimit) BindFlags(flags *pflag.FlagSet) {
+	flags.IntVar(&l.Limit, "limit", l.Limit, "maximum number of items to show, newest first. 0 means no limit")
+	flags.DurationVar(&l.Since, "since", l.Since, "only show items created within this duration, e.g. 24h. 0 means no limit")
+}
+
+// Validate returns an error if the limits are negative.
+func (l *ListLimit) Validate() error {
+	if l.Limit < 0 {
+		return errors.New("--limit must be non-negative")
+	}
+	if l.Since < 0 {
+		return errors.New("--since must be non-negative")
+	}
+	return nil
+}
+
+// Includes returns whether an item created at the given time is included by
+// --since.
+func (l *ListLimit) Includes(created metav1.Time, now time.Time) bool {
+	return l.Since == 0 || !created.Time.Before(now.Add(-l.Since))
+}
+
+// Truncate returns how many of n items, sorted newest first, are shown
+// given --limit.
+func (l *ListLimit) Truncate(n int) int {
+	if l.Limit > 0 && l.Limit < n {
+		return l.Limit
+	}
+	return n
+}
+
+// NewestFirst returns whether an item created at a should be printed before
+// one created at b.
+func NewestFirst(a, b metav1.Time) bool {
+	return b.Before(&a)
+}
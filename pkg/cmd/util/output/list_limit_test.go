@@ -0,0 +1,60 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestListPages(t *testing.T) {
+	var requests []metav1.ListOptions
+	pages := []string{"token-1", "token-2", ""}
+
+	err := ListPages(metav1.ListOptions{LabelSelector: "app=nginx"}, func(listOptions metav1.ListOptions) (string, error) {
+		requests = append(requests, listOptions)
+		return pages[len(requests)-1], nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []metav1.ListOptions{
+		{LabelSelector: "app=nginx", Limit: ListPageSize},
+		{LabelSelector: "app=nginx", Limit: ListPageSize, Continue: "token-1"},
+		{LabelSelector: "app=nginx", Limit: ListPageSize, Continue: "token-2"},
+	}, requests)
+
+	err = ListPages(metav1.ListOptions{}, func(metav1.ListOptions) (string, error) {
+		return "", errors.New("list failed")
+	})
+	assert.EqualError(t, err, "list failed")
+}
+
+func TestListLimitValidate(t *testing.T) {
+	assert.NoError(t, (&ListLimit{}).Validate())
+	assert.Error(t, (&ListLimit{Limit: -1}).Validate())
+	assert.Error(t, (&ListLimit{Since: -1}).Validate())
+}
+
+func TestListLimitTruncate(t *testing.T) {
+	assert.Equal(t, 10, (&ListLimit{}).Truncate(10))
+	assert.Equal(t, 3, (&ListLimit{Limit: 3}).Truncate(10))
+	assert.Equal(t, 2, (&ListLimit{Limit: 3}).Truncate(2))
+}
@@ -18,7 +18,9 @@ package output
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,6 +31,7 @@ import (
 	"k8s.io/kubernetes/pkg/printers"
 
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 )
 
@@ -37,15 +40,26 @@ const downloadRequestTimeout = 30 * time.Second
 // BindFlags defines a set of output-specific flags within the provided
 // FlagSet.
 func BindFlags(flags *pflag.FlagSet) {
-	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'json', and 'yaml'. 'table' is not valid for the install command.")
+	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'wide', 'json', 'yaml', 'name', and 'jsonpath=TEMPLATE'. 'table', 'wide', 'name', and 'jsonpath' are not valid for the install command.")
 	labelColumns := flag.NewStringArray()
 	flags.Var(&labelColumns, "label-columns", "a comma-separated list of labels to be displayed as columns")
 	flags.Bool("show-labels", false, "show labels in the last column")
+	flags.Bool("no-headers", false, "when using the 'table' or 'wide' output format, don't print headers")
+	columns := flag.NewStringArray()
+	flags.Var(&columns, "columns", "when using the 'table' or 'wide' output format, a comma-separated list of the columns to print, in order, e.g. 'name,status'")
+	flags.Bool("color", false, "when using the 'table' or 'wide' output format, color statuses, e.g. Completed in green and Failed in red")
+	flags.String("sort-by", "", fmt.Sprintf("sort the items by a field, in ascending order. Valid fields are %s", strings.Join(sortByFields, ", ")))
 }
 
 // BindFlagsSimple defines the output format flag only.
 func BindFlagsSimple(flags *pflag.FlagSet) {
-	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'json', and 'yaml'. 'table' is not valid for the install command.")
+	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'wide', 'json', 'yaml', 'name', and 'jsonpath=TEMPLATE'. 'table', 'wide', 'name', and 'jsonpath' are not valid for the install command.")
+}
+
+// BindDescribeFlags defines the output format flag of describe commands,
+// which describe objects as human-readable text unless it's set.
+func BindDescribeFlags(flags *pflag.FlagSet) {
+	flags.StringP("output", "o", "", "Output display format. If unset, objects are described as human-readable text. Valid formats are 'table', 'wide', 'json', 'yaml', 'name', and 'jsonpath=TEMPLATE'.")
 }
 
 // ClearOutputFlagDefault sets the current and default value
@@ -77,25 +91,51 @@ func GetShowLabelsValue(cmd *cobra.Command) bool {
 	return flag.GetOptionalBoolFlag(cmd, "show-labels")
 }
 
+// GetNoHeadersValue returns the value of the "no-headers" flag
+// in the provided command, or the zero value if not present.
+func GetNoHeadersValue(cmd *cobra.Command) bool {
+	return flag.GetOptionalBoolFlag(cmd, "no-headers")
+}
+
+// GetColumnsValues returns the value of the "columns" flag
+// in the provided command, or the zero value if not present.
+func GetColumnsValues(cmd *cobra.Command) []string {
+	return flag.GetOptionalStringArrayFlag(cmd, "columns")
+}
+
+// GetColorValue returns the value of the "color" flag
+// in the provided command, or the zero value if not present.
+func GetColorValue(cmd *cobra.Command) bool {
+	return flag.GetOptionalBoolFlag(cmd, "color")
+}
+
 // ValidateFlags returns an error if any of the output-related flags
 // were specified with invalid values, or nil otherwise.
 func ValidateFlags(cmd *cobra.Command) error {
 	if err := validateOutputFlag(cmd); err != nil {
 		return err
 	}
+	if err := validateSortByFlag(cmd); err != nil {
+		return err
+	}
 	return nil
 }
 
 func validateOutputFlag(cmd *cobra.Command) error {
 	output := GetOutputFlagValue(cmd)
-	switch output {
-	case "", "json", "yaml":
-	case "table":
+	switch {
+	case output == "", output == "json", output == "yaml":
+	case output == "table", output == "wide", output == "name", strings.HasPrefix(output, jsonPathPrefix):
 		if cmd.Name() == "install" {
-			return errors.New("'table' format is not supported with 'install' command")
+			return errors.Errorf("'%s' format is not supported with 'install' command", output)
+		}
+		if strings.HasPrefix(output, jsonPathPrefix) {
+			if _, err := parseJSONPath(strings.TrimPrefix(output, jsonPathPrefix)); err != nil {
+				return err
+			}
 		}
 	default:
-		return errors.Errorf("invalid output format %q - valid values are 'table', 'json', and 'yaml'", output)
+		return errors.Errorf("invalid output format %q - valid values are 'table', 'wide', 'json', 'yaml', 'name', and 'jsonpath=TEMPLATE'", output)
 	}
 	return nil
 }
@@ -108,29 +148,81 @@ func PrintWithFormat(c *cobra.Command, obj runtime.Object) (bool, error) {
 		return false, nil
 	}
 
-	switch format {
-	case "table":
+	if sortBy := GetSortByValue(c); sortBy != "" {
+		if err := sortList(obj, sortBy); err != nil {
+			return false, err
+		}
+	}
+
+	switch {
+	case format == "table", format == "wide":
 		return printTable(c, obj)
-	case "json", "yaml":
+	case format == "json", format == "yaml":
 		return printEncoded(obj, format)
+	case format == "name":
+		return printName(os.Stdout, obj)
+	case strings.HasPrefix(format, jsonPathPrefix):
+		return printJSONPath(os.Stdout, obj, strings.TrimPrefix(format, jsonPathPrefix))
 	}
 
-	return false, errors.Errorf("unsupported output format %q; valid values are 'table', 'json', and 'yaml'", format)
+	return false, errors.Errorf("unsupported output format %q; valid values are 'table', 'wide', 'json', 'yaml', 'name', and 'jsonpath=TEMPLATE'", format)
 }
 
-func printEncoded(obj runtime.Object, format string) (bool, error) {
-	// assume we're printing obj
-	toPrint := obj
+// printName prints the provided object, or each item of the provided list,
+// as <resource>.<group>/<name>, like kubectl's 'name' output format, e.g.
+// backup.velero.io/backup-1.
+func printName(w io.Writer, obj runtime.Object) (bool, error) {
+	items := []runtime.Object{obj}
+	if meta.IsListType(obj) {
+		list, err := meta.ExtractList(obj)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+		items = list
+	}
 
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+
+		gvk := item.GetObjectKind().GroupVersionKind()
+		if gvk.Empty() {
+			gvks, _, err := scheme.Scheme.ObjectKinds(item)
+			if err != nil {
+				return false, errors.WithStack(err)
+			}
+			gvk = gvks[0]
+		}
+
+		resource := strings.ToLower(gvk.Kind)
+		if gvk.Group != "" {
+			resource += "." + gvk.Group
+		}
+		if _, err := fmt.Fprintf(w, "%s/%s\n", resource, accessor.GetName()); err != nil {
+			return false, errors.WithStack(err)
+		}
+	}
+
+	return true, nil
+}
+
+// encodedObject returns the object that's printed when obj is printed in an
+// encoded format: obj, or its only item if it's a list with one item.
+func encodedObject(obj runtime.Object) runtime.Object {
 	if meta.IsListType(obj) {
 		list, _ := meta.ExtractList(obj)
 		if len(list) == 1 {
 			// if obj was a list and there was only 1 item, just print that 1 instead of a list
-			toPrint = list[0]
+			return list[0]
 		}
 	}
+	return obj
+}
 
-	encoded, err := encode.Encode(toPrint, format)
+func printEncoded(obj runtime.Object, format string) (bool, error) {
+	encoded, err := encode.Encode(encodedObject(obj), format)
 	if err != nil {
 		return false, err
 	}
@@ -160,23 +252,44 @@ func printTable(cmd *cobra.Command, obj runtime.Object) (bool, error) {
 	printer.TableHandler(volumeSnapshotLocationColumns, printVolumeSnapshotLocationList)
 	printer.TableHandler(pluginColumns, printPluginList)
 
-	err = printer.PrintObj(obj, os.Stdout)
+	// the table is generated with headers, so that its columns can be
+	// selected by name, and they're left out when it's written instead.
+	options := printOptions(cmd)
+	options.NoHeaders = false
+
+	table, err := printer.GenerateTable(obj, options)
 	if err != nil {
 		return false, err
 	}
 
+	if err := selectColumns(table, GetColumnsValues(cmd)); err != nil {
+		return false, err
+	}
+	if GetColorValue(cmd) {
+		colorizeStatuses(table)
+	}
+
+	if err := writeTable(os.Stdout, table, GetNoHeadersValue(cmd)); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
-// NewPrinter returns a printer for doing human-readable table printing of
-// Velero objects.
-func NewPrinter(cmd *cobra.Command) (*printers.HumanReadablePrinter, error) {
-	options := printers.PrintOptions{
+func printOptions(cmd *cobra.Command) printers.PrintOptions {
+	return printers.PrintOptions{
 		ShowLabels:   GetShowLabelsValue(cmd),
 		ColumnLabels: GetLabelColumnsValues(cmd),
+		Wide:         GetOutputFlagValue(cmd) == "wide",
+		NoHeaders:    GetNoHeadersValue(cmd),
 	}
+}
 
-	printer := printers.NewTablePrinter(options)
+// NewPrinter returns a printer for doing human-readable table printing of
+// Velero objects. Additional columns are printed if the output format is
+// 'wide'.
+func NewPrinter(cmd *cobra.Command) (*printers.HumanReadablePrinter, error) {
+	printer := printers.NewTablePrinter(printOptions(cmd))
 
 	return printer, nil
 }
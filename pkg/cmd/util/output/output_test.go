@@ -0,0 +1,147 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestPrintName(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  runtime.Object
+		want string
+	}{
+		{
+			name: "object",
+			obj:  builder.ForBackup("velero", "backup-1").Result(),
+			want: "backup.velero.io/backup-1\n",
+		},
+		{
+			name: "list",
+			obj: &velerov1api.RestoreList{
+				Items: []velerov1api.Restore{
+					*builder.ForRestore("velero", "restore-1").Result(),
+					*builder.ForRestore("velero", "restore-2").Result(),
+				},
+			},
+			want: "restore.velero.io/restore-1\nrestore.velero.io/restore-2\n",
+		},
+		{
+			name: "empty list",
+			obj:  &velerov1api.ScheduleList{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := new(bytes.Buffer)
+			printed, err := printName(got, tc.obj)
+			require.NoError(t, err)
+
+			assert.True(t, printed)
+			assert.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestPrintJSONPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		template string
+		want     string
+	}{
+		{
+			name:     "object",
+			obj:      builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			template: "{.metadata.name} {.status.phase}",
+			want:     "backup-1 Completed",
+		},
+		{
+			name: "list",
+			obj: &velerov1api.RestoreList{
+				Items: []velerov1api.Restore{
+					*builder.ForRestore("velero", "restore-1").Result(),
+					*builder.ForRestore("velero", "restore-2").Result(),
+				},
+			},
+			template: `{range .items[*]}{.metadata.name}{"\n"}{end}`,
+			want:     "restore-1\nrestore-2\n",
+		},
+		{
+			name: "list with one item is printed as the item, like with json",
+			obj: &velerov1api.ScheduleList{
+				Items: []velerov1api.Schedule{*builder.ForSchedule("velero", "daily").Result()},
+			},
+			template: "{.kind}/{.metadata.name}",
+			want:     "Schedule/daily",
+		},
+		{
+			name:     "missing fields are empty",
+			obj:      builder.ForBackup("velero", "backup-1").Result(),
+			template: "[{.status.phase}]",
+			want:     "[]",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := new(bytes.Buffer)
+			printed, err := printJSONPath(got, tc.obj, tc.template)
+			require.NoError(t, err)
+
+			assert.True(t, printed)
+			assert.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestValidateJSONPathOutputFlag(t *testing.T) {
+	tests := []struct {
+		format  string
+		wantErr string
+	}{
+		{format: "jsonpath={.metadata.name}"},
+		{format: "jsonpath=", wantErr: "a template is required with the 'jsonpath' output format, e.g. 'jsonpath={.status.phase}'"},
+		{format: "jsonpath={.metadata.name", wantErr: `error parsing JSONPath template "{.metadata.name": unclosed action`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			c := &cobra.Command{Use: "get"}
+			BindFlags(c.Flags())
+			require.NoError(t, c.Flags().Set("output", tc.format))
+
+			err := ValidateFlags(c)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -56,10 +57,27 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 			}
 		}
 
+		if len(restore.Status.CompatibilityIssues) > 0 {
+			d.Println()
+			d.Printf("Compatibility issues:\n")
+			for _, issue := range restore.Status.CompatibilityIssues {
+				d.Printf("\t%s\n", pkgrestore.CompatibilityIssueMessage(issue))
+			}
+		}
+
 		describeRestoreResults(d, restore, veleroClient, insecureSkipTLSVerify)
 
 		d.Println()
 		d.Printf("Backup:\t%s\n", restore.Spec.BackupName)
+		if restore.Spec.PointInTime != nil {
+			d.Printf("Point In Time:\t%s (schedule %s)\n", restore.Spec.PointInTime.UTC().Format(time.RFC3339), restore.Spec.ScheduleName)
+		}
+		if restore.Spec.StorageLocation != "" {
+			d.Printf("Storage Location:\t%s\n", restore.Spec.StorageLocation)
+		}
+		if restore.Spec.RetryOf != "" {
+			d.Printf("Retry Of:\t%s\n", restore.Spec.RetryOf)
+		}
 
 		d.Println()
 		d.Printf("Namespaces:\n")
@@ -106,6 +124,66 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 
 		d.Println()
 		d.Printf("Restore PVs:\t%s\n", BoolPointerString(restore.Spec.RestorePVs, "false", "true", "auto"))
+		if len(restore.Spec.ZoneMapping) > 0 {
+			d.DescribeMap("Zone mappings", restore.Spec.ZoneMapping)
+		}
+		if overrides := restore.Spec.VolumeOverrides; overrides != nil {
+			if overrides.VolumeType != "" {
+				d.Printf("Volume Type:\t%s\n", overrides.VolumeType)
+			}
+			if overrides.IOPS != nil {
+				d.Printf("Volume IOPS:\t%d\n", *overrides.IOPS)
+			}
+		}
+		if restore.Spec.DataOnly {
+			d.Printf("Data Only:\ttrue\n")
+		}
+		if restore.Spec.NoApply {
+			d.Printf("No Apply:\ttrue (manifests are written to object storage instead of being applied)\n")
+		}
+		if restore.Spec.SkipOwnerManaged {
+			d.Printf("Skip Owner-Managed:\ttrue\n")
+		}
+		if restore.Spec.RetainRestoredPVs {
+			d.Printf("Retain Restored PVs:\ttrue (until the restore completes without errors)\n")
+		}
+		if restore.Spec.WaitForUnboundPVCs {
+			d.Printf("Wait For Unbound PVCs:\ttrue\n")
+		}
+		if restore.Spec.Strict {
+			d.Printf("Strict:\ttrue (fails if the backup has resources the cluster doesn't serve)\n")
+		}
+		if restore.Spec.AllowNewerBackupFormat {
+			d.Printf("Allow Newer Backup Format:\ttrue\n")
+		}
+		if policy := restore.Spec.AdmissionWebhooks; policy != "" && policy != v1.AdmissionWebhookPolicyInOrder {
+			d.Printf("Admission Webhooks:\t%s\n", policy)
+		}
+		if len(restore.Spec.ReplicaPolicies) > 0 {
+			describeReplicaPolicies(d, restore.Spec.ReplicaPolicies)
+		}
+		if policy := restore.Spec.AutoscaledReplicas; policy != "" && policy != v1.AutoscaledReplicaPolicyKeep {
+			d.Printf("Autoscaled Replicas:\t%s\n", policy)
+		}
+		if policy := restore.Spec.ExistingResourcePolicy; policy != "" && policy != v1.ExistingResourcePolicyNone {
+			d.Printf("Existing Resource Policy:\t%s\n", policy)
+		}
+		if len(restore.Spec.ResourcePriorities) > 0 {
+			d.Printf("Resource Priorities:\t%s\n", strings.Join(restore.Spec.ResourcePriorities, ", "))
+		}
+		if options := restore.Spec.LoadBalancerServices; options != nil {
+			policy := options.AnnotationPolicy
+			if policy == "" {
+				policy = v1.LoadBalancerAnnotationPolicyKeep
+			}
+			d.Printf("Load Balancer Annotations:\t%s\n", policy)
+			if len(options.AllowedAnnotations) > 0 {
+				d.Printf("  Allowed:\t%s\n", strings.Join(options.AllowedAnnotations, ", "))
+			}
+			if len(options.DeniedAnnotations) > 0 {
+				d.Printf("  Denied:\t%s\n", strings.Join(options.DeniedAnnotations, ", "))
+			}
+		}
 
 		if len(podVolumeRestores) > 0 {
 			d.Println()
@@ -115,7 +193,7 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 }
 
 func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clientset.Interface, insecureSkipTLSVerify bool) {
-	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 {
+	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 && restore.Status.SkippedItems == 0 {
 		return
 	}
 
@@ -139,6 +217,16 @@ func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clie
 	if restore.Status.Errors > 0 {
 		d.Println()
 		describeRestoreResult(d, "Errors", resultMap["errors"])
+
+		// restores from before failed items were recorded don't have them.
+		if failed, ok := resultMap["failed"]; ok {
+			d.Println()
+			describeRestoreResult(d, "Failed Items", failed)
+		}
+	}
+	if restore.Status.SkippedItems > 0 {
+		d.Println()
+		describeRestoreResult(d, "Skipped Items", resultMap["skipped"])
 	}
 }
 
@@ -35,6 +35,7 @@ var (
 		{Name: "Errors"},
 		{Name: "Created"},
 		{Name: "Selector"},
+		{Name: "Reason", Priority: 1},
 	}
 )
 
@@ -71,5 +72,9 @@ func printRestore(restore *v1.Restore, options printers.PrintOptions) ([]metav1.
 		metav1.FormatLabelSelector(restore.Spec.LabelSelector),
 	)
 
+	if options.Wide {
+		row.Cells = append(row.Cells, failureReason(restore.Status.FailureReason, restore.Status.ValidationErrors))
+	}
+
 	return []metav1.TableRow{row}, nil
 }
@@ -18,6 +18,8 @@ package output
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -32,6 +34,9 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 			phase = v1.SchedulePhaseNew
 		}
 		d.Printf("Phase:\t%s\n", phase)
+		if schedule.Spec.Paused {
+			d.Printf("Paused:\ttrue\n")
+		}
 
 		status := schedule.Status
 		if len(status.ValidationErrors) > 0 {
@@ -47,11 +52,38 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 		d.Println()
 		DescribeScheduleStatus(d, schedule.Status)
+
+		nextBackup := "<none>"
+		if schedule.Spec.Paused {
+			nextBackup = "<paused>"
+		} else if next := scheduleNextBackup(schedule); !next.IsZero() {
+			if !next.After(time.Now()) {
+				nextBackup = "now"
+			} else {
+				nextBackup = fmt.Sprintf("%v", next)
+			}
+		}
+		d.Printf("Next Backup:\t%s\n", nextBackup)
 	})
 }
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	if spec.Timezone != "" {
+		d.Printf("Timezone:\t%s\n", spec.Timezone)
+	}
+
+	verifyEvery := "<none>"
+	if spec.VerifyEvery > 0 {
+		verifyEvery = fmt.Sprintf("every %d backups", spec.VerifyEvery)
+	}
+	d.Printf("Verify:\t%s\n", verifyEvery)
+	if len(spec.Clusters) > 0 {
+		d.Printf("Clusters:\t%s\n", strings.Join(spec.Clusters, ", "))
+	}
+	if policy := spec.Retention; policy != nil {
+		d.Printf("Retention:\tlast %d, daily %d, weekly %d\n", policy.KeepLast, policy.KeepDaily, policy.KeepWeekly)
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
@@ -66,4 +98,8 @@ func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 		lastBackup = fmt.Sprintf("%v", status.LastBackup.Time)
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
+	d.Printf("Backup Count:\t%d\n", status.BackupCount)
+	if len(status.Revisions) > 0 {
+		d.Printf("Template Revision:\t%d\n", status.Revisions[len(status.Revisions)-1].Revision)
+	}
 }
@@ -0,0 +1,160 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// DescribeScheduleHistory describes the recorded revisions of a schedule's
+// backup template, newest first, with the changes that each revision made
+// to the template and the backups that were created from it.
+func DescribeScheduleHistory(schedule *v1.Schedule, backups []v1.Backup) string {
+	revisions := make(map[int]*v1.ScheduleRevision)
+	for i := range schedule.Status.Revisions {
+		revisions[schedule.Status.Revisions[i].Revision] = &schedule.Status.Revisions[i]
+	}
+
+	// backups created before revisions were recorded aren't annotated
+	// with one, and are grouped under revision 0.
+	backupsByRevision := make(map[int][]string)
+	for _, backup := range backups {
+		revision, _ := strconv.Atoi(backup.Annotations[v1.ScheduleRevisionAnnotation])
+		backupsByRevision[revision] = append(backupsByRevision[revision], backup.Name)
+	}
+
+	var numbers []int
+	for revision := range revisions {
+		numbers = append(numbers, revision)
+	}
+	for revision := range backupsByRevision {
+		if _, ok := revisions[revision]; !ok {
+			numbers = append(numbers, revision)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(numbers)))
+
+	return Describe(func(d *Describer) {
+		d.Printf("Name:\t%s\n", schedule.Name)
+		d.Printf("Namespace:\t%s\n", schedule.Namespace)
+
+		if len(numbers) == 0 {
+			d.Println()
+			d.Println("<no revisions recorded>")
+			return
+		}
+
+		for _, number := range numbers {
+			d.Println()
+
+			revision, ok := revisions[number]
+			switch {
+			case number == 0:
+				d.Println("Unrecorded revision:")
+			case number == latestRevision(schedule):
+				d.Printf("Revision %d (current):\n", number)
+			default:
+				d.Printf("Revision %d:\n", number)
+			}
+
+			if ok {
+				d.Printf("\tRecorded:\t%s\n", revision.Timestamp.Time)
+
+				if previous, ok := revisions[number-1]; ok {
+					changes := diffBackupSpecs(previous.Template, revision.Template)
+					if len(changes) == 0 {
+						changes = []string{"<none>"}
+					}
+					d.DescribeSlice(1, "Changes", changes)
+				} else {
+					d.Printf("\tChanges:\t<previous revision not recorded>\n")
+				}
+			} else if number > 0 {
+				d.Printf("\tRecorded:\t<no longer recorded>\n")
+			}
+
+			names := backupsByRevision[number]
+			sort.Strings(names)
+			if len(names) == 0 {
+				names = []string{"<none>"}
+			}
+			d.DescribeSlice(1, "Backups", names)
+		}
+	})
+}
+
+// latestRevision returns the number of the latest recorded revision of a
+// schedule's backup template, or 0 if none has been recorded.
+func latestRevision(schedule *v1.Schedule) int {
+	if len(schedule.Status.Revisions) == 0 {
+		return 0
+	}
+	return schedule.Status.Revisions[len(schedule.Status.Revisions)-1].Revision
+}
+
+// diffBackupSpecs returns the fields of a backup spec that differ between
+// old and new, in the form "field: old -> new" with values in JSON.
+func diffBackupSpecs(old, new v1.BackupSpec) []string {
+	oldFields, newFields := backupSpecFields(old), backupSpecFields(new)
+
+	names := make(map[string]bool)
+	for name := range oldFields {
+		names[name] = true
+	}
+	for name := range newFields {
+		names[name] = true
+	}
+
+	var changes []string
+	for name := range names {
+		oldValue, newValue := oldFields[name], newFields[name]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s: %s -> %s", name, fieldString(oldValue), fieldString(newValue)))
+	}
+	sort.Strings(changes)
+
+	return changes
+}
+
+// backupSpecFields returns the top-level fields of a backup spec as they're
+// serialized to JSON.
+func backupSpecFields(spec v1.BackupSpec) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if data, err := json.Marshal(spec); err == nil {
+		json.Unmarshal(data, &fields)
+	}
+	return fields
+}
+
+func fieldString(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestDiffBackupSpecs(t *testing.T) {
+	old := v1.BackupSpec{
+		IncludedNamespaces: []string{"ns-1"},
+		StorageLocation:    "default",
+		TTL:                metav1.Duration{Duration: time.Hour},
+	}
+	snapshotVolumes := false
+	new := v1.BackupSpec{
+		IncludedNamespaces: []string{"ns-1", "ns-2"},
+		TTL:                metav1.Duration{Duration: time.Hour},
+		SnapshotVolumes:    &snapshotVolumes,
+	}
+
+	assert.Equal(t, []string{
+		`includedNamespaces: ["ns-1"] -> ["ns-1","ns-2"]`,
+		`snapshotVolumes: <none> -> false`,
+		`storageLocation: "default" -> <none>`,
+	}, diffBackupSpecs(old, new))
+
+	assert.Empty(t, diffBackupSpecs(old, old))
+}
+
+func TestDescribeScheduleHistory(t *testing.T) {
+	recorded := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	schedule := builder.ForSchedule("velero", "daily").Revisions(
+		v1.ScheduleRevision{Revision: 2, Timestamp: metav1.NewTime(recorded), Template: v1.BackupSpec{IncludedNamespaces: []string{"ns-1"}}},
+		v1.ScheduleRevision{Revision: 3, Timestamp: metav1.NewTime(recorded.Add(time.Hour)), Template: v1.BackupSpec{IncludedNamespaces: []string{"ns-2"}}},
+	).Result()
+
+	backups := []v1.Backup{
+		*builder.ForBackup("velero", "daily-3").ObjectMeta(builder.WithAnnotations(v1.ScheduleRevisionAnnotation, "3")).Result(),
+		*builder.ForBackup("velero", "daily-2").ObjectMeta(builder.WithAnnotations(v1.ScheduleRevisionAnnotation, "2")).Result(),
+		*builder.ForBackup("velero", "daily-1").ObjectMeta(builder.WithAnnotations(v1.ScheduleRevisionAnnotation, "1")).Result(),
+		*builder.ForBackup("velero", "daily-0").Result(),
+	}
+
+	want := `Name:       daily
+Namespace:  velero
+
+Revision 3 (current):
+  Recorded:  2020-01-01 13:00:00 +0000 UTC
+  Changes:   includedNamespaces: ["ns-1"] -> ["ns-2"]
+  Backups:   daily-3
+
+Revision 2:
+  Recorded:  2020-01-01 12:00:00 +0000 UTC
+  Changes:   <previous revision not recorded>
+  Backups:   daily-2
+
+Revision 1:
+  Recorded:  <no longer recorded>
+  Backups:   daily-1
+
+Unrecorded revision:
+  Backups:  daily-0
+`
+
+	assert.Equal(t, want, DescribeScheduleHistory(schedule, backups))
+}
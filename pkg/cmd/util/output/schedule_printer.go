@@ -17,11 +17,15 @@ limitations under the License.
 package output
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/kubernetes/pkg/printers"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 )
 
 var (
@@ -34,6 +38,8 @@ var (
 		{Name: "Schedule"},
 		{Name: "Backup TTL"},
 		{Name: "Last Backup"},
+		{Name: "Next Backup"},
+		{Name: "Paused"},
 		{Name: "Selector"},
 	}
 )
@@ -68,8 +74,46 @@ func printSchedule(schedule *v1.Schedule, options printers.PrintOptions) ([]meta
 		schedule.Spec.Schedule,
 		schedule.Spec.Template.TTL.Duration,
 		humanReadableTimeFromNow(schedule.Status.LastBackup.Time),
+		humanReadableNextBackup(scheduleNextBackup(schedule), time.Now()),
+		schedule.Spec.Paused,
 		metav1.FormatLabelSelector(schedule.Spec.Template.LabelSelector),
 	)
 
 	return []metav1.TableRow{row}, nil
 }
+
+// scheduleNextBackup returns when a schedule next creates a backup, which is
+// the zero time if it's paused or invalid. Like the schedule controller, it
+// runs the schedule's cron expression from its last backup, so a schedule
+// that's never run, or missed runs, is due immediately. Schedules without a
+// timezone are evaluated in the local time zone, which is only the Velero
+// server's if it's the same.
+func scheduleNextBackup(schedule *v1.Schedule) time.Time {
+	if schedule.Spec.Paused || schedule.Status.Phase == v1.SchedulePhaseFailedValidation {
+		return time.Time{}
+	}
+
+	cronSchedule, err := pkgbackup.ParseCronSchedule(schedule.Spec.Schedule)
+	if err != nil {
+		return time.Time{}
+	}
+	location, err := pkgbackup.ParseTimezone(schedule.Spec.Timezone)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return pkgbackup.InLocation(cronSchedule, location).Next(schedule.Status.LastBackup.Time)
+}
+
+// humanReadableNextBackup returns how long it is until a schedule's next
+// backup, "now" if it's due, or "n/a" if it has none.
+func humanReadableNextBackup(next, now time.Time) string {
+	switch {
+	case next.IsZero():
+		return "n/a"
+	case !next.After(now):
+		return "now"
+	default:
+		return duration.ShortHumanDuration(next.Sub(now))
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestScheduleNextBackup(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule *v1.Schedule
+		want     time.Time
+	}{
+		{
+			name:     "next run after the last backup",
+			schedule: builder.ForSchedule("velero", "daily").CronSchedule("0 3 * * *").Timezone("UTC").LastBackupTime("2020-01-01 03:00:00").Result(),
+			want:     time.Date(2020, 1, 2, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "next run is in the schedule's timezone",
+			schedule: builder.ForSchedule("velero", "daily").CronSchedule("0 3 * * *").Timezone("America/New_York").LastBackupTime("2020-01-01 09:00:00").Result(),
+			want:     time.Date(2020, 1, 2, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "paused schedules have no next run",
+			schedule: builder.ForSchedule("velero", "daily").CronSchedule("0 3 * * *").LastBackupTime("2020-01-01 03:00:00").Paused(true).Result(),
+		},
+		{
+			name:     "invalid schedules have no next run",
+			schedule: builder.ForSchedule("velero", "daily").CronSchedule("not a schedule").Result(),
+		},
+		{
+			name:     "schedules that failed validation have no next run",
+			schedule: builder.ForSchedule("velero", "daily").CronSchedule("0 3 * * *").Phase(v1.SchedulePhaseFailedValidation).Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.True(t, tc.want.Equal(scheduleNextBackup(tc.schedule)), "want %v, got %v", tc.want, scheduleNextBackup(tc.schedule))
+		})
+	}
+}
+
+func TestHumanReadableNextBackup(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "n/a", humanReadableNextBackup(time.Time{}, now))
+	assert.Equal(t, "now", humanReadableNextBackup(now, now))
+	// schedules that have never run are due immediately.
+	assert.Equal(t, "now", humanReadableNextBackup(time.Date(1, 1, 1, 3, 0, 0, 0, time.UTC), now))
+	assert.Equal(t, "15h", humanReadableNextBackup(now.Add(15*time.Hour), now))
+}
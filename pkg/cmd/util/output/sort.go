@@ -0,0 +1,157 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+)
+
+// sortByFields are the fields that get commands can sort items by.
+var sortByFields = []string{"name", "created", "expires", "status"}
+
+// GetSortByValue returns the value of the "sort-by" flag
+// in the provided command, or the zero value if not present.
+func GetSortByValue(cmd *cobra.Command) string {
+	return flag.GetOptionalStringFlag(cmd, "sort-by")
+}
+
+func validateSortByFlag(cmd *cobra.Command) error {
+	sortBy := GetSortByValue(cmd)
+	if sortBy == "" {
+		return nil
+	}
+
+	for _, field := range sortByFields {
+		if sortBy == field {
+			return nil
+		}
+	}
+	return errors.Errorf("invalid --sort-by field %q, valid fields are %s", sortBy, strings.Join(sortByFields, ", "))
+}
+
+// backupExpiration returns when a backup expires, computing it from its TTL
+// if it hasn't been processed yet. It's zero if the backup doesn't expire.
+func backupExpiration(backup *velerov1api.Backup) time.Time {
+	expiration := backup.Status.Expiration.Time
+	if expiration.IsZero() && backup.Spec.TTL.Duration > 0 {
+		expiration = backup.CreationTimestamp.Add(backup.Spec.TTL.Duration)
+	}
+	return expiration
+}
+
+// sortValue returns the value of an item's field that it's sorted by: a
+// string, or a time.
+func sortValue(item runtime.Object, sortBy string) (interface{}, error) {
+	accessor, err := meta.Accessor(item)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch sortBy {
+	case "name":
+		return accessor.GetName(), nil
+	case "created":
+		return accessor.GetCreationTimestamp().Time, nil
+	case "expires":
+		backup, ok := item.(*velerov1api.Backup)
+		if !ok {
+			return nil, errors.New("only backups can be sorted by expires")
+		}
+		return backupExpiration(backup), nil
+	case "status":
+		// all of Velero's objects have their status in status.phase, and
+		// objects that haven't been processed yet are New.
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		phase, _, _ := unstructured.NestedString(content, "status", "phase")
+		if phase == "" {
+			phase = "New"
+		}
+		return phase, nil
+	}
+
+	return nil, errors.Errorf("invalid --sort-by field %q, valid fields are %s", sortBy, strings.Join(sortByFields, ", "))
+}
+
+// lessSortValue returns whether one sort value sorts before another. Times
+// that are zero, e.g. of backups that don't expire, sort last.
+func lessSortValue(a, b interface{}) bool {
+	switch a := a.(type) {
+	case string:
+		return a < b.(string)
+	case time.Time:
+		b := b.(time.Time)
+		if a.IsZero() || b.IsZero() {
+			return !a.IsZero() && b.IsZero()
+		}
+		return a.Before(b)
+	}
+	return false
+}
+
+// sortList sorts the items of a list by a field, in ascending order. Items
+// with the same value are sorted by name. Objects that aren't lists can't be
+// sorted.
+func sortList(obj runtime.Object, sortBy string) error {
+	if !meta.IsListType(obj) {
+		return errors.New("--sort-by can only be used when listing items")
+	}
+
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	values := make(map[runtime.Object]interface{}, len(items))
+	names := make(map[runtime.Object]string, len(items))
+	for _, item := range items {
+		value, err := sortValue(item, sortBy)
+		if err != nil {
+			return err
+		}
+		values[item] = value
+
+		name, _ := sortValue(item, "name")
+		names[item] = name.(string)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := values[items[i]], values[items[j]]
+		if lessSortValue(a, b) {
+			return true
+		}
+		if lessSortValue(b, a) {
+			return false
+		}
+		return names[items[i]] < names[items[j]]
+	})
+
+	return errors.WithStack(meta.SetList(obj, items))
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestSortList(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	newList := func() *velerov1api.BackupList {
+		return &velerov1api.BackupList{
+			Items: []velerov1api.Backup{
+				*builder.ForBackup("velero", "backup-b").Phase(velerov1api.BackupPhaseFailed).TTL(time.Hour).
+					ObjectMeta(builder.WithCreationTimestamp(now.Add(-1 * time.Hour))).Result(),
+				*builder.ForBackup("velero", "backup-c").Phase(velerov1api.BackupPhaseCompleted).
+					ObjectMeta(builder.WithCreationTimestamp(now.Add(-3 * time.Hour))).Result(),
+				*builder.ForBackup("velero", "backup-a").Expiration(now.Add(24 * time.Hour)).
+					ObjectMeta(builder.WithCreationTimestamp(now.Add(-2 * time.Hour))).Result(),
+				*builder.ForBackup("velero", "backup-d").Phase(velerov1api.BackupPhaseCompleted).
+					ObjectMeta(builder.WithCreationTimestamp(now.Add(-4 * time.Hour))).Result(),
+			},
+		}
+	}
+
+	tests := []struct {
+		sortBy string
+		want   []string
+	}{
+		{
+			sortBy: "name",
+			want:   []string{"backup-a", "backup-b", "backup-c", "backup-d"},
+		},
+		{
+			sortBy: "created",
+			want:   []string{"backup-d", "backup-c", "backup-a", "backup-b"},
+		},
+		{
+			// backup-b expires after its TTL, and backups that don't expire are last.
+			sortBy: "expires",
+			want:   []string{"backup-b", "backup-a", "backup-c", "backup-d"},
+		},
+		{
+			// backups without a phase are New.
+			sortBy: "status",
+			want:   []string{"backup-c", "backup-d", "backup-b", "backup-a"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.sortBy, func(t *testing.T) {
+			list := newList()
+			require.NoError(t, sortList(list, tc.sortBy))
+
+			var names []string
+			for _, backup := range list.Items {
+				names = append(names, backup.Name)
+			}
+			assert.Equal(t, tc.want, names)
+		})
+	}
+}
+
+func TestSortListErrors(t *testing.T) {
+	restores := &velerov1api.RestoreList{
+		Items: []velerov1api.Restore{*builder.ForRestore("velero", "restore-1").Result()},
+	}
+	assert.EqualError(t, sortList(restores, "expires"), "only backups can be sorted by expires")
+
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	assert.EqualError(t, sortList(backup, "name"), "--sort-by can only be used when listing items")
+
+	assert.EqualError(t, sortList(&velerov1api.BackupList{Items: []velerov1api.Backup{*backup}}, "size"),
+		`invalid --sort-by field "size", valid fields are name, created, expires, status`)
+}
+
+func TestBackupExpiration(t *testing.T) {
+	created := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	backup := builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithCreationTimestamp(created)).Result()
+	assert.True(t, backupExpiration(backup).IsZero())
+
+	backup.Spec.TTL = metav1.Duration{Duration: time.Hour}
+	assert.Equal(t, created.Add(time.Hour), backupExpiration(backup))
+
+	backup.Status.Expiration = metav1.NewTime(created.Add(2 * time.Hour))
+	assert.Equal(t, created.Add(2*time.Hour), backupExpiration(backup))
+}
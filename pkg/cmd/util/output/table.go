@@ -0,0 +1,204 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+)
+
+const (
+	// tableMinWidth and tablePadding match the Kubernetes table printer's
+	// tab writer, so that tables are laid out the same way as kubectl's.
+	tableMinWidth = 6
+	tablePadding  = 3
+
+	colorGreen  = "\x1b[32m"
+	colorRed    = "\x1b[31m"
+	colorYellow = "\x1b[33m"
+	colorReset  = "\x1b[0m"
+)
+
+// statusColors are the colors of statuses when coloring is enabled. Statuses
+// that aren't in the map aren't colored.
+var statusColors = map[string]string{
+	"Completed":        colorGreen,
+	"Failed":           colorRed,
+	"PartiallyFailed":  colorRed,
+	"FailedValidation": colorRed,
+	"InProgress":       colorYellow,
+	"Available":        colorGreen,
+	"Unavailable":      colorRed,
+}
+
+// normalizeColumnName returns a column name in the form that's used to select
+// columns, e.g. "storage-location" for "Storage Location".
+func normalizeColumnName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(strings.Replace(name, "-", " ", -1)), "-"))
+}
+
+// selectColumns removes the columns of a table that aren't in columns, and
+// orders the remaining ones as they're ordered in columns. Columns are matched
+// by name, case-insensitively, with dashes matching spaces. An empty columns
+// selects all columns.
+func selectColumns(table *metav1beta1.Table, columns []string) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	indexes := make(map[string]int)
+	var valid []string
+	for i, column := range table.ColumnDefinitions {
+		name := normalizeColumnName(column.Name)
+		indexes[name] = i
+		valid = append(valid, name)
+	}
+
+	var selected []int
+	for _, column := range columns {
+		i, ok := indexes[normalizeColumnName(column)]
+		if !ok {
+			return errors.Errorf("invalid column %q, valid columns are %s", column, strings.Join(valid, ", "))
+		}
+		selected = append(selected, i)
+	}
+
+	definitions := make([]metav1beta1.TableColumnDefinition, 0, len(selected))
+	for _, i := range selected {
+		definitions = append(definitions, table.ColumnDefinitions[i])
+	}
+	table.ColumnDefinitions = definitions
+
+	for i := range table.Rows {
+		cells := make([]interface{}, 0, len(selected))
+		for _, j := range selected {
+			var cell interface{}
+			if j < len(table.Rows[i].Cells) {
+				cell = table.Rows[i].Cells[j]
+			}
+			cells = append(cells, cell)
+		}
+		table.Rows[i].Cells = cells
+	}
+
+	return nil
+}
+
+// colorizeStatuses colors the cells of a table's Status and Phase columns by
+// their status, e.g. Completed in green and Failed in red.
+func colorizeStatuses(table *metav1beta1.Table) {
+	for i, column := range table.ColumnDefinitions {
+		if column.Name != "Status" && column.Name != "Phase" {
+			continue
+		}
+
+		for _, row := range table.Rows {
+			if i >= len(row.Cells) || row.Cells[i] == nil {
+				continue
+			}
+
+			cell := fmt.Sprint(row.Cells[i])
+			fields := strings.Fields(cell)
+			if len(fields) == 0 {
+				continue
+			}
+			if color, ok := statusColors[fields[0]]; ok {
+				row.Cells[i] = color + cell + colorReset
+			}
+		}
+	}
+}
+
+// visibleWidth returns the width of a cell when printed to a terminal,
+// ignoring ANSI color codes.
+func visibleWidth(s string) int {
+	width := 0
+	for i := 0; i < len(s); {
+		if s[i] == '\x1b' {
+			if end := strings.IndexByte(s[i:], 'm'); end >= 0 {
+				i += end + 1
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		width++
+		i += size
+	}
+	return width
+}
+
+// writeTable writes a table's columns, aligned, with a header unless
+// noHeaders is true.
+func writeTable(w io.Writer, table *metav1beta1.Table, noHeaders bool) error {
+	var lines [][]string
+	if !noHeaders {
+		var header []string
+		for _, column := range table.ColumnDefinitions {
+			header = append(header, strings.ToUpper(column.Name))
+		}
+		lines = append(lines, header)
+	}
+
+	for _, row := range table.Rows {
+		var line []string
+		for i := range table.ColumnDefinitions {
+			var cell string
+			if i < len(row.Cells) && row.Cells[i] != nil {
+				cell = fmt.Sprint(row.Cells[i])
+			}
+			line = append(line, cell)
+		}
+		lines = append(lines, line)
+	}
+
+	widths := make([]int, len(table.ColumnDefinitions))
+	for _, line := range lines {
+		for i, cell := range line {
+			if width := visibleWidth(cell) + tablePadding; width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+
+	for _, line := range lines {
+		var b strings.Builder
+		for i, cell := range line {
+			b.WriteString(cell)
+			// the last column isn't padded.
+			if i < len(line)-1 {
+				width := widths[i]
+				if width < tableMinWidth {
+					width = tableMinWidth
+				}
+				b.WriteString(strings.Repeat(" ", width-visibleWidth(cell)))
+			}
+		}
+		b.WriteString("\n")
+
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/kubernetes/pkg/printers"
+)
+
+func newTestTable() *metav1beta1.Table {
+	return &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name"},
+			{Name: "Status"},
+			{Name: "Storage Location"},
+		},
+		Rows: []metav1beta1.TableRow{
+			{Cells: []interface{}{"backup-1", "Completed", "default"}},
+			{Cells: []interface{}{"a-much-longer-backup-name", "PartiallyFailed (2 errors)", "default"}},
+			{Cells: []interface{}{"backup-3", "Deleting", "secondary"}},
+		},
+	}
+}
+
+func TestWriteTableMatchesTabWriter(t *testing.T) {
+	table := newTestTable()
+
+	want := new(bytes.Buffer)
+	tw := printers.GetNewTabWriter(want)
+	fmt.Fprintln(tw, "NAME\tSTATUS\tSTORAGE LOCATION")
+	for _, row := range table.Rows {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", row.Cells...)
+	}
+	require.NoError(t, tw.Flush())
+
+	got := new(bytes.Buffer)
+	require.NoError(t, writeTable(got, table, false))
+
+	assert.Equal(t, want.String(), got.String())
+}
+
+func TestWriteTableNoHeaders(t *testing.T) {
+	got := new(bytes.Buffer)
+	require.NoError(t, writeTable(got, newTestTable(), true))
+
+	assert.Equal(t, "backup-1                    Completed                    default\n"+
+		"a-much-longer-backup-name   PartiallyFailed (2 errors)   default\n"+
+		"backup-3                    Deleting                     secondary\n", got.String())
+}
+
+func TestSelectColumns(t *testing.T) {
+	table := newTestTable()
+
+	require.NoError(t, selectColumns(table, []string{"storage-location", "NAME"}))
+
+	assert.Equal(t, []metav1beta1.TableColumnDefinition{{Name: "Storage Location"}, {Name: "Name"}}, table.ColumnDefinitions)
+	assert.Equal(t, []interface{}{"default", "backup-1"}, table.Rows[0].Cells)
+	assert.Equal(t, []interface{}{"secondary", "backup-3"}, table.Rows[2].Cells)
+
+	assert.EqualError(t, selectColumns(newTestTable(), []string{"size"}), `invalid column "size", valid columns are name, status, storage-location`)
+}
+
+func TestColorizeStatuses(t *testing.T) {
+	table := newTestTable()
+
+	colorizeStatuses(table)
+
+	assert.Equal(t, colorGreen+"Completed"+colorReset, table.Rows[0].Cells[1])
+	assert.Equal(t, colorRed+"PartiallyFailed (2 errors)"+colorReset, table.Rows[1].Cells[1])
+	assert.Equal(t, "Deleting", table.Rows[2].Cells[1])
+	assert.Equal(t, "backup-1", table.Rows[0].Cells[0])
+
+	// colors don't count towards the width of columns.
+	got := new(bytes.Buffer)
+	require.NoError(t, writeTable(got, table, true))
+	assert.Equal(t, "backup-1                    "+colorGreen+"Completed"+colorReset+"                    default\n"+
+		"a-much-longer-backup-name   "+colorRed+"PartiallyFailed (2 errors)"+colorReset+"   default\n"+
+		"backup-3                    Deleting                     secondary\n", got.String())
+}
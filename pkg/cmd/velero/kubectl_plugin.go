@@ -0,0 +1,77 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// kubectlPluginPrefix is the prefix of the names of kubectl plugins'
+// executables. kubectl runs `kubectl velero ...` by running the
+// kubectl-velero executable in the PATH, e.g. a symlink to velero that krew
+// installs.
+const kubectlPluginPrefix = "kubectl-"
+
+// isKubectlPlugin returns whether the CLI is run as a kubectl plugin, based on
+// the name of its executable.
+func isKubectlPlugin(name string) bool {
+	return strings.HasPrefix(name, kubectlPluginPrefix)
+}
+
+// kubectlCommandPath returns a command path or usage line as it's typed when
+// the CLI is run as a kubectl plugin, e.g. "kubectl velero backup get" for
+// "kubectl-velero backup get".
+func kubectlCommandPath(path string) string {
+	if !isKubectlPlugin(path) {
+		return path
+	}
+	return "kubectl " + strings.TrimPrefix(path, kubectlPluginPrefix)
+}
+
+// kubectlExample returns a command's example with the velero commands in it
+// replaced by their kubectl plugin equivalents.
+func kubectlExample(example string) string {
+	lines := strings.Split(example, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+		if strings.HasPrefix(trimmed, "velero ") {
+			lines[i] = line[:len(line)-len(trimmed)] + "kubectl " + trimmed
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// useKubectlPluginUsage makes a command and its subcommands show their usage
+// and examples as they're typed when the CLI is run as a kubectl plugin.
+func useKubectlPluginUsage(c *cobra.Command) {
+	cobra.AddTemplateFunc("kubectlCommandPath", kubectlCommandPath)
+	c.SetUsageTemplate(strings.NewReplacer(
+		"{{.UseLine}}", "{{kubectlCommandPath .UseLine}}",
+		"{{.CommandPath}}", "{{kubectlCommandPath .CommandPath}}",
+	).Replace(c.UsageTemplate()))
+
+	var setExamples func(*cobra.Command)
+	setExamples = func(c *cobra.Command) {
+		c.Example = kubectlExample(c.Example)
+		for _, sub := range c.Commands() {
+			setExamples(sub)
+		}
+	}
+	setExamples(c)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsKubectlPlugin(t *testing.T) {
+	assert.True(t, isKubectlPlugin("kubectl-velero"))
+	assert.False(t, isKubectlPlugin("velero"))
+	assert.False(t, isKubectlPlugin("kubectl"))
+}
+
+func TestKubectlCommandPath(t *testing.T) {
+	assert.Equal(t, "kubectl velero backup get", kubectlCommandPath("kubectl-velero backup get"))
+	assert.Equal(t, "kubectl velero restore create [RESTORE_NAME] [flags]", kubectlCommandPath("kubectl-velero restore create [RESTORE_NAME] [flags]"))
+	assert.Equal(t, "velero backup get", kubectlCommandPath("velero backup get"))
+}
+
+func TestKubectlExample(t *testing.T) {
+	example := `	# Create a backup containing all resources.
+	velero backup create backup1
+
+	# View the YAML for a backup that doesn't snapshot volumes, without sending it to the server.
+	velero backup create backup3 --snapshot-volumes=false -o yaml
+	  velero backup get
+	kubectl get backups`
+
+	want := `	# Create a backup containing all resources.
+	kubectl velero backup create backup1
+
+	# View the YAML for a backup that doesn't snapshot volumes, without sending it to the server.
+	kubectl velero backup create backup3 --snapshot-volumes=false -o yaml
+	  kubectl velero backup get
+	kubectl get backups`
+
+	assert.Equal(t, want, kubectlExample(example))
+}
+
+func TestKubectlPluginUsage(t *testing.T) {
+	c := NewCommand("kubectl-velero")
+
+	backup, _, err := c.Find([]string{"backup", "create"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	usage := backup.UsageString()
+	assert.Contains(t, usage, "kubectl velero backup create NAME [flags]")
+	assert.NotContains(t, usage, "kubectl-velero")
+	assert.Contains(t, usage, "\tkubectl velero backup create backup1")
+}
@@ -25,6 +25,7 @@ import (
 	"k8s.io/klog"
 
 	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backuplocation"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/bug"
@@ -40,6 +41,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restore"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/schedule"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/snapshotlocation"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/uninstall"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/version"
 	"github.com/vmware-tanzu/velero/pkg/cmd/server"
 	runplugin "github.com/vmware-tanzu/velero/pkg/cmd/server/plugin"
@@ -83,6 +85,8 @@ operations can also be performed as 'velero backup get' and 'velero schedule cre
 	// Bind features directly to the root command so it's available to all callers.
 	c.PersistentFlags().Var(&cmdFeatures, "features", "Comma-separated list of features to enable for this Velero process. Combines with values from $HOME/.config/velero/config.json if present")
 
+	cmd.BindErrorFlags(c.PersistentFlags())
+
 	c.AddCommand(
 		backup.NewCommand(f),
 		schedule.NewCommand(f),
@@ -91,6 +95,7 @@ operations can also be performed as 'velero backup get' and 'velero schedule cre
 		version.NewCommand(f),
 		get.NewCommand(f),
 		install.NewCommand(f),
+		uninstall.NewCommand(f),
 		describe.NewCommand(f),
 		create.NewCommand(f),
 		runplugin.NewCommand(f),
@@ -108,5 +113,9 @@ operations can also be performed as 'velero backup get' and 'velero schedule cre
 	klog.InitFlags(flag.CommandLine)
 	c.PersistentFlags().AddGoFlagSet(flag.CommandLine)
 
+	if isKubectlPlugin(name) {
+		useKubectlPluginUsage(c)
+	}
+
 	return c
 }
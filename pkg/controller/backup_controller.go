@@ -26,26 +26,34 @@ import (
 	"os"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/membercluster"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/storage"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -56,21 +64,32 @@ import (
 type backupController struct {
 	*genericController
 
-	backupper                pkgbackup.Backupper
-	lister                   listers.BackupLister
-	client                   velerov1client.BackupsGetter
-	clock                    clock.Clock
-	backupLogLevel           logrus.Level
-	newPluginManager         func(logrus.FieldLogger) clientmgmt.Manager
-	backupTracker            BackupTracker
-	backupLocationLister     listers.BackupStorageLocationLister
-	defaultBackupLocation    string
-	defaultBackupTTL         time.Duration
-	snapshotLocationLister   listers.VolumeSnapshotLocationLister
-	defaultSnapshotLocations map[string]string
-	metrics                  *metrics.ServerMetrics
-	newBackupStore           func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
-	formatFlag               logging.Format
+	backupper                 pkgbackup.Backupper
+	lister                    listers.BackupLister
+	client                    velerov1client.BackupsGetter
+	clock                     clock.Clock
+	backupLogLevel            logrus.Level
+	newPluginManager          func(logrus.FieldLogger) clientmgmt.Manager
+	backupTracker             BackupTracker
+	backupLocationLister      listers.BackupStorageLocationLister
+	backupQuotaLister         listers.BackupQuotaLister
+	defaultBackupLocation     string
+	defaultBackupTTL          time.Duration
+	defaultPodVolumeSelectors []metav1.LabelSelector
+	defaultVolumesToRestic    bool
+	defaultMaxItems           int
+	defaultMaxBytes           int64
+	protectedNamespaces       []string
+	writeQuorum               int
+	snapshotLocationLister    listers.VolumeSnapshotLocationLister
+	defaultSnapshotLocations  map[string]string
+	metrics                   *metrics.ServerMetrics
+	newBackupStore            func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	formatFlag                logging.Format
+	newCorrelationID          func() string
+	clusterIdentity           kubeutil.ClusterIdentity
+	memberClusters            membercluster.Registry
+	newMemberClusterBackupper func(*rest.Config) (pkgbackup.Backupper, error)
 }
 
 func NewBackupController(
@@ -84,65 +103,77 @@ func NewBackupController(
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	defaultBackupLocation string,
 	defaultBackupTTL time.Duration,
+	defaultPodVolumeSelectors []metav1.LabelSelector,
+	defaultVolumesToRestic bool,
+	defaultMaxItems int,
+	defaultMaxBytes int64,
+	protectedNamespaces []string,
+	writeQuorum int,
+	backupQuotaInformer informers.BackupQuotaInformer,
 	volumeSnapshotLocationInformer informers.VolumeSnapshotLocationInformer,
 	defaultSnapshotLocations map[string]string,
 	metrics *metrics.ServerMetrics,
 	formatFlag logging.Format,
+	clusterIdentity kubeutil.ClusterIdentity,
+	memberClusters membercluster.Registry,
+	newMemberClusterBackupper func(*rest.Config) (pkgbackup.Backupper, error),
 ) Interface {
 	c := &backupController{
-		genericController:        newGenericController("backup", logger),
-		backupper:                backupper,
-		lister:                   backupInformer.Lister(),
-		client:                   client,
-		clock:                    &clock.RealClock{},
-		backupLogLevel:           backupLogLevel,
-		newPluginManager:         newPluginManager,
-		backupTracker:            backupTracker,
-		backupLocationLister:     backupLocationInformer.Lister(),
-		defaultBackupLocation:    defaultBackupLocation,
-		defaultBackupTTL:         defaultBackupTTL,
-		snapshotLocationLister:   volumeSnapshotLocationInformer.Lister(),
-		defaultSnapshotLocations: defaultSnapshotLocations,
-		metrics:                  metrics,
-		formatFlag:               formatFlag,
-
-		newBackupStore: persistence.NewObjectBackupStore,
+		genericController:         newGenericController("backup", logger),
+		backupper:                 backupper,
+		lister:                    backupInformer.Lister(),
+		client:                    client,
+		clock:                     &clock.RealClock{},
+		backupLogLevel:            backupLogLevel,
+		newPluginManager:          newPluginManager,
+		backupTracker:             backupTracker,
+		backupLocationLister:      backupLocationInformer.Lister(),
+		backupQuotaLister:         backupQuotaInformer.Lister(),
+		defaultBackupLocation:     defaultBackupLocation,
+		defaultBackupTTL:          defaultBackupTTL,
+		defaultPodVolumeSelectors: defaultPodVolumeSelectors,
+		defaultVolumesToRestic:    defaultVolumesToRestic,
+		defaultMaxItems:           defaultMaxItems,
+		defaultMaxBytes:           defaultMaxBytes,
+		protectedNamespaces:       protectedNamespaces,
+		writeQuorum:               writeQuorum,
+		snapshotLocationLister:    volumeSnapshotLocationInformer.Lister(),
+		defaultSnapshotLocations:  defaultSnapshotLocations,
+		metrics:                   metrics,
+		formatFlag:                formatFlag,
+		clusterIdentity:           clusterIdentity,
+		memberClusters:            memberClusters,
+		newMemberClusterBackupper: newMemberClusterBackupper,
+
+		newBackupStore:   persistence.NewObjectBackupStore,
+		newCorrelationID: logging.NewCorrelationID,
 	}
 
 	c.syncHandler = c.processBackup
 	c.cacheSyncWaiters = append(c.cacheSyncWaiters,
 		backupInformer.Informer().HasSynced,
 		backupLocationInformer.Informer().HasSynced,
+		backupQuotaInformer.Informer().HasSynced,
 		volumeSnapshotLocationInformer.Informer().HasSynced,
 	)
 	c.resyncFunc = c.resync
 	c.resyncPeriod = time.Minute
 
-	backupInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				backup := obj.(*velerov1api.Backup)
-
-				switch backup.Status.Phase {
-				case "", velerov1api.BackupPhaseNew:
-					// only process new backups
-				default:
-					c.logger.WithFields(logrus.Fields{
-						"backup": kubeutil.NamespaceAndName(backup),
-						"phase":  backup.Status.Phase,
-					}).Debug("Backup is not new, skipping")
-					return
-				}
-
-				key, err := cache.MetaNamespaceKeyFunc(backup)
-				if err != nil {
-					c.logger.WithError(err).WithField("backup", backup).Error("Error creating queue key, item not added to queue")
-					return
-				}
-				c.queue.Add(key)
-			},
-		},
-	)
+	c.watch(backupInformer.Informer(), onCreate(func(obj runtime.Object) bool {
+		backup := obj.(*velerov1api.Backup)
+
+		switch backup.Status.Phase {
+		case "", velerov1api.BackupPhaseNew:
+			// only process new backups
+			return true
+		default:
+			c.logger.WithFields(logrus.Fields{
+				"backup": kubeutil.NamespaceAndName(backup),
+				"phase":  backup.Status.Phase,
+			}).Debug("Backup is not new, skipping")
+			return false
+		}
+	}))
 
 	return c
 }
@@ -157,7 +188,7 @@ func (c *backupController) resync() {
 }
 
 func (c *backupController) processBackup(key string) error {
-	log := c.logger.WithField("key", key)
+	var log logrus.FieldLogger = c.logger.WithField("key", key)
 
 	log.Debug("Running processBackup")
 	ns, name, err := cache.SplitMetaNamespaceKey(key)
@@ -191,8 +222,13 @@ func (c *backupController) processBackup(key string) error {
 		return nil
 	}
 
+	span := tracing.StartSpan(nil, "processBackup", trace.StringAttribute("backup", key))
+	defer span.End()
+
 	log.Debug("Preparing backup request")
 	request := c.prepareBackupRequest(original)
+	request.Span = span
+	log = logging.WithCorrelationID(log, request.Backup)
 
 	if len(request.Status.ValidationErrors) > 0 {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
@@ -232,6 +268,12 @@ func (c *backupController) processBackup(key string) error {
 		// result in the backup being Failed.
 		log.WithError(err).Error("backup failed")
 		request.Status.Phase = velerov1api.BackupPhaseFailed
+		request.Status.FailureReason = err.Error()
+	}
+
+	span.AddAttributes(trace.StringAttribute("phase", string(request.Status.Phase)))
+	if request.Status.Phase != velerov1api.BackupPhaseCompleted {
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: fmt.Sprintf("backup phase is %s", request.Status.Phase)})
 	}
 
 	switch request.Status.Phase {
@@ -252,22 +294,11 @@ func (c *backupController) processBackup(key string) error {
 }
 
 func patchBackup(original, updated *velerov1api.Backup, client velerov1client.BackupsGetter) (*velerov1api.Backup, error) {
-	origBytes, err := json.Marshal(original)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling original backup")
-	}
-
-	updatedBytes, err := json.Marshal(updated)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling updated backup")
-	}
-
-	patchBytes, err := jsonpatch.CreateMergePatch(origBytes, updatedBytes)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating json merge patch for backup")
-	}
-
-	res, err := client.Backups(original.Namespace).Patch(original.Name, types.MergePatchType, patchBytes)
+	var res *velerov1api.Backup
+	err := kubeutil.Patch(original, updated, kubeutil.PatchOptions{}, func(patchType types.PatchType, data []byte) (err error) {
+		res, err = client.Backups(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error patching backup")
 	}
@@ -280,8 +311,40 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		Backup: backup.DeepCopy(), // don't modify items in the cache
 	}
 
-	// set backup version
+	for _, step := range c.backupRequestSteps() {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, step.Prepare(request)...)
+	}
+
+	return request
+}
+
+// backupRequestSteps returns the steps that prepare a backup request to be
+// run, in the order they're run in: the server's own steps, followed by the
+// steps registered with pkgbackup.RegisterRequestStep.
+func (c *backupController) backupRequestSteps() []pkgbackup.RequestStep {
+	steps := []pkgbackup.RequestStep{
+		{Name: "defaults", Prepare: c.setBackupDefaults},
+		{Name: "restic", Prepare: c.setResticDefaults},
+		{Name: "limits", Prepare: c.setBackupLimits},
+		{Name: "storage-location", Prepare: c.setStorageLocation},
+		{Name: "cluster", Prepare: c.setBackupCluster},
+		{Name: "correlation-id", Prepare: c.setCorrelationID},
+		{Name: "protected-namespaces", Prepare: c.protectNamespaces},
+		{Name: "spec", Prepare: c.validateBackupSpec},
+		{Name: "storage-locations", Prepare: c.validateStorageLocations},
+		{Name: "quotas", Prepare: c.validateQuotas},
+		{Name: "snapshot-locations", Prepare: c.validateSnapshotLocations},
+	}
+
+	return append(steps, pkgbackup.RegisteredRequestSteps()...)
+}
+
+// setBackupDefaults sets the backup's version, the version of Velero that
+// created it, its default TTL and its expiration.
+func (c *backupController) setBackupDefaults(request *pkgbackup.Request) []string {
+	// set backup version, and the version of Velero that created it
 	request.Status.Version = pkgbackup.BackupVersion
+	request.Status.VeleroVersion = buildinfo.Version
 
 	if request.Spec.TTL.Duration == 0 {
 		// set default backup TTL
@@ -291,9 +354,60 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 	// calculate expiration
 	request.Status.Expiration = metav1.NewTime(c.clock.Now().Add(request.Spec.TTL.Duration))
 
-	// default storage location if not specified
+	// calculate log expiration, if the logs have a separate TTL
+	if request.Spec.LogTTL.Duration > 0 {
+		logsExpiration := metav1.NewTime(c.clock.Now().Add(request.Spec.LogTTL.Duration))
+		request.Status.LogsExpiration = &logsExpiration
+	}
+
+	return nil
+}
+
+// setResticDefaults sets the server's defaults for backing up pod volumes
+// with restic.
+func (c *backupController) setResticDefaults(request *pkgbackup.Request) []string {
+	if len(request.Spec.PodVolumeBackupSelectors) == 0 {
+		// set default pod volume backup selectors
+		request.Spec.PodVolumeBackupSelectors = c.defaultPodVolumeSelectors
+	}
+
+	if request.Spec.DefaultVolumesToRestic == nil {
+		// set the default for backing up pod volumes with restic
+		request.Spec.DefaultVolumesToRestic = &c.defaultVolumesToRestic
+	}
+
+	return nil
+}
+
+// setBackupLimits sets the server's default limits on the number of items
+// in the backup and the size of its tarball.
+func (c *backupController) setBackupLimits(request *pkgbackup.Request) []string {
+	if request.Spec.MaxItems == nil {
+		// set the default maximum number of items
+		maxItems := c.defaultMaxItems
+		request.Spec.MaxItems = &maxItems
+	}
+
+	if request.Spec.MaxBytes == nil {
+		// set the default maximum tarball size
+		maxBytes := c.defaultMaxBytes
+		request.Spec.MaxBytes = &maxBytes
+	}
+
+	return nil
+}
+
+// setStorageLocation defaults the backup's storage location, and labels
+// the backup with it.
+func (c *backupController) setStorageLocation(request *pkgbackup.Request) []string {
+	// default storage location if not specified, preferring the location
+	// that's annotated as the default over the server's default
 	if request.Spec.StorageLocation == "" {
-		request.Spec.StorageLocation = c.defaultBackupLocation
+		locations, err := c.backupLocationLister.BackupStorageLocations(request.Namespace).List(labels.Everything())
+		if err != nil {
+			c.logger.WithError(errors.WithStack(err)).Warn("Error listing backup storage locations, using the server's default location")
+		}
+		request.Spec.StorageLocation = storage.DefaultBackupLocationName(locations, c.defaultBackupLocation)
 	}
 
 	// add the storage location as a label for easy filtering later.
@@ -302,54 +416,262 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 	}
 	request.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(request.Spec.StorageLocation)
 
-	// validate the included/excluded resources
-	for _, err := range collections.ValidateIncludesExcludes(request.Spec.IncludedResources, request.Spec.ExcludedResources) {
-		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists: %v", err))
+	return nil
+}
+
+// setBackupCluster labels the backup with the identity of the cluster that
+// it's taken in, and validates the member cluster that it backs up, if any.
+func (c *backupController) setBackupCluster(request *pkgbackup.Request) []string {
+	if request.Labels == nil {
+		request.Labels = make(map[string]string)
+	}
+
+	// label the backup with the identity of the cluster it's taken in, so that
+	// restores into other clusters can tell where it came from.
+	if c.clusterIdentity.Name != "" {
+		request.Labels[velerov1api.SourceClusterNameLabel] = c.clusterIdentity.Name
 	}
+	if c.clusterIdentity.UID != "" {
+		request.Labels[velerov1api.SourceClusterUIDLabel] = c.clusterIdentity.UID
+	}
+
+	if request.Spec.Cluster == "" {
+		return nil
+	}
+
+	// backups of member clusters are labeled with the member cluster's
+	// name instead, since they aren't taken in this cluster.
+	request.Labels[velerov1api.MemberClusterLabel] = label.GetValidName(request.Spec.Cluster)
+	request.Labels[velerov1api.SourceClusterNameLabel] = label.GetValidName(request.Spec.Cluster)
+	delete(request.Labels, velerov1api.SourceClusterUIDLabel)
 
-	// validate the included/excluded namespaces
-	for _, err := range collections.ValidateIncludesExcludes(request.Spec.IncludedNamespaces, request.Spec.ExcludedNamespaces) {
-		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
+	if c.memberClusters == nil {
+		return []string{fmt.Sprintf("backup of member cluster %s can't be created because this server doesn't back up member clusters", request.Spec.Cluster)}
 	}
+	if _, err := c.memberClusters.RESTConfig(request.Spec.Cluster); err != nil {
+		return []string{err.Error()}
+	}
+
+	return nil
+}
+
+// setCorrelationID gives the backup a correlation ID so that the logs of
+// everything that's done for it can be tied together.
+func (c *backupController) setCorrelationID(request *pkgbackup.Request) []string {
+	if request.Annotations[velerov1api.CorrelationIDAnnotation] == "" {
+		if request.Annotations == nil {
+			request.Annotations = make(map[string]string)
+		}
+		request.Annotations[velerov1api.CorrelationIDAnnotation] = c.newCorrelationID()
+	}
+
+	return nil
+}
+
+// protectNamespaces excludes the protected namespaces from the backup.
+func (c *backupController) protectNamespaces(request *pkgbackup.Request) []string {
+	return protectBackupNamespaces(&request.Spec, c.protectedNamespaces)
+}
+
+// validateBackupSpec validates the included/excluded resources and
+// namespaces, selectors and TTLs of the backup.
+func (c *backupController) validateBackupSpec(request *pkgbackup.Request) []string {
+	return pkgbackup.ValidateSpec(request.Spec)
+}
+
+// validateStorageLocations validates the backup's storage location and
+// additional storage locations, and stores their BackupStorageLocation API
+// objs on the request.
+func (c *backupController) validateStorageLocations(request *pkgbackup.Request) []string {
+	var errs []string
 
 	// validate the storage location, and store the BackupStorageLocation API obj on the request
 	if storageLocation, err := c.backupLocationLister.BackupStorageLocations(request.Namespace).Get(request.Spec.StorageLocation); err != nil {
 		if apierrors.IsNotFound(err) {
-			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("a BackupStorageLocation CRD with the name specified in the backup spec needs to be created before this backup can be executed. Error: %v", err))
+			errs = append(errs, fmt.Sprintf("a BackupStorageLocation CRD with the name specified in the backup spec needs to be created before this backup can be executed. Error: %v", err))
 		} else {
-			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting backup storage location: %v", err))
+			errs = append(errs, fmt.Sprintf("error getting backup storage location: %v", err))
 		}
 	} else {
-		request.StorageLocation = storageLocation
+		request.StorageLocation = persistence.MemberClusterLocation(storageLocation, request.Spec.Cluster)
 
 		if request.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
-			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+			errs = append(errs,
 				fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
 		}
+
+		if storageLocation.Status.Phase == velerov1api.BackupStorageLocationPhaseUnavailable {
+			errs = append(errs,
+				fmt.Sprintf("backup can't be created because backup storage location %s is unavailable: %s", storageLocation.Name, storageLocation.Status.Message))
+		}
 	}
 
-	// validate and get the backup's VolumeSnapshotLocations, and store the
-	// VolumeSnapshotLocation API objs on the request
-	if locs, errs := c.validateAndGetSnapshotLocations(request.Backup); len(errs) > 0 {
-		request.Status.ValidationErrors = append(request.Status.ValidationErrors, errs...)
-	} else {
-		request.Spec.VolumeSnapshotLocations = nil
-		for _, loc := range locs {
-			request.Spec.VolumeSnapshotLocations = append(request.Spec.VolumeSnapshotLocations, loc.Name)
-			request.SnapshotLocations = append(request.SnapshotLocations, loc)
+	// validate the additional storage locations, and store the BackupStorageLocation API objs on the request
+	for _, locationName := range request.Spec.AdditionalStorageLocations {
+		if locationName == request.Spec.StorageLocation {
+			errs = append(errs, fmt.Sprintf("additional storage location %s is the backup's storage location", locationName))
+			continue
+		}
+
+		location, err := c.backupLocationLister.BackupStorageLocations(request.Namespace).Get(locationName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Sprintf("additional storage location %s does not exist", locationName))
+			} else {
+				errs = append(errs, fmt.Sprintf("error getting additional storage location %s: %v", locationName, err))
+			}
+			continue
 		}
+
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			errs = append(errs,
+				fmt.Sprintf("backup can't be created because additional storage location %s is currently in read-only mode", location.Name))
+			continue
+		}
+
+		if location.Status.Phase == velerov1api.BackupStorageLocationPhaseUnavailable {
+			errs = append(errs,
+				fmt.Sprintf("backup can't be created because additional storage location %s is unavailable: %s", location.Name, location.Status.Message))
+			continue
+		}
+
+		request.AdditionalStorageLocations = append(request.AdditionalStorageLocations, persistence.MemberClusterLocation(location, request.Spec.Cluster))
 	}
 
-	return request
+	// validate that the backup has enough storage locations to meet the write quorum
+	if locations := 1 + len(request.Spec.AdditionalStorageLocations); locations < c.writeQuorum {
+		errs = append(errs,
+			fmt.Sprintf("backup has %d storage location(s), including additional storage locations, but the server requires it to be uploaded to %d", locations, c.writeQuorum))
+	}
+
+	return errs
+}
+
+// validateQuotas validates that the backup doesn't exceed any of the quotas
+// for its namespaces.
+func (c *backupController) validateQuotas(request *pkgbackup.Request) []string {
+	return c.validateBackupQuotas(request.Backup)
+}
+
+// validateSnapshotLocations validates and gets the backup's
+// VolumeSnapshotLocations, and stores the VolumeSnapshotLocation API objs on
+// the request.
+func (c *backupController) validateSnapshotLocations(request *pkgbackup.Request) []string {
+	locs, errs := c.validateAndGetSnapshotLocations(request.Backup)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	request.Spec.VolumeSnapshotLocations = nil
+	for _, loc := range locs {
+		request.Spec.VolumeSnapshotLocations = append(request.Spec.VolumeSnapshotLocations, loc.Name)
+		request.SnapshotLocations = append(request.SnapshotLocations, loc)
+	}
+
+	return nil
+}
+
+// validateBackupQuotas ensures that running the backup won't exceed the BackupQuota for
+// any of the namespaces that it includes, based on the existing backups that include
+// the same namespaces.
+func (c *backupController) validateBackupQuotas(backup *velerov1api.Backup) []string {
+	quotas, err := c.backupQuotaLister.BackupQuotas(backup.Namespace).List(labels.Everything())
+	if err != nil {
+		return []string{fmt.Sprintf("error listing backup quotas: %v", err)}
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	backups, err := c.lister.Backups(backup.Namespace).List(labels.Everything())
+	if err != nil {
+		return []string{fmt.Sprintf("error listing backups: %v", err)}
+	}
+
+	namespaces := collections.NewIncludesExcludes().
+		Includes(backup.Spec.IncludedNamespaces...).
+		Excludes(backup.Spec.ExcludedNamespaces...)
+
+	var errs []string
+	for _, quota := range quotas {
+		if !namespaces.ShouldInclude(quota.Spec.Namespace) {
+			continue
+		}
+
+		usage := backupQuotaUsage(quota.Spec.Namespace, backup, backups)
+
+		if max := quota.Spec.MaxConcurrentBackups; max != nil && usage.concurrentBackups >= *max {
+			errs = append(errs, fmt.Sprintf("backup quota %s exceeded: namespace %s already has %d new or in-progress backups (max %d)", quota.Name, quota.Spec.Namespace, usage.concurrentBackups, *max))
+		}
+		if max := quota.Spec.MaxStoredBytes; max != nil && usage.storedBytes >= *max {
+			errs = append(errs, fmt.Sprintf("backup quota %s exceeded: backups of namespace %s already use %d bytes of storage (max %d)", quota.Name, quota.Spec.Namespace, usage.storedBytes, *max))
+		}
+		if max := quota.Spec.MaxSnapshots; max != nil && usage.snapshots >= *max {
+			errs = append(errs, fmt.Sprintf("backup quota %s exceeded: backups of namespace %s already have %d volume snapshots (max %d)", quota.Name, quota.Spec.Namespace, usage.snapshots, *max))
+		}
+	}
+
+	return errs
+}
+
+type quotaUsage struct {
+	concurrentBackups int
+	storedBytes       int64
+	snapshots         int
+}
+
+// backupQuotaUsage sums up the resources used by all backups, other than
+// current, that include the given namespace. New backups are only counted as
+// concurrent backups if they were created before current, since the ones
+// created after it are processed after it too.
+func backupQuotaUsage(namespace string, current *velerov1api.Backup, backups []*velerov1api.Backup) quotaUsage {
+	var usage quotaUsage
+
+	for _, backup := range backups {
+		if backup.Name == current.Name {
+			continue
+		}
+
+		includes := collections.NewIncludesExcludes().
+			Includes(backup.Spec.IncludedNamespaces...).
+			Excludes(backup.Spec.ExcludedNamespaces...)
+		if !includes.ShouldInclude(namespace) {
+			continue
+		}
+
+		switch backup.Status.Phase {
+		case "", velerov1api.BackupPhaseNew:
+			if createdBefore(backup, current) {
+				usage.concurrentBackups++
+			}
+		case velerov1api.BackupPhaseInProgress:
+			usage.concurrentBackups++
+		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed,
+			velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
+			usage.storedBytes += backup.Status.TarballSizeBytes
+			usage.snapshots += backup.Status.VolumeSnapshotsCompleted
+		}
+	}
+
+	return usage
+}
+
+// createdBefore returns whether a backup was created before another one, by
+// name for backups that were created in the same second.
+func createdBefore(backup, other *velerov1api.Backup) bool {
+	if !backup.CreationTimestamp.Equal(&other.CreationTimestamp) {
+		return backup.CreationTimestamp.Before(&other.CreationTimestamp)
+	}
+	return backup.Name < other.Name
 }
 
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
-// - each location name in .spec.volumeSnapshotLocations exists as a location
-// - exactly 1 location per provider
-// - a given provider's default location name is added to .spec.volumeSnapshotLocations if one
-//   is not explicitly specified for the provider (if there's only one location for the provider,
-//   it will automatically be used)
+//   - each location name in .spec.volumeSnapshotLocations exists as a location
+//   - exactly 1 location per provider
+//   - a given provider's default location name is added to .spec.volumeSnapshotLocations if one
+//     is not explicitly specified for the provider (if there's only one location for the provider,
+//     it will automatically be used)
 func (c *backupController) validateAndGetSnapshotLocations(backup *velerov1api.Backup) (map[string]*velerov1api.VolumeSnapshotLocation, []string) {
 	errors := []string{}
 	providerLocations := make(map[string]*velerov1api.VolumeSnapshotLocation)
@@ -457,7 +779,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	logCounter := logging.NewLogCounterHook()
 	logger.Hooks.Add(logCounter)
 
-	backupLog := logger.WithField("backup", kubeutil.NamespaceAndName(backup))
+	backupLog := logging.WithCorrelationID(logger.WithField("backup", kubeutil.NamespaceAndName(backup)), backup.Backup)
 
 	backupLog.Info("Setting up backup temp file")
 	backupFile, err := ioutil.TempFile("", "")
@@ -476,6 +798,17 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
+	backup.ActionsV2, err = pluginManager.GetBackupItemActionsV2()
+	if err != nil {
+		return err
+	}
+
+	backupLog.Info("Getting item transformers")
+	backup.ItemTransformers, err = pluginManager.GetItemTransformers()
+	if err != nil {
+		return err
+	}
+
 	backupLog.Info("Setting up backup store")
 	backupStore, err := c.newBackupStore(backup.StorageLocation, pluginManager, backupLog)
 	if err != nil {
@@ -489,11 +822,26 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		if err != nil {
 			return errors.Wrapf(err, "error checking if backup already exists in object storage")
 		}
-		return errors.Errorf("backup already exists in object storage")
+		return errors.WithStack(persistence.ErrBackupExists)
+	}
+
+	backupper := c.backupper
+	if backup.Spec.Cluster != "" {
+		backupLog.Infof("Setting up backupper for member cluster %s", backup.Spec.Cluster)
+		config, err := c.memberClusters.RESTConfig(backup.Spec.Cluster)
+		if err != nil {
+			return err
+		}
+		if backupper, err = c.newMemberClusterBackupper(config); err != nil {
+			return errors.Wrapf(err, "error setting up backupper for member cluster %s", backup.Spec.Cluster)
+		}
 	}
 
 	var fatalErrs []error
-	if err := c.backupper.Backup(backupLog, backup, backupFile, actions, pluginManager); err != nil {
+	span := tracing.StartSpan(backup.Span, "Backupper.Backup")
+	err = backupper.Backup(backupLog, backup, backupFile, actions, pluginManager)
+	tracing.EndSpan(span, err)
+	if err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
 
@@ -508,7 +856,52 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		}
 	}
 
-	recordBackupMetrics(backupLog, backup.Backup, backupFile, c.metrics)
+	contents := &backupContents{main: backupFile}
+	if backupFileStat, err := backupFile.Stat(); err != nil {
+		backupLog.WithError(errors.WithStack(err)).Error("Error getting backup file info")
+	} else if backupFileStat.Size() > 0 {
+		backupLog.Info("Splitting backup tarball into per-namespace archives")
+		if split, err := splitBackupContents(backupFile); err != nil {
+			backupLog.WithError(err).Error("Error splitting backup tarball into per-namespace archives, uploading it unsplit")
+		} else {
+			defer split.closeAndRemove(backupLog)
+			contents = split
+		}
+	}
+
+	if size, err := contents.size(); err != nil {
+		backupLog.WithError(err).Error("Error getting backup file info")
+	} else {
+		backup.Status.TarballSizeBytes = size
+	}
+
+	if backup.ItemTimings != nil {
+		backup.Status.SlowestItems = backup.ItemTimings.SlowestItems
+		backup.Status.SlowestActions = backup.ItemTimings.SlowestActions
+		recordItemTimingMetrics(backup.ItemTimings, c.metrics)
+	}
+
+	backup.Status.HooksAttempted = len(backup.HookExecutions)
+	backup.Status.HooksFailed = 0
+	for i := range backup.HookExecutions {
+		if backup.HookExecutions[i].Failed() {
+			backup.Status.HooksFailed++
+		}
+	}
+
+	// record the spec's hash, so that the backup sync controller can
+	// detect changes to the spec of the backup's custom resource.
+	if hash, err := pkgbackup.SpecHash(backup.Spec); err != nil {
+		backupLog.WithError(err).Error("Error hashing backup spec")
+	} else {
+		if backup.Annotations == nil {
+			backup.Annotations = make(map[string]string)
+		}
+		backup.Annotations[velerov1api.BackupSpecHashAnnotation] = hash
+		backup.Annotations[velerov1api.BackupSpecHashVersionAnnotation] = pkgbackup.SpecHashVersion
+	}
+
+	recordBackupMetrics(backup.Backup, c.metrics)
 
 	if err := gzippedLogFile.Close(); err != nil {
 		c.logger.WithError(err).Error("error closing gzippedLogFile")
@@ -524,14 +917,28 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	switch {
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
+	case len(backup.Status.PluginOperations) > 0 && logCounter.GetCount(logrus.ErrorLevel) > 0:
+		backup.Status.Phase = velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed
+	case len(backup.Status.PluginOperations) > 0:
+		backup.Status.Phase = velerov1api.BackupPhaseWaitingForPluginOperations
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
 		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 	default:
 		backup.Status.Phase = velerov1api.BackupPhaseCompleted
 	}
 
-	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger); len(errs) > 0 {
+	if errs := persistBackup(backup, contents, logFile, backupStore, c.logger); len(errs) > 0 {
 		fatalErrs = append(fatalErrs, errs...)
+	} else {
+		c.mirrorBackup(backup, contents, logFile, pluginManager)
+
+		// the write quorum can only be checked once the backup has been
+		// uploaded and copied, so if it isn't met, the metadata that was
+		// uploaded with the backup's final phase is replaced.
+		if err := checkWriteQuorum(backup.Backup, c.writeQuorum); err != nil {
+			fatalErrs = append(fatalErrs, err)
+			fatalErrs = append(fatalErrs, c.failBackupMetadata(backup, err, backupStore, pluginManager)...)
+		}
 	}
 
 	c.logger.Info("Backup completed")
@@ -541,16 +948,19 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
-func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics) {
+func recordItemTimingMetrics(timings *pkgbackup.ItemTimings, serverMetrics *metrics.ServerMetrics) {
+	for resource, duration := range timings.ResourceDurations {
+		serverMetrics.RegisterBackupItemDuration(resource, duration.Seconds())
+	}
+	for action, duration := range timings.ActionDurations {
+		serverMetrics.RegisterBackupItemActionDuration(action, duration.Seconds())
+	}
+}
+
+func recordBackupMetrics(backup *velerov1api.Backup, serverMetrics *metrics.ServerMetrics) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
-	var backupSizeBytes int64
-	if backupFileStat, err := backupFile.Stat(); err != nil {
-		log.WithError(errors.WithStack(err)).Error("Error getting backup file info")
-	} else {
-		backupSizeBytes = backupFileStat.Size()
-	}
-	serverMetrics.SetBackupTarballSizeBytesGauge(backupScheduleName, backupSizeBytes)
+	serverMetrics.SetBackupTarballSizeBytesGauge(backupScheduleName, backup.Status.TarballSizeBytes)
 
 	backupDuration := backup.Status.CompletionTimestamp.Time.Sub(backup.Status.StartTimestamp.Time)
 	backupDurationSeconds := float64(backupDuration / time.Second)
@@ -560,7 +970,77 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 	serverMetrics.RegisterVolumeSnapshotFailures(backupScheduleName, backup.Status.VolumeSnapshotsAttempted-backup.Status.VolumeSnapshotsCompleted)
 }
 
-func persistBackup(backup *pkgbackup.Request, backupContents, backupLog *os.File, backupStore persistence.BackupStore, log logrus.FieldLogger) []error {
+// backupContents are the temp files of a backup's archive. If the archive was
+// split, main is its main archive, and index and namespaces are its index and
+// per-namespace sub-archives.
+type backupContents struct {
+	main       *os.File
+	index      *archive.Index
+	namespaces map[string]*os.File
+}
+
+// splitBackupContents splits a backup's tarball into a main archive and a
+// sub-archive for each namespace, in temp files.
+func splitBackupContents(backupFile *os.File) (*backupContents, error) {
+	if _, err := backupFile.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	main, err := ioutil.TempFile("", "")
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp file for backup")
+	}
+
+	contents := &backupContents{
+		main:       main,
+		namespaces: make(map[string]*os.File),
+	}
+
+	contents.index, err = archive.Split(backupFile, main, func(namespace string) (io.Writer, error) {
+		file, err := ioutil.TempFile("", "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating temp file for namespace %s", namespace)
+		}
+		contents.namespaces[namespace] = file
+		return file, nil
+	})
+	if err != nil {
+		contents.closeAndRemove(logrus.StandardLogger())
+		return nil, err
+	}
+
+	return contents, nil
+}
+
+// size returns the total size of a backup's archive files.
+func (c *backupContents) size() (int64, error) {
+	var size int64
+	for _, file := range c.files() {
+		stat, err := file.Stat()
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		size += stat.Size()
+	}
+
+	return size, nil
+}
+
+func (c *backupContents) files() []*os.File {
+	files := []*os.File{c.main}
+	for _, file := range c.namespaces {
+		files = append(files, file)
+	}
+	return files
+}
+
+func (c *backupContents) closeAndRemove(log logrus.FieldLogger) {
+	for _, file := range c.files() {
+		closeAndRemoveFile(file, log)
+	}
+}
+
+func persistBackup(backup *pkgbackup.Request, contents *backupContents, backupLog *os.File, backupStore persistence.BackupStore, log logrus.FieldLogger) []error {
 	errs := []error{}
 	backupJSON := new(bytes.Buffer)
 
@@ -598,30 +1078,205 @@ func persistBackup(backup *pkgbackup.Request, backupContents, backupLog *os.File
 		errs = append(errs, errors.Wrap(err, "error closing gzip writer"))
 	}
 
+	var searchIndex io.Reader
+	if backup.Spec.SearchIndex {
+		searchIndexBuf := new(bytes.Buffer)
+		gzw = gzip.NewWriter(searchIndexBuf)
+
+		if err := json.NewEncoder(gzw).Encode(backup.SearchIndex); err != nil {
+			errs = append(errs, errors.Wrap(err, "error encoding search index"))
+		}
+		if err := gzw.Close(); err != nil {
+			errs = append(errs, errors.Wrap(err, "error closing gzip writer"))
+		}
+		searchIndex = searchIndexBuf
+	}
+
+	var results io.Reader
+	if len(backup.HookExecutions) > 0 {
+		resultsBuf := new(bytes.Buffer)
+		gzw = gzip.NewWriter(resultsBuf)
+
+		if err := json.NewEncoder(gzw).Encode(pkgbackup.Results{Hooks: backup.HookExecutions}); err != nil {
+			errs = append(errs, errors.Wrap(err, "error encoding backup results"))
+		}
+		if err := gzw.Close(); err != nil {
+			errs = append(errs, errors.Wrap(err, "error closing gzip writer"))
+		}
+		results = resultsBuf
+	}
+
+	var index io.Reader
+	namespaceContents := make(map[string]io.Reader)
+	if contents.index != nil {
+		indexBuf := new(bytes.Buffer)
+		gzw = gzip.NewWriter(indexBuf)
+
+		if err := json.NewEncoder(gzw).Encode(contents.index); err != nil {
+			errs = append(errs, errors.Wrap(err, "error encoding backup index"))
+		}
+		if err := gzw.Close(); err != nil {
+			errs = append(errs, errors.Wrap(err, "error closing gzip writer"))
+		}
+		index = indexBuf
+
+		for namespace, file := range contents.namespaces {
+			namespaceContents[namespace] = file
+		}
+	}
+
+	var mainContents io.Reader = contents.main
 	if len(errs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
-		backupContents = nil
+		mainContents = nil
 		volumeSnapshots = nil
 		backupResourceList = nil
+		searchIndex = nil
+		results = nil
+		index = nil
+		namespaceContents = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
 		Name:               backup.Name,
 		Metadata:           backupJSON,
-		Contents:           backupContents,
+		Contents:           mainContents,
 		Log:                backupLog,
 		PodVolumeBackups:   podVolumeBackups,
 		VolumeSnapshots:    volumeSnapshots,
 		BackupResourceList: backupResourceList,
+		SearchIndex:        searchIndex,
+		Results:            results,
+		Index:              index,
+		NamespaceContents:  namespaceContents,
 	}
-	if err := backupStore.PutBackup(backupInfo); err != nil {
+	span := tracing.StartSpan(backup.Span, "BackupStore.PutBackup")
+	err := backupStore.PutBackup(backupInfo)
+	tracing.EndSpan(span, err)
+	if err != nil {
 		errs = append(errs, err)
 	}
 
 	return errs
 }
 
+// mirrorBackup copies a backup's artifacts to each of its additional storage locations and
+// records the outcome for each location on the backup's status. A failure to copy to an
+// additional location does not fail the backup.
+func (c *backupController) mirrorBackup(backup *pkgbackup.Request, contents *backupContents, logFile *os.File, pluginManager clientmgmt.Manager) {
+	for _, location := range backup.AdditionalStorageLocations {
+		log := c.logger.WithFields(logrus.Fields{
+			"backup":                    kubeutil.NamespaceAndName(backup),
+			"additionalStorageLocation": location.Name,
+		})
+
+		status := velerov1api.AdditionalStorageLocationStatus{
+			Name:  location.Name,
+			Phase: velerov1api.AdditionalStorageLocationPhaseCompleted,
+		}
+
+		if err := mirrorBackupToLocation(backup, contents, logFile, location, pluginManager, c.newBackupStore, log); err != nil {
+			log.WithError(err).Error("Error copying backup to additional storage location")
+			status.Phase = velerov1api.AdditionalStorageLocationPhaseFailed
+			status.Error = err.Error()
+		} else {
+			log.Info("Backup copied to additional storage location")
+		}
+
+		backup.Status.AdditionalStorageLocations = append(backup.Status.AdditionalStorageLocations, status)
+	}
+}
+
+// checkWriteQuorum returns an error if a backup that was uploaded to its
+// storage location was copied to fewer of its additional storage locations
+// than needed to meet the write quorum.
+func checkWriteQuorum(backup *velerov1api.Backup, writeQuorum int) error {
+	uploaded := 1
+	for _, status := range backup.Status.AdditionalStorageLocations {
+		if status.Phase == velerov1api.AdditionalStorageLocationPhaseCompleted {
+			uploaded++
+		}
+	}
+
+	if uploaded < writeQuorum {
+		return errors.Errorf("backup was uploaded to %d of its %d storage locations, but the server requires it to be uploaded to %d",
+			uploaded, 1+len(backup.Spec.AdditionalStorageLocations), writeQuorum)
+	}
+	return nil
+}
+
+// failBackupMetadata marks a backup that was uploaded with its final phase
+// failed, and replaces its metadata in its storage location and each
+// additional storage location it was copied to, so that it isn't synced
+// from any of them as completed.
+func (c *backupController) failBackupMetadata(backup *pkgbackup.Request, reason error, backupStore persistence.BackupStore, pluginManager clientmgmt.Manager) []error {
+	backup.Status.Phase = velerov1api.BackupPhaseFailed
+	backup.Status.FailureReason = reason.Error()
+
+	metadata := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup.Backup, "json", metadata); err != nil {
+		return []error{errors.Wrap(err, "error encoding backup")}
+	}
+
+	var errs []error
+	if err := backupStore.UpdateBackupMetadata(backup.Name, bytes.NewReader(metadata.Bytes())); err != nil {
+		errs = append(errs, errors.Wrap(err, "error uploading backup metadata"))
+	}
+
+	copied := sets.NewString()
+	for _, status := range backup.Status.AdditionalStorageLocations {
+		if status.Phase == velerov1api.AdditionalStorageLocationPhaseCompleted {
+			copied.Insert(status.Name)
+		}
+	}
+
+	for _, location := range backup.AdditionalStorageLocations {
+		if !copied.Has(location.Name) {
+			continue
+		}
+
+		locationStore, err := c.newBackupStore(location, pluginManager, c.logger)
+		if err == nil {
+			err = locationStore.UpdateBackupMetadata(backup.Name, bytes.NewReader(metadata.Bytes()))
+		}
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "error uploading backup metadata to additional storage location %s", location.Name))
+		}
+	}
+
+	return errs
+}
+
+func mirrorBackupToLocation(
+	backup *pkgbackup.Request,
+	contents *backupContents,
+	logFile *os.File,
+	location *velerov1api.BackupStorageLocation,
+	pluginManager clientmgmt.Manager,
+	newBackupStore func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error),
+	log logrus.FieldLogger,
+) error {
+	backupStore, err := newBackupStore(location, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	exists, err := backupStore.BackupExists(location.Spec.StorageType.ObjectStorage.Bucket, backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error checking if backup already exists in object storage")
+	}
+	if exists {
+		return errors.WithStack(persistence.ErrBackupExists)
+	}
+
+	if errs := persistBackup(backup, contents, logFile, backupStore, log); len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+
+	return nil
+}
+
 func closeAndRemoveFile(file *os.File, log logrus.FieldLogger) {
 	if err := file.Close(); err != nil {
 		log.WithError(err).WithField("file", file.Name()).Error("error closing file")
@@ -18,8 +18,10 @@ package controller
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"sort"
 	"strings"
 	"testing"
@@ -44,6 +46,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -132,6 +137,7 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 		name           string
 		backup         *velerov1api.Backup
 		backupLocation *velerov1api.BackupStorageLocation
+		writeQuorum    int
 		expectedErrs   []string
 	}{
 		{
@@ -157,6 +163,35 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 			expectedErrs:   []string{"backup can't be created because backup storage location read-only is currently in read-only mode"},
 		},
+		{
+			name:   "backup for unavailable backup location fails validation",
+			backup: defaultBackup().StorageLocation("unavailable").Result(),
+			backupLocation: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation("velero", "unavailable").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result()
+				location.Status.Message = "bucket not found"
+				return location
+			}(),
+			expectedErrs: []string{"backup can't be created because backup storage location unavailable is unavailable: bucket not found"},
+		},
+		{
+			name:           "non-existent additional storage location fails validation",
+			backup:         defaultBackup().StorageLocation("loc-1").AdditionalStorageLocations("nonexistent").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"additional storage location nonexistent does not exist"},
+		},
+		{
+			name:           "additional storage location matching the backup's storage location fails validation",
+			backup:         defaultBackup().StorageLocation("loc-1").AdditionalStorageLocations("loc-1").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"additional storage location loc-1 is the backup's storage location"},
+		},
+		{
+			name:           "backup with fewer storage locations than the write quorum fails validation",
+			backup:         defaultBackup().StorageLocation("loc-1").Result(),
+			backupLocation: defaultBackupLocation,
+			writeQuorum:    2,
+			expectedErrs:   []string{"backup has 1 storage location(s), including additional storage locations, but the server requires it to be uploaded to 2"},
+		},
 	}
 
 	for _, test := range tests {
@@ -173,10 +208,13 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 				client:                 clientset.VeleroV1(),
 				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
 				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
 				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultBackupLocation:  defaultBackupLocation.Name,
+				writeQuorum:            test.writeQuorum,
 				clock:                  &clock.RealClock{},
 				formatFlag:             formatFlag,
+				newCorrelationID:       logging.NewCorrelationID,
 			}
 
 			require.NotNil(t, test.backup)
@@ -241,10 +279,12 @@ func TestBackupLocationLabel(t *testing.T) {
 				client:                 clientset.VeleroV1(),
 				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
 				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
 				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultBackupLocation:  test.backupLocation.Name,
 				clock:                  &clock.RealClock{},
 				formatFlag:             formatFlag,
+				newCorrelationID:       logging.NewCorrelationID,
 			}
 
 			res := c.prepareBackupRequest(test.backup)
@@ -254,6 +294,123 @@ func TestBackupLocationLabel(t *testing.T) {
 	}
 }
 
+func TestDefaultBackupLocation(t *testing.T) {
+	tests := []struct {
+		name                   string
+		backup                 *velerov1api.Backup
+		backupLocations        []*velerov1api.BackupStorageLocation
+		expectedBackupLocation string
+	}{
+		{
+			name:                   "backup without a location uses the server's default",
+			backup:                 defaultBackup().Result(),
+			backupLocations:        []*velerov1api.BackupStorageLocation{builder.ForBackupStorageLocation("velero", "loc-1").Result()},
+			expectedBackupLocation: "default",
+		},
+		{
+			name:   "backup without a location uses the location annotated as the default",
+			backup: defaultBackup().Result(),
+			backupLocations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "default").Result(),
+				builder.ForBackupStorageLocation("velero", "loc-1").ObjectMeta(builder.WithAnnotations(velerov1api.DefaultBackupLocationAnnotation, "true")).Result(),
+			},
+			expectedBackupLocation: "loc-1",
+		},
+		{
+			name:   "backup with a location keeps it",
+			backup: defaultBackup().StorageLocation("loc-2").Result(),
+			backupLocations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "loc-1").ObjectMeta(builder.WithAnnotations(velerov1api.DefaultBackupLocationAnnotation, "true")).Result(),
+			},
+			expectedBackupLocation: "loc-2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+
+			var (
+				clientset       = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+			)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  "default",
+				clock:                  &clock.RealClock{},
+				formatFlag:             formatFlag,
+				newCorrelationID:       logging.NewCorrelationID,
+			}
+
+			for _, location := range test.backupLocations {
+				require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+			}
+
+			res := c.prepareBackupRequest(test.backup)
+			assert.Equal(t, test.expectedBackupLocation, res.Spec.StorageLocation)
+		})
+	}
+}
+
+func TestSourceClusterLabels(t *testing.T) {
+	tests := []struct {
+		name            string
+		clusterIdentity kubeutil.ClusterIdentity
+		wantName        string
+		wantUID         string
+	}{
+		{
+			name:            "cluster name and UID are added as labels",
+			clusterIdentity: kubeutil.ClusterIdentity{Name: "cluster-1", UID: "uid-1"},
+			wantName:        "cluster-1",
+			wantUID:         "uid-1",
+		},
+		{
+			name:            "cluster UID is added as a label if the cluster doesn't have a name",
+			clusterIdentity: kubeutil.ClusterIdentity{UID: "uid-1"},
+			wantUID:         "uid-1",
+		},
+		{
+			name: "no labels are added if the cluster's identity isn't known",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				backup          = defaultBackup().Result()
+				clientset       = fake.NewSimpleClientset(backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+			)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  "loc-1",
+				clock:                  &clock.RealClock{},
+				newCorrelationID:       logging.NewCorrelationID,
+				clusterIdentity:        test.clusterIdentity,
+			}
+
+			res := c.prepareBackupRequest(backup)
+			assert.Equal(t, test.wantName, res.Labels[velerov1api.SourceClusterNameLabel])
+			assert.Equal(t, test.wantUID, res.Labels[velerov1api.SourceClusterUIDLabel])
+		})
+	}
+}
+
 func TestDefaultBackupTTL(t *testing.T) {
 	var (
 		defaultBackupTTL = metav1.Duration{Duration: 24 * 30 * time.Hour}
@@ -264,11 +421,12 @@ func TestDefaultBackupTTL(t *testing.T) {
 	now = now.Local()
 
 	tests := []struct {
-		name               string
-		backup             *velerov1api.Backup
-		backupLocation     *velerov1api.BackupStorageLocation
-		expectedTTL        metav1.Duration
-		expectedExpiration metav1.Time
+		name                   string
+		backup                 *velerov1api.Backup
+		backupLocation         *velerov1api.BackupStorageLocation
+		expectedTTL            metav1.Duration
+		expectedExpiration     metav1.Time
+		expectedLogsExpiration *metav1.Time
 	}{
 		{
 			name:               "backup with no TTL specified",
@@ -282,6 +440,13 @@ func TestDefaultBackupTTL(t *testing.T) {
 			expectedTTL:        metav1.Duration{Duration: 1 * time.Hour},
 			expectedExpiration: metav1.NewTime(now.Add(1 * time.Hour)),
 		},
+		{
+			name:                   "backup with log TTL specified",
+			backup:                 defaultBackup().TTL(time.Hour).LogTTL(time.Minute).Result(),
+			expectedTTL:            metav1.Duration{Duration: 1 * time.Hour},
+			expectedExpiration:     metav1.NewTime(now.Add(1 * time.Hour)),
+			expectedLogsExpiration: &metav1.Time{Time: now.Add(time.Minute)},
+		},
 	}
 
 	for _, test := range tests {
@@ -296,20 +461,207 @@ func TestDefaultBackupTTL(t *testing.T) {
 			c := &backupController{
 				genericController:      newGenericController("backup-test", logger),
 				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
 				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultBackupTTL:       defaultBackupTTL.Duration,
 				clock:                  clock.NewFakeClock(now),
 				formatFlag:             formatFlag,
+				newCorrelationID:       logging.NewCorrelationID,
 			}
 
 			res := c.prepareBackupRequest(test.backup)
 			assert.NotNil(t, res)
 			assert.Equal(t, test.expectedTTL, res.Spec.TTL)
 			assert.Equal(t, test.expectedExpiration, res.Status.Expiration)
+			assert.Equal(t, test.expectedLogsExpiration, res.Status.LogsExpiration)
+		})
+	}
+}
+
+func TestDefaultPodVolumeBackupSelectors(t *testing.T) {
+	defaultSelectors := []metav1.LabelSelector{{MatchLabels: map[string]string{"backup": "restic"}}}
+	ownSelectors := []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "db"}}}
+
+	tests := []struct {
+		name     string
+		backup   *velerov1api.Backup
+		expected []metav1.LabelSelector
+	}{
+		{
+			name:     "backup without selectors gets the default selectors",
+			backup:   defaultBackup().Result(),
+			expected: defaultSelectors,
+		},
+		{
+			name:     "backup with selectors keeps them",
+			backup:   defaultBackup().PodVolumeBackupSelectors(ownSelectors...).Result(),
+			expected: ownSelectors,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				clientset       = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+			)
+
+			c := &backupController{
+				genericController:         newGenericController("backup-test", logger),
+				backupLocationLister:      sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:         sharedInformers.Velero().V1().BackupQuotas().Lister(),
+				snapshotLocationLister:    sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultPodVolumeSelectors: defaultSelectors,
+				clock:                     &clock.RealClock{},
+				formatFlag:                logging.FormatText,
+				newCorrelationID:          logging.NewCorrelationID,
+			}
+
+			res := c.prepareBackupRequest(test.backup)
+			assert.Equal(t, test.expected, res.Spec.PodVolumeBackupSelectors)
+		})
+	}
+}
+
+func TestDefaultVolumesToRestic(t *testing.T) {
+	tests := []struct {
+		name          string
+		backup        *velerov1api.Backup
+		serverDefault bool
+		expected      *bool
+	}{
+		{
+			name:          "backup without the flag gets the server's default",
+			backup:        defaultBackup().Result(),
+			serverDefault: true,
+			expected:      boolptr.True(),
+		},
+		{
+			name:          "backup with the flag keeps it",
+			backup:        defaultBackup().DefaultVolumesToRestic(false).Result(),
+			serverDefault: true,
+			expected:      boolptr.False(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				clientset       = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+			)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultVolumesToRestic: test.serverDefault,
+				clock:                  &clock.RealClock{},
+				formatFlag:             logging.FormatText,
+				newCorrelationID:       logging.NewCorrelationID,
+			}
+
+			res := c.prepareBackupRequest(test.backup)
+			assert.Equal(t, test.expected, res.Spec.DefaultVolumesToRestic)
+		})
+	}
+}
+
+func TestDefaultBackupLimits(t *testing.T) {
+	tests := []struct {
+		name             string
+		backup           *velerov1api.Backup
+		expectedMaxItems int
+		expectedMaxBytes int64
+	}{
+		{
+			name:             "backup without limits gets the server's defaults",
+			backup:           defaultBackup().Result(),
+			expectedMaxItems: 1000,
+			expectedMaxBytes: 1 << 30,
+		},
+		{
+			name:             "backup with limits keeps them",
+			backup:           defaultBackup().MaxItems(10).MaxBytes(0).Result(),
+			expectedMaxItems: 10,
+			expectedMaxBytes: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				clientset       = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+			)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultMaxItems:        1000,
+				defaultMaxBytes:        1 << 30,
+				clock:                  &clock.RealClock{},
+				formatFlag:             logging.FormatText,
+				newCorrelationID:       logging.NewCorrelationID,
+			}
+
+			res := c.prepareBackupRequest(test.backup)
+			require.NotNil(t, res.Spec.MaxItems)
+			require.NotNil(t, res.Spec.MaxBytes)
+			assert.Equal(t, test.expectedMaxItems, *res.Spec.MaxItems)
+			assert.Equal(t, test.expectedMaxBytes, *res.Spec.MaxBytes)
 		})
 	}
 }
 
+func TestRegisteredBackupRequestSteps(t *testing.T) {
+	// registered steps are global, so this one only validates the backup
+	// made by this test.
+	pkgbackup.RegisterRequestStep(pkgbackup.RequestStep{
+		Name: "test-registered-step",
+		Prepare: func(request *pkgbackup.Request) []string {
+			if request.Name != "registered-step-backup" {
+				return nil
+			}
+			return []string{fmt.Sprintf("storage location is %s", request.Spec.StorageLocation)}
+		},
+	})
+
+	assert.Panics(t, func() {
+		pkgbackup.RegisterRequestStep(pkgbackup.RequestStep{Name: "test-registered-step", Prepare: func(*pkgbackup.Request) []string { return nil }})
+	})
+
+	var (
+		backup          = builder.ForBackup(velerov1api.DefaultNamespace, "registered-step-backup").IncludedNamespaces("*").Result()
+		clientset       = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+	)
+
+	c := &backupController{
+		genericController:      newGenericController("backup-test", logger),
+		backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+		backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		defaultBackupLocation:  "default",
+		clock:                  &clock.RealClock{},
+		formatFlag:             logging.FormatText,
+		newCorrelationID:       logging.NewCorrelationID,
+	}
+
+	// the registered step runs after the server's own steps, so it sees the
+	// defaulted storage location, and its errors follow theirs.
+	res := c.prepareBackupRequest(backup)
+	require.NotEmpty(t, res.Status.ValidationErrors)
+	assert.Equal(t, "storage location is default", res.Status.ValidationErrors[len(res.Status.ValidationErrors)-1])
+}
+
 func TestProcessBackupCompletions(t *testing.T) {
 	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Bucket("store-1").Result()
 
@@ -338,16 +690,22 @@ func TestProcessBackupCompletions(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: velerov1api.DefaultNamespace,
 					Name:      "backup-1",
+					Annotations: map[string]string{
+						velerov1api.CorrelationIDAnnotation: "correlation-1",
+					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: defaultBackupLocation.Name,
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
+					MaxItems:               new(int),
+					MaxBytes:               new(int64),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
 					StartTimestamp:      metav1.NewTime(now),
 					CompletionTimestamp: metav1.NewTime(now),
 					Expiration:          metav1.NewTime(now),
@@ -366,16 +724,22 @@ func TestProcessBackupCompletions(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: velerov1api.DefaultNamespace,
 					Name:      "backup-1",
+					Annotations: map[string]string{
+						velerov1api.CorrelationIDAnnotation: "correlation-1",
+					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "alt-loc",
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: "alt-loc",
+					StorageLocation:        "alt-loc",
+					DefaultVolumesToRestic: boolptr.False(),
+					MaxItems:               new(int),
+					MaxBytes:               new(int64),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
 					StartTimestamp:      metav1.NewTime(now),
 					CompletionTimestamp: metav1.NewTime(now),
 					Expiration:          metav1.NewTime(now),
@@ -397,16 +761,22 @@ func TestProcessBackupCompletions(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: velerov1api.DefaultNamespace,
 					Name:      "backup-1",
+					Annotations: map[string]string{
+						velerov1api.CorrelationIDAnnotation: "correlation-1",
+					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "read-write",
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: "read-write",
+					StorageLocation:        "read-write",
+					DefaultVolumesToRestic: boolptr.False(),
+					MaxItems:               new(int),
+					MaxBytes:               new(int64),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
 					StartTimestamp:      metav1.NewTime(now),
 					CompletionTimestamp: metav1.NewTime(now),
 					Expiration:          metav1.NewTime(now),
@@ -425,17 +795,23 @@ func TestProcessBackupCompletions(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: velerov1api.DefaultNamespace,
 					Name:      "backup-1",
+					Annotations: map[string]string{
+						velerov1api.CorrelationIDAnnotation: "correlation-1",
+					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					TTL:             metav1.Duration{Duration: 10 * time.Minute},
-					StorageLocation: defaultBackupLocation.Name,
+					TTL:                    metav1.Duration{Duration: 10 * time.Minute},
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
+					MaxItems:               new(int),
+					MaxBytes:               new(int64),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
 					Expiration:          metav1.NewTime(now.Add(10 * time.Minute)),
 					StartTimestamp:      metav1.NewTime(now),
 					CompletionTimestamp: metav1.NewTime(now),
@@ -455,16 +831,22 @@ func TestProcessBackupCompletions(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: velerov1api.DefaultNamespace,
 					Name:      "backup-1",
+					Annotations: map[string]string{
+						velerov1api.CorrelationIDAnnotation: "correlation-1",
+					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: defaultBackupLocation.Name,
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
+					MaxItems:               new(int),
+					MaxBytes:               new(int64),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
 					StartTimestamp:      metav1.NewTime(now),
 					CompletionTimestamp: metav1.NewTime(now),
 					Expiration:          metav1.NewTime(now),
@@ -486,16 +868,23 @@ func TestProcessBackupCompletions(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: velerov1api.DefaultNamespace,
 					Name:      "backup-1",
+					Annotations: map[string]string{
+						velerov1api.CorrelationIDAnnotation: "correlation-1",
+					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: defaultBackupLocation.Name,
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
+					MaxItems:               new(int),
+					MaxBytes:               new(int64),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseFailed,
-					Version:             1,
+					FailureReason:       persistence.ErrBackupExists.Error(),
+					Version:             2,
 					StartTimestamp:      metav1.NewTime(now),
 					CompletionTimestamp: metav1.NewTime(now),
 					Expiration:          metav1.NewTime(now),
@@ -515,16 +904,23 @@ func TestProcessBackupCompletions(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: velerov1api.DefaultNamespace,
 					Name:      "backup-1",
+					Annotations: map[string]string{
+						velerov1api.CorrelationIDAnnotation: "correlation-1",
+					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: defaultBackupLocation.Name,
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
+					MaxItems:               new(int),
+					MaxBytes:               new(int64),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseFailed,
-					Version:             1,
+					FailureReason:       "error checking if backup already exists in object storage: Backup already exists in object storage",
+					Version:             2,
 					StartTimestamp:      metav1.NewTime(now),
 					CompletionTimestamp: metav1.NewTime(now),
 					Expiration:          metav1.NewTime(now),
@@ -550,6 +946,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				client:                 clientset.VeleroV1(),
 				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
 				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				backupQuotaLister:      sharedInformers.Velero().V1().BackupQuotas().Lister(),
 				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultBackupLocation:  defaultBackupLocation.Name,
 				backupTracker:          NewBackupTracker(),
@@ -559,11 +956,14 @@ func TestProcessBackupCompletions(t *testing.T) {
 				newBackupStore: func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
 					return backupStore, nil
 				},
-				backupper:  backupper,
-				formatFlag: formatFlag,
+				backupper:        backupper,
+				formatFlag:       formatFlag,
+				newCorrelationID: func() string { return "correlation-1" },
 			}
 
 			pluginManager.On("GetBackupItemActions").Return(nil, nil)
+			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
+			pluginManager.On("GetItemTransformers").Return(nil, nil)
 			pluginManager.On("CleanupClients").Return(nil)
 			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), pluginManager).Return(nil)
 			backupStore.On("BackupExists", test.backupLocation.Spec.StorageType.ObjectStorage.Bucket, test.backup.Name).Return(test.backupExists, test.existenceCheckError)
@@ -602,6 +1002,14 @@ func TestProcessBackupCompletions(t *testing.T) {
 			res, err := clientset.VeleroV1().Backups(test.backup.Namespace).Get(test.backup.Name, metav1.GetOptions{})
 			require.NoError(t, err)
 
+			// backups that are run record the hash of their final spec.
+			if test.expectedResult.Status.Phase == velerov1api.BackupPhaseCompleted {
+				hash, err := pkgbackup.SpecHash(test.expectedResult.Spec)
+				require.NoError(t, err)
+				test.expectedResult.Annotations[velerov1api.BackupSpecHashAnnotation] = hash
+				test.expectedResult.Annotations[velerov1api.BackupSpecHashVersionAnnotation] = pkgbackup.SpecHashVersion
+			}
+
 			assert.Equal(t, test.expectedResult, res)
 		})
 	}
@@ -737,3 +1145,212 @@ func TestValidateAndGetSnapshotLocations(t *testing.T) {
 		})
 	}
 }
+
+func TestMirrorBackup(t *testing.T) {
+	backupFile, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer closeAndRemoveFile(backupFile, velerotest.NewLogger())
+
+	logFile, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer closeAndRemoveFile(logFile, velerotest.NewLogger())
+
+	request := &pkgbackup.Request{
+		Backup: defaultBackup().AdditionalStorageLocations("loc-2", "loc-3").Result(),
+		AdditionalStorageLocations: []*velerov1api.BackupStorageLocation{
+			builder.ForBackupStorageLocation("velero", "loc-2").Bucket("bucket-2").Result(),
+			builder.ForBackupStorageLocation("velero", "loc-3").Bucket("bucket-3").Result(),
+		},
+	}
+
+	backupStores := map[string]*persistencemocks.BackupStore{
+		"loc-2": new(persistencemocks.BackupStore),
+		"loc-3": new(persistencemocks.BackupStore),
+	}
+	backupStores["loc-2"].On("BackupExists", "bucket-2", "backup-1").Return(false, nil)
+	backupStores["loc-2"].On("PutBackup", mock.Anything).Return(nil)
+	backupStores["loc-3"].On("BackupExists", "bucket-3", "backup-1").Return(false, nil)
+	backupStores["loc-3"].On("PutBackup", mock.Anything).Return(errors.New("upload failed"))
+
+	c := &backupController{
+		genericController: newGenericController("backup-test", velerotest.NewLogger()),
+		newBackupStore: func(loc *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+			return backupStores[loc.Name], nil
+		},
+	}
+
+	c.mirrorBackup(request, &backupContents{main: backupFile}, logFile, new(pluginmocks.Manager))
+
+	expected := []velerov1api.AdditionalStorageLocationStatus{
+		{Name: "loc-2", Phase: velerov1api.AdditionalStorageLocationPhaseCompleted},
+		{Name: "loc-3", Phase: velerov1api.AdditionalStorageLocationPhaseFailed, Error: "upload failed"},
+	}
+	assert.Equal(t, expected, request.Status.AdditionalStorageLocations)
+}
+
+func TestCheckWriteQuorum(t *testing.T) {
+	backup := defaultBackup().AdditionalStorageLocations("loc-2", "loc-3").Result()
+	backup.Status.AdditionalStorageLocations = []velerov1api.AdditionalStorageLocationStatus{
+		{Name: "loc-2", Phase: velerov1api.AdditionalStorageLocationPhaseCompleted},
+		{Name: "loc-3", Phase: velerov1api.AdditionalStorageLocationPhaseFailed, Error: "error"},
+	}
+
+	assert.NoError(t, checkWriteQuorum(backup, 1))
+	assert.NoError(t, checkWriteQuorum(backup, 2))
+	assert.EqualError(t, checkWriteQuorum(backup, 3), "backup was uploaded to 2 of its 3 storage locations, but the server requires it to be uploaded to 3")
+}
+
+func TestFailBackupMetadata(t *testing.T) {
+	request := &pkgbackup.Request{
+		Backup: defaultBackup().AdditionalStorageLocations("loc-2", "loc-3").Phase(velerov1api.BackupPhaseCompleted).Result(),
+		AdditionalStorageLocations: []*velerov1api.BackupStorageLocation{
+			builder.ForBackupStorageLocation("velero", "loc-2").Result(),
+			builder.ForBackupStorageLocation("velero", "loc-3").Result(),
+		},
+	}
+	request.Status.AdditionalStorageLocations = []velerov1api.AdditionalStorageLocationStatus{
+		{Name: "loc-2", Phase: velerov1api.AdditionalStorageLocationPhaseCompleted},
+		{Name: "loc-3", Phase: velerov1api.AdditionalStorageLocationPhaseFailed, Error: "upload failed"},
+	}
+
+	// the metadata is replaced in the backup's storage location and in the
+	// additional storage location it was copied to, but not in the one that
+	// it failed to be copied to.
+	var uploaded []velerov1api.BackupPhase
+	recordPhase := func(args mock.Arguments) {
+		res := new(velerov1api.Backup)
+		require.NoError(t, json.NewDecoder(args.Get(1).(io.Reader)).Decode(res))
+		uploaded = append(uploaded, res.Status.Phase)
+	}
+
+	backupStores := map[string]*persistencemocks.BackupStore{
+		"loc-1": new(persistencemocks.BackupStore),
+		"loc-2": new(persistencemocks.BackupStore),
+		"loc-3": new(persistencemocks.BackupStore),
+	}
+	backupStores["loc-1"].On("UpdateBackupMetadata", "backup-1", mock.Anything).Run(recordPhase).Return(nil)
+	backupStores["loc-2"].On("UpdateBackupMetadata", "backup-1", mock.Anything).Run(recordPhase).Return(nil)
+
+	c := &backupController{
+		genericController: newGenericController("backup-test", velerotest.NewLogger()),
+		newBackupStore: func(loc *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+			return backupStores[loc.Name], nil
+		},
+	}
+
+	errs := c.failBackupMetadata(request, errors.New("quorum not met"), backupStores["loc-1"], new(pluginmocks.Manager))
+	assert.Empty(t, errs)
+
+	for _, backupStore := range backupStores {
+		backupStore.AssertExpectations(t)
+	}
+	assert.Equal(t, []velerov1api.BackupPhase{velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailed}, uploaded)
+	assert.Equal(t, velerov1api.BackupPhaseFailed, request.Status.Phase)
+	assert.Equal(t, "quorum not met", request.Status.FailureReason)
+}
+
+func TestValidateBackupQuotas(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       *velerov1api.Backup
+		quotas       []*velerov1api.BackupQuota
+		backups      []*velerov1api.Backup
+		expectedErrs []string
+	}{
+		{
+			name:   "no quotas passes validation",
+			backup: defaultBackup().Result(),
+		},
+		{
+			name:   "quota for namespace not included in backup is ignored",
+			backup: defaultBackup().IncludedNamespaces("ns-1").Result(),
+			quotas: []*velerov1api.BackupQuota{
+				builder.ForBackupQuota("velero", "quota-1").Namespace("ns-2").MaxSnapshots(1).Result(),
+			},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("velero", "backup-2").IncludedNamespaces("ns-2").Phase(velerov1api.BackupPhaseCompleted).VolumeSnapshotsCompleted(1).Result(),
+			},
+		},
+		{
+			name:   "stored bytes at max fails validation",
+			backup: defaultBackup().Result(),
+			quotas: []*velerov1api.BackupQuota{
+				builder.ForBackupQuota("velero", "quota-1").Namespace("ns-1").MaxStoredBytes(100).Result(),
+			},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("velero", "backup-2").IncludedNamespaces("ns-1").Phase(velerov1api.BackupPhaseCompleted).TarballSizeBytes(60).Result(),
+				builder.ForBackup("velero", "backup-3").IncludedNamespaces("ns-1").Phase(velerov1api.BackupPhasePartiallyFailed).TarballSizeBytes(40).Result(),
+				builder.ForBackup("velero", "backup-4").IncludedNamespaces("ns-2").Phase(velerov1api.BackupPhaseCompleted).TarballSizeBytes(1000).Result(),
+			},
+			expectedErrs: []string{"backup quota quota-1 exceeded: backups of namespace ns-1 already use 100 bytes of storage (max 100)"},
+		},
+		{
+			name:   "stored bytes under max passes validation",
+			backup: defaultBackup().Result(),
+			quotas: []*velerov1api.BackupQuota{
+				builder.ForBackupQuota("velero", "quota-1").Namespace("ns-1").MaxStoredBytes(100).Result(),
+			},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("velero", "backup-2").IncludedNamespaces("ns-1").Phase(velerov1api.BackupPhaseCompleted).TarballSizeBytes(60).Result(),
+				builder.ForBackup("velero", "backup-3").IncludedNamespaces("ns-1").Phase(velerov1api.BackupPhaseFailedValidation).TarballSizeBytes(40).Result(),
+			},
+		},
+		{
+			name:   "snapshots at max fails validation",
+			backup: defaultBackup().ExcludedNamespaces("ns-2").Result(),
+			quotas: []*velerov1api.BackupQuota{
+				builder.ForBackupQuota("velero", "quota-1").Namespace("ns-1").MaxSnapshots(2).Result(),
+				builder.ForBackupQuota("velero", "quota-2").Namespace("ns-2").MaxSnapshots(0).Result(),
+			},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("velero", "backup-2").Phase(velerov1api.BackupPhaseCompleted).VolumeSnapshotsCompleted(2).Result(),
+			},
+			expectedErrs: []string{"backup quota quota-1 exceeded: backups of namespace ns-1 already have 2 volume snapshots (max 2)"},
+		},
+		{
+			name:   "new and in-progress backups at max concurrent backups fails validation",
+			backup: defaultBackup().IncludedNamespaces("ns-1").ObjectMeta(builder.WithCreationTimestamp(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))).Result(),
+			quotas: []*velerov1api.BackupQuota{
+				builder.ForBackupQuota("velero", "quota-1").Namespace("ns-1").MaxConcurrentBackups(2).Result(),
+			},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("velero", "backup-2").Phase(velerov1api.BackupPhaseInProgress).Result(),
+				builder.ForBackup("velero", "backup-3").Phase(velerov1api.BackupPhaseNew).ObjectMeta(builder.WithCreationTimestamp(time.Date(2020, 1, 1, 11, 0, 0, 0, time.UTC))).Result(),
+				builder.ForBackup("velero", "backup-4").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			},
+			expectedErrs: []string{"backup quota quota-1 exceeded: namespace ns-1 already has 2 new or in-progress backups (max 2)"},
+		},
+		{
+			name:   "new backups created after the backup don't count against max concurrent backups",
+			backup: defaultBackup().IncludedNamespaces("ns-1").ObjectMeta(builder.WithCreationTimestamp(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))).Result(),
+			quotas: []*velerov1api.BackupQuota{
+				builder.ForBackupQuota("velero", "quota-1").Namespace("ns-1").MaxConcurrentBackups(1).Result(),
+			},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("velero", "backup-2").Phase(velerov1api.BackupPhaseNew).ObjectMeta(builder.WithCreationTimestamp(time.Date(2020, 1, 1, 13, 0, 0, 0, time.UTC))).Result(),
+				builder.ForBackup("velero", "backup-3").IncludedNamespaces("ns-2").Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sharedInformers := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+
+			c := &backupController{
+				lister:            sharedInformers.Velero().V1().Backups().Lister(),
+				backupQuotaLister: sharedInformers.Velero().V1().BackupQuotas().Lister(),
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			for _, backup := range test.backups {
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			}
+			for _, quota := range test.quotas {
+				require.NoError(t, sharedInformers.Velero().V1().BackupQuotas().Informer().GetStore().Add(quota))
+			}
+
+			assert.Equal(t, test.expectedErrs, c.validateBackupQuotas(test.backup))
+		})
+	}
+}
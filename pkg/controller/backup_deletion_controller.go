@@ -18,26 +18,35 @@ package controller
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/identity"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
@@ -47,26 +56,41 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
-const resticTimeout = time.Minute
+const (
+	resticTimeout = time.Minute
+
+	// deletionEventComponent is the source component of the events that
+	// are recorded on backups when they're deleted.
+	deletionEventComponent = "velero"
+)
 
 type backupDeletionController struct {
 	*genericController
 
-	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter
-	deleteBackupRequestLister listers.DeleteBackupRequestLister
-	backupClient              velerov1client.BackupsGetter
-	restoreLister             listers.RestoreLister
-	restoreClient             velerov1client.RestoresGetter
-	backupTracker             BackupTracker
-	resticMgr                 restic.RepositoryManager
-	podvolumeBackupLister     listers.PodVolumeBackupLister
-	backupLocationLister      listers.BackupStorageLocationLister
-	snapshotLocationLister    listers.VolumeSnapshotLocationLister
-	processRequestFunc        func(*v1.DeleteBackupRequest) error
-	clock                     clock.Clock
-	newPluginManager          func(logrus.FieldLogger) clientmgmt.Manager
-	newBackupStore            func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
-	metrics                   *metrics.ServerMetrics
+	deleteBackupRequestClient  velerov1client.DeleteBackupRequestsGetter
+	deleteBackupRequestLister  listers.DeleteBackupRequestLister
+	deleteBackupRequestIndexer cache.Indexer
+	approvalClient             velerov1client.DeleteBackupApprovalsGetter
+	approvalLister             listers.DeleteBackupApprovalLister
+	approvalIndexer            cache.Indexer
+	approvalTTL                time.Duration
+	identitySigner             *identity.Signer
+	backupClient               velerov1client.BackupsGetter
+	eventClient                corev1client.EventsGetter
+	restoreIndexer             cache.Indexer
+	restoreClient              velerov1client.RestoresGetter
+	backupTracker              BackupTracker
+	resticMgr                  restic.RepositoryManager
+	podvolumeBackupLister      listers.PodVolumeBackupLister
+	backupLocationLister       listers.BackupStorageLocationLister
+	snapshotLocationLister     listers.VolumeSnapshotLocationLister
+	dynamicFactory             client.DynamicFactory
+	discoveryHelper            discovery.Helper
+	processRequestFunc         func(*v1.DeleteBackupRequest) error
+	clock                      clock.Clock
+	newPluginManager           func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore             func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	metrics                    *metrics.ServerMetrics
 }
 
 // NewBackupDeletionController creates a new backup deletion controller.
@@ -74,7 +98,12 @@ func NewBackupDeletionController(
 	logger logrus.FieldLogger,
 	deleteBackupRequestInformer informers.DeleteBackupRequestInformer,
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
+	approvalInformer informers.DeleteBackupApprovalInformer,
+	approvalClient velerov1client.DeleteBackupApprovalsGetter,
+	approvalTTL time.Duration,
+	identitySigner *identity.Signer,
 	backupClient velerov1client.BackupsGetter,
+	eventClient corev1client.EventsGetter,
 	restoreInformer informers.RestoreInformer,
 	restoreClient velerov1client.RestoresGetter,
 	backupTracker BackupTracker,
@@ -82,22 +111,33 @@ func NewBackupDeletionController(
 	podvolumeBackupInformer informers.PodVolumeBackupInformer,
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	snapshotLocationInformer informers.VolumeSnapshotLocationInformer,
+	dynamicFactory client.DynamicFactory,
+	discoveryHelper discovery.Helper,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	metrics *metrics.ServerMetrics,
 ) Interface {
 	c := &backupDeletionController{
-		genericController:         newGenericController("backup-deletion", logger),
-		deleteBackupRequestClient: deleteBackupRequestClient,
-		deleteBackupRequestLister: deleteBackupRequestInformer.Lister(),
-		backupClient:              backupClient,
-		restoreLister:             restoreInformer.Lister(),
-		restoreClient:             restoreClient,
-		backupTracker:             backupTracker,
-		resticMgr:                 resticMgr,
-		podvolumeBackupLister:     podvolumeBackupInformer.Lister(),
-		backupLocationLister:      backupLocationInformer.Lister(),
-		snapshotLocationLister:    snapshotLocationInformer.Lister(),
-		metrics:                   metrics,
+		genericController:          newGenericController("backup-deletion", logger),
+		deleteBackupRequestClient:  deleteBackupRequestClient,
+		deleteBackupRequestLister:  deleteBackupRequestInformer.Lister(),
+		deleteBackupRequestIndexer: deleteBackupRequestInformer.Informer().GetIndexer(),
+		approvalClient:             approvalClient,
+		approvalLister:             approvalInformer.Lister(),
+		approvalIndexer:            approvalInformer.Informer().GetIndexer(),
+		approvalTTL:                approvalTTL,
+		identitySigner:             identitySigner,
+		backupClient:               backupClient,
+		eventClient:                eventClient,
+		restoreIndexer:             restoreInformer.Informer().GetIndexer(),
+		restoreClient:              restoreClient,
+		backupTracker:              backupTracker,
+		resticMgr:                  resticMgr,
+		podvolumeBackupLister:      podvolumeBackupInformer.Lister(),
+		backupLocationLister:       backupLocationInformer.Lister(),
+		snapshotLocationLister:     snapshotLocationInformer.Lister(),
+		dynamicFactory:             dynamicFactory,
+		discoveryHelper:            discoveryHelper,
+		metrics:                    metrics,
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager: newPluginManager,
@@ -110,6 +150,7 @@ func NewBackupDeletionController(
 	c.cacheSyncWaiters = append(
 		c.cacheSyncWaiters,
 		deleteBackupRequestInformer.Informer().HasSynced,
+		approvalInformer.Informer().HasSynced,
 		restoreInformer.Informer().HasSynced,
 		podvolumeBackupInformer.Informer().HasSynced,
 		backupLocationInformer.Informer().HasSynced,
@@ -117,11 +158,12 @@ func NewBackupDeletionController(
 	)
 	c.processRequestFunc = c.processRequest
 
-	deleteBackupRequestInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: c.enqueue,
-		},
-	)
+	addIndexers(deleteBackupRequestInformer.Informer(), cache.Indexers{backupNameIndex: backupNameIndexFunc})
+	addIndexers(approvalInformer.Informer(), cache.Indexers{backupNameIndex: backupNameIndexFunc})
+	addIndexers(restoreInformer.Informer(), cache.Indexers{backupNameIndex: backupNameIndexFunc})
+
+	c.watch(deleteBackupRequestInformer.Informer(), onCreate(nil))
+	c.watchMapped(approvalInformer.Informer(), c.pendingRequests, onCreate(nil))
 
 	c.resyncPeriod = time.Hour
 	c.resyncFunc = c.deleteExpiredRequests
@@ -164,6 +206,8 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 		"namespace": req.Namespace,
 		"name":      req.Name,
 		"backup":    req.Spec.BackupName,
+		"requester": req.Spec.Requester,
+		"reason":    req.Spec.Reason,
 	})
 
 	var err error
@@ -229,6 +273,61 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 		return err
 	}
 
+	// Protected backups are only deleted once someone other than the requester
+	// has approved the deletion. The requester and approvers are only trusted
+	// if the admission webhook verified that they're the users that created
+	// the request and approvals.
+	if backup.Labels[v1.ProtectedBackupLabel] == "true" {
+		if req.Spec.Requester == "" {
+			_, err := c.patchDeleteBackupRequest(req, func(r *v1.DeleteBackupRequest) {
+				r.Status.Phase = v1.DeleteBackupRequestPhaseProcessed
+				r.Status.Errors = []string{"spec.requester is required to delete a protected backup"}
+			})
+			return err
+		}
+
+		if err := c.verifyIdentity("DeleteBackupRequest", req, req.Spec.BackupName, req.Spec.Requester); err != nil {
+			log.WithError(err).Info("Requester of deletion of protected backup wasn't verified")
+			_, err := c.patchDeleteBackupRequest(req, func(r *v1.DeleteBackupRequest) {
+				r.Status.Phase = v1.DeleteBackupRequestPhaseProcessed
+				r.Status.Errors = []string{"the requester of the deletion of a protected backup must be verified by the server's admission webhook"}
+			})
+			return err
+		}
+
+		approver, err := c.findDeletionApprover(req)
+		if err != nil {
+			return err
+		}
+
+		if approver == "" {
+			if c.clock.Now().Sub(req.CreationTimestamp.Time) >= c.approvalTTL {
+				_, err := c.patchDeleteBackupRequest(req, func(r *v1.DeleteBackupRequest) {
+					r.Status.Phase = v1.DeleteBackupRequestPhaseProcessed
+					r.Status.Errors = []string{fmt.Sprintf("deletion of protected backup wasn't approved within %s", c.approvalTTL)}
+				})
+				return err
+			}
+
+			if req.Status.Phase != v1.DeleteBackupRequestPhasePendingApproval {
+				log.Info("Backup is protected, waiting for the deletion to be approved")
+				if _, err := c.patchDeleteBackupRequest(req, func(r *v1.DeleteBackupRequest) {
+					r.Status.Phase = v1.DeleteBackupRequestPhasePendingApproval
+				}); err != nil {
+					return err
+				}
+				c.recordDeletionEvent(backup, corev1api.EventTypeNormal, "DeletionPendingApproval", deletionRequestMessage(req)+", waiting for approval", log)
+			}
+
+			return nil
+		}
+
+		log = log.WithField("approver", approver)
+	}
+
+	log.Info("Deleting backup")
+	c.recordDeletionEvent(backup, corev1api.EventTypeNormal, "DeletionRequested", deletionRequestMessage(req), log)
+
 	// if the request object has no labels defined, initialise an empty map since
 	// we will be updating labels
 	if req.Labels == nil {
@@ -274,7 +373,7 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 	pluginManager := c.newPluginManager(log)
 	defer pluginManager.CleanupClients()
 
-	backupStore, err := c.newBackupStore(location, pluginManager, log)
+	backupStore, err := c.newBackupStore(persistence.MemberClusterLocation(location, backup.Spec.Cluster), pluginManager, log)
 	if err != nil {
 		errs = append(errs, err.Error())
 	}
@@ -306,6 +405,19 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 		}
 	}
 
+	if backup.Spec.Cluster != "" {
+		// CSI volume snapshots of member clusters are in the member cluster,
+		// not this one.
+		log.Infof("Not removing CSI volume snapshots because the backup is of member cluster %s", backup.Spec.Cluster)
+	} else {
+		log.Info("Removing CSI volume snapshots")
+		if deleteErrs := c.deleteCSISnapshots(backup); len(deleteErrs) > 0 {
+			for _, err := range deleteErrs {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+
 	log.Info("Removing restic snapshots")
 	if deleteErrs := c.deleteResticSnapshots(backup); len(deleteErrs) > 0 {
 		for _, err := range deleteErrs {
@@ -320,15 +432,22 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 		}
 	}
 
+	for _, status := range backup.Status.AdditionalStorageLocations {
+		if status.Phase != v1.AdditionalStorageLocationPhaseCompleted {
+			continue
+		}
+
+		log.WithField("additionalStorageLocation", status.Name).Info("Removing backup from additional storage location")
+		if err := c.deleteBackupFromLocation(backup, status.Name, pluginManager, log); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
 	log.Info("Removing restores")
-	if restores, err := c.restoreLister.Restores(backup.Namespace).List(labels.Everything()); err != nil {
+	if restores, err := restoresForBackup(c.restoreIndexer, backup.Namespace, backup.Name); err != nil {
 		log.WithError(errors.WithStack(err)).Error("Error listing restore API objects")
 	} else {
 		for _, restore := range restores {
-			if restore.Spec.BackupName != backup.Name {
-				continue
-			}
-
 			restoreLog := log.WithField("restore", kube.NamespaceAndName(restore))
 
 			restoreLog.Info("Deleting restore log/results from backup storage")
@@ -357,6 +476,7 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 		c.metrics.RegisterBackupDeletionSuccess(backupScheduleName)
 	} else {
 		c.metrics.RegisterBackupDeletionFailed(backupScheduleName)
+		c.recordDeletionEvent(backup, corev1api.EventTypeWarning, "DeletionFailed", fmt.Sprintf("%s, but it failed with %d error(s)", deletionRequestMessage(req), len(errs)), log)
 	}
 
 	// Update status to processed and record errors
@@ -376,11 +496,149 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 			// If this errors, all we can do is log it.
 			c.logger.WithField("backup", kube.NamespaceAndName(backup)).Error("error deleting all associated DeleteBackupRequests after successfully deleting the backup")
 		}
+
+		c.deleteApprovals(backup, log)
 	}
 
 	return nil
 }
 
+// deletionRequestMessage describes who requested a backup's deletion, and why,
+// for the events that are recorded on the backup.
+func deletionRequestMessage(req *v1.DeleteBackupRequest) string {
+	requester := req.Spec.Requester
+	if requester == "" {
+		requester = "an unknown requester"
+	}
+
+	message := fmt.Sprintf("Deletion requested by %s", requester)
+	if req.Spec.Reason != "" {
+		message += fmt.Sprintf(" (reason: %s)", req.Spec.Reason)
+	}
+
+	return message
+}
+
+// recordDeletionEvent records an event on a backup that's being deleted.
+// Failing to record it doesn't stop the deletion, so errors are only logged.
+func (c *backupDeletionController) recordDeletionEvent(backup *v1.Backup, eventType, reason, message string, log logrus.FieldLogger) {
+	ref := corev1api.ObjectReference{
+		APIVersion:      v1.SchemeGroupVersion.String(),
+		Kind:            "Backup",
+		Namespace:       backup.Namespace,
+		Name:            backup.Name,
+		UID:             backup.UID,
+		ResourceVersion: backup.ResourceVersion,
+	}
+
+	if err := kube.RecordEvent(c.eventClient, deletionEventComponent, ref, eventType, reason, message, c.clock.Now()); err != nil {
+		log.WithError(err).Warn("Error recording deletion event on backup")
+	}
+}
+
+// findDeletionApprover returns the approver of an unexpired, verified
+// DeleteBackupApproval for req's backup from someone other than req's
+// requester, or "" if there isn't one.
+func (c *backupDeletionController) findDeletionApprover(req *v1.DeleteBackupRequest) (string, error) {
+	approvals, err := approvalsForBackup(c.approvalIndexer, req.Namespace, req.Spec.BackupName)
+	if err != nil {
+		return "", errors.Wrap(err, "error listing DeleteBackupApprovals")
+	}
+
+	now := c.clock.Now()
+	for _, approval := range approvals {
+		if now.Sub(approval.CreationTimestamp.Time) >= c.approvalTTL {
+			continue
+		}
+		if approval.Spec.Approver == "" || approval.Spec.Approver == req.Spec.Requester {
+			continue
+		}
+		// approvers that weren't verified by the admission webhook could
+		// have been set to anyone by whoever created the approval.
+		if err := c.verifyIdentity("DeleteBackupApproval", approval, approval.Spec.BackupName, approval.Spec.Approver); err != nil {
+			c.logger.WithError(err).WithFields(logrus.Fields{
+				"approval": kube.NamespaceAndName(approval),
+				"approver": approval.Spec.Approver,
+			}).Warn("Ignoring DeleteBackupApproval whose approver wasn't verified by the admission webhook")
+			continue
+		}
+
+		return approval.Spec.Approver, nil
+	}
+
+	return "", nil
+}
+
+// verifyIdentity returns an error unless the admission webhook verified that
+// the requester or approver of an object of the given kind is the user that
+// created it, by signing the user into the object's annotation within the
+// approval TTL before the object was created, so that annotations copied
+// onto new objects expire. Every identity is unverified if the server doesn't
+// run the webhook, since it doesn't have a key to verify them with then.
+func (c *backupDeletionController) verifyIdentity(kind string, obj metav1.Object, backupName, user string) error {
+	if c.identitySigner == nil {
+		return errors.New("the server's admission webhook isn't enabled")
+	}
+
+	return c.identitySigner.Verify(kind, obj.GetNamespace(), backupName, user, obj.GetAnnotations()[v1.IdentityVerifiedAnnotation], obj.GetCreationTimestamp().Time, c.approvalTTL)
+}
+
+// pendingRequests returns the requests for the deletion requests that are
+// waiting for the approval's backup to be approved.
+func (c *backupDeletionController) pendingRequests(obj handler.MapObject) []reconcile.Request {
+	approval := obj.Object.(*v1.DeleteBackupApproval)
+
+	deletionRequests, err := deleteBackupRequestsForBackup(c.deleteBackupRequestIndexer, approval.Namespace, approval.Spec.BackupName)
+	if err != nil {
+		c.logger.WithError(err).Error("Error listing DeleteBackupRequests")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, req := range deletionRequests {
+		if req.Status.Phase == v1.DeleteBackupRequestPhasePendingApproval {
+			requests = append(requests, requestFor(req.Namespace, req.Name))
+		}
+	}
+
+	return requests
+}
+
+// deleteApprovals deletes the DeleteBackupApprovals for a backup that's been
+// deleted, so that they don't approve the deletion of a new backup with the
+// same name.
+func (c *backupDeletionController) deleteApprovals(backup *v1.Backup, log logrus.FieldLogger) {
+	approvals, err := approvalsForBackup(c.approvalIndexer, backup.Namespace, backup.Name)
+	if err != nil {
+		log.WithError(err).Error("Error listing DeleteBackupApprovals")
+		return
+	}
+
+	for _, approval := range approvals {
+		if err := c.approvalClient.DeleteBackupApprovals(approval.Namespace).Delete(approval.Name, nil); err != nil {
+			log.WithError(errors.WithStack(err)).WithField("approval", approval.Name).Error("Error deleting DeleteBackupApproval")
+		}
+	}
+}
+
+func (c *backupDeletionController) deleteBackupFromLocation(backup *v1.Backup, locationName string, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	location, err := c.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(locationName)
+	if err != nil {
+		return errors.Wrapf(err, "error getting additional storage location %s", locationName)
+	}
+
+	if location.Spec.AccessMode == v1.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("cannot delete backup from additional storage location %s because it is currently in read-only mode", location.Name)
+	}
+
+	backupStore, err := c.newBackupStore(persistence.MemberClusterLocation(location, backup.Spec.Cluster), pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	return backupStore.DeleteBackup(backup.Name)
+}
+
 func volumeSnapshotterForSnapshotLocation(
 	namespace, snapshotLocationName string,
 	snapshotLocationLister listers.VolumeSnapshotLocationLister,
@@ -396,7 +654,12 @@ func volumeSnapshotterForSnapshotLocation(
 		return nil, errors.Wrapf(err, "error getting volume snapshotter for provider %s", snapshotLocation.Spec.Provider)
 	}
 
-	if err = volumeSnapshotter.Init(snapshotLocation.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentialsFile(velero.ConfigWithIdentity(snapshotLocation.Spec.Config, snapshotLocation.Spec.Identity), snapshotLocation.Spec.Credential, pluginManager)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting credentials file for volume snapshot location %s", snapshotLocationName)
+	}
+
+	if err = volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.Wrapf(err, "error initializing volume snapshotter for volume snapshot location %s", snapshotLocationName)
 	}
 
@@ -405,10 +668,7 @@ func volumeSnapshotterForSnapshotLocation(
 
 func (c *backupDeletionController) deleteExistingDeletionRequests(req *v1.DeleteBackupRequest, log logrus.FieldLogger) []error {
 	log.Info("Removing existing deletion requests for backup")
-	selector := labels.SelectorFromSet(labels.Set(map[string]string{
-		v1.BackupNameLabel: label.GetValidName(req.Spec.BackupName),
-	}))
-	dbrs, err := c.deleteBackupRequestLister.DeleteBackupRequests(req.Namespace).List(selector)
+	dbrs, err := deleteBackupRequestsForBackup(c.deleteBackupRequestIndexer, req.Namespace, req.Spec.BackupName)
 	if err != nil {
 		return []error{errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")}
 	}
@@ -450,6 +710,56 @@ func (c *backupDeletionController) deleteResticSnapshots(backup *v1.Backup) []er
 	return errs
 }
 
+// deleteCSISnapshots deletes the CSI VolumeSnapshots taken by a backup, if
+// the CSI feature is enabled and the cluster serves volume snapshots.
+func (c *backupDeletionController) deleteCSISnapshots(backup *v1.Backup) []error {
+	if !features.IsEnabled(v1.CSIFeatureFlag) || c.dynamicFactory == nil || c.discoveryHelper == nil {
+		return nil
+	}
+
+	gvr, resource, err := c.discoveryHelper.ResourceFor(kuberesource.VolumeSnapshots.WithVersion(""))
+	if err != nil {
+		// the cluster doesn't serve volume snapshots, so the backup
+		// didn't take any.
+		return nil
+	}
+
+	listClient, err := c.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, "")
+	if err != nil {
+		return []error{err}
+	}
+	res, err := listClient.List(metav1.ListOptions{
+		LabelSelector: labels.Set{v1.BackupNameLabel: label.GetValidName(backup.Name)}.String(),
+	})
+	if err != nil {
+		return []error{errors.Wrap(err, "error listing volume snapshots")}
+	}
+	snapshots, err := meta.ExtractList(res)
+	if err != nil {
+		return []error{errors.WithStack(err)}
+	}
+
+	var errs []error
+	for _, obj := range snapshots {
+		snapshot, err := meta.Accessor(obj)
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+
+		snapshotClient, err := c.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, snapshot.GetNamespace())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := snapshotClient.Delete(snapshot.GetName(), &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "error deleting volume snapshot %s/%s", snapshot.GetNamespace(), snapshot.GetName()))
+		}
+	}
+
+	return errs
+}
+
 const deleteBackupRequestMaxAge = 24 * time.Hour
 
 func (c *backupDeletionController) deleteExpiredRequests() {
@@ -466,6 +776,13 @@ func (c *backupDeletionController) deleteExpiredRequests() {
 	now := c.clock.Now()
 
 	for _, req := range requests {
+		// Requests that are still waiting for approval are processed again,
+		// so that they're failed if they've been waiting too long
+		if req.Status.Phase == v1.DeleteBackupRequestPhasePendingApproval && now.Sub(req.CreationTimestamp.Time) >= c.approvalTTL {
+			c.enqueue(req)
+			continue
+		}
+
 		if req.Status.Phase != v1.DeleteBackupRequestPhaseProcessed {
 			continue
 		}
@@ -481,62 +798,55 @@ func (c *backupDeletionController) deleteExpiredRequests() {
 			}
 		}
 	}
-}
 
-func (c *backupDeletionController) patchDeleteBackupRequest(req *v1.DeleteBackupRequest, mutate func(*v1.DeleteBackupRequest)) (*v1.DeleteBackupRequest, error) {
-	// Record original json
-	oldData, err := json.Marshal(req)
+	approvals, err := c.approvalLister.List(labels.Everything())
 	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling original DeleteBackupRequest")
+		c.logger.WithError(err).Error("unable to check for expired DeleteBackupApprovals")
+		return
 	}
 
-	// Mutate
-	mutate(req)
+	for _, approval := range approvals {
+		if now.Sub(approval.CreationTimestamp.Time) < c.approvalTTL {
+			continue
+		}
 
-	// Record new json
-	newData, err := json.Marshal(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling updated DeleteBackupRequest")
-	}
+		approvalLog := c.logger.WithFields(logrus.Fields{"namespace": approval.Namespace, "name": approval.Name})
+		approvalLog.Info("Deleting expired DeleteBackupApproval")
 
-	patchBytes, err := jsonpatch.CreateMergePatch(oldData, newData)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating json merge patch for DeleteBackupRequest")
+		if err := c.approvalClient.DeleteBackupApprovals(approval.Namespace).Delete(approval.Name, nil); err != nil {
+			approvalLog.WithError(err).Error("Error deleting DeleteBackupApproval")
+		}
 	}
+}
+
+func (c *backupDeletionController) patchDeleteBackupRequest(req *v1.DeleteBackupRequest, mutate func(*v1.DeleteBackupRequest)) (*v1.DeleteBackupRequest, error) {
+	original := req.DeepCopy()
+	mutate(req)
 
-	req, err = c.deleteBackupRequestClient.DeleteBackupRequests(req.Namespace).Patch(req.Name, types.MergePatchType, patchBytes)
+	var res *v1.DeleteBackupRequest
+	err := kube.Patch(original, req, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) (err error) {
+		res, err = c.deleteBackupRequestClient.DeleteBackupRequests(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error patching DeleteBackupRequest")
 	}
 
-	return req, nil
+	return res, nil
 }
 
 func (c *backupDeletionController) patchBackup(backup *v1.Backup, mutate func(*v1.Backup)) (*v1.Backup, error) {
-	// Record original json
-	oldData, err := json.Marshal(backup)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling original Backup")
-	}
-
-	// Mutate
+	original := backup.DeepCopy()
 	mutate(backup)
 
-	// Record new json
-	newData, err := json.Marshal(backup)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling updated Backup")
-	}
-
-	patchBytes, err := jsonpatch.CreateMergePatch(oldData, newData)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating json merge patch for Backup")
-	}
-
-	backup, err = c.backupClient.Backups(backup.Namespace).Patch(backup.Name, types.MergePatchType, patchBytes)
+	var res *v1.Backup
+	err := kube.Patch(original, backup, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) (err error) {
+		res, err = c.backupClient.Backups(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error patching Backup")
 	}
 
-	return backup, nil
+	return res, nil
 }
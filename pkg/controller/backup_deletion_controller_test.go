@@ -30,13 +30,17 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/identity"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
@@ -54,7 +58,12 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 		velerotest.NewLogger(),
 		sharedInformers.Velero().V1().DeleteBackupRequests(),
 		client.VeleroV1(), // deleteBackupRequestClient
-		client.VeleroV1(), // backupClient
+		sharedInformers.Velero().V1().DeleteBackupApprovals(),
+		client.VeleroV1(),                      // approvalClient
+		24*time.Hour,                           // approvalTTL
+		nil,                                    // identitySigner
+		client.VeleroV1(),                      // backupClient
+		kubefake.NewSimpleClientset().CoreV1(), // eventClient
 		sharedInformers.Velero().V1().Restores(),
 		client.VeleroV1(), // restoreClient
 		NewBackupTracker(),
@@ -62,6 +71,8 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 		sharedInformers.Velero().V1().PodVolumeBackups(),
 		sharedInformers.Velero().V1().BackupStorageLocations(),
 		sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+		nil, // dynamicFactory
+		nil, // discoveryHelper
 		nil, // new plugin manager func
 		metrics.NewServerMetrics(),
 	).(*backupDeletionController)
@@ -115,6 +126,7 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 
 type backupDeletionControllerTestData struct {
 	client            *fake.Clientset
+	kubeClient        *kubefake.Clientset
 	sharedInformers   informers.SharedInformerFactory
 	volumeSnapshotter *velerotest.FakeVolumeSnapshotter
 	backupStore       *persistencemocks.BackupStore
@@ -129,6 +141,7 @@ func setupBackupDeletionControllerTest(objects ...runtime.Object) *backupDeletio
 
 	var (
 		client            = fake.NewSimpleClientset(append(objects, req)...)
+		kubeClient        = kubefake.NewSimpleClientset()
 		sharedInformers   = informers.NewSharedInformerFactory(client, 0)
 		volumeSnapshotter = &velerotest.FakeVolumeSnapshotter{SnapshotsTaken: sets.NewString()}
 		pluginManager     = &pluginmocks.Manager{}
@@ -137,6 +150,7 @@ func setupBackupDeletionControllerTest(objects ...runtime.Object) *backupDeletio
 
 	data := &backupDeletionControllerTestData{
 		client:            client,
+		kubeClient:        kubeClient,
 		sharedInformers:   sharedInformers,
 		volumeSnapshotter: volumeSnapshotter,
 		backupStore:       backupStore,
@@ -144,7 +158,12 @@ func setupBackupDeletionControllerTest(objects ...runtime.Object) *backupDeletio
 			velerotest.NewLogger(),
 			sharedInformers.Velero().V1().DeleteBackupRequests(),
 			client.VeleroV1(), // deleteBackupRequestClient
-			client.VeleroV1(), // backupClient
+			sharedInformers.Velero().V1().DeleteBackupApprovals(),
+			client.VeleroV1(),   // approvalClient
+			24*time.Hour,        // approvalTTL
+			nil,                 // identitySigner
+			client.VeleroV1(),   // backupClient
+			kubeClient.CoreV1(), // eventClient
 			sharedInformers.Velero().V1().Restores(),
 			client.VeleroV1(), // restoreClient
 			NewBackupTracker(),
@@ -152,6 +171,8 @@ func setupBackupDeletionControllerTest(objects ...runtime.Object) *backupDeletio
 			sharedInformers.Velero().V1().PodVolumeBackups(),
 			sharedInformers.Velero().V1().BackupStorageLocations(),
 			sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+			nil, // dynamicFactory
+			nil, // discoveryHelper
 			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			metrics.NewServerMetrics(),
 		).(*backupDeletionController),
@@ -466,6 +487,9 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		// (https://github.com/vmware-tanzu/velero/issues/1546)
 		td.req.Labels = nil
 
+		td.req.Spec.Requester = "alice"
+		td.req.Spec.Reason = "CHG-1234"
+
 		td.client.PrependReactor("get", "backups", func(action core.Action) (bool, runtime.Object, error) {
 			return true, backup, nil
 		})
@@ -563,6 +587,15 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 
 		// Make sure snapshot was deleted
 		assert.Equal(t, 0, td.volumeSnapshotter.SnapshotsTaken.Len())
+
+		// Make sure the deletion was recorded on the backup
+		events, err := td.kubeClient.CoreV1().Events(backup.Namespace).List(metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, events.Items, 1)
+		assert.Equal(t, "DeletionRequested", events.Items[0].Reason)
+		assert.Equal(t, "Deletion requested by alice (reason: CHG-1234)", events.Items[0].Message)
+		assert.Equal(t, "Backup", events.Items[0].InvolvedObject.Kind)
+		assert.Equal(t, backup.UID, events.Items[0].InvolvedObject.UID)
 	})
 
 	t.Run("full delete, no errors, with backup name greater than 63 chars", func(t *testing.T) {
@@ -842,7 +875,12 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 				velerotest.NewLogger(),
 				sharedInformers.Velero().V1().DeleteBackupRequests(),
 				client.VeleroV1(), // deleteBackupRequestClient
-				client.VeleroV1(), // backupClient
+				sharedInformers.Velero().V1().DeleteBackupApprovals(),
+				client.VeleroV1(),                      // approvalClient
+				24*time.Hour,                           // approvalTTL
+				nil,                                    // identitySigner
+				client.VeleroV1(),                      // backupClient
+				kubefake.NewSimpleClientset().CoreV1(), // eventClient
 				sharedInformers.Velero().V1().Restores(),
 				client.VeleroV1(), // restoreClient
 				NewBackupTracker(),
@@ -850,6 +888,8 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 				sharedInformers.Velero().V1().PodVolumeBackups(),
 				sharedInformers.Velero().V1().BackupStorageLocations(),
 				sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+				nil, // dynamicFactory
+				nil, // discoveryHelper
 				nil, // new plugin manager func
 				metrics.NewServerMetrics(),
 			).(*backupDeletionController)
@@ -873,3 +913,242 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestDeletionRequestMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		requester string
+		reason    string
+		want      string
+	}{
+		{
+			name: "no requester or reason",
+			want: "Deletion requested by an unknown requester",
+		},
+		{
+			name:      "requester without a reason",
+			requester: "alice",
+			want:      "Deletion requested by alice",
+		},
+		{
+			name:      "requester and reason",
+			requester: "velero-gc",
+			reason:    "backup expired",
+			want:      "Deletion requested by velero-gc (reason: backup expired)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := pkgbackup.NewDeleteBackupRequest("foo", "uid")
+			req.Spec.Requester = tc.requester
+			req.Spec.Reason = tc.reason
+
+			assert.Equal(t, tc.want, deletionRequestMessage(req))
+		})
+	}
+}
+
+func TestBackupDeletionControllerProtectedBackups(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	signer, err := identity.NewSigner([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	// verified returns the annotation that the webhook sets on an approval
+	// of foo by approver that it verified at verifiedAt.
+	verified := func(approver string, verifiedAt time.Time) builder.ObjectMetaOpt {
+		return builder.WithAnnotations(v1.IdentityVerifiedAnnotation, signer.Sign("DeleteBackupApproval", "velero", "foo", approver, verifiedAt))
+	}
+
+	tests := []struct {
+		name        string
+		requester   string
+		unverified  bool
+		noWebhook   bool
+		requestAge  time.Duration
+		approvals   []*v1.DeleteBackupApproval
+		wantPatches []string
+		wantDeleted bool
+		wantEvent   string
+	}{
+		{
+			name:        "deletion without a requester fails",
+			wantPatches: []string{`{"status":{"errors":["spec.requester is required to delete a protected backup"],"phase":"Processed"}}`},
+		},
+		{
+			name:        "deletion whose requester wasn't verified fails",
+			requester:   "alice",
+			unverified:  true,
+			wantPatches: []string{`{"status":{"errors":["the requester of the deletion of a protected backup must be verified by the server's admission webhook"],"phase":"Processed"}}`},
+		},
+		{
+			name:        "deletion fails if the server doesn't run the admission webhook",
+			requester:   "alice",
+			noWebhook:   true,
+			wantPatches: []string{`{"status":{"errors":["the requester of the deletion of a protected backup must be verified by the server's admission webhook"],"phase":"Processed"}}`},
+		},
+		{
+			name:        "deletion without an approval waits for approval",
+			requester:   "alice",
+			wantPatches: []string{`{"status":{"phase":"PendingApproval"}}`},
+			wantEvent:   "DeletionPendingApproval",
+		},
+		{
+			name:      "deletion approved by the requester waits for approval",
+			requester: "alice",
+			approvals: []*v1.DeleteBackupApproval{
+				builder.ForDeleteBackupApproval("velero", "foo-1").BackupName("foo").Approver("alice").ObjectMeta(builder.WithCreationTimestamp(now), verified("alice", now)).Result(),
+			},
+			wantPatches: []string{`{"status":{"phase":"PendingApproval"}}`},
+			wantEvent:   "DeletionPendingApproval",
+		},
+		{
+			name:      "approvals for other backups and expired approvals don't approve the deletion",
+			requester: "alice",
+			approvals: []*v1.DeleteBackupApproval{
+				builder.ForDeleteBackupApproval("velero", "bar-1").BackupName("bar").Approver("bob").ObjectMeta(builder.WithCreationTimestamp(now), verified("bob", now)).Result(),
+				builder.ForDeleteBackupApproval("velero", "foo-1").BackupName("foo").Approver("bob").ObjectMeta(builder.WithCreationTimestamp(now.Add(-25*time.Hour)), verified("bob", now.Add(-25*time.Hour))).Result(),
+			},
+			wantPatches: []string{`{"status":{"phase":"PendingApproval"}}`},
+			wantEvent:   "DeletionPendingApproval",
+		},
+		{
+			name:      "self-declared approvers that weren't verified don't approve the deletion",
+			requester: "alice",
+			approvals: []*v1.DeleteBackupApproval{
+				builder.ForDeleteBackupApproval("velero", "foo-1").BackupName("foo").Approver("bob").ObjectMeta(builder.WithCreationTimestamp(now)).Result(),
+			},
+			wantPatches: []string{`{"status":{"phase":"PendingApproval"}}`},
+			wantEvent:   "DeletionPendingApproval",
+		},
+		{
+			name:      "approvals that users annotated as verified themselves don't approve the deletion",
+			requester: "alice",
+			approvals: []*v1.DeleteBackupApproval{
+				builder.ForDeleteBackupApproval("velero", "foo-1").BackupName("foo").Approver("bob").ObjectMeta(builder.WithCreationTimestamp(now), builder.WithAnnotations(v1.IdentityVerifiedAnnotation, "true")).Result(),
+			},
+			wantPatches: []string{`{"status":{"phase":"PendingApproval"}}`},
+			wantEvent:   "DeletionPendingApproval",
+		},
+		{
+			name:      "approvals with annotations copied from another approver's or from expired approvals don't approve the deletion",
+			requester: "alice",
+			approvals: []*v1.DeleteBackupApproval{
+				builder.ForDeleteBackupApproval("velero", "foo-1").BackupName("foo").Approver("carol").ObjectMeta(builder.WithCreationTimestamp(now), verified("bob", now)).Result(),
+				builder.ForDeleteBackupApproval("velero", "foo-2").BackupName("foo").Approver("bob").ObjectMeta(builder.WithCreationTimestamp(now), verified("bob", now.Add(-25*time.Hour))).Result(),
+			},
+			wantPatches: []string{`{"status":{"phase":"PendingApproval"}}`},
+			wantEvent:   "DeletionPendingApproval",
+		},
+		{
+			name:        "deletion that wasn't approved in time fails",
+			requester:   "alice",
+			requestAge:  25 * time.Hour,
+			wantPatches: []string{`{"status":{"errors":["deletion of protected backup wasn't approved within 24h0m0s"],"phase":"Processed"}}`},
+		},
+		{
+			name:      "deletion approved by someone else goes ahead",
+			requester: "alice",
+			approvals: []*v1.DeleteBackupApproval{
+				builder.ForDeleteBackupApproval("velero", "foo-1").BackupName("foo").Approver("bob").ObjectMeta(builder.WithCreationTimestamp(now), verified("bob", now)).Result(),
+			},
+			wantPatches: []string{`{"status":{"phase":"InProgress"}}`},
+			wantDeleted: true,
+			wantEvent:   "DeletionRequested",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := builder.ForBackup(v1.DefaultNamespace, "foo").StorageLocation("default").
+				ObjectMeta(builder.WithLabels(v1.ProtectedBackupLabel, "true")).Result()
+			location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+			td := setupBackupDeletionControllerTest(backup)
+			td.controller.clock = clock.NewFakeClock(now)
+			if !tc.noWebhook {
+				td.controller.identitySigner = signer
+			}
+			td.req.Spec.Requester = tc.requester
+			td.req.CreationTimestamp = metav1.NewTime(now.Add(-tc.requestAge))
+			if tc.unverified {
+				td.req.Annotations = map[string]string{v1.IdentityVerifiedAnnotation: "true"}
+			} else {
+				td.req.Annotations = map[string]string{v1.IdentityVerifiedAnnotation: signer.Sign("DeleteBackupRequest", "velero", "foo", tc.requester, td.req.CreationTimestamp.Time)}
+			}
+
+			require.NoError(t, td.sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+			for _, approval := range tc.approvals {
+				require.NoError(t, td.sharedInformers.Velero().V1().DeleteBackupApprovals().Informer().GetStore().Add(approval))
+			}
+
+			td.client.PrependReactor("patch", "deletebackuprequests", func(action core.Action) (bool, runtime.Object, error) {
+				return true, td.req, nil
+			})
+			// stop the deletion once the backup is patched to Deleting, since
+			// that's where approved deletions go ahead.
+			td.client.PrependReactor("patch", "backups", func(action core.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("bad")
+			})
+
+			err := td.controller.processRequest(td.req)
+			if tc.wantDeleted {
+				assert.EqualError(t, err, "error patching Backup: bad")
+			} else {
+				require.NoError(t, err)
+			}
+
+			var patches []string
+			var deleting bool
+			for _, action := range td.client.Actions() {
+				patch, ok := action.(core.PatchAction)
+				if !ok {
+					continue
+				}
+				switch patch.GetResource().Resource {
+				case "deletebackuprequests":
+					patches = append(patches, string(patch.GetPatch()))
+				case "backups":
+					deleting = true
+				}
+			}
+			assert.Equal(t, tc.wantPatches, patches)
+			assert.Equal(t, tc.wantDeleted, deleting)
+
+			events, err := td.kubeClient.CoreV1().Events(backup.Namespace).List(metav1.ListOptions{})
+			require.NoError(t, err)
+			if tc.wantEvent == "" {
+				assert.Empty(t, events.Items)
+			} else {
+				require.Len(t, events.Items, 1)
+				assert.Equal(t, tc.wantEvent, events.Items[0].Reason)
+			}
+		})
+	}
+}
+
+func TestBackupDeletionControllerPendingRequests(t *testing.T) {
+	td := setupBackupDeletionControllerTest()
+
+	pending := pkgbackup.NewDeleteBackupRequest("foo", "uid")
+	pending.Namespace, pending.Name = "velero", "foo-pending"
+	pending.Status.Phase = v1.DeleteBackupRequestPhasePendingApproval
+
+	processed := pending.DeepCopy()
+	processed.Name = "foo-processed"
+	processed.Status.Phase = v1.DeleteBackupRequestPhaseProcessed
+
+	other := pkgbackup.NewDeleteBackupRequest("bar", "uid")
+	other.Namespace, other.Name = "velero", "bar-pending"
+	other.Status.Phase = v1.DeleteBackupRequestPhasePendingApproval
+
+	for _, req := range []*v1.DeleteBackupRequest{pending, processed, other} {
+		require.NoError(t, td.sharedInformers.Velero().V1().DeleteBackupRequests().Informer().GetStore().Add(req))
+	}
+
+	approval := builder.ForDeleteBackupApproval("velero", "foo-1").BackupName("foo").Approver("bob").Result()
+	requests := td.controller.pendingRequests(handler.MapObject{Meta: approval, Object: approval})
+
+	assert.Equal(t, []reconcile.Request{requestFor("velero", "foo-pending")}, requests)
+}
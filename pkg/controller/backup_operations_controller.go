@@ -0,0 +1,319 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+const (
+	// defaultPluginOperationPollPeriod is how often the progress of plugin
+	// operations is checked by default.
+	defaultPluginOperationPollPeriod = 10 * time.Second
+
+	// DefaultPluginOperationTimeout is how long backups wait for plugin
+	// operations by default, before canceling them.
+	DefaultPluginOperationTimeout = 4 * time.Hour
+)
+
+// backupOperationsController waits for the operations started by backup item
+// action plugins during backups, and completes the backups once they've all
+// finished.
+type backupOperationsController struct {
+	*genericController
+
+	lister               listers.BackupLister
+	client               velerov1client.BackupsGetter
+	backupLocationLister listers.BackupStorageLocationLister
+	newPluginManager     func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore       func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	operationTimeout     time.Duration
+	metrics              *metrics.ServerMetrics
+	clock                clock.Clock
+}
+
+// NewBackupOperationsController constructs a new backupOperationsController.
+func NewBackupOperationsController(
+	logger logrus.FieldLogger,
+	backupInformer informers.BackupInformer,
+	client velerov1client.BackupsGetter,
+	backupLocationInformer informers.BackupStorageLocationInformer,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	operationTimeout time.Duration,
+	metrics *metrics.ServerMetrics,
+) Interface {
+	c := &backupOperationsController{
+		genericController:    newGenericController("backup-operations", logger),
+		lister:               backupInformer.Lister(),
+		client:               client,
+		backupLocationLister: backupLocationInformer.Lister(),
+		newPluginManager:     newPluginManager,
+		newBackupStore:       persistence.NewObjectBackupStore,
+		operationTimeout:     operationTimeout,
+		metrics:              metrics,
+		clock:                clock.RealClock{},
+	}
+
+	c.syncHandler = c.processQueueItem
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters,
+		backupInformer.Informer().HasSynced,
+		backupLocationInformer.Informer().HasSynced,
+	)
+	c.resyncFunc = c.enqueueWaitingBackups
+	c.resyncPeriod = defaultPluginOperationPollPeriod
+
+	return c
+}
+
+// waitingForPluginOperations returns whether a backup is waiting for plugin
+// operations to finish.
+func waitingForPluginOperations(backup *velerov1api.Backup) bool {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// enqueueWaitingBackups enqueues the backups that are waiting for plugin
+// operations, so that the operations' progress is checked.
+func (c *backupOperationsController) enqueueWaitingBackups() {
+	backups, err := c.lister.List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing backups")
+		return
+	}
+
+	for _, backup := range backups {
+		if waitingForPluginOperations(backup) {
+			c.enqueue(backup)
+		}
+	}
+}
+
+func (c *backupOperationsController) processQueueItem(key string) error {
+	log := c.logger.WithField("backup", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	original, err := c.lister.Backups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+
+	if !waitingForPluginOperations(original) {
+		return nil
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backup := original.DeepCopy()
+	now := c.clock.Now()
+
+	done := true
+	for i := range backup.Status.PluginOperations {
+		operation := &backup.Status.PluginOperations[i]
+		if operation.Phase != velerov1api.PluginOperationPhaseInProgress {
+			continue
+		}
+
+		c.checkOperation(backup, operation, pluginManager, now, log)
+
+		if operation.Phase == velerov1api.PluginOperationPhaseInProgress {
+			done = false
+		}
+	}
+
+	if done {
+		partiallyFailed := backup.Status.Phase == velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed
+		for _, operation := range backup.Status.PluginOperations {
+			if operation.Phase == velerov1api.PluginOperationPhaseFailed {
+				backup.Status.Errors++
+				partiallyFailed = true
+			}
+		}
+
+		backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
+		if partiallyFailed {
+			backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+			c.metrics.RegisterBackupPartialFailure(backupScheduleName)
+		} else {
+			backup.Status.Phase = velerov1api.BackupPhaseCompleted
+			c.metrics.RegisterBackupSuccess(backupScheduleName)
+		}
+		backup.Status.CompletionTimestamp = metav1.NewTime(now)
+
+		log.WithField("phase", backup.Status.Phase).Info("Backup's plugin operations have finished")
+
+		// the backup's metadata in object storage is updated before the
+		// backup is, so that it's retried if the upload fails.
+		if err := c.updateBackupMetadata(backup, pluginManager, log); err != nil {
+			return err
+		}
+	}
+
+	if apiequality.Semantic.DeepEqual(original.Status, backup.Status) {
+		return nil
+	}
+
+	if _, err := patchBackup(original, backup, c.client); err != nil {
+		return errors.Wrapf(err, "error updating backup's plugin operations")
+	}
+
+	return nil
+}
+
+// checkOperation updates an in-progress plugin operation with its progress,
+// or cancels it and marks it failed if it has timed out.
+func (c *backupOperationsController) checkOperation(backup *velerov1api.Backup, operation *velerov1api.PluginOperation, pluginManager clientmgmt.Manager, now time.Time, log logrus.FieldLogger) {
+	log = log.WithFields(logrus.Fields{
+		"plugin":      operation.Plugin,
+		"operationID": operation.OperationID,
+	})
+
+	updated := metav1.NewTime(now)
+
+	action, err := pluginManager.GetBackupItemActionV2(operation.Plugin)
+	if err != nil {
+		log.WithError(err).Error("Error getting backup item action for plugin operation")
+		operation.Phase = velerov1api.PluginOperationPhaseFailed
+		operation.Error = err.Error()
+		operation.Updated = &updated
+		return
+	}
+
+	if c.operationTimeout > 0 && operation.Created != nil && now.Sub(operation.Created.Time) > c.operationTimeout {
+		log.Error("Plugin operation timed out, canceling it")
+		if err := action.Cancel(operation.OperationID, backup); err != nil {
+			log.WithError(err).Error("Error canceling plugin operation")
+		}
+		operation.Phase = velerov1api.PluginOperationPhaseFailed
+		operation.Error = fmt.Sprintf("timed out after %s", c.operationTimeout)
+		operation.Updated = &updated
+		return
+	}
+
+	progress, err := action.Progress(operation.OperationID, backup)
+	if err != nil {
+		log.WithError(err).Error("Error getting progress of plugin operation")
+		operation.Phase = velerov1api.PluginOperationPhaseFailed
+		operation.Error = err.Error()
+		operation.Updated = &updated
+		return
+	}
+
+	if operation.NCompleted == progress.NCompleted && operation.NTotal == progress.NTotal &&
+		operation.OperationUnits == progress.OperationUnits && operation.Description == progress.Description && !progress.Completed {
+		// nothing has changed, so the operation isn't updated
+		return
+	}
+
+	operation.NCompleted = progress.NCompleted
+	operation.NTotal = progress.NTotal
+	operation.OperationUnits = progress.OperationUnits
+	operation.Description = progress.Description
+	operation.Updated = &updated
+
+	switch {
+	case progress.Completed && progress.Err != "":
+		log.WithField("error", progress.Err).Error("Plugin operation failed")
+		operation.Phase = velerov1api.PluginOperationPhaseFailed
+		operation.Error = progress.Err
+	case progress.Completed:
+		log.Info("Plugin operation completed")
+		operation.Phase = velerov1api.PluginOperationPhaseCompleted
+	}
+}
+
+// updateBackupMetadata uploads a backup's metadata to its storage location,
+// and to each additional storage location it was copied to, replacing the
+// metadata that was uploaded while it was waiting for plugin operations.
+// A failure to update the metadata in an additional location marks the
+// backup's copy in that location failed, since it's out of date, rather than
+// failing the update.
+func (c *backupOperationsController) updateBackupMetadata(backup *velerov1api.Backup, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	// the additional locations are updated first, so that the metadata in
+	// the backup's storage location records the outcome.
+	for i := range backup.Status.AdditionalStorageLocations {
+		status := &backup.Status.AdditionalStorageLocations[i]
+		if status.Phase != velerov1api.AdditionalStorageLocationPhaseCompleted {
+			continue
+		}
+
+		if err := c.uploadBackupMetadata(backup, status.Name, pluginManager, log); err != nil {
+			log.WithError(err).WithField("additionalStorageLocation", status.Name).Error("Error updating backup metadata in additional storage location")
+			status.Phase = velerov1api.AdditionalStorageLocationPhaseFailed
+			status.Error = err.Error()
+		}
+	}
+
+	return c.uploadBackupMetadata(backup, backup.Spec.StorageLocation, pluginManager, log)
+}
+
+// uploadBackupMetadata replaces a backup's metadata in the given storage
+// location.
+func (c *backupOperationsController) uploadBackupMetadata(backup *velerov1api.Backup, locationName string, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	location, err := c.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(locationName)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup storage location %s", locationName)
+	}
+
+	backupStore, err := c.newBackupStore(persistence.MemberClusterLocation(location, backup.Spec.Cluster), pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	metadata := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup, "json", metadata); err != nil {
+		return errors.Wrap(err, "error encoding backup")
+	}
+
+	if err := backupStore.UpdateBackupMetadata(backup.Name, metadata); err != nil {
+		return errors.Wrap(err, "error uploading backup metadata")
+	}
+
+	return nil
+}
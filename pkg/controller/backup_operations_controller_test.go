@@ -0,0 +1,305 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// fakeOperationAction is a BackupItemActionV2 whose operations' progress is
+// looked up by operation ID.
+type fakeOperationAction struct {
+	progress map[string]velero.OperationProgress
+	canceled []string
+}
+
+func (a *fakeOperationAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (a *fakeOperationAction) Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, error) {
+	return item, nil, "", nil
+}
+
+func (a *fakeOperationAction) Progress(operationID string, backup *api.Backup) (velero.OperationProgress, error) {
+	progress, ok := a.progress[operationID]
+	if !ok {
+		return velero.OperationProgress{}, errors.Errorf("operation %s not found", operationID)
+	}
+	return progress, nil
+}
+
+func (a *fakeOperationAction) Cancel(operationID string, backup *api.Backup) error {
+	a.canceled = append(a.canceled, operationID)
+	return nil
+}
+
+func TestBackupOperationsControllerProcessQueueItem(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+	created := metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+
+	operation := func(id string, phase api.PluginOperationPhase) api.PluginOperation {
+		return api.PluginOperation{
+			Plugin:      "velero.io/snapshot",
+			OperationID: id,
+			Resource:    "persistentvolumeclaims",
+			Namespace:   "ns-1",
+			Name:        id,
+			Phase:       phase,
+			Created:     &created,
+			Updated:     &created,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		backup           *api.Backup
+		progress         map[string]velero.OperationProgress
+		timeout          time.Duration
+		expectUpload     bool
+		wantPhase        api.BackupPhase
+		wantOperations   []api.PluginOperationPhase
+		wantErrors       int
+		wantCanceled     []string
+		wantNoPatch      bool
+		wantCompletionTS bool
+	}{
+		{
+			name:        "backup that isn't waiting for plugin operations is skipped",
+			backup:      defaultBackup().StorageLocation("default").Phase(api.BackupPhaseCompleted).Result(),
+			wantNoPatch: true,
+		},
+		{
+			name: "backup with operations that are still running keeps waiting",
+			backup: defaultBackup().StorageLocation("default").Phase(api.BackupPhaseWaitingForPluginOperations).
+				PluginOperations(operation("op-1", api.PluginOperationPhaseInProgress), operation("op-2", api.PluginOperationPhaseInProgress)).Result(),
+			progress: map[string]velero.OperationProgress{
+				"op-1": {Completed: true},
+				"op-2": {NCompleted: 10, NTotal: 100, OperationUnits: "bytes"},
+			},
+			wantPhase:      api.BackupPhaseWaitingForPluginOperations,
+			wantOperations: []api.PluginOperationPhase{api.PluginOperationPhaseCompleted, api.PluginOperationPhaseInProgress},
+		},
+		{
+			name: "backup is completed when all of its operations are completed",
+			backup: defaultBackup().StorageLocation("default").Phase(api.BackupPhaseWaitingForPluginOperations).
+				PluginOperations(operation("op-1", api.PluginOperationPhaseCompleted), operation("op-2", api.PluginOperationPhaseInProgress)).Result(),
+			progress: map[string]velero.OperationProgress{
+				"op-2": {Completed: true},
+			},
+			expectUpload:     true,
+			wantPhase:        api.BackupPhaseCompleted,
+			wantOperations:   []api.PluginOperationPhase{api.PluginOperationPhaseCompleted, api.PluginOperationPhaseCompleted},
+			wantCompletionTS: true,
+		},
+		{
+			name: "backup is partially failed when an operation fails",
+			backup: defaultBackup().StorageLocation("default").Phase(api.BackupPhaseWaitingForPluginOperations).
+				PluginOperations(operation("op-1", api.PluginOperationPhaseInProgress), operation("op-2", api.PluginOperationPhaseInProgress)).Result(),
+			progress: map[string]velero.OperationProgress{
+				"op-1": {Completed: true, Err: "upload failed"},
+			},
+			expectUpload:     true,
+			wantPhase:        api.BackupPhasePartiallyFailed,
+			wantOperations:   []api.PluginOperationPhase{api.PluginOperationPhaseFailed, api.PluginOperationPhaseFailed},
+			wantErrors:       2,
+			wantCompletionTS: true,
+		},
+		{
+			name: "backup that was already partially failed stays partially failed",
+			backup: defaultBackup().StorageLocation("default").Phase(api.BackupPhaseWaitingForPluginOperationsPartiallyFailed).
+				PluginOperations(operation("op-1", api.PluginOperationPhaseInProgress)).Result(),
+			progress: map[string]velero.OperationProgress{
+				"op-1": {Completed: true},
+			},
+			expectUpload:     true,
+			wantPhase:        api.BackupPhasePartiallyFailed,
+			wantOperations:   []api.PluginOperationPhase{api.PluginOperationPhaseCompleted},
+			wantCompletionTS: true,
+		},
+		{
+			name: "operations that time out are canceled and failed",
+			backup: defaultBackup().StorageLocation("default").Phase(api.BackupPhaseWaitingForPluginOperations).
+				PluginOperations(operation("op-1", api.PluginOperationPhaseInProgress)).Result(),
+			progress: map[string]velero.OperationProgress{
+				"op-1": {},
+			},
+			timeout:          time.Minute,
+			expectUpload:     true,
+			wantPhase:        api.BackupPhasePartiallyFailed,
+			wantOperations:   []api.PluginOperationPhase{api.PluginOperationPhaseFailed},
+			wantErrors:       1,
+			wantCanceled:     []string{"op-1"},
+			wantCompletionTS: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(tc.backup)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+				action          = &fakeOperationAction{progress: tc.progress}
+			)
+
+			c := NewBackupOperationsController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				tc.timeout,
+				metrics.NewServerMetrics(),
+			).(*backupOperationsController)
+			c.clock = fakeClock
+			c.newBackupStore = func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(tc.backup))
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(builder.ForBackupStorageLocation("velero", "default").Result()))
+
+			pluginManager.On("CleanupClients").Return()
+			pluginManager.On("GetBackupItemActionV2", "velero.io/snapshot").Return(action, nil)
+			if tc.expectUpload {
+				backupStore.On("UpdateBackupMetadata", tc.backup.Name, mock.Anything).Return(nil)
+			}
+
+			require.NoError(t, c.processQueueItem(tc.backup.Namespace+"/"+tc.backup.Name))
+
+			backupStore.AssertExpectations(t)
+			assert.Equal(t, tc.wantCanceled, action.canceled)
+
+			if tc.wantNoPatch {
+				assert.Empty(t, client.Actions())
+				return
+			}
+
+			res, err := client.VeleroV1().Backups(tc.backup.Namespace).Get(tc.backup.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantPhase, res.Status.Phase)
+			assert.Equal(t, tc.wantErrors, res.Status.Errors)
+			assert.Equal(t, tc.wantCompletionTS, !res.Status.CompletionTimestamp.IsZero())
+
+			var phases []api.PluginOperationPhase
+			for _, operation := range res.Status.PluginOperations {
+				phases = append(phases, operation.Phase)
+			}
+			assert.Equal(t, tc.wantOperations, phases)
+		})
+	}
+}
+
+func TestBackupOperationsControllerUpdatesAdditionalStorageLocations(t *testing.T) {
+	backup := defaultBackup().StorageLocation("default").AdditionalStorageLocations("secondary", "tertiary", "unavailable").
+		Phase(api.BackupPhaseWaitingForPluginOperations).
+		PluginOperations(api.PluginOperation{Plugin: "velero.io/snapshot", OperationID: "op-1", Phase: api.PluginOperationPhaseInProgress}).Result()
+	backup.Status.AdditionalStorageLocations = []api.AdditionalStorageLocationStatus{
+		{Name: "secondary", Phase: api.AdditionalStorageLocationPhaseCompleted},
+		{Name: "tertiary", Phase: api.AdditionalStorageLocationPhaseFailed, Error: "copy failed"},
+		{Name: "unavailable", Phase: api.AdditionalStorageLocationPhaseCompleted},
+	}
+
+	var (
+		client          = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = new(pluginmocks.Manager)
+		backupStores    = map[string]*persistencemocks.BackupStore{
+			"default":     new(persistencemocks.BackupStore),
+			"secondary":   new(persistencemocks.BackupStore),
+			"tertiary":    new(persistencemocks.BackupStore),
+			"unavailable": new(persistencemocks.BackupStore),
+		}
+		action = &fakeOperationAction{progress: map[string]velero.OperationProgress{"op-1": {Completed: true}}}
+	)
+
+	c := NewBackupOperationsController(
+		velerotest.NewLogger(),
+		sharedInformers.Velero().V1().Backups(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		0,
+		metrics.NewServerMetrics(),
+	).(*backupOperationsController)
+	c.newBackupStore = func(location *api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+		return backupStores[location.Name], nil
+	}
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	for name := range backupStores {
+		require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(builder.ForBackupStorageLocation("velero", name).Result()))
+	}
+
+	pluginManager.On("CleanupClients").Return()
+	pluginManager.On("GetBackupItemActionV2", "velero.io/snapshot").Return(action, nil)
+
+	// the copies that were completed are updated with the backup's final
+	// phase, and a copy that can't be updated is marked failed.
+	var uploaded []api.BackupPhase
+	recordPhase := func(args mock.Arguments) {
+		res := new(api.Backup)
+		require.NoError(t, json.NewDecoder(args.Get(1).(io.Reader)).Decode(res))
+		uploaded = append(uploaded, res.Status.Phase)
+	}
+	backupStores["default"].On("UpdateBackupMetadata", backup.Name, mock.Anything).Run(recordPhase).Return(nil)
+	backupStores["secondary"].On("UpdateBackupMetadata", backup.Name, mock.Anything).Run(recordPhase).Return(nil)
+	backupStores["unavailable"].On("UpdateBackupMetadata", backup.Name, mock.Anything).Return(errors.New("location unavailable"))
+
+	require.NoError(t, c.processQueueItem(backup.Namespace+"/"+backup.Name))
+
+	for _, backupStore := range backupStores {
+		backupStore.AssertExpectations(t)
+	}
+	assert.Equal(t, []api.BackupPhase{api.BackupPhaseCompleted, api.BackupPhaseCompleted}, uploaded)
+
+	res, err := client.VeleroV1().Backups(backup.Namespace).Get(backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, api.BackupPhaseCompleted, res.Status.Phase)
+	assert.Equal(t, []api.AdditionalStorageLocationStatus{
+		{Name: "secondary", Phase: api.AdditionalStorageLocationPhaseCompleted},
+		{Name: "tertiary", Phase: api.AdditionalStorageLocationPhaseFailed, Error: "copy failed"},
+		{Name: "unavailable", Phase: api.AdditionalStorageLocationPhaseFailed, Error: "error uploading backup metadata: location unavailable"},
+	}, res.Status.AdditionalStorageLocations)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// backupStorageLocationResyncPeriod is how often the backup storage location
+// controller checks whether any locations are due to be validated.
+const backupStorageLocationResyncPeriod = 10 * time.Second
+
+// backupStorageLocationController periodically checks that backup storage
+// locations are available, and records the result in their status.
+type backupStorageLocationController struct {
+	*genericController
+
+	namespace                  string
+	defaultValidationFrequency time.Duration
+	backupLocationLister       listers.BackupStorageLocationLister
+	backupLocationClient       velerov1client.BackupStorageLocationsGetter
+	newPluginManager           func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore             func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+
+	clock clock.Clock
+}
+
+// NewBackupStorageLocationController constructs a new
+// backupStorageLocationController.
+func NewBackupStorageLocationController(
+	namespace string,
+	defaultValidationFrequency time.Duration,
+	backupLocationInformer informers.BackupStorageLocationInformer,
+	backupLocationClient velerov1client.BackupStorageLocationsGetter,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	logger logrus.FieldLogger,
+) Interface {
+	c := &backupStorageLocationController{
+		genericController:          newGenericController("backup-storage-location", logger),
+		namespace:                  namespace,
+		defaultValidationFrequency: defaultValidationFrequency,
+		backupLocationLister:       backupLocationInformer.Lister(),
+		backupLocationClient:       backupLocationClient,
+		newPluginManager:           newPluginManager,
+		newBackupStore:             persistence.NewObjectBackupStore,
+		clock:                      clock.RealClock{},
+	}
+
+	c.syncHandler = c.processQueueItem
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters, backupLocationInformer.Informer().HasSynced)
+
+	c.resyncPeriod = backupStorageLocationResyncPeriod
+	c.resyncFunc = c.enqueueAllLocations
+
+	c.watch(backupLocationInformer.Informer(), onCreateOrUpdate(nil))
+
+	return c
+}
+
+func (c *backupStorageLocationController) enqueueAllLocations() {
+	locations, err := c.backupLocationLister.BackupStorageLocations(c.namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing backup storage locations")
+		return
+	}
+
+	for _, location := range locations {
+		c.enqueue(location)
+	}
+}
+
+// validationFrequency returns how often a location is validated, which is
+// its own frequency if it has one and the server's default otherwise.
+func (c *backupStorageLocationController) validationFrequency(location *velerov1api.BackupStorageLocation) time.Duration {
+	if location.Spec.ValidationFrequency != nil {
+		return location.Spec.ValidationFrequency.Duration
+	}
+	return c.defaultValidationFrequency
+}
+
+func (c *backupStorageLocationController) processQueueItem(key string) error {
+	log := c.logger.WithField("backupLocation", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	location, err := c.backupLocationLister.BackupStorageLocations(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup storage location")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	frequency := c.validationFrequency(location)
+	if frequency <= 0 {
+		log.Debug("Validation of backup storage location is disabled, skipping")
+		return nil
+	}
+
+	now := c.clock.Now()
+	if lastValidation := location.Status.LastValidationTime; !lastValidation.IsZero() && now.Before(lastValidation.Add(frequency)) {
+		log.Debug("Backup storage location isn't due to be validated yet, skipping")
+		return nil
+	}
+
+	updated := location.DeepCopy()
+	updated.Status.LastValidationTime = metav1.Time{Time: now.UTC()}
+
+	if err := c.validate(location, log); err != nil {
+		log.WithError(err).Warn("Backup storage location is unavailable")
+		updated.Status.Phase = velerov1api.BackupStorageLocationPhaseUnavailable
+		updated.Status.Message = err.Error()
+	} else {
+		log.Debug("Backup storage location is available")
+		updated.Status.Phase = velerov1api.BackupStorageLocationPhaseAvailable
+		updated.Status.Message = ""
+	}
+
+	err = kube.Patch(location, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+		_, err := c.backupLocationClient.BackupStorageLocations(location.Namespace).Patch(location.Name, patchType, data)
+		return err
+	})
+	return errors.Wrap(err, "error patching backup storage location's status")
+}
+
+// validate returns an error if the location's backup store can't be reached
+// or doesn't have a valid layout.
+func (c *backupStorageLocationController) validate(location *velerov1api.BackupStorageLocation, log logrus.FieldLogger) error {
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.newBackupStore(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup store")
+	}
+
+	return backupStore.IsValid()
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func TestBackupStorageLocationControllerProcessQueueItem(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		location         *velerov1api.BackupStorageLocation
+		isValidErr       error
+		expectValidation bool
+		expectedPhase    velerov1api.BackupStorageLocationPhase
+		expectedMessage  string
+	}{
+		{
+			name:             "location that's never been validated is validated",
+			location:         builder.ForBackupStorageLocation("velero", "loc-1").Result(),
+			expectValidation: true,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseAvailable,
+		},
+		{
+			name:             "location that can't be reached is unavailable",
+			location:         builder.ForBackupStorageLocation("velero", "loc-1").Result(),
+			isValidErr:       errors.New("bucket not found"),
+			expectValidation: true,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseUnavailable,
+			expectedMessage:  "bucket not found",
+		},
+		{
+			name:     "location that was validated within the default frequency is skipped",
+			location: builder.ForBackupStorageLocation("velero", "loc-1").LastValidationTime(now.Add(-30 * time.Second)).Result(),
+		},
+		{
+			name:             "location that was validated before the default frequency is validated",
+			location:         builder.ForBackupStorageLocation("velero", "loc-1").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).LastValidationTime(now.Add(-2 * time.Minute)).Result(),
+			expectValidation: true,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseAvailable,
+		},
+		{
+			name:             "location's own frequency overrides the default frequency",
+			location:         builder.ForBackupStorageLocation("velero", "loc-1").ValidationFrequency(10 * time.Second).LastValidationTime(now.Add(-30 * time.Second)).Result(),
+			expectValidation: true,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseAvailable,
+		},
+		{
+			name:     "location with a frequency of 0 is never validated",
+			location: builder.ForBackupStorageLocation("velero", "loc-1").ValidationFrequency(0).Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(tc.location)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+			)
+
+			c := NewBackupStorageLocationController(
+				"velero",
+				time.Minute,
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				client.VeleroV1(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				velerotest.NewLogger(),
+			).(*backupStorageLocationController)
+			c.clock = clock.NewFakeClock(now)
+			c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(tc.location))
+
+			if tc.expectValidation {
+				pluginManager.On("CleanupClients").Return()
+				backupStore.On("IsValid").Return(tc.isValidErr)
+			}
+
+			require.NoError(t, c.processQueueItem(kube.NamespaceAndName(tc.location)))
+
+			pluginManager.AssertExpectations(t)
+			backupStore.AssertExpectations(t)
+
+			if !tc.expectValidation {
+				assert.Len(t, client.Actions(), 0)
+				return
+			}
+
+			res, err := client.VeleroV1().BackupStorageLocations("velero").Get("loc-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedPhase, res.Status.Phase)
+			assert.Equal(t, tc.expectedMessage, res.Status.Message)
+			assert.True(t, now.Equal(res.Status.LastValidationTime.Time))
+		})
+	}
+}
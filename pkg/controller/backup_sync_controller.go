@@ -17,24 +17,31 @@ limitations under the License.
 package controller
 
 import (
-	"encoding/json"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	kuberrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/storage"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 type backupSyncController struct {
@@ -50,8 +57,38 @@ type backupSyncController struct {
 	defaultBackupLocation       string
 	newPluginManager            func(logrus.FieldLogger) clientmgmt.Manager
 	newBackupStore              func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	clock                       clock.Clock
+	metrics                     *metrics.ServerMetrics
+
+	// lastPruned is when the incomplete backups in each location were last
+	// pruned.
+	lastPruned map[string]time.Time
+
+	// lastDriftChecked is when the specs of the backups in each location
+	// were last checked against their specs in the location.
+	lastDriftChecked map[string]time.Time
+
+	// syncLock makes sure that periodic syncs and requested syncs don't
+	// run at the same time.
+	syncLock sync.Mutex
 }
 
+const (
+	// incompleteBackupsPruneFrequency is how often the backups whose upload
+	// didn't finish are pruned from each backup storage location.
+	incompleteBackupsPruneFrequency = time.Hour
+
+	// incompleteBackupGracePeriod is how long after a backup's upload started
+	// it can be pruned if its upload didn't finish, so that backups that are
+	// still being uploaded, possibly by another cluster, aren't pruned.
+	incompleteBackupGracePeriod = time.Hour
+
+	// specDriftCheckFrequency is how often the specs of the backups in each
+	// backup storage location are checked against the specs stored with
+	// them, since it requires getting each backup's metadata.
+	specDriftCheckFrequency = time.Hour
+)
+
 func NewBackupSyncController(
 	backupClient velerov1client.BackupsGetter,
 	backupLocationClient velerov1client.BackupStorageLocationsGetter,
@@ -63,6 +100,7 @@ func NewBackupSyncController(
 	namespace string,
 	defaultBackupLocation string,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	metrics *metrics.ServerMetrics,
 	logger logrus.FieldLogger,
 ) Interface {
 	if syncPeriod <= 0 {
@@ -85,8 +123,13 @@ func NewBackupSyncController(
 		// replaced with fakes for testing.
 		newPluginManager: newPluginManager,
 		newBackupStore:   persistence.NewObjectBackupStore,
+		clock:            clock.RealClock{},
+		metrics:          metrics,
+		lastPruned:       make(map[string]time.Time),
+		lastDriftChecked: make(map[string]time.Time),
 	}
 
+	c.syncHandler = c.processQueueItem
 	c.resyncFunc = c.run
 	c.resyncPeriod = syncPeriod
 	c.cacheSyncWaiters = []cache.InformerSynced{
@@ -94,9 +137,49 @@ func NewBackupSyncController(
 		backupStorageLocationInformer.Informer().HasSynced,
 	}
 
+	c.watch(backupStorageLocationInformer.Informer(), onCreateOrUpdate(syncRequested))
+
 	return c
 }
 
+// syncRequested returns whether a backup storage location is annotated with
+// a request to sync it right away.
+func syncRequested(obj runtime.Object) bool {
+	location := obj.(*velerov1api.BackupStorageLocation)
+
+	_, syncRequested := location.Annotations[velerov1api.SyncBackupsAnnotation]
+	_, rebuildRequested := location.Annotations[velerov1api.RebuildBackupsAnnotation]
+	return syncRequested || rebuildRequested
+}
+
+// processQueueItem syncs a backup storage location whose sync was requested.
+func (c *backupSyncController) processQueueItem(key string) error {
+	log := c.logger.WithField("key", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.WithError(err).Error("Error splitting queue key")
+		return nil
+	}
+
+	location, err := c.backupStorageLocationLister.BackupStorageLocations(ns).Get(name)
+	if kuberrs.IsNotFound(err) {
+		log.Debug("Unable to find backup storage location")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	c.syncLock.Lock()
+	defer c.syncLock.Unlock()
+
+	log.Info("Syncing backup storage location on request")
+	c.syncLocations([]*velerov1api.BackupStorageLocation{location})
+
+	return nil
+}
+
 // orderedBackupLocations returns a new slice with the default backup location first (if it exists),
 // followed by the rest of the locations in no particular order.
 func orderedBackupLocations(locations []*velerov1api.BackupStorageLocation, defaultLocationName string) []*velerov1api.BackupStorageLocation {
@@ -119,6 +202,9 @@ func orderedBackupLocations(locations []*velerov1api.BackupStorageLocation, defa
 }
 
 func (c *backupSyncController) run() {
+	c.syncLock.Lock()
+	defer c.syncLock.Unlock()
+
 	c.logger.Debug("Checking for existing backup storage locations to sync into cluster")
 
 	locations, err := c.backupStorageLocationLister.BackupStorageLocations(c.namespace).List(labels.Everything())
@@ -127,8 +213,14 @@ func (c *backupSyncController) run() {
 		return
 	}
 	// sync the default location first, if it exists
-	locations = orderedBackupLocations(locations, c.defaultBackupLocation)
+	locations = orderedBackupLocations(locations, storage.DefaultBackupLocationName(locations, c.defaultBackupLocation))
 
+	c.syncLocations(locations)
+}
+
+// syncLocations syncs the backups in each of the given backup storage
+// locations into the cluster.
+func (c *backupSyncController) syncLocations(locations []*velerov1api.BackupStorageLocation) {
 	pluginManager := c.newPluginManager(c.logger)
 	defer pluginManager.CleanupClients()
 
@@ -149,6 +241,15 @@ func (c *backupSyncController) run() {
 			continue
 		}
 		backupStoreBackups := sets.NewString(res...)
+
+		// backups of member clusters are stored under their own prefixes
+		// in the location, so each backup is synced from the store that
+		// it's in.
+		backupStores, err := c.memberClusterBackupStores(location, backupStore, backupStoreBackups, pluginManager, log)
+		if err != nil {
+			log.WithError(err).Error("Error listing member cluster backups in backup store")
+			continue
+		}
 		log.WithField("backupCount", len(backupStoreBackups)).Debug("Got backups from backup store")
 
 		// get a list of all the backups that exist as custom resources in the cluster
@@ -172,12 +273,29 @@ func (c *backupSyncController) run() {
 			log.Debug("No backups found in the backup location that need to be synced into the cluster")
 		}
 
+		// if a rebuild was requested, the custom resources of the backups in
+		// the location that already exist in the cluster are recreated too.
+		backupsToRebuild := make(map[string]*velerov1api.Backup)
+		if _, ok := location.Annotations[velerov1api.RebuildBackupsAnnotation]; ok {
+			backupsToRebuild = rebuildableBackups(clusterBackups, backupStoreBackups, location.Name)
+			log.Infof("Rebuilding %v backups in the backup location that exist in the cluster", len(backupsToRebuild))
+
+			for backupName := range backupsToRebuild {
+				backupsToSync.Insert(backupName)
+			}
+		}
+
 		// sync each backup
 		for backupName := range backupsToSync {
 			log = log.WithField("backup", backupName)
 			log.Info("Attempting to sync backup into cluster")
 
-			backup, err := backupStore.GetBackupMetadata(backupName)
+			store := backupStore
+			if memberStore, ok := backupStores[backupName]; ok {
+				store = memberStore
+			}
+
+			backup, err := store.GetBackupMetadata(backupName)
 			if err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error getting backup metadata from backup store")
 				continue
@@ -195,6 +313,24 @@ func (c *backupSyncController) run() {
 			}
 			backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
 
+			existing, rebuild := backupsToRebuild[backupName]
+			if rebuild {
+				// keep the labels of the custom resource that's being
+				// replaced, which may have been changed since the backup
+				// was uploaded.
+				for k, v := range existing.Labels {
+					if k != velerov1api.StorageLocationLabel {
+						backup.Labels[k] = v
+					}
+				}
+
+				if err := c.backupClient.Backups(backup.Namespace).Delete(backupName, nil); err != nil && !kuberrs.IsNotFound(err) {
+					log.WithError(errors.WithStack(err)).Error("Error deleting backup from cluster to rebuild it")
+					continue
+				}
+				log.Info("Deleted backup from cluster to rebuild it")
+			}
+
 			// attempt to create backup custom resource via API
 			backup, err = c.backupClient.Backups(backup.Namespace).Create(backup)
 			switch {
@@ -205,11 +341,14 @@ func (c *backupSyncController) run() {
 				log.WithError(errors.WithStack(err)).Error("Error syncing backup into cluster")
 				continue
 			default:
-				log.Info("Successfully synced backup into cluster")
+				log.WithFields(logrus.Fields{
+					"sourceClusterName": backup.Labels[velerov1api.SourceClusterNameLabel],
+					"sourceClusterUID":  backup.Labels[velerov1api.SourceClusterUIDLabel],
+				}).Info("Successfully synced backup into cluster")
 			}
 
 			// process the pod volume backups from object store, if any
-			podVolumeBackups, err := backupStore.GetPodVolumeBackups(backupName)
+			podVolumeBackups, err := store.GetPodVolumeBackups(backupName)
 			if err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error getting pod volume backups for this backup from backup store")
 				continue
@@ -233,6 +372,15 @@ func (c *backupSyncController) run() {
 				podVolumeBackup.Namespace = backup.Namespace
 				podVolumeBackup.ResourceVersion = ""
 
+				// the pod volume backups of a rebuilt backup are recreated
+				// too, since they're owned by the backup's old custom resource.
+				if rebuild {
+					if err := c.podVolumeBackupClient.PodVolumeBackups(backup.Namespace).Delete(podVolumeBackup.Name, nil); err != nil && !kuberrs.IsNotFound(err) {
+						log.WithError(errors.WithStack(err)).Error("Error deleting pod volume backup from cluster to rebuild it")
+						continue
+					}
+				}
+
 				_, err = c.podVolumeBackupClient.PodVolumeBackups(backup.Namespace).Create(podVolumeBackup)
 				switch {
 				case err != nil && kuberrs.IsAlreadyExists(err):
@@ -247,37 +395,128 @@ func (c *backupSyncController) run() {
 			}
 		}
 
-		c.deleteOrphanedBackups(location.Name, backupStoreBackups, log)
+		if c.shouldCheckSpecDrift(location) {
+			c.checkSpecDrift(location.Name, clusterBackups, backupsToSync, backupStoreBackups, backupStore, backupStores, log)
+		}
+
+		c.syncOrphanedBackups(location, backupStoreBackups, log)
 
 		// update the location's last-synced time field
-		patch := map[string]interface{}{
-			"status": map[string]interface{}{
-				"lastSyncedTime": time.Now().UTC(),
-			},
+		updated := location.DeepCopy()
+		updated.Status.LastSyncedTime = metav1.Time{Time: time.Now().UTC()}
+
+		delete(updated.Annotations, velerov1api.SyncBackupsAnnotation)
+		delete(updated.Annotations, velerov1api.RebuildBackupsAnnotation)
+
+		if c.shouldPruneIncompleteBackups(location) {
+			c.pruneIncompleteBackups(location, backupStore, log)
+			delete(updated.Annotations, velerov1api.PruneIncompleteBackupsAnnotation)
+		}
+
+		// update the location's encryption status, leaving it as-is if it
+		// can't be checked right now
+		if encryption, err := backupStore.GetEncryptionStatus(); err != nil {
+			log.WithError(err).Warn("Error getting backup location's encryption status")
+		} else if encryption == nil {
+			updated.Status.Encryption = nil
+		} else {
+			updated.Status.Encryption = &velerov1api.EncryptionStatus{
+				Enabled:         encryption.Enabled,
+				Algorithm:       encryption.Algorithm,
+				KeyID:           encryption.KeyID,
+				LastCheckedTime: updated.Status.LastSyncedTime,
+			}
+		}
+
+		if err := kube.Patch(location, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+			_, err := c.backupLocationClient.BackupStorageLocations(c.namespace).Patch(location.Name, patchType, data)
+			return err
+		}); err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time and encryption status")
+			continue
+		}
+	}
+}
+
+// memberClusterBackupStores adds the backups of the member clusters that are
+// stored in a location to backupStoreBackups, and returns the backup stores
+// of those backups keyed by backup name.
+func (c *backupSyncController) memberClusterBackupStores(
+	location *velerov1api.BackupStorageLocation,
+	backupStore persistence.BackupStore,
+	backupStoreBackups sets.String,
+	pluginManager clientmgmt.Manager,
+	log logrus.FieldLogger,
+) (map[string]persistence.BackupStore, error) {
+	clusters, err := backupStore.ListMemberClusters()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]persistence.BackupStore)
+	for _, cluster := range clusters {
+		memberStore, err := c.newBackupStore(persistence.MemberClusterLocation(location, cluster), pluginManager, log)
+		if err != nil {
+			return nil, err
 		}
 
-		patchBytes, err := json.Marshal(patch)
+		backups, err := memberStore.ListBackups()
 		if err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error marshaling last-synced patch to JSON")
+			return nil, err
+		}
+		log.WithFields(logrus.Fields{
+			"memberCluster": cluster,
+			"backupCount":   len(backups),
+		}).Debug("Got member cluster backups from backup store")
+
+		for _, backup := range backups {
+			backupStoreBackups.Insert(backup)
+			res[backup] = memberStore
+		}
+	}
+
+	return res, nil
+}
+
+// rebuildableBackups returns the backups in the cluster, keyed by name, whose
+// custom resources can be rebuilt from a backup storage location: those that
+// are in the location, and that aren't being processed or deleted.
+func rebuildableBackups(clusterBackups []*velerov1api.Backup, backupStoreBackups sets.String, locationName string) map[string]*velerov1api.Backup {
+	res := make(map[string]*velerov1api.Backup)
+
+	for _, backup := range clusterBackups {
+		if !backupStoreBackups.Has(backup.Name) || backup.Spec.StorageLocation != locationName {
 			continue
 		}
 
-		if _, err = c.backupLocationClient.BackupStorageLocations(c.namespace).Patch(
-			location.Name,
-			types.MergePatchType,
-			patchBytes,
-		); err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time")
+		switch backup.Status.Phase {
+		case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress, velerov1api.BackupPhaseDeleting,
+			velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
 			continue
 		}
+
+		res[backup.Name] = backup
 	}
+
+	return res
 }
 
-// deleteOrphanedBackups deletes backup objects (CRDs) from Kubernetes that have the specified location
-// and a phase of Completed, but no corresponding backup in object storage.
-func (c *backupSyncController) deleteOrphanedBackups(locationName string, backupStoreBackups sets.String, log logrus.FieldLogger) {
+// syncOrphanedBackups handles the backup objects (CRDs) in Kubernetes that have the specified
+// location and a phase of Completed, but no corresponding backup in object storage, according
+// to the location's orphaned backup policy: by default, they're deleted; with Mark, their
+// Orphaned condition is set instead, and cleared if the backup is found in the location again;
+// and with Ignore, they're left as they are.
+func (c *backupSyncController) syncOrphanedBackups(location *velerov1api.BackupStorageLocation, backupStoreBackups sets.String, log logrus.FieldLogger) {
+	policy := location.Spec.OrphanedBackupPolicy
+	if policy == "" {
+		policy = velerov1api.OrphanedBackupPolicyDelete
+	}
+	if policy == velerov1api.OrphanedBackupPolicyIgnore {
+		return
+	}
+
 	locationSelector := labels.Set(map[string]string{
-		velerov1api.StorageLocationLabel: label.GetValidName(locationName),
+		velerov1api.StorageLocationLabel: label.GetValidName(location.Name),
 	}).AsSelector()
 
 	backups, err := c.backupLister.Backups(c.namespace).List(locationSelector)
@@ -291,7 +530,16 @@ func (c *backupSyncController) deleteOrphanedBackups(locationName string, backup
 
 	for _, backup := range backups {
 		log = log.WithField("backup", backup.Name)
-		if backup.Status.Phase != velerov1api.BackupPhaseCompleted || backupStoreBackups.Has(backup.Name) {
+		if backup.Status.Phase != velerov1api.BackupPhaseCompleted {
+			continue
+		}
+
+		if policy == velerov1api.OrphanedBackupPolicyMark {
+			c.markOrphanedBackup(backup, !backupStoreBackups.Has(backup.Name), log)
+			continue
+		}
+
+		if backupStoreBackups.Has(backup.Name) {
 			continue
 		}
 
@@ -302,3 +550,226 @@ func (c *backupSyncController) deleteOrphanedBackups(locationName string, backup
 		}
 	}
 }
+
+// markOrphanedBackup sets a backup's Orphaned condition: true if its data is
+// no longer in its location, and false if it is.
+func (c *backupSyncController) markOrphanedBackup(backup *velerov1api.Backup, orphaned bool, log logrus.FieldLogger) {
+	condition := velerov1api.BackupCondition{
+		Type:   velerov1api.BackupConditionOrphaned,
+		Status: velerov1api.ConditionFalse,
+		Reason: "FoundInStorage",
+	}
+	if orphaned {
+		condition.Status = velerov1api.ConditionTrue
+		condition.Reason = "NotFoundInStorage"
+		condition.Message = "The backup's data isn't in its backup storage location"
+	}
+
+	updated := backup.DeepCopy()
+	if !setBackupCondition(&updated.Status, condition, c.clock.Now()) {
+		return
+	}
+
+	if orphaned {
+		log.Warn("Backup's data isn't in its backup storage location")
+	}
+
+	if err := kube.Patch(backup, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+		_, err := c.backupClient.Backups(backup.Namespace).Patch(backup.Name, patchType, data)
+		return err
+	}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error patching backup's orphaned condition")
+	}
+}
+
+// shouldCheckSpecDrift returns whether the specs of the backups in a location
+// should be checked against the specs stored with them now, either because a
+// sync was requested or because they haven't been checked recently.
+func (c *backupSyncController) shouldCheckSpecDrift(location *velerov1api.BackupStorageLocation) bool {
+	_, syncRequested := location.Annotations[velerov1api.SyncBackupsAnnotation]
+	_, rebuildRequested := location.Annotations[velerov1api.RebuildBackupsAnnotation]
+	if syncRequested || rebuildRequested {
+		return true
+	}
+
+	lastChecked, ok := c.lastDriftChecked[location.Name]
+	return !ok || c.clock.Since(lastChecked) >= specDriftCheckFrequency
+}
+
+// checkSpecDrift sets the SpecDrifted condition of the completed backups in a
+// location whose spec hash is recorded: true if the hash of the custom
+// resource's spec doesn't match the hash stored with the backup in the
+// location, e.g. because the custom resource was edited after the backup was
+// taken, and false otherwise. Backups that were just synced from the location
+// aren't checked.
+func (c *backupSyncController) checkSpecDrift(
+	locationName string,
+	clusterBackups []*velerov1api.Backup,
+	syncedBackups sets.String,
+	backupStoreBackups sets.String,
+	backupStore persistence.BackupStore,
+	memberStores map[string]persistence.BackupStore,
+	log logrus.FieldLogger,
+) {
+	c.lastDriftChecked[locationName] = c.clock.Now()
+
+	for _, backup := range clusterBackups {
+		if backup.Spec.StorageLocation != locationName || !backupStoreBackups.Has(backup.Name) || syncedBackups.Has(backup.Name) {
+			continue
+		}
+		if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+			continue
+		}
+		if _, ok := backup.Annotations[velerov1api.BackupSpecHashAnnotation]; !ok {
+			continue
+		}
+
+		log := log.WithField("backup", backup.Name)
+
+		store := backupStore
+		if memberStore, ok := memberStores[backup.Name]; ok {
+			store = memberStore
+		}
+
+		stored, err := store.GetBackupMetadata(backup.Name)
+		if err != nil {
+			log.WithError(err).Error("Error getting backup metadata from backup store, skipping checking its spec")
+			continue
+		}
+		storedHash := stored.Annotations[velerov1api.BackupSpecHashAnnotation]
+		storedVersion := stored.Annotations[velerov1api.BackupSpecHashVersionAnnotation]
+		if storedHash == "" || storedVersion == "" {
+			continue
+		}
+
+		// the spec is hashed the same way as when the backup was taken,
+		// so that hashes don't change when the fields that are hashed do.
+		hash, err := pkgbackup.SpecHashForVersion(backup.Spec, storedVersion)
+		if err != nil {
+			log.WithError(err).Error("Error hashing backup spec")
+			continue
+		}
+
+		condition := velerov1api.BackupCondition{
+			Type:   velerov1api.BackupConditionSpecDrifted,
+			Status: velerov1api.ConditionFalse,
+			Reason: "SpecMatchesStorage",
+		}
+		if hash != storedHash {
+			condition.Status = velerov1api.ConditionTrue
+			condition.Reason = "SpecChanged"
+			condition.Message = "The backup's spec doesn't match the spec stored with it in its backup storage location"
+		}
+
+		updated := backup.DeepCopy()
+		if !setBackupCondition(&updated.Status, condition, c.clock.Now()) {
+			continue
+		}
+
+		if condition.Status == velerov1api.ConditionTrue {
+			log.Warn("Backup's spec doesn't match the spec stored with it in its backup storage location")
+			c.metrics.RegisterBackupSpecDrift(backup.Labels[velerov1api.ScheduleNameLabel])
+		}
+
+		if err := kube.Patch(backup, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+			_, err := c.backupClient.Backups(backup.Namespace).Patch(backup.Name, patchType, data)
+			return err
+		}); err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error patching backup's spec drift condition")
+		}
+	}
+}
+
+// setBackupCondition sets a condition in a backup's status, replacing the
+// condition of the same type if there is one, and returns whether the
+// condition's status, reason or message changed. The condition's last
+// transition time is only updated when its status changes.
+func setBackupCondition(status *velerov1api.BackupStatus, condition velerov1api.BackupCondition, now time.Time) bool {
+	condition.LastTransitionTime = metav1.NewTime(now)
+
+	for i, existing := range status.Conditions {
+		if existing.Type != condition.Type {
+			continue
+		}
+
+		if existing.Status == condition.Status {
+			if existing.Reason == condition.Reason && existing.Message == condition.Message {
+				return false
+			}
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		status.Conditions[i] = condition
+		return true
+	}
+
+	// a condition that doesn't hold isn't added, so that backups don't need
+	// to be updated until it first holds.
+	if condition.Status == velerov1api.ConditionFalse {
+		return false
+	}
+
+	status.Conditions = append(status.Conditions, condition)
+	return true
+}
+
+// shouldPruneIncompleteBackups returns whether the backups in a location whose
+// upload didn't finish should be pruned now, either because it was requested
+// or because they haven't been pruned recently. Read-only locations are never
+// pruned.
+func (c *backupSyncController) shouldPruneIncompleteBackups(location *velerov1api.BackupStorageLocation) bool {
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return false
+	}
+
+	if _, ok := location.Annotations[velerov1api.PruneIncompleteBackupsAnnotation]; ok {
+		return true
+	}
+
+	lastPruned, ok := c.lastPruned[location.Name]
+	return !ok || c.clock.Since(lastPruned) >= incompleteBackupsPruneFrequency
+}
+
+// pruneIncompleteBackups deletes the backups in a location whose upload didn't
+// finish. To do so safely, backups that have a custom resource that's new or
+// in progress are never pruned, since they may still be uploaded, and neither
+// are backups whose upload started within the grace period. Backups without
+// an upload marker that have a custom resource are kept too, since failed
+// backups only upload their logs.
+func (c *backupSyncController) pruneIncompleteBackups(location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	c.lastPruned[location.Name] = c.clock.Now()
+
+	incomplete, err := backupStore.ListIncompleteBackups()
+	if err != nil {
+		log.WithError(err).Error("Error listing incomplete backups in backup store")
+		return
+	}
+
+	for _, backup := range incomplete {
+		log := log.WithField("backup", backup.Name)
+
+		existing, err := c.backupLister.Backups(c.namespace).Get(backup.Name)
+		if err != nil && !kuberrs.IsNotFound(err) {
+			log.WithError(errors.WithStack(err)).Error("Error getting backup from cluster, skipping pruning it")
+			continue
+		}
+
+		switch {
+		case existing != nil && (existing.Status.Phase == "" || existing.Status.Phase == velerov1api.BackupPhaseNew || existing.Status.Phase == velerov1api.BackupPhaseInProgress ||
+			waitingForPluginOperations(existing)):
+			log.Debug("Not pruning incomplete backup because it's still being processed")
+			continue
+		case backup.UploadStarted.IsZero() && existing != nil:
+			log.Debug("Not pruning backup without metadata because it exists in the cluster")
+			continue
+		case !backup.UploadStarted.IsZero() && c.clock.Since(backup.UploadStarted) < incompleteBackupGracePeriod:
+			log.Debug("Not pruning incomplete backup because its upload started recently")
+			continue
+		}
+
+		if err := backupStore.DeleteBackup(backup.Name); err != nil {
+			log.WithError(err).Error("Error pruning incomplete backup from backup store")
+			continue
+		}
+		log.Info("Pruned incomplete backup from backup store")
+	}
+}
@@ -17,26 +17,32 @@ limitations under the License.
 package controller
 
 import (
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	core "k8s.io/client-go/testing"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -348,6 +354,7 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				test.namespace,
 				"",
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				metrics.NewServerMetrics(),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -374,6 +381,9 @@ func TestBackupSyncControllerRun(t *testing.T) {
 					backupStore.On("GetPodVolumeBackups", bucket.backup.Name).Return(bucket.podVolumeBackups, nil)
 				}
 				backupStore.On("ListBackups").Return(backupNames, nil)
+				backupStore.On("GetEncryptionStatus").Return(nil, nil)
+				backupStore.On("ListIncompleteBackups").Return(nil, nil)
+				backupStore.On("ListMemberClusters").Return(nil, nil)
 			}
 
 			for _, existingBackup := range test.existingBackups {
@@ -467,6 +477,276 @@ func TestBackupSyncControllerRun(t *testing.T) {
 	}
 }
 
+func TestBackupSyncControllerRebuild(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+	)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().PodVolumeBackups(),
+		time.Duration(0),
+		"ns-1",
+		"",
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+		return backupStore, nil
+	}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	location := builder.ForBackupStorageLocation("ns-1", "location-1").
+		ObjectMeta(builder.WithAnnotations(velerov1api.RebuildBackupsAnnotation, "2019-10-01T12:00:00Z")).Result()
+	_, err := client.VeleroV1().BackupStorageLocations("ns-1").Create(location)
+	require.NoError(t, err)
+	require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+
+	// the custom resources in the cluster, one of which has lost its status.
+	for _, backup := range []*velerov1api.Backup{
+		builder.ForBackup("ns-1", "corrupted").StorageLocation("location-1").Phase(velerov1api.BackupPhaseFailed).
+			ObjectMeta(builder.WithLabels("team", "web", velerov1api.StorageLocationLabel, "location-1"), builder.WithUID("old-uid")).Result(),
+		builder.ForBackup("ns-1", "in-progress").StorageLocation("location-1").Phase(velerov1api.BackupPhaseInProgress).Result(),
+		builder.ForBackup("ns-1", "other-location").StorageLocation("location-2").Phase(velerov1api.BackupPhaseCompleted).Result(),
+	} {
+		_, err := client.VeleroV1().Backups("ns-1").Create(backup)
+		require.NoError(t, err)
+		require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	}
+	podVolumeBackup := builder.ForPodVolumeBackup("ns-1", "pvb-1").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "corrupted")).Result()
+	_, err = client.VeleroV1().PodVolumeBackups("ns-1").Create(podVolumeBackup)
+	require.NoError(t, err)
+
+	// the backups' metadata in the location.
+	backupStore.On("ListBackups").Return([]string{"corrupted", "in-progress", "other-location", "missing"}, nil)
+	backupStore.On("GetBackupMetadata", "corrupted").Return(
+		builder.ForBackup("ns-1", "corrupted").Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(builder.WithLabels("app", "nginx")).Result(), nil)
+	backupStore.On("GetBackupMetadata", "missing").Return(
+		builder.ForBackup("ns-1", "missing").Phase(velerov1api.BackupPhaseCompleted).Result(), nil)
+	backupStore.On("GetPodVolumeBackups", "corrupted").Return([]*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup("ns-1", "pvb-1").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "corrupted")).Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+	}, nil)
+	backupStore.On("GetPodVolumeBackups", "missing").Return(nil, nil)
+	backupStore.On("GetEncryptionStatus").Return(nil, nil)
+	backupStore.On("ListIncompleteBackups").Return(nil, nil)
+	backupStore.On("ListMemberClusters").Return(nil, nil)
+
+	c.run()
+
+	// the corrupted backup is rebuilt from its metadata, keeping its labels.
+	rebuilt, err := client.VeleroV1().Backups("ns-1").Get("corrupted", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, rebuilt.Status.Phase)
+	assert.Equal(t, map[string]string{"app": "nginx", "team": "web", velerov1api.StorageLocationLabel: "location-1"}, rebuilt.Labels)
+
+	pvb, err := client.VeleroV1().PodVolumeBackups("ns-1").Get("pvb-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.PodVolumeBackupPhaseCompleted, pvb.Status.Phase)
+
+	// the missing backup is synced as usual.
+	_, err = client.VeleroV1().Backups("ns-1").Get("missing", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// backups that are in progress or in other locations aren't rebuilt.
+	var deleted []string
+	for _, action := range getDeleteActions(client.Actions()) {
+		if action.GetResource().Resource == "backups" {
+			deleted = append(deleted, action.(core.DeleteAction).GetName())
+		}
+	}
+	assert.Equal(t, []string{"corrupted"}, deleted)
+
+	// the rebuild request is removed from the location.
+	var patches []string
+	for _, action := range client.Actions() {
+		if patch, ok := action.(core.PatchAction); ok && patch.GetResource().Resource == "backupstoragelocations" {
+			patches = append(patches, string(patch.GetPatch()))
+		}
+	}
+	require.Len(t, patches, 1)
+	assert.Contains(t, patches[0], `"annotations":null`)
+}
+
+func TestBackupSyncControllerMemberClusters(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+		memberStore     = &persistencemocks.BackupStore{}
+	)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().PodVolumeBackups(),
+		time.Duration(0),
+		"ns-1",
+		"",
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	c.newBackupStore = func(loc *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+		if loc.Spec.ObjectStorage.Prefix == "velero/clusters/cluster-1" {
+			return memberStore, nil
+		}
+		return backupStore, nil
+	}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	location := builder.ForBackupStorageLocation("ns-1", "location-1").Provider("aws").Bucket("bucket-1").Prefix("velero").Result()
+	require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+
+	// a backup of the member cluster whose custom resource is in the cluster
+	// isn't an orphan, even though it's not in the location's own prefix.
+	existing := builder.ForBackup("ns-1", "member-existing").StorageLocation("location-1").Cluster("cluster-1").
+		Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "location-1")).Result()
+	_, err := client.VeleroV1().Backups("ns-1").Create(existing)
+	require.NoError(t, err)
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(existing))
+
+	backupStore.On("ListBackups").Return([]string{"local"}, nil)
+	backupStore.On("ListMemberClusters").Return([]string{"cluster-1"}, nil)
+	backupStore.On("GetBackupMetadata", "local").Return(builder.ForBackup("ns-1", "local").Phase(velerov1api.BackupPhaseCompleted).Result(), nil)
+	backupStore.On("GetPodVolumeBackups", "local").Return(nil, nil)
+	backupStore.On("GetEncryptionStatus").Return(nil, nil)
+	backupStore.On("ListIncompleteBackups").Return(nil, nil)
+
+	memberStore.On("ListBackups").Return([]string{"member", "member-existing"}, nil)
+	memberStore.On("GetBackupMetadata", "member").Return(builder.ForBackup("ns-1", "member").Cluster("cluster-1").Phase(velerov1api.BackupPhaseCompleted).Result(), nil)
+	memberStore.On("GetPodVolumeBackups", "member").Return(nil, nil)
+
+	c.run()
+
+	local, err := client.VeleroV1().Backups("ns-1").Get("local", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "", local.Spec.Cluster)
+
+	member, err := client.VeleroV1().Backups("ns-1").Get("member", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "cluster-1", member.Spec.Cluster)
+	assert.Equal(t, "location-1", member.Spec.StorageLocation)
+
+	_, err = client.VeleroV1().Backups("ns-1").Get("member-existing", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	backupStore.AssertExpectations(t)
+	memberStore.AssertExpectations(t)
+}
+
+func TestBackupSyncControllerEncryptionStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       *velerov1api.EncryptionStatus
+		reported       *velero.EncryptionStatus
+		reportedErr    error
+		wantEnabled    bool
+		wantAlgorithm  string
+		wantKeyID      string
+		wantEncryption bool
+	}{
+		{
+			name:           "reported encryption status is recorded",
+			reported:       &velero.EncryptionStatus{Enabled: true, Algorithm: "aws:kms", KeyID: "key-1"},
+			wantEncryption: true,
+			wantEnabled:    true,
+			wantAlgorithm:  "aws:kms",
+			wantKeyID:      "key-1",
+		},
+		{
+			name:           "unreported encryption status is cleared",
+			existing:       &velerov1api.EncryptionStatus{Enabled: true},
+			wantEncryption: false,
+		},
+		{
+			name:           "encryption status is left as-is if there's an error",
+			existing:       &velerov1api.EncryptionStatus{Enabled: true, Algorithm: "AES256"},
+			reportedErr:    errors.New("bad"),
+			wantEncryption: true,
+			wantEnabled:    true,
+			wantAlgorithm:  "AES256",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = &pluginmocks.Manager{}
+				backupStore     = &persistencemocks.BackupStore{}
+			)
+
+			c := NewBackupSyncController(
+				client.VeleroV1(),
+				client.VeleroV1(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				sharedInformers.Velero().V1().PodVolumeBackups(),
+				time.Duration(0),
+				"ns-1",
+				"",
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				metrics.NewServerMetrics(),
+				velerotest.NewLogger(),
+			).(*backupSyncController)
+
+			c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			pluginManager.On("CleanupClients").Return(nil)
+			backupStore.On("ListBackups").Return(nil, nil)
+			backupStore.On("GetEncryptionStatus").Return(test.reported, test.reportedErr)
+			backupStore.On("ListIncompleteBackups").Return(nil, nil)
+			backupStore.On("ListMemberClusters").Return(nil, nil)
+
+			location := builder.ForBackupStorageLocation("ns-1", "location-1").Provider("aws").Bucket("bucket-1").Result()
+			location.Status.Encryption = test.existing
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+			_, err := client.VeleroV1().BackupStorageLocations("ns-1").Create(location)
+			require.NoError(t, err)
+
+			c.run()
+
+			res, err := client.VeleroV1().BackupStorageLocations("ns-1").Get("location-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			if !test.wantEncryption {
+				// the fake client doesn't remove fields that are set to null
+				// by a merge patch, so check the patch itself.
+				var patch []byte
+				for _, action := range client.Actions() {
+					if patchAction, ok := action.(core.PatchAction); ok {
+						patch = patchAction.GetPatch()
+					}
+				}
+				assert.Contains(t, string(patch), `"encryption":null`)
+				return
+			}
+			require.NotNil(t, res.Status.Encryption)
+			assert.Equal(t, test.wantEnabled, res.Status.Encryption.Enabled)
+			assert.Equal(t, test.wantAlgorithm, res.Status.Encryption.Algorithm)
+			assert.Equal(t, test.wantKeyID, res.Status.Encryption.KeyID)
+		})
+	}
+}
+
 func TestDeleteOrphanedBackups(t *testing.T) {
 	baseBuilder := func(name string) *builder.BackupBuilder {
 		return builder.ForBackup("ns-1", name).ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "default"))
@@ -572,6 +852,7 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				test.namespace,
 				"",
 				nil, // new plugin manager func
+				metrics.NewServerMetrics(),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -596,7 +877,7 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				}
 			}
 
-			c.deleteOrphanedBackups("default", test.cloudBackups, velerotest.NewLogger())
+			c.syncOrphanedBackups(builder.ForBackupStorageLocation(test.namespace, "default").Result(), test.cloudBackups, velerotest.NewLogger())
 
 			numBackups, err := numBackups(t, client, c.namespace)
 			assert.NoError(t, err)
@@ -664,6 +945,7 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				test.namespace,
 				"",
 				nil, // new plugin manager func
+				metrics.NewServerMetrics(),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -688,7 +970,7 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				}
 			}
 
-			c.deleteOrphanedBackups(longLabelName, test.cloudBackups, velerotest.NewLogger())
+			c.syncOrphanedBackups(builder.ForBackupStorageLocation(test.namespace, longLabelName).Result(), test.cloudBackups, velerotest.NewLogger())
 
 			numBackups, err := numBackups(t, client, c.namespace)
 			assert.NoError(t, err)
@@ -701,6 +983,147 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 	}
 }
 
+func TestSyncOrphanedBackupsPolicies(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	newBackup := func(name string) *builder.BackupBuilder {
+		return builder.ForBackup("ns-1", name).
+			ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "default")).
+			Phase(velerov1api.BackupPhaseCompleted)
+	}
+
+	orphanedCondition := velerov1api.BackupCondition{
+		Type:               velerov1api.BackupConditionOrphaned,
+		Status:             velerov1api.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(now.Add(-time.Hour)),
+		Reason:             "NotFoundInStorage",
+		Message:            "The backup's data isn't in its backup storage location",
+	}
+
+	// the fake client decodes patches into the existing object, so fields
+	// that a patch removes from a condition aren't cleared. The condition of
+	// the backup that's found again has no message so that this doesn't
+	// matter.
+	foundAgainCondition := orphanedCondition
+	foundAgainCondition.Message = ""
+
+	tests := []struct {
+		name           string
+		policy         velerov1api.OrphanedBackupPolicy
+		wantBackups    []string
+		wantConditions map[string][]velerov1api.BackupCondition
+	}{
+		{
+			name:        "orphaned backups are deleted by default",
+			wantBackups: []string{"found-again", "in-location", "in-progress"},
+			wantConditions: map[string][]velerov1api.BackupCondition{
+				"found-again": {foundAgainCondition},
+			},
+		},
+		{
+			name:        "orphaned backups are deleted with Delete",
+			policy:      velerov1api.OrphanedBackupPolicyDelete,
+			wantBackups: []string{"found-again", "in-location", "in-progress"},
+			wantConditions: map[string][]velerov1api.BackupCondition{
+				"found-again": {foundAgainCondition},
+			},
+		},
+		{
+			name:        "orphaned backups are kept with Mark, and their Orphaned condition is set",
+			policy:      velerov1api.OrphanedBackupPolicyMark,
+			wantBackups: []string{"found-again", "in-location", "in-progress", "orphaned", "still-orphaned"},
+			wantConditions: map[string][]velerov1api.BackupCondition{
+				"orphaned": {{
+					Type:               velerov1api.BackupConditionOrphaned,
+					Status:             velerov1api.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(now),
+					Reason:             "NotFoundInStorage",
+					Message:            "The backup's data isn't in its backup storage location",
+				}},
+				"still-orphaned": {orphanedCondition},
+				"found-again": {{
+					Type:               velerov1api.BackupConditionOrphaned,
+					Status:             velerov1api.ConditionFalse,
+					LastTransitionTime: metav1.NewTime(now),
+					Reason:             "FoundInStorage",
+				}},
+			},
+		},
+		{
+			name:        "orphaned backups are left as they are with Ignore",
+			policy:      velerov1api.OrphanedBackupPolicyIgnore,
+			wantBackups: []string{"found-again", "in-location", "in-progress", "orphaned", "still-orphaned"},
+			wantConditions: map[string][]velerov1api.BackupCondition{
+				"still-orphaned": {orphanedCondition},
+				"found-again":    {foundAgainCondition},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+			)
+
+			c := NewBackupSyncController(
+				client.VeleroV1(),
+				client.VeleroV1(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				sharedInformers.Velero().V1().PodVolumeBackups(),
+				time.Duration(0),
+				"ns-1",
+				"",
+				nil, // new plugin manager func
+				metrics.NewServerMetrics(),
+				velerotest.NewLogger(),
+			).(*backupSyncController)
+			c.clock = clock.NewFakeClock(now)
+
+			backups := []*velerov1api.Backup{
+				newBackup("in-location").Result(),
+				newBackup("orphaned").Result(),
+				newBackup("still-orphaned").Conditions(orphanedCondition).Result(),
+				newBackup("found-again").Conditions(foundAgainCondition).Result(),
+				newBackup("in-progress").Phase(velerov1api.BackupPhaseInProgress).Result(),
+			}
+			for _, backup := range backups {
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+				_, err := client.VeleroV1().Backups("ns-1").Create(backup)
+				require.NoError(t, err)
+			}
+
+			location := builder.ForBackupStorageLocation("ns-1", "default").OrphanedBackupPolicy(tc.policy).Result()
+			c.syncOrphanedBackups(location, sets.NewString("in-location", "found-again"), velerotest.NewLogger())
+
+			list, err := client.VeleroV1().Backups("ns-1").List(metav1.ListOptions{})
+			require.NoError(t, err)
+
+			var names []string
+			conditions := make(map[string][]velerov1api.BackupCondition)
+			for _, backup := range list.Items {
+				names = append(names, backup.Name)
+				if len(backup.Status.Conditions) == 0 {
+					continue
+				}
+
+				// times are decoded from patches in the local time zone.
+				for i := range backup.Status.Conditions {
+					backup.Status.Conditions[i].LastTransitionTime = metav1.NewTime(backup.Status.Conditions[i].LastTransitionTime.UTC())
+				}
+				conditions[backup.Name] = backup.Status.Conditions
+			}
+			sort.Strings(names)
+
+			assert.Equal(t, tc.wantBackups, names)
+			assert.Equal(t, tc.wantConditions, conditions)
+		})
+	}
+}
+
 func getDeleteActions(actions []core.Action) []core.Action {
 	var deleteActions []core.Action
 	for _, action := range actions {
@@ -730,3 +1153,240 @@ func numPodVolumeBackups(t *testing.T, c *fake.Clientset, ns string) (int, error
 
 	return len(existingK8SPodvolumeBackups.Items), nil
 }
+
+func TestPruneIncompleteBackups(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		backupStore     = &persistencemocks.BackupStore{}
+	)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().PodVolumeBackups(),
+		time.Duration(0),
+		"ns-1",
+		"",
+		nil,
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+	c.clock = clock.NewFakeClock(now)
+
+	for _, backup := range []*velerov1api.Backup{
+		builder.ForBackup("ns-1", "in-progress").Phase(velerov1api.BackupPhaseInProgress).Result(),
+		builder.ForBackup("ns-1", "failed").Phase(velerov1api.BackupPhaseFailed).Result(),
+		builder.ForBackup("ns-1", "failed-upload").Phase(velerov1api.BackupPhaseFailed).Result(),
+	} {
+		require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	}
+
+	backupStore.On("ListIncompleteBackups").Return([]persistence.IncompleteBackup{
+		// still being processed
+		{Name: "in-progress", UploadStarted: now.Add(-2 * time.Hour)},
+		// a failed backup's logs
+		{Name: "failed"},
+		// upload interrupted long enough ago
+		{Name: "failed-upload", UploadStarted: now.Add(-2 * time.Hour)},
+		// upload started recently
+		{Name: "recent-upload", UploadStarted: now.Add(-time.Minute)},
+		// no metadata and no custom resource
+		{Name: "orphaned"},
+	}, nil)
+	backupStore.On("DeleteBackup", "failed-upload").Return(nil)
+	backupStore.On("DeleteBackup", "orphaned").Return(nil)
+
+	location := builder.ForBackupStorageLocation("ns-1", "location-1").Result()
+
+	assert.True(t, c.shouldPruneIncompleteBackups(location))
+	c.pruneIncompleteBackups(location, backupStore, velerotest.NewLogger())
+
+	backupStore.AssertNumberOfCalls(t, "DeleteBackup", 2)
+	backupStore.AssertExpectations(t)
+
+	// pruning isn't due again until an hour later, unless it's requested.
+	assert.False(t, c.shouldPruneIncompleteBackups(location))
+
+	location.Annotations = map[string]string{velerov1api.PruneIncompleteBackupsAnnotation: now.Format(time.RFC3339)}
+	assert.True(t, c.shouldPruneIncompleteBackups(location))
+
+	location.Spec.AccessMode = velerov1api.BackupStorageLocationAccessModeReadOnly
+	assert.False(t, c.shouldPruneIncompleteBackups(location))
+}
+
+func TestCheckSpecDrift(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		backupStore     = &persistencemocks.BackupStore{}
+	)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().PodVolumeBackups(),
+		time.Duration(0),
+		"ns-1",
+		"",
+		nil,
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+	c.clock = clock.NewFakeClock(now)
+
+	stored := builder.ForBackup("ns-1", "backup").StorageLocation("location-1").IncludedNamespaces("ns-a").Result()
+	hash, err := pkgbackup.SpecHash(stored.Spec)
+	require.NoError(t, err)
+
+	newBackup := func(name string, namespaces ...string) *builder.BackupBuilder {
+		return builder.ForBackup("ns-1", name).
+			StorageLocation("location-1").
+			IncludedNamespaces(namespaces...).
+			Phase(velerov1api.BackupPhaseCompleted).
+			ObjectMeta(builder.WithAnnotations(velerov1api.BackupSpecHashAnnotation, hash, velerov1api.BackupSpecHashVersionAnnotation, pkgbackup.SpecHashVersion))
+	}
+
+	backups := []*velerov1api.Backup{
+		// spec matches the stored spec
+		newBackup("unchanged", "ns-a").Result(),
+		// spec was changed since the backup was taken
+		newBackup("changed", "ns-a", "ns-b").Result(),
+		// spec was changed back to the stored spec
+		newBackup("reverted", "ns-a").Conditions(velerov1api.BackupCondition{
+			Type:               velerov1api.BackupConditionSpecDrifted,
+			Status:             velerov1api.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(now.Add(-time.Hour)),
+		}).Result(),
+		// just synced from the location
+		newBackup("synced", "ns-a", "ns-b").Result(),
+		// in another location
+		newBackup("other-location", "ns-a", "ns-b").StorageLocation("location-2").Result(),
+		// not in the location
+		newBackup("not-in-location", "ns-a", "ns-b").Result(),
+		// still in progress
+		newBackup("in-progress", "ns-a", "ns-b").Phase(velerov1api.BackupPhaseInProgress).Result(),
+		// taken before spec hashes were recorded
+		builder.ForBackup("ns-1", "no-hash").StorageLocation("location-1").Phase(velerov1api.BackupPhaseCompleted).Result(),
+	}
+	for _, backup := range backups {
+		_, err := client.VeleroV1().Backups("ns-1").Create(backup)
+		require.NoError(t, err)
+	}
+
+	for _, name := range []string{"unchanged", "changed", "reverted"} {
+		backup := stored.DeepCopy()
+		backup.Name = name
+		backup.Annotations = map[string]string{
+			velerov1api.BackupSpecHashAnnotation:        hash,
+			velerov1api.BackupSpecHashVersionAnnotation: pkgbackup.SpecHashVersion,
+		}
+		backupStore.On("GetBackupMetadata", name).Return(backup, nil)
+	}
+
+	location := builder.ForBackupStorageLocation("ns-1", "location-1").Result()
+
+	assert.True(t, c.shouldCheckSpecDrift(location))
+	c.checkSpecDrift(
+		"location-1",
+		backups,
+		sets.NewString("synced"),
+		sets.NewString("unchanged", "changed", "reverted", "synced", "other-location", "in-progress", "no-hash"),
+		backupStore,
+		nil,
+		velerotest.NewLogger(),
+	)
+	backupStore.AssertExpectations(t)
+
+	conditions := func(name string) []velerov1api.BackupCondition {
+		backup, err := client.VeleroV1().Backups("ns-1").Get(name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		// times are decoded from patches in the local time zone.
+		for i := range backup.Status.Conditions {
+			backup.Status.Conditions[i].LastTransitionTime = metav1.NewTime(backup.Status.Conditions[i].LastTransitionTime.UTC())
+		}
+		return backup.Status.Conditions
+	}
+
+	assert.Empty(t, conditions("unchanged"))
+	assert.Equal(t, []velerov1api.BackupCondition{{
+		Type:               velerov1api.BackupConditionSpecDrifted,
+		Status:             velerov1api.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(now),
+		Reason:             "SpecChanged",
+		Message:            "The backup's spec doesn't match the spec stored with it in its backup storage location",
+	}}, conditions("changed"))
+	assert.Equal(t, []velerov1api.BackupCondition{{
+		Type:               velerov1api.BackupConditionSpecDrifted,
+		Status:             velerov1api.ConditionFalse,
+		LastTransitionTime: metav1.NewTime(now),
+		Reason:             "SpecMatchesStorage",
+	}}, conditions("reverted"))
+	for _, name := range []string{"synced", "other-location", "not-in-location", "in-progress", "no-hash"} {
+		assert.Empty(t, conditions(name), name)
+	}
+
+	// checking isn't due again until an hour later, unless a sync is
+	// requested.
+	assert.False(t, c.shouldCheckSpecDrift(location))
+
+	location.Annotations = map[string]string{velerov1api.SyncBackupsAnnotation: now.Format(time.RFC3339)}
+	assert.True(t, c.shouldCheckSpecDrift(location))
+}
+
+func TestSetBackupCondition(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	drifted := velerov1api.BackupCondition{Type: velerov1api.BackupConditionSpecDrifted, Status: velerov1api.ConditionTrue, Reason: "SpecChanged"}
+	notDrifted := velerov1api.BackupCondition{Type: velerov1api.BackupConditionSpecDrifted, Status: velerov1api.ConditionFalse, Reason: "SpecMatchesStorage"}
+
+	// a condition that doesn't hold isn't added.
+	status := &velerov1api.BackupStatus{}
+	assert.False(t, setBackupCondition(status, notDrifted, now))
+	assert.Empty(t, status.Conditions)
+
+	// a condition that holds is added.
+	assert.True(t, setBackupCondition(status, drifted, now))
+	assert.Equal(t, metav1.NewTime(now), status.Conditions[0].LastTransitionTime)
+
+	// an unchanged condition isn't updated.
+	status.Conditions[0].LastTransitionTime = earlier
+	assert.False(t, setBackupCondition(status, drifted, now))
+	assert.Equal(t, earlier, status.Conditions[0].LastTransitionTime)
+
+	// a changed message keeps the transition time.
+	drifted.Message = "changed"
+	assert.True(t, setBackupCondition(status, drifted, now))
+	assert.Equal(t, "changed", status.Conditions[0].Message)
+	assert.Equal(t, earlier, status.Conditions[0].LastTransitionTime)
+
+	// a changed status updates the transition time.
+	assert.True(t, setBackupCondition(status, notDrifted, now))
+	require.Len(t, status.Conditions, 1)
+	assert.Equal(t, velerov1api.ConditionFalse, status.Conditions[0].Status)
+	assert.Equal(t, metav1.NewTime(now), status.Conditions[0].LastTransitionTime)
+}
+
+func TestSpecHashIgnoresStorageLocation(t *testing.T) {
+	a, err := pkgbackup.SpecHash(builder.ForBackup("ns-1", "backup").StorageLocation("location-1").TTL(time.Hour).Result().Spec)
+	require.NoError(t, err)
+	b, err := pkgbackup.SpecHash(builder.ForBackup("ns-1", "backup").StorageLocation("location-2").TTL(time.Hour).Result().Spec)
+	require.NoError(t, err)
+	c, err := pkgbackup.SpecHash(builder.ForBackup("ns-1", "backup").StorageLocation("location-1").TTL(2 * time.Hour).Result().Spec)
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+const (
+	verificationPassed  = "Passed"
+	verificationFailed  = "Failed"
+	verificationSkipped = "Skipped"
+)
+
+// verificationExcludedResources are the resources that verification restores
+// don't restore, since they'd start workloads, wait for volumes or provision
+// load balancers in the scratch namespaces.
+var verificationExcludedResources = []string{
+	"pods",
+	"persistentvolumeclaims",
+	"services",
+}
+
+// backupVerificationController verifies the backups that are annotated for it
+// by their schedules, once they've been uploaded to object storage, by
+// restoring the namespaces in them into scratch namespaces. The verification
+// passes if the restore completes without errors. The scratch namespaces are
+// deleted once the restore finishes, and the restore is kept so that its logs
+// and results can be inspected.
+type backupVerificationController struct {
+	*genericController
+
+	backupLister         listers.BackupLister
+	backupClient         velerov1client.BackupsGetter
+	restoreLister        listers.RestoreLister
+	restoreClient        velerov1client.RestoresGetter
+	namespaceClient      corev1client.NamespaceInterface
+	backupLocationLister listers.BackupStorageLocationLister
+	newPluginManager     func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore       func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	metrics              *metrics.ServerMetrics
+}
+
+// NewBackupVerificationController constructs a new backupVerificationController.
+func NewBackupVerificationController(
+	logger logrus.FieldLogger,
+	backupInformer informers.BackupInformer,
+	backupClient velerov1client.BackupsGetter,
+	restoreInformer informers.RestoreInformer,
+	restoreClient velerov1client.RestoresGetter,
+	namespaceClient corev1client.NamespaceInterface,
+	backupLocationInformer informers.BackupStorageLocationInformer,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	metrics *metrics.ServerMetrics,
+) Interface {
+	c := &backupVerificationController{
+		genericController:    newGenericController("backup-verification", logger),
+		backupLister:         backupInformer.Lister(),
+		backupClient:         backupClient,
+		restoreLister:        restoreInformer.Lister(),
+		restoreClient:        restoreClient,
+		namespaceClient:      namespaceClient,
+		backupLocationLister: backupLocationInformer.Lister(),
+		newPluginManager:     newPluginManager,
+		newBackupStore:       persistence.NewObjectBackupStore,
+		metrics:              metrics,
+	}
+
+	c.syncHandler = c.processBackup
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters,
+		backupInformer.Informer().HasSynced,
+		restoreInformer.Informer().HasSynced,
+		backupLocationInformer.Informer().HasSynced,
+	)
+
+	c.watch(backupInformer.Informer(), onCreateOrUpdate(func(obj runtime.Object) bool {
+		return needsVerification(obj.(*velerov1api.Backup))
+	}))
+
+	// a verification restore's backup is processed again whenever the
+	// restore changes, so that its result is recorded once it finishes.
+	c.watchMapped(restoreInformer.Informer(), verifiedBackup, onCreateOrUpdate(func(obj runtime.Object) bool {
+		return obj.(*velerov1api.Restore).Labels[velerov1api.BackupVerificationLabel] == "true"
+	}))
+
+	return c
+}
+
+// verifiedBackup returns the request for the backup that a verification
+// restore verifies.
+func verifiedBackup(obj handler.MapObject) []reconcile.Request {
+	restore := obj.Object.(*velerov1api.Restore)
+	return []reconcile.Request{requestFor(restore.Namespace, restore.Spec.BackupName)}
+}
+
+// needsVerification returns whether a backup is annotated for a verification
+// that it doesn't have the result of yet, and has been uploaded to object
+// storage.
+func needsVerification(backup *velerov1api.Backup) bool {
+	if backup.Annotations[velerov1api.VerifyBackupAnnotation] != "true" {
+		return false
+	}
+	if backup.Annotations[velerov1api.VerificationResultAnnotation] != "" {
+		return false
+	}
+
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed,
+		velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *backupVerificationController) processBackup(key string) error {
+	log := c.logger.WithField("backup", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	backup, err := c.backupLister.Backups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+
+	if !needsVerification(backup) {
+		return nil
+	}
+
+	if backup.Annotations[velerov1api.VerificationRestoreAnnotation] == "" {
+		return c.startVerification(backup, log)
+	}
+
+	return c.finishVerification(backup, log)
+}
+
+// startVerification creates the restore that verifies a backup, and records
+// its name on the backup.
+func (c *backupVerificationController) startVerification(original *velerov1api.Backup, log logrus.FieldLogger) error {
+	location, err := c.backupLocationLister.BackupStorageLocations(original.Namespace).Get(original.Spec.StorageLocation)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup storage location %s", original.Spec.StorageLocation)
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.newBackupStore(persistence.MemberClusterLocation(location, original.Spec.Cluster), pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	resourceList, err := backupStore.GetBackupResourceList(original.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup resource list")
+	}
+
+	backup := original.DeepCopy()
+
+	namespaces := backupNamespaces(resourceList)
+	if len(namespaces) == 0 {
+		log.Info("Backup has no namespaces to restore, skipping its verification")
+		backup.Annotations[velerov1api.VerificationResultAnnotation] = verificationSkipped
+		if _, err := patchBackup(original, backup, c.backupClient); err != nil {
+			return errors.Wrap(err, "error updating backup's verification result")
+		}
+		return nil
+	}
+
+	restore := verificationRestore(backup, namespaces)
+	if _, err := c.restoreClient.Restores(restore.Namespace).Create(restore); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "error creating verification restore %s", restore.Name)
+	}
+	log.WithField("restore", restore.Name).Info("Verifying backup by restoring it into scratch namespaces")
+
+	backup.Annotations[velerov1api.VerificationRestoreAnnotation] = restore.Name
+	if _, err := patchBackup(original, backup, c.backupClient); err != nil {
+		return errors.Wrap(err, "error updating backup's verification restore")
+	}
+
+	return nil
+}
+
+// finishVerification records the result of a backup's verification once its
+// restore has finished, and deletes the restore's scratch namespaces.
+func (c *backupVerificationController) finishVerification(original *velerov1api.Backup, log logrus.FieldLogger) error {
+	restoreName := original.Annotations[velerov1api.VerificationRestoreAnnotation]
+	log = log.WithField("restore", restoreName)
+
+	result := verificationFailed
+
+	restore, err := c.restoreLister.Restores(original.Namespace).Get(restoreName)
+	switch {
+	case apierrors.IsNotFound(err):
+		log.Error("Verification restore was deleted before it finished")
+	case err != nil:
+		return errors.Wrapf(err, "error getting verification restore %s", restoreName)
+	default:
+		switch restore.Status.Phase {
+		case velerov1api.RestorePhaseCompleted:
+			result = verificationPassed
+		case velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed, velerov1api.RestorePhaseFailedValidation:
+		default:
+			// the restore hasn't finished yet
+			return nil
+		}
+
+		for _, namespace := range restore.Spec.NamespaceMapping {
+			if err := c.namespaceClient.Delete(namespace, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "error deleting scratch namespace %s", namespace)
+			}
+		}
+	}
+
+	backupScheduleName := original.GetLabels()[velerov1api.ScheduleNameLabel]
+	if result == verificationPassed {
+		log.Info("Backup passed its verification")
+		c.metrics.RegisterBackupVerificationSuccess(backupScheduleName)
+	} else {
+		log.Error("Backup failed its verification")
+		c.metrics.RegisterBackupVerificationFailure(backupScheduleName)
+	}
+
+	backup := original.DeepCopy()
+	backup.Annotations[velerov1api.VerificationResultAnnotation] = result
+	if _, err := patchBackup(original, backup, c.backupClient); err != nil {
+		return errors.Wrap(err, "error updating backup's verification result")
+	}
+
+	return nil
+}
+
+// backupNamespaces returns the sorted names of the namespaces in a backup's
+// resource list, which lists namespaced items as namespace/name.
+func backupNamespaces(resourceList map[string][]string) []string {
+	namespaces := sets.NewString()
+	for resource, items := range resourceList {
+		for _, item := range items {
+			if i := strings.Index(item, "/"); i >= 0 {
+				namespaces.Insert(item[:i])
+			} else if resource == "v1/Namespace" {
+				namespaces.Insert(item)
+			}
+		}
+	}
+
+	return namespaces.List()
+}
+
+// verificationRestore returns the restore that verifies a backup, by restoring
+// the given namespaces in it into scratch namespaces without its volumes or
+// its cluster-scoped resources.
+func verificationRestore(backup *velerov1api.Backup, namespaces []string) *velerov1api.Restore {
+	// the scratch namespaces are named after the backup's hash, so that the
+	// verifications of different backups of the same namespaces don't
+	// restore into the same scratch namespaces.
+	sum := sha256.Sum256([]byte(backup.Namespace + "/" + backup.Name))
+	prefix := fmt.Sprintf("velero-verify-%x-", sum[:4])
+
+	mapping := make(map[string]string, len(namespaces))
+	for _, namespace := range namespaces {
+		mapping[namespace] = label.GetValidName(prefix + namespace)
+	}
+
+	return &velerov1api.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name + "-verify",
+			Labels: map[string]string{
+				velerov1api.BackupVerificationLabel: "true",
+			},
+		},
+		Spec: velerov1api.RestoreSpec{
+			BackupName:              backup.Name,
+			IncludedNamespaces:      namespaces,
+			NamespaceMapping:        mapping,
+			ExcludedResources:       append([]string(nil), verificationExcludedResources...),
+			IncludeClusterResources: boolptr.False(),
+			RestorePVs:              boolptr.False(),
+		},
+	}
+}
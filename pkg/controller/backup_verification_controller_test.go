@@ -0,0 +1,243 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func TestNeedsVerification(t *testing.T) {
+	tests := []struct {
+		name   string
+		backup *velerov1api.Backup
+		want   bool
+	}{
+		{
+			name:   "backup without annotation doesn't need verification",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).Result(),
+		},
+		{
+			name: "completed backup with annotation needs verification",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(
+				builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true"),
+			).Result(),
+			want: true,
+		},
+		{
+			name: "completed backup whose verification restore was created still needs verification",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(
+				builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true", velerov1api.VerificationRestoreAnnotation, "backup-1-verify"),
+			).Result(),
+			want: true,
+		},
+		{
+			name: "in-progress backup with annotation doesn't need verification yet",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseInProgress).ObjectMeta(
+				builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true"),
+			).Result(),
+		},
+		{
+			name: "failed backup with annotation doesn't need verification",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseFailed).ObjectMeta(
+				builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true"),
+			).Result(),
+		},
+		{
+			name: "backup that has been verified doesn't need another verification",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(
+				builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true", velerov1api.VerificationResultAnnotation, verificationPassed),
+			).Result(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, needsVerification(test.backup))
+		})
+	}
+}
+
+func TestBackupNamespaces(t *testing.T) {
+	resourceList := map[string][]string{
+		"v1/Pod":              {"ns-1/pod-1", "ns-2/pod-2"},
+		"v1/PersistentVolume": {"pv-1"},
+		"v1/Namespace":        {"ns-1", "ns-3"},
+	}
+
+	assert.Equal(t, []string{"ns-1", "ns-2", "ns-3"}, backupNamespaces(resourceList))
+	assert.Empty(t, backupNamespaces(map[string][]string{"v1/PersistentVolume": {"pv-1"}}))
+}
+
+func TestVerificationRestore(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").Result()
+
+	restore := verificationRestore(backup, []string{"ns-1", "ns-2"})
+
+	assert.Equal(t, "velero", restore.Namespace)
+	assert.Equal(t, "backup-1-verify", restore.Name)
+	assert.Equal(t, "true", restore.Labels[velerov1api.BackupVerificationLabel])
+	assert.Equal(t, "backup-1", restore.Spec.BackupName)
+	assert.Equal(t, []string{"ns-1", "ns-2"}, restore.Spec.IncludedNamespaces)
+	assert.Equal(t, []string{"pods", "persistentvolumeclaims", "services"}, restore.Spec.ExcludedResources)
+	assert.Equal(t, boolptr.False(), restore.Spec.IncludeClusterResources)
+	assert.Equal(t, boolptr.False(), restore.Spec.RestorePVs)
+
+	// every namespace is restored into its own scratch namespace, whose
+	// name is unique to the backup.
+	require.Len(t, restore.Spec.NamespaceMapping, 2)
+	assert.Regexp(t, "^velero-verify-[0-9a-f]{8}-ns-1$", restore.Spec.NamespaceMapping["ns-1"])
+	assert.Regexp(t, "^velero-verify-[0-9a-f]{8}-ns-2$", restore.Spec.NamespaceMapping["ns-2"])
+
+	other := verificationRestore(builder.ForBackup("velero", "backup-2").Result(), []string{"ns-1"})
+	assert.NotEqual(t, restore.Spec.NamespaceMapping["ns-1"], other.Spec.NamespaceMapping["ns-1"])
+}
+
+func TestBackupVerificationControllerProcessBackup(t *testing.T) {
+	verifyAnnotations := func(restoreName string) builder.ObjectMetaOpt {
+		if restoreName == "" {
+			return builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true")
+		}
+		return builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true", velerov1api.VerificationRestoreAnnotation, restoreName)
+	}
+
+	tests := []struct {
+		name              string
+		backup            *velerov1api.Backup
+		resourceList      map[string][]string
+		restore           *velerov1api.Restore
+		wantRestore       bool
+		wantResult        string
+		wantNamespaceGone bool
+	}{
+		{
+			name:         "backup with namespaces gets a verification restore",
+			backup:       defaultBackup().StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(verifyAnnotations("")).Result(),
+			resourceList: map[string][]string{"v1/Pod": {"ns-1/pod-1"}},
+			wantRestore:  true,
+		},
+		{
+			name:         "backup without namespaces skips its verification",
+			backup:       defaultBackup().StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(verifyAnnotations("")).Result(),
+			resourceList: map[string][]string{"v1/PersistentVolume": {"pv-1"}},
+			wantResult:   verificationSkipped,
+		},
+		{
+			name:   "backup whose verification restore is in progress has no result yet",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(verifyAnnotations("backup-1-verify")).Result(),
+			restore: builder.ForRestore("velero", "backup-1-verify").Backup("backup-1").NamespaceMappings("ns-1", "scratch-1").
+				Phase(velerov1api.RestorePhaseInProgress).Result(),
+		},
+		{
+			name:   "backup whose verification restore completed passes",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(verifyAnnotations("backup-1-verify")).Result(),
+			restore: builder.ForRestore("velero", "backup-1-verify").Backup("backup-1").NamespaceMappings("ns-1", "scratch-1").
+				Phase(velerov1api.RestorePhaseCompleted).Result(),
+			wantResult:        verificationPassed,
+			wantNamespaceGone: true,
+		},
+		{
+			name:   "backup whose verification restore partially failed fails",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(verifyAnnotations("backup-1-verify")).Result(),
+			restore: builder.ForRestore("velero", "backup-1-verify").Backup("backup-1").NamespaceMappings("ns-1", "scratch-1").
+				Phase(velerov1api.RestorePhasePartiallyFailed).Result(),
+			wantResult:        verificationFailed,
+			wantNamespaceGone: true,
+		},
+		{
+			name:       "backup whose verification restore was deleted fails",
+			backup:     defaultBackup().Phase(velerov1api.BackupPhaseCompleted).ObjectMeta(verifyAnnotations("backup-1-verify")).Result(),
+			wantResult: verificationFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(test.backup)
+				kubeClient      = kubefake.NewSimpleClientset(builder.ForNamespace("scratch-1").Result())
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+			)
+
+			c := NewBackupVerificationController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Restores(),
+				client.VeleroV1(),
+				kubeClient.CoreV1().Namespaces(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				metrics.NewServerMetrics(),
+			).(*backupVerificationController)
+			c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(builder.ForBackupStorageLocation("velero", "default").Result()))
+			if test.restore != nil {
+				require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(test.restore))
+			}
+
+			pluginManager.On("CleanupClients").Return()
+			backupStore.On("GetBackupResourceList", test.backup.Name).Return(test.resourceList, nil)
+
+			require.NoError(t, c.processBackup(test.backup.Namespace+"/"+test.backup.Name))
+
+			res, err := client.VeleroV1().Backups(test.backup.Namespace).Get(test.backup.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.wantResult, res.Annotations[velerov1api.VerificationResultAnnotation])
+
+			restore, err := client.VeleroV1().Restores(test.backup.Namespace).Get(test.backup.Name+"-verify", metav1.GetOptions{})
+			if test.wantRestore {
+				require.NoError(t, err)
+				assert.Equal(t, []string{"ns-1"}, restore.Spec.IncludedNamespaces)
+				assert.Equal(t, restore.Name, res.Annotations[velerov1api.VerificationRestoreAnnotation])
+			} else {
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+
+			_, err = kubeClient.CoreV1().Namespaces().Get("scratch-1", metav1.GetOptions{})
+			if test.wantNamespaceGone {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -17,10 +17,9 @@ limitations under the License.
 package controller
 
 import (
-	"encoding/json"
+	"io"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,6 +30,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/downloadproxy"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -42,29 +42,56 @@ import (
 type downloadRequestController struct {
 	*genericController
 
+	// getter finds the backup stores that have the objects of download
+	// requests.
+	getter *downloadGetter
+
 	downloadRequestClient velerov1client.DownloadRequestsGetter
 	downloadRequestLister listers.DownloadRequestLister
-	restoreLister         listers.RestoreLister
 	clock                 clock.Clock
+
+	// downloadProxy signs the URLs of downloads through the server's
+	// download proxy, which are returned instead of the object store's
+	// signed URLs if it's set.
+	downloadProxy *downloadproxy.Signer
+}
+
+// downloadGetter finds the backup stores that have the objects of download
+// requests, and gets the objects for the server's download proxy.
+type downloadGetter struct {
+	downloadRequestLister listers.DownloadRequestLister
+	restoreLister         listers.RestoreLister
 	backupLocationLister  listers.BackupStorageLocationLister
 	backupLister          listers.BackupLister
 	newPluginManager      func(logrus.FieldLogger) clientmgmt.Manager
 	newBackupStore        func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	logger                logrus.FieldLogger
 }
 
-// NewDownloadRequestController creates a new DownloadRequestController.
-func NewDownloadRequestController(
-	downloadRequestClient velerov1client.DownloadRequestsGetter,
+// NewDownloadGetter returns a downloadproxy.DownloadGetter that gets the
+// objects of download requests from object storage, for the server's
+// download proxy. It only reads the informers' caches, so it doesn't need
+// the download request controller to be running.
+func NewDownloadGetter(
 	downloadRequestInformer informers.DownloadRequestInformer,
 	restoreInformer informers.RestoreInformer,
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	backupInformer informers.BackupInformer,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	logger logrus.FieldLogger,
-) Interface {
-	c := &downloadRequestController{
-		genericController:     newGenericController("downloadrequest", logger),
-		downloadRequestClient: downloadRequestClient,
+) downloadproxy.DownloadGetter {
+	return newDownloadGetter(downloadRequestInformer, restoreInformer, backupLocationInformer, backupInformer, newPluginManager, logger)
+}
+
+func newDownloadGetter(
+	downloadRequestInformer informers.DownloadRequestInformer,
+	restoreInformer informers.RestoreInformer,
+	backupLocationInformer informers.BackupStorageLocationInformer,
+	backupInformer informers.BackupInformer,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	logger logrus.FieldLogger,
+) *downloadGetter {
+	return &downloadGetter{
 		downloadRequestLister: downloadRequestInformer.Lister(),
 		restoreLister:         restoreInformer.Lister(),
 		backupLocationLister:  backupLocationInformer.Lister(),
@@ -74,8 +101,30 @@ func NewDownloadRequestController(
 		// replaced with fakes for testing.
 		newPluginManager: newPluginManager,
 		newBackupStore:   persistence.NewObjectBackupStore,
+		logger:           logger,
+	}
+}
 
-		clock: &clock.RealClock{},
+// NewDownloadRequestController creates a new DownloadRequestController. If
+// downloadProxy isn't nil, download requests are given URLs of the server's
+// download proxy instead of the object store's signed URLs.
+func NewDownloadRequestController(
+	downloadRequestClient velerov1client.DownloadRequestsGetter,
+	downloadRequestInformer informers.DownloadRequestInformer,
+	restoreInformer informers.RestoreInformer,
+	backupLocationInformer informers.BackupStorageLocationInformer,
+	backupInformer informers.BackupInformer,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	downloadProxy *downloadproxy.Signer,
+	logger logrus.FieldLogger,
+) Interface {
+	c := &downloadRequestController{
+		genericController:     newGenericController("downloadrequest", logger),
+		getter:                newDownloadGetter(downloadRequestInformer, restoreInformer, backupLocationInformer, backupInformer, newPluginManager, logger),
+		downloadRequestClient: downloadRequestClient,
+		downloadRequestLister: downloadRequestInformer.Lister(),
+		clock:                 &clock.RealClock{},
+		downloadProxy:         downloadProxy,
 	}
 
 	c.syncHandler = c.processDownloadRequest
@@ -87,21 +136,7 @@ func NewDownloadRequestController(
 		backupInformer.Informer().HasSynced,
 	)
 
-	downloadRequestInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				key, err := cache.MetaNamespaceKeyFunc(obj)
-				if err != nil {
-					downloadRequest := obj.(*v1.DownloadRequest)
-					c.logger.WithError(errors.WithStack(err)).
-						WithField("downloadRequest", downloadRequest.Name).
-						Error("Error creating queue key, item not added to queue")
-					return
-				}
-				c.queue.Add(key)
-			},
-		},
-	)
+	c.watch(downloadRequestInformer.Informer(), onCreate(nil))
 
 	return c
 }
@@ -143,51 +178,119 @@ const signedURLTTL = 10 * time.Minute
 // Processed, and persists the changes to storage.
 func (c *downloadRequestController) generatePreSignedURL(downloadRequest *v1.DownloadRequest, log logrus.FieldLogger) error {
 	update := downloadRequest.DeepCopy()
+	expiration := c.clock.Now().Add(persistence.DownloadURLTTL)
+
+	pluginManager := c.getter.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.getter.backupStoreFor(downloadRequest, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	if c.downloadProxy != nil {
+		update.Status.DownloadURL = c.downloadProxy.URL(downloadRequest, expiration)
+	} else if update.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
+		return err
+	}
 
+	update.Status.Phase = v1.DownloadRequestPhaseProcessed
+	update.Status.Expiration = metav1.NewTime(expiration)
+
+	_, err = patchDownloadRequest(downloadRequest, update, c.downloadRequestClient)
+	return errors.WithStack(err)
+}
+
+// GetDownloadRequest returns a download request, for serving its object
+// through the server's download proxy.
+func (g *downloadGetter) GetDownloadRequest(namespace, name string) (*v1.DownloadRequest, error) {
+	downloadRequest, err := g.downloadRequestLister.DownloadRequests(namespace).Get(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting DownloadRequest")
+	}
+	return downloadRequest, nil
+}
+
+// GetDownload returns the object of a download request, for serving it
+// through the server's download proxy.
+func (g *downloadGetter) GetDownload(downloadRequest *v1.DownloadRequest) (io.ReadCloser, error) {
+	log := g.logger.WithField("key", kube.NamespaceAndName(downloadRequest))
+
+	pluginManager := g.newPluginManager(log)
+
+	backupStore, err := g.backupStoreFor(downloadRequest, pluginManager, log)
+	if err != nil {
+		pluginManager.CleanupClients()
+		return nil, err
+	}
+
+	download, err := backupStore.GetDownload(downloadRequest.Spec.Target)
+	if err != nil {
+		pluginManager.CleanupClients()
+		return nil, err
+	}
+
+	// the object is read from the object store plugin, so its client is
+	// only cleaned up once the object is closed.
+	return &cleanupReadCloser{ReadCloser: download, cleanup: pluginManager.CleanupClients}, nil
+}
+
+// cleanupReadCloser is an io.ReadCloser that calls a cleanup function once
+// it's closed.
+type cleanupReadCloser struct {
+	io.ReadCloser
+	cleanup func()
+}
+
+func (r *cleanupReadCloser) Close() error {
+	defer r.cleanup()
+	return r.ReadCloser.Close()
+}
+
+// backupStoreFor returns the backup store that has the object of a download
+// request.
+func (g *downloadGetter) backupStoreFor(downloadRequest *v1.DownloadRequest, pluginManager clientmgmt.Manager, log logrus.FieldLogger) (persistence.BackupStore, error) {
 	var (
-		backupName string
-		err        error
+		backupName   string
+		locationName string
+		err          error
 	)
 
 	switch downloadRequest.Spec.Target.Kind {
 	case v1.DownloadTargetKindRestoreLog, v1.DownloadTargetKindRestoreResults:
-		restore, err := c.restoreLister.Restores(downloadRequest.Namespace).Get(downloadRequest.Spec.Target.Name)
+		restore, err := g.restoreLister.Restores(downloadRequest.Namespace).Get(downloadRequest.Spec.Target.Name)
 		if err != nil {
-			return errors.Wrap(err, "error getting Restore")
+			return nil, errors.Wrap(err, "error getting Restore")
 		}
 
 		backupName = restore.Spec.BackupName
+		locationName = restore.Spec.StorageLocation
 	default:
 		backupName = downloadRequest.Spec.Target.Name
 	}
 
-	backup, err := c.backupLister.Backups(downloadRequest.Namespace).Get(backupName)
+	backup, err := g.backupLister.Backups(downloadRequest.Namespace).Get(backupName)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
-	backupLocation, err := c.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(backup.Spec.StorageLocation)
-	if err != nil {
-		return errors.WithStack(err)
+	// restores from a location other than the backup's storage location
+	// have their logs and results stored in that location.
+	if locationName == "" {
+		locationName = backup.Spec.StorageLocation
 	}
 
-	pluginManager := c.newPluginManager(log)
-	defer pluginManager.CleanupClients()
-
-	backupStore, err := c.newBackupStore(backupLocation, pluginManager, log)
+	backupLocation, err := g.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(locationName)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
-	if update.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
-		return err
+	backupStore, err := g.newBackupStore(persistence.MemberClusterLocation(backupLocation, backup.Spec.Cluster), pluginManager, log)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
 
-	update.Status.Phase = v1.DownloadRequestPhaseProcessed
-	update.Status.Expiration = metav1.NewTime(c.clock.Now().Add(persistence.DownloadURLTTL))
-
-	_, err = patchDownloadRequest(downloadRequest, update, c.downloadRequestClient)
-	return errors.WithStack(err)
+	return backupStore, nil
 }
 
 // deleteIfExpired deletes downloadRequest if it has expired.
@@ -213,33 +316,16 @@ func (c *downloadRequestController) resync() {
 	}
 
 	for _, dr := range list {
-		key, err := cache.MetaNamespaceKeyFunc(dr)
-		if err != nil {
-			c.logger.WithError(errors.WithStack(err)).WithField("downloadRequest", dr.Name).Error("error generating key for download request")
-			continue
-		}
-
-		c.queue.Add(key)
+		c.enqueue(dr)
 	}
 }
 
 func patchDownloadRequest(original, updated *v1.DownloadRequest, client velerov1client.DownloadRequestsGetter) (*v1.DownloadRequest, error) {
-	origBytes, err := json.Marshal(original)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling original download request")
-	}
-
-	updatedBytes, err := json.Marshal(updated)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling updated download request")
-	}
-
-	patchBytes, err := jsonpatch.CreateMergePatch(origBytes, updatedBytes)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating json merge patch for download request")
-	}
-
-	res, err := client.DownloadRequests(original.Namespace).Patch(original.Name, types.MergePatchType, patchBytes)
+	var res *v1.DownloadRequest
+	err := kube.Patch(original, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) (err error) {
+		res, err = client.DownloadRequests(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error patching download request")
 	}
@@ -17,11 +17,15 @@ limitations under the License.
 package controller
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,6 +33,7 @@ import (
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/downloadproxy"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
@@ -61,6 +66,7 @@ func newDownloadRequestTestHarness(t *testing.T) *downloadRequestTestHarness {
 			informerFactory.Velero().V1().BackupStorageLocations(),
 			informerFactory.Velero().V1().Backups(),
 			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			nil, // download proxy
 			velerotest.NewLogger(),
 		).(*downloadRequestController)
 	)
@@ -69,7 +75,7 @@ func newDownloadRequestTestHarness(t *testing.T) *downloadRequestTestHarness {
 	require.NoError(t, err)
 	controller.clock = clock.NewFakeClock(clockTime)
 
-	controller.newBackupStore = func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+	controller.getter.newBackupStore = func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
 		return backupStore, nil
 	}
 
@@ -318,3 +324,49 @@ func TestProcessDownloadRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessDownloadRequestWithProxy(t *testing.T) {
+	harness := newDownloadRequestTestHarness(t)
+
+	signer, err := downloadproxy.NewSigner("https://velero.example.com", make([]byte, 32))
+	require.NoError(t, err)
+	harness.controller.downloadProxy = signer
+
+	downloadRequest := newDownloadRequest("", v1.DownloadTargetKindBackupLog, "a-backup")
+	require.NoError(t, harness.informerFactory.Velero().V1().DownloadRequests().Informer().GetStore().Add(downloadRequest))
+	_, err = harness.client.VeleroV1().DownloadRequests(downloadRequest.Namespace).Create(downloadRequest)
+	require.NoError(t, err)
+	require.NoError(t, harness.informerFactory.Velero().V1().Backups().Informer().GetStore().Add(builder.ForBackup(v1.DefaultNamespace, "a-backup").StorageLocation("a-location").Result()))
+	require.NoError(t, harness.informerFactory.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(newBackupLocation("a-location", "a-provider", "a-bucket")))
+
+	require.NoError(t, harness.controller.processDownloadRequest(kubeutil.NamespaceAndName(downloadRequest)))
+
+	// the object store's signed URL isn't used.
+	harness.backupStore.AssertNotCalled(t, "GetDownloadURL", mock.Anything)
+
+	output, err := harness.client.VeleroV1().DownloadRequests(downloadRequest.Namespace).Get(downloadRequest.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	expiration := harness.controller.clock.Now().Add(signedURLTTL)
+	assert.Equal(t, v1.DownloadRequestPhaseProcessed, output.Status.Phase)
+	assert.Equal(t, signer.URL(downloadRequest, expiration), output.Status.DownloadURL)
+	assert.True(t, velerotest.TimesAreEqual(expiration, output.Status.Expiration.Time), "expiration does not match")
+
+	// the proxy gets the object from the backup store.
+	harness.backupStore.On("GetDownload", downloadRequest.Spec.Target).Return(ioutil.NopCloser(strings.NewReader("logs")), nil)
+
+	request, err := harness.controller.getter.GetDownloadRequest(downloadRequest.Namespace, downloadRequest.Name)
+	require.NoError(t, err)
+	download, err := harness.controller.getter.GetDownload(request)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(download)
+	require.NoError(t, err)
+	assert.Equal(t, "logs", string(data))
+
+	harness.pluginManager.AssertNumberOfCalls(t, "CleanupClients", 1)
+	require.NoError(t, download.Close())
+	harness.pluginManager.AssertNumberOfCalls(t, "CleanupClients", 2)
+
+	_, err = harness.controller.getter.GetDownloadRequest(downloadRequest.Namespace, "missing")
+	assert.True(t, apierrors.IsNotFound(errors.Cause(err)))
+}
@@ -17,12 +17,14 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/client-go/tools/cache"
 
@@ -31,21 +33,29 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
-	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 const (
 	GCSyncPeriod = 60 * time.Minute
 )
 
-// gcController creates DeleteBackupRequests for expired backups.
+// gcController creates DeleteBackupRequests for expired backups, and deletes
+// the logs of backups whose logs have expired.
 type gcController struct {
 	*genericController
 
-	backupLister              listers.BackupLister
-	deleteBackupRequestLister listers.DeleteBackupRequestLister
-	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter
-	backupLocationLister      listers.BackupStorageLocationLister
+	backupLister               listers.BackupLister
+	backupClient               velerov1client.BackupsGetter
+	deleteBackupRequestIndexer cache.Indexer
+	deleteBackupRequestClient  velerov1client.DeleteBackupRequestsGetter
+	backupLocationLister       listers.BackupStorageLocationLister
+	restoreIndexer             cache.Indexer
+	scheduleLister             listers.ScheduleLister
+	newPluginManager           func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore             func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
 
 	clock clock.Clock
 }
@@ -54,35 +64,44 @@ type gcController struct {
 func NewGCController(
 	logger logrus.FieldLogger,
 	backupInformer informers.BackupInformer,
+	backupClient velerov1client.BackupsGetter,
 	deleteBackupRequestInformer informers.DeleteBackupRequestInformer,
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
 	backupLocationInformer informers.BackupStorageLocationInformer,
+	restoreInformer informers.RestoreInformer,
+	scheduleInformer informers.ScheduleInformer,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 ) Interface {
 	c := &gcController{
-		genericController:         newGenericController("gc-controller", logger),
-		clock:                     clock.RealClock{},
-		backupLister:              backupInformer.Lister(),
-		deleteBackupRequestLister: deleteBackupRequestInformer.Lister(),
-		deleteBackupRequestClient: deleteBackupRequestClient,
-		backupLocationLister:      backupLocationInformer.Lister(),
+		genericController:          newGenericController("gc-controller", logger),
+		clock:                      clock.RealClock{},
+		backupLister:               backupInformer.Lister(),
+		backupClient:               backupClient,
+		deleteBackupRequestIndexer: deleteBackupRequestInformer.Informer().GetIndexer(),
+		deleteBackupRequestClient:  deleteBackupRequestClient,
+		backupLocationLister:       backupLocationInformer.Lister(),
+		restoreIndexer:             restoreInformer.Informer().GetIndexer(),
+		scheduleLister:             scheduleInformer.Lister(),
+		newPluginManager:           newPluginManager,
+		newBackupStore:             persistence.NewObjectBackupStore,
 	}
 
+	addIndexers(deleteBackupRequestInformer.Informer(), cache.Indexers{backupNameIndex: backupNameIndexFunc})
+	addIndexers(restoreInformer.Informer(), cache.Indexers{backupNameIndex: backupNameIndexFunc})
+
 	c.syncHandler = c.processQueueItem
 	c.cacheSyncWaiters = append(c.cacheSyncWaiters,
 		backupInformer.Informer().HasSynced,
 		deleteBackupRequestInformer.Informer().HasSynced,
 		backupLocationInformer.Informer().HasSynced,
+		restoreInformer.Informer().HasSynced,
+		scheduleInformer.Informer().HasSynced,
 	)
 
 	c.resyncPeriod = GCSyncPeriod
 	c.resyncFunc = c.enqueueAllBackups
 
-	backupInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc:    c.enqueue,
-			UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
-		},
-	)
+	c.watch(backupInformer.Informer(), onCreateOrUpdate(nil))
 
 	return c
 }
@@ -131,10 +150,19 @@ func (c *gcController) processQueueItem(key string) error {
 
 	expiration := backup.Status.Expiration.Time
 	if expiration.IsZero() || expiration.After(now) {
+		if logsExpired(backup, now) {
+			return c.deleteExpiredLogs(backup, log)
+		}
+
 		log.Debug("Backup has not expired yet, skipping")
 		return nil
 	}
 
+	if c.retainedBySchedule(backup) {
+		log.Debug("Backup has expired, but it's deleted by its schedule's retention policy instead, skipping")
+		return nil
+	}
+
 	log.Info("Backup has expired")
 
 	loc, err := c.backupLocationLister.BackupStorageLocations(ns).Get(backup.Spec.StorageLocation)
@@ -150,28 +178,20 @@ func (c *gcController) processQueueItem(key string) error {
 		return nil
 	}
 
-	selector := labels.SelectorFromSet(labels.Set(map[string]string{
-		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
-		velerov1api.BackupUIDLabel:  string(backup.UID),
-	}))
-
-	dbrs, err := c.deleteBackupRequestLister.DeleteBackupRequests(ns).List(selector)
+	pending, err := hasPendingDeleteBackupRequest(c.deleteBackupRequestIndexer, backup)
 	if err != nil {
-		return errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")
+		return err
 	}
-
 	// if there's an existing unprocessed deletion request for this backup, don't create
 	// another one
-	for _, dbr := range dbrs {
-		switch dbr.Status.Phase {
-		case "", velerov1api.DeleteBackupRequestPhaseNew, velerov1api.DeleteBackupRequestPhaseInProgress:
-			log.Info("Backup already has a pending deletion request")
-			return nil
-		}
+	if pending {
+		log.Info("Backup already has a pending deletion request")
+		return nil
 	}
 
 	log.Info("Creating a new deletion request")
 	req := pkgbackup.NewDeleteBackupRequest(backup.Name, string(backup.UID))
+	req.Spec.Reason = fmt.Sprintf("backup expired at %s", backup.Status.Expiration.Time.UTC().Format(time.RFC3339))
 
 	if _, err = c.deleteBackupRequestClient.DeleteBackupRequests(ns).Create(req); err != nil {
 		return errors.Wrap(err, "error creating DeleteBackupRequest")
@@ -179,3 +199,111 @@ func (c *gcController) processQueueItem(key string) error {
 
 	return nil
 }
+
+// retainedBySchedule returns whether a backup is deleted by its schedule's
+// retention policy rather than when it expires.
+func (c *gcController) retainedBySchedule(backup *velerov1api.Backup) bool {
+	if !countsTowardRetention(backup) {
+		return false
+	}
+
+	scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName == "" {
+		return false
+	}
+
+	schedule, err := c.scheduleLister.Schedules(backup.Namespace).Get(scheduleName)
+	if err != nil {
+		return false
+	}
+
+	return hasRetentionPolicy(schedule)
+}
+
+// hasPendingDeleteBackupRequest returns whether a backup has a deletion
+// request that hasn't been processed yet.
+func hasPendingDeleteBackupRequest(indexer cache.Indexer, backup *velerov1api.Backup) (bool, error) {
+	dbrs, err := deleteBackupRequestsForBackup(indexer, backup.Namespace, backup.Name)
+	if err != nil {
+		return false, errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")
+	}
+
+	for _, dbr := range dbrs {
+		// requests for an earlier backup with the same name don't count
+		if dbr.Labels[velerov1api.BackupUIDLabel] != string(backup.UID) {
+			continue
+		}
+
+		switch dbr.Status.Phase {
+		case "", velerov1api.DeleteBackupRequestPhaseNew, velerov1api.DeleteBackupRequestPhasePendingApproval, velerov1api.DeleteBackupRequestPhaseInProgress:
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// logsExpired returns true if the backup's logs have expired and haven't
+// been deleted yet.
+func logsExpired(backup *velerov1api.Backup, now time.Time) bool {
+	if backup.Status.LogsDeleted || backup.Status.LogsExpiration == nil {
+		return false
+	}
+
+	return !backup.Status.LogsExpiration.After(now)
+}
+
+// deleteExpiredLogs deletes the log of a backup, and the logs and results of
+// the restores from it, from object storage, and records that they've been
+// deleted on the backup.
+func (c *gcController) deleteExpiredLogs(backup *velerov1api.Backup, log logrus.FieldLogger) error {
+	log = log.WithField("logsExpiration", backup.Status.LogsExpiration.Time)
+	log.Info("Backup logs have expired")
+
+	loc, err := c.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(backup.Spec.StorageLocation)
+	if apierrors.IsNotFound(err) {
+		log.Warnf("Backup logs cannot be garbage-collected because backup storage location %s does not exist", backup.Spec.StorageLocation)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	if loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		log.Infof("Backup logs cannot be garbage-collected because backup storage location %s is currently in read-only mode", loc.Name)
+		return nil
+	}
+
+	restores, err := restoresForBackup(c.restoreIndexer, backup.Namespace, backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error listing restores")
+	}
+
+	var restoreNames []string
+	for _, restore := range restores {
+		restoreNames = append(restoreNames, restore.Name)
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.newBackupStore(persistence.MemberClusterLocation(loc, backup.Spec.Cluster), pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Deleting backup logs")
+	if err := backupStore.DeleteBackupLogs(backup.Name, restoreNames); err != nil {
+		return errors.Wrap(err, "error deleting backup logs")
+	}
+
+	original := backup
+	updated := backup.DeepCopy()
+	updated.Status.LogsDeleted = true
+
+	err = kube.Patch(original, updated, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+		_, err := c.backupClient.Backups(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
+	return errors.Wrap(err, "error patching Backup")
+}
@@ -24,10 +24,12 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/watch"
 	core "k8s.io/client-go/testing"
@@ -36,6 +38,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -48,9 +54,13 @@ func TestGCControllerEnqueueAllBackups(t *testing.T) {
 		controller = NewGCController(
 			velerotest.NewLogger(),
 			sharedInformers.Velero().V1().Backups(),
+			client.VeleroV1(),
 			sharedInformers.Velero().V1().DeleteBackupRequests(),
 			client.VeleroV1(),
 			sharedInformers.Velero().V1().BackupStorageLocations(),
+			sharedInformers.Velero().V1().Restores(),
+			sharedInformers.Velero().V1().Schedules(),
+			nil, // new plugin manager func
 		).(*gcController)
 	)
 
@@ -75,7 +85,7 @@ func TestGCControllerEnqueueAllBackups(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	go controller.Run(ctx, 1)
+	runController(ctx, t, controller, 1)
 
 	var received []string
 
@@ -112,9 +122,13 @@ func TestGCControllerHasUpdateFunc(t *testing.T) {
 	controller := NewGCController(
 		velerotest.NewLogger(),
 		sharedInformers.Velero().V1().Backups(),
+		client.VeleroV1(),
 		sharedInformers.Velero().V1().DeleteBackupRequests(),
 		client.VeleroV1(),
 		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().Restores(),
+		sharedInformers.Velero().V1().Schedules(),
+		nil, // new plugin manager func
 	).(*gcController)
 
 	keys := make(chan string)
@@ -128,7 +142,7 @@ func TestGCControllerHasUpdateFunc(t *testing.T) {
 	defer cancel()
 
 	go sharedInformers.Start(ctx.Done())
-	go controller.Run(ctx, 1)
+	runController(ctx, t, controller, 1)
 
 	// wait for the AddFunc
 	select {
@@ -159,6 +173,7 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 		backup                         *api.Backup
 		deleteBackupRequests           []*api.DeleteBackupRequest
 		backupLocation                 *api.BackupStorageLocation
+		schedule                       *api.Schedule
 		expectDeletion                 bool
 		createDeleteBackupRequestError bool
 		expectError                    bool
@@ -204,6 +219,9 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 							api.BackupUIDLabel:  "",
 						},
 					},
+					Spec: api.DeleteBackupRequestSpec{
+						BackupName: "backup-1",
+					},
 					Status: api.DeleteBackupRequestStatus{
 						Phase: api.DeleteBackupRequestPhaseInProgress,
 					},
@@ -225,6 +243,9 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 							api.BackupUIDLabel:  "",
 						},
 					},
+					Spec: api.DeleteBackupRequestSpec{
+						BackupName: "backup-1",
+					},
 					Status: api.DeleteBackupRequestStatus{
 						Phase: api.DeleteBackupRequestPhaseProcessed,
 					},
@@ -232,6 +253,30 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			},
 			expectDeletion: true,
 		},
+		{
+			name: "expired completed backup of a schedule with a retention policy is not deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Phase(api.BackupPhaseCompleted).
+				ObjectMeta(builder.WithLabels(api.ScheduleNameLabel, "schedule-1")).Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(api.DefaultNamespace, "schedule-1").Retention(api.RetentionPolicy{KeepLast: 3}).Result(),
+			expectDeletion: false,
+		},
+		{
+			name: "expired failed backup of a schedule with a retention policy is deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Phase(api.BackupPhaseFailed).
+				ObjectMeta(builder.WithLabels(api.ScheduleNameLabel, "schedule-1")).Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(api.DefaultNamespace, "schedule-1").Retention(api.RetentionPolicy{KeepLast: 3}).Result(),
+			expectDeletion: true,
+		},
+		{
+			name: "expired completed backup of a schedule without a retention policy is deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Phase(api.BackupPhaseCompleted).
+				ObjectMeta(builder.WithLabels(api.ScheduleNameLabel, "schedule-1")).Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(api.DefaultNamespace, "schedule-1").Result(),
+			expectDeletion: true,
+		},
 		{
 			name:                           "create DeleteBackupRequest error returns an error",
 			backup:                         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
@@ -252,9 +297,13 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			controller := NewGCController(
 				velerotest.NewLogger(),
 				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
 				sharedInformers.Velero().V1().DeleteBackupRequests(),
 				client.VeleroV1(),
 				sharedInformers.Velero().V1().BackupStorageLocations(),
+				sharedInformers.Velero().V1().Restores(),
+				sharedInformers.Velero().V1().Schedules(),
+				nil, // new plugin manager func
 			).(*gcController)
 			controller.clock = fakeClock
 
@@ -272,6 +321,10 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().DeleteBackupRequests().Informer().GetStore().Add(dbr)
 			}
 
+			if test.schedule != nil {
+				sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(test.schedule)
+			}
+
 			if test.createDeleteBackupRequestError {
 				client.PrependReactor("create", "deletebackuprequests", func(action core.Action) (bool, runtime.Object, error) {
 					return true, nil, errors.New("foo")
@@ -289,6 +342,118 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				require.True(t, ok)
 
 				assert.Equal(t, "deletebackuprequests", createAction.GetResource().Resource)
+
+				req, ok := createAction.GetObject().(*api.DeleteBackupRequest)
+				require.True(t, ok)
+				assert.Empty(t, req.Spec.Requester)
+				assert.Contains(t, req.Spec.Reason, "backup expired at ")
+			} else {
+				assert.Len(t, client.Actions(), 0)
+			}
+		})
+	}
+}
+
+func TestGCControllerDeleteExpiredLogs(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "default").Result()
+
+	tests := []struct {
+		name                string
+		backup              *api.Backup
+		backupLocation      *api.BackupStorageLocation
+		deleteBackupLogsErr error
+		expectDeleteLogs    bool
+		expectError         bool
+	}{
+		{
+			name:           "backup without a logs expiration is skipped",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(time.Hour)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+		},
+		{
+			name:           "unexpired logs are not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(time.Hour)).LogsExpiration(fakeClock.Now().Add(time.Minute)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+		},
+		{
+			name:           "logs that have already been deleted are skipped",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(time.Hour)).LogsExpiration(fakeClock.Now().Add(-time.Minute)).LogsDeleted(true).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+		},
+		{
+			name:           "expired logs in read-only storage location are not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(time.Hour)).LogsExpiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("read-only").Result(),
+			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(api.BackupStorageLocationAccessModeReadOnly).Result(),
+		},
+		{
+			name:             "expired logs are deleted",
+			backup:           defaultBackup().Expiration(fakeClock.Now().Add(time.Hour)).LogsExpiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("default").Result(),
+			backupLocation:   defaultBackupLocation,
+			expectDeleteLogs: true,
+		},
+		{
+			name:                "error deleting logs returns an error",
+			backup:              defaultBackup().Expiration(fakeClock.Now().Add(time.Hour)).LogsExpiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("default").Result(),
+			backupLocation:      defaultBackupLocation,
+			deleteBackupLogsErr: errors.New("foo"),
+			expectDeleteLogs:    true,
+			expectError:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+			)
+
+			controller := NewGCController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().DeleteBackupRequests(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				sharedInformers.Velero().V1().Restores(),
+				sharedInformers.Velero().V1().Schedules(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			).(*gcController)
+			controller.clock = fakeClock
+			controller.newBackupStore = func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(test.backupLocation))
+			require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(builder.ForRestore(api.DefaultNamespace, "restore-1").Backup("backup-1").Result()))
+			require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(builder.ForRestore(api.DefaultNamespace, "restore-2").Backup("backup-2").Result()))
+
+			if test.expectDeleteLogs {
+				pluginManager.On("CleanupClients").Return()
+				backupStore.On("DeleteBackupLogs", "backup-1", []string{"restore-1"}).Return(test.deleteBackupLogsErr)
+			}
+
+			err := controller.processQueueItem(kube.NamespaceAndName(test.backup))
+			assert.Equal(t, test.expectError, err != nil)
+
+			pluginManager.AssertExpectations(t)
+			backupStore.AssertExpectations(t)
+
+			if test.expectDeleteLogs && !test.expectError {
+				expectedActions := []core.Action{
+					core.NewPatchAction(
+						api.SchemeGroupVersion.WithResource("backups"),
+						test.backup.Namespace,
+						test.backup.Name,
+						types.MergePatchType,
+						[]byte(`{"status":{"logsDeleted":true}}`),
+					),
+				}
+				assert.Equal(t, expectedActions, client.Actions())
 			} else {
 				assert.Len(t, client.Actions(), 0)
 			}
@@ -17,142 +17,230 @@ limitations under the License.
 package controller
 
 import (
-	"context"
-	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
+// requestBufferSize is the number of objects that a controller's periodic
+// resync can enqueue before it waits for the controller's work queue.
+const requestBufferSize = 100
+
 type genericController struct {
 	name             string
-	queue            workqueue.RateLimitingInterface
 	logger           logrus.FieldLogger
 	syncHandler      func(key string) error
 	resyncFunc       func()
 	resyncPeriod     time.Duration
+	rateLimiter      workqueue.RateLimiter
 	cacheSyncWaiters []cache.InformerSynced
+	watches          []informerWatch
+
+	// requests has the objects that are enqueued other than by the
+	// controller's watches, e.g. by its periodic resync.
+	requests chan event.GenericEvent
+
+	// synced is closed once the caches in cacheSyncWaiters have synced.
+	synced chan struct{}
+}
+
+// informerWatch is an informer whose objects are enqueued by a controller.
+type informerWatch struct {
+	informer   cache.SharedIndexInformer
+	handler    handler.EventHandler
+	predicates []predicate.Predicate
 }
 
 func newGenericController(name string, logger logrus.FieldLogger) *genericController {
 	c := &genericController{
-		name:   name,
-		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name),
-		logger: logger.WithField("controller", name),
+		name:        name,
+		logger:      logger.WithField("controller", name),
+		rateLimiter: workqueue.DefaultControllerRateLimiter(),
+		requests:    make(chan event.GenericEvent, requestBufferSize),
+		synced:      make(chan struct{}),
 	}
 
 	return c
 }
 
-// Run is a blocking function that runs the specified number of worker goroutines
-// to process items in the work queue. It will return when it receives on the
-// ctx.Done() channel.
-func (c *genericController) Run(ctx context.Context, numWorkers int) error {
-	if c.syncHandler == nil && c.resyncFunc == nil {
-		// programmer error
-		panic("at least one of syncHandler or resyncFunc is required")
+// Configure overrides the controller's default resync period and work queue
+// rate limiter. It must be called before the controller is set up with a
+// manager.
+func (c *genericController) Configure(opts Options) {
+	if opts.ResyncPeriod > 0 {
+		c.resyncPeriod = opts.ResyncPeriod
 	}
 
-	var wg sync.WaitGroup
+	if opts.RateLimiter != nil {
+		c.rateLimiter = opts.RateLimiter
+	}
+}
+
+// watch enqueues the objects from informer whose events pass the predicates.
+func (c *genericController) watch(informer cache.SharedIndexInformer, predicates ...predicate.Predicate) {
+	c.watchMapped(informer, nil, predicates...)
+}
 
-	defer func() {
-		c.logger.Info("Waiting for workers to finish their work")
+// watchMapped enqueues the requests that mapFunc returns for the objects from
+// informer whose events pass the predicates. If mapFunc is nil, the objects
+// themselves are enqueued.
+func (c *genericController) watchMapped(informer cache.SharedIndexInformer, mapFunc handler.ToRequestsFunc, predicates ...predicate.Predicate) {
+	var h handler.EventHandler = &handler.EnqueueRequestForObject{}
+	if mapFunc != nil {
+		h = &handler.EnqueueRequestsFromMapFunc{ToRequests: mapFunc}
+	}
 
-		c.queue.ShutDown()
+	c.watches = append(c.watches, informerWatch{
+		informer:   informer,
+		handler:    h,
+		predicates: predicates,
+	})
+}
 
-		// We have to wait here in the deferred function instead of at the bottom of the function body
-		// because we have to shut down the queue in order for the workers to shut down gracefully, and
-		// we want to shut down the queue via defer and not at the end of the body.
-		wg.Wait()
+// SetupWithManager registers the controller with a manager, which runs the
+// specified number of workers to process the items in its work queue, and
+// its periodic resync, once it's started.
+func (c *genericController) SetupWithManager(mgr manager.Manager, numWorkers int) error {
+	if c.syncHandler == nil {
+		// programmer error
+		panic("syncHandler is required")
+	}
 
-		c.logger.Info("All workers have finished")
+	if c.resyncFunc != nil && c.resyncPeriod == 0 {
+		// programmer error
+		panic("non-zero resyncPeriod is required")
+	}
 
-	}()
+	ctrlr, err := ctrl.New(c.name, mgr, ctrl.Options{
+		MaxConcurrentReconciles: numWorkers,
+		Reconciler:              c,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error creating controller %s", c.name)
+	}
 
-	c.logger.Info("Starting controller")
-	defer c.logger.Info("Shutting down controller")
+	for _, w := range c.watches {
+		if err := ctrlr.Watch(&source.Informer{Informer: w.informer}, w.handler, w.predicates...); err != nil {
+			return errors.Wrapf(err, "error watching informer for controller %s", c.name)
+		}
+	}
 
+	if err := ctrlr.Watch(&source.Channel{Source: c.requests}, &handler.EnqueueRequestForObject{}); err != nil {
+		return errors.Wrapf(err, "error watching requests for controller %s", c.name)
+	}
+
+	return mgr.Add(manager.RunnableFunc(c.run))
+}
+
+// run waits for the controller's caches to sync, then runs its periodic
+// resync until stop is closed.
+func (c *genericController) run(stop <-chan struct{}) error {
 	c.logger.Info("Waiting for caches to sync")
-	if !cache.WaitForCacheSync(ctx.Done(), c.cacheSyncWaiters...) {
+	if !cache.WaitForCacheSync(stop, c.cacheSyncWaiters...) {
 		return errors.New("timed out waiting for caches to sync")
 	}
 	c.logger.Info("Caches are synced")
 
-	if c.syncHandler != nil {
-		wg.Add(numWorkers)
-		for i := 0; i < numWorkers; i++ {
-			go func() {
-				wait.Until(c.runWorker, time.Second, ctx.Done())
-				wg.Done()
-			}()
-		}
-	}
+	close(c.synced)
 
 	if c.resyncFunc != nil {
-		if c.resyncPeriod == 0 {
-			// Programmer error
-			panic("non-zero resyncPeriod is required")
-		}
-
-		wg.Add(1)
-		go func() {
-			wait.Until(c.resyncFunc, c.resyncPeriod, ctx.Done())
-			wg.Done()
-		}()
+		wait.Until(c.resyncFunc, c.resyncPeriod, stop)
+	} else {
+		<-stop
 	}
 
-	<-ctx.Done()
+	c.logger.Info("Shutting down controller")
 
 	return nil
 }
 
-func (c *genericController) runWorker() {
-	// continually take items off the queue (waits if it's
-	// empty) until we get a shutdown signal from the queue
-	for c.processNextWorkItem() {
-	}
-}
+// Reconcile calls the controller's sync handler for a request from its work
+// queue, once its caches have synced. Requests whose sync fails are retried
+// after the delay that the controller's rate limiter returns for them.
+func (c *genericController) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	<-c.synced
 
-func (c *genericController) processNextWorkItem() bool {
-	key, quit := c.queue.Get()
-	if quit {
-		return false
+	key := req.Name
+	if req.Namespace != "" {
+		key = req.Namespace + "/" + req.Name
 	}
-	// always call done on this item, since if it fails we'll add
-	// it back with rate-limiting below
-	defer c.queue.Done(key)
 
-	err := c.syncHandler(key.(string))
-	if err == nil {
-		// If you had no error, tell the queue to stop tracking history for your key. This will reset
-		// things like failure counts for per-item rate limiting.
-		c.queue.Forget(key)
-		return true
+	if err := c.syncHandler(key); err != nil {
+		c.logger.WithError(err).WithField("key", key).Error("Error in syncHandler, re-adding item to queue")
+		return reconcile.Result{RequeueAfter: c.rateLimiter.When(req)}, nil
 	}
 
-	c.logger.WithError(err).WithField("key", key).Error("Error in syncHandler, re-adding item to queue")
-	// we had an error processing the item so add it back
-	// into the queue for re-processing with rate-limiting
-	c.queue.AddRateLimited(key)
+	// stop tracking the request's failures, so that the next one is
+	// retried after the rate limiter's base delay.
+	c.rateLimiter.Forget(req)
 
-	return true
+	return reconcile.Result{}, nil
 }
 
+// enqueue adds an object to the controller's work queue.
 func (c *genericController) enqueue(obj interface{}) {
-	key, err := cache.MetaNamespaceKeyFunc(obj)
+	accessor, err := meta.Accessor(obj)
 	if err != nil {
 		c.logger.WithError(errors.WithStack(err)).
 			Error("Error creating queue key, item not added to queue")
 		return
 	}
 
-	c.queue.Add(key)
+	c.requests <- event.GenericEvent{Meta: accessor, Object: obj.(runtime.Object)}
 }
 
-func (c *genericController) enqueueSecond(_, obj interface{}) {
-	c.enqueue(obj)
+// requestFor returns the work queue request for the object in namespace with
+// the given name.
+func requestFor(namespace, name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+}
+
+// onCreate returns a predicate that passes the create events of the objects
+// that accept returns true for. If accept is nil, the create events of all
+// objects pass.
+func onCreate(accept func(obj runtime.Object) bool) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return accept == nil || accept(e.Object) },
+		UpdateFunc:  func(event.UpdateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// onUpdate returns a predicate that passes the update events of the objects
+// that accept returns true for, based on the updated object.
+func onUpdate(accept func(obj runtime.Object) bool) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return accept(e.ObjectNew) },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// onCreateOrUpdate returns a predicate that passes the create events, and the
+// update events, of the objects that accept returns true for. Update events
+// are passed or dropped based on the updated object. If accept is nil, the
+// create and update events of all objects pass.
+func onCreateOrUpdate(accept func(obj runtime.Object) bool) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return accept == nil || accept(e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return accept == nil || accept(e.ObjectNew) },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// runController sets up a controller with a new manager, and runs the
+// manager until ctx is done.
+func runController(ctx context.Context, t *testing.T, c Interface, workers int) {
+	mgr, err := NewManager(&rest.Config{})
+	require.NoError(t, err)
+	require.NoError(t, c.SetupWithManager(mgr, workers))
+
+	go mgr.Start(ctx.Done())
+}
+
+func TestGenericControllerConfigure(t *testing.T) {
+	c := newGenericController("test", velerotest.NewLogger())
+	c.resyncPeriod = time.Minute
+
+	// zero options keep the defaults
+	rateLimiter := c.rateLimiter
+	c.Configure(Options{})
+	assert.Equal(t, time.Minute, c.resyncPeriod)
+	assert.True(t, rateLimiter == c.rateLimiter)
+
+	rateLimiter = workqueue.NewItemExponentialFailureRateLimiter(time.Second, time.Minute)
+	c.Configure(Options{ResyncPeriod: time.Hour, RateLimiter: rateLimiter})
+	assert.Equal(t, time.Hour, c.resyncPeriod)
+	assert.True(t, rateLimiter == c.rateLimiter)
+}
+
+func TestGenericControllerReconcile(t *testing.T) {
+	c := newGenericController("test", velerotest.NewLogger())
+	c.Configure(Options{RateLimiter: workqueue.NewItemExponentialFailureRateLimiter(time.Second, time.Minute)})
+	close(c.synced)
+
+	var keys []string
+	syncErr := errors.New("sync failed")
+	c.syncHandler = func(key string) error {
+		keys = append(keys, key)
+		return syncErr
+	}
+
+	req := requestFor("velero", "backup-1")
+
+	// failed syncs are retried with the rate limiter's backoff
+	res, err := c.Reconcile(req)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, res.RequeueAfter)
+
+	res, err = c.Reconcile(req)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, res.RequeueAfter)
+
+	// a successful sync resets the backoff
+	syncErr = nil
+	res, err = c.Reconcile(req)
+	require.NoError(t, err)
+	assert.Zero(t, res.RequeueAfter)
+	assert.Equal(t, 0, c.rateLimiter.NumRequeues(req))
+
+	assert.Equal(t, []string{"velero/backup-1", "velero/backup-1", "velero/backup-1"}, keys)
+
+	// cluster-scoped objects' keys are just their names
+	c.Reconcile(requestFor("", "node-1"))
+	assert.Equal(t, "node-1", keys[len(keys)-1])
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// backupNameIndex indexes restores, DeleteBackupRequests and
+	// DeleteBackupApprovals by the namespace and name of their backup.
+	backupNameIndex = "backupName"
+
+	// scheduleNameIndex indexes backups by the namespace and name of the
+	// schedule that created them.
+	scheduleNameIndex = "scheduleName"
+)
+
+func indexKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+func backupNameIndexFunc(obj interface{}) ([]string, error) {
+	switch obj := obj.(type) {
+	case *velerov1api.Restore:
+		return []string{indexKey(obj.Namespace, obj.Spec.BackupName)}, nil
+	case *velerov1api.DeleteBackupRequest:
+		return []string{indexKey(obj.Namespace, obj.Spec.BackupName)}, nil
+	case *velerov1api.DeleteBackupApproval:
+		return []string{indexKey(obj.Namespace, obj.Spec.BackupName)}, nil
+	default:
+		return nil, errors.Errorf("unexpected object of type %T in backup name index", obj)
+	}
+}
+
+func scheduleNameIndexFunc(obj interface{}) ([]string, error) {
+	backup, ok := obj.(*velerov1api.Backup)
+	if !ok {
+		return nil, errors.Errorf("unexpected object of type %T in schedule name index", obj)
+	}
+
+	scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName == "" {
+		return nil, nil
+	}
+
+	return []string{indexKey(backup.Namespace, scheduleName)}, nil
+}
+
+// addIndexers adds indexers to a shared informer, skipping the ones that
+// another controller has already added to it. Indexers can't be added once
+// an informer has objects in it, so controllers must be created before their
+// informers are started.
+func addIndexers(informer cache.SharedIndexInformer, indexers cache.Indexers) {
+	existing := informer.GetIndexer().GetIndexers()
+
+	missing := cache.Indexers{}
+	for name, indexFunc := range indexers {
+		if _, ok := existing[name]; !ok {
+			missing[name] = indexFunc
+		}
+	}
+
+	if len(missing) == 0 {
+		return
+	}
+
+	if err := informer.AddIndexers(missing); err != nil {
+		// programmer error
+		panic(fmt.Sprintf("error adding indexers: %v", err))
+	}
+}
+
+// restoresForBackup returns the restores from a backup.
+func restoresForBackup(indexer cache.Indexer, namespace, backupName string) ([]*velerov1api.Restore, error) {
+	objs, err := indexer.ByIndex(backupNameIndex, indexKey(namespace, backupName))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	restores := make([]*velerov1api.Restore, 0, len(objs))
+	for _, obj := range objs {
+		restores = append(restores, obj.(*velerov1api.Restore))
+	}
+
+	return restores, nil
+}
+
+// deleteBackupRequestsForBackup returns the DeleteBackupRequests for a
+// backup.
+func deleteBackupRequestsForBackup(indexer cache.Indexer, namespace, backupName string) ([]*velerov1api.DeleteBackupRequest, error) {
+	objs, err := indexer.ByIndex(backupNameIndex, indexKey(namespace, backupName))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	requests := make([]*velerov1api.DeleteBackupRequest, 0, len(objs))
+	for _, obj := range objs {
+		requests = append(requests, obj.(*velerov1api.DeleteBackupRequest))
+	}
+
+	return requests, nil
+}
+
+// approvalsForBackup returns the DeleteBackupApprovals for a backup.
+func approvalsForBackup(indexer cache.Indexer, namespace, backupName string) ([]*velerov1api.DeleteBackupApproval, error) {
+	objs, err := indexer.ByIndex(backupNameIndex, indexKey(namespace, backupName))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	approvals := make([]*velerov1api.DeleteBackupApproval, 0, len(objs))
+	for _, obj := range objs {
+		approvals = append(approvals, obj.(*velerov1api.DeleteBackupApproval))
+	}
+
+	return approvals, nil
+}
+
+// backupsForSchedule returns the backups that a schedule created.
+func backupsForSchedule(indexer cache.Indexer, namespace, scheduleName string) ([]*velerov1api.Backup, error) {
+	objs, err := indexer.ByIndex(scheduleNameIndex, indexKey(namespace, scheduleName))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backups := make([]*velerov1api.Backup, 0, len(objs))
+	for _, obj := range objs {
+		backups = append(backups, obj.(*velerov1api.Backup))
+	}
+
+	return backups, nil
+}
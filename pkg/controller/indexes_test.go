@@ -0,0 +1,81 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+)
+
+func TestAddIndexers(t *testing.T) {
+	informer := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Velero().V1().Restores().Informer()
+
+	// controllers that share an informer can each add the same index
+	addIndexers(informer, cache.Indexers{backupNameIndex: backupNameIndexFunc})
+	addIndexers(informer, cache.Indexers{backupNameIndex: backupNameIndexFunc})
+
+	assert.Contains(t, informer.GetIndexer().GetIndexers(), backupNameIndex)
+}
+
+func TestRestoresForBackup(t *testing.T) {
+	informer := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Velero().V1().Restores().Informer()
+	addIndexers(informer, cache.Indexers{backupNameIndex: backupNameIndexFunc})
+
+	for _, restore := range []*velerov1api.Restore{
+		builder.ForRestore("velero", "restore-1").Backup("backup-1").Result(),
+		builder.ForRestore("velero", "restore-2").Backup("backup-2").Result(),
+		builder.ForRestore("velero", "restore-3").Backup("backup-1").Result(),
+		builder.ForRestore("other", "restore-4").Backup("backup-1").Result(),
+	} {
+		require.NoError(t, informer.GetStore().Add(restore))
+	}
+
+	restores, err := restoresForBackup(informer.GetIndexer(), "velero", "backup-1")
+	require.NoError(t, err)
+
+	var names []string
+	for _, restore := range restores {
+		names = append(names, restore.Name)
+	}
+	assert.ElementsMatch(t, []string{"restore-1", "restore-3"}, names)
+}
+
+func TestBackupsForSchedule(t *testing.T) {
+	informer := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Velero().V1().Backups().Informer()
+	addIndexers(informer, cache.Indexers{scheduleNameIndex: scheduleNameIndexFunc})
+
+	for _, backup := range []*velerov1api.Backup{
+		builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).Result(),
+		builder.ForBackup("velero", "backup-2").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-2")).Result(),
+		builder.ForBackup("velero", "backup-3").Result(),
+	} {
+		require.NoError(t, informer.GetStore().Add(backup))
+	}
+
+	backups, err := backupsForSchedule(informer.GetIndexer(), "velero", "schedule-1")
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "backup-1", backups[0].Name)
+}
@@ -16,10 +16,35 @@ limitations under the License.
 
 package controller
 
-import "context"
+import (
+	"time"
 
-// Interface represents a runnable component.
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Interface represents a controller that's run by a controller-runtime
+// manager.
 type Interface interface {
-	// Run runs the component.
-	Run(ctx context.Context, workers int) error
+	// SetupWithManager registers the controller with mgr, which runs it
+	// with the specified number of workers once it's started.
+	SetupWithManager(mgr manager.Manager, workers int) error
+}
+
+// Options configures how a controller processes its work.
+type Options struct {
+	// ResyncPeriod is how often the controller's periodic resync runs.
+	// If zero, the controller's default is used.
+	ResyncPeriod time.Duration
+
+	// RateLimiter is used to rate-limit retries of items in the controller's
+	// work queue. If nil, the controller's default is used.
+	RateLimiter workqueue.RateLimiter
+}
+
+// Configurable is a controller whose resync period and rate limiting can be
+// configured. Configure must be called before the controller is set up
+// with a manager.
+type Configurable interface {
+	Configure(opts Options)
 }
@@ -0,0 +1,42 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewManager returns a controller-runtime manager to run controllers in.
+// The controllers watch Velero's shared informers rather than the manager's
+// cache, so the manager doesn't need to discover the API server's resources.
+// Its metrics aren't served, since Velero serves its own.
+func NewManager(config *rest.Config) (manager.Manager, error) {
+	mgr, err := manager.New(config, manager.Options{
+		MapperProvider: func(*rest.Config) (meta.RESTMapper, error) {
+			return meta.NewDefaultRESTMapper(nil), nil
+		},
+		MetricsBindAddress: "0",
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating controller manager")
+	}
+
+	return mgr, nil
+}
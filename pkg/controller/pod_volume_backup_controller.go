@@ -17,17 +17,16 @@ limitations under the License.
 package controller
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	corev1informers "k8s.io/client-go/informers/core/v1"
@@ -38,9 +37,11 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
 type podVolumeBackupController struct {
@@ -54,6 +55,8 @@ type podVolumeBackupController struct {
 	pvLister              corev1listers.PersistentVolumeLister
 	backupLocationLister  listers.BackupStorageLocationLister
 	nodeName              string
+	commandOptions        restic.CommandOptions
+	metrics               *metrics.ServerMetrics
 
 	processBackupFunc func(*velerov1api.PodVolumeBackup) error
 	fileSystem        filesystem.Interface
@@ -71,6 +74,8 @@ func NewPodVolumeBackupController(
 	pvInformer corev1informers.PersistentVolumeInformer,
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	nodeName string,
+	commandOptions restic.CommandOptions,
+	metrics *metrics.ServerMetrics,
 ) Interface {
 	c := &podVolumeBackupController{
 		genericController:     newGenericController("pod-volume-backup", logger),
@@ -82,6 +87,8 @@ func NewPodVolumeBackupController(
 		pvLister:              pvInformer.Lister(),
 		backupLocationLister:  backupLocationInformer.Lister(),
 		nodeName:              nodeName,
+		commandOptions:        commandOptions,
+		metrics:               metrics,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -98,33 +105,29 @@ func NewPodVolumeBackupController(
 	)
 	c.processBackupFunc = c.processBackup
 
-	podVolumeBackupInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc:    c.pvbHandler,
-			UpdateFunc: func(_, obj interface{}) { c.pvbHandler(obj) },
-		},
-	)
+	c.watch(podVolumeBackupInformer.Informer(), onCreateOrUpdate(c.pvbFilter))
 
 	return c
 }
 
-func (c *podVolumeBackupController) pvbHandler(obj interface{}) {
+// pvbFilter returns whether a pod volume backup is new and for this node.
+func (c *podVolumeBackupController) pvbFilter(obj runtime.Object) bool {
 	req := obj.(*velerov1api.PodVolumeBackup)
 
 	// only enqueue items for this node
 	if req.Spec.Node != c.nodeName {
-		return
+		return false
 	}
 
 	log := loggerForPodVolumeBackup(c.logger, req)
 
 	if req.Status.Phase != "" && req.Status.Phase != velerov1api.PodVolumeBackupPhaseNew {
 		log.Debug("Backup is not new, not enqueuing")
-		return
+		return false
 	}
 
 	log.Debug("Enqueueing")
-	c.enqueue(obj)
+	return true
 }
 
 func (c *podVolumeBackupController) processQueueItem(key string) error {
@@ -168,7 +171,7 @@ func loggerForPodVolumeBackup(baseLogger logrus.FieldLogger, req *velerov1api.Po
 		log = log.WithField("backup", fmt.Sprintf("%s/%s", req.Namespace, req.OwnerReferences[0].Name))
 	}
 
-	return log
+	return logging.WithCorrelationID(log, req)
 }
 
 func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBackup) error {
@@ -225,6 +228,7 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		path,
 		req.Spec.Tags,
 	)
+	c.commandOptions.Apply(resticCmd)
 
 	// if this is azure, set resticCmd.Env appropriately
 	var env []string
@@ -263,6 +267,14 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	}
 	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
 
+	var summary restic.BackupSummary
+	if !emptySnapshot {
+		if summary, err = restic.ParseBackupSummary(stdout); err != nil {
+			// the summary is only used for metrics, so don't fail the backup.
+			log.WithError(err).Warn("Error parsing restic backup summary")
+		}
+	}
+
 	var snapshotID string
 	if !emptySnapshot {
 		snapshotID, err = restic.GetSnapshotID(req.Spec.RepoIdentifier, file, req.Spec.Tags, env)
@@ -287,6 +299,9 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		return err
 	}
 
+	c.metrics.RegisterPodVolumeBackupSuccess(c.nodeName, req.Spec.Pod.Namespace, req.Annotations[restic.PVCNameAnnotation],
+		req.Status.CompletionTimestamp.Sub(req.Status.StartTimestamp.Time).Seconds(), summary.BytesRead, summary.BytesAdded)
+
 	log.Info("Backup completed")
 
 	return nil
@@ -332,32 +347,19 @@ func getParentSnapshot(log logrus.FieldLogger, pvcUID string, podVolumeBackupLis
 }
 
 func (c *podVolumeBackupController) patchPodVolumeBackup(req *velerov1api.PodVolumeBackup, mutate func(*velerov1api.PodVolumeBackup)) (*velerov1api.PodVolumeBackup, error) {
-	// Record original json
-	oldData, err := json.Marshal(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling original PodVolumeBackup")
-	}
-
-	// Mutate
+	original := req.DeepCopy()
 	mutate(req)
 
-	// Record new json
-	newData, err := json.Marshal(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling updated PodVolumeBackup")
-	}
-
-	patchBytes, err := jsonpatch.CreateMergePatch(oldData, newData)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating json merge patch for PodVolumeBackup")
-	}
-
-	req, err = c.podVolumeBackupClient.PodVolumeBackups(req.Namespace).Patch(req.Name, types.MergePatchType, patchBytes)
+	var res *velerov1api.PodVolumeBackup
+	err := kube.Patch(original, req, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) (err error) {
+		res, err = c.podVolumeBackupClient.PodVolumeBackups(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error patching PodVolumeBackup")
 	}
 
-	return req, nil
+	return res, nil
 }
 
 // updateBackupProgressFunc returns a func that takes progress info and patches
@@ -381,6 +383,9 @@ func (c *podVolumeBackupController) fail(req *velerov1api.PodVolumeBackup, msg s
 		log.WithError(err).Error("Error setting PodVolumeBackup phase to Failed")
 		return err
 	}
+
+	c.metrics.RegisterPodVolumeBackupFailure(c.nodeName, req.Spec.Pod.Namespace, req.Annotations[restic.PVCNameAnnotation])
+
 	return nil
 }
 
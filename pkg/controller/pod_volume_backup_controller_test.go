@@ -20,13 +20,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
-func TestPVBHandler(t *testing.T) {
+func TestPVBFilter(t *testing.T) {
 	controllerNode := "foo"
 
 	tests := []struct {
@@ -158,14 +157,7 @@ func TestPVBHandler(t *testing.T) {
 				nodeName:          controllerNode,
 			}
 
-			c.pvbHandler(test.obj)
-
-			if !test.shouldEnqueue {
-				assert.Equal(t, 0, c.queue.Len())
-				return
-			}
-
-			require.Equal(t, 1, c.queue.Len())
+			assert.Equal(t, test.shouldEnqueue, c.pvbFilter(test.obj))
 		})
 	}
 }
@@ -17,24 +17,25 @@ limitations under the License.
 package controller
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	corev1informers "k8s.io/client-go/informers/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
@@ -44,6 +45,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
 type podVolumeRestoreController struct {
@@ -57,6 +59,7 @@ type podVolumeRestoreController struct {
 	pvLister               corev1listers.PersistentVolumeLister
 	backupLocationLister   listers.BackupStorageLocationLister
 	nodeName               string
+	commandOptions         restic.CommandOptions
 
 	processRestoreFunc func(*velerov1api.PodVolumeRestore) error
 	fileSystem         filesystem.Interface
@@ -74,6 +77,7 @@ func NewPodVolumeRestoreController(
 	pvInformer corev1informers.PersistentVolumeInformer,
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	nodeName string,
+	commandOptions restic.CommandOptions,
 ) Interface {
 	c := &podVolumeRestoreController{
 		genericController:      newGenericController("pod-volume-restore", logger),
@@ -85,6 +89,7 @@ func NewPodVolumeRestoreController(
 		pvLister:               pvInformer.Lister(),
 		backupLocationLister:   backupLocationInformer.Lister(),
 		nodeName:               nodeName,
+		commandOptions:         commandOptions,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -101,73 +106,62 @@ func NewPodVolumeRestoreController(
 	)
 	c.processRestoreFunc = c.processRestore
 
-	podVolumeRestoreInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: c.pvrHandler,
-			UpdateFunc: func(_, obj interface{}) {
-				c.pvrHandler(obj)
-			},
-		},
-	)
-
-	podInformer.AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: c.podHandler,
-			UpdateFunc: func(_, obj interface{}) {
-				c.podHandler(obj)
-			},
-		},
-	)
+	c.watch(podVolumeRestoreInformer.Informer(), onCreateOrUpdate(c.pvrFilter))
+	c.watchMapped(podInformer, c.podRestores, onCreateOrUpdate(nil))
 
 	return c
 }
 
-func (c *podVolumeRestoreController) pvrHandler(obj interface{}) {
+// pvrFilter returns whether a pod volume restore is new, and its pod is on
+// this node and running the restic-wait init container.
+func (c *podVolumeRestoreController) pvrFilter(obj runtime.Object) bool {
 	pvr := obj.(*velerov1api.PodVolumeRestore)
 	log := loggerForPodVolumeRestore(c.logger, pvr)
 
 	if !isPVRNew(pvr) {
 		log.Debugf("Restore is not new, not enqueuing")
-		return
+		return false
 	}
 
 	pod, err := c.podLister.Pods(pvr.Spec.Pod.Namespace).Get(pvr.Spec.Pod.Name)
 	if apierrors.IsNotFound(err) {
 		log.WithError(err).Debugf("Restore's pod %s/%s not found, not enqueueing.", pvr.Spec.Pod.Namespace, pvr.Spec.Pod.Name)
-		return
+		return false
 	}
 	if err != nil {
 		log.WithError(err).Errorf("Unable to get restore's pod %s/%s, not enqueueing.", pvr.Spec.Pod.Namespace, pvr.Spec.Pod.Name)
-		return
+		return false
 	}
 
 	if !isPodOnNode(pod, c.nodeName) {
 		log.Debugf("Restore's pod is not on this node, not enqueuing")
-		return
+		return false
 	}
 
 	if !isResticInitContainerRunning(pod) {
 		log.Debug("Restore's pod is not running restic-wait init container, not enqueuing")
-		return
+		return false
 	}
 
 	log.Debug("Enqueueing")
-	c.enqueue(obj)
+	return true
 }
 
-func (c *podVolumeRestoreController) podHandler(obj interface{}) {
-	pod := obj.(*corev1api.Pod)
+// podRestores returns the requests for the new pod volume restores of a pod
+// that's on this node and running the restic-wait init container.
+func (c *podVolumeRestoreController) podRestores(obj handler.MapObject) []reconcile.Request {
+	pod := obj.Object.(*corev1api.Pod)
 	log := c.logger.WithField("key", kube.NamespaceAndName(pod))
 
 	// the pod should always be for this node since the podInformer is filtered
 	// based on node, so this is just a failsafe.
 	if !isPodOnNode(pod, c.nodeName) {
-		return
+		return nil
 	}
 
 	if !isResticInitContainerRunning(pod) {
 		log.Debug("Pod is not running restic-wait init container, not enqueuing restores for pod")
-		return
+		return nil
 	}
 
 	selector := labels.Set(map[string]string{
@@ -177,13 +171,10 @@ func (c *podVolumeRestoreController) podHandler(obj interface{}) {
 	pvrs, err := c.podVolumeRestoreLister.List(selector)
 	if err != nil {
 		log.WithError(err).Error("Unable to list pod volume restores")
-		return
-	}
-
-	if len(pvrs) == 0 {
-		return
+		return nil
 	}
 
+	var requests []reconcile.Request
 	for _, pvr := range pvrs {
 		log := loggerForPodVolumeRestore(log, pvr)
 		if !isPVRNew(pvr) {
@@ -191,8 +182,10 @@ func (c *podVolumeRestoreController) podHandler(obj interface{}) {
 			continue
 		}
 		log.Debug("Enqueuing")
-		c.enqueue(pvr)
+		requests = append(requests, requestFor(pvr.Namespace, pvr.Name))
 	}
+
+	return requests
 }
 
 func isPVRNew(pvr *velerov1api.PodVolumeRestore) bool {
@@ -252,7 +245,7 @@ func loggerForPodVolumeRestore(baseLogger logrus.FieldLogger, req *velerov1api.P
 		log = log.WithField("restore", fmt.Sprintf("%s/%s", req.Namespace, req.OwnerReferences[0].Name))
 	}
 
-	return log
+	return logging.WithCorrelationID(log, req)
 }
 
 func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRestore) error {
@@ -326,6 +319,7 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 		req.Spec.SnapshotID,
 		volumePath,
 	)
+	c.commandOptions.Apply(resticCmd)
 
 	// if this is azure, set resticCmd.Env appropriately
 	if strings.HasPrefix(req.Spec.RepoIdentifier, "azure") {
@@ -376,32 +370,19 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 }
 
 func (c *podVolumeRestoreController) patchPodVolumeRestore(req *velerov1api.PodVolumeRestore, mutate func(*velerov1api.PodVolumeRestore)) (*velerov1api.PodVolumeRestore, error) {
-	// Record original json
-	oldData, err := json.Marshal(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling original PodVolumeRestore")
-	}
-
-	// Mutate
+	original := req.DeepCopy()
 	mutate(req)
 
-	// Record new json
-	newData, err := json.Marshal(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling updated PodVolumeRestore")
-	}
-
-	patchBytes, err := jsonpatch.CreateMergePatch(oldData, newData)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating json merge patch for PodVolumeRestore")
-	}
-
-	req, err = c.podVolumeRestoreClient.PodVolumeRestores(req.Namespace).Patch(req.Name, types.MergePatchType, patchBytes)
+	var res *velerov1api.PodVolumeRestore
+	err := kube.Patch(original, req, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) (err error) {
+		res, err = c.podVolumeRestoreClient.PodVolumeRestores(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error patching PodVolumeRestore")
 	}
 
-	return req, nil
+	return res, nil
 }
 
 func (c *podVolumeRestoreController) failRestore(req *velerov1api.PodVolumeRestore, msg string, log logrus.FieldLogger) error {
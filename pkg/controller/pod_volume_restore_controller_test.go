@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerofake "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
@@ -37,7 +38,7 @@ import (
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
-func TestPVRHandler(t *testing.T) {
+func TestPVRFilter(t *testing.T) {
 	controllerNode := "foo"
 
 	tests := []struct {
@@ -221,19 +222,12 @@ func TestPVRHandler(t *testing.T) {
 				require.NoError(t, podInformer.GetStore().Add(test.pod))
 			}
 
-			c.pvrHandler(test.obj)
-
-			if !test.shouldEnqueue {
-				assert.Equal(t, 0, c.queue.Len())
-				return
-			}
-
-			require.Equal(t, 1, c.queue.Len())
+			assert.Equal(t, test.shouldEnqueue, c.pvrFilter(test.obj))
 		})
 	}
 }
 
-func TestPodHandler(t *testing.T) {
+func TestPodRestores(t *testing.T) {
 	controllerNode := "foo"
 
 	tests := []struct {
@@ -406,15 +400,11 @@ func TestPodHandler(t *testing.T) {
 				}
 			}
 
-			c.podHandler(test.pod)
-
-			require.Equal(t, len(test.expectedEnqueues), c.queue.Len())
-
-			itemCount := c.queue.Len()
+			requests := c.podRestores(handler.MapObject{Meta: test.pod, Object: test.pod})
 
-			for i := 0; i < itemCount; i++ {
-				item, _ := c.queue.Get()
-				assert.True(t, test.expectedEnqueues.Has(item.(string)))
+			require.Len(t, requests, len(test.expectedEnqueues))
+			for _, req := range requests {
+				assert.True(t, test.expectedEnqueues.Has(req.String()))
 			}
 		})
 	}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+)
+
+// protectBackupNamespaces adds the protected namespaces to a backup's
+// excluded namespaces, so that they're never backed up, and returns a
+// validation error for each protected namespace that the backup includes
+// by name.
+func protectBackupNamespaces(spec *velerov1api.BackupSpec, protected []string) []string {
+	var errs []string
+
+	included := sets.NewString(spec.IncludedNamespaces...)
+	excluded := sets.NewString(spec.ExcludedNamespaces...)
+	for _, namespace := range protected {
+		switch {
+		case included.Has(namespace):
+			errs = append(errs, fmt.Sprintf("namespace %s is protected and can't be backed up", namespace))
+		case !excluded.Has(namespace):
+			spec.ExcludedNamespaces = append(spec.ExcludedNamespaces, namespace)
+		}
+	}
+
+	return errs
+}
+
+// protectRestoreNamespaces adds the namespaces that a restore would restore
+// into protected namespaces to its excluded namespaces, so that nothing is
+// ever restored into them, and returns a validation error for each protected
+// namespace that the restore includes by name or maps a namespace to,
+// including by a wildcard or regular expression mapping whose target can
+// resolve to it.
+func protectRestoreNamespaces(spec *velerov1api.RestoreSpec, protected []string) []string {
+	var errs []string
+
+	protectedSet := sets.NewString(protected...)
+	for source, target := range spec.NamespaceMapping {
+		if protectedSet.Has(target) {
+			errs = append(errs, fmt.Sprintf("namespace %s is protected and can't be restored into, but namespace %s is mapped to it", target, source))
+		}
+	}
+	for _, namespace := range protected {
+		for _, pattern := range pkgrestore.PatternsMappingTo(spec.NamespaceMapping, namespace) {
+			errs = append(errs, fmt.Sprintf("namespace %s is protected and can't be restored into, but namespace mapping %s can map namespaces to it", namespace, pattern))
+		}
+	}
+
+	included := sets.NewString(spec.IncludedNamespaces...)
+	excluded := sets.NewString(spec.ExcludedNamespaces...)
+	for _, namespace := range protected {
+		// a protected namespace that's mapped to another namespace is
+		// restored into that namespace instead.
+		if _, mapped := spec.NamespaceMapping[namespace]; mapped {
+			continue
+		}
+
+		switch {
+		case included.Has(namespace):
+			errs = append(errs, fmt.Sprintf("namespace %s is protected and can't be restored into", namespace))
+		case !excluded.Has(namespace):
+			spec.ExcludedNamespaces = append(spec.ExcludedNamespaces, namespace)
+		}
+	}
+
+	// map iteration order is random
+	sort.Strings(errs)
+
+	return errs
+}
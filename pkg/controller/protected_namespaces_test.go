@@ -0,0 +1,106 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestProtectBackupNamespaces(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         velerov1api.BackupSpec
+		wantExcluded []string
+		wantErrs     []string
+	}{
+		{
+			name:         "protected namespaces are excluded",
+			spec:         velerov1api.BackupSpec{ExcludedNamespaces: []string{"velero"}},
+			wantExcluded: []string{"velero", "kube-system"},
+		},
+		{
+			name:         "protected namespaces that are included by name are validation errors",
+			spec:         velerov1api.BackupSpec{IncludedNamespaces: []string{"app", "kube-system"}},
+			wantExcluded: []string{"velero"},
+			wantErrs:     []string{"namespace kube-system is protected and can't be backed up"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := protectBackupNamespaces(&tc.spec, []string{"kube-system", "velero"})
+
+			assert.Equal(t, tc.wantErrs, errs)
+			assert.Equal(t, tc.wantExcluded, tc.spec.ExcludedNamespaces)
+		})
+	}
+}
+
+func TestProtectRestoreNamespaces(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         velerov1api.RestoreSpec
+		wantExcluded []string
+		wantErrs     []string
+	}{
+		{
+			name:         "protected namespaces are excluded",
+			spec:         velerov1api.RestoreSpec{},
+			wantExcluded: []string{"kube-system", "velero"},
+		},
+		{
+			name:         "protected namespaces that are mapped to other namespaces are restored",
+			spec:         velerov1api.RestoreSpec{IncludedNamespaces: []string{"kube-system"}, NamespaceMapping: map[string]string{"kube-system": "kube-system-copy"}},
+			wantExcluded: []string{"velero"},
+		},
+		{
+			name:         "protected namespaces that are included by name are validation errors",
+			spec:         velerov1api.RestoreSpec{IncludedNamespaces: []string{"velero"}},
+			wantExcluded: []string{"kube-system"},
+			wantErrs:     []string{"namespace velero is protected and can't be restored into"},
+		},
+		{
+			name:         "namespaces that are mapped to protected namespaces are validation errors",
+			spec:         velerov1api.RestoreSpec{NamespaceMapping: map[string]string{"app": "kube-system"}},
+			wantExcluded: []string{"kube-system", "velero"},
+			wantErrs:     []string{"namespace kube-system is protected and can't be restored into, but namespace app is mapped to it"},
+		},
+		{
+			name:         "wildcard and regex mappings that can map namespaces to protected namespaces are validation errors",
+			spec:         velerov1api.RestoreSpec{NamespaceMapping: map[string]string{"x-*": "kube-*", "/^(.*)-copy$/": "${1}", "app-*": "app-*-copy"}},
+			wantExcluded: []string{"kube-system", "velero"},
+			wantErrs: []string{
+				"namespace kube-system is protected and can't be restored into, but namespace mapping /^(.*)-copy$/ can map namespaces to it",
+				"namespace kube-system is protected and can't be restored into, but namespace mapping x-* can map namespaces to it",
+				"namespace velero is protected and can't be restored into, but namespace mapping /^(.*)-copy$/ can map namespaces to it",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := protectRestoreNamespaces(&tc.spec, []string{"kube-system", "velero"})
+
+			assert.Equal(t, tc.wantErrs, errs)
+			assert.Equal(t, tc.wantExcluded, tc.spec.ExcludedNamespaces)
+		})
+	}
+}
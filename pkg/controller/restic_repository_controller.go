@@ -17,13 +17,14 @@ limitations under the License.
 package controller
 
 import (
-	"encoding/json"
+	"hash/fnv"
+	"math"
 	"strings"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -36,6 +37,7 @@ import (
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 type resticRepositoryController struct {
@@ -78,11 +80,7 @@ func NewResticRepositoryController(
 	c.syncHandler = c.processQueueItem
 	c.cacheSyncWaiters = append(c.cacheSyncWaiters, resticRepositoryInformer.Informer().HasSynced, backupLocationInformer.Informer().HasSynced)
 
-	resticRepositoryInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: c.enqueue,
-		},
-	)
+	c.watch(resticRepositoryInformer.Informer(), onCreate(nil))
 
 	c.resyncPeriod = 5 * time.Minute
 	c.resyncFunc = c.enqueueAllRepositories
@@ -229,21 +227,49 @@ func (c *resticRepositoryController) runMaintenanceIfDue(req *v1.ResticRepositor
 	// should not cause the repo to move to `NotReady`.
 	log.Debug("Pruning repo")
 	if err := c.repositoryManager.PruneRepo(req); err != nil {
+		// restic prune needs an exclusive lock on the repository, so it
+		// can't run while a pod volume backup or restore is using it. The
+		// last maintenance time isn't updated, so that maintenance is
+		// retried the next time the repository is checked, after stale
+		// locks have been removed.
+		if restic.IsRepoLockedError(err) {
+			log.WithError(err).Info("Restic repository is locked, postponing maintenance")
+			return c.patchResticRepository(req, func(r *v1.ResticRepository) {
+				r.Status.Message = "maintenance postponed because the repository is locked by another restic process"
+			})
+		}
+
 		log.WithError(err).Warn("error pruning repository")
-		if patchErr := c.patchResticRepository(req, func(r *v1.ResticRepository) {
+		return c.patchResticRepository(req, func(r *v1.ResticRepository) {
 			r.Status.Message = err.Error()
-		}); patchErr != nil {
-			return patchErr
-		}
+			r.Status.LastMaintenanceTime = metav1.Time{Time: now}
+		})
 	}
 
 	return c.patchResticRepository(req, func(req *v1.ResticRepository) {
+		req.Status.Message = ""
 		req.Status.LastMaintenanceTime = metav1.Time{Time: now}
 	})
 }
 
+// maxMaintenanceJitter is the largest fraction of a repository's maintenance
+// frequency that its maintenance is delayed by, so that repositories that were
+// created together aren't all pruned at once.
+const maxMaintenanceJitter = 0.1
+
 func dueForMaintenance(req *v1.ResticRepository, now time.Time) bool {
-	return req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
+	return req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration + maintenanceJitter(req)).Before(now)
+}
+
+// maintenanceJitter returns how long a repository's maintenance is delayed
+// by. It's derived from the repository's name, so that it's the same each
+// time the repository is checked.
+func maintenanceJitter(req *v1.ResticRepository) time.Duration {
+	hash := fnv.New32a()
+	hash.Write([]byte(req.Namespace + "/" + req.Name))
+
+	maxJitter := float64(req.Spec.MaintenanceFrequency.Duration) * maxMaintenanceJitter
+	return time.Duration(maxJitter * float64(hash.Sum32()) / math.MaxUint32)
 }
 
 func (c *resticRepositoryController) checkNotReadyRepo(req *v1.ResticRepository, log logrus.FieldLogger) error {
@@ -281,36 +307,21 @@ func repoReady() func(*v1.ResticRepository) {
 // through the Kube API. After executing this function, req will be updated with both
 // the mutation and the results of the Patch() API call.
 func (c *resticRepositoryController) patchResticRepository(req *v1.ResticRepository, mutate func(*v1.ResticRepository)) error {
-	// Record original json
-	oldData, err := json.Marshal(req)
-	if err != nil {
-		return errors.Wrap(err, "error marshalling original ResticRepository")
-	}
-
+	original := req.DeepCopy()
 	mutate(req)
 
-	// Record new json
-	newData, err := json.Marshal(req)
-	if err != nil {
-		return errors.Wrap(err, "error marshalling updated ResticRepository")
-	}
-
-	patchBytes, err := jsonpatch.CreateMergePatch(oldData, newData)
-	if err != nil {
-		return errors.Wrap(err, "error creating json merge patch for ResticRepository")
-	}
-
-	// empty patch: don't apply
-	if string(patchBytes) == "{}" {
+	// no changes: don't apply
+	if equality.Semantic.DeepEqual(original, req) {
 		return nil
 	}
 
-	// patch, and if successful, update req
-	var patched *v1.ResticRepository
-	if patched, err = c.resticRepositoryClient.ResticRepositories(req.Namespace).Patch(req.Name, types.MergePatchType, patchBytes); err != nil {
+	err := kube.Patch(original, req, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+		_, err := c.resticRepositoryClient.ResticRepositories(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
+	if err != nil {
 		return errors.Wrap(err, "error patching ResticRepository")
 	}
-	req = patched
 
 	return nil
 }
@@ -0,0 +1,163 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// fakeRepositoryManager is a restic.RepositoryManager that records which
+// repositories were pruned. Its other methods aren't implemented.
+type fakeRepositoryManager struct {
+	restic.RepositoryManager
+
+	pruneErr error
+	pruned   []string
+}
+
+func (m *fakeRepositoryManager) PruneRepo(repo *velerov1api.ResticRepository) error {
+	m.pruned = append(m.pruned, repo.Name)
+	return m.pruneErr
+}
+
+func (m *fakeRepositoryManager) UnlockRepo(repo *velerov1api.ResticRepository) error {
+	return nil
+}
+
+func newResticRepository(name string, lastMaintenance time.Time) *velerov1api.ResticRepository {
+	return &velerov1api.ResticRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      name,
+		},
+		Spec: velerov1api.ResticRepositorySpec{
+			MaintenanceFrequency: metav1.Duration{Duration: 24 * time.Hour},
+		},
+		Status: velerov1api.ResticRepositoryStatus{
+			Phase:               velerov1api.ResticRepositoryPhaseReady,
+			LastMaintenanceTime: metav1.Time{Time: lastMaintenance},
+		},
+	}
+}
+
+func TestResticRepositoryControllerMaintenance(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                    string
+		repo                    *velerov1api.ResticRepository
+		pruneErr                error
+		expectPrune             bool
+		expectedMessage         string
+		expectedLastMaintenance time.Time
+	}{
+		{
+			name:                    "repository that was maintained within its frequency isn't pruned",
+			repo:                    newResticRepository("repo-1", now.Add(-12*time.Hour)),
+			expectedLastMaintenance: now.Add(-12 * time.Hour),
+		},
+		{
+			name:                    "repository that's due for maintenance is pruned",
+			repo:                    newResticRepository("repo-1", now.Add(-48*time.Hour)),
+			expectPrune:             true,
+			expectedLastMaintenance: now,
+		},
+		{
+			name:                    "repository whose prune fails records the error",
+			repo:                    newResticRepository("repo-1", now.Add(-48*time.Hour)),
+			pruneErr:                errors.New("error running command"),
+			expectPrune:             true,
+			expectedMessage:         "error running command",
+			expectedLastMaintenance: now,
+		},
+		{
+			name:                    "repository that's locked is retried later",
+			repo:                    newResticRepository("repo-1", now.Add(-48*time.Hour)),
+			pruneErr:                errors.New("error running command, stderr=unable to create lock in backend: repository is already locked by PID 12"),
+			expectPrune:             true,
+			expectedMessage:         "maintenance postponed because the repository is locked by another restic process",
+			expectedLastMaintenance: now.Add(-48 * time.Hour),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(tc.repo)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				repoManager     = &fakeRepositoryManager{pruneErr: tc.pruneErr}
+			)
+
+			c := NewResticRepositoryController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().ResticRepositories(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				repoManager,
+				24*time.Hour,
+			).(*resticRepositoryController)
+			c.clock = clock.NewFakeClock(now)
+
+			require.NoError(t, sharedInformers.Velero().V1().ResticRepositories().Informer().GetStore().Add(tc.repo))
+
+			require.NoError(t, c.processQueueItem(kube.NamespaceAndName(tc.repo)))
+
+			if tc.expectPrune {
+				assert.Equal(t, []string{"repo-1"}, repoManager.pruned)
+			} else {
+				assert.Empty(t, repoManager.pruned)
+			}
+
+			res, err := client.VeleroV1().ResticRepositories("velero").Get("repo-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedMessage, res.Status.Message)
+			assert.True(t, tc.expectedLastMaintenance.Equal(res.Status.LastMaintenanceTime.Time))
+		})
+	}
+}
+
+func TestDueForMaintenance(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	repo := newResticRepository("repo-1", now.Add(-24*time.Hour))
+	jitter := maintenanceJitter(repo)
+
+	// the jitter is the same each time, and at most a tenth of the frequency.
+	assert.Equal(t, jitter, maintenanceJitter(repo.DeepCopy()))
+	assert.True(t, jitter >= 0 && jitter <= 144*time.Minute, "jitter %s is out of range", jitter)
+
+	// repositories with different names get different jitters.
+	assert.NotEqual(t, jitter, maintenanceJitter(newResticRepository("repo-2", now)))
+
+	assert.False(t, dueForMaintenance(repo, now.Add(jitter)))
+	assert.True(t, dueForMaintenance(repo, now.Add(jitter+time.Second)))
+}
@@ -27,24 +27,31 @@ import (
 	"sort"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/buildinfo"
+	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -79,16 +86,21 @@ type restoreController struct {
 	podVolumeBackupClient  velerov1client.PodVolumeBackupsGetter
 	restorer               pkgrestore.Restorer
 	backupLister           listers.BackupLister
+	backupIndexer          cache.Indexer
 	restoreLister          listers.RestoreLister
 	backupLocationLister   listers.BackupStorageLocationLister
 	snapshotLocationLister listers.VolumeSnapshotLocationLister
 	restoreLogLevel        logrus.Level
 	defaultBackupLocation  string
+	protectedNamespaces    []string
 	metrics                *metrics.ServerMetrics
 	logFormat              logging.Format
+	clusterIdentity        kubeutil.ClusterIdentity
+	discoveryHelper        velerodiscovery.Helper
 
 	newPluginManager func(logger logrus.FieldLogger) clientmgmt.Manager
 	newBackupStore   func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	newCorrelationID func() string
 }
 
 func NewRestoreController(
@@ -104,8 +116,11 @@ func NewRestoreController(
 	restoreLogLevel logrus.Level,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	defaultBackupLocation string,
+	protectedNamespaces []string,
 	metrics *metrics.ServerMetrics,
 	logFormat logging.Format,
+	clusterIdentity kubeutil.ClusterIdentity,
+	discoveryHelper velerodiscovery.Helper,
 ) Interface {
 	c := &restoreController{
 		genericController:      newGenericController("restore", logger),
@@ -114,18 +129,23 @@ func NewRestoreController(
 		podVolumeBackupClient:  podVolumeBackupClient,
 		restorer:               restorer,
 		backupLister:           backupInformer.Lister(),
+		backupIndexer:          backupInformer.Informer().GetIndexer(),
 		restoreLister:          restoreInformer.Lister(),
 		backupLocationLister:   backupLocationInformer.Lister(),
 		snapshotLocationLister: snapshotLocationInformer.Lister(),
 		restoreLogLevel:        restoreLogLevel,
 		defaultBackupLocation:  defaultBackupLocation,
+		protectedNamespaces:    protectedNamespaces,
 		metrics:                metrics,
 		logFormat:              logFormat,
+		clusterIdentity:        clusterIdentity,
+		discoveryHelper:        discoveryHelper,
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager: newPluginManager,
 		newBackupStore:   persistence.NewObjectBackupStore,
+		newCorrelationID: logging.NewCorrelationID,
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -138,31 +158,23 @@ func NewRestoreController(
 	c.resyncFunc = c.resync
 	c.resyncPeriod = time.Minute
 
-	restoreInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				restore := obj.(*api.Restore)
-
-				switch restore.Status.Phase {
-				case "", api.RestorePhaseNew:
-					// only process new restores
-				default:
-					c.logger.WithFields(logrus.Fields{
-						"restore": kubeutil.NamespaceAndName(restore),
-						"phase":   restore.Status.Phase,
-					}).Debug("Restore is not new, skipping")
-					return
-				}
-
-				key, err := cache.MetaNamespaceKeyFunc(restore)
-				if err != nil {
-					c.logger.WithError(errors.WithStack(err)).WithField("restore", restore).Error("Error creating queue key, item not added to queue")
-					return
-				}
-				c.queue.Add(key)
-			},
-		},
-	)
+	addIndexers(backupInformer.Informer(), cache.Indexers{scheduleNameIndex: scheduleNameIndexFunc})
+
+	c.watch(restoreInformer.Informer(), onCreate(func(obj runtime.Object) bool {
+		restore := obj.(*api.Restore)
+
+		switch restore.Status.Phase {
+		case "", api.RestorePhaseNew:
+			// only process new restores
+			return true
+		default:
+			c.logger.WithFields(logrus.Fields{
+				"restore": kubeutil.NamespaceAndName(restore),
+				"phase":   restore.Status.Phase,
+			}).Debug("Restore is not new, skipping")
+			return false
+		}
+	}))
 
 	return c
 }
@@ -225,7 +237,19 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	// manager used here is not the same one used by c.runValidatedRestore,
 	// since within that function we want the plugin manager to log to
 	// our per-restore log (which is instantiated within c.runValidatedRestore).
-	pluginManager := c.newPluginManager(c.logger)
+	span := tracing.StartSpan(nil, "processRestore", trace.StringAttribute("restore", kubeutil.NamespaceAndName(restore)))
+	defer span.End()
+
+	// give the restore a correlation ID so that the logs of everything
+	// that's done for it can be tied together.
+	if restore.Annotations[api.CorrelationIDAnnotation] == "" {
+		if restore.Annotations == nil {
+			restore.Annotations = make(map[string]string)
+		}
+		restore.Annotations[api.CorrelationIDAnnotation] = c.newCorrelationID()
+	}
+
+	pluginManager := c.newPluginManager(logging.WithCorrelationID(c.logger, restore))
 	info := c.validateAndComplete(restore, pluginManager)
 	pluginManager.CleanupClients()
 
@@ -255,7 +279,7 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 		return nil
 	}
 
-	if err := c.runValidatedRestore(restore, info); err != nil {
+	if err := c.runValidatedRestore(restore, info, span); err != nil {
 		c.logger.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
@@ -270,6 +294,11 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 		c.metrics.RegisterRestoreSuccess(backupScheduleName)
 	}
 
+	span.AddAttributes(trace.StringAttribute("phase", string(restore.Status.Phase)))
+	if restore.Status.Phase != api.RestorePhaseCompleted {
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: fmt.Sprintf("restore phase is %s", restore.Status.Phase)})
+	}
+
 	c.logger.Debug("Updating restore's final status")
 	if _, err = patchRestore(original, restore, c.restoreClient); err != nil {
 		c.logger.WithError(errors.WithStack(err)).Info("Error updating restore's final status")
@@ -281,6 +310,10 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 type backupInfo struct {
 	backup      *api.Backup
 	backupStore persistence.BackupStore
+
+	// retryItems are the items that failed in the restore that the restore
+	// retries, if it's a retry.
+	retryItems sets.String
 }
 
 func (c *restoreController) validateAndComplete(restore *api.Restore, pluginManager clientmgmt.Manager) backupInfo {
@@ -300,30 +333,30 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		}
 	}
 
-	// validate included/excluded resources
-	for _, err := range collections.ValidateIncludesExcludes(restore.Spec.IncludedResources, restore.Spec.ExcludedResources) {
-		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists: %v", err))
-	}
+	// exclude the namespaces that would be restored into protected
+	// namespaces from the restore
+	restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, protectRestoreNamespaces(&restore.Spec, c.protectedNamespaces)...)
+
+	// validate the included/excluded resources and namespaces, namespace
+	// mapping and source of the restore
+	restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, pkgrestore.ValidateSpec(restore.Spec)...)
 
-	// validate included/excluded namespaces
-	for _, err := range collections.ValidateIncludesExcludes(restore.Spec.IncludedNamespaces, restore.Spec.ExcludedNamespaces) {
-		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
+	// the items that the restore creates are labeled with its idempotency
+	// token, which its retries share.
+	if restore.Spec.IdempotencyToken == "" {
+		restore.Spec.IdempotencyToken = string(restore.UID)
 	}
 
-	// validate that exactly one of BackupName and ScheduleName have been specified
+	// a restore without exactly one of BackupName and ScheduleName can't
+	// be validated further
 	if !backupXorScheduleProvided(restore) {
-		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
 		return backupInfo{}
 	}
 
 	// if ScheduleName is specified, fill in BackupName with the most recent successful backup from
-	// the schedule
+	// the schedule, that started at or before the restore's point in time if it has one
 	if restore.Spec.ScheduleName != "" {
-		selector := labels.SelectorFromSet(labels.Set(map[string]string{
-			velerov1api.ScheduleNameLabel: restore.Spec.ScheduleName,
-		}))
-
-		backups, err := c.backupLister.Backups(c.namespace).List(selector)
+		backups, err := backupsForSchedule(c.backupIndexer, c.namespace, restore.Spec.ScheduleName)
 		if err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Unable to list backups for schedule")
 			return backupInfo{}
@@ -332,15 +365,30 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "No backups found for schedule")
 		}
 
+		if restore.Spec.PointInTime != nil {
+			backups = backupsStartedBy(backups, restore.Spec.PointInTime.Time)
+		}
+
 		if backup := mostRecentCompletedBackup(backups); backup != nil {
 			restore.Spec.BackupName = backup.Name
+		} else if restore.Spec.PointInTime != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors,
+				fmt.Sprintf("No completed backups found for schedule that started at or before %s", restore.Spec.PointInTime.UTC().Format(time.RFC3339)))
+			return backupInfo{}
 		} else {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "No completed backups found for schedule")
 			return backupInfo{}
 		}
 	}
 
-	info, err := c.fetchBackupInfo(restore.Spec.BackupName, pluginManager)
+	// label the restore with the name of its backup, so the restores
+	// created from a backup can be listed when describing it.
+	if restore.Labels == nil {
+		restore.Labels = make(map[string]string)
+	}
+	restore.Labels[velerov1api.BackupNameLabel] = label.GetValidName(restore.Spec.BackupName)
+
+	info, err := c.fetchBackupInfo(restore.Spec.BackupName, restore.Spec.StorageLocation, pluginManager)
 	if err != nil {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving backup: %v", err))
 		return backupInfo{}
@@ -351,9 +399,110 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Spec.ScheduleName = info.backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	}
 
+	if msg := checkBackupFormatVersion(info.backup); msg != "" {
+		if !restore.Spec.AllowNewerBackupFormat {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, msg+". Upgrade Velero to restore it, or set spec.allowNewerBackupFormat to attempt the restore anyway")
+			return backupInfo{}
+		}
+		c.logger.WithField("restore", kubeutil.NamespaceAndName(restore)).Warnf("%s, attempting the restore anyway because spec.allowNewerBackupFormat is set", msg)
+	}
+
+	if !restore.Spec.DataOnly {
+		c.checkCompatibility(restore, info)
+	}
+
+	if restore.Spec.RetryOf != "" {
+		retryItems, err := c.getRetryItems(restore, info.backupStore)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+			return backupInfo{}
+		}
+		info.retryItems = retryItems
+	}
+
 	return info
 }
 
+// getRetryItems returns the resource IDs of the items that failed in the
+// restore that a restore retries, from the results it uploaded.
+func (c *restoreController) getRetryItems(restore *api.Restore, backupStore persistence.BackupStore) (sets.String, error) {
+	original, err := c.restoreLister.Restores(restore.Namespace).Get(restore.Spec.RetryOf)
+	if apierrors.IsNotFound(err) {
+		return nil, errors.Errorf("Restore %s to retry does not exist", restore.Spec.RetryOf)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting restore %s to retry", restore.Spec.RetryOf)
+	}
+
+	if original.Status.Phase != api.RestorePhasePartiallyFailed {
+		return nil, errors.Errorf("Restore %s can't be retried because its phase is %s, not %s", original.Name, original.Status.Phase, api.RestorePhasePartiallyFailed)
+	}
+	if original.Spec.BackupName != restore.Spec.BackupName {
+		return nil, errors.Errorf("Restore %s to retry is of backup %s, not %s", original.Name, original.Spec.BackupName, restore.Spec.BackupName)
+	}
+
+	results, err := getResults(original, backupStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting results of restore %s to retry", original.Name)
+	}
+
+	failed := results["failed"]
+	items := sets.NewString(failed.Cluster...)
+	for _, namespaceItems := range failed.Namespaces {
+		items.Insert(namespaceItems...)
+	}
+	if items.Len() == 0 {
+		return nil, errors.Errorf("Restore %s has no failed items to retry", original.Name)
+	}
+
+	return items, nil
+}
+
+// checkBackupFormatVersion returns a message saying why a backup can't be
+// restored if its format version is newer than the ones that this server
+// supports, or an empty string if it isn't.
+func checkBackupFormatVersion(backup *api.Backup) string {
+	if backup.Status.Version <= pkgbackup.BackupVersion {
+		return ""
+	}
+
+	createdBy := "a newer version of Velero"
+	if backup.Status.VeleroVersion != "" {
+		createdBy = "Velero " + backup.Status.VeleroVersion
+	}
+
+	serverVersion := buildinfo.Version
+	if serverVersion == "" {
+		serverVersion = "<unknown>"
+	}
+
+	return fmt.Sprintf("Backup %s has format version %d, which was created by %s, but this Velero server (%s) only supports format versions up to %d",
+		backup.Name, backup.Status.Version, createdBy, serverVersion, pkgbackup.BackupVersion)
+}
+
+// checkCompatibility records the kinds of items in the backup whose API versions
+// aren't served by the cluster in the restore's status, and, if the restore is
+// strict, fails its validation if there are any.
+func (c *restoreController) checkCompatibility(restore *api.Restore, info backupInfo) {
+	resourceList, err := info.backupStore.GetBackupResourceList(info.backup.Name)
+	if err != nil {
+		c.logger.WithError(err).WithField("backup", info.backup.Name).Warn("Unable to get backup's resource list, not checking its compatibility with the cluster")
+		return
+	}
+	if resourceList == nil {
+		c.logger.WithField("backup", info.backup.Name).Info("Backup doesn't have a resource list, not checking its compatibility with the cluster")
+		return
+	}
+
+	restore.Status.CompatibilityIssues = pkgrestore.CheckCompatibility(resourceList, restore, c.discoveryHelper)
+
+	if restore.Spec.Strict {
+		for _, issue := range restore.Status.CompatibilityIssues {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, pkgrestore.CompatibilityIssueMessage(issue))
+		}
+	}
+}
+
 // backupXorScheduleProvided returns true if exactly one of BackupName and
 // ScheduleName are non-empty for the restore, or false otherwise.
 func backupXorScheduleProvided(restore *api.Restore) bool {
@@ -385,24 +534,52 @@ func mostRecentCompletedBackup(backups []*api.Backup) *api.Backup {
 	return nil
 }
 
+// backupsStartedBy returns the backups that started at or before a point in
+// time.
+func backupsStartedBy(backups []*api.Backup, pointInTime time.Time) []*api.Backup {
+	var started []*api.Backup
+	for _, backup := range backups {
+		if !backup.Status.StartTimestamp.IsZero() && !backup.Status.StartTimestamp.After(pointInTime) {
+			started = append(started, backup)
+		}
+	}
+	return started
+}
+
 // fetchBackupInfo checks the backup lister for a backup that matches the given name. If it doesn't
-// find it, it returns an error.
-func (c *restoreController) fetchBackupInfo(backupName string, pluginManager clientmgmt.Manager) (backupInfo, error) {
+// find it, it returns an error. If locationName is specified, the backup store for that location
+// is used instead of the one for the backup's storage location, and the backup's artifacts must
+// exist in it.
+func (c *restoreController) fetchBackupInfo(backupName, locationName string, pluginManager clientmgmt.Manager) (backupInfo, error) {
 	backup, err := c.backupLister.Backups(c.namespace).Get(backupName)
 	if err != nil {
 		return backupInfo{}, err
 	}
 
-	location, err := c.backupLocationLister.BackupStorageLocations(c.namespace).Get(backup.Spec.StorageLocation)
+	if locationName == "" {
+		locationName = backup.Spec.StorageLocation
+	}
+
+	location, err := c.backupLocationLister.BackupStorageLocations(c.namespace).Get(locationName)
 	if err != nil {
 		return backupInfo{}, errors.WithStack(err)
 	}
 
-	backupStore, err := c.newBackupStore(location, pluginManager, c.logger)
+	backupStore, err := c.newBackupStore(persistence.MemberClusterLocation(location, backup.Spec.Cluster), pluginManager, c.logger)
 	if err != nil {
 		return backupInfo{}, err
 	}
 
+	if locationName != backup.Spec.StorageLocation {
+		exists, err := backupStore.BackupExists(location.Spec.StorageType.ObjectStorage.Bucket, backupName)
+		if err != nil {
+			return backupInfo{}, errors.Wrapf(err, "error checking if backup exists in backup storage location %s", locationName)
+		}
+		if !exists {
+			return backupInfo{}, errors.Errorf("backup %s does not exist in backup storage location %s", backupName, locationName)
+		}
+	}
+
 	return backupInfo{
 		backup:      backup,
 		backupStore: backupStore,
@@ -413,7 +590,7 @@ func (c *restoreController) fetchBackupInfo(backupName string, pluginManager cli
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
 // counts, but *does not* update its phase or patch it via the API.
-func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) error {
+func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo, span *trace.Span) error {
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
 	restoreLog, err := newRestoreLogger(restore, c.logger, c.restoreLogLevel, c.logFormat)
@@ -430,7 +607,10 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error getting restore item actions")
 	}
 
-	backupFile, err := downloadToTempFile(restore.Spec.BackupName, info.backupStore, restoreLog)
+	downloadSpan := tracing.StartSpan(span, "BackupStore.GetBackupContents")
+	namespaces := collections.NewIncludesExcludes().Includes(restore.Spec.IncludedNamespaces...).Excludes(restore.Spec.ExcludedNamespaces...)
+	backupFile, err := downloadToTempFile(restore.Spec.BackupName, namespaces.ShouldInclude, info.backupStore, restoreLog)
+	tracing.EndSpan(downloadSpan, err)
 	if err != nil {
 		return errors.Wrap(err, "error downloading backup")
 	}
@@ -447,6 +627,11 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error fetching volume snapshots metadata")
 	}
 
+	originWarning := c.checkBackupOrigin(info.backup)
+	if originWarning != "" {
+		restoreLog.Warn(originWarning)
+	}
+
 	restoreLog.Info("starting restore")
 
 	var podVolumeBackups []*velerov1api.PodVolumeBackup
@@ -460,14 +645,51 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		PodVolumeBackups: podVolumeBackups,
 		VolumeSnapshots:  volumeSnapshots,
 		BackupReader:     backupFile,
+		SkippedItems:     new(pkgrestore.Result),
+		FailedItems:      new(pkgrestore.Result),
+		RetryItems:       info.retryItems,
+		Span:             span,
 	}
+
+	var manifestsFile *os.File
+	var manifestsWriter *gzip.Writer
+	if restore.Spec.NoApply {
+		if manifestsFile, err = ioutil.TempFile("", ""); err != nil {
+			return errors.Wrap(err, "error creating temp file for restore manifests")
+		}
+		defer closeAndRemoveFile(manifestsFile, c.logger)
+
+		manifestsWriter = gzip.NewWriter(manifestsFile)
+		restoreReq.ManifestsWriter = manifestsWriter
+	}
+
+	restoreSpan := tracing.StartSpan(span, "Restorer.Restore")
 	restoreWarnings, restoreErrors := c.restorer.Restore(restoreReq, actions, c.snapshotLocationLister, pluginManager)
+	restoreSpan.End()
 	restoreLog.Info("restore completed")
 
+	if originWarning != "" {
+		restoreWarnings.Velero = append(restoreWarnings.Velero, originWarning)
+	}
+	for _, issue := range restore.Status.CompatibilityIssues {
+		restoreWarnings.Velero = append(restoreWarnings.Velero, pkgrestore.CompatibilityIssueMessage(issue))
+	}
+
+	if manifestsWriter != nil {
+		if err := manifestsWriter.Close(); err != nil {
+			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error closing restore manifests: %v", err))
+		} else if err := info.backupStore.PutRestoreManifests(restore.Spec.BackupName, restore.Name, manifestsFile); err != nil {
+			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error uploading restore manifests to backup storage: %v", err))
+		}
+	}
+
 	if logReader, err := restoreLog.done(c.logger); err != nil {
 		restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error getting restore log reader: %v", err))
 	} else {
-		if err := info.backupStore.PutRestoreLog(restore.Spec.BackupName, restore.Name, logReader); err != nil {
+		logSpan := tracing.StartSpan(span, "BackupStore.PutRestoreLog")
+		err := info.backupStore.PutRestoreLog(restore.Spec.BackupName, restore.Name, logReader)
+		tracing.EndSpan(logSpan, err)
+		if err != nil {
 			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error uploading log file to backup storage: %v", err))
 		}
 	}
@@ -485,18 +707,45 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		restore.Status.Errors += len(e)
 	}
 
+	restore.Status.SkippedItems = len(restoreReq.SkippedItems.Cluster)
+	for _, s := range restoreReq.SkippedItems.Namespaces {
+		restore.Status.SkippedItems += len(s)
+	}
+
 	m := map[string]pkgrestore.Result{
 		"warnings": restoreWarnings,
 		"errors":   restoreErrors,
+		"skipped":  *restoreReq.SkippedItems,
+		"failed":   *restoreReq.FailedItems,
 	}
 
-	if err := putResults(restore, m, info.backupStore, c.logger); err != nil {
+	resultsSpan := tracing.StartSpan(span, "BackupStore.PutRestoreResults")
+	err = putResults(restore, m, info.backupStore, c.logger)
+	tracing.EndSpan(resultsSpan, err)
+	if err != nil {
 		c.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
 	return nil
 }
 
+// checkBackupOrigin returns a warning if the backup was taken in a different
+// cluster than the one it's being restored into, or an empty string if it
+// wasn't or the cluster it was taken in isn't known.
+func (c *restoreController) checkBackupOrigin(backup *api.Backup) string {
+	sourceUID := backup.Labels[api.SourceClusterUIDLabel]
+	if sourceUID == "" || c.clusterIdentity.UID == "" || sourceUID == c.clusterIdentity.UID {
+		return ""
+	}
+
+	source := backup.Labels[api.SourceClusterNameLabel]
+	if source == "" {
+		source = sourceUID
+	}
+
+	return fmt.Sprintf("backup %s was taken in cluster %s, which is different from the cluster it's being restored into (%s); cluster-specific resources may not restore as expected", backup.Name, source, c.clusterIdentity)
+}
+
 func putResults(restore *api.Restore, results map[string]pkgrestore.Result, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -517,29 +766,54 @@ func putResults(restore *api.Restore, results map[string]pkgrestore.Result, back
 	return nil
 }
 
-func downloadToTempFile(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
-	readCloser, err := backupStore.GetBackupContents(backupName)
+// getResults returns the results of a restore that were uploaded by
+// putResults.
+func getResults(restore *api.Restore, backupStore persistence.BackupStore) (map[string]pkgrestore.Result, error) {
+	rc, err := backupStore.GetRestoreResults(restore.Name)
 	if err != nil {
 		return nil, err
 	}
-	defer readCloser.Close()
+	if rc == nil {
+		return nil, errors.Errorf("restore %s has no results", restore.Name)
+	}
+	defer rc.Close()
+
+	gzr, err := gzip.NewReader(rc)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	var results map[string]pkgrestore.Result
+	if err := json.NewDecoder(gzr).Decode(&results); err != nil {
+		return nil, errors.Wrap(err, "error decoding restore results from JSON")
+	}
+
+	return results, nil
+}
 
+// downloadToTempFile downloads a backup's archive, including only the namespaces
+// for which includeNamespace returns true if the archive is split into
+// per-namespace sub-archives, to a temp file.
+func downloadToTempFile(backupName string, includeNamespace func(string) bool, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
 	file, err := ioutil.TempFile("", backupName)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating Backup temp file")
 	}
 
-	n, err := io.Copy(file, readCloser)
-	if err != nil {
+	if err := persistence.CopyBackupContents(backupStore, backupName, includeNamespace, file); err != nil {
+		closeAndRemoveFile(file, logger)
 		return nil, errors.Wrap(err, "error copying Backup to temp file")
 	}
 
 	log := logger.WithField("backup", backupName)
 
-	log.WithFields(logrus.Fields{
-		"fileName": file.Name(),
-		"bytes":    n,
-	}).Debug("Copied Backup to file")
+	if stat, err := file.Stat(); err == nil {
+		log.WithFields(logrus.Fields{
+			"fileName": file.Name(),
+			"bytes":    stat.Size(),
+		}).Debug("Copied Backup to file")
+	}
 
 	if _, err := file.Seek(0, 0); err != nil {
 		return nil, errors.Wrap(err, "error resetting Backup file offset")
@@ -549,22 +823,11 @@ func downloadToTempFile(backupName string, backupStore persistence.BackupStore,
 }
 
 func patchRestore(original, updated *api.Restore, client velerov1client.RestoresGetter) (*api.Restore, error) {
-	origBytes, err := json.Marshal(original)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling original restore")
-	}
-
-	updatedBytes, err := json.Marshal(updated)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling updated restore")
-	}
-
-	patchBytes, err := jsonpatch.CreateMergePatch(origBytes, updatedBytes)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating json merge patch for restore")
-	}
-
-	res, err := client.Restores(original.Namespace).Patch(original.Name, types.MergePatchType, patchBytes)
+	var res *api.Restore
+	err := kubeutil.Patch(original, updated, kubeutil.PatchOptions{}, func(patchType types.PatchType, data []byte) (err error) {
+		res, err = client.Restores(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error patching restore")
 	}
@@ -589,7 +852,7 @@ func newRestoreLogger(restore *api.Restore, baseLogger logrus.FieldLogger, logLe
 	logger.Out = io.MultiWriter(os.Stdout, w)
 
 	return &restoreLogger{
-		FieldLogger: logger.WithField("restore", kubeutil.NamespaceAndName(restore)),
+		FieldLogger: logging.WithCorrelationID(logger.WithField("restore", kubeutil.NamespaceAndName(restore)), restore),
 		file:        file,
 		w:           w,
 	}, nil
@@ -18,7 +18,9 @@ package controller
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -31,11 +33,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -47,6 +52,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -55,6 +62,8 @@ func TestFetchBackupInfo(t *testing.T) {
 	tests := []struct {
 		name              string
 		backupName        string
+		locationName      string
+		backupExists      *bool
 		informerLocations []*api.BackupStorageLocation
 		informerBackups   []*api.Backup
 		backupStoreBackup *api.Backup
@@ -83,6 +92,30 @@ func TestFetchBackupInfo(t *testing.T) {
 			backupStoreError: errors.New("no backup here"),
 			expectedErr:      true,
 		},
+		{
+			name:         "backup exists in location override",
+			backupName:   "backup-1",
+			locationName: "replica",
+			backupExists: boolptr.True(),
+			informerLocations: []*api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result(),
+				builder.ForBackupStorageLocation("velero", "replica").Provider("myCloud").Bucket("replica-bucket").Result(),
+			},
+			informerBackups: []*api.Backup{defaultBackup().StorageLocation("default").Result()},
+			expectedRes:     defaultBackup().StorageLocation("default").Result(),
+		},
+		{
+			name:         "backup does not exist in location override",
+			backupName:   "backup-1",
+			locationName: "replica",
+			backupExists: boolptr.False(),
+			informerLocations: []*api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result(),
+				builder.ForBackupStorageLocation("velero", "replica").Provider("myCloud").Bucket("replica-bucket").Result(),
+			},
+			informerBackups: []*api.Backup{defaultBackup().StorageLocation("default").Result()},
+			expectedErr:     true,
+		},
 	}
 
 	formatFlag := logging.FormatText
@@ -114,8 +147,11 @@ func TestFetchBackupInfo(t *testing.T) {
 				logrus.InfoLevel,
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				"default",
+				nil,
 				metrics.NewServerMetrics(),
 				formatFlag,
+				kubeutil.ClusterIdentity{},
+				velerotest.NewFakeDiscoveryHelper(false, nil),
 			).(*restoreController)
 
 			c.newBackupStore = func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
@@ -145,7 +181,11 @@ func TestFetchBackupInfo(t *testing.T) {
 				backupStore.On("GetBackupMetadata", test.backupName).Return(test.backupStoreBackup, nil).Maybe()
 			}
 
-			info, err := c.fetchBackupInfo(test.backupName, pluginManager)
+			if test.backupExists != nil {
+				backupStore.On("BackupExists", "replica-bucket", test.backupName).Return(*test.backupExists, nil)
+			}
+
+			info, err := c.fetchBackupInfo(test.backupName, test.locationName, pluginManager)
 
 			require.Equal(t, test.expectedErr, err != nil)
 			assert.Equal(t, test.expectedRes, info.backup)
@@ -210,8 +250,11 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				logrus.InfoLevel,
 				nil,
 				"default",
+				nil,
 				metrics.NewServerMetrics(),
 				formatFlag,
+				kubeutil.ClusterIdentity{},
+				velerotest.NewFakeDiscoveryHelper(false, nil),
 			).(*restoreController)
 
 			if test.restore != nil {
@@ -315,6 +358,26 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:        string(api.RestorePhaseInProgress),
 			expectedRestorerCall: NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseInProgress).Result(),
 		},
+		{
+			name:          "restore of a backup with a newer format version fails validation",
+			location:      defaultStorageLocation,
+			restore:       NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).Result(),
+			backup:        defaultBackup().StorageLocation("default").Version(pkgbackup.BackupVersion + 1).VeleroVersion("v9.0.0").Result(),
+			expectedErr:   false,
+			expectedPhase: string(api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				fmt.Sprintf("Backup backup-1 has format version %d, which was created by Velero v9.0.0, but this Velero server (<unknown>) only supports format versions up to %d. Upgrade Velero to restore it, or set spec.allowNewerBackupFormat to attempt the restore anyway", pkgbackup.BackupVersion+1, pkgbackup.BackupVersion),
+			},
+		},
+		{
+			name:                 "restore of a backup with a newer format version gets executed if it's allowed",
+			location:             defaultStorageLocation,
+			restore:              NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).AllowNewerBackupFormat(true).Result(),
+			backup:               defaultBackup().StorageLocation("default").Version(pkgbackup.BackupVersion + 1).Result(),
+			expectedErr:          false,
+			expectedPhase:        string(api.RestorePhaseInProgress),
+			expectedRestorerCall: NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseInProgress).AllowNewerBackupFormat(true).Result(),
+		},
 		{
 			name:          "restoration of nodes is not supported",
 			location:      defaultStorageLocation,
@@ -375,6 +438,28 @@ func TestProcessQueueItem(t *testing.T) {
 				"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: restores.velero.io",
 			},
 		},
+		{
+			name:          "restore with data-only and no-apply set fails validation",
+			location:      defaultStorageLocation,
+			restore:       NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).DataOnly(true).NoApply(true).Result(),
+			backup:        defaultBackup().StorageLocation("default").Result(),
+			expectedErr:   false,
+			expectedPhase: string(api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				"A data-only restore can't have spec.noApply set",
+			},
+		},
+		{
+			name:          "restore with data-only and skip-owner-managed set fails validation",
+			location:      defaultStorageLocation,
+			restore:       NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).DataOnly(true).SkipOwnerManaged(true).Result(),
+			backup:        defaultBackup().StorageLocation("default").Result(),
+			expectedErr:   false,
+			expectedPhase: string(api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				"A data-only restore can't have spec.skipOwnerManaged set",
+			},
+		},
 		{
 			name:                            "backup download error results in failed restore",
 			location:                        defaultStorageLocation,
@@ -415,13 +500,17 @@ func TestProcessQueueItem(t *testing.T) {
 				logrus.InfoLevel,
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				"default",
+				nil,
 				metrics.NewServerMetrics(),
 				formatFlag,
+				kubeutil.ClusterIdentity{},
+				velerotest.NewFakeDiscoveryHelper(false, nil),
 			).(*restoreController)
 
 			c.newBackupStore = func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
 				return backupStore, nil
 			}
+			c.newCorrelationID = func() string { return "correlation-1" }
 
 			if test.location != nil {
 				sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(test.location)
@@ -485,6 +574,7 @@ func TestProcessQueueItem(t *testing.T) {
 				errors.Velero = append(errors.Velero, "error uploading log file to object storage: "+test.putRestoreLogErr.Error())
 			}
 			if test.expectedRestorerCall != nil {
+				backupStore.On("GetBackupIndex", test.backup.Name).Return(nil, nil)
 				backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 
 				restorer.On("Restore", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(warnings, errors)
@@ -504,6 +594,10 @@ func TestProcessQueueItem(t *testing.T) {
 				backupStore.On("GetBackupVolumeSnapshots", test.backup.Name).Return(volumeSnapshots, nil)
 			}
 
+			if test.backup != nil {
+				backupStore.On("GetBackupResourceList", test.backup.Name).Return(nil, nil).Maybe()
+			}
+
 			var (
 				key = test.restoreKey
 				err error
@@ -522,6 +616,7 @@ func TestProcessQueueItem(t *testing.T) {
 
 			if test.backupStoreGetBackupContentsErr != nil {
 				// TODO why do I need .Maybe() here?
+				backupStore.On("GetBackupIndex", test.restore.Spec.BackupName).Return(nil, nil).Maybe()
 				backupStore.On("GetBackupContents", test.restore.Spec.BackupName).Return(nil, test.backupStoreGetBackupContentsErr).Maybe()
 			}
 
@@ -551,9 +646,15 @@ func TestProcessQueueItem(t *testing.T) {
 				Errors           int              `json:"errors"`
 			}
 
+			type MetadataPatch struct {
+				Labels      map[string]string `json:"labels,omitempty"`
+				Annotations map[string]string `json:"annotations,omitempty"`
+			}
+
 			type Patch struct {
-				Spec   SpecPatch   `json:"spec,omitempty"`
-				Status StatusPatch `json:"status"`
+				Metadata MetadataPatch `json:"metadata,omitempty"`
+				Spec     SpecPatch     `json:"spec,omitempty"`
+				Status   StatusPatch   `json:"status"`
 			}
 
 			decode := func(decoder *json.Decoder) (interface{}, error) {
@@ -567,6 +668,9 @@ func TestProcessQueueItem(t *testing.T) {
 			require.True(t, len(actions) > 0, "len(actions) is too small")
 
 			expected := Patch{
+				Metadata: MetadataPatch{
+					Annotations: map[string]string{api.CorrelationIDAnnotation: "correlation-1"},
+				},
 				Status: StatusPatch{
 					Phase:            api.RestorePhase(test.expectedPhase),
 					ValidationErrors: test.expectedValidationErrors,
@@ -579,6 +683,14 @@ func TestProcessQueueItem(t *testing.T) {
 				}
 			}
 
+			if backupXorScheduleProvided(test.restore) {
+				backupName := test.restore.Spec.BackupName
+				if test.restore.Spec.ScheduleName != "" && test.backup != nil {
+					backupName = test.backup.Name
+				}
+				expected.Metadata.Labels = map[string]string{api.BackupNameLabel: backupName}
+			}
+
 			velerotest.ValidatePatch(t, actions[0], expected, decode)
 
 			// if we don't expect a restore, validate it wasn't called and exit the test
@@ -641,7 +753,10 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		nil,
 		"default",
 		nil,
+		nil,
 		formatFlag,
+		kubeutil.ClusterIdentity{},
+		velerotest.NewFakeDiscoveryHelper(false, nil),
 	).(*restoreController)
 
 	restore := &api.Restore{
@@ -710,6 +825,234 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 	assert.Equal(t, "bar", restore.Spec.BackupName)
 }
 
+func TestValidateAndCompleteWithPointInTime(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		logger          = velerotest.NewLogger()
+		pluginManager   = &pluginmocks.Manager{}
+		now             = time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	c := NewRestoreController(
+		api.DefaultNamespace,
+		sharedInformers.Velero().V1().Restores(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		nil,
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+		logger,
+		logrus.DebugLevel,
+		nil,
+		"default",
+		nil,
+		nil,
+		logging.FormatText,
+		kubeutil.ClusterIdentity{},
+		velerotest.NewFakeDiscoveryHelper(false, nil),
+	).(*restoreController)
+
+	for i, name := range []string{"daily-1", "daily-2", "daily-3"} {
+		require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(
+			builder.ForBackup(api.DefaultNamespace, name).
+				ObjectMeta(builder.WithLabels(api.ScheduleNameLabel, "daily")).
+				Phase(api.BackupPhaseCompleted).
+				StartTimestamp(now.Add(time.Duration(i-2)*24*time.Hour)).
+				Result(),
+		))
+	}
+
+	// the most recent backup that started at or before the point in time is used
+	restore := builder.ForRestore(api.DefaultNamespace, "restore-1").Schedule("daily").PointInTime(now.Add(-time.Hour)).Result()
+	c.validateAndComplete(restore, pluginManager)
+	assert.Equal(t, "daily-2", restore.Spec.BackupName)
+
+	// a point in time before all of the backups fails validation
+	restore = builder.ForRestore(api.DefaultNamespace, "restore-2").Schedule("daily").PointInTime(now.Add(-72 * time.Hour)).Result()
+	c.validateAndComplete(restore, pluginManager)
+	assert.Equal(t, []string{"No completed backups found for schedule that started at or before 2020-04-28T12:00:00Z"}, restore.Status.ValidationErrors)
+	assert.Empty(t, restore.Spec.BackupName)
+}
+
+func TestValidateAndCompleteAddsBackupNameLabel(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+	)
+
+	c := NewRestoreController(
+		api.DefaultNamespace,
+		sharedInformers.Velero().V1().Restores(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		nil,
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+		velerotest.NewLogger(),
+		logrus.DebugLevel,
+		nil,
+		"default",
+		nil,
+		nil,
+		logging.FormatText,
+		kubeutil.ClusterIdentity{},
+		velerotest.NewFakeDiscoveryHelper(false, nil),
+	).(*restoreController)
+
+	restore := builder.ForRestore(api.DefaultNamespace, "restore-1").
+		Backup("backup-1").
+		ObjectMeta(builder.WithLabels("foo", "bar")).
+		Result()
+
+	c.validateAndComplete(restore, pluginManager)
+	assert.Equal(t, map[string]string{"foo": "bar", api.BackupNameLabel: "backup-1"}, restore.Labels)
+}
+
+func TestValidateAndCompleteDefaultsIdempotencyToken(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+	)
+
+	c := NewRestoreController(
+		api.DefaultNamespace,
+		sharedInformers.Velero().V1().Restores(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		nil,
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+		velerotest.NewLogger(),
+		logrus.DebugLevel,
+		nil,
+		"default",
+		nil,
+		nil,
+		logging.FormatText,
+		kubeutil.ClusterIdentity{},
+		velerotest.NewFakeDiscoveryHelper(false, nil),
+	).(*restoreController)
+
+	// the restore's UID is used by default.
+	restore := builder.ForRestore(api.DefaultNamespace, "restore-1").
+		Backup("backup-1").
+		ObjectMeta(builder.WithUID("uid-1")).
+		Result()
+	c.validateAndComplete(restore, pluginManager)
+	assert.Equal(t, "uid-1", restore.Spec.IdempotencyToken)
+
+	// a retry keeps the token of the restore that it retries.
+	restore = builder.ForRestore(api.DefaultNamespace, "restore-1-retry").
+		Backup("backup-1").
+		IdempotencyToken("uid-1").
+		ObjectMeta(builder.WithUID("uid-2")).
+		Result()
+	c.validateAndComplete(restore, pluginManager)
+	assert.Equal(t, "uid-1", restore.Spec.IdempotencyToken)
+}
+
+func TestGetRetryItems(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		backupStore     = &persistencemocks.BackupStore{}
+	)
+
+	c := NewRestoreController(
+		api.DefaultNamespace,
+		sharedInformers.Velero().V1().Restores(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		nil,
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+		velerotest.NewLogger(),
+		logrus.DebugLevel,
+		nil,
+		"default",
+		nil,
+		nil,
+		logging.FormatText,
+		kubeutil.ClusterIdentity{},
+		velerotest.NewFakeDiscoveryHelper(false, nil),
+	).(*restoreController)
+
+	for _, restore := range []*api.Restore{
+		builder.ForRestore(api.DefaultNamespace, "partially-failed").Backup("backup-1").Phase(api.RestorePhasePartiallyFailed).Result(),
+		builder.ForRestore(api.DefaultNamespace, "completed").Backup("backup-1").Phase(api.RestorePhaseCompleted).Result(),
+		builder.ForRestore(api.DefaultNamespace, "no-failed-items").Backup("backup-1").Phase(api.RestorePhasePartiallyFailed).Result(),
+	} {
+		require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(restore))
+	}
+
+	gzipped := func(results map[string]pkgrestore.Result) []byte {
+		buf := new(bytes.Buffer)
+		gzw := gzip.NewWriter(buf)
+		require.NoError(t, json.NewEncoder(gzw).Encode(results))
+		require.NoError(t, gzw.Close())
+		return buf.Bytes()
+	}
+	backupStore.On("GetRestoreResults", "partially-failed").Return(ioutil.NopCloser(bytes.NewReader(gzipped(map[string]pkgrestore.Result{
+		"errors": {Namespaces: map[string][]string{"ns-1": {"error restoring deployments.apps/ns-1/app"}}},
+		"failed": {Cluster: []string{"persistentvolumes/pv-1"}, Namespaces: map[string][]string{"ns-1": {"deployments.apps/ns-1/app"}}},
+	}))), nil)
+	backupStore.On("GetRestoreResults", "no-failed-items").Return(ioutil.NopCloser(bytes.NewReader(gzipped(map[string]pkgrestore.Result{
+		"errors": {Velero: []string{"error uploading restore manifests"}},
+	}))), nil)
+
+	tests := []struct {
+		name    string
+		retry   *api.Restore
+		want    sets.String
+		wantErr string
+	}{
+		{
+			name:  "failed items are retried",
+			retry: builder.ForRestore(api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("partially-failed").Result(),
+			want:  sets.NewString("persistentvolumes/pv-1", "deployments.apps/ns-1/app"),
+		},
+		{
+			name:    "restore that doesn't exist",
+			retry:   builder.ForRestore(api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("missing").Result(),
+			wantErr: "Restore missing to retry does not exist",
+		},
+		{
+			name:    "restore that isn't partially failed",
+			retry:   builder.ForRestore(api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("completed").Result(),
+			wantErr: "Restore completed can't be retried because its phase is Completed, not PartiallyFailed",
+		},
+		{
+			name:    "restore of a different backup",
+			retry:   builder.ForRestore(api.DefaultNamespace, "retry").Backup("backup-2").RetryOf("partially-failed").Result(),
+			wantErr: "Restore partially-failed to retry is of backup backup-1, not backup-2",
+		},
+		{
+			name:    "restore without failed items",
+			retry:   builder.ForRestore(api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("no-failed-items").Result(),
+			wantErr: "Restore no-failed-items has no failed items to retry",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			items, err := c.getRetryItems(tc.retry, backupStore)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, items)
+		})
+	}
+}
+
 func TestBackupXorScheduleProvided(t *testing.T) {
 	r := &api.Restore{}
 	assert.False(t, backupXorScheduleProvided(r))
@@ -832,3 +1175,144 @@ func (r *fakeRestorer) Restore(
 
 	return res.Get(0).(pkgrestore.Result), res.Get(1).(pkgrestore.Result)
 }
+
+func TestCheckBackupOrigin(t *testing.T) {
+	tests := []struct {
+		name            string
+		backup          *api.Backup
+		clusterIdentity kubeutil.ClusterIdentity
+		wantWarning     string
+	}{
+		{
+			name:            "backup from the same cluster doesn't warn",
+			backup:          builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(api.SourceClusterUIDLabel, "uid-1")).Result(),
+			clusterIdentity: kubeutil.ClusterIdentity{Name: "cluster-1", UID: "uid-1"},
+		},
+		{
+			name:            "backup without a source cluster doesn't warn",
+			backup:          builder.ForBackup("velero", "backup-1").Result(),
+			clusterIdentity: kubeutil.ClusterIdentity{Name: "cluster-1", UID: "uid-1"},
+		},
+		{
+			name:   "unknown target cluster doesn't warn",
+			backup: builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(api.SourceClusterUIDLabel, "uid-1")).Result(),
+		},
+		{
+			name:            "backup from a different named cluster warns",
+			backup:          builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(api.SourceClusterNameLabel, "cluster-2", api.SourceClusterUIDLabel, "uid-2")).Result(),
+			clusterIdentity: kubeutil.ClusterIdentity{Name: "cluster-1", UID: "uid-1"},
+			wantWarning:     "backup backup-1 was taken in cluster cluster-2, which is different from the cluster it's being restored into (cluster-1); cluster-specific resources may not restore as expected",
+		},
+		{
+			name:            "backup from a different unnamed cluster warns with its UID",
+			backup:          builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(api.SourceClusterUIDLabel, "uid-2")).Result(),
+			clusterIdentity: kubeutil.ClusterIdentity{UID: "uid-1"},
+			wantWarning:     "backup backup-1 was taken in cluster uid-2, which is different from the cluster it's being restored into (uid-1); cluster-specific resources may not restore as expected",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &restoreController{clusterIdentity: test.clusterIdentity}
+			assert.Equal(t, test.wantWarning, c.checkBackupOrigin(test.backup))
+		})
+	}
+}
+
+func TestCheckBackupFormatVersion(t *testing.T) {
+	originalVersion := buildinfo.Version
+	buildinfo.Version = "v1.2.0"
+	defer func() {
+		buildinfo.Version = originalVersion
+	}()
+
+	tests := []struct {
+		name   string
+		backup *api.Backup
+		want   string
+	}{
+		{
+			name:   "backup with the current format version is supported",
+			backup: builder.ForBackup("velero", "backup-1").Version(pkgbackup.BackupVersion).Result(),
+		},
+		{
+			name:   "backup with an older format version is supported",
+			backup: builder.ForBackup("velero", "backup-1").Version(1).Result(),
+		},
+		{
+			name:   "backup with a newer format version isn't supported",
+			backup: builder.ForBackup("velero", "backup-1").Version(pkgbackup.BackupVersion + 1).VeleroVersion("v2.0.0").Result(),
+			want:   fmt.Sprintf("Backup backup-1 has format version %d, which was created by Velero v2.0.0, but this Velero server (v1.2.0) only supports format versions up to %d", pkgbackup.BackupVersion+1, pkgbackup.BackupVersion),
+		},
+		{
+			name:   "backup with a newer format version and no Velero version isn't supported",
+			backup: builder.ForBackup("velero", "backup-1").Version(pkgbackup.BackupVersion + 1).Result(),
+			want:   fmt.Sprintf("Backup backup-1 has format version %d, which was created by a newer version of Velero, but this Velero server (v1.2.0) only supports format versions up to %d", pkgbackup.BackupVersion+1, pkgbackup.BackupVersion),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, checkBackupFormatVersion(test.backup))
+		})
+	}
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	discoveryHelper := &velerotest.FakeDiscoveryHelper{
+		ResourceList: []*metav1.APIResourceList{
+			{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment"}}},
+		},
+		APIGroupsList: []metav1.APIGroup{
+			{Name: "apps", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "apps/v1", Version: "v1"}}},
+		},
+	}
+
+	tests := []struct {
+		name                     string
+		strict                   bool
+		resourceList             map[string][]string
+		expectedIssues           []api.RestoreCompatibilityIssue
+		expectedValidationErrors []string
+	}{
+		{
+			name:         "backup without a resource list isn't checked",
+			resourceList: nil,
+		},
+		{
+			name:         "issues are recorded in the restore's status",
+			resourceList: map[string][]string{"apps/v1beta1/Deployment": {"ns-1/deploy-1"}},
+			expectedIssues: []api.RestoreCompatibilityIssue{
+				{Type: api.RestoreCompatibilityIssueRemovedAPIVersion, APIVersion: "apps/v1beta1", Kind: "Deployment", Items: 1},
+			},
+		},
+		{
+			name:         "issues fail validation of strict restores",
+			strict:       true,
+			resourceList: map[string][]string{"apps/v1beta1/Deployment": {"ns-1/deploy-1"}},
+			expectedIssues: []api.RestoreCompatibilityIssue{
+				{Type: api.RestoreCompatibilityIssueRemovedAPIVersion, APIVersion: "apps/v1beta1", Kind: "Deployment", Items: 1},
+			},
+			expectedValidationErrors: []string{"1 apps/v1beta1 Deployment item(s) can't be restored because the cluster doesn't serve their API version"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetBackupResourceList", "backup-1").Return(test.resourceList, nil)
+
+			c := &restoreController{
+				genericController: newGenericController("restore-test", velerotest.NewLogger()),
+				discoveryHelper:   discoveryHelper,
+			}
+
+			restore := builder.ForRestore("velero", "restore-1").Backup("backup-1").Result()
+			restore.Spec.Strict = test.strict
+
+			c.checkCompatibility(restore, backupInfo{backup: defaultBackup().Result(), backupStore: backupStore})
+			assert.Equal(t, test.expectedIssues, restore.Status.CompatibilityIssues)
+			assert.Equal(t, test.expectedValidationErrors, restore.Status.ValidationErrors)
+		})
+	}
+}
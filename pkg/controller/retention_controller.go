@@ -0,0 +1,274 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+)
+
+const (
+	RetentionSyncPeriod = 60 * time.Minute
+)
+
+// retentionController creates DeleteBackupRequests for the backups of
+// schedules with retention policies that the policies don't keep.
+type retentionController struct {
+	*genericController
+
+	scheduleLister             listers.ScheduleLister
+	backupIndexer              cache.Indexer
+	backupLocationLister       listers.BackupStorageLocationLister
+	deleteBackupRequestIndexer cache.Indexer
+	deleteBackupRequestClient  velerov1client.DeleteBackupRequestsGetter
+}
+
+// NewRetentionController constructs a new retentionController.
+func NewRetentionController(
+	logger logrus.FieldLogger,
+	scheduleInformer informers.ScheduleInformer,
+	backupInformer informers.BackupInformer,
+	backupLocationInformer informers.BackupStorageLocationInformer,
+	deleteBackupRequestInformer informers.DeleteBackupRequestInformer,
+	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
+) Interface {
+	c := &retentionController{
+		genericController:          newGenericController("retention-controller", logger),
+		scheduleLister:             scheduleInformer.Lister(),
+		backupIndexer:              backupInformer.Informer().GetIndexer(),
+		backupLocationLister:       backupLocationInformer.Lister(),
+		deleteBackupRequestIndexer: deleteBackupRequestInformer.Informer().GetIndexer(),
+		deleteBackupRequestClient:  deleteBackupRequestClient,
+	}
+
+	addIndexers(backupInformer.Informer(), cache.Indexers{scheduleNameIndex: scheduleNameIndexFunc})
+	addIndexers(deleteBackupRequestInformer.Informer(), cache.Indexers{backupNameIndex: backupNameIndexFunc})
+
+	c.syncHandler = c.processSchedule
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters,
+		scheduleInformer.Informer().HasSynced,
+		backupInformer.Informer().HasSynced,
+		backupLocationInformer.Informer().HasSynced,
+		deleteBackupRequestInformer.Informer().HasSynced,
+	)
+
+	c.resyncPeriod = RetentionSyncPeriod
+	c.resyncFunc = c.enqueueAllSchedules
+
+	c.watch(scheduleInformer.Informer(), onCreateOrUpdate(nil))
+
+	// a schedule's retention is enforced again whenever one of its backups
+	// finishes, so that the backup that it makes redundant is deleted.
+	c.watchMapped(backupInformer.Informer(), backupSchedule, onUpdate(func(obj runtime.Object) bool {
+		backup := obj.(*velerov1api.Backup)
+		return backup.Labels[velerov1api.ScheduleNameLabel] != "" && countsTowardRetention(backup)
+	}))
+
+	return c
+}
+
+// backupSchedule returns the request for the schedule that created a backup.
+func backupSchedule(obj handler.MapObject) []reconcile.Request {
+	return []reconcile.Request{requestFor(obj.Meta.GetNamespace(), obj.Meta.GetLabels()[velerov1api.ScheduleNameLabel])}
+}
+
+// enqueueAllSchedules lists all schedules from cache and enqueues the ones
+// with retention policies.
+func (c *retentionController) enqueueAllSchedules() {
+	schedules, err := c.scheduleLister.List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("error listing schedules")
+		return
+	}
+
+	for _, schedule := range schedules {
+		if hasRetentionPolicy(schedule) {
+			c.enqueue(schedule)
+		}
+	}
+}
+
+func (c *retentionController) processSchedule(key string) error {
+	log := c.logger.WithField("schedule", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	schedule, err := c.scheduleLister.Schedules(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find schedule")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting schedule")
+	}
+
+	if !hasRetentionPolicy(schedule) {
+		return nil
+	}
+
+	location, err := pkgbackup.ParseTimezone(schedule.Spec.Timezone)
+	if err != nil {
+		log.WithError(err).Info("Schedule has an invalid timezone, skipping")
+		return nil
+	}
+
+	backups, err := backupsForSchedule(c.backupIndexer, ns, schedule.Name)
+	if err != nil {
+		return errors.Wrap(err, "error listing schedule's backups")
+	}
+
+	// the backups of each member cluster that the schedule backs up are
+	// retained separately.
+	byCluster := make(map[string][]*velerov1api.Backup)
+	for _, backup := range backups {
+		byCluster[backup.Spec.Cluster] = append(byCluster[backup.Spec.Cluster], backup)
+	}
+
+	for _, backups := range byCluster {
+		for _, backup := range unretainedBackups(*schedule.Spec.Retention, backups, location) {
+			if err := c.deleteBackup(schedule, backup, log.WithField("backup", backup.Name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteBackup creates a DeleteBackupRequest for a backup, unless it already
+// has a pending one or its location is read-only.
+func (c *retentionController) deleteBackup(schedule *velerov1api.Schedule, backup *velerov1api.Backup, log logrus.FieldLogger) error {
+	loc, err := c.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(backup.Spec.StorageLocation)
+	if apierrors.IsNotFound(err) {
+		log.Warnf("Backup cannot be deleted because backup storage location %s does not exist", backup.Spec.StorageLocation)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	if loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		log.Infof("Backup cannot be deleted because backup storage location %s is currently in read-only mode", loc.Name)
+		return nil
+	}
+
+	pending, err := hasPendingDeleteBackupRequest(c.deleteBackupRequestIndexer, backup)
+	if err != nil {
+		return err
+	}
+	if pending {
+		log.Debug("Backup already has a pending deletion request")
+		return nil
+	}
+
+	log.Info("Creating a new deletion request for backup that isn't kept by its schedule's retention policy")
+	req := pkgbackup.NewDeleteBackupRequest(backup.Name, string(backup.UID))
+	req.Spec.Reason = fmt.Sprintf("backup isn't kept by schedule %s's retention policy", schedule.Name)
+
+	if _, err := c.deleteBackupRequestClient.DeleteBackupRequests(backup.Namespace).Create(req); err != nil {
+		return errors.Wrap(err, "error creating DeleteBackupRequest")
+	}
+
+	return nil
+}
+
+// hasRetentionPolicy returns whether a schedule has a retention policy that
+// keeps any backups. A policy whose counts are all zero is ignored, rather
+// than deleting all of the schedule's backups.
+func hasRetentionPolicy(schedule *velerov1api.Schedule) bool {
+	policy := schedule.Spec.Retention
+	return policy != nil && (policy.KeepLast > 0 || policy.KeepDaily > 0 || policy.KeepWeekly > 0)
+}
+
+// countsTowardRetention returns whether a backup is counted by retention
+// policies. Backups that are still running are never deleted by them, and
+// failed backups are left to expire when their TTL does.
+func countsTowardRetention(backup *velerov1api.Backup) bool {
+	return backup.Status.Phase == velerov1api.BackupPhaseCompleted || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed
+}
+
+// backupTime returns the time that a backup was started, or created if it
+// hasn't been started.
+func backupTime(backup *velerov1api.Backup) time.Time {
+	if !backup.Status.StartTimestamp.IsZero() {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
+
+// unretainedBackups returns the backups that a retention policy doesn't keep,
+// of backups that count toward it. Days and weeks are in location's time zone.
+func unretainedBackups(policy velerov1api.RetentionPolicy, backups []*velerov1api.Backup, location *time.Location) []*velerov1api.Backup {
+	var candidates []*velerov1api.Backup
+	for _, backup := range backups {
+		if countsTowardRetention(backup) {
+			candidates = append(candidates, backup)
+		}
+	}
+
+	// newest first.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return backupTime(candidates[i]).After(backupTime(candidates[j]))
+	})
+
+	days := make(map[string]bool)
+	weeks := make(map[string]bool)
+
+	var unretained []*velerov1api.Backup
+	for i, backup := range candidates {
+		t := backupTime(backup).In(location)
+		keep := i < policy.KeepLast
+
+		day := t.Format("2006-01-02")
+		if !days[day] && len(days) < policy.KeepDaily {
+			days[day] = true
+			keep = true
+		}
+
+		year, week := t.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%d", year, week)
+		if !weeks[weekKey] && len(weeks) < policy.KeepWeekly {
+			weeks[weekKey] = true
+			keep = true
+		}
+
+		if !keep {
+			unretained = append(unretained, backup)
+		}
+	}
+
+	return unretained
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	core "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestUnretainedBackups(t *testing.T) {
+	// Monday, June 1st.
+	start := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// a backup every 12 hours for 3 weeks, named by their day and hour,
+	// e.g. 1-0 and 1-12.
+	var backups []*velerov1api.Backup
+	for ts := start; ts.Before(start.AddDate(0, 0, 21)); ts = ts.Add(12 * time.Hour) {
+		backups = append(backups, builder.ForBackup("velero", ts.Format("2-15")).
+			Phase(velerov1api.BackupPhaseCompleted).
+			StartTimestamp(ts).
+			Result())
+	}
+
+	tests := []struct {
+		name      string
+		policy    velerov1api.RetentionPolicy
+		backups   []*velerov1api.Backup
+		location  *time.Location
+		wantKept  int
+		wantNames []string
+	}{
+		{
+			name:     "keepLast keeps the most recent backups",
+			policy:   velerov1api.RetentionPolicy{KeepLast: 3},
+			backups:  backups,
+			wantKept: 3,
+		},
+		{
+			name:     "keepDaily keeps the last backup of the most recent days",
+			policy:   velerov1api.RetentionPolicy{KeepDaily: 2},
+			backups:  backups,
+			wantKept: 2,
+		},
+		{
+			name:     "keepWeekly keeps the last backup of the most recent weeks",
+			policy:   velerov1api.RetentionPolicy{KeepWeekly: 4},
+			backups:  backups,
+			wantKept: 3,
+		},
+		{
+			name:     "a backup that's kept by more than one count is counted by each of them",
+			policy:   velerov1api.RetentionPolicy{KeepLast: 2, KeepDaily: 2, KeepWeekly: 2},
+			backups:  backups,
+			wantKept: 4,
+		},
+		{
+			name:   "backups that are running or failed aren't counted or deleted",
+			policy: velerov1api.RetentionPolicy{KeepLast: 1},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("velero", "completed-1").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(start).Result(),
+				builder.ForBackup("velero", "partially-failed").Phase(velerov1api.BackupPhasePartiallyFailed).StartTimestamp(start.Add(time.Hour)).Result(),
+				builder.ForBackup("velero", "failed").Phase(velerov1api.BackupPhaseFailed).StartTimestamp(start.Add(2 * time.Hour)).Result(),
+				builder.ForBackup("velero", "in-progress").Phase(velerov1api.BackupPhaseInProgress).StartTimestamp(start.Add(3 * time.Hour)).Result(),
+			},
+			wantKept:  1,
+			wantNames: []string{"completed-1"},
+		},
+		{
+			name:   "days are in the given time zone",
+			policy: velerov1api.RetentionPolicy{KeepDaily: 2},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("velero", "1").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(start.Add(-4 * time.Hour)).Result(),
+				builder.ForBackup("velero", "2").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(start.Add(-2 * time.Hour)).Result(),
+				builder.ForBackup("velero", "3").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(start).Result(),
+			},
+			// 11pm on May 31st, and 1am and 3am on June 1st, in UTC+3.
+			location:  time.FixedZone("UTC+3", 3*60*60),
+			wantKept:  2,
+			wantNames: []string{"2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			location := tc.location
+			if location == nil {
+				location = time.UTC
+			}
+
+			unretained := unretainedBackups(tc.policy, tc.backups, location)
+
+			var counted int
+			for _, backup := range tc.backups {
+				if countsTowardRetention(backup) {
+					counted++
+				}
+			}
+			assert.Equal(t, tc.wantKept, counted-len(unretained))
+
+			if tc.wantNames != nil {
+				var names []string
+				for _, backup := range unretained {
+					names = append(names, backup.Name)
+				}
+				sort.Strings(names)
+				assert.Equal(t, tc.wantNames, names)
+			}
+		})
+	}
+}
+
+func TestRetentionControllerProcessSchedule(t *testing.T) {
+	start := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	backup := func(name, cluster string, ts time.Time) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).
+			Phase(velerov1api.BackupPhaseCompleted).
+			StorageLocation("default").
+			Cluster(cluster).
+			StartTimestamp(ts).
+			Result()
+	}
+
+	tests := []struct {
+		name        string
+		schedule    *velerov1api.Schedule
+		backups     []*velerov1api.Backup
+		location    *velerov1api.BackupStorageLocation
+		wantDeleted []string
+	}{
+		{
+			name:     "schedule without a retention policy deletes nothing",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Result(),
+			backups: []*velerov1api.Backup{
+				backup("backup-1", "", start),
+				backup("backup-2", "", start.Add(time.Hour)),
+			},
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+		},
+		{
+			name:     "backups beyond the retention policy are deleted",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(velerov1api.RetentionPolicy{KeepLast: 1}).Result(),
+			backups: []*velerov1api.Backup{
+				backup("backup-1", "", start),
+				backup("backup-2", "", start.Add(time.Hour)),
+				backup("backup-3", "", start.Add(2*time.Hour)),
+			},
+			location:    builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			wantDeleted: []string{"backup-1", "backup-2"},
+		},
+		{
+			name:     "member clusters' backups are retained separately",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(velerov1api.RetentionPolicy{KeepLast: 1}).Result(),
+			backups: []*velerov1api.Backup{
+				backup("backup-a-1", "cluster-a", start),
+				backup("backup-a-2", "cluster-a", start.Add(time.Hour)),
+				backup("backup-b-1", "cluster-b", start),
+			},
+			location:    builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			wantDeleted: []string{"backup-a-1"},
+		},
+		{
+			name:     "backups in a read-only location aren't deleted",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(velerov1api.RetentionPolicy{KeepLast: 1}).Result(),
+			backups: []*velerov1api.Backup{
+				backup("backup-1", "", start),
+				backup("backup-2", "", start.Add(time.Hour)),
+			},
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+			)
+
+			c := NewRetentionController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Schedules(),
+				sharedInformers.Velero().V1().Backups(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				sharedInformers.Velero().V1().DeleteBackupRequests(),
+				client.VeleroV1(),
+			).(*retentionController)
+
+			// deletion requests have generated names, which the fake client
+			// doesn't generate.
+			client.PrependReactor("create", "deletebackuprequests", func(action core.Action) (bool, runtime.Object, error) {
+				return true, action.(core.CreateAction).GetObject(), nil
+			})
+
+			require.NoError(t, sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(tc.schedule))
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(tc.location))
+			for _, backup := range tc.backups {
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			}
+
+			require.NoError(t, c.processSchedule(velerov1api.DefaultNamespace+"/schedule-1"))
+
+			var deleted []string
+			for _, action := range client.Actions() {
+				createAction, ok := action.(core.CreateAction)
+				require.True(t, ok)
+
+				req, ok := createAction.GetObject().(*velerov1api.DeleteBackupRequest)
+				require.True(t, ok)
+				assert.Empty(t, req.Spec.Requester)
+				deleted = append(deleted, req.Spec.BackupName)
+			}
+			sort.Strings(deleted)
+
+			assert.Equal(t, tc.wantDeleted, deleted)
+		})
+	}
+}
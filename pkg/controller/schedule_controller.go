@@ -17,26 +17,29 @@ limitations under the License.
 package controller
 
 import (
-	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/robfig/cron"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/client-go/tools/cache"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/membercluster"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -54,6 +57,7 @@ type scheduleController struct {
 	schedulesLister listers.ScheduleLister
 	clock           clock.Clock
 	metrics         *metrics.ServerMetrics
+	memberClusters  membercluster.Registry
 }
 
 func NewScheduleController(
@@ -63,6 +67,7 @@ func NewScheduleController(
 	schedulesInformer informers.ScheduleInformer,
 	logger logrus.FieldLogger,
 	metrics *metrics.ServerMetrics,
+	memberClusters membercluster.Registry,
 ) *scheduleController {
 	c := &scheduleController{
 		genericController: newGenericController("schedule", logger),
@@ -72,6 +77,7 @@ func NewScheduleController(
 		schedulesLister:   schedulesInformer.Lister(),
 		clock:             clock.RealClock{},
 		metrics:           metrics,
+		memberClusters:    memberClusters,
 	}
 
 	c.syncHandler = c.processSchedule
@@ -79,35 +85,27 @@ func NewScheduleController(
 	c.resyncFunc = c.enqueueAllEnabledSchedules
 	c.resyncPeriod = scheduleSyncPeriod
 
-	schedulesInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				schedule := obj.(*api.Schedule)
-
-				switch schedule.Status.Phase {
-				case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled:
-					// add to work queue
-				default:
-					c.logger.WithFields(logrus.Fields{
-						"schedule": kubeutil.NamespaceAndName(schedule),
-						"phase":    schedule.Status.Phase,
-					}).Debug("Schedule is not new, skipping")
-					return
-				}
-
-				key, err := cache.MetaNamespaceKeyFunc(schedule)
-				if err != nil {
-					c.logger.WithError(errors.WithStack(err)).WithField("schedule", schedule).Error("Error creating queue key, item not added to queue")
-					return
-				}
-				c.queue.Add(key)
-				scheduleName := schedule.GetName()
-				c.logger.Info("Creating schedule ", scheduleName)
-				//Init Prometheus metrics to 0 to have them flowing up
-				metrics.InitSchedule(scheduleName)
-			},
-		},
-	)
+	c.watch(schedulesInformer.Informer(), onCreate(func(obj runtime.Object) bool {
+		schedule := obj.(*api.Schedule)
+
+		switch schedule.Status.Phase {
+		case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled:
+			// add to work queue
+		default:
+			c.logger.WithFields(logrus.Fields{
+				"schedule": kubeutil.NamespaceAndName(schedule),
+				"phase":    schedule.Status.Phase,
+			}).Debug("Schedule is not new, skipping")
+			return false
+		}
+
+		scheduleName := schedule.GetName()
+		c.logger.Info("Creating schedule ", scheduleName)
+		//Init Prometheus metrics to 0 to have them flowing up
+		metrics.InitSchedule(scheduleName)
+
+		return true
+	}))
 
 	return c
 }
@@ -124,12 +122,7 @@ func (c *scheduleController) enqueueAllEnabledSchedules() {
 			continue
 		}
 
-		key, err := cache.MetaNamespaceKeyFunc(schedule)
-		if err != nil {
-			c.logger.WithError(errors.WithStack(err)).WithField("schedule", schedule).Error("Error creating queue key, item not added to queue")
-			continue
-		}
-		c.queue.Add(key)
+		c.enqueue(schedule)
 	}
 }
 
@@ -171,6 +164,9 @@ func (c *scheduleController) processSchedule(key string) error {
 	currentPhase := schedule.Status.Phase
 
 	cronSchedule, errs := parseCronSchedule(schedule, c.logger)
+	if schedule.Spec.VerifyEvery < 0 {
+		errs = append(errs, "VerifyEvery must be zero or a positive number")
+	}
 	if len(errs) > 0 {
 		schedule.Status.Phase = api.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
@@ -178,8 +174,14 @@ func (c *scheduleController) processSchedule(key string) error {
 		schedule.Status.Phase = api.SchedulePhaseEnabled
 	}
 
+	// record a revision if the backup template of a valid schedule has changed
+	revised := schedule.Status.Phase == api.SchedulePhaseEnabled && recordScheduleRevision(schedule, c.clock.Now())
+	if revised {
+		log.WithField("revision", latestScheduleRevision(schedule)).Info("Schedule's backup template changed, recorded a new revision")
+	}
+
 	// update status if it's changed
-	if currentPhase != schedule.Status.Phase {
+	if currentPhase != schedule.Status.Phase || revised {
 		updatedSchedule, err := patchSchedule(original, schedule, c.schedulesClient)
 		if err != nil {
 			return errors.Wrapf(err, "error updating Schedule phase to %s", schedule.Status.Phase)
@@ -191,6 +193,11 @@ func (c *scheduleController) processSchedule(key string) error {
 		return nil
 	}
 
+	if schedule.Spec.Paused {
+		log.Debug("Schedule is paused, skipping")
+		return nil
+	}
+
 	// check for the schedule being due to run, and submit a Backup if so
 	if err := c.submitBackupIfDue(schedule, cronSchedule); err != nil {
 		return err
@@ -200,43 +207,26 @@ func (c *scheduleController) processSchedule(key string) error {
 }
 
 func parseCronSchedule(itm *api.Schedule, logger logrus.FieldLogger) (cron.Schedule, []string) {
-	var validationErrors []string
-	var schedule cron.Schedule
-
-	// cron.Parse panics if schedule is empty
-	if len(itm.Spec.Schedule) == 0 {
-		validationErrors = append(validationErrors, "Schedule must be a non-empty valid Cron expression")
-		return nil, validationErrors
+	schedule, err := pkgbackup.ParseCronSchedule(itm.Spec.Schedule)
+	if err != nil {
+		logger.WithError(err).WithFields(logrus.Fields{
+			"schedule": kubeutil.NamespaceAndName(itm),
+			"cron":     itm.Spec.Schedule,
+		}).Debug("Error parsing schedule")
+		return nil, []string{err.Error()}
 	}
 
-	log := logger.WithField("schedule", kubeutil.NamespaceAndName(itm))
-
-	// adding a recover() around cron.Parse because it panics on empty string and is possible
-	// that it panics under other scenarios as well.
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				log.WithFields(logrus.Fields{
-					"schedule": itm.Spec.Schedule,
-					"recover":  r,
-				}).Debug("Panic parsing schedule")
-				validationErrors = append(validationErrors, fmt.Sprintf("invalid schedule: %v", r))
-			}
-		}()
-
-		if res, err := cron.ParseStandard(itm.Spec.Schedule); err != nil {
-			log.WithError(errors.WithStack(err)).WithField("schedule", itm.Spec.Schedule).Debug("Error parsing schedule")
-			validationErrors = append(validationErrors, fmt.Sprintf("invalid schedule: %v", err))
-		} else {
-			schedule = res
-		}
-	}()
+	if itm.Spec.Timezone == "" {
+		return schedule, nil
+	}
 
-	if len(validationErrors) > 0 {
-		return nil, validationErrors
+	location, err := pkgbackup.ParseTimezone(itm.Spec.Timezone)
+	if err != nil {
+		logger.WithError(err).WithField("schedule", kubeutil.NamespaceAndName(itm)).Debug("Error parsing schedule's timezone")
+		return nil, []string{err.Error()}
 	}
 
-	return schedule, nil
+	return pkgbackup.InLocation(schedule, location), nil
 }
 
 func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule cron.Schedule) error {
@@ -258,13 +248,45 @@ func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule
 	// backups so that we don't overlap runs (for disk snapshots in particular, this can
 	// lead to performance issues).
 	log.WithField("nextRunTime", nextRunTime).Info("Schedule is due, submitting Backup")
-	backup := getBackup(item, now)
-	if _, err := c.backupsClient.Backups(backup.Namespace).Create(backup); err != nil {
-		return errors.Wrap(err, "error creating Backup")
-	}
-
 	original := item
 	schedule := item.DeepCopy()
+	schedule.Status.BackupCount++
+
+	backups := []*api.Backup{getBackup(item, now)}
+	if len(item.Spec.Clusters) > 0 {
+		var err error
+		if backups, err = c.getMemberClusterBackups(item, now); err != nil {
+			return err
+		}
+		if len(backups) == 0 {
+			log.Warn("Schedule has no registered member clusters to back up")
+		}
+	}
+
+	verify := shouldVerifyBackup(schedule)
+	if verify {
+		log.Info("Backup will be verified after it is uploaded")
+	}
+
+	revision := latestScheduleRevision(item)
+
+	for _, backup := range backups {
+		if backup.Annotations == nil {
+			backup.Annotations = make(map[string]string)
+		}
+		if verify {
+			backup.Annotations[api.VerifyBackupAnnotation] = "true"
+		}
+		if revision > 0 {
+			backup.Annotations[api.ScheduleRevisionAnnotation] = strconv.Itoa(revision)
+		}
+
+		// a backup that already exists was created by an earlier attempt
+		// to submit this run's backups.
+		if _, err := c.backupsClient.Backups(backup.Namespace).Create(backup); err != nil && !apierrors.IsAlreadyExists(err) {
+			return errors.Wrap(err, "error creating Backup")
+		}
+	}
 
 	schedule.Status.LastBackup = metav1.NewTime(now)
 
@@ -275,6 +297,42 @@ func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule
 	return nil
 }
 
+// maxScheduleRevisions is the number of revisions of a schedule's backup
+// template that are kept in its status.
+const maxScheduleRevisions = 10
+
+// recordScheduleRevision records a revision of a schedule's backup template
+// in its status if the template differs from the latest revision's, keeping
+// the most recent maxScheduleRevisions. It returns whether a revision was
+// recorded.
+func recordScheduleRevision(schedule *api.Schedule, now time.Time) bool {
+	revisions := schedule.Status.Revisions
+	if len(revisions) > 0 && equality.Semantic.DeepEqual(revisions[len(revisions)-1].Template, schedule.Spec.Template) {
+		return false
+	}
+
+	revisions = append(revisions, api.ScheduleRevision{
+		Revision:  latestScheduleRevision(schedule) + 1,
+		Timestamp: metav1.NewTime(now),
+		Template:  *schedule.Spec.Template.DeepCopy(),
+	})
+	if len(revisions) > maxScheduleRevisions {
+		revisions = revisions[len(revisions)-maxScheduleRevisions:]
+	}
+	schedule.Status.Revisions = revisions
+
+	return true
+}
+
+// latestScheduleRevision returns the number of the latest revision of a
+// schedule's backup template, or 0 if none has been recorded.
+func latestScheduleRevision(schedule *api.Schedule) int {
+	if len(schedule.Status.Revisions) == 0 {
+		return 0
+	}
+	return schedule.Status.Revisions[len(schedule.Status.Revisions)-1].Revision
+}
+
 func getNextRunTime(schedule *api.Schedule, cronSchedule cron.Schedule, asOf time.Time) (bool, time.Time) {
 	// get the latest run time (if the schedule hasn't run yet, this will be the zero value which will trigger
 	// an immediate backup)
@@ -285,6 +343,16 @@ func getNextRunTime(schedule *api.Schedule, cronSchedule cron.Schedule, asOf tim
 	return asOf.After(nextRunTime), nextRunTime
 }
 
+// shouldVerifyBackup returns true if the schedule's most recently counted
+// backup is one of every Nth backups that should be verified.
+func shouldVerifyBackup(schedule *api.Schedule) bool {
+	if schedule.Spec.VerifyEvery <= 0 {
+		return false
+	}
+
+	return schedule.Status.BackupCount%schedule.Spec.VerifyEvery == 0
+}
+
 func getBackup(item *api.Schedule, timestamp time.Time) *api.Backup {
 	name := fmt.Sprintf("%s-%s", item.Name, timestamp.Format("20060102150405"))
 	backup := builder.
@@ -295,23 +363,37 @@ func getBackup(item *api.Schedule, timestamp time.Time) *api.Backup {
 	return backup
 }
 
-func patchSchedule(original, updated *api.Schedule, client velerov1client.SchedulesGetter) (*api.Schedule, error) {
-	origBytes, err := json.Marshal(original)
-	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling original schedule")
+// getMemberClusterBackups returns a backup of each of the member clusters
+// that a schedule backs up.
+func (c *scheduleController) getMemberClusterBackups(item *api.Schedule, timestamp time.Time) ([]*api.Backup, error) {
+	if c.memberClusters == nil {
+		return nil, errors.New("schedule backs up member clusters, but this server doesn't back up member clusters")
 	}
 
-	updatedBytes, err := json.Marshal(updated)
+	clusters, err := membercluster.Resolve(c.memberClusters, item.Spec.Clusters)
 	if err != nil {
-		return nil, errors.Wrap(err, "error marshalling updated schedule")
+		return nil, err
 	}
 
-	patchBytes, err := jsonpatch.CreateMergePatch(origBytes, updatedBytes)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating json merge patch for schedule")
+	var backups []*api.Backup
+	for _, cluster := range clusters {
+		name := fmt.Sprintf("%s-%s-%s", item.Name, cluster, timestamp.Format("20060102150405"))
+		backups = append(backups, builder.
+			ForBackup(item.Namespace, name).
+			FromSchedule(item.DeepCopy()).
+			Cluster(cluster).
+			Result())
 	}
 
-	res, err := client.Schedules(original.Namespace).Patch(original.Name, types.MergePatchType, patchBytes)
+	return backups, nil
+}
+
+func patchSchedule(original, updated *api.Schedule, client velerov1client.SchedulesGetter) (*api.Schedule, error) {
+	var res *api.Schedule
+	err := kubeutil.Patch(original, updated, kubeutil.PatchOptions{}, func(patchType types.PatchType, data []byte) (err error) {
+		res, err = client.Schedules(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error patching schedule")
 	}
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/rest"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/membercluster"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -52,6 +54,7 @@ func TestProcessSchedule(t *testing.T) {
 		expectedErr              bool
 		expectedPhase            string
 		expectedValidationErrors []string
+		expectedRevisions        []velerov1api.ScheduleRevision
 		expectedBackupCreate     *velerov1api.Backup
 		expectedLastBackup       string
 	}{
@@ -92,29 +95,116 @@ func TestProcessSchedule(t *testing.T) {
 			expectedValidationErrors: []string{"Schedule must be a non-empty valid Cron expression"},
 		},
 		{
-			name:                 "schedule with phase New gets validated and triggers a backup",
-			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Result(),
-			fakeClockTime:        "2017-01-01 12:00:00",
-			expectedErr:          false,
-			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
-			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
-			expectedLastBackup:   "2017-01-01 12:00:00",
+			name:              "schedule with phase New gets validated and triggers a backup",
+			schedule:          newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Result(),
+			fakeClockTime:     "2017-01-01 12:00:00",
+			expectedErr:       false,
+			expectedPhase:     string(velerov1api.SchedulePhaseEnabled),
+			expectedRevisions: []velerov1api.ScheduleRevision{{Revision: 1, Timestamp: metav1.NewTime(parseTime("2017-01-01 12:00:00"))}},
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "name"),
+				builder.WithAnnotations(velerov1api.ScheduleRevisionAnnotation, "1"),
+			).Result(),
+			expectedLastBackup: "2017-01-01 12:00:00",
 		},
 		{
-			name:                 "schedule with phase Enabled gets re-validated and triggers a backup if valid",
-			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").Result(),
-			fakeClockTime:        "2017-01-01 12:00:00",
-			expectedErr:          false,
-			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
-			expectedLastBackup:   "2017-01-01 12:00:00",
+			name:              "schedule with phase Enabled gets re-validated and triggers a backup if valid",
+			schedule:          newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").Result(),
+			fakeClockTime:     "2017-01-01 12:00:00",
+			expectedErr:       false,
+			expectedRevisions: []velerov1api.ScheduleRevision{{Revision: 1, Timestamp: metav1.NewTime(parseTime("2017-01-01 12:00:00"))}},
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "name"),
+				builder.WithAnnotations(velerov1api.ScheduleRevisionAnnotation, "1"),
+			).Result(),
+			expectedLastBackup: "2017-01-01 12:00:00",
 		},
 		{
-			name:                 "schedule that's already run gets LastBackup updated",
-			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Result(),
-			fakeClockTime:        "2017-01-01 12:00:00",
-			expectedErr:          false,
-			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
-			expectedLastBackup:   "2017-01-01 12:00:00",
+			name:              "schedule that's already run gets LastBackup updated",
+			schedule:          newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime:     "2017-01-01 12:00:00",
+			expectedErr:       false,
+			expectedRevisions: []velerov1api.ScheduleRevision{{Revision: 1, Timestamp: metav1.NewTime(parseTime("2017-01-01 12:00:00"))}},
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "name"),
+				builder.WithAnnotations(velerov1api.ScheduleRevisionAnnotation, "1"),
+			).Result(),
+			expectedLastBackup: "2017-01-01 12:00:00",
+		},
+		{
+			name:              "paused schedule doesn't trigger a backup",
+			schedule:          newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Paused(true).Result(),
+			fakeClockTime:     "2017-01-01 12:00:00",
+			expectedErr:       false,
+			expectedRevisions: []velerov1api.ScheduleRevision{{Revision: 1, Timestamp: metav1.NewTime(parseTime("2017-01-01 12:00:00"))}},
+		},
+		{
+			name:              "schedule with VerifyEvery annotates every Nth backup for verification",
+			schedule:          newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").VerifyEvery(3).BackupCount(2).Result(),
+			fakeClockTime:     "2017-01-01 12:00:00",
+			expectedErr:       false,
+			expectedRevisions: []velerov1api.ScheduleRevision{{Revision: 1, Timestamp: metav1.NewTime(parseTime("2017-01-01 12:00:00"))}},
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "name"),
+				builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true", velerov1api.ScheduleRevisionAnnotation, "1"),
+			).Result(),
+			expectedLastBackup: "2017-01-01 12:00:00",
+		},
+		{
+			name:              "schedule with VerifyEvery does not annotate backups between verifications",
+			schedule:          newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").VerifyEvery(3).BackupCount(3).Result(),
+			fakeClockTime:     "2017-01-01 12:00:00",
+			expectedErr:       false,
+			expectedRevisions: []velerov1api.ScheduleRevision{{Revision: 1, Timestamp: metav1.NewTime(parseTime("2017-01-01 12:00:00"))}},
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "name"),
+				builder.WithAnnotations(velerov1api.ScheduleRevisionAnnotation, "1"),
+			).Result(),
+			expectedLastBackup: "2017-01-01 12:00:00",
+		},
+		{
+			name: "schedule whose template hasn't changed doesn't record a revision",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").
+				Template(velerov1api.BackupSpec{IncludedNamespaces: []string{"ns-1"}}).
+				Revisions(velerov1api.ScheduleRevision{Revision: 3, Template: velerov1api.BackupSpec{IncludedNamespaces: []string{"ns-1"}}}).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   false,
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").IncludedNamespaces("ns-1").ObjectMeta(
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "name"),
+				builder.WithAnnotations(velerov1api.ScheduleRevisionAnnotation, "3"),
+			).Result(),
+			expectedLastBackup: "2017-01-01 12:00:00",
+		},
+		{
+			name: "schedule whose template has changed records a revision",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").
+				Template(velerov1api.BackupSpec{IncludedNamespaces: []string{"ns-2"}}).
+				Revisions(velerov1api.ScheduleRevision{Revision: 3, Template: velerov1api.BackupSpec{IncludedNamespaces: []string{"ns-1"}}}).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   false,
+			expectedRevisions: []velerov1api.ScheduleRevision{
+				{Revision: 3, Template: velerov1api.BackupSpec{IncludedNamespaces: []string{"ns-1"}}},
+				{Revision: 4, Timestamp: metav1.NewTime(parseTime("2017-01-01 12:00:00")), Template: velerov1api.BackupSpec{IncludedNamespaces: []string{"ns-2"}}},
+			},
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").IncludedNamespaces("ns-2").ObjectMeta(
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "name"),
+				builder.WithAnnotations(velerov1api.ScheduleRevisionAnnotation, "4"),
+			).Result(),
+			expectedLastBackup: "2017-01-01 12:00:00",
+		},
+		{
+			name:                     "schedule with negative VerifyEvery fails validation",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").VerifyEvery(-1).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"VerifyEvery must be zero or a positive number"},
+		},
+		{
+			name:                     "schedule with an unknown timezone fails validation",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Timezone("Mars/Olympus_Mons").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid timezone "Mars/Olympus_Mons": unknown time zone Mars/Olympus_Mons`},
 		},
 	}
 
@@ -133,6 +223,7 @@ func TestProcessSchedule(t *testing.T) {
 				sharedInformers.Velero().V1().Schedules(),
 				logger,
 				metrics.NewServerMetrics(),
+				nil,
 			)
 
 			var (
@@ -177,6 +268,15 @@ func TestProcessSchedule(t *testing.T) {
 						res.Status.LastBackup = metav1.Time{Time: parsed}
 					}
 
+					var revisionsPatch struct {
+						Status struct {
+							Revisions []velerov1api.ScheduleRevision `json:"revisions"`
+						} `json:"status"`
+					}
+					if err := json.Unmarshal(patch, &revisionsPatch); err == nil && revisionsPatch.Status.Revisions != nil {
+						res.Status.Revisions = revisionsPatch.Status.Revisions
+					}
+
 					return true, res, nil
 				})
 			}
@@ -195,9 +295,11 @@ func TestProcessSchedule(t *testing.T) {
 			index := 0
 
 			type PatchStatus struct {
-				ValidationErrors []string                  `json:"validationErrors"`
-				Phase            velerov1api.SchedulePhase `json:"phase"`
-				LastBackup       time.Time                 `json:"lastBackup"`
+				ValidationErrors []string                       `json:"validationErrors"`
+				Phase            velerov1api.SchedulePhase      `json:"phase"`
+				LastBackup       time.Time                      `json:"lastBackup"`
+				BackupCount      int                            `json:"backupCount"`
+				Revisions        []velerov1api.ScheduleRevision `json:"revisions"`
 			}
 
 			type Patch struct {
@@ -211,13 +313,14 @@ func TestProcessSchedule(t *testing.T) {
 				return *actual, err
 			}
 
-			if test.expectedPhase != "" {
+			if test.expectedPhase != "" || test.expectedRevisions != nil {
 				require.True(t, len(actions) > index, "len(actions) is too small")
 
 				expected := Patch{
 					Status: PatchStatus{
 						ValidationErrors: test.expectedValidationErrors,
 						Phase:            velerov1api.SchedulePhase(test.expectedPhase),
+						Revisions:        test.expectedRevisions,
 					},
 				}
 
@@ -237,6 +340,10 @@ func TestProcessSchedule(t *testing.T) {
 				assert.Equal(t, action, actions[index])
 
 				index++
+			} else {
+				for _, action := range actions {
+					assert.NotEqual(t, "create", action.GetVerb(), "no backup should be created")
+				}
 			}
 
 			if test.expectedLastBackup != "" {
@@ -244,7 +351,8 @@ func TestProcessSchedule(t *testing.T) {
 
 				expected := Patch{
 					Status: PatchStatus{
-						LastBackup: parseTime(test.expectedLastBackup),
+						LastBackup:  parseTime(test.expectedLastBackup),
+						BackupCount: test.schedule.Status.BackupCount + 1,
 					},
 				}
 
@@ -380,6 +488,24 @@ func TestParseCronSchedule(t *testing.T) {
 	assert.Equal(t, time.Date(2017, 8, 12, 9, 0, 0, 0, time.UTC), next)
 }
 
+func TestParseCronScheduleWithTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// 2017-08-10 12:27 UTC is 08:27 in New York, so a schedule of 9am New
+	// York time is next due at 13:00 UTC the same day.
+	now := time.Date(2017, 8, 10, 12, 27, 0, 0, time.UTC)
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 9 * * *").Timezone("America/New_York").LastBackupTime(now.Format("2006-01-02 15:04:05")).Result()
+
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	due, next := getNextRunTime(s, c, now)
+	assert.False(t, due)
+	assert.Equal(t, time.Date(2017, 8, 10, 9, 0, 0, 0, newYork), next)
+	assert.True(t, next.Equal(time.Date(2017, 8, 10, 13, 0, 0, 0, time.UTC)))
+}
+
 func TestGetBackup(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -445,3 +571,69 @@ func TestGetBackup(t *testing.T) {
 		})
 	}
 }
+
+type fakeMemberClusters []string
+
+func (f fakeMemberClusters) List() ([]string, error) {
+	return f, nil
+}
+
+func (f fakeMemberClusters) RESTConfig(name string) (*rest.Config, error) {
+	return &rest.Config{Host: name}, nil
+}
+
+func TestGetMemberClusterBackups(t *testing.T) {
+	now := time.Date(2017, 7, 25, 9, 15, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		clusters       []string
+		memberClusters membercluster.Registry
+		want           []string
+		wantErr        bool
+	}{
+		{
+			name:           "each listed cluster gets a backup",
+			clusters:       []string{"cluster-2", "cluster-1"},
+			memberClusters: fakeMemberClusters{"cluster-1", "cluster-2", "cluster-3"},
+			want:           []string{"cluster-2", "cluster-1"},
+		},
+		{
+			name:           "all clusters are backed up with '*', without duplicates",
+			clusters:       []string{"cluster-2", "*"},
+			memberClusters: fakeMemberClusters{"cluster-1", "cluster-2", "cluster-3"},
+			want:           []string{"cluster-2", "cluster-1", "cluster-3"},
+		},
+		{
+			name:     "a server without member clusters is an error",
+			clusters: []string{"cluster-1"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &scheduleController{memberClusters: tc.memberClusters}
+
+			schedule := builder.ForSchedule("ns", "daily").Clusters(tc.clusters...).
+				Template(builder.ForBackup("", "").IncludedNamespaces("ns-1").Result().Spec).Result()
+
+			backups, err := c.getMemberClusterBackups(schedule, now)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var clusters []string
+			for _, backup := range backups {
+				clusters = append(clusters, backup.Spec.Cluster)
+
+				assert.Equal(t, "daily-"+backup.Spec.Cluster+"-20170725091500", backup.Name)
+				assert.Equal(t, "daily", backup.Labels[velerov1api.ScheduleNameLabel])
+				assert.Equal(t, []string{"ns-1"}, backup.Spec.IncludedNamespaces)
+			}
+			assert.Equal(t, tc.want, clusters)
+		})
+	}
+}
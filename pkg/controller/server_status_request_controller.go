@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/client-go/tools/cache"
 
@@ -67,21 +68,16 @@ func NewServerStatusRequestController(
 	c.resyncFunc = c.enqueueAllItems
 	c.resyncPeriod = statusRequestResyncPeriod
 
-	informer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				req := obj.(*velerov1api.ServerStatusRequest)
-				key := kubeutil.NamespaceAndName(req)
-
-				c.logger.WithFields(logrus.Fields{
-					"serverStatusRequest": key,
-					"phase":               req.Status.Phase,
-				}).Debug("Enqueueing server status request")
-
-				c.queue.Add(key)
-			},
-		},
-	)
+	c.watch(informer.Informer(), onCreate(func(obj runtime.Object) bool {
+		req := obj.(*velerov1api.ServerStatusRequest)
+
+		c.logger.WithFields(logrus.Fields{
+			"serverStatusRequest": kubeutil.NamespaceAndName(req),
+			"phase":               req.Status.Phase,
+		}).Debug("Enqueueing server status request")
+
+		return true
+	}))
 
 	return c
 }
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// FileStore gets the contents of secret keys as files, for plugins that
+// read their credentials from a file.
+type FileStore interface {
+	// Path returns the path of a file with the contents of the secret key
+	// that selector selects.
+	Path(selector *corev1api.SecretKeySelector) (string, error)
+}
+
+type namespacedFileStore struct {
+	secrets   corev1client.SecretsGetter
+	namespace string
+	fsRoot    string
+	fs        filesystem.Interface
+}
+
+// NewNamespacedFileStore returns a FileStore that gets secret keys from
+// secrets in namespace, and writes them to files under fsRoot. A key's file
+// is replaced each time its path is gotten if the secret has changed, so that
+// changes to the secret are picked up.
+func NewNamespacedFileStore(secrets corev1client.SecretsGetter, namespace, fsRoot string, fs filesystem.Interface) FileStore {
+	return &namespacedFileStore{
+		secrets:   secrets,
+		namespace: namespace,
+		fsRoot:    fsRoot,
+		fs:        fs,
+	}
+}
+
+func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (string, error) {
+	secret, err := n.secrets.Secrets(n.namespace).Get(selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting secret %s/%s", n.namespace, selector.Name)
+	}
+
+	data, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", errors.Errorf("secret %s/%s has no key %q", n.namespace, selector.Name, selector.Key)
+	}
+
+	dir := filepath.Join(n.fsRoot, n.namespace)
+	if err := n.fs.MkdirAll(dir, 0700); err != nil {
+		return "", errors.Wrapf(err, "error creating credentials directory %s", dir)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s", selector.Name, selector.Key))
+	if existing, err := n.fs.ReadFile(path); err == nil && bytes.Equal(existing, data) {
+		return path, nil
+	}
+
+	// the file may be read by plugins at any time, so it's replaced by
+	// renaming a complete file over it rather than rewritten in place. Temp
+	// files are only readable by the server's user.
+	file, err := n.fs.TempFile(dir, filepath.Base(path)+".")
+	if err != nil {
+		return "", errors.Wrapf(err, "error creating temp file for credentials file %s", path)
+	}
+
+	if _, err := file.Write(data); err != nil {
+		// we're already returning an error about the write failing.
+		file.Close()
+		n.fs.RemoveAll(file.Name())
+		return "", errors.Wrapf(err, "error writing credentials file %s", path)
+	}
+
+	if err := file.Close(); err != nil {
+		n.fs.RemoveAll(file.Name())
+		return "", errors.Wrapf(err, "error closing credentials file %s", path)
+	}
+
+	if err := n.fs.Rename(file.Name(), path); err != nil {
+		n.fs.RemoveAll(file.Name())
+		return "", errors.Wrapf(err, "error replacing credentials file %s", path)
+	}
+
+	return path, nil
+}
+
+// FileGetter is implemented by plugin managers that can get the credentials
+// files of locations' ObjectStore and VolumeSnapshotter plugins.
+type FileGetter interface {
+	GetCredentialsFile(selector *corev1api.SecretKeySelector) (string, error)
+}
+
+// ConfigWithCredentialsFile returns a copy of a location's config with the
+// path of a file with its credential's contents added under
+// velero.CredentialsFileConfigKey, so that plugins can authenticate with it.
+// config is returned as it is if credential is nil. pluginGetter is the plugin
+// manager that the location's plugin was gotten from, and a location can only
+// have a credential if it's a FileGetter.
+func ConfigWithCredentialsFile(config map[string]string, credential *corev1api.SecretKeySelector, pluginGetter interface{}) (map[string]string, error) {
+	if credential == nil {
+		return config, nil
+	}
+
+	fileGetter, ok := pluginGetter.(FileGetter)
+	if !ok {
+		return nil, errors.New("location has a credential, but its plugin's credentials file can't be gotten")
+	}
+
+	path, err := fileGetter.GetCredentialsFile(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(config)+1)
+	for k, v := range config {
+		res[k] = v
+	}
+	res[velero.CredentialsFileConfigKey] = path
+
+	return res, nil
+}
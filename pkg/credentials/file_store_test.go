@@ -0,0 +1,162 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+func selector(name, key string) *corev1api.SecretKeySelector {
+	return &corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+		Key:                  key,
+	}
+}
+
+func TestNamespacedFileStorePath(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "account-2"},
+		Data:       map[string][]byte{"cloud": []byte("[default]\naws_access_key_id=key-2\n")},
+	})
+	fs := velerotest.NewFakeFileSystem()
+	store := NewNamespacedFileStore(client.CoreV1(), "velero", "/tmp/credentials", fs)
+
+	path, err := store.Path(selector("account-2", "cloud"))
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/credentials/velero/account-2-cloud", path)
+
+	data, err := fs.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "[default]\naws_access_key_id=key-2\n", string(data))
+
+	_, err = store.Path(selector("account-2", "missing"))
+	assert.EqualError(t, err, `secret velero/account-2 has no key "missing"`)
+
+	_, err = store.Path(selector("account-3", "cloud"))
+	assert.Error(t, err)
+}
+
+// countingFileSystem counts the temp files that are created in it.
+type countingFileSystem struct {
+	*velerotest.FakeFileSystem
+	tempFiles int
+}
+
+func (fs *countingFileSystem) TempFile(dir, prefix string) (filesystem.NameWriteCloser, error) {
+	fs.tempFiles++
+	return fs.FakeFileSystem.TempFile(dir, prefix)
+}
+
+func TestNamespacedFileStorePathReplacesChangedFiles(t *testing.T) {
+	secret := &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "account-2"},
+		Data:       map[string][]byte{"cloud": []byte("key-2")},
+	}
+	client := fake.NewSimpleClientset(secret)
+	fs := &countingFileSystem{FakeFileSystem: velerotest.NewFakeFileSystem()}
+	store := NewNamespacedFileStore(client.CoreV1(), "velero", "/tmp/credentials", fs)
+
+	path, err := store.Path(selector("account-2", "cloud"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, fs.tempFiles)
+
+	// the file isn't rewritten if the secret hasn't changed.
+	_, err = store.Path(selector("account-2", "cloud"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, fs.tempFiles)
+
+	// it's replaced if it has, and the temp file is renamed over it.
+	secret.Data["cloud"] = []byte("key-3")
+	_, err = client.CoreV1().Secrets("velero").Update(secret)
+	require.NoError(t, err)
+
+	_, err = store.Path(selector("account-2", "cloud"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, fs.tempFiles)
+
+	data, err := fs.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "key-3", string(data))
+
+	files, err := fs.ReadDir("/tmp/credentials/velero")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "account-2-cloud", files[0].Name())
+}
+
+func TestNamespacedFileStorePathFileMode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	client := fake.NewSimpleClientset(&corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "account-2"},
+		Data:       map[string][]byte{"cloud": []byte("key-2")},
+	})
+	store := NewNamespacedFileStore(client.CoreV1(), "velero", dir, filesystem.NewFileSystem())
+
+	path, err := store.Path(selector("account-2", "cloud"))
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+type fakeFileGetter map[string]string
+
+func (g fakeFileGetter) GetCredentialsFile(selector *corev1api.SecretKeySelector) (string, error) {
+	path, ok := g[selector.Name]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+	return path, nil
+}
+
+func TestConfigWithCredentialsFile(t *testing.T) {
+	config := map[string]string{"region": "us-east-1"}
+	getter := fakeFileGetter{"account-2": "/tmp/credentials/velero/account-2-cloud"}
+
+	// locations without a credential keep their config
+	res, err := ConfigWithCredentialsFile(config, nil, getter)
+	require.NoError(t, err)
+	assert.Equal(t, config, res)
+
+	res, err = ConfigWithCredentialsFile(config, selector("account-2", "cloud"), getter)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-east-1", velero.CredentialsFileConfigKey: "/tmp/credentials/velero/account-2-cloud"}, res)
+	assert.Equal(t, map[string]string{"region": "us-east-1"}, config)
+
+	_, err = ConfigWithCredentialsFile(config, selector("account-3", "cloud"), getter)
+	assert.EqualError(t, err, "secret not found")
+
+	_, err = ConfigWithCredentialsFile(config, selector("account-2", "cloud"), struct{}{})
+	assert.Error(t, err)
+}
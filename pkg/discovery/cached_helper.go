@@ -0,0 +1,202 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+)
+
+const (
+	refreshReasonRequested   = "requested"
+	refreshReasonInvalidated = "invalidated"
+	refreshReasonExpired     = "expired"
+
+	skipReasonFresh       = "fresh"
+	skipReasonRateLimited = "rate_limited"
+)
+
+// invalidatingResources are the resources whose changes add or remove
+// resources from the cluster.
+var invalidatingResources = []schema.GroupVersionResource{
+	{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"},
+	{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"},
+}
+
+// CachedHelper is a Helper that caches the cluster's resources between
+// refreshes, so that they're only pulled from the discovery API again when
+// they're stale.
+type CachedHelper interface {
+	Helper
+
+	// Invalidate marks the cached resources as stale, e.g. because a custom
+	// resource definition was created, so that the next RefreshIfStale pulls
+	// them from the discovery API.
+	Invalidate()
+
+	// RefreshIfStale pulls an updated set of Velero-backuppable resources
+	// from the discovery API if the cached ones have been invalidated or
+	// have expired, but not more often than the helper's minimum refresh
+	// interval.
+	RefreshIfStale() error
+}
+
+type cachedHelper struct {
+	Helper
+
+	minRefreshInterval time.Duration
+	maxAge             time.Duration
+	metrics            *metrics.ServerMetrics
+	logger             logrus.FieldLogger
+	clock              clock.Clock
+
+	// lock guards lastRefresh and invalidated
+	lock        sync.Mutex
+	lastRefresh time.Time
+	invalidated bool
+}
+
+var _ CachedHelper = &cachedHelper{}
+
+// NewCachedHelper returns a CachedHelper whose cached resources expire after
+// maxAge, and that refreshes them at most once every minRefreshInterval when
+// they're invalidated. Refreshes are recorded in serverMetrics.
+func NewCachedHelper(discoveryClient discovery.DiscoveryInterface, logger logrus.FieldLogger, minRefreshInterval, maxAge time.Duration, serverMetrics *metrics.ServerMetrics) (CachedHelper, error) {
+	helper, err := NewHelper(discoveryClient, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCachedHelper(helper, logger, minRefreshInterval, maxAge, serverMetrics, clock.RealClock{}), nil
+}
+
+func newCachedHelper(helper Helper, logger logrus.FieldLogger, minRefreshInterval, maxAge time.Duration, serverMetrics *metrics.ServerMetrics, clock clock.Clock) *cachedHelper {
+	return &cachedHelper{
+		Helper:             helper,
+		minRefreshInterval: minRefreshInterval,
+		maxAge:             maxAge,
+		metrics:            serverMetrics,
+		logger:             logger,
+		clock:              clock,
+		lastRefresh:        clock.Now(),
+	}
+}
+
+func (h *cachedHelper) Invalidate() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.invalidated = true
+}
+
+// Refresh always pulls the resources from the discovery API, since callers
+// that refresh explicitly, e.g. restores that have just created custom
+// resource definitions, need them to be current.
+func (h *cachedHelper) Refresh() error {
+	return h.refresh(refreshReasonRequested)
+}
+
+func (h *cachedHelper) RefreshIfStale() error {
+	h.lock.Lock()
+	age := h.clock.Since(h.lastRefresh)
+	invalidated := h.invalidated
+	h.lock.Unlock()
+
+	var reason string
+	switch {
+	case invalidated && age < h.minRefreshInterval:
+		h.metrics.RegisterDiscoveryRefreshSkipped(skipReasonRateLimited)
+		return nil
+	case invalidated:
+		reason = refreshReasonInvalidated
+	case age >= h.maxAge:
+		reason = refreshReasonExpired
+	default:
+		h.metrics.RegisterDiscoveryRefreshSkipped(skipReasonFresh)
+		return nil
+	}
+
+	return h.refresh(reason)
+}
+
+func (h *cachedHelper) refresh(reason string) error {
+	// changes that invalidate the resources while they're being refreshed
+	// might not be included, so the invalidation is cleared before the
+	// refresh starts rather than after it. Failed refreshes are retried.
+	h.lock.Lock()
+	h.invalidated = false
+	h.lock.Unlock()
+
+	start := h.clock.Now()
+	if err := h.Helper.Refresh(); err != nil {
+		h.lock.Lock()
+		h.invalidated = true
+		h.lock.Unlock()
+
+		h.metrics.RegisterDiscoveryRefreshFailure(reason)
+		return err
+	}
+	h.metrics.RegisterDiscoveryRefresh(reason, h.clock.Since(start).Seconds())
+
+	h.lock.Lock()
+	h.lastRefresh = h.clock.Now()
+	h.lock.Unlock()
+
+	h.logger.WithField("reason", reason).Debug("Refreshed discovery")
+	return nil
+}
+
+// InvalidateOnChanges watches custom resource definitions and API services,
+// which add and remove resources from the cluster, and invalidates helper's
+// cached resources whenever one of them changes, until stopCh is closed.
+func InvalidateOnChanges(helper CachedHelper, dynamicClient dynamic.Interface, stopCh <-chan struct{}) {
+	for _, gvr := range invalidatingResources {
+		client := dynamicClient.Resource(gvr)
+		listWatch := &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return client.List(options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return client.Watch(options)
+			},
+		}
+
+		// the objects that are listed when the informer starts invalidate the
+		// resources too, so that ones that changed between the helper's first
+		// refresh and the start of the watch aren't missed.
+		_, informer := cache.NewInformer(listWatch, &unstructured.Unstructured{}, 0, cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(interface{}) { helper.Invalidate() },
+			UpdateFunc: func(interface{}, interface{}) { helper.Invalidate() },
+			DeleteFunc: func(interface{}) { helper.Invalidate() },
+		})
+
+		go informer.Run(stopCh)
+	}
+}
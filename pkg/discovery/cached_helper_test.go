@@ -0,0 +1,131 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// countingHelper is a Helper that counts its refreshes.
+type countingHelper struct {
+	*velerotest.FakeDiscoveryHelper
+	refreshes int
+	err       error
+}
+
+func (h *countingHelper) Refresh() error {
+	h.refreshes++
+	return h.err
+}
+
+func TestCachedHelperRefreshIfStale(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFakeClock(now)
+	helper := &countingHelper{FakeDiscoveryHelper: velerotest.NewFakeDiscoveryHelper(false, nil)}
+	h := newCachedHelper(helper, velerotest.NewLogger(), 30*time.Second, 30*time.Minute, metrics.NewServerMetrics(), fakeClock)
+
+	// fresh resources are used from the cache.
+	require.NoError(t, h.RefreshIfStale())
+	assert.Equal(t, 0, helper.refreshes)
+
+	// invalidated resources aren't refreshed more often than the minimum
+	// refresh interval.
+	h.Invalidate()
+	fakeClock.Step(10 * time.Second)
+	require.NoError(t, h.RefreshIfStale())
+	assert.Equal(t, 0, helper.refreshes)
+
+	fakeClock.Step(20 * time.Second)
+	require.NoError(t, h.RefreshIfStale())
+	assert.Equal(t, 1, helper.refreshes)
+
+	// the invalidation was cleared by the refresh.
+	fakeClock.Step(time.Minute)
+	require.NoError(t, h.RefreshIfStale())
+	assert.Equal(t, 1, helper.refreshes)
+
+	// resources older than the maximum age are refreshed.
+	fakeClock.Step(30 * time.Minute)
+	require.NoError(t, h.RefreshIfStale())
+	assert.Equal(t, 2, helper.refreshes)
+
+	// failed refreshes are retried.
+	helper.err = errors.New("discovery failed")
+	h.Invalidate()
+	fakeClock.Step(time.Minute)
+	assert.EqualError(t, h.RefreshIfStale(), "discovery failed")
+	helper.err = nil
+	require.NoError(t, h.RefreshIfStale())
+	assert.Equal(t, 4, helper.refreshes)
+}
+
+func TestCachedHelperRefresh(t *testing.T) {
+	helper := &countingHelper{FakeDiscoveryHelper: velerotest.NewFakeDiscoveryHelper(false, nil)}
+	h := newCachedHelper(helper, velerotest.NewLogger(), 30*time.Second, 30*time.Minute, metrics.NewServerMetrics(), clock.NewFakeClock(time.Now()))
+
+	// explicit refreshes aren't cached or rate-limited.
+	require.NoError(t, h.Refresh())
+	require.NoError(t, h.Refresh())
+	assert.Equal(t, 2, helper.refreshes)
+}
+
+func TestInvalidateOnChanges(t *testing.T) {
+	helper := &countingHelper{FakeDiscoveryHelper: velerotest.NewFakeDiscoveryHelper(false, nil)}
+	h := newCachedHelper(helper, velerotest.NewLogger(), 0, 30*time.Minute, metrics.NewServerMetrics(), clock.NewFakeClock(time.Now()))
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	InvalidateOnChanges(h, dynamicClient, stopCh)
+
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1")
+	crd.SetKind("CustomResourceDefinition")
+	crd.SetName("widgets.example.com")
+
+	gvr := schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	_, err := dynamicClient.Resource(gvr).Create(crd, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// whether the informer lists or watches the definition, it invalidates
+	// the resources.
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		return h.invalidated, nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, h.RefreshIfStale())
+	assert.Equal(t, 1, helper.refreshes)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package downloadproxy serves the objects of download requests through the
+// Velero server, for object stores whose signed URLs can't be reached by the
+// users of the CLI, e.g. because they're behind a private endpoint. Instead of
+// a signed URL, the download request controller returns a URL of the proxy
+// with a short-lived token that's only valid for that download request.
+package downloadproxy
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+)
+
+const (
+	// PathPrefix is the prefix of the paths of the proxy's download URLs,
+	// which are <PathPrefix><namespace>/<name> for a download request.
+	PathPrefix = "/downloads/"
+
+	// SigningKeySecret is the name of the secret, in the server's namespace,
+	// with the key that download URLs are signed with.
+	SigningKeySecret = "velero-download-proxy-key"
+
+	signingKeySecretKey = "key"
+	signingKeySize      = 32
+)
+
+// Signer creates and verifies the URLs of downloads through the proxy.
+type Signer struct {
+	baseURL *url.URL
+	key     []byte
+}
+
+// NewSigner returns a signer for the URLs of downloads through the proxy that
+// is reachable at baseURL, e.g. https://velero.example.com, that signs them
+// with key.
+func NewSigner(baseURL string, key []byte) (*Signer, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid download proxy URL %q", baseURL)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, errors.Errorf("invalid download proxy URL %q, must be an http or https URL", baseURL)
+	}
+	if len(key) < signingKeySize {
+		return nil, errors.Errorf("download proxy signing key must be at least %d bytes", signingKeySize)
+	}
+
+	return &Signer{baseURL: parsed, key: key}, nil
+}
+
+// SigningKey returns the key in the download proxy's signing key secret,
+// creating the secret with a random key if it doesn't exist. Every replica
+// of the server signs download URLs with the same key, so the URLs are valid
+// on any of them, and stay valid across restarts until they expire.
+func SigningKey(secrets corev1client.SecretInterface) ([]byte, error) {
+	secret, err := secrets.Get(SigningKeySecret, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		key := make([]byte, signingKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, errors.Wrap(err, "error generating download proxy signing key")
+		}
+
+		secret, err = secrets.Create(&corev1api.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: SigningKeySecret},
+			Data:       map[string][]byte{signingKeySecretKey: key},
+		})
+		if apierrors.IsAlreadyExists(err) {
+			// another replica created it first
+			secret, err = secrets.Get(SigningKeySecret, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting download proxy signing key secret %s", SigningKeySecret)
+	}
+
+	return secret.Data[signingKeySecretKey], nil
+}
+
+// URL returns the URL of the proxy for downloading the object of a download
+// request until expiration.
+func (s *Signer) URL(request *velerov1api.DownloadRequest, expiration time.Time) string {
+	expires := strconv.FormatInt(expiration.Unix(), 10)
+
+	u := *s.baseURL
+	u.Path = strings.TrimSuffix(u.Path, "/") + PathPrefix + request.Namespace + "/" + request.Name
+	u.RawQuery = url.Values{
+		"expires": []string{expires},
+		"token":   []string{s.token(request, expires)},
+	}.Encode()
+
+	return u.String()
+}
+
+// Verify returns an error if token isn't a valid token of a download
+// request's URL that expires at expires, or if it's expired. Tokens are only
+// valid for the download request they were created for, so they aren't valid
+// for a download request that's recreated with the same name, or whose target
+// is changed.
+func (s *Signer) Verify(request *velerov1api.DownloadRequest, expires, token string, now time.Time) error {
+	if !hmac.Equal([]byte(token), []byte(s.token(request, expires))) {
+		return errors.New("invalid token")
+	}
+
+	expiration, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid expiration")
+	}
+	if now.Unix() > expiration {
+		return errors.New("token expired")
+	}
+
+	return nil
+}
+
+func (s *Signer) token(request *velerov1api.DownloadRequest, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%s/%s/%s/%s/%s/%s/%s", request.Namespace, request.Name, request.UID,
+		request.Spec.Target.Kind, request.Spec.Target.Namespace, request.Spec.Target.Name, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// DownloadGetter gets the objects of download requests.
+type DownloadGetter interface {
+	// GetDownloadRequest returns the download request with the given
+	// namespace and name.
+	GetDownloadRequest(namespace, name string) (*velerov1api.DownloadRequest, error)
+
+	// GetDownload returns the object of a download request. It returns
+	// persistence.ErrDownloadNotFound if the object doesn't exist.
+	GetDownload(request *velerov1api.DownloadRequest) (io.ReadCloser, error)
+}
+
+type handler struct {
+	signer *Signer
+	getter DownloadGetter
+	clock  clock.Clock
+	logger logrus.FieldLogger
+}
+
+// NewHandler returns a handler that serves the objects of download requests
+// at the URLs returned by signer.
+func NewHandler(signer *Signer, getter DownloadGetter, logger logrus.FieldLogger) http.Handler {
+	return &handler{
+		signer: signer,
+		getter: getter,
+		clock:  clock.RealClock{},
+		logger: logger,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// the proxy may be behind an ingress that does or doesn't strip the
+	// base URL's path, so only the part after the prefix is used.
+	i := strings.Index(r.URL.Path, PathPrefix)
+	if i < 0 {
+		http.NotFound(w, r)
+		return
+	}
+	parts := strings.Split(r.URL.Path[i+len(PathPrefix):], "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.NotFound(w, r)
+		return
+	}
+	namespace, name := parts[0], parts[1]
+
+	log := h.logger.WithField("downloadRequest", namespace+"/"+name)
+
+	request, err := h.getter.GetDownloadRequest(namespace, name)
+	if apierrors.IsNotFound(errors.Cause(err)) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.WithError(err).Error("Error getting download request")
+		http.Error(w, "error getting download request", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	if err := h.signer.Verify(request, query.Get("expires"), query.Get("token"), h.clock.Now()); err != nil {
+		log.WithError(err).Info("Rejected download through proxy")
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	download, err := h.getter.GetDownload(request)
+	if errors.Cause(err) == persistence.ErrDownloadNotFound {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.WithError(err).Error("Error getting download")
+		http.Error(w, "error getting download", http.StatusInternalServerError)
+		return
+	}
+	defer download.Close()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err := io.Copy(w, download); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error writing download")
+		return
+	}
+	log.Debug("Served download through proxy")
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downloadproxy
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestDownloadRequest(name, uid string) *velerov1api.DownloadRequest {
+	return &velerov1api.DownloadRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: name, UID: types.UID(uid)},
+		Spec: velerov1api.DownloadRequestSpec{
+			Target: velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupLog, Name: "backup-1"},
+		},
+	}
+}
+
+func TestNewSigner(t *testing.T) {
+	_, err := NewSigner("https://velero.example.com", testKey)
+	assert.NoError(t, err)
+
+	for _, invalid := range []string{"", "velero.example.com", "ftp://velero.example.com", "https://"} {
+		_, err := NewSigner(invalid, testKey)
+		assert.Error(t, err, invalid)
+	}
+
+	_, err = NewSigner("https://velero.example.com", testKey[:16])
+	assert.EqualError(t, err, "download proxy signing key must be at least 32 bytes")
+}
+
+func TestSigningKey(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	secrets := client.CoreV1().Secrets("velero")
+
+	// the secret is created with a random key if it doesn't exist, and its
+	// key is used after that.
+	key, err := SigningKey(secrets)
+	require.NoError(t, err)
+	assert.Len(t, key, 32)
+
+	again, err := SigningKey(secrets)
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	secret, err := secrets.Get(SigningKeySecret, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, key, secret.Data["key"])
+
+	// if another replica creates the secret first, its key is used.
+	client = fake.NewSimpleClientset()
+	client.PrependReactor("create", "secrets", func(action core.Action) (bool, runtime.Object, error) {
+		created := &corev1api.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: SigningKeySecret},
+			Data:       map[string][]byte{"key": testKey},
+		}
+		require.NoError(t, client.Tracker().Add(created))
+		return true, nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, SigningKeySecret)
+	})
+
+	key, err = SigningKey(client.CoreV1().Secrets("velero"))
+	require.NoError(t, err)
+	assert.Equal(t, testKey, key)
+}
+
+func TestSignerURL(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	signer, err := NewSigner("https://velero.example.com/proxy/", testKey)
+	require.NoError(t, err)
+
+	request := newTestDownloadRequest("request-1", "uid-1")
+	u, err := url.Parse(signer.URL(request, now.Add(10*time.Minute)))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https", u.Scheme)
+	assert.Equal(t, "velero.example.com", u.Host)
+	assert.Equal(t, "/proxy/downloads/velero/request-1", u.Path)
+	assert.Equal(t, "1569931800", u.Query().Get("expires"))
+
+	token := u.Query().Get("token")
+	assert.NoError(t, signer.Verify(request, "1569931800", token, now))
+
+	// the token is only valid for its download request and expiration.
+	assert.EqualError(t, signer.Verify(newTestDownloadRequest("request-2", "uid-1"), "1569931800", token, now), "invalid token")
+	assert.EqualError(t, signer.Verify(request, "1569931801", token, now), "invalid token")
+	assert.EqualError(t, signer.Verify(request, "1569931800", token, now.Add(11*time.Minute)), "token expired")
+
+	other := request.DeepCopy()
+	other.Namespace = "other"
+	assert.EqualError(t, signer.Verify(other, "1569931800", token, now), "invalid token")
+
+	// a download request that's recreated with the same name, or whose
+	// target is changed, isn't valid for the token.
+	assert.EqualError(t, signer.Verify(newTestDownloadRequest("request-1", "uid-2"), "1569931800", token, now), "invalid token")
+
+	retargeted := request.DeepCopy()
+	retargeted.Spec.Target.Name = "backup-2"
+	assert.EqualError(t, signer.Verify(retargeted, "1569931800", token, now), "invalid token")
+
+	retargeted = request.DeepCopy()
+	retargeted.Spec.Target.Kind = velerov1api.DownloadTargetKindBackupContents
+	assert.EqualError(t, signer.Verify(retargeted, "1569931800", token, now), "invalid token")
+
+	// tokens of a signer with another key aren't valid, but tokens of one
+	// with the same key, e.g. in another replica of the server, are.
+	other2, err := NewSigner("https://velero.example.com/proxy/", []byte("fedcba9876543210fedcba9876543210"))
+	require.NoError(t, err)
+	assert.EqualError(t, other2.Verify(request, "1569931800", token, now), "invalid token")
+
+	same, err := NewSigner("https://velero.example.com/proxy/", testKey)
+	require.NoError(t, err)
+	assert.NoError(t, same.Verify(request, "1569931800", token, now))
+}
+
+type fakeDownloadGetter struct {
+	requests map[string]*velerov1api.DownloadRequest
+	contents map[string]string
+}
+
+func (g *fakeDownloadGetter) GetDownloadRequest(namespace, name string) (*velerov1api.DownloadRequest, error) {
+	request, ok := g.requests[namespace+"/"+name]
+	if !ok {
+		return nil, errors.WithStack(apierrors.NewNotFound(velerov1api.Resource("downloadrequests"), name))
+	}
+	return request, nil
+}
+
+func (g *fakeDownloadGetter) GetDownload(request *velerov1api.DownloadRequest) (io.ReadCloser, error) {
+	contents, ok := g.contents[request.Namespace+"/"+request.Name]
+	if !ok {
+		return nil, errors.WithStack(persistence.ErrDownloadNotFound)
+	}
+	if contents == "error" {
+		return nil, errors.New("object store error")
+	}
+	return ioutil.NopCloser(strings.NewReader(contents)), nil
+}
+
+func TestHandler(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	signer, err := NewSigner("https://velero.example.com", testKey)
+	require.NoError(t, err)
+
+	getter := &fakeDownloadGetter{
+		requests: map[string]*velerov1api.DownloadRequest{
+			"velero/request-1": newTestDownloadRequest("request-1", "uid-1"),
+			"velero/request-2": newTestDownloadRequest("request-2", "uid-2"),
+			"velero/request-3": newTestDownloadRequest("request-3", "uid-3"),
+		},
+		contents: map[string]string{
+			"velero/request-1": "logs",
+			"velero/request-3": "error",
+		},
+	}
+	h := NewHandler(signer, getter, velerotest.NewLogger()).(*handler)
+	h.clock = clock.NewFakeClock(now)
+
+	signedPath := func(request *velerov1api.DownloadRequest, expiration time.Time) string {
+		u, err := url.Parse(signer.URL(request, expiration))
+		require.NoError(t, err)
+		return u.RequestURI()
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "valid token",
+			path:       signedPath(getter.requests["velero/request-1"], now.Add(time.Minute)),
+			wantStatus: http.StatusOK,
+			wantBody:   "logs",
+		},
+		{
+			name:       "path with the base URL's path",
+			path:       "/proxy" + signedPath(getter.requests["velero/request-1"], now.Add(time.Minute)),
+			wantStatus: http.StatusOK,
+			wantBody:   "logs",
+		},
+		{
+			name:       "expired token",
+			path:       signedPath(getter.requests["velero/request-1"], now.Add(-time.Minute)),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "token of another download request",
+			path:       strings.Replace(signedPath(getter.requests["velero/request-2"], now.Add(time.Minute)), "request-2", "request-1", 1),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "token of a download request that was recreated",
+			path:       signedPath(newTestDownloadRequest("request-1", "uid-0"), now.Add(time.Minute)),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "no token",
+			path:       "/downloads/velero/request-1",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "download request that doesn't exist",
+			path:       signedPath(newTestDownloadRequest("request-4", "uid-4"), now.Add(time.Minute)),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "object that doesn't exist",
+			path:       signedPath(getter.requests["velero/request-2"], now.Add(time.Minute)),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error getting object",
+			path:       signedPath(getter.requests["velero/request-3"], now.Add(time.Minute)),
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "invalid path",
+			path:       "/downloads/velero",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "method other than GET",
+			method:     http.MethodPost,
+			path:       signedPath(getter.requests["velero/request-1"], now.Add(time.Minute)),
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(method, tc.path, nil))
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption generates the data keys that encrypt backup artifacts,
+// and wraps and unwraps them with KeyWrapper plugins.
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// DataKeySize is the size, in bytes, of a data key, i.e. a 256-bit key.
+const DataKeySize = 32
+
+// NewDataKey generates a random data key and wraps it with the key identified
+// by keyID, using the KeyWrapper plugin named keyWrapperName. It returns the
+// plain-text data key, which must never be stored, and the backup encryption
+// metadata that UnwrapDataKey gets it back from.
+func NewDataKey(keyWrapper velero.KeyWrapper, keyWrapperName, keyID string) ([]byte, *velerov1api.BackupEncryption, error) {
+	dataKey := make([]byte, DataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, errors.Wrap(err, "error generating data key")
+	}
+
+	wrapped, wrappingKeyID, err := keyWrapper.WrapKey(keyID, dataKey)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error wrapping data key with key %s", keyID)
+	}
+
+	return dataKey, &velerov1api.BackupEncryption{
+		KeyWrapper:     keyWrapperName,
+		WrappingKeyID:  wrappingKeyID,
+		WrappedDataKey: base64.StdEncoding.EncodeToString(wrapped),
+	}, nil
+}
+
+// UnwrapDataKey unwraps the data key recorded in a backup's encryption
+// metadata, using the KeyWrapper plugin named in it.
+func UnwrapDataKey(keyWrapper velero.KeyWrapper, encryption *velerov1api.BackupEncryption) ([]byte, error) {
+	wrapped, err := base64.StdEncoding.DecodeString(encryption.WrappedDataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding wrapped data key")
+	}
+
+	dataKey, err := keyWrapper.UnwrapKey(encryption.WrappingKeyID, wrapped)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unwrapping data key with key %s", encryption.WrappingKeyID)
+	}
+
+	if len(dataKey) != DataKeySize {
+		return nil, errors.Errorf("unwrapped data key is %d bytes, expected %d", len(dataKey), DataKeySize)
+	}
+
+	return dataKey, nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	cloudprovidermocks "github.com/vmware-tanzu/velero/pkg/cloudprovider/mocks"
+)
+
+func TestNewDataKey(t *testing.T) {
+	keyWrapper := new(cloudprovidermocks.KeyWrapper)
+	keyWrapper.Test(t)
+	defer keyWrapper.AssertExpectations(t)
+
+	var wrappedKey []byte
+	keyWrapper.On("WrapKey", "key-1", mock.AnythingOfType("[]uint8")).
+		Return(func(keyID string, dataKey []byte) []byte {
+			wrappedKey = append([]byte("wrapped:"), dataKey...)
+			return wrappedKey
+		}, "key-1/version-2", nil)
+
+	dataKey, encryption, err := NewDataKey(keyWrapper, "velero.io/aws-kms", "key-1")
+	require.NoError(t, err)
+
+	assert.Len(t, dataKey, DataKeySize)
+	assert.Equal(t, &velerov1api.BackupEncryption{
+		KeyWrapper:     "velero.io/aws-kms",
+		WrappingKeyID:  "key-1/version-2",
+		WrappedDataKey: base64.StdEncoding.EncodeToString(wrappedKey),
+	}, encryption)
+}
+
+func TestNewDataKeyWrapError(t *testing.T) {
+	keyWrapper := new(cloudprovidermocks.KeyWrapper)
+	keyWrapper.Test(t)
+	defer keyWrapper.AssertExpectations(t)
+
+	keyWrapper.On("WrapKey", "key-1", mock.AnythingOfType("[]uint8")).Return(nil, "", errors.New("access denied"))
+
+	dataKey, encryption, err := NewDataKey(keyWrapper, "velero.io/aws-kms", "key-1")
+	assert.EqualError(t, err, "error wrapping data key with key key-1: access denied")
+	assert.Nil(t, dataKey)
+	assert.Nil(t, encryption)
+}
+
+func TestUnwrapDataKey(t *testing.T) {
+	dataKey := make([]byte, DataKeySize)
+	for i := range dataKey {
+		dataKey[i] = byte(i)
+	}
+
+	tests := []struct {
+		name          string
+		encryption    *velerov1api.BackupEncryption
+		unwrapped     []byte
+		unwrapErr     error
+		expectUnwrap  bool
+		expectedError string
+	}{
+		{
+			name: "wrapped data key is unwrapped with the wrapping key",
+			encryption: &velerov1api.BackupEncryption{
+				WrappingKeyID:  "key-1/version-2",
+				WrappedDataKey: base64.StdEncoding.EncodeToString([]byte("wrapped")),
+			},
+			unwrapped:    dataKey,
+			expectUnwrap: true,
+		},
+		{
+			name: "invalid base64 returns an error",
+			encryption: &velerov1api.BackupEncryption{
+				WrappingKeyID:  "key-1/version-2",
+				WrappedDataKey: "not base64!",
+			},
+			expectedError: "error decoding wrapped data key: illegal base64 data at input byte 3",
+		},
+		{
+			name: "unwrap error is returned",
+			encryption: &velerov1api.BackupEncryption{
+				WrappingKeyID:  "key-1/version-2",
+				WrappedDataKey: base64.StdEncoding.EncodeToString([]byte("wrapped")),
+			},
+			unwrapErr:     errors.New("key disabled"),
+			expectUnwrap:  true,
+			expectedError: "error unwrapping data key with key key-1/version-2: key disabled",
+		},
+		{
+			name: "data key of the wrong size returns an error",
+			encryption: &velerov1api.BackupEncryption{
+				WrappingKeyID:  "key-1/version-2",
+				WrappedDataKey: base64.StdEncoding.EncodeToString([]byte("wrapped")),
+			},
+			unwrapped:     []byte("short"),
+			expectUnwrap:  true,
+			expectedError: "unwrapped data key is 5 bytes, expected 32",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keyWrapper := new(cloudprovidermocks.KeyWrapper)
+			keyWrapper.Test(t)
+			defer keyWrapper.AssertExpectations(t)
+
+			if tc.expectUnwrap {
+				keyWrapper.On("UnwrapKey", "key-1/version-2", []byte("wrapped")).Return(tc.unwrapped, tc.unwrapErr)
+			}
+
+			res, err := UnwrapDataKey(keyWrapper, tc.encryption)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, dataKey, res)
+		})
+	}
+}
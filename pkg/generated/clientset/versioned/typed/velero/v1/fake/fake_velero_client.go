@@ -32,10 +32,18 @@ func (c *FakeVeleroV1) Backups(namespace string) v1.BackupInterface {
 	return &FakeBackups{c, namespace}
 }
 
+func (c *FakeVeleroV1) BackupQuotas(namespace string) v1.BackupQuotaInterface {
+	return &FakeBackupQuotas{c, namespace}
+}
+
 func (c *FakeVeleroV1) BackupStorageLocations(namespace string) v1.BackupStorageLocationInterface {
 	return &FakeBackupStorageLocations{c, namespace}
 }
 
+func (c *FakeVeleroV1) DeleteBackupApprovals(namespace string) v1.DeleteBackupApprovalInterface {
+	return &FakeDeleteBackupApprovals{c, namespace}
+}
+
 func (c *FakeVeleroV1) DeleteBackupRequests(namespace string) v1.DeleteBackupRequestInterface {
 	return &FakeDeleteBackupRequests{c, namespace}
 }
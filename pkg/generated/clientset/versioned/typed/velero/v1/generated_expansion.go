@@ -20,8 +20,12 @@ package v1
 
 type BackupExpansion interface{}
 
+type BackupQuotaExpansion interface{}
+
 type BackupStorageLocationExpansion interface{}
 
+type DeleteBackupApprovalExpansion interface{}
+
 type DeleteBackupRequestExpansion interface{}
 
 type DownloadRequestExpansion interface{}
@@ -27,7 +27,9 @@ import (
 type VeleroV1Interface interface {
 	RESTClient() rest.Interface
 	BackupsGetter
+	BackupQuotasGetter
 	BackupStorageLocationsGetter
+	DeleteBackupApprovalsGetter
 	DeleteBackupRequestsGetter
 	DownloadRequestsGetter
 	PodVolumeBackupsGetter
@@ -48,10 +50,18 @@ func (c *VeleroV1Client) Backups(namespace string) BackupInterface {
 	return newBackups(c, namespace)
 }
 
+func (c *VeleroV1Client) BackupQuotas(namespace string) BackupQuotaInterface {
+	return newBackupQuotas(c, namespace)
+}
+
 func (c *VeleroV1Client) BackupStorageLocations(namespace string) BackupStorageLocationInterface {
 	return newBackupStorageLocations(c, namespace)
 }
 
+func (c *VeleroV1Client) DeleteBackupApprovals(namespace string) DeleteBackupApprovalInterface {
+	return newDeleteBackupApprovals(c, namespace)
+}
+
 func (c *VeleroV1Client) DeleteBackupRequests(namespace string) DeleteBackupRequestInterface {
 	return newDeleteBackupRequests(c, namespace)
 }
@@ -23,31 +23,32 @@ import (
 	"compress/gzip"
 	"io/ioutil"
 
-	apiextinstall "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
-	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
-	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\x1c\xcbn#\xb9\xf1\xee\xaf(8\x87\xd9\x05,\x0f\x06\xb9\x04\xbe\xcdz\x1cD\xd8ɬ\xb16\x9cC\x90\x03\xd5]\x92\x18\xb3\xc9\x0eɖ\xad\x04\xf9\xf7\xa0\xf8\xe8\xf7\x83\x9aUv\xb0X\xf3dSd\xb1\xde,\x16\x8b}\xb1Z\xad.XɟP\x1b\xae\xe4\r\xb0\x92\xe3\xabEI\xff\x99\xeb\xe7?\x99k\xae\xde\x1f>lв\x0f\x17\xcf\\\xe67p[\x19\xab\x8a\x9fѨJg\xf8\t\xb7\\r˕\xbc(в\x9cYvs\x01\x90id\xd4\xf9\xc8\v4\x96\x15\xe5\r\xc8J\x88\v\x00\xc9\n\xbc\x81\r˞\xab\xd2\\\x1fP\xa0V\xd7\\]\x98\x123\x9a\xb9Ӫ*o\xa0\xf9\xc1O1\xf4\x1b\x80G\xe1\a7\xdbu\bn쏭\xce\xcf\xdcX\xf7C)*\xcdD\xbd\x92\xeb3\\\xee*\xc1t\xec\xbd\x000\x99*\xf1\x06.//\x00\x0eL\xf0ܡ\xed\x17S%ʏ\xf7\xeb\xa7?>d{,\x98\xef\x04\xc8\xd1d\x9a\x97n\\X\x15\xb8\x01\x06O\x0egЁ5`\xf7\xcc\xd2\u007f\xa5F\x83\xd2\x1a\xb0{\x84\x8c\x95\xb6\xd2\bj\v?V\x1b\xd4\x12-\x9a\x00\x19 \x13\x95\xb1\xa8\xc1Xf\x11\x98\x05\x06\xa5\xe2\xd2\x02\x97`y\x81\xf0\xdd\xc7\xfb5\xa8\xcd?1\xb3\x06\x98́\x19\xa32\xce,\xe6pP\xa2*\xd0\xcf\xfd\xfe:\xc0,\xb5*Q[\x1e9H\xad%\xf1\xba\xafG\xd7;\"\u070f\x81\x9cd\x8c\x1e\xfd\x83\xef\xc3\x1c\x8cc\n\xd1a\xf7܀\xc6@\xa6c`\v,\xd0\x10&\x03\xd2\xd7\xf0\x80\x9a\x80\x80٫J\xe4\x90)y@M|\xca\xd4N\xf2\u007fא\rX\xe5\x96\x14\xccb\x10jl\\ZԒ\t\x12Y\x85W\x8e\x11\x05;\x82FZ\x03*ق憘k\xf8\xab\xd2\b\\n\xd5\r\xec\xad-\xcd\xcd\xfb\xf7;n\xa3\x8eg\xaa(*\xc9\xed\xf1}\xa6\xa4\xd5|SY\xa5\xcd\xfb\x1c\x0f(\u07b3\x92\xaf\x1c\x9e\xd2:\xbb(\xf2?D!\x9bw-\xc4\xec\x91t\xc9X\xcd\xe5\xae\xeev*;\xc9f\xd2]\xaf=~\x9aG\xb7\xe1&u\x11\x13~\xbe{xlk\x167]\x16;\xe66\xd3L\xc3g\xe2\v\x97[\xd4^N[\xad\n\a\x11e\xeeU\xcbi\xa5\xe0(\xbb<6զ\xe0\x96\x04\xfb\xaf\n\ri\xaf\xba\x86[&\xa5\xb2\xb0A\xa8ʜ\x94\xee\x1a\xd6\x12nY\x81\xe2\x96\x19<7\x97\x89\xa1fE\x1c\\\xe6s\xdb\xfdt\az\xe6\xd4\xdd\xd1ӌ\n\xc4\xdb\xf3C\x89YG\xedi\x0e\xdf\xf2\xcc)7l\x95n\xccݻ\x92\xeb\x16\xc01\x93\xa3\x86\xaf\x99\xa8r̿\x90?+Y\xd6\xff\xbd\x87\xca\xdd`8\x19\x8be\\\x92\xba\x90\xe3#˒ͯ\xce\xdf0\x8d=\xa0\x00$2.=4\xe7I\xf68\x82\xb63,\x8b\xc5\x00\xab\t\x86\aؕ\x10l#\xf0\x06\xac\xae\xfaK\xfbyLkv\x1c\xe5D\xdcE\xd2\x18Q\x8f\x0e\x06#x\xe6\xfchm\x16\x8e\x17\xbf!6\xec\x95z\x9e'\xfd/4\xa21k\xc8\xdc\xe6\v\x1bܳ\x03W:\x10\x1b\\\xe9\x06\x01_1\xab,\xe6\x03ԙ\x85\x9co\xb7\xa8\tJ\xb9g\x06\x8dw\xddS,\x98RbjzJl\x03\xfc\x1b\x911\x8d\x9e\xde)\x94\xe1e\x8f\xd2!3\xe4\xaeo\xb4\xcfʜ\x1fx^1\x01\\\x1a\xcbd\xe6\xe9`5N}:`Z\x9c\x03l\xbd\xf1G\x9c\x89\xf7\x1dG\xa0$\x82\xd2P\x90\x8b\x1b\x0e5\xa3\xf0a\x92\xdc\r3\x98\x83\xf2j\xa8+\x81&,\x94;\xff\xd2\xd8\xf5\xd5\x04\xe0Z\n~\xff\x13l\x83\x02\f\n̬\xd2cl\x98\x17\xaao\xcb>j\x82w#\xde*8\xcd\xe0BێJM\xc2\x04x\xd9\xf3l\xef\xf7*\xd2\x17\a\x05r\x85\xc6\xd9/+Kq\x1c'\x0e\xe6%\xedی\t7m֘\xfb\xb0\x86fݴE?״\x05\x8f\xd7\xe5e-\xfa\xdf\x0f+\xa3\xe3>Y1׃\x89\xe7TLb\"\xa7\xf0r\xbd\x05,J{\xbc\x02nc/E\xb0̝z&\xd9S\xaf\xfd\x9b\x13ĩ:\xbd\xee\xcf;\xa3N\xffB)\xd4K\xfff\x84\xe0\x9c\xfdC\xf0\xf5\x89\x02\xf8ܞs\x05|[\v \xbf\x82-\x17\x16uO\x12s\xe4\xaayI\xfcR\x16,\xefT\xd4\nf\xb3\xfd\xdd+EG.c17\xb6Ǎ\xfeT\x1fSƨ\xba\xbb\x99\xceB\x05wB\xe2\x1a\v\u007f\xeezt\x1clz\\\xe4\xf3\xf1\xcb'̧\x99\x02)\x1a6 \xe1c\x0f\xcd\xf6\xb2!DN# \x04)\xf5\xe9\xc2\x1f\x99\xaf\x80\xc13\x1e}tA\a\xf8\x125\xa3eh\xf0\"D\x8d\xee\xdc\xee\x14\xea\x19\x8f\x0eH8\x8a/\xccM\x13\xbdo\xcfx\\\x1e\xd4c\x1ba\xc3MH-\x10\xff\xa8\xc31\xc0\x9d\xf4RY\x06.\x91\x12=\xcc\x12Q\x90\xea\"b\x8b\xdc>\x99\xbcZLM2\xc0\v\xf2\x9d\xf1B!m\xdf\xf32\x89@r\x9d`\xd0\xd9DL\xa4<1\xc1\xf3z\x19\xaf\xdfky\x05_\x94]˩`\xb5\xdb\xee^\xb9\t\xf9\xabO\n\xcd\x17e]\xcfٙ\xe8Q>\x99\x85~\x9a3!\xe9\xdd0\xd1\xdfN\xd0,*\xb1ok\u007fªE\xc2\r\xac%\x9d!<\xaf|F\xcd/6\xe7\xed\xbb\xad\xa8\x8c\xcb\xc0H%Wn\xb3\xbb\x1e['\xb08Q\x91\xdbR\x18\xa2U/\xe9\x97K\x82\xf8H\xfb\x82\x9f\xed\xb3\x83\x82e\x98C^9&\xbat\x17\xb3\xb8\xe3\x19\x14\xa8w\xd3\x1bA\xbb\x95\xe4\xb3S\x96O\U000a5f9d\xa4O)[sl\xc1\x19\xe7Kh\xac\xc86\x17\xc7D\xd1.\f\x1cMxM\x0f\\\xa2\xc3m\x92.nX\xe0&\xcbsw\t\xc0\xc4}\xb2\xf7N\xe6\xfcp\xdf\xf6(\xf9=\xae`%Y\xe7\u007fh\xabrJ\xfb_(\x19\u05cb\x16\xfa\xd1]\a\b\xec\xcc\fY\xa1\xf6\"\x04\x9f\x1b i\x1e\x98\xe8gIG\xc8R\xe45P\xf8mXm\a\x91\xc6\x15\xbc\xec\x95\xf1\xbb▣ȁ\xcfEZ\xd4.\x9f\xf1xy5\xb0\xf1˵\xbc\xf4\xdb\xf3\xc0b\xe3^\xbe\x00XIq\x84K7\xf3\xf2\xebC\x97$\xadK\x18\xe4\xee\x86҂Y:\xcd\xc5]\x9c\xa6\xd5\xf7\x10\x14\x8aNc\x9b\xa0s\xa526\x11\x89{e\xac\xcf\xd0u\x82Ǒ\xdc\xd0\xfc\x99&䄀m\xfdݏ\xd21\xedO\x8e\xac\x97\xaa$)\x19\x1cMp\x0e \xe6\x01$\x13\x02.\x1b\x1b\xf5\xfe\xf1\xd2\xdf\x05\xb8%X\xe6\u0082\x19\x88\xa4\n\xa5V\x19\x1a3\xa7\x0e\x8b\x9ew!\xe1V'ۘ?T\xf8T\xfb\\r/\xb6\u0530\x91XsR\x98}\xf7\xda\xca\x01\x92i\xd3\xff\xf3jv\x1aF\xd42U\x14L.n\x16\x03\xe4n\xfd\xbch\n\x01\x8c\x0f\xd9\xf5\xaerf\x9c\x1a\xe9\x05\xa5\xf9\xb6\x1bl\xc1\xe5\xda\x01\x87\x0fgݎ!\xbaD<=\xa4\xbe\x8d3\x1b6\xd7\x1d\xde6K5L\xb9\x8f\xb5\x97=j\xecHj\x98\x19v\xe1\x9cT\xb6u<Oc\xb4\xc7㝁-\xd7ƶ\x914P\xcdZm\v\xc8Ig\x14y\xa7\xf5W\x1cQ~\xf2\xf3Z\t\xa0\xbdz\x89\xf7i\x9e!I\x14\xbbk\x10\x04\xbe\x05n\x01e\xa6*\xe9\x92\x18d\xa4n\x01\xcfR\xefL\x177Y\xdfR\f\x9b\x1aʪH!|崇˙\\G{\xf0\x9f\x19\x9f\xcbT\xc5v\x92\x98,/PU3\x9bZ\xd3:bz\xf4\xf3:\x17\xa1\x05{\xe5EU\x00+\x88\xd9I\x1c\xa5\x9d\x99\x17ؕ/\xbc0n\x9dw'\xa8\xce\xd5[EFQ\n\xb4i\xa7\x81\rn\x95v\xb6hx\x8e\xf5\x96\x19d\xae$0\xd82.*\x9d\xe4\xd1N\xe0hzd\x1f\x8c\xfc<A{ʲ+G\xfeb\x9a2)T\x9b\xf3\xaa\xa5N\r\xd4\xee5\x9e3D*5'\x9dQ獒\x82*1y|\v\x93Z\xbcy\v\x93\x06\xed-L괷0\xe9-L\x9amoa\xd2[\x98\xf4{\r\x93\xe61Y\xb9\xbc\xd5\xe8O\v\xab/^\xa1N#6\t9\xdc\xea\xdf\xfa\xfa㴺\xbc\xf5\xf8\x9c\x96\xbfz٣ݣ\x8ee\xcd+Wm=\x94ss\xf5߸\xf9\xbaP\x8f\x94?*\xaf/\xbf\x9c-\xddK(\xc4\xdb(%\x90\xc91\xfaSk3G\x8aJ\xba5\x89uaG,JTq\x89\x01\xf5\xb1\x9a\x9b\xc2\xccv\x05\x03\x13\xa2]\x9b\xc2tÔoT\xaf\xb8X\xfa\xb1P\xf01_\xb69͡^h\xdfe\x91\xee\x94\x18~c\x0e\xcd\xd6eLWc\x84\x9b\f\xb4\xec\xf0\xe1\xba\xfb\x8bU\xa16\x03^\xb8\xdd\x0f\bpE\x93td\x91\xbbvqdԩPB\xdf\xe7\x1c(\r\x92\x8b\xabѺ\x98\xfauA\x9b\x9d\xf0S\xe9\x0fE'\xd9\xdb\\h\x9fR\xbb\xf1\xd5\x15\x1bݚ\x8cQ'{\xdaeGj\tizMF\xb7\xe6bb\x93I\xa8\xc48\xb9\xd2b\xf9\xbc5[U\xf1\x15\xb5\x14\xb1NbnÝ\xa9\xa0H\x889\x96\xab%\xbe\xaaF\xc2]\xe6\xcd`}ReD\xab\xeaa\x06dZ=D\x02K\x96j\x1fN\xaex\xe8W\x19\xcc\x10\xb1T\xe70]\xc30\x03t\xb4\xba!\xa5ra\x06f]\xd3p\xc6z\x85\x85*\x85\xf3T\x12\xfe\xd2\xd8s\xaa\xe6`\xa1\xd2`!2\x9d\xc3j\xa1\x96 \xbd\x82`\x81?_Y-P\xd7\x03\x8c\xaeyj\x8d@\xb7\n`\x14dbe\xc0\xc4\xdd\xff(Ȅz\x80\x85\x1b\xffQ\xb0\xb3\x1b\xe3\x8cFL\xfed$+\xcd^\xd9'\xf7`q>\x8a|\xe8\x8e\x1d9\\P\x8cÞ\x112\xa1\xaa\xbc\x86=$\xc5=C<\xc2\xfd\x93s\xf2\xee)L\xd6<\x04\n\xae<\x06?\xfdwB?\x9c\xf3\xb0a\xac\xd2l\x87\x9fU\xd6zm:E\u007fwl\xe7\xad`\x10j<\xd2\xc7:\b\x16_\xb1u\xa7\x8eŎ!\xcb\xe6\xe3\xc3\xd6\xe9\x8b0\x1c\xca{\xd2\xf2\xac\x15\xb3D<>~\xf6\x88[^\xe0\xf5\xa7\xca\x1f\xe4V%\xd3\x06\x89\u007f\x91 ?iC\u007f\xee\xd5\xcb\x00a\xa1\x02\xa5?\xf4\xf1\xd5\xe8rx\ued18\x8c\xb5\u007f/\x1b\x15,\xb2i^\x1d\x9f\xc6\xe7\xb4bіP\xfc\xc1Fm\xa7f\r\bl=\xe6\xa5h\xdfW\xb4\x9c\xeb\x89ڸs\x1e\u007f\"i\x99\xad\xcc\xd2#I7(>h\x0e\x19\xdfJ\xbb\x17f\x1e\x80WƓ\xdfI\x86\xf4V\xe7\xf9\xf8\x9cLn\x87\xe3\xddsb\x9d{\xa4\\Z\x8dE%\u007fa\xa6N\xa0\x8dx\xb4\x06\x98\x9f\xe7\x82\x01\x82\x859\xe0\x01%(\xe9\xf2e\xee\x05\x97\u007f\xc4ޟ3<\xbf\xb6`\x84t\\U\n\xc5\xf2h\xb9\x01\xb5\xf8D\xfa\xd1\xf9#}@\xfd\xceLB\xacLH\x8e\x8c\x90\xdf\u05ec\xad\xd2\x05\xb37\x903\x8b\xab\x11\x80\t~lD\xa5\\\xf2x\xe1\xe9\xa6\x1b\xe2\xad\xc3坝J\b\x11\x12\xcf\x05\x1a\xc3v\xf1\xcd\xe6\v\xb9\xa3\x1dJ\xda\xe4F\xb2D!\x14k\x12\x97\xdd\xf7\x8b\xfeD\xc72K\xe7_\x8fZ8¶F\xbd\x1bڜP;:a\xbb\x81\xe1\x19u\xf0\xcf㎄K\x8b;\xec\x86G\xf8Zr\xbd\xec\xcb\xef\xeaa\xc4\x11wtw\x16\xde|D\x00\x05\xdfqr\x88$\xd8\x1d\xd3\x1b\xb6\xc3U\xa6\x04\x9d\xa3\xb8\x92}\x8c\xfe?ru\xcfCg\t\xb9\xa7\x11\xf5-O\xcb\xe61\ne|\xbf\x1c\xbb\rX\xc1\x17\xec\xbbz\x9f\xe0\xc7\xfc\xa9\xfe\"\xc3`\xc0Z\xdek\xb5\xa3\xc8i\xf0\xd3m\xb4\xee\xc1/\xf7L[΄8z\xf0\x13\xab\x0e\xba?!\xd9ׄC\x1da\xa0\xb1L\xdb4\xdf\xf5\xd0\x19\xba\xe0\xb6\x1c\\̯\xe1\x01KFF2PfwU}\xdb\xff\xf8\xc6\x15\x85\xb6\xf1\x83\x14\xfe\x03\x03ٞɝ{\xd3\n\x1a\xddn\uf7ee\f v\xfcP\xc7\xeftQ\xffu\\N\U000c93bbe\xe7\xf3\xd4\x1b\xdc\xcb?\x92\x1bj\xe0E\x97\xf1\x1d\xdf\x0e\xb7\xe5\xb2\x14<#l\xbf\xffFy\xc5\xc3\xf0s\x1dCr\xc3\xe7:\x82Y\x06\xbd\xf1r\x88\x00\xd2]Z782\x1f\xad\xa5\x83\xf2\xf0@9\x13\x1d5\x93\"NVY&@V\xc5\x06\xb5\x13A\x1c0``\xfc\x96I\x04\x15\xee\xc0&ádBj\xe7p\n!\xf5\xa4)BL\x95eh̶\x12bx\U000ad8cd3R\xf5\xc24\x85\x98\xf3\x06\xf0\xb70hd\xff\r\xf3ϻ\x03\xb76\xe0\x88߯\xb4\x05\x8fD\xb1\xbd\xaehAp\xf8\xd0\xfc\xe7ط\n\xdf$:\xf8J\n\xe7\xf0\xf2\x96u\x06TBO\x13\x1a\xb3,C\xd2\xdd/\xfd\xcf\x13\xb9\x8f\t5_ r\xfffJ\xfa\xfc\x86\xb9\x81\xbf\xff\xe3\x02\xc2\t\xeb)\xe2A\x9d\xff\v\x00\x00\xff\xffj\x12iȎI\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcWMs\xe36\x0f\xbe\xfbW`\xf2\x1ery-w\xdbKǷ\xddl\x0f;\xcd6\x99x\x9b\x1e\xda\xce,MB6\x1b\x8aT\tH\xd9\xf4\xd7w@Q\xb2%\xcbIv\xa6\xadn\x04 \xf0\xe1\x83\x0f\x82\x8b\xe5r\xb9P\xb5\xbd\xc7H6\xf85\xa8\xda\xe2\x17F/+*\x1e\xbe\xa7\u0086U\xfbf\x8b\xac\xde,\x1e\xac7k\xb8j\x88Cu\x87\x14\x9a\xa8\xf1=\x96\xd6[\xb6\xc1/*de\x14\xab\xf5\x02@GT\"\xfcd+$VU\xbd\x06\xdf8\xb7\x00\xf0\xaa\xc25l\x95~hj\xe2\x10\xd5\x0e]\xd0ɘ\x8a\x16\x1d\xc6Pذ\xa0\x1a\xb58\xda\xc5\xd0\xd4k8(:\x0f$:\x80\x0eѻ\xe4l\xd39\xbb\xceΒ\xdeY\xe2\x1f\xcf\xdb\\[\xe2dW\xbb&*w\x0eV2!\xebw\x8dS\xf1\x8c\xd1\x02\x80t\xa8q\r\x17\x17\v\x80V9k\x92\xa2\x03\x1aj\xf4oo?\xdc\u007f\xb7\xd1{\xacT'\x040H:\xda:\xd9\xcdC\x04K\xa0\xa0\xdf\x05\x1e\xf7\x18\x11\xee\x13\x1b \x10\x902\x9e\xec\x11 l\xff@\xcdTdA\x1dC\x8d\x91mO\x99|G\x11\x1fd\x130\x97\x82\xb6\xb3\x01#1F\x02\xde#\xb4\x9d\f\rP:\t\x84\x12xo\t\"\xd6\x11\t=\x1f\xd8\x1f\x10\x95\xa0|\xc6U\xc0\x06\xa38\x01ڇ\xc6\x19\xd0\xc1\xb7\x18\x19\"\xea\xb0\xf3\xf6\xaf\xc13\x01\x87\xb4\xa5S\x8c9N\xfdg=c\xf4\xca\t\xcf\r\xfe\x1f\x947P\xa9'\x88({@㏼%\x13*\xe0c\x88\b֗a\r{\xe6\x9a֫\xd5\xcer\x9f\xe3:TU\xe3-?\xadt\xf0\x1c\xed\xb6\xe1\x10ie\xb0E\xb7R\xb5]&\x9c\xbeK\xd3\xca\xfc/\xe6\xfc\xa7\xcb#`\xfc$\t@\x1c\xad\xdf\r┣gi\x96\xec\xecb\xdc\xfd\xd6\xc1=\xb0)\"!\xe1\xee\x87\xcd'\xe87M\x8c\x8f)N\xe4\x1e~\xa3\x03\xcf\u008b\xf5%\xc6.Ne\fU\xf2\x88\xde\xd4\xc1zN\v\xed,\xfa1\xc7\xd4l+\xcb\x12\xd8?\x1b$\x96p\x14p\xa5\xbc\x0f\f[\x84\xa66\x8a\xd1\x14\xf0\xc1Õ\xaa\xd0])\xc2\u007f\x9ae!\x94\x96\xc2\xe0\xcb<\x1f\xb7\x9f\xb1aG\xce \xee[\xcbl@f\x8bpS\xa3\x1eU\x81\xb8\xb0\xa5\xcdEY\x86\b*\x17\xe5\x88\xc2Ygő\xc9\\q\xa6\x02\xd5\x1a\x89>\x06\x83c\xf9\x04\xec\xdb\xc1l\x84\xae\xc6XYJ-<a\x13Y\xd7$ w\xad\x89S\x18\x1aL1Ѡo\xaa)\x84%ܡ27\xde=\xcd*~\x89\x96\xa7\x1b\xcc\x06L>\x1d|iw\xd3\x1d\x941\xe9JQ\xee\xf6\fA\xcf:\x9d\xb0t\x95\xf6\x90\"\x132\xea\x18Zk0.\xfb\x18f\fM\xcc\xc1\xb4\xe8\fMy\x98M$\x18\n/\x87\xf8\xd9`\xdd\x1c[\x0e\xed=\xa3\xe8\xf3\nY*\x9e\xc0\xa3DV\xc5)\xc5 MQ\a\xef\xa5\xda9\x80\x1a\xcesI}\x13\xc81\x9e\x1e\xe1\\\xaeɷm\xf4\x03\xf2\xa9|Z\x1c\xc9L\x98L)խ8@C\x98\xb8}\x1e\xc0\v1\x13\x84X\xda//\xa2\xb8Mf=\x8aZ\xf1\x1e\xac'k\x10\xd4\f\xa6\x99\xb2\xec\xbf\x1e'\xdc\xd4]\xb6}%b\xe9\x8c6\xa29-\x90\x0e\xc6ks\xa8\x0f\xe1\xb3\xe9s\x9b\x8d\x86s\xf7\xebt\x01O\v|>\u007fON1w\x82\xe58\xa7G\x9a~\xd3\x17[,+n\xe8+\x9bl\xfa'\x1bnsA\xe8&F\xf4\x9c\x1dB(\xc7m\xb2\x9f\x84\xfe\xedF{q\xd4i\xe5\xb2\xf6\xd0\xf8\x86\xd0tݢ\x80\xdf<\xbc\x97\xabW˕\xb8\x16\xe4r\v\xd2I:\xf9\xf0(?\x1fyK\x0e \xf8t\xdat\xcf\xc8,\xd3\xdd\xd4I\xf5h\x9d\x93\xfb6b\x15Z4'.嶌\xe8\x9e@\t=\xd0~[|S\\\xfc\xc7]\xdc)\xe2͓\xd7h\uec35ӹ\xf2\x94\xcd\xeb\x13\xfb>\xab\xbb\xe9'\xa7\xf4\xe7\xfeJ_\xc5l\xf6\xf9\xe4\xf8\xa5u2o̔\xc0ah\xee\x86Hb`[aZ\xbd\xdb\\_\x92\xf4Q\x96Y\xe9\xc4飄\x8f\x12@\x195C\x1e\x91\x1ab\x8c3\xc1\x1ebe\t|\x00\x17\xfcnT\"ݗ\a&\b\x11\xba\xd4\t\x11\f2\xea4\xe3\xe9\xbd\xf2;<̼\x19\xfb\x11JI\x8cS\xa4\xe3\xec8d\x83\xf5\xf3\xa9\xf0\x8a\x18ʛ\xed\xd9\xf8]\x8fL\xfbЍ\x19\x1eP\xe7X\xba\xe3g٫\xb9\x9eX\x97!V\x8a\xd7 D.e\xab\x89^\x9e\x98j\xebp\r\x1c\x9bWgo\xbdW\xf4\xfc\x81oŢ?\xe7qK\x1aR\xf5\xc5\x06\x04g\xcb\xf0m\xablB}\xa2\xf9٫3\xba3g\x99\xe9\xc5\x13Q\xdb?\xf4\xdb7\x87U\xea\x89\xcb\xfc2O\n\x00\x92׃9\"2WU\x96\x1c\x1a\xbctК\xd1\xfc4}\x95_\\\x8c\x9e\xd6i\xa9\x83\xef&;Zï\xbf/:\xafh\xee{\x1c\"\xfc;\x00\x00\xff\xff\xc2\xdb\xde:\x94\x10\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xac\x96Ao\xeb6\f\xc7\xef\xfe\x14\xc4\xdb\xe1]\x16\a\x0f\xbb\f\xbem}\xefPl+\x8a\xf6\xa1\x97a\aEbb\xad\xb6\xa4\x91T\xba\xec\xd3\x0f\x94\xe2$N]\xf4\r\x98o\xa2(\x8a\xfa\xfd)Z\xcdj\xb5jL\xf2OH\xecc\xe8\xc0$\x8f\u007f\v\x06\x1dq\xfb\xfc#\xb7>\xae\xf7\x9f6(\xe6S\xf3\xec\x83\xeb\xe0&\xb3\xc4\xf1\x019f\xb2\xf8\x19\xb7>x\xf114#\x8aqFL\xd7\x00XB\xa3Ư~D\x163\xa6\x0eB\x1e\x86\x06 \x98\x11;p8\xa0\xe0\xc6\xd8\xe7\x9c\b\xff\xca\xc8\xc2\xed\x1e\a\xa4\xd8\xfa\xd8pB\xabav\x14s\xea\xe0<Q׳\xce\x01\xd4|>\x97P?\x97P\x0f5T\x99\x1d<\xcb/oy\xfc\xea\x8f^i\xc8d\x86儊\x03\xfb\xb0˃\xa1E\x97\x06\x80mL\xd8\xc1\x87\x0f\r\xc0\xde\fޕs\xd7\x04c\xc2\xf0\xd3\xfd\xed\xd3\x0f\x8f\xb6\xc7\xd1T#\x80C\xb6\xe4S\xf1[J\x0e<\x83\x81\xe3\x16 \xf1\xb83Ā\x10\t\xc6H\b5\rn\x8f!\x13ń$~B\xa3߅\xae'\xdb\xd5\xe6\x1f5\xbb\xea\x03N\x95D\x06\xe9\x11\xf6Ն\x0e\xb8d\x0eq\v\xd2{\x06\xc2D\xc8\x18\xa4\x9c\xf2\",\xa8\x8b\t\x107\u007f\xa2\x95\x16\x1e\x914\bp\x1f\xf3\xe0\xc0ưG\x12 \xb4q\x17\xfc?\xa7Ȭ\xe7\xd3-\a#\x93r\xd3\xe7\x83 \x053(\u05cc߃\t\x0eFs\x00B\xdd\x03r\xb8\x88V\\\xb8\x85\xdf\x14\x8e\x0f\xdb\xd8A/\x92\xb8[\xafw^\xa6J\xb6q\x1cs\xf0rX\xdb\x18\x84\xfc&K$^;\xdc\xe3\xb06ɯJ\x9eAJ\xf5\x8f\xee;:V9\u007f\xbcHL\x0e*8\v\xf9\xb0;\x99K-\xbe\x89Y밪Z\x97\xd5t\xcf4դ\x10\x1e\xbe<~\x85i\xd3B|\x8e\xb8\xc0=/\xe33g\xe5\xe2\xc3\x16\xa9괥8\x96\x88\x18\\\x8a>H\x19\xd8\xc1c\x983\xe6\xbc\x19\xbd\xf0Tm*G\v7&\x84(\xb0A\xc8\xc9\x19A\xd7\xc2m\x80\x1b3\xe2pc\x18\xffo\xca\n\x94WJ\xf0}ΗMf\xeeX\xe1\x9c\xccS\vY\x14d\xe1\xd2=&\xb4*\x91rҵ~\xebm)r\xd8F\x82\x97\xde\xdb~\xbat3\x80\xa7\xeb\xd9^\x98\x97\xae\xa3~5\xc0\x9d\xb6\xc0\x99\xfd\x8d\xc3B\x91\xc5\x13\xceJku\x11\xe6]\nb$\xf3\u007f\xe2PVL$l&\xc2 \xc78\xe5\x8e/-\xfa\x96\xb3#Q$\xbe>\xf7,\x9d/\xc5E\x9b\x85\x18\x1f\x18L8\x1c\x97\x81\xf4F\xe0\x05I+\xdaƬ\x9d\x01\x1d\xb8|\xc5눢\xc7*\x8aʗ(Zdn\xaf\xbc\xbc\xe0\xf8*\x9b7u\xd0O\u007f`f3`\aB\x19\x17\xf53D\xe60\x9bI\xbd\xe1Wb\xcf\x0e}\xaf\x1eK\xbc\xb1v]|\x0fx\x81\x1b\xf2x\xbd\xcb\n\xee\xf0\xe5\x95\xed6\xdcS\xdc\x112\xbf\x9a\xba\xaf\xa4\xd0}[m.\x14ܕi?=+\xf6\x9fΣ\x02}u|\a\x94\t\x00\xd6.\xe6.\xc0\xb2D2\xbb\t\xf5\xb9\x8a\x8d\xb5\x98\x04\xdd\xdd\xf5+\xa0\xfc\u007fϿ\xf32\xb41\xb8\xf24\xe1\x0e~\xff\xa3\xa9Q\xd1=My\xa8\xf1\xdf\x00\x00\x00\xff\xff\xe9\x15A\x19\x02\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacVMo\xe46\f\xbd\xfbW\x10\xdbö\xc0ڃ\xa0\x97·6\xd9âi\x10L\xd2\\\x8a\x1e42\xc7VcK\xaaHM6\xfd\xf5\x05%{><\x9ed\v\xd47S\x14\xf5\xf4\xf8\x9e\xa4\xa2,\xcbBy\U000c404c\xb35(o\xf0+\xa3\x95?\xaa\x9e\u007f\xa2ʸ\xd5\xeej\x83\xac\xae\x8agc\x9b\x1a\xae#\xb1\x1b\xd6H.\x06\x8d7\xb85ְq\xb6\x18\x90U\xa3X\xd5\x05\x80\x0e\xa8$\xf8h\x06$V\x83\xaf\xc1ƾ/\x00\xac\x1a\xb0\x86ƽ\xd8ީ&\xe0\xdf\x11\x89\xa9\xdaa\x8f\xc1U\xc6\x15\xe4QK\x896\xb8\xe8k8\f\xe4\xb9$c\x00\x19\xcb\xcdXf\x9dˤ\x91\xde\x10\xff\xba4zk\xc6\f\xdfǠ\xfas\x10i\x90\x8cmc\xaf\xc2\xd9p\x01@\xday\xac\xe1Ç\x02`\xa7zӤ=f@Σ\xfd\xf9\xfe\xcbӏ\x0f\xba\xc3A\xe5 @\x83\xa4\x83\xf1)o\x0e\b\f\x81\x82\xb1<\xb0ۯ\bʂ\nl\xb6J3l\x83\x1b`\xa3\xf4s\xf4cM\x00\xb7\xf9\v5\x03\xb1\v\xaa\xc5O@Qw\xa0\xa4ZN\x84\u07b5\xb05=V\xe3\x14\x1f\x9c\xc7\xc0f\xa2O\xbe\xa3\xbe\xefc3\xc0\x1feG9\a\x1a\xe94\x12p\x87\xb0\xcb1l\x80\xd2n\xc1m\x81;C\x10\xd0\a$\xb4\x9c\x989*\v\x92\xa2숼\x82\a\fR\x04\xa8s\xb1o@;\xbb\xc3\xc0\x10P\xbb֚\u007f\xf6\x95Ix\x91%{\xc5S\x87\xa7\xcfX\xc6`U/\xbd\x88\xf8\t\x94m`P\xaf\x100\xb1\x13\xedQ\xb5\x94B\x15\xfc\xe6\x02\x82\xb1[WC\xc7\xec\xa9^\xadZÓҵ\x1b\x86h\r\xbf\xae\xb4\xb3\x1c\xcc&\xb2\v\xb4jp\x87\xfdJyS&\x9c\x96\x93;\x86\xe6\xbb0\xba\x80>\x1e\x01\xe3W\x11\tq0\xb6݇\x93^/\xd2,z\xcdj\xc8\xd32\xdc\x03\x9b\x12\x12\x12֟\x1f\x1eaZ41~Jq\x96\xc5~\x1a\x1dx\x16^\x8c\xddb\xc8}J\xa2\x92\x8ah\x1b\xef\x8c\xe5\xf4\xa3{\x83\xf6\x94c\x8a\x9b\xc10M*\x95vTp\xad\xacu\f\x1b\x84\xe8\x1b\xc5\xd8T\xf0\xc5µ\x1a\xb0\xbfV\x84\xff7\xcbB(\x95\xc2\xe0\xfb<\x1f\x1fB\xa7\x89\x99\x9c}x:f\x16\x1b23\xea\x83G-\xed\x11\x8ed\x9e\xd9\x1a\x9d\x04\x0e[\x17@\x1d|;\xb2T\x1d\xd5]r^\x02\xa5B\x8b|\x1a\x9b\xa1xL)\xb2\xf0K\xa7N\x0f\x88\xef\xb1j+q9\x8d\x10\xb2\xef\u007f\xa8f\xf5.\xad\xbe$\xc9E\f\x932e\xeb£\xd8X\x0e\x96c4\xf3E\xe5C\x1b\x87\xa5\xe2%\xfc\x92\x90\u07ba\xf6\x8d\xd1kgY\xf4\xfbFʓ\xeb\xe3\x80\x0fVy\xea\x1c\xbf\x918\xddT\xfb\xe3\u007f\x9e\xb6F9G\xf1\x12\xa2qx\x8d\x14\xfbED\x8b:\x9c\xbetݽG\xf2\x9d\x1ap\"Y&\xe4\xe3\x14\xe19n0Xd\xa4\x83\xe9_\fw\xf0\xd2\x19\xdd-T\x854-\xf5GN\x13\"\xa7M\xf2\xe7\u007f\x83-26\x01\xcf\xd4Q&͜\x05\x05r\xb1T|f\xb9\xe5\xc2\xe5h\x85w\rˊ#}\xb3eS\xf6D\xaa\x8e!\xa0\xe5\xb1F\xba\x8a\xe6\x13\xbeų\x93\xe0\u007f_\u07feiܛC\x9e\xdcl\xac\x8c\xcd8|\xc0\x92L+\x17\xa7\x8c\x89u\x93\xb1\xe6\x04\xe4\xef\xf8\x02\u007f\xb7k\xf8՛p\xf4\x1e\xb9\x00\xed\xf3>-\x9f+h\xf3\x850\u007f\x9a\xa4rH\xe9N\xd5ʞa\xdb 4\xd8#c\x03\x9b\xd7|0\xbe\x12\xe30ǻuaP\\\x83\\\x13%\x9b3\xa1ȫPmz\xac\x81C\\V\xd1\xc2f}\xa7\xe8\xccV'\xfb\xbc\x97\x8c\xa5\xf6\xef\xcd\xf5F\xff\xe1\xc2\x01V\xc2\x1d\xbe\x9c\xc5\xee\x83\xd3H\x84sc\\@\xbf \xeeYh7\xbd\xc6wW\x87\xbf$\xc5r|>\xa7\x01\x00\x92˽9\xa2n|\x10\x8e\x91\x83c\x94\xd6\xe8\x19\x9b\xbb\xf9\x03:=e\x0f/\xe2\xf4\xab\x9dmҋ\x9ej\xf8\xe3\xcf\"W\xc5\xe6i\xc2!\xc1\u007f\x03\x00\x00\xff\xff'B.\x809\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYQs\x1b\xb9\r~\u05ef\xc0\xf8\x1eܛ\x89\xa4I\xda\xe9t\xf4v\xb1{\x1d\xb7w\x8e'r\xf3\x92\xc9\x03\xb4\x84\xb4\xacw\t\x96\xc4JQ;\xfd\xef\x1d\x90\xbb\x92VZ\xcb\xf2]&\xfb\x92\x98\x04A\xe0#\x00~\xa0F\xe3\xf1x\x84\xde~\xa2\x10-\xbb\x19\xa0\xb7\xf4U\xc8\xe9_q\xf2\xf4\x978\xb1<]\xbf]\x90\xe0\xdbѓuf\x067M\x14\xae?R\xe4&\x14tKK\xeb\xacXv\xa3\x9a\x04\r\n\xceF\x00E \xd4\xc1G[S\x14\xac\xfd\f\\SU#\x00\x875\xcd\xc0\xb3Ys\xd5Դ\xc0\xe2\xa9\xf1q\xb2\xa6\x8a\x02O,\x8f\xa2\xa7BU\xac\x027~\x06\xfb\x89\xbc6\xea\x1c@\xb6\xe5\x81ͧ\xa4\xe6}R\x93f*\x1b\xe5\x1fC\xb3\xbf\xd8(I\xc2WM\xc0\xeaԈ4\x19\xad[5\x15\x86\x93\xe9\x11@,\xd8\xd3\f\xae\xaeF\x00k\xac\xacI>f\x83ؓ\xfb\xe9\xe1\xee\xd3\x1f\xe7EI5\xe6A\x00\x1f\xd8S\x10\xdb٭\xdf\x01\xe0\xbb1\x00C\xb1\b\xd6'\x8dp\xad\xaa\xb2\f\x18\x85\x98\"HI\xb0\xcecd \xa6m\x80\x97 \xa5\x8d\x10\xc8\a\x8a\xe4$\x99t\xa0\x16T\x04\x1d\xf0\xe2_T\xc8\x04\xe6\x14T\tĒ\x9b\xca@\xc1nMA P\xc1+g\xff\xb3\xd3\x1cA8mY\xa1P\v\\\xf7Y'\x14\x1cV\nBCo\x00\x9d\x81\x1a\xb7\x10H\xf7\x80\xc6\x1dhK\"q\x02\xbfr \xb0n\xc93(E|\x9cM\xa7++]\x88\x15\\\u05cd\xb3\xb2\x9d\x16\xec$\xd8E#\x1c\xe2\xd4К\xaa)z;Nv:IaY\x9b\x1fB\x1b~\xf1\xfa\xc00\xd9\xea\xe9D\t֭v\xc3)P\x9e\x85Y\x03\x05l\x04l\x97es\xf7hꐂ\xf0\xf1\xaf\xf3G\xe86M\x88\xf7!N\xe0\xee\x97\xc5=Ί\x8buK\n\xf9\x9c\x96\x81뤑\x9c\xf1l\x9d\xa4?\x8aʒ\xebc\x1c\x9bEmE\x0f\xf6\xdf\rE\xd1\xe3\x98\xc0\r:\xc7\x02\v\x82\xc6\x1b\x142\x13\xb8sp\x835U7\x18\xe9[\xa3\xac\x80Ʊ\"\xf82·\xd9\xdf\x17\xcc\xe0솻\xfc\x1e<\x90\xa3\x94\x9d{*\xf4x\x14#]g\x97\xb6H\x01\x0eK\x0e\x80\xc7\xe2\x93\x03\xb5C\x89\xa7_N\xe6\xb9p\xc0\x15\xfd\xc2\xc5A\n?c\xd3\xfb\xa1\x15\x9dUZ\x92r\x12R\xab\x1ab\x96<R\tPuK7%\x05J+\x02E\xb1\x85\xc6\rG+\x1c\xb6\xaaVד\x99\x1c\xad\x1f\x04]?ǆ\xce\xda\u007fφ\x86\xccՅ %\xe6\x10|\xe0\x94\b\xa1qN\x83\x9e\xdd\xc5\x06x6g\xf7o5#\x04ZR \xa7\t\x94K\x8b\xe7T\x80\x04\xad\xeb\x12-W\\\x10>\x81o\x91\x01&\x03\xfd\x83>w\xd8\xf0l\xb5\x1d\xb4\xf4\xa7\x87\xbb\xae\xc2v \xb56\xcb\xf1\x8eg\x11\xd1oi\xa92\x0f(勻^\xdf-\xf36\xa9\x00\t\x03\x82\xb7TP\xafp\x83uQ\bM\x1e\x1cP\t\xa0\x89\x1b\xa8\x95\u007f\x93\xcbM[\xd5\xf6\xc5^\xb1\x06̷\x17\xfc}\xfe\xe1~\xfa7ζ\x0e\xeaĢ\xa0\xa8jP\xa8&'o 6E\t\x18\xd5\x05\x1b\xc8\xccufR\xa3\xb3K\x8a2iw\xa0\x10?\xbf\xfb2\x84\x19\xc0\xcf\x1c\x80\xbeb\xed+z\x036\xa3\xbc\xab\x9f]\x80ؘ\x81\xd8郍\x95\xd2\x0e;\x8e\x1aH\xadÛ\xe4\xa8\xe0\x13\x01\xb7\x8e6\x04\x95}\xd2{[Kȁ\x89\xff\xd5l\xf8\xdf\u0560\xce?\xe4$\xbdR\x91\xabl\xd8\xeeF<L\xa2\xbd\x819\x93\x82]\xad(\xd00\x9a\xa9\xeek\x81\xfd\x118\xa8\xef\x8e\x0f\x14$\xb5zf\xb9Б91\xf8\xf3\xbb/\xcfX\xdb\xc7\t\xac3\xf4\x15ށu\x19\x15\xcf\xe6\xc7\t<\xa6\x88\xd8:\xc1\xaf\xbaOQr$\a\xec\xaa\xed\xb0\xb5\f%\xae\t\"\xd7\x04\x1b\xaa\xaaqf\"\x066\xb8U\xff\xbb\xe3\xd2\bC\xf0\x18\xa4\xcf5\x06\xb5>~\xb8\xfd0\xcbVi\b\xadR%\xd5Kmi\x95Q(\x95\xc8\x17\xa5\xc6d\x82\xa3\xc9\xc1!\fE\x89n\xa0\xb0B\xa2$\t\xdde#M\xa0\xc9\xf5k\xb3\xf5\x98%t\xdf\x00[8.\f\xdf\xe7νȋĭ_\xf4\xe2\xfe |\xcfz\xf1\xd4,(8\x12J\x8e\x18.\xa2\xfaP\x90\x978\xe55\x85\xb5\xa5\xcdt\xc3\xe1ɺ\xd5X\xe3n\x9c\x0f>N\x13Q\x9f\xfe\x90\xfe\xf9M^D\x8fŅ\xae$\xd1\xef\xe1\x8f\xee\x13\xa7\xafv\xa7c\x8d\x97^B\xd7\xf3\x96\xe8\x1c\xaf\xd4\fؔ\xb6(;ƿ/\x96\x83)Q\xa3\xc9\x15\x16\xdd\xf6[G\xa9\xe2\xd6\x04\xdd~;N+\xb8\x1a\xa33\xfa\xffh\xa3\xe8\xf8\xab\x81j\xec\x05)\xf8ϻ\xdb\xef\x13\xbb\x8d}u\x02\x0e\xd2\xdd\x1c\x02\x9e\xef\x8c·\xb4\x14Β\xa5\x8f=ю\xb6\r\xf0ĝ\xcc\xc5<MpuB\x8fИԼc\xf5p\x86B\x9d\xf1\xb9g\xfc#\xae\"` @\xa8\xd1\xeb9=\xd1v\x9c\xaf`\x8fVoP\xbd$[6\xb2 @\xef+;pY\xb6\x17mK\x06[^\xad-)\xaeⰿ\x03\xa8\xe7\xd5g\xd1\xce\xcd\xc3\x109n\xb7άcG\x90\x85\xf74\xf44~Oh\xe93\xb8iK\xa7\xdc\xe9д\xf1pcғP\xc2\xde\x1b\xf0lz\u007f\xf7\xe3\xac7\x95\xfdy\xb17\x13\x94&^ܝ%\xe9\x0e\xbd\\\x0f\xa4Ց\x98\xc0o\xe9\xcf\nVf\xd8\u007f;:w\x847\xa7\xf2\xe91#\x98l\x96\xd8Z㱍\xa1\r\xc6n\x87\xd3\x16\v\x0e\x94\xe5u\xa9̪.2\x89\xb8)\xa7\\\xa2\xad\xc8@\xf7pu\xbc\xe6D硎\x05-\xb5T5\xbeb4]\xcbӚ\xd6=\xd0<j\xaf\x9b\x1e\x0f\xae\xe3\xb3\x1a\x9bH&\xf5\xc0\x03\xee\x1f\xdf\x06K\x0e5\xca\f\f\n\x8d\a\x14\xba\xa6\xaapQ\xd1\f$4ǓϦ~M1\xe2\xea|z\xfd\x9aer\xf7\xd7.\x00\\p#\xbb\xf6\xaf\x97\xe2ױ\x8d\x9e\xcb{ρ\x06\xab\x1f\xb2\xa8t8\xb6䰪Ҋôno\xaf\x8aB\xb2gAz,\xbf7\xc3\x01|\x89\xf1<8\x0f*1\x94<\xbb\x1at&{ \xf5{M}\xbc\xc3\x18\xeeis2v\xe7\x1e\x02\xaf\x02\xc5\xe3\xd0\x18w\xf1s\xe2\xec\x18~Nq~\xb1\xbf\xed\x06\xe7]n\x85\xa0\xe4\xaaKO\x16\xac\xc05\xf5\x82\x82\xfa\xbd\xd8\n\xc5~\x11>\xed\xe8S\x8f\xb0\a\xed`u\xf7@\x90\xf5\xb4-O\x81.=\x99i\xce\b\x83\xb1\xd1Wx\xda\xf3t.$&\xa1)\xa3)\xbd\x8f\xd6.M=\x854\xf5\x9a7\x88d\xcd-\xbbAJ\xdb\xe5\xa7u\xf2\xe7?=\xcb8\xac\x13Z\xf5\x8az;\xab\x00\xbeW\xfd\xdfZ\xf7\xb3\x17kt\xe8c\xc9rw{\xf6\xb4\xe7;\xb1.\xca\xf7\xa4%ծ\xf4\xaa\xd7\nuG\u07bf\xd2\xf2\x97\xc3\xe0\xe2ԋ\x82A.\xbb<\xe6=\xd1\x17\ue364\x97\xcc\x04\xe6\xe41\xa0\x9c\x06fzܽ9\xfe\xe9\xe3\rD\x9b\x1e\xbd\x94\xfbd2\x94\x1b٨\u05c9R;\x0e9VO5\xf6.\x82^\xe1\xef\x9b\xfe=j\xfe@<\x1c\r\xad\xbb\x9f\x91\xd6o\xf7\u007f\xa5\xb8\x1c\xb7\xbf\xfb\xa4\x89\xd6-s\xb0y\xfbfڎ\xeci\b\x16J\xd9\xc9\xdc\x1f\xff\xf2s\x95\x1fB\xba\x9frҟ\x05\xbb\xccf\xe3\f>\u007f\x19A\xfb\x92\xfa\xa9\xb3C\a\xff\x1f\x00\x00\xff\xff\r_=\xe6\xf2\x1a\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcXOo\x1b\xbb\x11\xbf\xebS\f\xfc\x0e\xee\x03\"\tI\x8b\xa2\xd0\xed=\xbb\xafP\xfb\x92\x18\x91\x9bK\x90\x03\xb5\x9cղ\xde\xe5l9\xb3RԢ\u07fd\x18rW\xd2J+Y\x0e\x02\xebb/9\x1c\xfe\xe6\xc7\xf9G\x8e\xc6\xe3\xf1\xc8\xd4\xee3\x06v\xe4g`j\x87\xdf\x04\xbd~\xf1\xe4\xe9/<q4]\xbf]\xa2\x98\xb7\xa3'\xe7\xed\f\xee\x1a\x16\xaa>!S\x132\xbc\xc7\xdcy'\x8e\xfc\xa8B1\u0588\x99\x8d\x00\xb2\x80F\a\x1f]\x85,\xa6\xaag\xe0\x9b\xb2\x1c\x01xS\xe1\fj\xb2k*\x9b\n\x03\xb2P@\x9e\xac\xb1\xc4@\x13G#\xae1S\x1d\xab@M=\x83\xfdDZ\xcc:\a\x90\xc0<\x90\xfd\x1c\xf5|Jz\xe2T\xe9X\xfe18\xfd\xbbc\x89\"u\xd9\x04S\x0e\xe0\x88\xb3\xec\xfc\xaa)M8\x9d\x1f\x01pF5\xce\xe0\xe6f\x04\xb06\xa5\xb3\xd1\xd0\x04\x8aj\xf4\xbf<\xcc?\xffq\x91\x15X\x994\bP\a\xaa1\x88\xeb\xb0\xeb\xef\x80\xf5\xdd\x18\x80E\u0382\xab\xa3F\xb8UUI\x06\xac\xf2\x8c\fR \xac\xd3\x18Z\xe0\xb8\rP\x0eR8\x86\x80u@F/\x11ҁZP\x11ざ\xff\xc2L&\xb0\xc0\xa0J\x80\vjJ\v\x19\xf95\x06\x81\x80\x19\xad\xbc\xfb\xcfN3\x83Pܲ4\x82-u\xdd\xcfy\xc1\xe0M\xa9$4\xf8\x06\x8c\xb7P\x99-\x04\xd4=\xa0\xf1\aڢ\bO\xe0=\x05\x04\xe7s\x9aA!R\xf3l:]9\xe9\xfc,\xa3\xaaj\xbc\x93\xed4#/\xc1-\x1b\xa1\xc0S\x8bk,\xa7\xa6v\xe3\x88\xd3K\xf4\xcd\xca\xfe\x14Z\x1f\xe4\xdb\x03`\xb2\xd5\xd3a\tίv\xc3\xd1Y\xceҬ\xbe\x02\x8e\xc1\xb4\xcb\x12\xdc=\x9b:\xa4$|\xfa\xeb\xe2\x11\xbaM#\xe3}\x8a#\xb9\xfbe\xbc\xe7Yyq>ǐ\xce)\x0fTE\x8d\xe8mM\xceK\xfc\xc8J\x87\xbe\xcf17\xcbʉ\x1e\xec\xbf\x1bd\xd1\xe3\x98\xc0\x9d\xf1\x9e\x04\x96\bMm\x8d\xa0\x9d\xc0\xdcÝ\xa9\xb0\xbc3\x8c?\x9ae%\x94\xc7\xca\xe0\xf3<\x1f\xa6\x80\xbe`\"g7\xdc\xc5\xf8\xe0\x81\x1cG\xed\xa2\xc6L\xcfGI҅.wY\xf4p\xc8)\x809\x91\x9f\x1c(\x1e\n=\xfd-M\xf6\xd4\xd4\v\xa1`V\xf8;e\aA|\x06կC+:X\x9a\x98R\x18b\xab\x1a8I\x1e\xa9\x04(\xbb\xa5\x9b\x02\x03\xc6\x15\x9a\\\\\xa6\x9eC\xec\x84\xc2V\xd5FS\xec\xe4h\xfd \xed\xd1P\xb2\x17\xe1?P\xeb\xe3\x01s\f\xe8ՃSl\xd7\x143\x80\x18\xe7;OO9\x0f\x84N\xd0/\x13\xda!h稆\xb3\xd9n\x10\xe8/\x0f\xf3.\xc3u\x8c\xb6\x90\xe5xǋ\x84\xe8/wX\xda\a#ų\xbb\xde\xce\xf3\xb4ML\x00B`\xa0v\x98a/q\x82\xf3,hl\x1a\x1cP\t\xa0\x81\x13\xb0\x95\u007f\x93½\xcd*\xfbd\xabT\x83I\xd5\x03\xfe\xbe\xf8\xf8a\xfa7JX\au\x9a,CV5F\xb0B/o\x80\x9b\xac\x00\xc3j\x82\vh\x17:3\xa9\x8cw9\xb2L\xda\x1d0\xf0\x97w_\x878\x03\xf8\x8d\x02\xe07S\xd5%\xbe\x01\x97X\xde\xe5\xaf\xce?\x1c'\"v\xfa`\xe3\xa4pÆ\x1b\xf5\xa3\xd6\xe0M4T\xcc\x13\x02\xb5\x866\b\xa5{Һ\xa9\x11|\x00\xf1\xbf\x1a:\xff\xbb\x19\xd4\xf9\x87\x14\"7*r\x93\x80\xed*\xd2a\xc4\xed\x01Ja\x04$\xb8\xd5\n\x03\x0e\xb3\x19\xf3\xae&\xb8\x9f\x81\x82\xda\xee\xe9@AT\xabg\x96\xf2\f\xda\x13\xc0_\xde}=\x83\xb6\xcf\x138o\xf1\x1b\xbc\x03\xe7\x13+5ٟ'\xf0\x18=b\xeb\xc5|\xd3}\xb2\x82\x18=\x90/\xb7\xc3h\t\n\xb3F`\xaa\x106X\x96\xe3\xd4\tXؘ\xad\xda\xdf\x1d\x97z\x98\x81\xda\x04\xe9\xd7\xfaA\xad\x8f\x1f\xef?\xce\x12*u\xa1U\xcccZTr\xa7\x15]Ky*Tꓑ\x8e&9\x87\x10d\x85\xf1\x03i\rbK\x10\xd9\xcd\x1bi\x02Nn_\x1a\xad\xc7U\xba\xfb\rT\xeb\xe3\xc4\xf0:5\xef*+b\x83\xfb\xac\x15\x1f\x0e\xdc\xf7\xa2\x15O\xcd\x12\x83G\xc1h\x88\xa5\x8cՆ\fk\xe1)\xad1\xac\x1dn\xa6\x1b\nOί\xc6\xeaw\xe3t\xf0<\x8d\xcd\xf2\xf4\xa7\xf8绬\xe0\xdadW\x9a\x12E_\xc3\x1e݇\xa7/6\xa7\xebڮ-B\xb7\x8b\xb6\xcf8^\xa9\x11\xb0)\\Vt\x1d\xf7>Y\x0e\x86Delʰ\xc6o\u007f\xb4\x97*oM\xd0\xed\xb7㸂ʱ\xf1V\xffgǢ\xe3/&\xaaqW\x84\xe0?\xe7\xf7\xaf㻍{q\x00\x0e\xb6\x9b\xc9\x05j\x9a[\xa5/w\x18.\xf6J\x9fz\xa2]\x8f7Х\xedd\xaen\xd3؛\x9a\v\x92\xf9\xfdE\x04\x8b\x9dX\xb7\xfb\x9e\xf2\xb69\xeb4\xa9G^\xe8\xca\xce\"Ij.\xa2H]\xf5P\x8f\xdbbH\xfd@\x1c\xd1\xfe\xf2\xbb\x90\xe8\xddF\x9b\x98C$\xe3\xe1\xfe\xbc'Q\x93\xed}\xf7Ϸ7\xb5'\xbd7\x9c\x8cx\xf6\xaa\"F\x1a\xbe\xfe\xb2\x12\xc5;\xceR|J\xab$V\xe6ﺮd\xa4\xadZ\xffE\xe5\xd2\xc9ݝ\xca\xc7\xdb}\xb0\t\x97\xb8\n\xe3] \"\x80\x8d\xe1n\x8b\xd3s\x83\x03miaL|\xaa\fml\xa5\xb4\xcbˍ+\xd1\xc2\xee=\a\x1e\xf5\xb6\x16\ufff7\xa7\xa9\xb1S\xd30\xdax\x8b\x1b\x00|\xbc*\xa7P\x19\x99\x81\xdeyǪ\xe0h\xde7ei\x96%\xce@Bs<y6\f*d6\xab\xcbq\xf0>ɤ\xfbS\xbb\x00̒\x1a\xd9]\xa0ڀhͿ\xe5\xf6į\xbf\xbe\x15\x86/\x83xP\x89!\xbf\xda\x05\xe5%ǂx7i\xaa\xe3-\xc6\xf0\x017'cs\xff\x10h\x15\x90\x8f\xcf`\xdc\x1d\xd4Is=\x86ߢ\a\\mp\xbb\xc1e\x9b[!(\xa8\xec<\x97Ĕ\xe0\x9bj\x89A\r_n\x05\xb9c\xa0\v\xf4\xd3\xfbg\xech\xf7\xbc\xed\xd7w\xd9*)j\xfb\xf3\xcc\xf8\xf8\xbe\xa2\xde)\x04\xd6q]\x9a\xd3\x06\xbd\xb3!\x96=uN\x8d\x90\xbd_tѥ!\x1d\xe7^rc\x8ep\xee\xc9\x0f6`](8/\u007f\xfe\xd3\xd9\xfa\xe8\xbcગ\n\xdbY\xa5\xf0W\xd5\xff\xa3u\x9f-\xbe,&\xc8u\xa9k\xd1\x13}.kE\xc5C9\xeb0\xfd\x9c\xa6\x9b\xfe&\xaf\x91i\x06\xa89\x1aZw\x8f\xf0\xeb\xb7\xfb\xafxD\xe3\xf6\xd5<N@2\xcb\x1el\xde>5\xb5#\xfb\x82e2\xed\xb5\xd0~8~6\xbf\xb9齂\xc7ό\xbc\x8d\x0f\xf9<\x83/_G\xd0>@}\xeep\xe8\xe0\xff\x03\x00\x00\xff\xff\xc8\xe0\xe2+0\x18\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacV\xc1\x8e\xe36\f\xbd\xe7+\x88\xeda/u\x82E/\x85o\xed\xb4\x05\x06\x9d\x19,2\x8b\xb9\x14=02\x9d\xa8cK\xaaHe\x9a~}A\xd9N\x1c\xc7\xc9\x0e\x16\xf5\xcd$\xf5\xf4\xf4HJ\\\x14E\xb1\xc0`_(\xb2\xf5\xae\x04\f\x96\xfe\x11r\xfa\xc7\xcb\xd7\x1fyi\xfdj\xffiC\x82\x9f\x16\xaf\xd6U%\xdc%\x16߮\x89}\x8a\x86~\xa1\xda:+ֻEK\x82\x15\n\x96\v\x00\x13\t\xd5\xf8ŶĂm(\xc1\xa5\xa6Y\x008l\xa9\x84H,\xd6D\n\x9e\xad\xf8h\x89\x97{j(\xfa\xa5\xf5\v\x0ed\x14d\x1b}\n%\x9c\x1c\xddjV\x1f@\xc7f\x9d\x81\xd6\x03\xd0!\xbb\x1a\xcb\xf2\xfb\xac\xfb\xc1\xb2\xe4\x90Ф\x88\xcd\x1c\x91\xecf붩\xc1x\x11\xa0\x1b\xb0\xf1\x81J\xf8\xf0a\x01\xb0\xc7\xc6V\xf9\xa8\x1d+\x1f\xc8\xfd\xf4\xf9\xfe\xe5\x87g\xb3\xa3\x16;#@\x88>P\x14;\x90\xd7o\xa4\xfb\xd1\x06P\x11\x9bhCF\x84\x8f\n\xd5\xc5@\xa5J\x13\x83\xec\b\xf6\x9d\x8d*\xe0\xbc\r\xf8\x1adg\x19\"\x85HLN2\xa5\x11,h\b:\xf0\x9b\xbf\xc8\xc8\x12\x9e)*\b\xf0Χ\xa6\x02\xe3ݞ\xa2@$\xe3\xb7\xce\xfe{Df\x10\x9f\xb7lP\xa8\xd7n\xf8\xac\x13\x8a\x0e\x1b\x15!\xd1\xf7\x80\xae\x82\x16\x0f\x10I\xf7\x80\xe4Fh9\x84\x97\xf0\xe8#\x81u\xb5/a'\x12\xb8\\\xad\xb6V\x86J3\xbem\x93\xb3rX\x19\xef$\xdaM\x12\x1fyUў\x9a\x15\x06[d\x9eNru\xb6\xd5w\xb1\xafB\xfe8\"&\a\xcd\x0eK\xb4n{4\xe7j\xb9*\xb3\x16\vX\x06\xec\x97utOj\xaaIEX\xff\xfa\xfc\x05\x86M\xb3\xe2\xe7\x12gqO\xcb\xf8\xa4\xb3\xeab]M\xb1\xcbS\x1d}\x9b\x11\xc9U\xc1['\xf9\xc74\x96ܹƜ6\xad\x15M\xec߉X4\x1dK\xb8C\xe7\xbc\xc0\x86 \x85\n\x85\xaa%\xdc;\xb8Ö\x9a;d\xfa\xbfUVA\xb9P\x05\xbf\xae\xf3\xf8\x128\x0f\xec\xc49\x9a\x87&\x9fMȴm\x9f\x03\x19͏\x8a\xa4\vmmM\xaep\xa8}\x04\xbc\x88_\x8e\x80\xe7ZO\xbf\r\x9a\xd7\x14\x9e\xc5G\xdc҃7\xa3&\xbe\xc2\xea\xe7\xb9\x15\x03-\xbd\x99\xba6\xa4\xf9\xc0\t2\x80\xecPF\xfd'hݱ\x89g\xceqU\xf2,;j3:t\x86~˥\xe2\xcc\xe1\xe6Y\x1eg\x16\xe8Qv\xfe\r|-\xe4Ɛ\x03\xcb\r]\x1c\"&\xf7n\x92\xddUz_iiՖ\xe2M\x82\xebI\xf0\xa0s\x9d\x9a\xa6G*\x8co\x03\x8a\xdd44\xf4m\xed\xe3\x05E\xdba\x1c\xba&\xfe6}\xf7\xbeI-=\xe9\xeb\x13\xd0\xd0M\xe6/\xe7\xb1\xe3\x02\xe9\f=\t=\x02\xc4\xf3\x97k\xfc\xf55\xc1\x10|\xd5\x13苖\xf5\x9c\xef\xe4\xaeɵ\x91\xce.\xbfb\xbe\xf8\xcf\"\xe6*\xea,`\x9a\xcd3\xe7D\xaf\xaf^\x06\x82\x92\xf8\xfd\xd7A\x0e\x1f\x845)Fr҃\xe4w\xee\x9b.\x84\x06YFm\xa1\xa3\xcb\xcd<?\\\xc6\x0f\x94\x14\nD\r\xe3.zC\x9e\xeb\x97\xda\xc7\x16\xa5\x04\xbd\xc9\v]4\xf1\xeb\xe0\x84\x9b\x86J\x90\x98\xa6\xce\xeb7\x021\xe3\xf6\xf6\t\x1e\xbb\x98\xee\xe5\xeb\x17\x00n|\x92+\xc2\xe67\xf0\x86\xb47\x19\x85\x1d\xf2m>\x9f5b.\xad\xf4\xde\xcdɥv\xbaE\x01O\xf4va[\x13VӞ+\xe0\xc9˜\xe3ʙfjyb\xda\x0f\x83\xf5\xfe\xd3\xe9/\xd7]\xd1O\xc2\xd9\x01\xc0:'T\xa3\x14sכ\xbd\xe5\xd4 h\f\x05\xa1\xeai:\t\xe7q\xf44\xd8\xe6_\xe3]\x95\x87s.\xe1\x8f?\x17\x1d*U/\x03\x0f5\xfe\x17\x00\x00\xff\xffT\x83\a \x04\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xd4YIo+\xb9\x11\xbe\xebW\x14\x9cÛ\x01,\x19\x0f\xb9\x04\xba9~\x0e ̌c؆r\br\xa0\xd8%\x891\x9b\xec\xe1\"[\t\xf2߃\xe2\xd2{\xb74\xc8\x04\x93\xf4E\x10\xbbX\xac\xfaX{/\x96\xcb\xe5\x82Ub\x8b\xc6\n\xad\xd6\xc0*\x81\x9f\x0e\x15\xfd\xb3\xab\xf7?ؕ\xd0w\xa7\xaf;t\xec\xeb\xe2]\xa8b\r\x0f\xde:]\xbe\xa0\xd5\xdep\xfc\x86{\xa1\x84\x13Z-Jt\xac`\x8e\xad\x17\x00\xdc \xa3\xc57Q\xa2u\xac\xac֠\xbc\x94\v\x00\xc5J\\\x83A\xeb\xb4A\xbb:\xa1D\xa3WB/l\x85\x9c\xb6\x1e\x8c\xf6\xd5\x1a\x9a\x17q\x8f\xa5w\x00Q\x86\x97\xb8=\xacHa\xdd\x0f\xed\xd5\x1f\x85u\xe1M%\xbda\xb29,,Z\xa1\x0e^2S//\x00,\xd7\x15\xae\xe1\xe6f\x01pbR\x14A\xf6x\xa0\xaeP\xdd?o\xb6\xbf\u007f\xe5G,Y\\\x04(\xd0r#\xaa@\x97\x0f\x06a\x81\xc16\bN\xdc\x03@\xe0\x8é\xc1ʠE\xe5,\xb8#\x02\xab*)x8\x05\xf4>\xb1\x84z\x8f\x85\xbd\xd1e\xc3k\xc7\xf8\xbb\xaf\xc0i`\xe0\x989\xa0\x83\x1f\xfc\x0e\x8dB\x87\x16\xb8\xf4֡Y%6\x95\xd1\x15\x1a'2b\xf4\xb4\xae\xb8^\xeb\xe9\xf0\x85\x94\x8c4PХb\x14\xf5\x14װ\x00\x1b\x00\x00\xbd\aw\x14\xb6Q)\xa8\xd1b\vD\xc2\x14\xe8\xddߑ\xbb\x15\xbc\xa2!&`\x8f\xda\xcb\x02\xb8V'4\x04\t\xd7\a%\xfeQs\xb6\xa4 \x1d)\x99\xc3t\x85\xf9\x11ʡQL\xd2\xf5x\xbc\x05\xa6\n(\xd9\x19\f\xd2\x19\xe0U\x8b[ \xb1+\xf8)\\\x89\xda\xeb5\x1c\x9d\xab\xec\xfa\xee\xee \\6j\xae\xcb\xd2+\xe1\xcew\\+g\xc4\xce;m\xec]\x81'\x94w\xac\x12\xcb \xa7r\xc1\x11\xca\xe2w\xf5\xdd|i\t\xe6\xced7\xd6\x19\xa1\x0e\xf5r0\xd1I\x98\xc9T\xa3\xa1\xc4mQ\xdc\x06MZ\"\x10^\x1e_\xdf\xdaF$l\x17\xe2\x00nˮ\x1a\x9c\t\x17\xa1\xf6h\xe2=\x05S\"\x8e\xa8\x8aJ\v\xe5\xc2\x1f.\x05\xaa.\xc6\xd6\xefJ\xe1\xe8b\u007f\xf6h\xc9R\xf5\n\x1e\x98R\xda\xc1\x0e\xc1W\x05sX\xac`\xa3\xe0\x81\x95(\x1f\x98\xc5_\x1be\x02\xd4.\t\xc1\xcb8\xb7\xe3M\x970\x82S/\xe7\xc82z!\xc9w_+\xe4\x1d\xbb\xa7Mb\x9f\x9dt\xafMǵi˪\xc5r\xcc\xe9艞\xfbD1\xaf\xb3\xde\x13\xe2\x8f5\x19\x99\x06\x1d\xef\x95\xf8\xd9c\x88|\xd1\xe3p\x18\fL+\n\xb6\x1f\xba\xf1Uou\x14Az\xf0\x93K_`A\x87ۊ\xf1\xbe\x06=I\x1f\a\xe4\xe4Ў\tE&M\xb1\x98\xc4U\xcd\xdb\x10\xfe؈\x94dVBEn TPq\x04Yz\x84\xc3r \u058cN\x10\x92\r\xdbI\\\x833\xbe\u007fv\xdcǌa\xe7Q(rn\xbb\x0e\x89\x9a:y\xb5\x14<\\Y\xed\xbb\x01\x8c\xff'\x1c\x924\x0f1\xaf\\\x87\xc6f|Ov#\xb4\xf0qDwD\x93\xd3\xd52$\xddb\xa0L\x93\x03S\xbe\xd8a\x03\x0f\xb9!\xd7ʊ\x02Mt\xcc\x1e`\xb0\xd9\x0f\x01\xf6Rޒo3/]L2\xc6\x0f\xb0\xbd\x02\xa9\x9d\xd6\x12\x99\x1a\xc3\xeaZ\xf7\xd9\f\xc8{VS{N6\x1b\x9d\x8f\x18\xe8\x15\xa3\\\f\xf0\xa48`Y\xb9\xf3-0)\xdb\x0e\xc8L\x03\xe0okPW:֦O}\xc1\xb1\xa6\x11\x1a\x1aG\x1b\xa3\xc6\xd2\x12]\x8a\xac\xff\x03\x80I\xb6C\xf9\x8a\x12\xb9\xd3f\x16\xac\x1f۔\x11(ʋ\xa7\xaf\xab\xee\x1b\xa7a/\xa4C\x03\x1f\xc2\x1d\a\n|\x1cQ%\x9c\xa8\x00\x11\xaa\x10'Qx&;V\xd6B\xa9\x01\x13\xb4\x01%\xe4\xed\x80'a\x9cww0\x85?\a\xe1\x99\xfcE>8\x95b\xe9)\x99\xe3\xc7\xc7O\xaa\x85B\xe72\xa4\xe8\xc1\xd6\xdf\x10\x91\xcb\xe9+\xc0\x0f6cG\x05\x910X\x862k\x843\xc0[\xb0\xb2\x86*\xe8{\xff\xf4mh@0mD\x03!\xefg\x04I>Q_/e\x97\x9c\x88G9C\xaa\x8bo\x81\xc1;\x9ec\tMUzE\xa14\xb30\x18\x8a\xefp\xd1\xefx\x0eD\xa9\x9e\x1e\xe5:w)\xf1y\xc7\xf3ԫ\x9e\xbat^\xaa~\xa2\u07b4\x10\xa4\n\xf5XV5\xf4N8\xa5$=N\x8f\v\v\U000de69f\x8cȕb\xd7\x00\xb6z\xbc\x00\xf1\x17\x1b\xe1$\xfb:\x8aؾ\xcdHm1\xd8^\xee^\xb6ԇ\xd6̣Em\xd4-<iG?\x8f\x9f\x82Jt\xa6\x86\x19\xb4y\xbei\xb4O\xda\x05\xda\xff\b\x92(ԕ\x80D\xe2`\xa0*\xc66ҫ\xdd\xed\xd8\x10=\xe8V\xb3~3J\bK\x1d\x876Y\xf3Д\xc6#\"\xf3\xd2\xdbР(\xad\x96!\"e\xee3L\xebK\x136C\xa9M\a\xaf\x89\x83fx\xee\x10\xd2\xf1o\xd4w\xc5=\xb1Q\x96\x8cc\x01\x85\x0f\x10\x84Ώ9<\b\x0e%\x9aÜ\x9c\x15ũ髛\x89$\xf1\xb9\xe2n\xa7\xb3P~R\xd8)\xc6\x0fZ\x92\xadO\xbc\x99\xbd\xde\xd1^\xed:\xa9B\xf8\x0e\tnT{V\x14\"f\x98\xe7\v\xf1\xe9\x02>Ü\x11\x0fM\x89\x96Ud\xd9\xff\xa4p\x1a\f\xe5_P1a\xec\n\xeeØI\x8e\xdfl\x9b>U\x1em\xd6\xc4UX \xccOLR\xa8\xa7\xc0\xa1\x00e\b\xfc\xa3,\xf5~\x90\xd1n\xe1\xe3\xa8m\x8c\xe2{\x812\f\x1dn\xde\xf1|s\xdb\xf1<\x10\xe3\xa1\xf4f\xa3nb\x92\x18\xf8A\xdd\xf0i%\xcfp\x13\xdeݬ\x06Ip\x94\xedlb\x9c\xb1\x88\xc9Wu\xa5\xfb\x13\xab*\xa1\x0e\xfd{\xbe\xce\x16f\xec\xa0c\x03O\xbd\xd3:\x86\xd0.K;%\xfc\xf0\xb88\xc4\x1b)\xf6M\x1e%*\xa7Wp\xaf\xce\x03\xae\x96:ƑR\xb7\xdbA\x92H\x1fBJ\x8aJ\x89g\x11\x98\xb6\x19\xa5\xc1\x82ee\xe4?>4\x18\x01=q|\xde\xceW\xf2/5\xd9H\x1f\xd8R\x96*\xc5Z\x81\xe7\xed\xd0rB\xf1i\x15\xab\xecQ;\xf8\xee$X\x1abi_TF\x9f\xa8\x1f\xfc\xfeW\xea\xe8,?b\xe1%^\x1cڼ\xb6\b/\x8fm2ۡ-48ԝ\\F\xab\x88\x1e\xd8\x1d\x0f\xa5\x16&\xf1\xa5K\x1ek\xa2k\x86\xd1\x1c\xb4\r\x13W\n'\xd6s\x8e\xd6\xee\xbd\xcc\x1dO\x98\xd6S{\x1dɅ\xad\xa5\xbdr\x8c4\x96!\x96\xad\xe1\xd7\xc5\t\x9dc\xceۋ3\xba@\x05\x9cUΛT\xa4roLP*\xbe\xd3\xfb\xc1\x98\xee\x8a)\x1d\x1a\xa3ͅiO \x89\xeeεW\xa1V#\xbb\r{\xa1Dk\xd9!\x8fy>\xd0 \x1cPQ\x84\x1d\x19q\xa4:\x00?\x91\xfb\xf4\t\xa0ۧR$e\xdcQ\xfb\x15E\va\xb3v⩜I\x04\xec0qgB9<`7\x13\uf650\xde\xe0\v2\xdb\xfd20P\xffOm\xcaT\xdaE\xcdc\xe7\xc1\xbc\xc5\"M\x99\x9d089\x9b\xa4>\x94\x89A\xe77\x19\u007f\xab#\xb3\xf3n\xf8L\x14\xd9\xff\xda\xe6P{\xe0˨,\xa8|\xd9g\xbc\x84'\xfc\x18\xac\x91\xf2Xl\xeb\x0fC\x03\x82\x8dz6\xfa@\x99w\xf0\xeaA\x97\x95ġ\x15,\xe1\x99\x19'\x98\x94\xe7\xc8~\xe2\xd4kqj>[=^6\xe6m\x8f\xb87`!\xb3n\xf8e\x13\xfcN\fGk\xe9;\xd6N\xe2\xf7\xbf͠\xe4\x83\x19%\xd4a^ݿ$\xa2\x11\xefM\xfb\xff{\xfe\x9b\x05\xecz\xf0\xc4,\xef\x97z\xf0H,\xed-\x9d\xf2\x87\xdd\xd3\xd7\xe6_@k\x99\xbeĆ\x17\xd4}\x9a\x13\x16-\xec\x93(i\xa5\tЌs\xac\\\x9a`\xb6\xbfɆ\xaf\xa7\xcdG\xd7\xf0\x97k\x15\xab/\xbb\x86\xbf\xfem\x01\t\x81m\x96\x83\x16\xff\x1d\x00\x00\xff\xff\xf2\x9dI\xf7\x84\x1e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Ko#\xb9\xd1w\xff\x8a\x82\xbf\xc3|\x01,\r\x06\xb9\x04\xba\xcdz\x1cD\xc8d\xd6X\x1b\xbe\x049P\xdd%\x8bq7\xd9!ٲ\x95 \xff=\xa8\"\xfb\xa9~\xb0\xbd\x9e`\xb20O6E\x16\x8b\xf5f\xb1\xd8\x17\xab\xd5\xeaB\x14\xf2\x01\x8d\x95Zm@\x14\x12_\x1c*\xfaϮ\x9f\xfe`\xd7R\u007f<~ڡ\x13\x9f.\x9e\xa4J7p]Z\xa7\xf3_\xd0\xea\xd2$\xf8\x05\xf7RI'\xb5\xba\xc8щT8\xb1\xb9\x00H\f\n꼗9Z'\xf2b\x03\xaa̲\v\x00%r܀M\x0e\x98\x96\x19\xda\xf5\x1134z-\xf5\x85-0\xa1\xb9\x8fF\x97\xc5\x06\x9a\x1f\xfc$K\xbf\x01x$\xee\xc2|\xeeʤu\u007f\xeet\u007f\x95\xd6\xf1OEV\x1a\x91\xb5\xd6\xe3^+\xd5c\x99\t\xd3\xf4_\x00\xd8D\x17\xb8\x81\xcb\xcb\v\x80\xa3\xc8d\xca\x1b\xf0\x8b\xea\x02\xd5\xe7\xdb\xed\xc3\xefi\x81\\\xf8N\x80\x14mbd\xc1\xe3\xea\xb5AZ\x10\xf0\xc0\u0603\td\x02w\x10\x0e\f\x16\x06-*G#\n\x83\xabj\xf9\x14\xb4\t0\x01\n4R\xa72\x81\x9fD\xf2T\x16~\xaa=\xe82Ka\x87`J\xb5\x0ec\v\xa3\v4NV\xb4\xa1\xd6\xe2f\xdd\xd7\xc3\xf4\x03mŏ\x81\x94\xf8\x87\x16\xdc\x01\xe1\xe8\xfb0e\xb2\xe4\x02\xf4\x1e\xdcA\xda\x06o&I\v,\xd0\x10\xa1@\xef\xfe\x8e\x89[\xc3\x1d\x1a\x02Ra\x9bhuDC\xfbN\xf4\xa3\x92\xff\xac![p\x9a\x97̄\xc3\xc0\xaa\xaaI\xe5\xd0(\x91\x11\x13J\xbc\x02\xa1R\xc8\xc5\t\f\xd2\x1aP\xaa\x164\x1eb\xd7\xf0\x17m\x10\xa4\xda\xeb\r\x1c\x9c+\xec\xe6\xe3\xc7G\xe9*\xf9Mt\x9e\x97J\xba\xd3\xc7D+g\xe4\xaet\xda؏)\x1e1\xfb(\n\xb9b<\x95c\x99\xcf\xd3\xff\xab\x98f?\xb4\x10s'\x92\x0e\xeb\x8cT\x8fu7\v\xe3(\x99I&\xbd4\xf8i\x1e݆\x9a\xd4ED\xf8\xe5\xe6\xee\xbe-)\xd2vI\xcc\xc4m\tOCg\xa2\x8bT{4\x9eO{\xa3s\x86\x88*-\xb4T\x8e\xffI2\x89\xaaKc[\xeer鈱\xff(\xd1:b\xc7\x1a\xae\x85Rڑ\x88\x95E*\x1c\xa6k\xd8*\xb8\x169f\xd7\xc2\xe2[S\x99\bjWD\xc1y:\xb7MKw\xa0'N\xdd]ِA\x86T\x1azW`\xd2\x11|\x9a%\xf72a\xf1\x86\xbd6\x8d\x02۶\xa1\x19\xd7:^;\f\xed\xf6NZ\x89k\xa3\x15\xe0\v1\xb6\xd1F\x12\x8b\xe7\x03*\xd2\x11S*°\a\x11\x82iX\xf7\xfa\ai\xc7?`^\x90\xaaM\xa2v\x1f\x06\x11jD\x95\xb46\xed\xde\x10`m\x90t\xb0C\xa0\x87\xb1+\x8c>\xca4h{\x8fzS\x14\xa4\x86/IV\xa6\x98~#\x93_\x88dhL\x0f\xf1\x9b\xb3)dy\x9c\x90\x8ahLށ6\xa0\x9a_ɢ\x0e\x00\x05\x10\x06\x81t@*\x0f\x11$o\x10v\x83\xe4\xa6&\x1d\xe6\x83\x18Np\xc37\xf2\x87b\x97\xe1\x06\x9c)\xcfiX\xcd\x17ƈ\xd3(\x95*7\x1cO\xa4zF\xb0L\x99L\x90\xc8S\xdb\x1f\xa6\xd3o\x80D\a\xad\x9f\xe6\xc9\xf2'\x1a\xd5\xd8VH8\xba\x81\x1d\x1e\xc4Qjc\xfb\xde\x17_0)\x1d\xa6ôq\x90\xca\xfd\x1e\rA*\x0e¢\xadTg\x9c<S\xca@\xcdL\xb1\xf8l?\r{\x89QL\x83\xb1-\xb0\x91\x19\x81\t\x8c0Y\xa2\xb2\x00\xa9Ry\x94i)2\x90\xca:\xa1\x12\xbf/Q\xe36\xb4/\x98f\xfd\x19\xe6\u07b8T\xf8\x13_:vZ+\x04m '\x1ft>Ԏ\xae\x01\xa3\xdb\xdf\tK\x81\x97\x17_C\xc1aX,e\x17\xd0؋\xab\t\xe05w|\xa0\x92\x89\x1df`1\xc3\xc4i3F\x96y\xa6\xfb\x16g\vG\xe89`\x15\x83\x9f\v^\xaf\xd9\xe0$P {\xff|\x90\xc9\xc1\a\x19$S\f\tR\x8d\x96m\x81(\x8a\xec4\xbeY\x98\x97\x84\xb0д9hڬa\xe8\xc3\x1c6\x11M\x8b\xb2\xa7M\x9b\xb1\xac]:\xd7\"\xf2N\xe6\xcai\xbcJ\xa0\xb7g\x93\xdfZ\xa0\x89\xc0\x92\xce\x13\xdb=`^\xb8\xd3\x15HW\xf5\xce\xc3\x14Y\xd6\xc2\xe17\xc1\xa8\xd7\xe8ö?\xf7\x8d\xf5\xe1\r\xb8T\xa3\xf0?\xcd$v6w\xc1\xd7,`\xd0\xd7\xf6\xbc+\x90\xfb\x9aA\xe9\x15\xece\xe6\x90\xe3\x9ei\x14[\xaeo\x96SoE\x968\xafI-\x17.9\xdc\xd4\a\xab\xd9\xf1=\n\xf5\xa7\xfbX\xb9:It\x9d\xfc,d\xe0c\xb64\x98\xfb\xc3\xfb=\xeb@\xd3Ñ\xda\xe7o_0\x9d&\x14\xc4J\xe4\xd9v>\xf7Pn/\x1f\x8e\x01\xf1\x9b\t\x01U}\xc2\xf29\x98+\x10\xf0\x84'\x1f\x05\t\x05\xc4(AK\x8d\x1e$Ή\xc4\xc9 6\x11Oxb@!\xbf\x131?^4|{\xc2S\xdc\xc0\x1e)\t\xb3p>\xf64\xa5\x0e&\b'\x12\x96\x90\x118[\xc7\x1a\x02N\xc7l\x12\x96\x98\x9b\xaaU\x9cx\xd5vk6vR\x97Ox\xfa`=\xc3H;\x0e\xb2\x88\xde0\x19`\xb0\xc8zTe\xef\x1eD&\xd3z)\xaf\x0f[5\x15uw\xdb7\xed\xb6\xea\nn^\xa4%\xf4T\n_4\xdao\xdaq\xcfw#\xacG\xffUd\xf5SY\xf5\x947\xf3D\x8fv\x960J\xe8}\xdb\xfa\x13f\xcd*ia\xab\xe8\xac\x14\xe8©]\x86\x19/\x96\x8cR^ZN\a*\xadV\xech\xd7\x03kE\xc3\f\xecѦÝ6z\xade\xa3\xa1ҁΣvO\xbe\xc7C\xf0)\xebL$\x98BZ2QE4D\xeb\x8cp\xf8(\x13\xc8\xd1<\"\x14\xe4\vb\xb9\x11m\x9f}[,s\xb1\xa1AՂ\xa1OcPZ\x91^G\x8d\xab\xd8\x1f1x0K;=8fo\xec\xa09\x8e\x89\xa0\xb6HSNc\x8a\xecv\x91\x97Xĝ\xf3\xf8\xc1\xa3\xe7\xfdk.\n\xd2\xf0\u007f\x91\x8bda\xff7\x14B\x9a(-\xff\xcc\xf7U\x19vf\x87\xac[{!ZCZ \x8e\x1fE\xd6O\xf9\x8flQ\x93\x05\xc2̇\x02z\u007f\x16\xf9\\\xc1\xf3A[\xef\x91\xf7\x12\xb3\xe1\xc4W\xb7I\v\x97Ox\xba\xbc:\xb3K\x97[u\xe9C\x84\xbe\xd6G\x80\xad#\x0e\xad\xb2\x13\\\xf2\xec\xcb_\x17NEKg\xe4@\xbe܌\x0f\xc4\xe9$[E\x134\xb5\xbep\xa3\x10z\x1a\xfbH\xd9,\xb4u\v\x10\xba\xd5\xd6\xf9\x8ch'\xe0]\x96o\x03/W!\xcf\x06b\xefЀu\xdaT\xf7]d${ic⢝;p\x10c\xeb\xec\x9d\aK\x87\xb9\xcbF\xbf\xbd\xfd\xbd\xf4\x17a\xf4\xf7\x1cĄ\x03\x18\x86\\\x18\x9d\xa0\xb5sb\x13e\xe1g\x12\x9buRS\xf8\xc3\x12\xdf8\xcd\n\xeb\\\xb2\xb5jKBa\"\xe7\xe2\xe3\xc4\xcdK+/K\xe6\x83\xfe\x9f\x17\xd9\xe5\xd8\x01k}\x9e\v\x15\xe5\xc0\xce\x10\xbd\xf6s+\x15\v\xa0\xfc\x11\xc5<\x96l.\x96D\xaeA\xf8~\x9c` \x97jˋ\xc0\xa7\xef\x12>\xd4F\x17_w|\xb8\xaef7,\xa8;\x86\xaf\x0e\xc7Z\xa1\xf9\xbe\xc2`\x87\x93\xe7Y\xfdEa\xb3Ү\x9d\xfa ȅN?X\xd8Kc]\x83l4Li\xa1\x9c\xb5 M[~\x96S7Ƽ\xf2(\xf7\xb3\x9f\xdbJ\xc6\x1d\xf4s}\xcd̈́\\\x10u\x1f\xc4\x11A\xeeA:@\x95\xe8Rq҈\x8c\x01/\xe2\xd9\x11/\xc8\x10\xeb\xf7\x9a\x86\xaa\xccc\t\xb1bI\x94j&\xbfԞ\xf0G!\xb3\xef\xc5F's\xd4\xe5\x8ccnZ\xf7\x96\xde\xcf\xed\xd4/\xe4\xe2E\xe6e\x0e\"'FD\x93\x9c\xa2\r\x99cW\x06\xe0YH\xc7\x1e\x89 \xb3{r:\x1ad\xa2\xf3\"C\x87\xb0ý6\xac\xefV\xa6X\xbb\xfe \x17z\xfcޱ\xdf\x04\xec\x85\xccJ\x13mu\x17rc\xd9\t)\x18\x9e\xb7=\xf8Ģ\xb0b\xf2E\xa5\xa3\xa3C\xda9OP\x98%\x01\xed\xad\xc1\xb7\x0e\x1f\v#I\x16\xf5\\\x049\x03\x91\xe3\xcbn\x04\x19DT\xa8\xd3X\b9\x03\x93\xb1x\x0f!\xdfC\xc88\xb8\xef!\xe4{\b9\xdf\xdeC\xc8\xf7\x102f\xc2{\b\xf9\x1eB.G\xe1\xbf\x1fB\xcec\xb6\xe2\xdc\xe7\xe8\xcf\x11\xd8D\x95\x10L#;\xb9J\xa8\x86\xb9\xceJ\xeb\xd0\xc4\xd7\xdcn\x87\xe7\xb5\xec\xe7\xf3\x01\xdd\x01\r$~Ȋ\x1f\xa1\f\xcbFSnѸ\xac\xba\b\x97\x94\xadR\x14_\xc5>\x1b\x1dG\x16\xd7\xee\xb4\xceP\x9c+[\\)\xd7\\\x01W\xb7\x06\xb9.\x9e\xaa\x8a\x90\x87\xadFX:p\xcb?{hW\x03u\xeb\xb082\xaf\xb0\xfd\x81ꓣJ\xacf\n\xab\xa6K\xb8u\xb5\xc6\b\x15;\t\xf8.\xf9L\xa7\x8c\xf8\a\xa4\xdel\xed\xd3x\xc5S\xb8\x91C'\x8e\x9f\xd6\xdd_\x9c\x0e\xf5O\xf0,\xddapS\xfc2\x83\x8e\x8b\xea\xb1]\x18]\xc9bx\xd7ԧ*h\x03Jf\xc35\rD\xf0j~\x87\xdc\xf0s\xe1\x0f\xa5\xaf\xd2߹cRl\x8dԫ+\xa3\xbauO\xa3\x06~\xf9\x05ޒ\xb2\xf2\xf8ڧ\xb9R\xa5%\x15O\xedj\xa6\t\x90\xb1uNq'\xdeٚ\xa6WT2E\xd7U\xbe\xc9ue\\\xad\xd2\xf7\xa8PZP\x97ԭ7\x9a\x81\xbb\xac\x1a)\x92L1\x95G\x8b\xeb\x8dBm\xcf\xcc~\"\xaa\x8cF\xab\x87f@\x0f\xd41\xcd\xd7\f͑\xbf\x83ʛT\n\xbd\xa2>\xe8m\xeb\x88\xdf*Ɦ\xf6\x89\xa8\xf1\x89\x88\xcb\xe70\x8d\xa8\xe2YV\xbb\x13A\xc3W\xd6\xe9\xd4U8\xa3k/\xad\xce\xe9\xd6ތ\x82\x8d\xa9\xc9\x19\xa9\xb8\x19\x859Y\x89\x13[g3\n}\xd6}\xcfH\xce\xe4\xcfV\x89\xc2\x1e\xb4{\xd0Y\x99G\xc4\xccw\xdd\xf1\x03G/\x8a\xd8\xc4\x13B\x92\xe92\xad\xe1\x0fo\x8f_\xbb\x9f\xe0\xf6\x81\x9d\v?\xf4K\x9a'\x90\xc1}T\xa1\\\xff\x85\xe4\xf0\x9b]\xf8\xf5G1\xeb\xb4\x11\x8f\xf8U'\xadO\x16LѤ;\xbe\xf3D=0\xbfJ\xb6\x84\xaa\xa4\xe1\xb05\xec\xa8\x0f\xaeɱ\xfa(\xb8u^%L\x87\xe5bRs\x9d\xcbf7u\u007f\xff\xd5o\xc4\xc9\x1c\xd7_J\u007f\f^\x15\xc2X$\xdaV\x1b\xf4\x93vc\x06⠟!\xd3a\xf7?\xf5\xf17\xc8\xd9\\>o/\xdeőE\xb0\x12Ȋ\\\xf3\"\xfc0<\xaf\x15y\xb7\x98\xc6G\xbf1\xd9\x1d\x83$\xacՉ\x14\xfc&T\xba\xf0\x8c\xe3{<\xe8\x1dw\b#J?\xe4\xc7VC\xcf\xcaW\xf5\x1b\xf7\x8b\x19\xa0\xd6\tW\xda\xf9\x8f\x04\xf00HD\xe1J\x13\x92\xa4Ii\xf8i/\x81@\xff\x02v\xf9g\x022a\x9d\x17\xac\xc9\xd7\xf8_\xebaM\x94n\x9d϶V\x9a\a\xcf\xc2\xf2;|\x9fk\x95\xb6\xfb]\x94v\x1by\x89\xbf\xd7&\x17n\x03\xa9p\xb8\"ؽ\xdf'-\xd3(\xb3\xf9\xe9\xf3\xe4\xeeniD}\xa3\x13\xc8\xcaӪ\a\xd3#;\x19Jٯ\xe0\x1b>\x9f\xf5\xdd(B\xbc\x9fK\xf3YyL\x1f\xea/\xbc\xc4n\xaa\xf9&\f_\x82\x9c)@Wg{\x83{\x99\x1a:\xf17\xf0\xfc\x85\x87\x85\xff\x97熖\x8f_\t\xed\xe4w\xbd\xdfF\xb4pB\x03\x87\xb5o@Iz]\xc7\xea\xeb@\xc7O\xcd\u007f\xbc\xf4*|\xce\xe7\xe8\xef\x0e-\x9a#\xa6-Y\t\x9e)\xf44\x9a'\x92\x04\v\x172\x81\xed\xef\xfa\xf0\xd7w\x9a\xcf\xf6\xf0\xbf\x89V>\x06\xb4\x1b\xf8\xeb\xdf. x\x91\x87\n\x0f\xea\xfcO\x00\x00\x00\xff\xff}8\x8d\xbc\xc9H\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacVM\x8f\xe36\f\xbd\xe7W\x10\xdb\xc3^\x1a\a\x8b^\nߊ\xb4\x87A\xdb\xc5`\xb2\x98Kу\"Ӊ\xba2\xe5\x92T\xa6\xe9\xaf/$\xd9\xe3\xc4qvv\x81\xeaf\x8a\x1fO\x8fԳV\xeb\xf5zez\xf7\x8c,.P\r\xa6w\xf8\x8f\"\xa5/\xa9>\xff(\x95\v\x9bӇ=\xaa\xf9\xb0\xfa쨩a\x1bEC\xf7\x84\x12\"[\xfc\x19[GN]\xa0U\x87j\x1a\xa3\xa6^\x01XF\x93\x8c\x9f\\\x87\xa2\xa6\xebk\xa0\xe8\xfd\n\x80L\x875\b\xf2\tY\xd4h\x14ƿ#\x8aJuB\x8f\x1c*\x17VңMi\x0e\x1cb_ôQ\xe2%\xed\x01\x14<\xbb\x9cj\x97S=\x95Ty\xd7;\xd1_\xefy\xfc\xe6\x06\xaf\xdeG6~\x19Pv\x10G\x87\xe8\r/\xba\xac\x00Ć\x1ekx\xf7n\x05p2\xde5\xf9\xdc\x05`\xe8\x91~z|x\xfeag\x8fؙb\x04hP,\xbb>\xfb-\x81\x03'``(\x01\x1a\xc0X\x8b\"`#3\x92B\x81\x00\x8e\xda\xc0].7$\x060\xfb\x10\x15\xf4\x88\xf0\x9c9\x1b@W\x83CϡGV72\x98C\xa6\xf6\xbf\xdaf\x18ߧC\x14\x1fhR\xc3Qr\x8dS\xb1a\x03\x92\x0f\b\xa1\x05=:\x01ƞQ\x90\xf4\x1a]\xe6\xa4\x05C\x10\xf6\u007f\xa1\xd5j8\xbd\x80\x1cC\xf4\r\xd8@'d\x05F\x1b\x0e\xe4\xfe}\xcd,\x89\x86T\xd2\x1b\x1d\x1b<.G\x8aL\xc6'\xfa#~\x0f\x86\x1a\xe8\xcc\x19\x18S\r\x88t\x91-\xbbH\x05\xbf\a\xc6L`\rG\xd5^\xea\xcd\xe6\xe0t\x1cx\x1b\xba.\x92\xd3\xf3\xc6\x06Rv\xfb\xa8\x81e\xd3\xe0\t\xfd\xc6\xf4n\x9dq\x92\xe6K\xd25\xdf\xf1p\x19\xe4\xfd\x050=\xa7\xb9\x10eG\x87Ws\x1eٻ4\xa7q-\xcd/a\x05\xee\xc4f2%\x12\x9e~\xd9}\x82\xb1hf\xfc\x9a\xe2L\xee\x14&\x13ω\x17G-r\xe9Sˡ\xcb\x19\x91\x9a>8*\xa3c\xbdC\xba\xe6X\xe2\xbes*\xe3P\xa6vT\xb05DAa\x8f\x10\xfb\xc6(6\x15<\x10lM\x87~k\x04\xffo\x96\x13\xa1\xb2N\f\xbe\xcd\xf3\xa5\x16];\x16r^ͣ\xd2,6d\xe1n\xeez\xb4\xa9E\x89\xa7\x14\xebZg\xf3\x90C\x1b\x18\xccRH\xf5&\x86\xec\xfdM(\x06\x05(8f\xba\x90n\xd8[8\x96\x84 ۏF\xf0\xda4C\xf3\x98<敽kў\xadǒ\xa0\xe8\x00\xbe\x05\"-\xa4\xd8\xcd\xeb\xad\xe1#\xbe\xdc\xd8\x1e9$\x15\xc4f\xb6\xb3\xd8\u007f(\xd2~p$_>M\xf1\xc9?\x8bKA\xbd\x10\xd2!\rp$J\x170P2ϒµ\xde\xcev\x9dbw\x83c\x11\xc9\x03\xb5!\xa9\xa0\x9aT\xd2h\xb9\x1684u\xa8Q\x10ݤ\xbb\xd7Ӳ\xe6\xca\xf3\x15\x04\x96\x95\u007f\xd8\xdf\x1e\x98\x94\xc21.\xd4\\g,\v\xe6T\xe9Ƽxc\x06d\xd1{\xb3\xf7X\x83r\x9cG\x968\xc3l\xce\xd7S1\x8e\xd1\xf44\xf9\xe2\x80ܸ\xa7\xd9\u007f9\"ݛpx1\xb2ԛ\x92\x06\xf6\xe7{\x81ۤ\x82\xc1\xfb\xe9w=\xae2\x965$\x91]\xab\xbba\xe9+\x88X\xe8R\x19Յ\u007f\xff\r\t\xbbK\xcf\xf1\xee_\r\xfc\xf8\x14\x98#\xbfS|\xa1\xa93\xd3i|\x91\x9e>L_y\xb0\xd7\xc3\x132o\f\xa7h.N.\x1a\xd8\x1cF.&mM\x8f\xa8^\xb1\xf98\u007f@\xe6\xa7\xdb\xf4\x12̟6P\x93_\xb5R\xc3\x1f\u007f\xaeJVl\x9eG\x1c\xc9\xf8_\x00\x00\x00\xff\xff\xf0\xbc\x15\xae=\v\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacVK\x8f#5\x10\xbe\xf7\xaf(-\x87\xbd\x90\x8eF\\P\xdfV\x81Ê\x05\x8d6K.\x88\x83cW'źm\xe3*7\f\xbf\x1e\xf9ѓ\xc7$; \xf0\xad\xcb\xe5\xaf>\u007f\xf5hw\xabժS\x81v\x18\x99\xbc\x1b@\x05\xc2?\x05]\xfe\xe2\xfe\xf3\xb7ܓ_\xcf\x0f{\x14\xf5\xd0}&g\x06\xd8$\x16?}D\xf6)j\xfc\x0eGr$\xe4]7\xa1(\xa3D\r\x1d\x80\x8e\xa8\xb2\xf1\x13MȢ\xa60\x80K\xd6v\x00NM8\xc0\xecm\x9a\x90\x9d\n|\xf4b\xbd.\xde\xdc\xcfh1\xfa\x9e|\xc7\x01uF:D\x9f\xc2\x00\xa7\x8d\n\xc1y\x0f\xa0R\xda\x15\xb4mC\xfb\xd0Њ\x83%\x96\x1f\xbe\xe0\xf4\x81X\x8ac\xb0)*{\x97Y\xf1ar\x87dU\xbc\xe7\xd5\x01\xb0\xf6\x01\ax\xf3\xa6\x03\x98\x95%S6*Y\x1fн{|\xbf\xfbf\xab\x8f8\xa9j\x040\xc8:R(~wX\x021(X\xc2\xc0\x1fG\x8c\b\xbb\"\t\xb0\xf8\x88\xdc\x185H\x80\x85\x1a\xf7\xcd\x14\xa2\x0f\x18\x85\x16\xe5\xf2:\xcb\xfc\xb3\xed\x8a\xcf\xdbL\xb8\xfa\x80ɹF\x069\"\xccՆ\x06\xb8\\\x06\xfc\br$\x86\x88!\"\xa3\x93S\x0e\x96\xe5GP\x0e\xfc\xfe7\xd4\xd2\xc3\x16c\x06\x01>\xfad\rh\xeff\x8c\x02\x11\xb5?8\xfa\xeb\x19\x99A|\ti\x95`Kֲ\xc8\tF\xa7l\x96:\xe1נ\x9c\x81I=A\xc4\x1c\x03\x92;C+.\xdcÏ>\"\x90\x1b\xfd\x00G\x91\xc0\xc3z} Yj]\xfbiJ\x8e\xe4i\xad\xbd\x93H\xfb$>\xf2\xda\xe0\x8cv\xad\x02\xad\nOW\xabu2_\xc5\xd6\a\xfc\xf6\x8c\x98<\xe5\x1a`\x89\xe4\x0e\xcf\xe6R\xaawe\xce5Z\xb3\\\x8fU\xba'5\xb3)\x8b\xf0\xf1\xfb\xed'X\x82\x16\xc5/%.➎\xf1I\xe7\xac\v\xb9\x11c\xcd\xd3\x18\xfdT\x10љ\xe0\xc9I\xf9Ж\xd0]j\xcci?\x91\xe4\xc4\xfe\x9e\x90%\xa7\xa3\x87\x8dr\xce\v\xec\x11R0J\xd0\xf4\xf0\xde\xc1FMh7\x8a\xf1\xffV9\vʫ\xac\xe0\xeb:\x9f\x8f\xa1K\xc7*ΩEڄ\xb9\x99\x90\xdb}\xb8\r\xa8/\xda c\xd0H\xad/G\x1fA]\x88\xd7z\xf46Z\u007f\xe6z\xab=\xf3\xd2ލt\xb8\xb4\x01(c\xca\xccU\xf6\xf1ι\xbb\xf2ܸ\xeb\xa6\xc4\xc8\u0557/\x10\xa2\x9f\xc9`\\-wk\x1cRl\x97$\xb4\x86\xfb\xeeV\xac+\x85\xdb\xc5\n\xdc5\xbd\v\x06\x8f\xcd)sȲ.\x87\xeaT\xc16\xdcʨS\a\xbc\x1d\xfb\xc5=s\xc1Rċ\xa6[=C\xbfZ\x1d\xa2$\U0007fb4fr\xa8y\xee[\x8d\xe8\x14#:i\x88\xe0\xc7\v\xfa\xea\xbf\xd7H8*\xc6/\xea{\x1b\xfb1\x9f[$\xb74\xa2~\xb2X\xd1ʬ~QP\xff\x98i^\xe8\xd2tMj\x05\xeffEV\xed-\xbe\xd8\xf9٩;{w\xf2{#mW\xa6yy\xd6\xcc\x0f\xa7\xaf\"\xdejy\x87<\xd4\x1f{\x9e\x91f\x00\x89\xa9\x06o\x95\xd6,\xa7ZPZc\x104?]?A\xca\x0f\xff\xf4\x8a(\x9fڻڦ<\xc0/\xbfv\x15\x15\xcdnᑍ\u007f\a\x00\x00\xff\xff\xf7\x15\x9ep\x82\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96Ao[7\f\x80\xef\xfe\x15Dw\xc8%\xb6Ql\x18\x86wk\xb3\x1d\x8a\xad\xc5\xd6\x14\xbd\x14=\xd0z\xb4\xadEOTEʉ\xfb\xeb\a\xea=\xdb\xef\xd9ɖ\x01m^\x0e\x96(R\xe4'\x92\xd2l>\x9f\xcf0\xf9\x8f\x94\xc5sl\x00\x93\xa7\a\xa5h#Y\xdc\xfd\"\v\xcf\xcb\xdd\xcbٝ\x8fm\x037E\x94\xbb\xf7$\\\xb2\xa3_i\xed\xa3W\xcfq֑b\x8b\x8a\xcd\f\xc0eB\x9b\xfc\xe0;\x12\xc5.5\x10K\b3\x80\x88\x1d5\xb0BwWҗ\u008a\xb2\xd8Q\xa0\xcc\v\xcf3I\xe4L}\x93\xb9\xa4\x06N\x82^OL\x06\xd0\xfb\xf1\xba\x9a\xf8\xcbL\xd4\xd9\xe0E\x7f?\x97\xfc\xe1E\xab4\x85\x921L7\xae\x02\xf1qS\x02\xe6\x89h\x06 \x8e\x135\xf0\x0e;\x92\x84\x8e\xda\x19\xc0\xae'Tݘ\x0f\x91\xec^\xf6fܖ\xba\x1a\xba\x8d8Q|\xf5盏?\xdeN\xa6\x01Z\x12\x97}24\x13?!\xf8Ϋ\x80ni\xf0\xc3~\xa3\x82\xc3\b+\xeayR\vk\u0380u\xe7\xea\xd4\xf5\xd10\x80p\xaf\x815\xa4@\xa0\x141V\vW\n\x8e\xa3\x94\x8e\x00C\x00^\xd7}d\x8b\x99\xdaa;\x10\xe5\x8c\x1bZ\x8c,V\xcf\x040\x13P\\sv\xd4\xc2\xfd\x96\xe2\xd1C\x93\xec0\xf8\xd6|;i\xa6̉\xb2\xfa\xc3y\xf5\xdf(\xc3F\xb3gH\xae\x8cZ\xbf\nZK-2\x0et O\xed\x00\xba\x8f\xc1\vdJ\x99\x84\xa2\xd6t\x9b\x18\x06[\x84\x11x\xf579]\xc0-e3\x03\xb2\xe5\x12Z#\xb2\xa3\xac\x90\xc9\xf1&\xfa\xafG\xdb\x02j(\t\x02*\r\xe9s\xfa|T\xca\x11\x03\xec0\x14\xba\x06\x8c-t\xb8\x87L\xb6\v\x948\xb2W\x97\xc8\x02\xder&\xf0q\xcd\rlU\x934\xcb\xe5\xc6롲\x1cw]\x89^\xf7K\xc7Q\xb3_\x15\xe5,˖v\x14\x96\x98\xfc\xbcz\x1a->Yt\xed\x0fy(=\xb9\x9a\xb8\xa6{\xcbW\xd1\xec\xe3f$\xa8\xc5\xf2/\xc0\xadd\xc0\v\xe0\xa0\xda\xc7u\xe2jS\x06\xe3\xfdo\xb7\x1f\xe0\xb0ue?1\n\x03擢\x9c\x88\x1b\x1f\x1fה\xab\x1e\xac3w\x150\xc56\xb1\x8fZ\a.x\x8a紥\xacj]d\xfaRH\xac@x\x017\x18#\xab\x95EI}\xea\xc1\x9b\b7\xd8Q\xb8A\xa1o\xcd\xdb\xc0\xca\xdc8>\x8f\xf8\xb8\x0f\x9e\xfe\xccJ3@\x1a\t\x0e\x1d\xef\x89\xe3\x19\xb5\x88\xdbDnR\x13C\xcb\xe0S=\xd6\xfa\xf7х\xd2\xd2\xc4&\x8c\x9bƸğ*V\xfb:|\xb8\xe1\xe8J\xce\x14\xb5w\xe4b͙\xbbo\x1fQ\xb1\xe4\xb2\xf3\xed\xf0\xc1w\xa5\x83X\xba\x15e\xab\xcdH\xf7V>\x17&\xad\xc8\xe6)\xf3&\x93\xc84\xb8>\x93j\x80\x95\xc1\x13Aٿ\xdd7\xb8\nԀ\xe6r\x8e\xe3p\x1eV\xcd\x1b\xcag\xd2\x0e\x1fn#&ٲ>#\xe2\xe3\xd2\xf3H\x95\x15\xc3(\xde\x1d\ak\xc1rX\x7fa\x19@\xf1\xce\xfa\xeb\xfe\xd1#\xfd\xce\x11+gj_\uf55e\x13\xf3i\xf1\xe3Q\x8b\xffJ\xd7\xe0-\x16%\xb9\x06^_\u0604\xd1m\a\x8ay\x85!\x88\xa9\f\x9dd\xb8\x91\xfe\x17\x825\xe7\x0e\xb5\x9e\xeb\xcf?}K@\xc7=\xff\x83\xcd\xf1\xbdp\xc0b\x8a\xc0\xeb\xa9\xe3p\xbfe9\xde\xf4\x17\x16\xa1\u07b9\xb5\xbe\xed\x82\xde\xf7m\xb3\xbeL\x16\xf0\xea\x80\xccq\x89*\x80\x1b\xf4Q\xfa\x1eZ\x97\x80\x7f\x8a\xf5i\x7f/\a\xa2\xad\x11\xf7z\x89\xf2\x89\xee\x06\xb5\x17\xfbLg\xb7\xca\xfcd}2\xffh\u07fb\x98\x14\xbb\x9b\xdbѹ\f\x87?̈\xa2\x96\x9a\x96\xe8\x1c%\xa5\xf6\xdd\xf9s\xf0ŋ\xc9;\xaf\x0e\x1dǶ\xbeM\xa5\x81O\x9f\xedQW\xd3vx`H\x03\x9f>\xcf\xfe\x19\x00d\x1bC-\xfe\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]\x8f㸑\xef\xfe\x15D\xdfC'\x81\xad\xb9\xe0\x82\xe0`\x1c\x0e\x98\xf4\xcc\"\x8d\x9d\xcc6fzg\x1f\x82<\xd0\x12m3-\x91\nIu\xb7s\xb8\xff~(~\x89\x92(\x89\xf2t\xcf\xee^\xdc^`\xc7\x16Y,V\x15\xeb\x8bEj\xb5\xd9lV\xb8\xa6_\x88\x90\x94\xb3-\xc25%ϊ0\xf8&\xb3\x87\xff\x94\x19\xe5o\x1e\x7f\xbfz\xa0\xacآ\x9bF*^}\"\x927\"'\xefȞ2\xaa(g\xab\x8a(\\`\x85\xb7+\x84rA0\xfcxO+\"\x15\xae\xea-bMY\xae\x10b\xb8\"[\xb4\xc3\xf9CS\xcb쑔D\xf0\x8c\xf2\x95\xacI\x0e=\x0f\x827\xf5\x16\xb5\x0fL\x17\t\xcf\x102(\xfcI\xf7\xd6?\x94T\xaa\xef\x83\x1f?P\xa9\xf4\x83\xbal\x04.\xfdH\xfa7I١)\xb1p\xbf\xae\x10\x929\xaf\xc9\x16}\xc4\x15\x915\xceI\xb1B\xe8\xd1\x10B\x0f\xb9A\xb8(\xf4\xfcpy'(SD\xdc𲩘Eh\x83\n\"sAkh\xb2E\x9f\x15V\x8dD|\x8fԑ\xb4\xa3\xc0\xe7\uf4b3;\xac\x8e[\x94I\xdd*\xab\x8fX\x12\xfb\x14\xe6\xe8\xba۟\xd4\t0\x93JPv\x88\x8d\xf5\xb1\xa9vD\xc0XD\b.$\",\xe7\r`H\nT4\xd0-\x05\v\xd3\xd9>6h\xbc\x0f\x7f2h\xc0\xcc\x0fDL\xe3\xf1\x84\x05\xa3\xecp.&\xae\xbbm`p\xf9\xa9\xfb\xe3,6 q\xc1`\xe8\tK#\x8d\xa4\x18\x0e\xecD6\x1bȫmkp\xb8\xe9\xf47(\x14X\x91\xd1\xf1\xf1^\x11\x81\x9e\x8e4?\x86\xb8䘡\x1dA\a,v\xf8@P\xce˒\xe4Q\xc4\x1co\x9ek*\xf4B\xea\xf2\a~&2\t\x1f\xb3V\x90T\\\xc0\x98%\xcf5\xbc\x10-*\xf5cR \xca\"\xa8\xd4$\xcfl\xf7\x0f\xb6wOh\xf53\xd4{8'\xbe\x9f\b\x96]<\xf6\x98\x96\x13ĀǍ \xa6\x9fme\xf8\xd3\xf9\xa9\x16\x94\v\xaaN[\xf4\xfb1LL\xafG\xf3\\\xe6GRi\xa5\x05\xdfxM\xd8ۻ\xdb/\xff\xf1\xb9\xf33\x8a\x12\x95J\x84\xd1\x17\xad\xa9\x90\xb0\n\x11\xa9#V\xf0\xad\x16D\x12\xa6\xa4\x9ea\x8ek\xd5\b\x02\x8b\xe4\xfbfG\x04#\xca\xf3\x0f\xfe\xcb\xcbF\x82\xc8\xc0T\t\xc2\naTs\xca\x14\xa2\f)\x90\xa8\u07fc\xbd\xbbE|\xf7w\x92+\x890+\x10\x96\x92\xe7\x14\xc4\x12=\x82B\"\xa6\xefo3\x0f\xb5\x16\xbc&BQ\xa7;\xcd'P\xf4\xc1\xaf\xbd\xf9]\x03\tL+T\x80\x86'f\x1aV3\x92\xc2R\r棎T\"A\xectC\tp\x7f|\x8f0\xb3\xc8g\xe83\x11\x00\x06\xc9#o\xca\x02\xe5\x9c=\x12\x01\x14\xcb\xf9\x81\xd1\x7fz\xd8\x12)\xae\a-\xb1\"V\xa9\xb7\x1f\xd0\x00\x82\xe1\x12=\xe2\xb2!kM\x92\n\x9f\x90 @\"\u0530\x00\x9en\"3\xf4\x17.\b\xa2lϷ\xe8\xa8T-\xb7o\xde\x1c\xa8r\x06.\xe7U\xd50\xaaNorΔ\xa0\xbbFq!\xdf\x14䑔opM7\x1aS\x06\xf3\x93YU\xfc\x9bc\xb8\xbc\xee\xa06\x106\xf3\x9f6\\\x13\x04\a\x1bf\xe4\xc9t5\xf3j\xe9\xeaT\xe8\xa7\xf7\x9f\xefCY\xa3\xa1\x14\xc1ǐ\xb9\xed([\x8a\x03}(\xdb\x13\xa1\xfb\xa1\xbd\xe0\x95&0a\x85\x116\xf8\x92\x97\x94\xb0>\xb5e\xb3\xab\xa8\x026\xff\xa3!\x12d\x9ag\xe8\x063\xc6\x15(\xb4\xa6\x06\xedSd薡\x1b\\\x91\xf2\x06K\xf2\xd2\xf4\x06\xc2\xca\r\xd01\x8d\xe2\xa1;\xd2\xfe\x01\x94\xad%R\xf0\xc0y\x1f#\xec1\xeb\xfdsM\xf2\xcer\x80^tO\xadF\xddsѪ\x03c\xfa\xdb\xc58\xbe \xe1\xd3\xfa\x18\x9f\xbb\x8avв\x87\xd8\xdbюF\x98\xc0=\x82%\xa60\x05+\xaa5v_b\xec\x12\xb5s\xec\x83\xd1\xea,P\xd2v\xd9\xee\xc0|Ք\x14\xb0J\xb5\xb9\x8b@\xa5\n\x1d\xb1D;B\x18\x92M\x9e\x13)\xf7MY\x9ePS\x97\x1c\x17\xa63\xc8U\x0f\xf9.\xd9\xe0C\x15\xa9\"\xb4\x18e\xbe\xb5\x0eMY\xe2]I\xb6H\x89\x86\xac\xba\x0f]_,\x04>\xf5\x9eYu<C\xfc\x1b\xab\xb4)P\x89h\xda:ϯ\"\xda'rj]\x19\x81@M\xbd\x1e\x80D\x88\x9a>Vr\xa4֏H4L\x82\xf6Ǩ\xc2\f\x1fHE\x98\xf2fB3\xa5;F\x8c\xabX\x10$ȁ\xc2sR\xa0'\xaa\x8e\x19\xba\x8f\x19\xfe\x86\x15\x1a,\xf1\xe0\xde\xfc\x17\xcc\xe7\xbf#PkA\xf6\xf4\x19f\n\xac\xeb;\x162C\xb7{D\xaaZ\x9d\xd6!@/H\x11\x88\xf1\x99S\xa9\xf1$\x05\xea/\xa4\x19\xc6\x17d\x8f\x9bR}\xd1fQ\xde\xf3OD*\x9a\xcf0\xf3]\xb4\x93[\xe2D\xa2\xa7#QG\"\x10.K\xc7ecxG\xd6S\xbbf\xae%\xaay\xe1-ގ\xb4\xf3\xd2<\x01}\x0ec\xedN\x0e\xf5\x98\x94\x90\xe7\x9c\xd4\n\x1d\xb9T\xe0$\xba\xc1\xd7\xee\x1f\xa8\x16\x1cT?)Z\xcd\xde\xfa\x1a\xe8\xed\xddm\f*\xd8M\a\x00\x94\x85v\x025\xba\xd7\x16\xfb6F{c~\xd8\xd8\xf6\x1b\xf2\x9c\x97M\x11\x9d\xbf6\r\x81<4L\x12e\xe4\xc1\xc8\xf7\xb5ts\x05E\xd5HR\fY\x9c\xb4|w\x9c\x97\x04\xf7]\x0e\x8bZ\xe1\xe3\xba9E\xfa~\xd0\xc1\xa9M\xafF\xf9\x1e\xb1\xf6)\x88\xf3\x00\xa4Yr`\x15)3\xf0\x80\x9a\xad$\xfc\xec\x8a\xcd\xd1Ņ\xef\xa9d\xf1\xed\xad\x8fR\xd2\\;\xb3\xde\x13є1k\x1c\x8b!F\xe8\xd7@\x94\xcf\f\xd7\xf2\xc8\xd5\a\xbc#\xe5g\x02\xb1\x19\x17\x89\x04\x8a\xf65\xc4\x02G\xe4\xf1\xf7Y\xe7\xc9\x00(B\x15V\xf9\x11l\xf4\xdd\x17\xb9F\xdch\xe3\xbb/7\xd6\x04\xe7%\xa6zQW\xb0\x8c\xb0r\xdaĺ`Ҏ\xafH\x11U\x1e\x8f\x84\x81\x9dqhZ5\a\b\x82\x04\x19\xabp\xf7Ej\xf9\x95\x8a\x96e\x9fY\x11\xa0c\xec\x9baĸ\x1b\xe4\xc9\xf0\xfe\x19\xc2\t\x9f\x84Ah\x92\a\xfd.\x81\xeb\xc3\xf7\xa8\x04\xba#\xe9X\x02.,\x15ڜ\xca!\xea\xe6\x03\xc4\b\xdbi\xaa\xbc\xfd\xf8.\xa6\xa4&\xe5u\x80\xea\xdb\tt\xec\xd2rOF\x14\x8cuP\x9cn\xd2a\x82\\#\x8c\x1e\xc8ɄA\x10k\xd5D`\a\x04\t\xa2C(\xe0\"\xb4\x1a\x05\x8a\x99\x8f\x95F\xdaL\xb3\xceF:\xe44\xfe\xb0G\x8e\arrޓ\xa1\v\xfc\xe0=NO$\\\xd7%%r\x02*\x82\x88d\xe2\xf9\xa4\xe2p\x1fG\xb5d\xf4=\x99\xdbh\xcb0\xe2\x1aB\xa5\xd2ؿ#\xad\x91\xe2\x13 \x11\x04}D\x81:u\x91\xea\x17\\\xd2\xc2\xe3cV\xe5-[\xa3\x8f\\\xc1\xff\xde?S\xa9\xa6\xc9\x01\xbc|ǉ\xfcȕn\xfd\xd5\xc41\xa8%\x93\xc64\a\xe6bf,\x11\xcc/\x8cm\x8d\xa3\x18\xd7,\xed\x9f'1\x95\x10]r\xe1h\x002c\a1\xe0\xabFjM\xc88\xdbh\xf7sj\xcaȎ݁\xaf\t%A\xf5\x86\x94\v\x87\x9a\x84\xd8Eà\x80\xee!\xd26OL\x9a\xa4\xc4y\x9b\x14\xc5`\xe0\xb1\"\a\x9aO\x82\xae\x888\x10T\x83\x9e\x9b\x9aդ\x1eZ\xc0\xeb)c\xe9\xfe\xac\xe2\xea%5\xda\xcffB\xd5l<\xd9G\x1a\x8cD\xe9\xa9\xf8i\x83\xa0\xed\xed\b5\u009c\xfe\x9cF\x9b\xa5XG\ue0e1\xad\xf5\xc75H\xfe\xff\x80z\xd6B\xf4\xbf\xa8\xc6T\xc8\f\xbd\xd5\xfb\x11\xe5\x98\xfc\x87=\xac\xbf\x14\x02\xaf\xb0\x8e߀\v\x8f\xb8\x04\xf3\x01\x818C\xa4\xd4\xc6d\x04(\xdf\x0f\f\xec\x1a=\x1d\xb9$\xc0.\xb4\xa7\xa4,\x00\xec\xd5\x039]\xad;+d\x04\"4\xbeeW\xc6\xf4\f\x16\xa5\xf7\xa19+O\xe8J?\xbb\xca\x06\x06v\x04\xf6\x8cٝ\x94\x92\x89\x87}\x7f\xaf\xf5\xf9\xb7\xabI\xe6\xbe\x1f\xed\x88\xe8H\x98\xa0i;\x80\x8a\xd0\xdd\x17\x1f\x0fF<\xb8Y\x7f-\x02qփ\xfb\xa5\xb8\xdbG\xce\x1f\xe6(\xfdgh\xd3&1Q\xae7\x1dю\x1c\xf1#\x85\xbd\xae\xd0\x05\xde\x11D\x9eI\u07b4;)\xe1\x1fV\xa8\xa0\xfb=\x11\xb0F\xf4\x96[o\x7f.[-ss\\\xcc\x13}؛G\x1b7\x01[\xf4\xcc\xc7P\x87\x04C?\x8cu\x7f\xc09\xb0\x17\xb0\xe7\xc0\n\xfaH\x8b\x06\x03\x7f\xa5\xc2\f\x80C\x86\xdd㕭\x16ۆ\x0e\xce&\x11\xe80\aNt\x12\x9f\x9c\x110\x91\x15$ӇM\xc7M\xe4شwX\x92\x02ٝ єDڡ\n\x9dQm\xd7R,\xae\xe9q\xc4h\xa1\xae\x8b\xfd5\xbe\xac\xd3\x14\xedB\x1fo;\xa2+ڮA.ɥ\v̓\t\x90\xe0\xd7\xfa}D*\xb5\x04i8\xa8\xe0D\xea\xa0\x1a\x9c\xe3\xd3\xd8$g9\x9f\xb0Г\x97|\xca\xe2\x1f\xd2\xd6I\xcfr\xd2\xfa\x9e=\xcazq\x98\xf3\xbb\xff\x7f\x12\x96\xb2\xbe\xe4%S\xf6\x96\xbd\xae\xd0\xda@.L\x11S\x95\x18\xde\xe9\xc4k;\xfe\xaf\x981\xcb%\xfe\xb6\xdf\xf3E%~\x92+s\x10\x81+~\xf8_!S\xca0-\x97̐N2o\r\x995ǐb\x8d\xf6\xb4\x84\x1d\x94.g\xbej\xbd\xbc\x041R\xec]z\x02n\x84.KRq3p}\x88\t\xe1\x8c\xcc\x16'\xe5\x16I\xdeW$\xeaf\xe1Z\xd7gI\xca.\x01f/\xa9\x97\x90\xbc[.\nI\t\xbd\x11\x02\xa6\xa5\xf6\x92\xe0\xa2@\x17\xcdOn\x81\"q\x1fG\xfb3\xa6\x99\x9a\x02L\x82l\xcc\\b20\x11b'e\xb8(-x69\xe7S\x85#\xc4LI\x1a&A\x8d\xa6\xf7&Ӈ\x89`\x87I\xc6\xf1Db\"ȉtc4\xa5\x98\b69\xf1h\x92\x8b\x89PgS\x90\x8b\xb5\xeeY\x12\x96f\xda\xdd\xdf\\\xaa2-i\xb9 }\x99\x94\x85:wFA\x12pnBKҜg\xf1\xa2\xb3z\xd3S\x9f\xb3(\xb8\xd4\xe8\xe2$\xe8,\xe4N\x924)\x1d:\v2\x9e.\x9dN\x8c\xce\x02ML\x9c\xa6;A\x89\x92\x98\xd4\f\xa2\xb0\xed*Q, \f\x1d\x96HY77[}\xa5\x1c\xd6\\\xaadT\xee\xb8T:I\xd5uK\x97d\xb1\xac\f\xd9\xec\x95-\xf4\x86\x1a(W\xa0\tj\xaf\x97p\x05\xaeE\x93\xc0\xed\a\x8b #f\x80B`uծ`\x93m\xb82\xb5=\xf0o\x84sx2\x8d*\xc0\xad\x05\x87ʻi\x11I\xd0\xd6\x1dR\x0ei\xe6\x13\x84XsV'\xef撒\xcb\x1dR \xd2\\\x9b\x1e\xaa\uf7c3\xec%f\x1aĬ\xf0-\xc5\v>Pъ\xfbe\xbeI(ޘ\x9en\x99X@\xda[\xc3\xe2\xd0L푌\v\xe7/\xc1LW\x94\xddj\xc9\xf2\xc5\xf8/c\x04;J2V\xa8\x99@r۷%\xba\xffa\xac\xe0%\xf6Ws\x9d\xb9\x17\xa4ùa\x9e\x1br^\x89 !\xf9\x18\xa4\x13\x00n͋k\x89\xf6T\xb4强\xf04\x11b\xbc\xbe\xee\x058̙>+t\x06\xfd\x7f0=\xfdD\xc1\x1e<\xf9\x1aXM\xbe$\xa0\xc8l\n\x11\xc8\xc1P՞<\xd21\x84>\xdbdY`\x14t2\xc9\xd2\x14\x04|\bk\xaa4\x02l\xb4\xd4Q6\x99\xa7i?\x1b\xf4\x1d\xa6\xe5k\xb0\r\x8e\x94\xf0Fm\x13\x9a\xf6\xd8\x06\a\xa4x\xa3\xbc>\x05\xe1\xac\xf03\xad\x9a\n\xe1\nH\x9f\x04\x13\x81\xdd\x05,\xba\x1cGO\x98*m9\x00.\xb0\x00\"\xe2\x9cWuI\xec\xf1\xa6\xf9ώ\xecao*\xe7L҂x\xc3l\xa5\x80CI\xb5=J\xf4\nKbI\xaca\x95\xc5l\xcbD\xd7-u\xf0\x8d^\x10\xab\x17\x181E[\xd7\"\xddU\xbc\x13$\xcd=\x9bKJ[\xa5k\u0382\x81\b\xbd\xb0\x87fE\f\xb3\xd3\xc5E\xbb\xb8h\x17\x17\xed\xe2\xa2]\\\xb4\x8b\x8bvq\xd1..گ\xcfE\x9b\xc3h\xa3O=\xad\xce\xc4\"a{z\n\xc5\t\xf8\xb6\x9a\xc2\x1e\xc2tnN\xc4N\xc6*)\xfa\xbd\"\xe7\xfc\xec\xb9ō\xbe!$&\x01\xceo\n\x0f\xf6\xb9\x12\x0f\xbd@\x9cx\xebs\x00=\x8fs\xb5\x90PS\x87\xdd\xec\xa0\xefᴴ|ˊ;^|\xe0\x87DJ\xf4{E(\x01\xeb\xdb\xdc_0\x80\b{\xdbDW\xab*_U\xd9\xd6\xe8t\xe7\xdcf\xc2+.\xf5\x81\xffx¾\xe4\a\x0f\v\x0e\"\x02\x14\xaa\xd6]`p~\x90\xe2\x03\xe3p\xb4\x13\xfe-\xf4f\xbc\xae\xb8'\xa7\xeb(\xaa\x0fp~\x12\x18\xa3\x04ov%\x91Gε\xcd\x01\xbc\xb0 \xec\x1a\xb0\x82P!f\x8a\x1380Yr5Wh\xd5=X\xe7\x89\xe8N\xd6q7\xc8\x00\xb0;\xf3/un8\xac\xe2\xe9VL\xe9\xbd\x02\x87i\xb6J\xf62'\x95k\x92\xd8\xc6ֶC\xc4-\xc1\x8f\xed%?\xe1'u\vk\xc2G\x9e1\x0eS\xea'ʳ\x0eƨ\xa4\xfaf\x04\x17X\xca\xf0T\xe4\xe4)\xd1\xf6\f\xb0\xbd\xe7\x028\x05\x85\xb0\xc4\x16\xbb<\x90\x93\xb4G\xb8-\xb85\"\xd9!C\x92\xe4\x82D\xa3\r.PA꒟t8\x92ẖ\x91\xfd'\xa2Ck\x8d)\xa0l$l\r*\xab\xc2j\xa4\x88Z\xb6\x82\xf4\x06\xfeխ\xcd-B\x1cM%S\xd5V\xff\xa3'Z\x169\x16E\xb4\x84WO\t\xd7\xf5\x9bb\xb7\xf9]\x86n\xe3Dt\xebӞQ\xf6\xdf*\xaab¬\v\x00\xfa\f\xd3k\xcc.\r}\x1e\x01\x98f\x01\xb6\xa3\x85\xab$\x02\xb7\xa3\x85\xb2\xf3\xd6Ô=k\xb1\xdd.\x13Ǿ\x06q3JQ \xddI\xf54H\x9c4\xd9*y\t\xbe\x8e\x02\x99)\xdc\x1b/\xd7\x1b?\x95\vD2\xc5{\xfa\\\xfe\x00&\xd4O\x12\xa6o\x04c\x87\xb0\x12\xdf)`ţt\x84\xba\x13FK\xad\x90'\xd4w\x87\xbc\xe8\a\x8d;.\x17\xcb\xd8tF\xa4\xbf\xdf\x1dkӣ^\xbf\xcbTQ\xdf\xe5|\xed\xe5|\xed\xe5|\xed\xe5|\xed\xe5|\xed\xe5|\xed\xe5|\xed\xbf\xe6\xf9ڒ\x1f\xee\xef?lW\x93\x8c\xfc\xa0\x1b\xc1\xf4\xb0\xce2f\xef\x1as\xd3\xe5\xa6\xc6B\x12\xf0o\xacP\xd8~\xbb\xb8|@J\xba\xe46\x81\xf8'\x97\x1b\x80\x1cBK2\xf8\xa6\xbf\b\"\x9bR\xb9\xf0\x02\x02\xfd\x18i\xec\xfe\xdd:\xc8\xeb\b\x02d6y\x9dޅF\x90l\xe8<\x8f@\xc4\xd2\xe0\x88e\x80f\xb6Z\xb0\x14*\xfc\xfc\xa7\x93\"r\x86\xaa\x7f\xb1\xcd\x10\xed\xe6}%\xfd'\xd1\x19\x94\x1d\x00Y\xf7\xef\xa7\x1a\x00\x85\x8d\x9b\nl.\x9c\xcdTp_iY\xfaRg\xfb\x1d\x1d\x04\x7f\x92\xa8\xc6Rij\xb5\x00\xe3\xbb\x1ex\xc7\x05\x1c\xf8\x04F\xc0\x06|\xfa\xcdP\xe8\xdfQEpt\x1f\x95q\x13\x04\x0e\x89i\xc2Y}W\xec\x1f\xff\xb0ԇ\x1e^3\xdb\xfeU\xf8\xf96n\b\xfa\xac\xd0\xcd\xfa\xac`\xfe\xba\\\x1d0\xb5\xeeX\xe7v\xdc\xf0\x13\x84Қd\xfa\xe8\xad\xe9\xfc4\xb8Q\xac\xc7\aG\xf5\b\xd8\xf3\xf90A\xf5\xaf\xa0\xab\xcf&\xb8pl\x86\xc0\x1f\xfb\xed\xadC\x1b\xa4*°\xd7ꪘ\x10\xe9\x05\xbf\x83\x9bg\b\x15\xc6S\x06\xa2(\xedfJ^>\xda\xd3\xd9=Ҋ\x86i\x1d\x13\x81غפ\b\xd1\tC<\x84\xb5N\x80H3\x92\x0f\x9c\xc9i\xb4\r}R\x03\x1c\xab\xab\xdf]\x05\xb9\x8d\b\x06\x11\xa8\x9d\xb0s)C/\xc1\xe6%ؼ\x04\x9b\x97`\xf3\x12l^\x82\xcdK\xb0y\t6\xbfy\xb0\xc9EAD\xb0\v\xb2]\x9d+\x1f\x93\xb2ё\x8b\x1fzc\x06{\xe6\xc0Y\x8d\x12\xb0ٝ\xe9\xb7\xdezd\xcc\xce>WgW\xd0\xed\xf5q\xbb\xfd\x05\xb5\xac\x15\x16'\x04w\x9aÝ9pIo\x04bxk\xb2\xab\xb3\x81\rJH\x8d\xd1\x1c\xcf\xed-\u0086\xfbWl,\xea⺍$5\x86\xb4[\xe17\x1ac\x88ƶ\x1e\x17l4\xc6\xc4\xe8\xbe\xdd\xd0\xf3\x9b\xb1m\x81ko\xdbU\x9f\xa6\xf0e\x05\x9ai\x11\x90.\xc27`\xd7h\xcf˒?A\r\xf0Iӕ\xeb\x12\t=\xdab\xe7yB\xack^\x98;Z\xed\xb5\xec\xd6{\x92\xdbiɼ\x1b\xe9\xd6\xf5\xa2c{_1\xae\xfb+iA*\xacfq\x97E\xb7\xb9\x91\xe8e\xd6\xeb\xf6]\"\xb1\xb5\xe8v\xca\x1c4Ǵī\xa7c\x90\xc3\x1b\xa7ߚ;\xbaqg\x06\xd7\xd2\x0f\xd7_i\xfaj\xed\b\xd0\xfee\u06dd\xeb\xb2Ϻo\xbba%\x91\xd2\u074c\x0f$h\x11_\xb7:#\x87\x05\xdeߣ\xb6\x03G\xa0\xe2X\xa1ܨ\x031\x1d\xc4\x18Iѿ\xfd\xa3!\xe2\x848\\\xca\xee\xbd\xda\xc9\xf5\xe7\xa2-Ȳy\xf3dm\x1c\x90n\x10ܵF\x01\xbde&\xa7\x1f\x05\xdb\xc3Q\xc3\x01v\x94~\xef\x15L0,\xb7\x91\xa6Q\xa8\x8c\xfbޫ\xe5\xf1Q\x7f2\xf1V=r\xbfxx\xbb<\xc0\x9du-\xa7\xe5\xe3\xcc \xf7\xfc0w\x02d\xea\xc5')\xa1\xeel\xb0\xdb#\xcc\v\x86\xbbs\x01\xef\x8co\xd2~\x1c\r\x17L#5\xec]\xbd\xd8\xc5%\v\x02\xdfe\xa1o2\x99\xe6\xc3\xdf\x1e\x91^*\x00~\xc5\x10\xf85\x82\xe0\xf3\xc2\xe0\x19\x90>HN\r\x84g\xf5\xd5\"\xdeυ\x9bi\x01\xf1tH\x9c\x10\x14O:\x7f\xa9\x98\x06\xe6u\f\xd1\xd4\xe0'\x99\x86\x9du\xf1r\x01\xf2+\x85ȯ\x11$\xbfn\x98<\x1b(\xcfJ\xce\xe4㤈$&q6~\xbc\xe3%ͣ2\xd4\x11\x8cO\xdd\xd6m\x80\xbcF5\x11>\"[\xb75\xe6Q\xcdi\aE\xfam\x92:\x9a{\xe2\xe2\x01\xde\x1de\xc3MS\x96\u07bf\xe1X\xd3Z\x87w\x11\x985\xcc\xe0dE\xc0{\xb3n\a\x04\xb2.\xa0n\x9c\xdd\x06)\xa3*C\x9f:\x98D\xc0vЁ\xe2\xd8`w\x8fq7j\v5[%k\xb9\x1ee\r\xc6!\x85\xbd#\xe2\xe8eG\xe3\xe3\x16\xa9\xa5#߷\x96ۓ#[-w\xa2̠\xf1g\xbdI\xb4X\a\xfc\xd7B\x92\xd9)H\xbb2'\xa6\xd09\x83qm\xe9M\xa5.\xf3\xcfV\xcb\x0f\x82m\xd0\xf7\x84\x8c\xf99\x1b\xf4CEc┤6=\x9aI\xd4i\xf3J؞Y\xf4\xfd[\a\xb3+P#`\xc1\xb1\xb4\x89\x9d~\xfa&C\u05ff\xbb\xf6RN\x95\xbbauR\x04\x12\x8cq\x82\t\x996kS\xa6wc\xa7\x1d}\xe41\xfff:Q\x12,\xf2\xe3-+\xc8\xf3v5\xc9\xd2\xcfm\xcb Y腟\xa3]CK\x1d\x06Q\xddfT\xec\xfd$\xd7.w\x06\x1e\xb2\x8e\x1b\xfd\xa1\x19\xbb\x12B\x95h\x9a\x99\xd7\xeeE\xa0\xc2%\xbc\xb0\x19\r\xa7\xf1:\xbd\\\xfaq\xf8\x12[3\xf7ъ\x17;\xc9|,36u\x9aFv/\xbd\x9f#m\xef\x8a\xfc(y\x15~\x80W\xdb\xf1\xa6\xf0\xd0ck\x06t!;\xa1\xbb/z\xe7_\xdf\x19\x9f\xb7\xd6\xc5*I\x9b2\xf0{\xe6\xee\xf1XUO\x92x\x8dP\xa2\xfb^\xc49Jt[\xdb\xe8\\\xefu8\xa7ĝ\xb4t\x17qŜu\x9b:\xec\x01k\x0fP[9h\x13\x80\xd3'\xa6\xa2\xaa@\xa9rf2ˋ\xc3\xe0\x02\x99\x01L\xd4/\x0e\x1b+\xeaZ\x82\xbdI\xc49\xc1s$\x9233\xfa\x12\xef\x15d\x80\x02&\x01\x83F\x12\xe7cp\x827\x02\xebD,\\vc\x99\x95\xad\x92\xb5\xf8Ĵ\xc7U\xe1\x88z\x857\x127\xbdQ:$q\xa2\x06͜\xf7d\x8f\xfa7B\xbf\xb0A\xfa\x17\xaa\xff\x82^\xad\n\xef\x16\x16\x85}\al\xf8\xc6\xf7\x01Dd\xb1\xbd\x96\xf0\xbaTx\xfd.\"8?\xba\x97g\xb6\xc8Eޣ\x99γ4\xbccd\x96\xe1\v\xe7\xbb\x1fЅC\xec\xf5\xdb\x1f\xceA~\xde\x7f$S\xd7\x14t\xa6h\xae%\xb0>\xaf\xeef\xac\x14ϵ\xd8\xd8b-@\xd9\xea\xbb\x11\xa0\x8e;n7¡\xaf/\xf4\xc6l4\xe32\xb9F\\)\xdbvu\xf6\xe5\x88NW\xf5\x18x6:\xfa\xad'I\xf8\xdcAK\x87\x10\b\x87\xc7h \tSd-g0\x9ev\xc3o\xece\x02\xc5j\xea\xd2\x05R\x9cG\x8ei\xffr\xe4\xac\xfb\xeb\xf8\x8f\xf6\xd6\x04\xca\x19\\\xab%\x15\xae\xea\xedj\x92?7\xc3\x1e\x1dm\xa4\xefkp\xcb\x16=a\xe9of\x88f\x13Zp\xda\xcc\x02\xe3\r4R\xe8C\xdd\xf0Z\x16(\x11\x85\xedN\xcd\x7f\x99\xf5\xfbD\xa0\x86P\xec\x0e\xb4\xf1<\x9d\xf7a\xd1so\x84\xbf\x0fkN\xc7a\xc2%v\xe0mƈ G\xcb\x7f\xe1\xcd\xe4\x9b(\xd0$\xb6E\xc5(\xe7̨>9\xcb.\xd7Їr\xfa\xa8\x98B|\a3\xb6\xf6\xa4\xb7\xc4\x060\xc1\x11Ċ\xd8Hι\xb5\xa0\x83\xc1\xd9\xd5\xef\xd8.\x04\xddw\xb6\"\xf5\x13}r\xde9\x01Q\xb0]\xbd}\xae\xcd1\xea\xca\xcf\xd6&ʃ9:-\xe2UJ<K<\xe6\xad\xcc\x1b\x8f\x12Ku/0\x93\xd4\xc9E\xbc]\x0f\xf1\x0f\x83n6)\xc1\xec]AvF\xd7r\xcaT:\x04P~\xc4\xec\x10_ji2\x99$\x99\xb3\xf2i\xb3\xc3DJ|H\xb3C\x7f1ma\xf2\x18\x1d\x9b\n\xb3\x8d \xb8\x00$\x10y\xaeK\xccB6\x8e@D\x11ze\xe7b/\b\x96\x9c%!\xffI75\xb8\xef\x04%\xfb5\xba\xc1\x15)o\xc0\x96\x198\xfe\u009a\x00\xc3\x11\xd0\xe8k1\x8fy\xbd#\x98_[\x9f\xac\x97\b\xf3H\xa2#/\v\xb9E\xf7\xa2!k\xf4\x1d.%\x89\x15\x15X\x87M\xa0\x1f\xd9\x03\xe3O,\xbb>\xcb\xee^\xc10W\xe3\x8f\xf5\xf8\xe3\xcf\xed\xe0\xe7\x92M\xd35\x85h\xf7\xa7ڻ(\xd0\xc9\xe9\x16O\xb5\xec\xac\xd9\xc3K\xce\xde\x19-:\xda\xe6\aQ\x1f1{\x1d\xcfcT\xbfl4\xdco\xe6\x94\x10\x96\x8bS\x9d\x90nx\xef\x1bZso\xee\a\xb3\x85\xe9\xc6#\xb7\xc0\xe4\x9c}\xc3B\xd1=\x86\xac\n\xf8,O\x02\xd75)\xda\xf4\x13U.\xed\xd40\xfb\x10|v{\x86K\xdf{\x12\x01ڎ\x99-%ϴ\xb9y \xa7\x9f4\x16#\xe1J\x87L\xdf\xfb\xc61G?xZ\x97́\xb2\xa9*\n?\xf5#\xd1e\x80\xb0\x83\x95\xadΐD\v\xe8\x1dV\xf8{rJ\x98\xc4O\x9d\x0en\"P\x86\xf8\xc7?l\xe0\xce]\xf0\xf5\x1cz_\x8f\x1ae\x87\xef\xc9\xe9\xf6]*f\xae\xbdClߔ\xe5i\xf3\x8f\x06\x97\x90\t,\xd0\xed;Go'\x99Q\xc0q\n\xaf\xa1(Q\x10\xd5\b\xc8\x15\xedNq\xbe\x9d1\xd9q}\xb0\tD,\xf2\xf0\xa9Í\xb1\x06\x8e(\xab\x05zC\a\xd3\x11\x99\xef\x90\\\x87\xdfv\xc3W\xef\x87\x01q\xe1@\xa0\xee\xed\xfc\x0e\xbb\xf7\xf5\x04i\xc3\x03a\xb0\x1b\x1eU\x9c\xb6l\xa0\xbd\xfb\x8d\xefÕk\xea\x9bp\xae\xe0\x9e\r=\x80;E5\xa7SJ~\x80Wq\xe9\xa6&\xd4p\xfen\xb6\xe8\\\x1ay\xae\xa9Hɿ\xbe\xf7\r\x03'\x92J\xeb\x1d\xc3o\xa4\xa4\a\n>\x15\xb8#\a8Fy \x9b\x9c\x97P+\x145\\\xaf\x19\xc5\xd8\x1b\xf6>\x8d8Z\x9d\xa9}\x17\xb6\xb5\xee}\x90xɱ\x0e\u0380!\x84)*\xc8x\xc8\x01W\xac`ZfK0\x85\x8b\x1e\xe5[\xa5\xa0\xfe\x84\x143\xa8\xfe\xb9\xd3\xd8i\x84\xf6ԥ\xbf[6\x10\xd0\x01D\x84D\x03\xc9.\xa8\x1b\x95\xae\xb2%\x90\xcaE\x02\xa4\xd1\a\n\xa6\xe1nZ\xa6!\x0eh\x0e@\xa2q\xc4\xfd\xa1OR,\x9b\x03\x1c\xa1~GJ2O\xff\x0fmK\xf7\xbabH\xa7\x05+\xc1\x9e\xcfF\xfaN\xd0\x1d!\xac\xbf\x14H\xb1l\xc3\b\x90k\x17_\x02~\xd3+5z~|\x00\x14\x8d\x9d(oϏ\x83\x9e\xfaE-\xf9\x91D\xe0x\n0L\xc3{\x9fel\xa3+\xee_o\xd0G\xf2\xb4\x1a\xcb\xe1\xe9\x9bX\xb4Ό4\xb9ew\x82\x1f\xa0T6\xf2\xf0'L\xe1\xf2\xbe︸ӎ\xd3\x0f\xb5\xbdiqY\xe3;p=qY\x9eFr\x8aS\xe9\xc8\r\x9a\xef=\xfa@\xaf\x91!\x8b\xa6\xf9\xd7C~\x8e\x95\xbd\xe6>\t\xc5۟\xa4\xc2p>\x1c\x8e3\x8c\xea\xec\xe0.s\x8bBD\xb5\xacm\xe9:U\xfa\x16\x7f\t\x82l\xd3\x7fQ\x90>3)\xcf\xcd:\xf5\xa6g7\xd28;lD\xc3\xf4.\x9a\x9f\xa7\x9bf\x04$\x82\xa9\xfb\x94\xe9p\xaan^\x81\x12M\x98\xdf\xdc\f\xe73Z\xb9 8\xaam#\x94\xb81m\x03e\x16\xf0X\xa7\x81\xed\xfcc\x88\xa4)\x9d$\xd53+\xc0C\xdcS\xa6\xf7\xae\xfd\xe2\x14\x93\xe1͵\f\x1bZ\xfd\xb4\x9a\xae\xbd}\x91\xec\xd4W\xeeU\x85ܱ\xf9uز=\x1b\x1d\xe6uT\x12N\x1f}sm\xc3>\xdes\x85K\xfb\x86\xfa'T\xc1k\r\xf8\xbe\x8bf\xd4\xc3vGZ\xa8=tPpFL\xa5\x89\x87\x039-\xe2\x1dv\x18Go\xae\xb5,\x1c\x05+Hͅ\xb9Y\xb3\x9a\x13\xdb\xf8\x1d\x1f\xf3^\x8d\xa5\x9e\x9e\xff\xf6u\xc7\xf8\xda\x1dA\xaa\xc6\xc90/\x1f\xee\xfc\\2\n\xbau\x88\x87\xf9!@f\r\xc5\xeb\xe3'v\xe0\xa3_\xc9s-{W)\x9f=\v/\x8c\xb7\xef\x92\xe6\xe1-\x03$\x06\n\bL\xda#\x9a\xee\x91\xdb\xfa\x9d\n◠\xf6#\xa3J.\xc3\xeeG\xbf~\x00\x11\xb3\x9a\xf8\xbe\xb7HG \"\xbbx\xed\xd6Е\xbe\xde\xe7\xeag\xdd(\xf6\xa48/\r;\xe1\xf3\xb9&\x9e0\xa3-F\x9c\xaeT*hYH#\x83n\x1a\xae\x13G\x86\x88?1\x91wB\xceAI#\xe1\xec\x14\\\x99\xe0\xa2zS7\x8d\x83\xe0M\x1dd\xcf\x1c0;\xb5\x11\x90\xd6M\xf4\xe5\x82~\x12\xa1\xff\x11/\xb5J\x9cTS\x17\xc9\x1eяu1\xee\x11]K\xf0\xbet`\xa1\x91\x83\xbd\xbb\x11\xa0\b\xe5G\x02\aE\xb3\x19\xf3\xf0-<\xa7\xe9\r\x84\x91\xd2\x05w\x14E\xeb\xc1\xe8\xf3QC\xdc\xd6\xd4~\xb3\xdd\a\t\x87\xab\xa5z\x9b\xa7\xc47\x9f;\x8d\xbd\n\x8d,=\x9f]\x8ci\x15#\xb2\xfaM\x16:\xd8g\a\x02\a\xc3-*\xe6\xad{\xe7\xc6(\xb7\x8aT\xf7\xb4\x82`$\xdc$\xd1E\x89ԍ\xcau\xf4\x01'\xa8\xed\xe9\x83\xf8&\x1f\x17\xc1\xab\x83\xe2!\v8\xc8\xea\x9ch\xc3\xd0)\xfe\xac7%C\xee\x97\xd0y\xb0\xf6\xdc\xcbw g\xe5\xfd\x1cs\xf7\x96v.\x9c\xb7\xa8\f\x11\xa9\x1c)8n\v\b\xa0YS{`P\x01A\xca}\x8c(\tK\x0eA\xa0\x8b\x93i\xe3\xeaT\x11\x1d\xf2\xf9\xe7\xab&\xfb\x17\xf7\x1d\xc1wt\x8a\xec\x9b\x1b\xc4\xec5T\xbd\x93\xc9ev\xc0\xa1\xfd\xad\x95y\xcam\x89V\x95\xeb\xa6^\x91\aG\xa8\xfa\xea\xd9)\xcd\x01T\xa4ot\xe8\xean\xb8[\x00`\xd9\xf22W\xb9\xef\x94\x0f(\fp\xbau\x9e~\xc4\xd9\x1e\xaa6\xb9F\xbbF\xe97\x81\x05Z\xa7W\x18;r\xfa\xe1b;.\xb6\xe3b;.\xb6\xe3b;\xc6m\a\x04\x8c\xbe\xbew\xbb\x9a$\xfa\xe7Nc\xaf-\xed\xda\x0f\xf4\xddL*\xfc\xb3\xbd\xb8\xcal\xe1\xe9\xaczXe\xbc\x863\xec9\xe4i\xb02g\xbem\xc5'ܿ\xed\xf7\xffb\x80\a%Ν\x82\xe6.\xfar\xb5<\xc6L\"sT`\xeceΟ\xe9?I\xca\x05\xd3\xf7\xbd\xe6N̗_0\xedn\x91N\xa8ΘN\xc1N%_\x1f\xfd\xf6\xe2\xfb\x94ʖv72\xacq\xf1\xaf\xd4\x01t[\x88\xb6\x1ae\x00\x11\xa1\xdf\xc0\xf1\x118Y\x9b\x03O~\xbb\xc0\xfeO\xae\xec\xb3ג\xb9F\xeb\v\x112j\x8b\xba$\b\xdb:\xee>گ\x96\xab\ue150\xfa\x9e\xe813m\xf7\xaf\x021\xc8V\v\xa6\xfb\x98\x88m\aO\xbbʍ\xbc8\xac\xb3e\x12\xd39]\x97\\u\xf2e\xa4\x9b\xc3LA\x966(\xe6\xc0\xae\xc1\x00\xacC\xa1=\xa8j\x8b\x97'N\xf3-\x98\x90O\x9e.\x9b\x90\xef66!\xd9\xe49\x91RW\xde\r \xfb\xa3.\xa4x\xe1\xd9=a\x01;\xbds\v\xfb'\xdb,R\xb4f!D\xca\xd6\x06 Q[\xc8\xe6\xf6\xbf}b\xa9\xab\xf1\xb2\xb0j\xcd\xe1\x88p\x14f\xaf\x92\xed\x85\xea֢Vy\xf0\xa3\xb6IE\xa0P\xecH\xf6\x97\xb6\x94\x1d\xe7pE\x9e\xbdw\x1c~@聲b\x8b\xaeLAx]6\x02\x97\xf6\xab\xafĖ[\xf4\u05ff\xad\x90=!l\x17\xabܢ\xbf\xfem\xf5\x7f\x03\x00bN\xea\x97v\xb8\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcZ[o\xeb6\x12~\xf7\xaf\x18\xa4\x0f~\x89\x95v\xf7e\xa1\x97EN\xd2-\x8c\x9e4Ar\xf6\x9c\x87n\x81\xd2\xe2\xc8fM\x91Z\x92\xb2\xeb\xfe\xfa\xc5P\xa4.\x16}I\xf7\xa0\xb1\x81@\xbc\f\xe7\xc2\xf9\xe6\"\xcf\x16\x8bŌ\xd5\xe23\x1a+\xb4ʁ\xd5\x02\x7fw\xa8\xe8\xc9f\xdb\x7f\xd8L\xe8\xbb\xddw\xb3\xadP<\x87\x87\xc6:]\xbd\xa2Ս)\xf0\x11K\xa1\x84\x13Z\xcd*t\x8c3\xc7\xf2\x19@a\x90\xd1\xe0'Q\xa1u\xac\xaasP\x8d\x943\x00\xc5*\xccaŊmS[\xa7\r[\xa3ԅ_l\xb3\x1dJ4:\x13zfk,\x88\xd0\xda\xe8\xa6Ρ\x9fh)X\x9a\x03h9\xfa\xe0\x89\xbd\xb5\xc4>\x06b~^\n\xeb~<\xbd棰ί\xabec\x98<Ŗ_b\x85Z7\x92\x99\x13\x8bf\x00\xb6\xd05\xe6\xf0\x13\xab\xd0֬@>\x03ص:\xf5\xec.\x80q\xeeU\xc5\xe4\x8b\x11ʡyв\xa9T\x10f\x01\x1cmaDMKrx^\xfd\x86\x85\x83p\x0e\xd4F\xef\x04G\xe3\x97\x02\xfcf\xb5zan\x93CF\xaaʎ\xa6IG9\xbc\x8c\a݁\xf8\xb3\xce\b\xb5N\x9d\xf8\xa1)\xb6\xe8\xa2|\xc0\f\xfaӑ\x83P'\x8e՞ɠ\xd6l\xe5\t\x84\xa5-\v\x1f\x86C\x97\x18x1X\x8a\xdfa/\xdcF(p\x1b\x84\x11\xc5\xf3\x87\xd7~\xf3\x91\xfc\x83\xa1K\x87\x7f٠۠i\x8f\xf5*\xe8t\x1f\x8d\f\xc2\x02\xdb1!\xd9Jb\x82)\xc7\\c\xb3z\xc3,\x8e\xf9\x18\x8c\\b\xe3\xd3\x06A2\xeb\xc0\x89\n\xcf2\xb3g\x16\x8a\r\x16[\xe4\xe04\xac\xe2\tp\x05\x8ft\xc2g&\x05\xef\xbc4,m\x19\xfeH\f\x84y\xe4#\xcei$\xc5\xf7\v\x9aJX\x7f١\xd4g\u0558\xe0\x8a\xccɊ\x02\xad}\xd2<\xb2\xdd\xf2r\xef\x87a0>Qa\xbbp\xf7\x9d\x7f\xb0\xc5\x06+\x0fB\xf4\xa4kT\xf7/\xcb\xcf\x7f\x7f\x1b\rØ\xf9$:xk\x0fԽA\x83\xf0\xd9\x03\x91\x17\tm\x10\xb0\xa3\t\xd0^I\x9buC\xb5\xd15\x1a'\"b\x05\x03\xf5h;\x18=bjN|\xb7\xf8\x01\x9c`\x16\xad\xd7j\xc0\x14\xe4AT\xd0%\xb8\x8d\xb0`\xb06hQ\xb9\xa1\x96\xe3\x9f.\x81\xa9\xc0_\x06oh\x88\f؍n$\x87B\xab\x1d\x1a\a\x06\v\xbdV⏎\xb6\xa5\x9bE\x87J\xe60\x80e\xff\xf1\x18\xa6\x98\x84\x1d\x93\r\xde\x02S\x1c*v\x00\x83\xa4\x05hԀ\x9e_b3x\xd2\x06A\xa8R\xe7\xb0q\xae\xb6\xf9\xdd\xddZ\xb8\x18e\n]U\x8d\x12\xeepWh\xe5\x8cX5N\x1b{\xc7q\x87\xf2\x8e\xd5b\xe19U$\x9f\xcd*\xfe\x8d\ta\xc8\xceG\xacMnH\xfb\xf5\xe1\xe2\x8c\xc2)T\xb4Vo\xb7\xb6r\xf5z\x15j\xed-\xf0\xfa\xfd\xdb'\x88G{ݏ\x88\xc6k\xd0o\xb4\xbd\xc6I?B\x95\x1eh\x84\x85\xd2\xe8\xca\xd3D\xc5k-\x94\xf3\x0f\x85\x14\xa8\x8e\xb5m\x9bU%\x1c\x99\xf9\xbf\rZG\xa6\xc9\xe0\x81)\xa5\x1d\xac\x10\x9a\x9a\\\x93g\xb0T\xf0\xc0*\x94\x0f\xcc\xe2\xd7\xd67)\xd6.H\x8f\xd7i|\x98\x13\xf4\x7fD%\x0fJ\x1aLĘ\x7f\xc2<I'}\xab\xb1\x18y\a\x11\x11\xa5\bNKH\xc4F$!\xbap\x92\\︧\x9d\x97>=V\x1d\xcf\x1c1}\xdf-\x1cqY_D\xcb\tY\x00\x99d\x92\xbe\xa8\x9aj\xca\xc8\x02^\x91\xf1g%\x0f'\xa6\xbe\x18\x11\xc0\xfc\nSҷЪ\x14\xeb\xe9I\xc3\xc4\xe6\x94\xca.\x90>\xd2ۃ?\x89\x9c\x91L\x18\xb3\x9bE\xb4n\xe0\xa41\xc1\xcc\x02%\xb7ل䉋F\xdf\xc2 G\xe5\x04\x93\xf9\x05N\xba\x85\xc4\ry\xe7\x16\x0f\x84\xb9\f,\x16\x06\x1d\x84\\%\x86\x06\x0f\xads;\xa1\x1a2WJ\r\xc1m\x98\x83\x8d\x96\xbc\xa5\xd83CϬ\x05\x81(\xf4\xdcB-\x9bu\x97\x83\r?\xacq\x1b\xdaX\x10<G\xac>\n\xbb\x94N݂\xa59\xe6\xbaKd\xa1`)\x8a+Bg\xe0\xa2,Ѡr\xc0\x8aB7\x1e\xc1\x96%\b7\xb7@xc\xd1ݶLzΠ\xb18\x91$A\xbc\x93m\xa4+\xd2k\xb4'r\x9f\xfeMMI\xe5\x03\xa5498\xd3L/\xediW\xa5\xcf\x16\x0f\xa9\xe1#K\x7f\xeamK\xa2\x05\xeb:\r\x16%\xc53\xc2\xea\fੱ\x1ep\x8fq%\xfe\xed(o\x8a\xbb\xb7x\x98\xcar\xd1\x15BJs\x99\xe59U\x1b\x91a\x83\xad͒\xa0\xbfmVh\x14:\xf4\xd5\x1cׅ\xa5\x10[`\xed\xec\x9dޡ\xd9\t\xdc\xdf\xed\xb5\xd9\n\xb5^\x90\t\x16!\x97\xb9#V\xec\xdd7\xfe_\x92#\x80OϏ\xcf9\xdcs\x0e\xda\xe7ЍŲ\x91\xd1-\a\xe9έ/\xd9n\xa1\x11\xfc\x9f\xf3Y\x82\xd2%\xbdho+&\xaf\xd0\r\x85\x06Q\x1e`?H\xec\xdfZ\xabh\x03\x14I\xc9\xd8U\xb0f\x8b\xce|\x96\xa0\x1axZi-1\xe13\x14\x8f\x85\xc1\xa3̂\xbe\v\xd8\xe2\xe1=\xa0$\xbc\xef\xb8\xc4e\x1dI\xb6\f\xcb\xc8q6z\x9fF\x8b\t6LhB\x02-\xfeZ7\xbf\x05\xcc\xd6\x190\xa8\bc0z\xcdW\xf6\xfe\xd3Z\x9dhv>T-IPH\xdd\xf0\x8e\x82\x97l>\xb7\xc0\xacm\xaa\x94\xc9\x03,+X\xde?\x81\xd1\x12\xe1\xfe\xf5'\x1f\xe2￼-_\xdf\xeeo\x81\xc1\x0fZ\xaf%\x01\x8cى\x02#\xc4\x02VL\xc8\x13\x14\x89\xc2\x0f\x0f/_\xb4\xd9J\xcdxd\xf3\x16(\xc1\t\xf9\",\x1fۓ\xfeh\f\x1e\xafL\xa3\x10\xc0\xd2\xcbӨ\xc6\"\xf7\xbb[\x17\xc9\xfe\x94wVɄh\xa2e\x9f\x0e\r\xefn\xe2¦\xf9M':\xf4Y\x04\xc6OL\x06ퟘMh\xf6\x14\x9d\x94n߯\xaas\x98Q\xf5\x95\xeeU\xa01j\x83䳳\x9a\x7f\x1e\xae\x8dI/\x84\xac*\xf8\xb6E\xe7\x84Z[PH\xb9+3)\xf9\x9c&_V\x14\x16\x9d\x066\x84\x9fP\xfcD@y\xa7\xb3\xb6\x1d\x9f+.Q\xe8V\x05?m\xb7Q\x06\xd4X\xf4\xf7\xf8\x12\x1b\x17mDI\x05\xf5\x8f\xae\xe0%4\xae\x02/5s\x1b\x10\xca\n\x8e\xc0\x12\x9c\xb5\xa8\x98\xa4\n=\x0e?\x87H\x97}\xdd\xdb5\xea\xa8]w\xbfL\xbda\ny[0\xbdh)\x8aK\x01\xea9\xb1\x85\x10uO\xf0i\x81k\x85>\xcd\xf3\xea*|C\xb9\xab\xa7S\x01E\x97P誖萇\x80e)M\xa5һ\xcbha\xbf\xd1\x16\x81\xcaM:Ki\x90Z\xad\xd1\xf4\xdd\xcb\xe1\x1f\x9d\x1cwf\xf0\x88%k\xa4\xaf\xa9\xe1\x11\xe9\x9clv\x1d\xf6,\xc2\xfa\xc4\xc4\x133\xdb\xc4\xf0r\xad\xb4\xc1\xd9;,\x1a\x9d\xeb\x82\xd6c\xbb7Ʈ\xb8-\xe6\x87G\x91\xfe=\x1c\xec\xba^\xe1\xbf\b\xbaP]\xbc\x02\x9f\xa7;b\xba\xa2K\x87jd\x00\x10]+sB\xd5c\xcd\n\xfb\xa6\xe6\x89\x14%\xd6]Tg\x93-\xa1\x8c\xe7&H\nK\xde\xc83\xb8\uf5d1\x9a\xbe\x05.,\x1d\x12\xa2?\xb5Wߝ\x8d\x9c\xd4c\xda/\x17\xa0\x87\xa8|4\x17\x8d8\xbb\xc2[\xdb\xe6n>;i\x94t\v\xc5\xef\n\vWQ\xf2\xc6P)\x11H\x8e(zwd\x7fm\x1b\xe5f\xd0G\xa1\x06\x9d\xea2\x16*12\xf8\x8f\x82Gj\xb6Q\xea\xc0s\x92 \xe1a@\xd7L\xe9=m\x1f\xd0\xf3$@\xb77\x92\x8a\x06\xdf\xc7\xf4\xd0\xd2N텔T\xf0\x19\xac\xf4.yC)\r0(\x0f\xc0,)g\xf7\xb7\xec\xdb\xec\xe6j\x00\xf9\xda]\x1aT\x859\xb4\x16?\xaf\xd5ﻅǐ\xb1\xf0\xc1\xab'\x04\x8c\x9a\xc3ց.'$[,\r\xd5\"\x88\xb1gߒJ\xe8m\x03\x18\xac\xb5\xf1\xf8}\xf0\xc5\xd7 >\xa7L\xd5\x161\x19,\a\x8e\x0e\xa2\x1c\xe6\x8b\\\xa3U\xf3H\x19\x84{\xb7\xa7\x9eOE\x98\\k#\xdc&a\xb4\x89*\xef\xe3ک&c\xcbj\xa8\u0378:I\x18(\xa9\xf7\xbd}\f\x05\xd2\r\xdb\xdb|[ٛ\xa9\x84\x17\xee\x02}Q\x91\n\xf8\x15R|߮$\x19b\xd5\x1c\xed\xba7\xc2y\xd8\xd6#\xfb&i\x82\x7fw\x18\xe4E\x1e/O\xf6'\x8ak\u07fbY>^\xc1\xfb\x8fxX>\x86J\xad\xcbe\xa9§\x9a\xad\x13c\xc4X\x92(\x84\xca4\xdc5\xa2 \xa8m\xafغ\xbd\xbc$~cрa\xa1\xaf\xc0T\x1c\x8fV\xffSv\"7y\xa0\x88\x83\x9cޛ_!\xf3\xc7\xf1\x8ex\xf7\xc6\xef\x0f\x83\xb8\xe4\xe5I4\x8f\x9f\xc1\xfb\xc44\xfb\xa56\x15s9eX\xb8p\xfd;\xc3w\xb9\xdc\xff\x95\xbc\x86\x9b\xfc\x9e\xec\x95t\xf1vP\x05\xf2W܉\xe9+\xb7\x89No>NvD\xbd\xb6\xaf\x83B6\xf5k|\xb7qg²_'\x84\x01J!1b\xe28\xff\xea\\(a\xb2\x0fo\x1f\xe7\xbe'\xeaP\xb9\x94\xbd\xf6\xf4.\xd2z\xb1@\xa8\xd0\xf7-dc\x1d\x9aD4\xecB\xd90/N\x90\r\xef\x90\b\x7f\xba~\x00G\x87\x05\x15\x84Pl\x98Z\xa3=\x86\x80\xf3\x9c25\t\xa0}\xb8\x14\xeaT\xac<sEz\x8b\xa6\xbdd\xe2!\xfdⴃD\xee\xa3e\xa3`\xef\xd5\xfb\xec\xfd\x0es\xc1Y.h\xa1ϱ\xaf\xd4\xc4xCZ\x1bQzس\x94=\x03BL\x92\xf2\xbfT\xf8\n\xad\xbd\xdc\xecxjWE1\r2\xab\xd5DFhT'\x05MNh\xc2@A\u009d\x87\xc93<\xfb\x9f\x85\\\xe0\xf8\x85ր\x98\xa6\xe0\x1d\xea\\\x91n\x9fK5\uf8e4\x89\xb9\x7f+vr\xf6\xa4\\I\xe4\x9d\f\xfaڌ\x0f\xec\x1c00\x8c\xf4u\v\xbdW\xad\x1d\xf2\x9f\x8e\x7f\xe3us3\xfa\xa1\x96\x7f,\xb4j_;\xda\x1c~\xfe\x85~\x81E\xb9$\x0f\xaf\x1al\x0e?\xff2\xfb\xdf\x009+G\x93\xdd&\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacVM\x8f\xdb6\x10\xbd\xebW\f҃/\xb5\x8c\xa0\x97B@\x0fɦ\x87E۠\xc8\x06\xb9\x049\xd0\xe4\xd8bW\xe2\xb03#o\xdc__\f%ٲwݴ@-_4\x1c>\xbey\xf3AU\xeb\xf5\xbar9~B\x96H\xa9\x01\x97#~UL\xf6&\xf5\xe3\x8fRG\xda\x1c^W\x8f1\x85\x06\xee\x06Q\xea?\xa0\xd0\xc0\x1e\xdf\xe1.\xa6\xa8\x91Rգ\xba\xe0\xd45\x15\x80gtf\xfc\x18{\x14u}n \r]W\x01$\xd7c\x03\x01;T\xdc:\xff8d\x973\xd3\xc1uR\x1f\xb0C\xa6:R%\x19\xbd\xe1왆\xdc\xc0ya\x04\x10[\x03\x18\t\xbd+Xo\v֛\t\xab,wQ\xf4\x97\x9b.\xbfF\xd1▻\x81]w\x83S\xf1\x90\x98\xf6C\xe7\xf8e\x9f\n@<el\xe0\xbd\xebQ\xb2\xf3\x18*\x80\xc3(g\xa1\xba\x9e\xc2>\xbc\x1e\xf1|\x8b}\xd1\xc9\xde(cz\xf3\xfb\xfd\xa7\x1f\x1e.\xcc\x00\x01\xc5ș\xe3\xcb!@\x14p \xe8)\x05\xc8\xc8Bi%0\xf3\x02ځ\xb68r\xb6\x04\u0378`+\x0e2\x93\xa2W\f0\xc6SÈ.й-v\x18βoN\xbe?)\x0f\b\x8e\x11(u\xc7\x05d9\x05\x03P\xf2\b\xeee\xba;\xa6\x1e\x84z\xa4\x84@\xda\"\x83\xb6.\x15\x96\x8c\x7f\x0e(\x8a|Is\x19\x00\xe0\xd7(*\xb0#ۇ}}r\xcdL\x19Y\xe3\\\x18㳨\xe9\x85\xf5JוI?zA\xb0bF1\xf09}\x18\xa6l\x8dd\xa2\x00cf\x14L\xea\xaeD\x9d\x85M@\xdb?\xd0k\r\x0f\xc8\x06\x03\xd2\xd2\xd0\x05\xf0\x94\x0e\xc8\n\x8c\x9e\xf6)\xfeu\xc2\x16P*\x87vNq\xaa\xca\xf3\x13\x93\"'\xd7\xc1\xc1u\x03~\x0f.\x05\xe8\xdd\x11\x18\xed\x14\x18\xd2\x02\xaf\xb8H\r\xbf\x11#Ĵ\xa3\x06Z\xd5,\xcdf\xb3\x8f:\xf7\xb2\xa7\xbe\x1fR\xd4\xe3\xc6SR\x8e\xdbA\x89e\x13\xf0\x80\xdd\xc6\xe5\xb8.L\x93\xc5'u\x1f\xbe\xe3\xa9\xd9euAM\x8fV\xf4\xa2\x1c\xd3~\xb1P\xba\xf2\x1f\x04\xb7\x96\x9c*\xb7l\x1d\xe3:\xebj&\x13\xe3\xc3\xcf\x0f\x1fa>\xbah\x7f\x01\n\x93\xcc\xe7\x8drV\xdc\xf4\x89iW\n,\xcaXx\x86\x89)d\x8aI\x8bھ\x8b\x98\xaeՖa\xdbG\xb54\x97z\xb4\xd4\xd4p\xe7R\"\x85-\u0090\x83S\f5\xdc'\xb8s=vwN\xf0\xff\xd6ۄ\x95\xb5\xe9\xf8\xef\x14_N\xde\xf3\xcfP\x9aI\xa4\xc5\xc2<Zo\xa4\xe7\xa5\xc6}\xc8\xe8-c&\x9am\x8f\xbb\xe8K\xf5\x97V|j\xa3o\xa7\x19\xb2\xba\xceѩw\xe3<\x980\x8c%\xbc=\xc2SK\x8b&\xbe\xdd\xc8\xf6L\x9b\xf9\xda~E\xff\xcd\xe46\xd3\x1d\x04\x19\x88A\x90\x0f\xd1&\x93\xf74\x94\xfc;=\x11z\x06\t\x17s\xa7\x86{\x85~\x90R\x00!\xeevȘ\xf4\\T\xa7\xd1u=\xb0.c\x1b\x9f{]\t\b*l\x8f\xe5\x10#\x86lc;\xf4Ql\xe4\xc0\x13n[\xa2\xc7y(\x94\x10\xb4u:ު(7\xe8\xces\xff\xf9\xa97\xca\xc6\xfec\xda\xec\xe2\xfa\x86\xb0oO\x8e\xb3\xb4v\xa5\xcd\x11\x8f0\x96P9\x87\x0f\xcf:v\x91\xc8\xf0\x1fh\x9a\xc0\x91\xf1j\xaa\xac\x17\xe4\xbf]\xf8όE\xf8Ѐ]h\xa3A\x89\xdd\x1e'\x8b\xa8ӡ\\'\xce{̊\xe1\xfd\xf5\x87ǫW\x17\xdf\x0f\xe5\xd5S\n\xe5sH\x1a\xf8\xfc\xc5>\r\x94\x18\xc3t\xc3H\x03\x9f\xbfT\x7f\x0f\x00es.Sq\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacVMo#7\f\xbd\xfbW\x10\xdbC.\xf5\x18\x8b^\x8a\xb9m\xb3{\b\xda\x06A\xb2\xd8\xcbb\x0f\xb2D{\xd4\xccH*I\xd9u\x7f}Ai&\xfe\xc8d\x93\x05j\xcfe$\xf2\x89||\xe4h\xb1\\.\x17&\xf9/H\xecch\xc1$\x8f\xff\b\x06}\xe3\xe6\xf1Wn|\\\xed\xde/\x1e}p-\\g\x968\xdc#\xc7L\x16?\xe2\xc6\a/>\x86ŀb\x9c\x11\xd3.\x00,\xa1\xd1\xc5\xcf~@\x163\xa4\x16B\xee\xfb\x05@0\x03\xb6\xe0\xb0G\xc1\xb5\xb1\x8f9\x11\xfe\x9d\x91\x85\x9b\x1d\xf6H\xb1\xf1q\xc1\t\xad\xc2l)\xe6\xd4\xc2q\xa3\xfa\xb3\xee\x01\xd4x>\x16\xa8\xdf\n\xd4}\x85*\xbb\xbdg\xf9\xfd%\x8b?\xfch\x95\xfaL\xa6\x9f\x0f\xa8\x18\xb0\x0f\xdb\xdc\x1b\x9a5Y\x00\xb0\x8d\t[\xb85\x03r2\x16\xdd\x02`W\x99,a.ǌw\xef+\x9c\xedp(\x14\xe9[L\x18>\xdc\xdd|\xf9\xe5\xe1l\x19\xc0![\xf2I)\x9c\x8d\x1f<\x83\x811\n\x908\x06\a1 D\x82!\x12B\x8d\x94\x9b'\xd0D1!\x89\x9f\xf8\xab\xff\x93ʟ\xac^\x84p\xa5QV+pZrd\x90\x0e\xa7Lэ\x89A܀t\x9e\x810\x112\x06)28\x03\x0652\x01\xe2\xfa/\xb4\xd2\xc0\x03\x92\xc2\x00w1\xf7\x0el\f;$\x01B\x1b\xb7\xc1\xff\xfb\x84͚\xa7\x1e\xda\x1b\x99\x8a|\xfc\xf9 H\xc1\xf4\xb03}Ɵ\xc1\x04\a\x839\x00\xa1\x9e\x029\x9c\xe0\x15\x13n\xe0O\xa5ɇMl\xa1\x13IܮV[/\x93\xe2m\x1c\x86\x1c\xbc\x1cV6\x06!\xbf\xce\x12\x89W\x0ewدL\xf2\xcb\x12i\xd0\xfc\xb8\x19\xdcO4\xb6\x04_\x9d\x85&\a\xd5\a\v\xf9\xb0=\xd9(\xe2\xfd\x0e\xe1*\xddZ\xe5\xeaZ\xf3:\xf2\xeaöT\xe0\xfe\xd3\xc3g\x98\x8e.ܟ\x81\xc2H\xf3ё\x8f\x8c+?>l\x90\x8a\x1fl(\x0e\x05\x13\x83K\xd1\a)/\xb6\xf7\x18.\xd9\xe6\xbc\x1e\xbc\xf0\xa4@-M\x03\xd7&\x84(\xb0F\xc8\xc9\x19A\xd7\xc0M\x80k3`\x7fm\x18\xffo\xbe\x95X^*\x8foc\xfct>\x1d\x7f\x8aҎ$\x9dlL\x13\xe8\x85\xf2̴\xe4CB\xab\x05S\xce\xd4\xdbo\xbc-\xe2\x87M$\xd8w\xdevSK\x9e\xe1±}\x8f\xad\xfar\xbb\xea\xbf\xc2\xe8ȹ\xdcy1y}\b\r_v\xf9\xb3\xcc\ue2d1&\xb2\xef\x0eE\x00%6\xcdco\x9e\n\x8e\xae\xf9\xb1\x93\xab\x17\xbdz\xf8h7\x11\x99\x19I\a\x9a\x8dC\x8a\x01\x8b$\x8d\x1c\xa3\xd0\x00\x9fA*h\r\xb9\x81\x1b\xb9b`\x14X\xd7d\xb8\f\x9b+\x06\xe3\x06\xcf:\xbb`\x8f\xeb.\xc6\xc7i\xba\xe8\x913\x90\xd2\x19\xa9\x9f\xb5q\xf4\x8d1\xfc\x00\x0f\xea\xe1\t/\xda~yR\xce7)S\x8c\xe4\vI\xbc\xaa\xcd\xe23\x91j3\x91R\xc9uU\xa7\xf1\x9c\xd3[ՈD\x91\xf8\x95\xca~*F:\xdc\xc5\xf8\xc0`\xc2at\xac\x15\xdd#!`\xb01\xeb\x1cG\a.\xcf\xe8H\x9f3I&\x8a\x16\xf9\xe4\x1b7\xfd\xbd\xe00\x13\xd3w\xaa\xa3\x8f\xdeQ̺\xc7\x16\x84\xf2saU_Cd\x0e\x17{\xa93\x8c\xafPp\xa76s5@\xfd \xea\xe2\xabE\xd0\aC\x1e\x9e\x9f\xb4\x84[\xdcϬ\xdeap>l?\xa4Dqg\xfa\x19\x8b\x9bpGqKȗcI7\xef*\xbf\xe5V\xf3F\x1ege\xfbl\xb1\xf4\xa1;\xe1\x99%\x92\xd9N\xcc\x1fEn\xac\xc5$\xe8n/\xef}\xefޝ]\xe0ʫ\x8d\xc1\x95\xcb(\xb7\xf0\xf5\x9b\xde\xce$\x12\xba\xf1\xe6\xc2-|\xfd\xb6\xf8o\x00\xed9\xb9\xe6\xef\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacW͒\xdb6\f\xbe\xeb)0\xe9!\xedL$O\xa6\x97\x8en\xed&\x87L7\x99\x8c\x9d\xec%\x93\x03M\xc1\x12\xbb\x12\xc9\x12\xa0\xbdۧ\xef\x80\xfa\xf1\x9f\xecu35/\x16\x01\x82\xc0\xc7\x0f\x00\x99\xe5y\x9e)o\x1e0\x90q\xb6\x04\xe5\r>1Z\xf9\xa2\xe2\xf17*\x8c[l\xdff\x8f\xc6V%\xdcEb\xd7-\x91\\\f\x1a\xdf\xe1\xc6X\xc3\xc6٬CV\x95bUf\x00:\xa0\x92\xc9/\xa6Cb\xd5\xf9\x12ll\xdb\f\xc0\xaa\x0eK\xa8\xdcζNU\x01\xff\x8eHL\xc5\x16[\f\xae0.#\x8fZL\xd4\xc1E_\xc2^Я%\x91\x01\xf4\xbe\xbc\x1b\xcc,{3I\xd2\x1a\xe2?\xe7\xa4\xf7f\xd0\xf0m\f\xaa=w\"\t\xc9\xd8:\xb6*\x9c\x893\x00\xd2\xcec\t\x9fT\x87\xe4\x95\xc6*\x03\xd8\xf6\xa8%\xb7\xf2!\xba\xed\xdbޔn\xb0Kpȗ\xf3h\x7f\xff\xfc\xe1\xe1\xd7\xd5\xd14@\x85\xa4\x83\xf1\x02י\xcf`\b\x14\f\x1e\x00\xbb\xc9)P\x16T`\xb3Q\x9aa\x13\\\ak\xa5\x1f\xa3\x9f\xac\x02\xb8\xf5_\xa8\x19\x88]P5\xbe\x01\x8a\xba\x01%\xf6zUh]\r\x1b\xd3b1-\xf2\xc1y\flF\x94\xfbq\xc0\x8d\x83\xd9\x13\xc7_Kl\xbd\x16TB\n$\xe0\x06G|\xb0\x1a\xe0\x00\xb7\x01n\fA@\x1f\x90\xd0r\"ʑa\x10%e\x87\b\nXa\x103@\x8d\x8bm\x05\xda\xd9-\x06\x86\x80\xda\xd5\xd6\xfc3\xd9&AH6m\x15\x8ft\xd8\xff\x8ce\fV\xb5\xb0Um\xc47\xa0l\x05\x9dz\x86\x80\t\xa7h\x0f\xec%\x15*\xe0\xa3\v\b\xc6n\\\t\r\xb3\xa7r\xb1\xa8\r\x8f9\xa1]\xd7Ek\xf8y\xa1\x9d\xe5`֑]\xa0E\x85[l\x17ʛ<yj%>*\xba\xea\xa70$\r\xbd>r\x8d\x9f\x85U\xc4\xc1\xd8\xfa@\x90(~\x05p!yϏ~i\x1f\xd7\x1eWc\xebt\x02\xcb\xf7\xab/0n\x9d\xb0?2:\x11eZH{\xc4\x05\x1fc7\x18Һ\x9ehb\x13m坱\x9c6ЭA{\x8a6\xc5ug\x98F\xee\xca\xd1\x14p\xa7\xacu\fk\x84\xe8+\xc5X\x15\xf0\xc1\u009d갽S\x84\xff7\xde\x02,\xe5\x82\xe3m\x88\x1fV\xb0\xfdO\xac\x94\x03H\a\x82\xb1N]8\x9e\x93D^y\xd4rX\x82\x97\xac4\x1b\xa3\x13\xf1a\xe3\x02\xa8}^\x0fx\xeds\xf2r^\xca`\x15j\xe4\xd3\xd9\x13_\xbe$%\xd9~ר\xe32\xf23\x16u!\x95\x80\x06G\xfa\xda\xf0\xcb\xf1\xfe\xd7}\x98'\xeb\xac'#g\x05\x06\xc1U\x12]JСO\xe7[\xcb@\x1b\xbb\xf9\rr\xf8#\xf9|\xef\xea\xecLx \xbfs\x96\x85\xddW\x95\xa6\xda~\x93\xf6\a[\xe1\xd3U\x8d\a\xd7\xc6\x0eWVyj\x1c_U\x1d[\xeaԧ.)\xaeP\x05ݼ\xbc\xf7\x12)\xb6\x17#X\xa2t\x06\xbc\x8cڠp\x93\x95\x8fʚ\xcd\xd4COǅt\x1bGj\x9a/sG\x8ef\xe4\x8e,\x11\xee\xc8\xffǸ\xc6`\x91\x91\xf6Ung\xb8\x99\xb5\b\xb0k\x8cnR\xddJē\x02J\xe4\xb4I\xe5\xe8G\xddO\x94\xb9\x81\xff\x13\xbd\x0e\x03\xe9'v\x8d#\x04\x8a\xeb\\N\xd7l\x8fr\xe2ͬiH9ۗ\x00\x128$\v/\x11\xf9\ab\x93Zd\x02\xce$v\x9e.`3\xd3\x12\xcf\xd9\xf4\x85\nzi\x83|\xa8j\xd9\r6\x88\x15Ǔ\x8at\xb5\x0e'\xfd\x11}\x1dC@˃\x15AP\x9d.(\xb2ۊ\xe0xR_\x97\xf7ev\x95\x03\xe3\x06_\x97\xf7r\x95ael\xef\x8d\x0f\x98\x93\xa9-V \xb2t\xb6\r\u03811\x1c\xfe\xd1\xdd\xed\x86\x13\xc5'oB\xea:/\xb8\xf8~R\x14\xa4v\rھ\xff\x9f`\xd3\x1bDJW)\xad\xec\x99Q\x90V_a\x8b\x8c\x15\xac\x9fS\x94\xf4L\x8cݹ\xdf\x1b\x17:\xc5%Ƚ g3C#yA\xa8u\x8b%p\x88\xf8_\x02\xf7\x8d\"|!\xe6Ϣ3G\x8c\xa9МD_d\xb7\xf5\xa8\x1c>\xe1nf\xf6sp\x1a\x89\xd2+\xe2\xc6Hf\x93\xe0l\x92\xe4\xba\\\x1d\xa04<\x01\x86\x99}\xca(\xad\xd13V\x9fN\xdfU\xaf^\x1d=\x94ҧv\xb6J\x0f=*\xe1\xdbwy\rI\xfb\xa8\x86;?\x95\xf0\xed{\xf6\xef\x00\x99\xfa\xf2\xbdK\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcY_o\xe3\xb8\x11\x7f\xf7\xa7\x18\xe4\x1e\xd2\x03b\x19\xb7-\x8aBo\xb7I\xafH{\x97\r\xd6\xe9\xbe,\xf6a,\x8e,6\x12\xc9r({ݢ߽\x18R\xb2-[\xb1\x9d\xdcbW\x066\x16\xc9\x1fg~\x9c\xbf\xf4d:\x9dN\xd0\xe9O\xe4Y[\x93\x03:M_\x03\x19\xf9\xc6\xd9\xf3_8\xd3v\xb6\xfai\xf2\xac\x8d\xca\xe1\xb6\xe5`\x9b\x8fĶ\xf5\x05\xddQ\xa9\x8d\x0eښIC\x01\x15\x06\xcc'\x00\x85'\x94\x97O\xba!\x0eظ\x1cL[\xd7\x13\x00\x83\r\xe5\xe0\xacZٺmh\x81\xc5s\xeb8[QM\xdef\xdaN\xd8Q!\x10Ko[\x97\xc3n \xade\x19\x03H\xb2<Z\xf5)¼\x8f0q\xa4\xd6\x1c\xfe16\xfa\xab\xe6\x10g\xb8\xba\xf5X\x1f\v\x11\aY\x9be[\xa3?\x1a\x9e\x00pa\x1d\xe5\xf0\x80\r\xb1Â\xd4\x04`\x95X\x8bbM;\xedV?%\xa8\xa2\xa2&\xd2!߬#\xf3\xf3\xe3\xfd\xa7?\xce\a\xaf\x01\x9c\xb7\x8e|нj\xe9\xd9;\x90\xbd\xb7\x00\x8a\xb8\xf0\xda\t\xb99\\\v`\x9a\x05JN\x82\x18BE\xbdP\xa4:\x19\xc0\x96\x10*\xcd\xe0\xc9yb2!\x9e\xce\x00\x18d\x12\x1a\xb0\x8b\x7fQ\x112\x98\x93\x17\x18\xe0ʶ\xb5\x82\u009a\x15\xf9\x00\x9e\n\xbb4\xfa?[l\x86`\xe3\xa65\x06\xea\x18\xde=\xda\x04\xf2\x06kXa\xdd\xd2\r\xa0Q\xd0\xe0\x06<\xc9.К=\xbc8\x853\xf8\xcdz\x02mJ\x9bC\x15\x82\xe3|6[\xea\xd0\x1bba\x9b\xa65:lf\x855\xc1\xebE\x1b\xac癢\x15\xd53tz\x1a%5\xa2\x1fg\x8d\xfa\xc1w\x96\xca\xd7\x03\xd1\xc2F\x8e\x92\x83\xd7f\xb97\x10\xed\xea\x04\xe1bY\xa0\x19\xb0[\x9a\xf4\xda\xf1*\xaf\x84\x8c\x8f\x7f\x9d?A\xbfu\xe4~\x00\n\x1dͻ\x85\xbcc\\\xf8Ѧ$\x1f\xd7A\xe9m\x13\t&\xa3\x9c\xd5&\xc4/E\xad\xc9\x1c\xb2\xcd\xed\xa2\xd1A\x8e\xf9\xdf-q\x90\xa3\xc9\xe0\x16\x8d\xb1\x01\x16\x04\xadS\x18Hepo\xe0\x16\x1b\xaao\x91\xe9[\xf3-\xc4\xf2Tx\xbc\x8c\xf1\xfd\xb0\xb1\xfb'(yG\xd2\xde@\x1f\x1c^8\x9e\x03\x8f\x9f;*䰄/Y\xa9K]DÇ\xd2z\xc0\xc3\x00\x91\r\x80\xc7\xddR\x9e\x14\xb3\xe6\xc1z\\ү6A\x1eN:\x90\xec\xfdؚ^6\x89\x1a\xe2}\xf2w\x02\aN\xe8G\xa0\x00u\xbfx]\x91\xa7h\v\x9e8\xe8Blɲ\x0e\xd6o\x04X\x10H\ru:q\f\xf21V\xd1\x19=\x1e\xac\xa21\xb1e)\x84\n\x93q>Z%\x93|k\xcc\xf1.\xf2X\xf3*\xc1\x9cUg\xe4\xeavD\xf0T\x92'#N\x97\u0092\xb31x\x05ԦwΔz \xd8#L\x107\x91# \x05\x87\x06q\xda(N\xc5\xecQ\x89\x7f~\xbc\xef\xe3tOb'{8\xde\xf7\f?\xf2)5\xd5\xea\x11Cu\xc1\xde\xd7\xf7e\"J\xb0\x84(\x04\xa7\xa9\xa0A\n\x00m8\x10*\xb0\xe5(\"\x00\x1a \x13\xb4\xa7n\xc5M\nX]d\xdc%\x0e\xe1\x1ePB\xa5V\xf0\xf7\xf9\x87\x87\xd9\xdfƨ\xdfj\x01X\x14\xc4\x02\x84\x81\x1a2\xe1\x06\xb8-*@\x96Cמ\xd4<`\xa0\xacA\xa3K\xe2\x90u{\x90\xe7\xcfﾌ\xb3\a\xf0\x8b\xf5@_\xb1q5݀N\x8co\xa3po4b\xdaB\xc7\x16\x11\xd6:Tڼ\x80\x89R%tj\xaf\xa3\xba\x01\x9f\tl\xa7nKP\xebg\xca\xe1J\xc2Ϟ\x98\xff\x15\xdf\xf9\xdf\xd5\v\xa8\x7fH\xae}%\x93\xae\x92p\xdb,\xbb\xeft;!\x93\xe7y\xbd\\\x92\x8fe\xc9\xd8#KHB\xf5\x8f`\xbd0`\xec\x1eD\x04\x96\xb8\x91\x02%\xa9#\xa1?\xbf\xfb\xf2\xa2\xc4;\x1c\xe1\v\xb4Q\xf4\x15ށ6\x89\x1bgՏ\x19<ɟ\xbc1\x01\xbfJx(*\xcb\xf4\x12\xb3\xd6\xd4\x1bѹ\xc2\x15\x01ۆ`Mu=MU\x8e\x825n\x84\x85\xfe\xe0Č\x11\x1c\xfap\xd2Z\xfb\xda\xe6\xe9\xc3݇<I&\x06\xb54\"\x8e$\xc9RK\xad\"EJ\x1cL֨\xf9\x05Dn#\x9e\x88YTh\x96R\xb5\xc4C*\xdb\xd0zʮ'#\x8b\xce\xf9\xf1q\x052\xee±\x129\f\x1c\xdf+\x97_\xa8\x8b\xd8\xd4%\xba<\xec\x19\xf5I]\x9e\xdb\x05yC\x81\xa2:\xca\x16,\x9a\x14\xe4\x02\xcf\xec\x8a\xfcJ\xd3z\xb6\xb6\xfeY\x9b\xe5T,q\x9a\x8e\x9cg\"\n\xcf~\x88\xff\xbdY\x97X\xf5_\xaaP\x9c\xfc=\xb4\x92}x\xf6&\xa5\xfa\n\xf5\xf2\xb4u=\xef\n\xa9õ\xe2\x05\xebJ\x17U\xdfit!u\x14\x12\xc4\xe1\x1aT)\x12\xa3\xd9|k\xcb\x15\xfeZ/\x02ld(x[O\xd1(\xf9\x9b5\ay\xff&\xc2Z}\x91s\xfe\xf3\xfe\xee\xfb\xd8s\xab\xdf\xe4\x9a/\x94\xd7\xf2\x91*\xf2^I\x10(5\xf9|rRя\x83\xc9}a8R\x8fn\xe7d\x93W\b\x1ap9Rh\xa1R\xf1\xc6\x01\xebǓ\xe5\xd8I\x06\x06j<\xe1\x92\x01=\x01B\x83NN\xee\x996Ӕ\xc0\x1dj/ja\xe8[\xe1\x05\x01:W\xeb\xd1D\x1b\xec~\x89\xd9U\xf3\xc8Q\x95\xec5琊\xd4\xfc\xb4\xe0\xa9}\x19+\xc8;\x01\xc4f\xba\xa4$%r\xb0\xb0\x18k*N\x94\xbc/\xb2(M\xa6\xd4bC\x11\xa7\xb0\x18ku\x0e\xe6H\xbbp\xf0\xca\xd9!\x9d\xd3\x03K<\x18L\xfaM. S\xaa\xc8\xf6\xc0@Nv\x8dq~\xcfi\x8a\"\xa1C\x11v\xdf\xdc7\x16Vj\xcf\xe1\xb5\xd8\xe9\xe3\xbd=^\x11/`\xbcJ\xc2\x05݈\xcdvV\xb6F\xee\xf7\x18k\xfc`\x0f.\xad\x94\x16-\xa2\x91\x8a\x85\xa1ԭ%\xea\x9aT\a\xc9\xd9\xe1\x9a\x11\xd4}\x94\x05\x95R\x80\xb4\xae\xb6\xa8\xfav\xab\x13o[|I7\x1e\xaf:\xae\xf9\x04fˤb\x9f>B\xc2qAVZ\xdf`\xc8A.8\xa6\xa3\xa0r\xff\x88\x8b\x9ar\b\xbe\xa5\xcb\xcd\\n(\x98qy\xce\x15\x7fK\xb3\xc4n\xb0_\x02\xb8\xb0mض\xa1\x83\xa0p͝Me\xaf\x91ō6x\x03A\xa4\a쭷l\xeb:\xae\xe9ژm\xdb\xe0m]\x93\x97\xee\x05\x16t\xbc\xcd[c\x02\x80\xab\x90\xcfQ\xf5(s\xc6\x1cl\x1b\xbdNz\x98|ȴ\xcd\xf1.Sx\xa0\xf5\xc8\xdb{\xf3\xe8\xed\xd2\x13\x1f\x1bδ\xb7\xf0\x91h>\x85_\xa27\xbcJ\xffn\xa3s\x14tӠ\xb2u\xef\xcc6`\r\xa6m\x16䅇\xc5&\x10\x0f\xc3\xf9\x11&t\xbdʎƽ\xf5\xfd\xf9%\xa4\xae\xfd*\xd0\xc8\x1dG\xf4\xae`Aiv5nF\x80]/\xa1t\x13\xe2\\\x12\x02v\xf6\xdc;\xb5#\x1f\x87^{W\x12e\xba\xb3f\xc4V\xf6\xfdY\x9b\xf0\xe7?\x8d\xceHN\"\xf7\xcb˃\xe4Ѝ\v\x9d\xef7a|\xfb߿É\xd4\xcd\x06\x1dW6\xdcߝ\xb1\x82\xf9vb\xef\rz\x9b\xefD\xc0h\x17=Zg\nG\x88\xb0\x17[\xb2ט*\a\xf4a\x1bSω:\x98|&\vE\xe4\xf1\x1c4'\x87^<=^k\xdf\x1e\xfeNt\x03\xac\xe5\x1e&\xd6[\xa9\x00K\xad5Kr\x92\xc2\xd2z\x1a\t\x99p\x9cV\x06Id(\xfe\xf7\xcc\x1f\xa3vr\xf42J\xae\xf6\xb0\xbb\v\xe0\xeeͮ\x86\x91\xab1\x17H=\x1c\xfe\x16vu5\xf8q+~-\xacI\xa52\xe7\xf0\xf9\x8b\xfc\x82\x15/\x85\xbb\x8e\x8ds\xf8\xfce\xf2\xff\x01\x00\t\xcf߀\xff\x1b\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYK\x8f\x1b\xb9\x11\xbe\xebW\x14f\x0f\x93\x05,\t\xeb\x04Aзݙl\xa0dw<\xb0\x1c_\f\x1f\xa8f\xb5\x9a\x99n\x92aUKV\x82\xfc\xf7\xa0H\xb6\x9e\xad\x87g\x17\xb6\x06\xf0\xa8I~\xfc\xea\xab\a\x8b=\xa3\xf1x<R\xde|\xc4@\xc6\xd9\x02\x947\xf8\x85\xd1\xca7\x9a\xbc\xfc\x85&\xc6MW?\x8c^\x8c\xd5\x05<tĮ}\x8f\xe4\xbaP\xe2#V\xc6\x1a6ΎZd\xa5\x15\xabb\x04P\x06T\xf2\xf0\x83i\x91X\xb5\xbe\x00\xdb5\xcd\b\xc0\xaa\x16\v\xf0N\xaf\\ӵ\x18\x90\xd8\x05\xa4\xc9\n\x1b\fnb܈<\x96\x82\xb1\f\xae\xf3\x05\xec\x06\xd2b\x921\x80D\xe6\xd9\xe9\x8f\x11\xe7}\u0089C\x8d!\xfe\xc7\xe0\xf0/\x868N\xf1M\x17T3\xc0#\x8e\x92\xb1ˮQ\xe1t|\x04@\xa5\xf3X\xc0\x93j\x91\xbc*Q\x8f\x00VI\xbaHm\x9cM\\\xfd\x90\xb0\xca\x1aۨ\x89|s\x1e\xed\x8fϳ\x8f\x7f\x9c\x1f<\x06\xf0\xc1y\flz\xf3\xd2g\xcf+{O\x014R\x19\x8c\x17\x85\v\xb8\x17\xc04\v\xb4\xb8\x03\t\xb8ƞ\x14\xea\xcc\x01\\\x05\\\x1b\x82\x80> \xa1\xe5\xe8\xa2\x03`\x90Iʂ[\xfc\vK\x9e\xc0\x1c\x83\xc0\x00ծk4\x94ή00\x04,\xddҚ\xffl\xb1\t\xd8\xc5M\x1bŘ5\xde}\x8ce\fV5\xb0RM\x87o@Y\r\xad\xda@@\xd9\x05:\xbb\x87\x17\xa7\xd0\x04~u\x01\xc1\xd8\xca\x15P3{*\xa6ӥ\xe1>\x1aK\u05f6\x9d5\xbc\x99\x96\xcer0\x8b\x8e]\xa0\xa9\xc6\x156S\xe5\xcd82\xb5b\x1fMZ\xfd]\xc8\xe1J\xf7\a\xd4x#\xae$\x0e\xc6.\xf7\x06bl]\x10\\\x82\v\f\x81\xcaK\x93];]呈\xf1\xfe\xaf\xf3\x0f\xd0o\x1d\xb5?\x00\x85,\xf3n!\xed\x14\x17}\x8c\xad0\xc4uP\x05\xd7F\x81\xd1j\xef\x8c\xe5\xf8\xa5l\f\xdac\xb5\xa9[\xb4\x86\xc5\xcd\xff\xee\x90X\\3\x81\ae\xadcX t^+F=\x81\x99\x85\a\xd5b\xf3\xa0\b\x7fo\xbdEX\x1a\x8b\x8e\xb7)\xbe_;v\xff\x04\xa5\xc8\"\xed\r\xf4\x05\xe2\x8c{\x8e\x93~\xee\xb1\x14o\x89`\xb2\xd4T\xa6\x8c\x91\x0f\x95\v\xa0N\x8a\xc4\xe4\x00z81\xe5\xb3P\xe5K\xe7\xe7\xec\x82Z\xe2/.a\x1eO:\xe2\xf6\xd3К\x9e\x9c\xd4\r\xc9?\xf9=\x81\x83\x10RK<\x01\x05h\xfa\xc5\xeb\x1a\x03\xc6h\x90ZjJ\x89&G\x86]\xd8\b\xb0 \xa0>\xb4\xe9\x82#\xe4\xc7;}Ōg\x97\xe3?`\x85\x01\xadDw\xca\x7f\xefb\x95`el\x9f\x05\xa9\xd0\x03\xbb\x13L\x90x\fx\x8e\xe2y\xe9/\xd5\xc6A\xc2?>\xcf\xfaz\xd8+\x9c\xa9\xf3\xe9\xbeW䑟\xca`\xa3\x9f\x15\xd77\xec}?\xab\xd2f\x82%:)\xf0\x06K<(\xb5`,1*\r\xae\x1aD\x04P\x16в\t\x98W\xbcI\x85!W\xa0]\x81\x16\xe9AII2\x1a\xfe>\x7f\xf74\xfdې\xf2[+@\x95%\x92\x00)\xc6\x16-\xbf\x01\xea\xca\x1a\x14\x89\xcfM@=g\xc58i\x955\x15\x12O\xf2\x1e\x18\xe8\xd3\xdb\xcf\xc3\xea\x01\xfc\xec\x02\xe0\x17\xd5\xfa\x06߀I\x8ao\xab]\x1f3\x12\xf7\"\xc7\x16\x11ֆkc\xcf`*9\x8e\xb3\xd9\xebh.\xab\x17\x04\x97\xcd\xed\x10\x1a\xf3\x82\x05\xdcI\x96\xef\xd1\xfc\xaf$\xd6\xff\xeeΠ\xfe!%НL\xbaK䶧\xd9~F\xeeHr\xad\x188\x98\xe5\x12C<\xfe\x87>\xb2\x04\xa5$~\x0f.\x88\x02\xd6\xedAD`\xc9\xceT\x8fP\x9f\x90\xfe\xf4\xf6\xf3Y\xc6;\x1c\xd1\v\x8c\xd5\xf8\x05ނ\xb1I\x1b\xef\xf4\xf7\x13\xf8 \xbf\xd2Ʋ\xfa\"\xb9Z֎\xf0\x9c\xb2\xce6\x1b\xb1\xb9V+\x04r-\xc2\x1a\x9bf\x9c\xba\t\rk\xb5\x11\x15z\xc7I\x18+\xf0*\xf0\xc5h\xed{\x88\x0f\xef\x1e\xdf\x15\x89\x99\x04\xd4\xd2\n\x1d9\x8c*#=\x814\x03q0E\xa3\xa13\x88\xd4E<\xa1Y\xd6\xca.\xa5;\x88N\xaa:\xee\x02N\xeeG\x03\x8b\xae\xe5\xf1\xe9I?\x9c\xc2\xf1\xc4?.\x1c\xdf\xea̼\xd1\x16\x89\xa9[ly\xda\vꋶ\xbct\v\f\x16\x19\xa39ڕ$\x96\x94虦n\x85aep=]\xbb\xf0b\xecr,\x918N.\xa7\xa9P\xa1\xe9w\xf1\xbfW\xdb\x12\xbb\xeb[\r\x8a\x93\xbf\x85U\xb2\x0fM_eT\xdf\t\xde~l\xdd\xcfs\xbfr\xbcV\xb2`]\x9b\xb2\xee;\xfa\\R\a!A\x12\xaeU:Ube7\xbfw\xe4\x8a~]\x10\x02\x1b\x19\xe2\xe0\x9a\xb1\xb2Z~'C,\xcf_%XgnJ\xce\x7f\xce\x1e\xbfM<w\xe6U\xa9y\xa6\x8d\x95\x1f\xe9\xd5fZ\x8a@e0\x14\xa3\x8b\x86\xbe?\x98\xdcw\x8d\x03]\xdfv\xced\xf4\x15D\xc9*O\xb5\xe3\xd9\xe3\x15\x1e\xf3\xedĞ\xc3\xce\x01\xb9\xd9\xeb\xb1$N/\xf6x\x17\xf8$\xa8+\\R\xe7>\xd4Ag&\xe2\xc7|PH\xd7\x1a\xf9\x9c@\xc2k\x18\xca\xfdJڣC\x86\xe3\xe1{\xc1\xd1\x1c\xef\x0e\xfb\x86\xf1Q$\x1c\r\xee\\s4\x90\x8c\x1c\xdd\x10m\xd2\xdeuG\x8d\xf4\xe5kS\\\xd0+\x9b\xf2\x9b3\x8ch\xfc\xfa\x8bS\xe9\xa4-<|=t\xd9\xcb\x0f\xa7+\xe2;\x88\xa0\x13;6-\xc6\xdbHd\x0ekE\xfd&C\x1e\x85=\xbc\xb44\xbe\x14)]Шc\xd3&=e\xa5L\x83\xba\xc7$i\xa8\x10(\xde\xce\xef\x87z\x94\x1e\xa8#\xd4\xf1f9@\xfat]\xe5B\xab\xb8\x00\xb9\x93\x8f\x05\xe2d\x86\xbc7S\x8b\x06\v\xe0\xd0\xe1\xed\xe1)\x97j\"\xb5\xbc\x96A\xbf\xa6YB]\xf5K@-\\\xc7\xdb\v]N\xa5,\xc5=\xe5(\x98|\r\x19_+\xbaF\xe5Y\xe6\fE\xdc6\xa9/\x87\x9c|\xd0v\xed\xe96cx\xc2\xf5\xc0ә}\x0en\x19\x90N=3\xee\xa3d\xa0\xc5\x1f\xc3\xcf1:\xbeJ\x80\xbc\xd15\r\xf24\xa8]\xd3G\xb7cՀ\xed\xda\x05\x06\x11b\xb1a\xa4^\x91\xbe4\x9c\xa0B\xee\xacwJ\xee\x10\xb2'u\x82\xcaw\x85RY\xb9\x8f\xc7\xf8e\aڐo\xd4f\x00\xd7\xf7\x14\xa5\xf5\x95\xf0\x95<\xdaEL\x06\aI\xff8\xf6\xb57\xfbH\xea\xd1فp\xd9O\x19c\xf9\xcf\x7f\x1a\x9c\x91\xc2P\xde:.\x8fJi\x1e\x17A\x7f\xda\xf0\xf0\xf6\xbf}\x87\v\a>\xb1\n\xbc\xad\aWba~0\xf9Zŋ\xd0\xc3\xf5n\xbft\x9d\x16\xaa\xc3m\xbee\x8d\x1a\x14\xea\xe4ad\xae\xf7\xb0\xf3[\xb1\xfcdw\xb2ɛ\fϨ\x9f\x8e\xffLpww\xf0\xd6?~-\x9d\xd5\xf1\x0f\x17T\xc0\xa7\xcf\xf2b_\n\x8a\xce\r6\x15\xf0\xe9\xf3\xe8\xff\x03\x00\xb4\xe5\xbfk\x1b\x19\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacWOo\xe3\xb6\x13\xbd\xfbS\f\xf6w\xd8\xcb\xcf2\x16\xbd\x14\xba\xb5i\v,\x9a\x04\x81\xbd\xc8e\xb1\x8715\xb6\xb9\x91H\x963t\xea~\xfab()\x96%e\x9d]4\xcaE\xe4\xcc\xe3\x9b7\x7fD/\x96\xcb\xe5\x02\x83}\xa4\xc8ֻ\x120X\xfa[\xc8\xe9\x1b\x17O?sa\xfd\xea\xf8a\xf1d]U\xc2Mb\xf1͚اh\xe87\xdaYg\xc5z\xb7hH\xb0B\xc1r\x01`\"\xa1.~\xb2\r\xb1`\x13Jp\xa9\xae\x17\x00\x0e\x1b*!\x12\x8b5\x91\x82g+>Z\xe2\xe2H5E_X\xbf\xe0@FA\xf6ѧP\xc2y\xa3\xf5f\xdd\x03h٬3к\a:\xe5\xadڲ\xfc9\xbb}kY\xb2I\xa8S\xc4z\x8eH\xdef\xeb\xf6\xa9\xc681\xd0\x03\xd8\xf8@%\xdccC\x1c\xd0P\xb5\x008\xb6\xe2enK\xc0\xaaʚ`\xfd\x10\xad\x13\x8a7\xbeN\x8d\xeb\x98/\xa1\"6\xd1\x065)a#(\x89\xc1\xef@\x0e\x04\x17')\x95\xaf\xec\xdd\x03ʡ\x84\x82\xb3e\x11\x0e\xc8\xd4\xed\xaa =D\xb7$'e\xc7\x12\xad\xdbϝ\xa79\x81\x06\x95\x97Cg\b\x9e\x91\xa1F\x16\x88Ɂwo\xa5\xa1.wg\x18\x85\xedl[R\xb7\n90\xe86[z\x15J\x1bBk{\xfc\x90_\xd8\x1c\xa8\xc9\x05\xa4o>\x90\xfb\xe5\xe1\xe3\xe3O\x9b\x8be\x80\x10}\xa0(\xb6/\x85\xf6\x19\x94\xf0`\x15.\x83\x7f\xaf\x80\xad\x15TZ\xbb\xc49\xde.\x7fTu\x1c\xdatXV!\"19\xc9\xf5|\x01\fj\x84\x0e\xfc\xf6+\x19)`CQa\x80\x0f>\xd5\x15\x18\xef\x8e\x14\x05\"\x19\xbfw\xf6\x9f\x17l\x06\xf1\xf9\xd0\x1a\x85\xbaz<?\xaaWtX\xc3\x11\xebD\xff\at\x154x\x82Hz\n$7\xc0\xcb&\\\xc0\x9d\x8f\x04\xd6\xed|\t\a\x91\xc0\xe5j\xb5\xb7ҷ\xae\xf1M\x93\x9c\x95\xd3\xcax'\xd1n\x93\xf8ȫ\x8a\x8eT\xaf0\xd8ef\xea4>.\x9a\xea\x7f\xb1\xebm~\x7fAmRW\xed\x7f\xee\xc3o\b\xae\x8d\b\x96\x01;\xd76\xae\xb3\xae\xba\xa4b\xac\x7f\xdf|\x82\xfe\xe8\xac\xfd\x05(t2\x9f\x1d\xf9\xac\xb8\xeacݎb\xf6\x83]\xf4M\x16\x98\\\x15\xbcu\x92_Lmɍ\xd5\xe6\xb4m\xach\x9a\xffJĢ\xa9)\xe0\x06\x9d\xf3\x02[\x82\x14\xb4P\xab\x02>:\xb8\xc1\x86\xea\x1bd\xfa\xaf\xf5Vay\xa9:\xbeM\xf1\xe1\xa0=\xff)Jى4\xd8\xe8\x87\xe9+\xe9\x19\x0f\xc8M \xa3\xd9R\xc1\xd4\xd5\xee\xacɕ\x0f;\x1f\x01'\x03\xb5\xb8\x80\x9eoL}\xb6h\x9eR؈\x8f\xb8\xa7[\xdfb\x8e\x8dF\xdc~\x9d\xf3\xe9\xc9\xe9\xdc\xe8g\xe6\xac\xe1\x04\x1b@\x0e(\x83\xee\x14\xb4\xee\xa5\xc9g\xe3\xf9F\x12\xf4\x7f0D\xff\xc8\x05\xe4\xcc\xe9JLw3.\x1a\xd2\xc1?\x83\xdf\t\xb9\x8b\xc9\xdcr\x9d \x82\x96fL\xee\xbbȶ\x1f\xb2\x8f\x159\xb1;K\xf1\n\xd1\xf5ȼ\xd7}\x97\xea\xba\xfb(.\x8do\x02\x8a\xdd\xd64\x7f\xa4>Z6\xb6=\xf4Զ\xfa\x8f\xeb}ԯ(\xbd|w\xafD\xf0xi=,\x9c\xec\xdeS\xd18\a\x8c&\xa0\xd0\xd7\nC\xf0UG\xa2+hֶ\xf8\x8e\x184\xe56\xd2h`.\xe7\xdbcd3Wm#\x93q\x8eG\xdb#\xfd\xde4>\xf2\xb7\xbe\\\xbc\xaa\xf2d\x80d\x87^l\x93b$'\x1d\x8c\xf6돏\x90\x99\xfbƕ\n\xb8\x9dz\xf4\xc4\x14\fd\xe6&4A\x84\xf9N\xdb\xf9ؠ\xb4\x17\x99\xa5\x9c\xef>\xe7G/\xbc\xb8\xad\xa9\x04\x89\x89\xde^#:\xe0\x99q\x7f-\xba\xbb\xd6J#\xc2\xde\x05p듼\"\xbd\x1c\xa6,\xe0J:\xae0\xcdW\xd1+<\x1f\xd4f\xae ^\xe6\xf7u\n\xe4R3=f\t\xf7\xf4<\xb3\xba&\xacNs\xd6^\xe6\xb7^\x8dp\xb6+&\x8b\xac\x17\xbfj\x90gn\x1b\xb9[9\xf7\x10\x1aCA\xa8\xba\x1f\xff\x8cy\xf7\xee\xe2WI~5\u07b5\xbf\"\xb8\x84\xcf_\xf4w\x87\xf8HUw{\xe5\x12>\x7fY\xfc;\x00n{\xbf\xe9\xbc\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xcc|_\x8f\xe36\x92\xf8\xbb?Ea~\x0f\xde]\xd8\x1a\x04\xbf\xc3\xe1\xe0\xb7N\xcf\x04h$\xe9i\xa4g'\xc0-\xf6\x81\x96\xca6\xb7%RKR\xdd\xe3\x1c\xee\xbb\x1f\x8a,\xea/%ۓ\xc5]\xc6\x03$#\x91\xc5\xfa_\xc5bQ\xab\xedv\xbb\x12\xb5\xfc\x82\xc6J\xadv j\x89_\x1d*\xfa\x97\xcd^\xfe\xc3fR\xbf\x7f\xfdn\xf5\"U\xb1\x83\xfb\xc6:]\xfd\x82V7&\xc7\x0fx\x90J:\xa9ժB'\n\xe1\xc4n\x05\x90\x1b\x14\xf4\xf0\xb3\xac\xd0:Q\xd5;PMY\xae\x00\x94\xa8p\a\x06\xad\xd3\x06m\xf6\x8a%\x1a\x9dI\xbd\xb25\xe64\xf5htS\xef\xa0{\x11\xe6Xz\a\x10p\xf8%L\xf7OJiݏ\xfd\xa7?I\xeb\xfc\x9b\xbal\x8c(\xbb\xc5\xfcC+ձ)\x85i\x1f\xaf\x00l\xaek\xdc\xc1\xa3\xa8\xd0\xd6\"\xc7b\x05\xf0\x1a\xb8\xe1\x97݂(\nO\xa4(\x9f\x8cT\x0eͽ.\x9bJ1R[(\xd0\xe6F\xd64d\aߋ\xfc\xa5\xa9\xc1\x9d0\xae\x01\xd2\xc2\xc1\xe8ʏ\x06\xf8\x87\xd5\xeaI\xb8\xd3\x0e2\xa2:\xdb\xfb\t\xb4<\x0f \x82#\x1c~\xe4΄\xa2uF\xaacj\xd1g'\\cA\x1f\xfa\xeb&\xd6\xf3ò\xfa$\xecp\xb10\xff\xca\xc5\x1e\x9bj\x8f\x86\x16{\x13FIu\xb4\x80*\xd7\rq\x06\v(\x1a\xc2\xf2*D\xe2|\x1e\x10p\xf9u\xf80\x90Nl?\xa2YF\a\x8d\xd1曑\t\xb3\xf9u@\xe5c\xff\xd1EDH\xdd\xfb+\xc1\x9b\xb0\xc1\x16\xb0\x98\xae\x1a\r&\x9bX\v\x8f\r(\xdc\x0f\xe6\a\x1c\n\xe10\x85\xc0/(\xacV\x03\x14\x0eB\x96X\xcc\xd2L\xaf\x1b\x83a\"\x8f\n\xeb\x0e\x1e\xd5Fj#\xddy\a\xdf\xcd\xe9H\x98\xf5\x1a\xde\xdb\xfc\x84\x95w\x05\xf4/]\xa3\xba{z\xf8\xf2\xff\x9f\a\x8fa\x8c|k,\x02\xbex\xfb'*\xbc\x9f\x01w\x12\x0e\f\xd6\x06-*g=\x89\xa2\xaeK\x99{G\xd3B\x04R\x838+X]\amϖ\xa9A\x80\x13\xe6\x88\x0e~l\xf6h\x14:\xb4\x90\x97\x8duh\xb2\x16Vmt\x8d\xc6\xc9\xe8|¯\xe7*{OG\xb4\xac\x89\xdc0\n\n\xf2\x91\x18Pf\xb7\x82\x05s\x88\xb0u'i;\xd2\xc6\xe40IB\x81\xde\xff\x03s\x97\xc13\x1a\x02\x03\xf6\xa4\x9b\xb2\x80\\\xabW4Ĝ\\\x1f\x95\xfc\xad\x85m\x89PZ\xb4\x14\x0e\xd9'v?Rc\xa3D\t\xaf\xa2lp\x03B\x15P\x893\x18\xa4U\xa0Q=x~\x88\xcd\xe0g/\x1eu\xd0;89W\xdb\xdd\xfb\xf7G\xe9b\x88\xc8uU5J\xba\xf3\xfb\\+g\xe4\xbeq\xda\xd8\xf7\x05\xbeb\xf9^\xd4r\xeb1UD\x9fͪ\xe2\xff\xb5RZ\x0fP\x9b(V\xf8\xeb=\xff\x02\xc3)\x06\x90\x9f\x15<5\xd0\xd5\xf15:\x81_>>\x7f\uead5\x8c\xd6\x1d\xff\x046w\x13m\xc7q\xe2\x8fT\a4~^P.\x82\x89\xaa\xa8\xb5T\u038b8/%\xaa1\xb7m\xb3\xaf\xa4#1\xff\xb3AK\xfa\xab3\xb8\x17Ji\a{\x84\xa6&\x8b.2xPp/*,\xef\x85\xc5\x7f5\xbf\x89\xb1vK|\xbc\x8e\xe3\xfd\x80\xde\xfd\t\x83\x03\x93z/b\xf8\x9e\x11\x0f\xdb\xf6s\x8d\xf9\xc0\x1eh\x9a<\xb0\x11\xc3A\x9b\xceXفu\xe68o\x92\xf4\x13E%-\xd9ۯ\xb8?i\xfd2\x190\xc2\xe8n<>\xe2\x82\x16N\xfa\xcdc\xf7*JY\b\xaf:\xde<\x1a\xe7\xff1\x01\xdc[\x1d\xde\xc2\xf2d\x96\ayl\x8c\xa7̂\f^\x99=\x900\xad\x83.6`\xa5\xcaq5\x80\xe7\xff2(\vo'm\xc3\\T\x85\x05aP\xad\x1d\x98FQ\x98\x843:ȅ\x8a\x96K\xcbH\x87\xd5X\xaf\xe9\x17\xd7\x04qp^\x8b\xb1\xca\xe0\x03\x1eDSz\x9d\x84\a\xf5\xc9\x14}\x1f\x18\xff\xa0j\xaa)G\xb7qB\xe2\r\x8b\xfc'1q=~\xdeQi\x83?\x84\xe83EuF%\xe9\xaf(K\xfd\xf6\x88ohB\x82\xf4\x836\x95p\x97\xa4\x9d\x9c\xd4\x13\xf9\xdb\t݉X\xa2A8\x87U\xed\x199\xcfBr\xdc\"\x8a3\xc8\xe7\x10`\xb2\x8b'_\xa4\bK\n]A\xf8Z%(\x05z\xef\x17\x8b\x8ao\xbd\x7f\a\xdbԵ6\xcen@*\xebP\x14\xb4$\x85\xebQ:\xb3N\xc1\x8c\x9a\xab\xd5T\x94\x81\xb7{\xadK\x14\xe3H#\x1a\xa7m.J,~A\x1f\\/\x9a\xd1dB\x8f\xa9\x01K\x0f\a|FFAPL\xd5\x01\xe0M\x9b\x97R\x8b\xa0ܑ\xb2\x02ޤ;\x81\xa4\x10\x89\xe7\xb5!w\x8d\xe0ы\xe1\xdbKᤍ\xfcM+'\xca\x04\xe4Z\x17\x1dUfh\x87\x19\xfc\x88Xo<\xd8\"X\xc1\x06J\x14\xaf\x01wi\"\xf6\t\xb8\x91\x1e\rL\xf8\x93.e.\xd1^o;\xb4x\xe2\xf1\xa7J\xa6,\xe6g\xa9\"\x8bo1\x97nsqA\x92߷\x03Iu\x89%\x8d\x92\xffl\xd0o\xbf@\x1f\xfa*\xcaz\xef\xf4\x82\x81Pt\xccn\xc1\x94b\xcd'U\x9e/\xe0\xf9\x81\x87\xa5\x8d7\xae\xaei\x04a\xfcJ;\xb5\x94w\xa5\xe5\x86\xea@\x96\xe64\xe0Wi\xc9\xcd\xc3ӗ{\x1bT\x90\xc6Xb\x03\xf1\":\xf3\x04L\xd6J\x15w\x92v\xe3\xe7\xeb\xc6\xf1\x96X\x1dA\x1b\xa8t!\x0fgZB\xa83h\x8f{\x97\x88&\xe0\x86pk3\xf8|B\xf8I\xec\xb1|\xc6\x12s\xa7͆\xccC\xa8\xf3\x86\x84V\t\x97\x9fȻ\x1f\x05\xf9\fB\xb2\xa5&\x01\x95\xe8[CI\xe0\xecmn\x02\xbf\xe6eS`\xd1n\x99/\xb9\x89\x8f\x93\t\x14 \x1d\xa1\t\xc2\xef\xe1I\xc3:\xbe\xcd\xf9\t\n\x9c\x943I\x15\xe0E\x01\xb2اT\xf8H8EnQ\x11\xc1\x17+ľ\xc4\x1d8\xd3L\x05\x1d\xe6\nc\xc4y\x861\xb1>r-_\xda\xf1\x9c\u00962\xc7\xfeN\x86\x15\x8f\xb8B\x1er\x02\x14\xfe\xe0\\\t\x16\x15\xa9\xf4\xae\xf2\x92\x9d\x7fLN\x1aX\xbdp}2\xa1\xd0I\xe3!\v\f\x14{\xad\x02Q\x1a\x14\xc59`\x15Yś?\xbf\r*\xe4\x81r\xfc\x98\xde\xcbiv\x13\xdc*\x16ۦ\x8e\xf1\xde\x0e\x13)\xa5\x15^\x1f\tht\xe2q\xd8\x16$^\xd4d\xe8\xab\x1b\x84'\v\xacj\xedP\xe5\xe7\xcf\xfa\x05\xd5\x05ޯ\x1fF\xe3A\x16\xa8\\\x17\xd5{\xec\xecI`\x02\x94+\x81\xde\x0f\x9ed~\"\xdd\r\x0e\xa7\r\xee.\x8b\x1b\xff\xb1\xafu\x84\xe8&\x01\x13\xb3c\x06\xa2\x15;\x89,쭜\x91\xb4\x94#W\xdbGQ\xab\x18\xc0\xaaQ9\xa6\xff\x131\xe8\xeb7\xb5\xa3\xff=\xaf)\xe7\xb0/\xb2\xae\xc9\xd3\xf8\bx\x0eN\x96GN\xb5 \x85/!X\xb3kv\xba\x03P\xb50\v\xad\xd6k\xd7\x05\x8bX\x16\xcb\xe0\xe7&\x91>\x03\xed\x19\x05\xedpe\x11\xd8I\xff\xdf\xe0P\x05{\x82Y\xaf-\xfc\xf5\xe1C\xb6\xbeIg\x827\xb9\x0f\x96q\xadG{H\xcfJDk6\xb9\xad/\xbf\xa6\x04\x12\x9d_[\xea\xd8c\xe7\xe2h\xaf\x98kee\x81a\x8f5vz\xf0pH\xc0$\x1f\xb6\x89ɞ\xdf\xf2\x90/˾\xcdץ\x83\xa3T\xe3Xw\x1d\xcb\xfa\xc1q\x18\x05ڸ\x18À\x8e\x8b\xcc\xe7\n\xbe:\x91\xc1\xc3\x01h3sހ(\xcb~\x80%K\x8c\x98\xfe\x9f\a\x88\x88ȍJvu\xd8\\\xe2\xd7Tm\xfa\x1c\xebt\x90\xc7q\xea\xfb\x87b_\xd9\xcf\b/\xb0n\x90=\x06\xb6Q\xa1\xe7\xf5\xbbl\xf8\xc6i8ȒB\"9\xa5\tL 3V\xcc5\xcad\xa5*\xe4\xab,\x1aQ\x0e4\xb0ǳ\x8e\xb5\x94\x03+Y&}e\xd9\xcd\x1f\xf0\x18>y\x02D\x99\xddʷ\xf9\x9a\x11\xfd\xbc7\xfe\xf8\x95\n\xcb\xed\x81\x0f\xc0\"\v\xc7S@\xf6\x93X/\f\xb0\x91\x8fT\xf1\x93\x06+\xaaZOQ\x0f?\xca\xea\xfb\xe3|\x98\xbc{\xfc\x90R\xadE\xf5\x9a\xa0z\xb7\x80\x0e\xdbL|3\x93q\xc7\xdd.'\xeb>\xce\xd8\r\bxAr*\xaa\xf0\xa5障0\x03\x01\x83\xbe\xe2\xecE\xff\x82\xe7U\x1ad\x88\x8b\\Z\x9e\x19\xb3,:.\f\xe3y\xfe\xe5\x88\x1d/x\x8e\x9b\xdb\xc0\x17z\xd0f1-\x93\xfc\xc1\x02\xda\x05\xa8@\x05܅\xf7\x8bv\x1e\x7f\x91kW\xa3߲\xb9+N\aA\xac)\xfb)}\x18\xb4'9\xb31\xef~$u_;\x89\x85\xfd/>\x93\x88\xe0\x83\xe5=\xa8\r<jG\xff\xf1\xa9\xf82;H\x96\x1f4\xdaG\xed\xfc\xe8\xdf͜\x80\xdaլ\t\xc3I\xb8B\x05\x1fI\xf4\xf5\x8f\x02\xac\xf7?\xe9}{\xf7\xa7e\xb1\xb4T\x8c\xd7&\xf2\x80\xeb\xc1\rZ\x06_5\xd6\xd7\xee\x95V[\x1f0\x96H\x06^{\x00\xdf3ʒ3\xecs\xae\xbf\xd4\"\xc4!\x1a\x01\x05\xf8L\a\x13\xe1M8U*Eޝ\x82\xfa\xc3\x11\xe1\xf0(\xf3E\xd0\x15\x9a#\x86\x9cu\x89\xaaE?t\x83\xac\x97b[\xfcÎkt\x06\xd4\xfd\xb6\v\xaef۲}f\xc0̡Ƶ\xf8\xf9\x80\xe0\xc3\xe7\f7\xfa\xfd\x03\x97<\xdaE\x8e\r\xf4\xbe\xb74\asQ\x93\xe6\xff\x17\xb9g\xafD\xff\r\xb5\x90\xc6fpG\a\r\xc7rN\xff\xfb38\xd7\xe9\x03\xafDM\v\x90\x14^EI\xe1\xc3ir\xfdX\xfa`2\x03T\x1f&\x01v\xc3\xe5rr\xbd\a\x89eA`߽\xe0\xf9\xddf`!3\x10i\xf0\x83z\x17B\xcf\xc4(\xdb8\xe5\xeb\x7f\xef\xfc\xbbw\xd9$\xc0\xce\xc0\xbe\x10v\x17\xb5d\xe1%\x15\xb6\xbf\x17\xa5P9\x1a:J\x94\x97\x13ܟ\x12S\x12[(NZ\v\x88c&P\x81\x94\x81p\x1b\x80\x84\x17Ě\xeb\x1e\xba)\xa06\xfa\x956R\xe0O$\xf9\xc8\xca\xc7ż\x14\xb2Z\r\x00\xfa\xbf\xd4> sxx\xb2\x1b\xf8\xf0\xf8̉6\xc9$\x943\x89f\xd8\xc7\xe5,:\xaa\xff\x04\x17\xbc\x94\xf9\x1dxc\xddǃ\xa4\xf2\x82\xb5\xfb\x17'~\xfe\x1c\t\x8b\xbbn\xa5\xddes\xbb\x9bL\xf2\xb1\x923\x1d\xdf\x7f3fa\x12(\xb4T\x01\xbe\xa2\xe2B\x00ԡ\xc6%-<;#\xfd\xf9ęL\xafUlX\xffe\ro\xb2,ra\x8ad\xb1\xa1-\x90\xbc\xa3s$\x99c\xb6G'\xb2\x97\xb6\xbcLG\xc7\xe2\xcdnIB\xdb(\xa1\xed_\xdee\xab\x9b]\xfcEWuA@\x97=k\xc7̹\x9a\xe1TD\xa3) ;{!\x1e\xb7\xe6\x14m@\x9a\xf9\xecsb\x15\xc3\x12\v\x9d\xe0\xa4\xf9\x96\xae\xf4-\x9c\xfb\xd0\xdfm\x10\xfb\xea\x1bx]\xa0\x92\xb7*\xf3\x87\xf1\x9cߣ\xcb\x06+\xfd\x8aŌ:\x13\xc9im\x9e\x01\xd9\xea\xf8\x1fP-\x17\\}[`\xf9YԵT\xc7\xdd\xea[S\x81E\"\x06b|\x1c\xad9\xc8\x03\xfau\x90A\x05\xe9\x8aӫvl,\x8e\xf8\xf3\xb1\f\xee\xd4y\x02\xd7\xd2\x01D\x02fܿw)EM\xfe\xab\xa4\x94\xb5\x8d^\x04\xb6\x0f\x8a\x0f\x1bm\xd7\x11\xd9\xff\xd1\xc0\f\x9e{\b,x\xc8^\xdd\xd96{\xeb\xa4k\xd2\xd5_\xaa'\x12\x82\xb96\x06m\xadUA\t3\xb9[Ƽǝ\r\xe5\xec\x9e\x00\xdfK\n\xd8e7\tȶ1F7\x8a\xcee\xf6gX\xbf_\xc7\f\xa8\a\x91[\xaf\x0ehP\xe5\b\xb9\xa8]c04\xc3\xda\xec&\r\xd4wu}\xf1\x10\xf51\x8cJ\xa4\x14NÛ\x91\x0eYZJ\x1e|\xbf\x92\x9e\xdb:\xf5\xca\xeco\xb1H\xdb\n\xd6iF\x12\xe8\x818⠙!\x1e\x89&\xa0\x86\xea\xf8\xe0h&\x83\a\xbf\x149\x1b\xebH\x85j\xa3s\xb46\xf0\x95\xd7\xf4e\x7f\x10\xb9\x9b\x11\x06e(\xad\xa6A\x15,\xc6n`\xdf8>*\xee\xfak\x98\x8a\xec\xa6\xea\xafo\x04{\xf0\xbd\x9c\x17d\xf0ԍ\x8cE\t?ٛ\x8a\xef$펳\xe9%u\f\x16M2\x7fw\xdagVQ\x9a\x05\x88R\xab\xa3?N\x80g\x9eFnb\x13\x0f\xf0\xbd\tF\xe0\xbd\xd6\xe4\xfe\x8f֬\xb4\xf5\x9d\x85\x94\xef\xdb&'v\x1f\x9a\x92\vz\xf1,\xa5+\xf4E\x14炅u\xc28:\xa0v\xc1\x82\x0e\xb4\xbc\xef\xa5s\xb2JT\xe1CKM\xe8w\xddҐՍ\xae|\xc1\x8b\x9aa\xdb\xc6\x05a\x8d\x9a<:\xc3\xd9@\x1d\x12q\xef\x8f6\xad\x1d\xb5\x1d-\xab\xb9x\xc96\xd2\x1ezM:e\xf0\foh\xb8\U0006b006<\xa7;\xf9\xddL\x02\xe8A\x1a\xebb\xc8\r\x0e\xa6_\xbc\xf6n\x18\x84b\x03\t\x15.\xf2\xed\xe1\x04\xeeB\x9b\xcb\x00cr\x95=\xb3W:\xae\xdaA\xcdVWG\xec\x11\x9b\x03\xc6}v\xb7%\xbb\xc8 ^m\xd6%\xf5ۉ\xf4\xa1\xabv\xb5\xec\xc8V\xb7\x97\x1a\xeb\x85\xfcsDD:\xef$\x8dɘ\x04\xcb;\xdf\x05\x12\x06\a`k淴3;\xa1KI\xe7b\xda9\xdbttцFh^ŝ\xee\xcc&f\x9b\xed\xfc\xae\x14;T\xa8\x19\xb0T\x84݄\xcdN\x81u\xa9\xcfT\x88\xb0\x99\xa8k\x9b\xf94 \xea\xa3\fŊ\xb2\\V\x81E5\xbd\x92\x17˙\xe3r\x19k\xcbd'_\xb5\x98'\xde.\xa4\x03Wz\xc8\x14\xbaqŧ\xd0\xfb\x7f\x8d\x8f\x1cO\x88\x96\xab\xa9G4\x1e\x0e0\x1d\x03/8\x01L\xe7r\x1bn\xa9\xf4\x11ö33\x9f\x15m\xc06\x94\xd8\xd1Y\xa9m\xd0\xd8,G㶕P\xe2\x88&\x93\xc9\xf2\xfc\x83\x8b%Qn?\xf6\xad\x96k\v\xdb-c\xb2\x8d\xabl\xf9\xca\x03\xe9\x0f9\xbcD\xa783\x89\b\xc8Z\xe2\xd9)r\x0e\xc1\xb9\xa3?\x1b\x1a\xf8PQ\xd6'\xb1G'sQ\x96)Ei;t!\"B\x9d\xfdZ\xa5TwVi\x17\xd5\xf5\xf7(\x06\xd1\xfc\xf4\xe5\n\x85\xe0\x81\xe9D\x93\x01yˌ\x1b\x85\tD\x00\x9a\xefS\f\xabDmO\xda\xc1\x9f^\xa5\xe8\xcaWq\x9f\xfe\xe7\xec\xdbhL'r\x06i;\xc1$\x14\xd7\x10;\x1a\x9f\xa6\x99N^\bs\x83s\xa5\xb5.\xbc1\x7f\nJ1\xac\xb4\x0eU\x97\xa4:\xcd+\xd2\x0e\xa7\x1c\\;J\xc0\xa4\xb3\x80\xd0.\xbe\x01\xabYE}71\x16q\x1a5\x91\xaf\xa9\x95\xbc\xb1\xc8e\xb8n\xb1\x04\xcc=B\x81%\xfa{\v\x9f\xa9\x8c\x02\xdaȣT\xa2\x8c\xc4\x05\x7f&G\xb6\x0e\x9a\xb68\xe9\xb8ע\xa2\xab\x9a@۶%&\\\xce\xcan\x15\xa19\x7f:\\\x16\x1c\x8d\x8a\xbe*\xb6\xbb\nx\x12\xc6I2\xcf\x1f\x86|\x9a\x8dڴa\x1d\xf5\x84\x87\x99\xb2ݹ0\f\xee\xd1\x1cl\x87D\x99\xea_\xe6\xbdp/\xdf\xeaIzm\xf9\x90\xbe\xcd\xf0b\xadۿ澙\x04ԓx\xe5M\x05\xa1\xdc\xeb\x0e\v]W\xdc\v\xe5\x1b\xa7\xa4\x8b\x9dU\xd9\xea\x06\xff\x12\xb7\x02Wt\x1e\xf7w(\x97{\x8f#\xe0\tL軔\xb6\xfb%\x1aa\x11N\f\x86]\xce\xdc\xe8\xd1\xdb\x14%\xa0\xf6A\u07be)JF\x8dH\xc3m\x1c}\x91\xf5\xa77\x85\xe6g\x1f\xe3\x8aK\\\x1d\r\x9fqG/\xb2f\xf5\x9cٳyU\xa13~\x82իQPF\xa5B\xb1\x9f\xe6{\x9fba\x8fT6a\x96\xd1ݖ&?\xcd\xf6\xdaq\xca\x12Cf\xaf\x8f\x80\xdb\x06)\x01\xf0\xbd}\xb9\xbfu\f18'+\xdf\xfe\x1a\x8d\x17P@\x95\xf7\xb8\x01\x14=\xafn\xf3\x1e\\\xb2\xf8I\x87\xdbI\x97\xd8=\x1c=\xf6&\xf4\xffA\xf7F\x03\x97ո\xd7q4\xee\xe7\xea^\xad-\t'\x96X\xa0\x9c\x87,-4\x16\x8b\xdb\xd4\xce\x19\x99_\xba_Cu\xebܥTl\xe2\x8d|\xd4\xe9]P\x99\x00\x86X>f\xc2O²\x86\x06\x9fz\xf7\xf4ж[\xc6Z\r\x9du\x84:P\xda3s\ri\xe0o\xfd\x11\xa1A\xbad\xc3Wjڒ\x13c\xbc\xb6\xc0\xd7doR\x9c\x105?\xbd\xa21\xb2\xb8\x985\x7f\x19\x8e\x06\xdd\xfe_w}\xc1/\xe7\xfd\xd7ç\xa7\xe7\xb9\xeaJ\"K`B\x8aa\xfe\x14BQtT\x14aoΜ\x96\xb7\xcbR\xd7\xc9\xe7#\xd2=1\xd1P\xdaK\xdc>\x9d\xe3[\xb2~\x84ӌk\x12b䷝!\xa4_G\x92\xca\xfd\xfb\xbf%G\\ 7}\xfd{\xf8'\xa0\xf1\x99\x14\xe32\xe9_\xda\xc1 \xa7\x92n)\xbe\x826\xdfZ\"\x06ӥ\xf5%\x8fV_\x82\x91lZ`=\xe9π\x8cY\xd7X\x16|Y\xb1\xbd\x90\xc5\x06\x9f\x93ϊ8̀$\xcc\xd2\x14,8\x9fŽ훐\xee\am\xfe\xaa\xf6T^\xa7\xdb,\xbb\xd5\"\xd3\x7f\x9dLH\aE\x02\xbc\xe1\x1dX\xdb\xe1x\x8d\xc1\x81O{9\x9eQ\xed\x8e|\x93_\x8c\xc0\xabIM/\x01\x93\xae!\xf1YDE\xb8\xec\x91\x018\r\xc5Y\x89*\xec\x18\a\x92\x89r\r\x05\xd5\x04ЮM\x934\xad\xd6\x05\xa3ș~\x95\xc1}@<x\xd8\x18I\xf2RX빑Jb\bK\xaaV*\xdbT\xd8Vs\xf7\xd4\bJ7\x9b\x02\xf14\x99\x92!mn\xf3\xa1\xbfi\x15ϳ\xfe7\xce\xd0\xfeS\xab\xe4\xf1\x99x\x15\xb2\x14{YJw\xf681\xe3:\xc9'\x96\x8d\xe2 \x05h}\xae\xe330\xda|a\x12n\x1b\xf5\x13 98Q\xbd\x8b\xd8\x1e\x95)\x1e!qx#ԥ\xe2\xfb+\xa4\x95-\xc6*\r3\x1e\xe3\xf1\xfc\xb0y\xa0I|+̇\x1c\xa5\v\x04q\xf0\x1fz\x89U\u05c8jq\x8dU\x84p\xc3w\xdc\xdb\x1b\x10\xd9\xf5\xa6\x9e.\x9am9Ax\x1c\x1f\x13\xce\xc0\t\xa1|\xb7\x9aU\x02\u07bb\xf3\xa7T\xf8\f\x8e؇\x907\xc63Զ\x9fY\x19\xdfS_]\x17\x1ds]\xd5\xc2\xc9 \xf9\ak\x93=\x8e\x03\xac\xee\xa73\xfc\x85\xb9\x80\x98\xbf\xceO\xf8p\x81\xb8ߤ>\x81\v\xd7fPQ!b\x97U\xd8u\x9a9\xf7\x12\n\a\xbd\x83\xbf\x1bJT)\tLI&\xcd\x16\xfe\xeb?\x91VJ\xd5\xe2\x05\xec\x04X\xbeV=\xc1\xcc\x1bQ\x9f\xc4)\xaa\x97\x92\x9b\xf9O\x80\xccP\xd5\xfb\x16\b\xc7\xfa\x9e\x00\xba==縘d1\x97\\\x86'A3\xe3\x16\xddޢ0&\xa8?\xc4c\x87a\x8a\x96P\xb6\xb9\xa4\x949F\xf7\xb4\x0e\a\xcc\x1d\x16\xcbh\xcf\xe7W\xa9o\x80̠\x1d?\x06\x12-$z-\x8f\xf77\xb3\xcdͦv\xa3\xe5\xfbi\x1dMj\x97'U\xfe\xc6\xe5\x97\x0f\x0e:\x8dL\xbe\x9e\xfb\x1e\xc4ֳ4\xf9\x82\xd0I\xbc\x98\xf5\xd1W$\xd1\xf3\x15\xe5P\xdcۭ\x16\xb9\x1a\xbe\xc5D|\xe53R.\x9a\x85\xd9P\xa1\xb5\xe2\x18\x03\xb4\x8f\xbdGTTOHF)\xee\x88Ư\x987\x94\x03D\x19\xb1\xa3\b\xa1P\xe4\x8e.\xb4\xf0g\xa5(\x8a\xb5^$\x01r\xd8ꐭnQ\xf0\xc1w\x98.0\x82\xbf\x9a\xc1\x1f{\"~(\xe6\x01\xfb<\xda\xe3\xf3\x87i\x9c슎\x13\xa8\xbebF+g\xab\x1b\xb4\xd1\x7f<\xec\x02\x8aO4\x06\xe44x\xb6\xb6\xc0\xae~u\xdd\x19\xe6\x16\x1e\xf1-\xf1\x94X\x81ŗ\xf9b\x02}\xa1\xe4\xc9\xe8#\xf5\xe7$^\xdes\x9dy\xaa!\xdbq\xf971b\xe6\xc5\x02\xef\xf82\xe9C\xda\x01\x0fX\xf8\xdc\x1b:R\xfa.Z\xc4#\xb5\xb6\xf5e\x02\x13b3\f\xe4>\xb7\xa7{\xe2N'ռ\xabR\xb7Z>g\xe9\xd0\xee\x11z\xed\v\xb1f\x12o\xaf\xfa\xe4a\xb9n\x9f6\x86\xae8\xf4\xf1\x1a\xc7Љ\xbf\xef\"\xdaˀ\xa2엛ؘ'\x10\x01\xfeD_R\xa0\x13\xe3\x9c|؟WWG\xcd\x05y\xff\x0e\x9f\x18\xb9x\x81\xf8\xf8\xb1\xbc\x84_d\b\t\xcf8\x01\t\x9d\xaf\xbc\xc93F$g>J0֣o\xf1\x8dɈ3y\x18\xd2\xd7\x1e\x93y%~\xd2\xe5\xfe\"ϱv|ٶ\xffQ\xc9w\xef\x06_\x8d\xf4\xff̩\r\x90\xb4\xc6\xee\xe0o\x7f_E\x828\xd4\xda\x1d\xfc\xed\xef\xab\xff\x19\x00\xafTdP@S\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}m\x8f\xe3\xb8\xd1\xe0w\xff\n\xa2\xefC\x03\vۓ\xc5\x05\xc1\xa1\x11\x04\xe8\x9d\xedC\x1a\x99\x9dm\xcc4\xfap\x9f\x0e\xb4D\xdb\xccH\xa4BR\xfd\xb2\x87\xe7\xbf?(\xbe\x88\x94,J\xa4\xed\xde\xdddm\x05\xd9i\x9b,\x91U\xc5b\xbd\xb1\xb8X\xadV\v\xdc\xd0'\"$\xe5\xec\x06ᆒWE\x18\xfc%\xd7\xdf\xfe\x97\\S\xfe\xe1\xf9\xfb\xc57\xca\xca\x1b\xf4\xb1\x95\x8a\xd7_\x88\xe4\xad(ȏdK\x19U\x94\xb3EM\x14.\xb1\xc27\v\x84\nA0|\xf9Hk\"\x15\xae\x9b\x1b\xc4ڪZ \xc4pMn\x90,\xf6\xa4l+\"\xd7Ϥ\"\x82\xaf)_Ȇ\x14\xd0w'x\xdb\xdc \xff\x83\xe9$\xe17\x84\xcc \xbe\xda\xfe\xfa\xab\x8aJ\xf5\x8f\xdeן\xa8T\xfa\xa7\xa6j\x05\xae\x82\xf7\xe9o%e\xbb\xb6\xc2\xc2\x7f\xbf@H\x16\xbc!7\xe83\xae\x89lpA\xca\x05B\xcf\x06%\xfa\xd5+\x84\xcbR\xcf\x14W\x0f\x822E\xc4G^\xb55\xb3\x03[\xa1\x92\xc8B\xd0\x06\x9aܠ\xaf\n\xabV\"\xbeEjO\xc2\xf7\xc0\xf3O\xc9\xd9\x03V\xfb\x1b\xb4\x96\xbaݺ\xd9c\xe9~\x85\xd9:\x00\xf6+\xf5\x06c\x93JP\xb6\x1b{\x1b\xe0\xb9\xf7\"\U00102961\x02)\x0f_\xeaH\xb5>\xa0\x93mk\x86\xf0\xb1\xd7ߌ\xa1Ċ\x8c\x8d\xe0\xa3\xe0\f\x91\xd7F\x10\t(\xeb\x0fF\xb4L\"\xce\x0e\a\x024_\xbbf\xfd\xe9\xf7\xbf\x9cC\xc0\xdf\xf9\v\xaa8\xdb\xf5\xde{-\xd1\x06\x17\xdf\xdaF\",\b\x12Da\xcaH\x89\xb6\\D\x86\xa2H\xddTX\x91\xb5R\x95mbP\U00043183\x1e\x1f?%\x0e\xe8\x90\"\x15\x96\n\t\xcc\x10\xb6\xa3\x1a\x19\x83a\x06h\xf9C\xd8Č\xe1\x13\x00\xe8}? \x89i\xf6\xfc\xbd\xfe\x03\xb0Z\xeb\xc5\b\x7f\xf1\x86\xb0ۇ\xfb\xa7\xff\xf9\xb5\xf75\xea\x0f\xda!\x1dQ\x890z\xd2+\x10\t\xbbԑ\xdac\x85\x04\x01\x12\x13\xa6\xa0E#\xc8\xca\xcdϱ\t<\\\xa0\x86\b\xcaKZ8\xcc\xe9\xcer\xcf۪D\x1b\xcd\x11\xeb\xaeC#xC\x84\xa2n\x8d\x9b'\x10I\xc1\xb7\x83\x11_äL+T\x82,\"Rcݮ\\Rj\xfc\xd7\xd8,D*\xfd\xf8\xb5|\xea\x01F\xd0\b3\xc47\xff$\x85Z\xa3\xafD\x00\x187ꂳg\"\x00\x03\x05\xdf1\xfaK\a[\"\xc5\xf5K\x81s\xac\xe0\xf1\x8f\x96\x14\fW\xe8\x19W-Y\"\xccJT\xe37$\b\xbc\x05\xb5,\x80\xa7\x9b\xc85\xfa\x89\v\x82(\xdb\xf2\x1b\xb4W\xaa\x917\x1f>\xec\xa8r\xa2\xb8\xe0u\xdd2\xaa\xde>\x14\x9c)A7\xad\xe2B~(\xc93\xa9>\xe0\x86\xae\xf4H\x19\xccO\xae\xeb\xf2\x7f8\x02\xca\xeb\xde\xd0\x0e8\xd8\xfcO\v\xd8\t\x84\x83\xa45\xfca\xba\x9ayy\xbcR\xbb\b\xbf\xdc}}\fy\x87:Y\xe6>\x06;\xa3\xf4\x18\a\xfcP\xb6%B\xf7C[\xc1k\r\x93\xb0\xb2\xe1\x94)\xfdGQQ\u0086ؖ\xed\xa6\xa6\n\xc8\xfc\xaf\x96H\x05\xa4Y\xa3\x8f\x981\xae\x80\xed\xda\x06\x16K\xb9F\xf7\f}\xc45\xa9>bI\u038do@\xac\\\x01\x1e\xd30\x1en\x9c\xfe\x03Pn,\x92\x82\x1f\xdc.\x19!\x8f[\xc1_\x1bR\xf4\x16\x04\xf4\xa3[Zh\xb6\a\t\xe8\x17\xb8[\xc1=\xa8\xe3k\x12\x9e\xa2j\xa5\"\xe2\xe0\xfb\xc1H>\xdafZ\xf4\x02\xbd@:u\x1bbM\xea\r\x11\x1d,XA \x14\x0f@\"\xd46KDa\xf1\x92n\xbcz]\x82\b\x91\x88\x828\xad1\xc3;R\x13\xa6\x1c@#\xab\xccKF`v\xaf\x85\xb1\t\xb2\xa3Ї\x94腪\xfd\x1a\xdd\xe1b\x8fԁ\xfc\x86\xf7-\x11\xeeK\xe0\xf0÷\x88@W3\xc5\x1a\xd1n\a\xf6\x1c\xdcm0\xe8\xfa\xbbk\xbd\x0fH\xd46\bW\x15\xe2\xdb\x11\x98j\xdf\x1b\xe0\x00mkt\xbfE\xa4n\xd4\x1b\f\fԚ\x8a8\x81\xeb߾^\f\x80\"\xaaH=B\xbf(\x87\xda]\xa8\xad*\xbc\xa9\xc8\rR\xa2%\x8b\xf1\xbeX\b\xfc6\xf8\xad\xc1\xad$\xe5\f\xbf<\xe8F\x86\xd6\x02\x04\xa5T\xbc\x91}\x12h4j\x9c\x82\x941\x13\x1d\x8a\x15xZ\xa6h\x85\xa8\xba\x96\xa8e\xe6\xed\x1aU@B\xf4B\x04A5\x95\x12\b\xbe\xa7\xb0\xdb)\xad.5n\x04C\xa1\x02\x8f~+\x91\x1d\xfd\x11g\x05A/{\xc2\xfa\xefY\"\x81\xd5\xdep C\x9c\x11\xbdր/F\x80\xdaQ\xf46C\xf7\x18tn8\xaf\bf\x8b\xdeO\xa0\xc9\x18y3\x83\xd3/\xae\x1d\xf0➿\xa0\x1a\xb37\xb7\x04#Z\xd27Ҩ\xc3\xd1 @\x9f\x9e\xa8$j9\xec_\xf0\xba\xa9\b0:\xecn\r\x16\x8a\xe2\xaazC[L+R:\xf0#@a\xfd\x95\xc4t\xd5\b\x05\xc0\r\xafh\xf1\x86\x18\xd7\x1a\x1d\x11\xe8\x1b!\x86\x13\xea%\xa2L*\x82K\x98\x04 \x7f\x04\xa6\xda\x13*@S\x03u\x94\n\"\x97H\xc2\xfe\x8c\x15\xc2ݠ\xcd\xdf\x060\x8c\x12\xf8\xa9\xe4D\xb2\xeb1\xe2W\\\x12\xbbF\x11U\x1d\xbe\x0e\xd14\xb3D\xe2B\x15\x1e\x18͏\x98Voc?\x0e(\xfb\x0f\xd7\x16(\vHc\xad\x96\f|\x8bJ\xfc&\x97\x8e\xc85\a\xa5\x93\x14\x87;\xa5\xfb@s\x83\x8d=~&njK\x90\xc80 \xab\xd8He\x7fA|;\xc6\x1d\bՔѺ\xadoПF\x7f6\xec\f\xca\xd0nT$û@\xc1M\x9c;4=\x9cz0[7\x11\xa4\xf8(D\x83\xeew\x9b\xca\xff!\xe4[2!M\xe3\xc3\xe9\xbc\x10\xf2-\x87\x94\xba}&-\x11\xbc\\\"\xa9\xb0\x88\x81\xe5\f\xfd\xc4Y\x89\xdf\xde\x01[\x11-\xc7\x190 \x9fn\x16\x93\b\xec\xdb,C3T\xab@\xb0\xb8AX\x00O\x8b\x96\x01K\x1f\xc0DV\xae\xaf\x17\x19{\xa2\xdb\xcdg\x86\xf8h\x9b9\n\x97\x9d\xd3\xc4\xd1\xd6\xee)\xa0\x05i\xdb\xc8\x1b\xcb\xe1\aZ6\x82?Ӓ\x94\xe3Zۼ\x90\xf1N\x8c\xaf\x8a\v\xbc#\x9f\xb8\xd1\tG[\x0f&r\x1b\xed\fS\xc3\xda\x13\x83@I\xc6\x06\xe9Z\xe5\x1b\x05\x8b`\xe6f\xd6\a\xa04\x03\xc3\\-\x97z\xab\xb1\xe0\r%e|I\xe3\xad\"\x02\xb6\xf3=\x96hC\bC\xb2-\n\"嶅\xed\xa8m*\x8e\x01w\x8ak1>x\xf38{Gu\xa5\x19\xdeH\xda\x10\xa6\xf5\xa6@UM \x8eU\xb8;1\x82k2\xaemO(\xdb\xef\xa5p\xcf+ݏ\x9e\xde\x14\xc4\x11\x87\x9fZV\x12\x11Y\xae!\xc8\x0f\x7f\x05N\xfb\x1bj\x04\xd9\xd2W\xb7K\x03\x10\xbc#\xa8r\x9c\x15\xaa˳@=\x1b\x8ec\x81\x1a5\x00F\x19\xd9Ff\x98\xa3$[\xdcV\xea\t\x9c\x88D>\xf2/D*:\xb0\xedF\t\xfd\xe3hGg\xe1\x11\tZ\xa9VA\xad\xc6\x12\x9f\xea\xb3y\xb7c\x13\x98O\xdb\\K\xd4\xf0\xb2s{l\x88\x9f\xa76\x90\xc0\xa8W\xb4\x88\x80ܼ\xb9\x89-\x11y-H\xa3ОK\x05\xdeN\xf7\xbae\xf7\xdeFp\x10\xfc\xd6@\x8a@\x84\x91\xfd\xa3\xdd\x10\xc1\x88\"\x12\xdd>\xdc\x1b'\x8a\x03\x02B\x87\x94@\x12\x18\xf6\xb5\x9d\x85w,\x7f0_\xacl\xfb\x15y-\xaa\xb6\x8c\xca%\xed+\b\xf8\xa5e^\xe3\xd5\x1cp-\xdd\fa\xa9\x81ο>m\xe9\x8f\xeb\xf8\xf0ء\x96\x9dS:EH\xdf\x1dtr\"\xb9\x13\xd1|\xab\xbd\xac\x06\xe4(Dd\x15fA\x10\xb8N(30\x01˞S~\x97\x02\xd3\xe1\xccE(rP\xd6\xf5\xb1\x0e\xae\x8a\x16Z\x86vn,\x8d5\x8d\x9aQ\xa0\xe8\xdf\x19a_\x19n䞫OxC\xaa\xaf\xa4\"\x85\xe2\"\x03y\xa3\xfd\r\"\xc1\xc3\xf5\xfc\xfd\xba\xf7\xcb(`\x84j\xac\x8a=\xe8\x0e\x0fO\xa0\xfaj\xe9\x8f\x1e\x9e>Z\xb5\xa0\xa80\xad\xad)\x18\xba\x94\x81I7\xe3\xb3GHڑ)0\xcf\xc93\x98\xec[\xe4\x86k\xc5(\f\x148\xce\xecD\x0fO&d \x15\xad\xaa\xc5\bH\x84\xb2H\x9c@\xa4i\xb5\xadC\xcd]\xa7\xdbF\xdb\r\xe83\xec\x16\xa8j|\x8b*\xa0\t\x92\xd3D\x81\a<\xaaT\xe8M_\x1a$\x85\xdfhl\xdd~\xfe1&\fg\xf9\xfc`ط\x83\xa1\x85\xaf\xb3\xcbs~\xd0V\x8cu\xf2O\xfb\xaa%8˾\x11\xf0\x991\xf0X @<\x86W\xd8\b\a\xa8\xf4r\x06*A\xdfț\x06`\x9d\xf6\x13\xed\xe7I\xeb\fǷ\xe9\x06\x03\x14\xc1\b\xac\xb6gp\x05_tjK\x02M\xad\xccj\x9a\x8a\x82\x9b\x98\xc7i\x97(\x8c\xdc\xe30\x9a5\x9d\x8e\f>$`\bu\r\xfe\xfc\xca\xec\xc9{\xda,\xa2\xe0\xec\xa38xz\x88\x02\xd1\xedB*O\xb8\xa2e7.\xb3\xba\xef\xd9\x12}\xe6\xea\x9e-gA\u07bdR\x88&\x00\xbd\x7f\xe4D~\xe6J\x7fs6\x84\x99af\xa1\xcbt\xd1K\x81\x99\xdd\x10\xe6\x1b\x06e\xb4\x023\x03\xd2\xf0r\x87z*!4\u0085ŋ\xfeѾȼ\xa2n\x0f\"\\\x87\xcf\x06\xb4\x06\xb6Ҟi\x18\xc3\xc1;,:\xb9\xe8as\x9e\f\xa3\xc3\x01\xcbо\xea\x11\xc2Ef\xa0&\xd6W\xd9H\xfe\xf4S\xb6\x1ai:\xa4\x85\x15\xd9\xd1\x02\xd5D\xec\bj@v\xce\x11yV\xaee\xf2\xc2܆\xed>V \x8e8\xd6\xfd\xb3\x82\xf53\xf9\xbb#\xcbD\xa3\t'MΘ\xf5F\xa4u\x80\tl\x85I\x16)R3\t\xab\xbdu\x13\f\xc3j'\x18<a\xe8\xffÖ\xa0\x99\xeb\xbfP\x83\xa9\x90kt;\xf1b\x1bm\t{YE |A\x8d\xb5=\v\x94z\xc6U\xdcu\xe7\xc4\x16C\xa4\xd2;*\x8ch\xb8s/\xd1\xcb\x1e<\xd1 淔T%\x80\xbe\xfaFޮ\x96\x8b\xf4\xf5}uϮ\xcc\xd6w\xb0\x9a\xba}\x92\xb3j\x8ak\xaet\xaf\xab\xe3ԀYn\x9ai0\xd4W\xbd\x9ds\xb3\x98%\xfe]\xb43\xa2Y摡\xc4\xc3Sg'\xdb\bs\x8a\xae\x19\x01\x19\xd7@\xff\x9d̉=\xe7\xdfR(\xf1wh\xe7\xb7zT\xe8\xbc2\xb4!{\xfcL\xb9\x90=\xf5\x1e$\xfc+)Z\x9f\x8d4\xfc`\x85J\xba\xdd\x12\x01kGgS\r\xdc\x1a\xeb\xc5q\xaa\x99\xb3\xfd\xa2\r\x06\xf3\xf26$\xa8\x18\x1a\x1b\xb1\xa9\xc4\"X\xee\x03V6\xecKm\x83(+\xe93-[\\\xe9\b\x18f\xf0\x02HW\xe9Ʒ^\x1c\xbd?\xf5\xc6o\x9c\xb2n\x16@\xa5^.\x01D4\xb9@5\x17\xe3\xcc\xe1>\x87`\xa2\x14E\x1b\f\xb1X\x1es\xcc\xfbG@ʠ\x1dJ\xa9\x03\xab~\x9d.=\xa5\x8ct\xeb\x9b\x0f\xe7\xd0ϝ\xe4\xf1Bc\xba}D\xf6\xf8\xee\x81Ϯː\x98\x12:\xfe\xa38Į!O\x014\x1e\xe02\rK\xc70\xb5\x03\x027M\x15\t\xd8dpF\xa2\xd0\xc8\x12\x1f\xa9\x82\xe4\x10\uf39b\x8eC{\xd7{\x80\xf5\x8em.H\x0f\x91Nِ[\xb3\xb0~\xcfޟ\xd9\x01ݔ\xf4\xdc\xfaT9s6\x05*8\xc8\xfd8\xfe\xc3\bw\xdcj\xb9\x1f\xf6>\xfbj9\vպa\xfc\x87\x10\xad\n]\xa3Y\x04\xeb9Uu\xe4\xce\x11\xac\\\xa2-\xad >6\xbb\xb1\xf6\x14\x9dYʝ\x13A\xa9{o\x9e\x034\x82\xab\x04Wh\x02H\xd4)\x15gp\x8afsj\xbe\xa34\td0\xa9\x04\x97i\"\xc8Q\xc7j\xa6\xf3\xf48VIv\xa8F\x90:\xe9ZM\x06\x19 5\xdd\xc9z\x94P\x1ab\xfc\xc8i\x9f\xcd\x05\x9b\xed\x8c̀\xe8ݶǺeOBq\x9a\xab6\x82\xe0)\xa7m2D7\x86Q\xd7j\xe8\xbè\x18\xf5\xac\x1e8r3\x80&\xb8|3!&;\x7f3`:7\xf1\x89n\xe0\xa3$\xf9\xd1\\\x98\xaeZ\xb8O\x8a\xbb8\xddq\x9c\xe9BN\xf6\xee\x9d2\xcb\xc0\xf1\x9a2\xc9\\W\xf3\xd1\xf4\xeaI\x80\x04\xf7s\xd2\x18\x9c\x8b:\xcd\x11\x9d\x04\xf2\xc0Y\x9d\xe0\x92N\x02\x1cu[\x8f;\xa7\x93`\xce;\xb0{n\xea\x9c%r\x84\xf2\x96\xc1\xd5\xc9M\xc12\xbdYd\xb0\x16\x98\xeaNk\xf1\xe9\x7fV\x85_/\xce\xc4\xd3\r\x8feiG\x86\xf5\xc0\xa52\x0e\xc0\x9e\xba=\xe2!\x9c\x81\xaa\x95\t\xeb5\xb4\xb9\x9e\x90\xe3\xe7N\x9c\x81\xd8\x1d8\xc8A%\xef\xce\xd5\xc6\x1f,\x02o\xa4\x01\f\xae\x81+/!\x8c\xd7\xe6J\xe7\xa9\xe9\x7f\xcf\xc3,\xa0\xa7a\xa3Fp\xc8B\x9dg\xa5ĝ\xa3\x87\xdeC<v\xceZ\xac)\x1f\x9cw\x9dzR\\\xc9ǩ\xe2\x80ڔv\x83\x89ݽ\x06~g\x10C\xf0w\n+\x1f3Fx\xe0\xa0\x1f\x1e\x9e~L\x1e\xeeG\xd3\xdb-@\vL\xeb\xa6X\xecZ-T\x92!\x87\xac\xfe{S<j\xca\xee5\x9f\xa2\xef\xdfMYAN\x94\xc7R\x9f\x13\xc8a\xfb{\x82t_\xb0E\"D\xab\x187\x1c\x8e\x8a\x11Az\x94=\x8cd\xa4SJ\x9f\xa7\x02\x97q\u0b31o\xba\x96hK\x85O\xa4\x8f&T\x8f=\x93\x19\xa9g\xe2\x00\xce\xee\x848\xda\xc4\xfc\xd9\xf4\x0e܊p0\xcd\xe4X'CD\x1e\xf9\xfa\xa4\v\x85\x8coDX\xc1[8n\xad\xad+\x02\xafɀh\x88h6\x93\xc4=\xd3?\x84\xb5u:BV\x9a;)\x9b\xf5\x8e\xf9g\x85\xfe7\xa6\xd5\"\xa1\xe5\xb1d\x85\x13\xaf\xbcU7\x89\xcd\ad\x85z\a\xbcU\x9d\xbc\x06f\xae\xf1+\x1c\tC\xb8\x06\xb2$\xc3EZo\xa1\xb5ϼ7\xb4~\xc1T\xc1^\xa6\x97$\xec\x03\x19\x10\x15\xef\x0e)\xa2\r\xd9\xc2\xf9\xfa\x823IKҩ\x0f\x96\xfe\xa3'ob\x0f\xd6G\x1c[A\xd6\xefG\x99\\\xbb͊\xa7\xa4\xd6\x19jk\xce@Vz\xebZ\x9c\xf1\xed\xa9\xfbG#\xf2T\xe6\aAί\x9a6\x82\x02\x97\xf29\xedt\x16\xa6\xd6^\xfbکe^8\xc7\x1bQOg\xa1BۋzzQO/\xea\xe9E=\xbd\xa8\xa7\x17\xf5\xf4\xa2\x9e^\xd4Ӌz\xfa+\xa8\xa7)#\\铙\x8b\x13G\x95\x98\x8217\xec\x99w\xd9L#{\xf0ܩx\x91\x1d~,\xcbh\xd8s\xe4\f\xb3=\x8d\xbd\xd2\xe5\x19c\\\xe34\xc3\xf0вK\x83\xd2\x16\xa3[L\xfa\fQ\x8a\x16~\x86ûv\x00wP\x1aL\u07b2\U000815df\xf8.\x03;Þ#\xd8\x01\xb3\x167\xaa\x8d\xc6\xcfa\x9ep\xe2Qu\xd9\xd0>߭\x8f\a\x7f$`\xbe\xd0H\xc5w\x1d<8t\r\x90\xa8Z\xf6\x01\xc29i\x8aw\x8cñv\xf8\xb7\xd0\xe9)\xd1\xfc\xc8\xc7=y\xbb\xb6\x05\x884є\xe0\xed\xa6\"rϹ\x02)\b\xe3Â\xb0kH%\x01\xd3*\xa6H$Rf6\xb5q.\xa1\xb1\x7fH\xb8C\xecd\xd9\v(=a \xd9u%\xb5\xd1\x16f\xc3\xf5\xb3\x12\xb5\x85\xe6F\xbc^d\xebճ\x02=\x99\xd5cr\xc2\r\xce-\xe3ϾZ\xeb\xf0\xc9\t\xb9\xceX\v\t\x1b՜x\x1b\xa5oo\x16\xa8\xa2\xba\x9c\xa03\xe0ex\x1a|\xf6伯\x9b`\vD\x02U!ѝ\xd8d\xb1o\xe4͕ð c\xb1O\xb2ޭ\x91$\x85 \xb0\x92\x05*IS\xf17\x1dSX㦑\xcb\xc3x(ё69^W\xce!\xd8\xf0\xea\x12\xd6[\x8d\x158\x18\xb0\xf4\xcc\xf7\x01\xfe\xd5ϳ/\xe7\xc7jr\ak\x1f\x8eE/\xb4*\v,J\xb94\x13\xc1M\xf3\xa1ܬ\xbe[\xa3\xfb\\\xa4\xc2\xea\xb7\x15\x1f\xba\xbfj\xaal\x81\xb7!\x11\xf5\x1a\xd5\xd9+\xb1\xc1\xea@1\x90\xd7\x02\xed\xc6\xd1[w}ɶ>m\x1d\xcd\xed\xa7~\xf47\xf9l;\x94Jn>\xb6\xfcb\xb4t\x8e}\xf7`\xa2\x03\xa94\x8e\x9cߥPJH\xa8\x8d\xa7\xd1ƫ\x16\x80\xa6n\x92jGA\"S!\x05\xce\xf5\xe8r\xd1l\x17\x9e\xdcq\x02_\xf1Q\x1cG \xc2꣕\xd9\x16\x1c\x84\x1e\xfa\xd1\xcfz\x0e\xb8:\x9a/\xe7}Qü\x8fX\xbb\x01V\x87\xdd\xfan\xd6~\xde\xea\xbc\xe2|\xa9=p\xa9=p\xa9=p\xa9=p\xa9=p\xa9=p\xa9=p\xa9=\xf0\xeb\xd7\x1e\xa8\xf8\xee\xf1\xf1\xd3\xcdb\x96ПtC\x982֥\xc4\xd7?\xb6Bo\"\xab\x06\vI@\x1f\xb3\x8cc\xfbm\xe2<\xb4\x0f\xef\xb6\xf8\xc1\xf9V\xc0\a\xe3Q\t\x7f\xe9?\x04\x91m\xa5\x9cI\x05N\x92\x986aS\x19\x97\x81\xcf,\xbc!cP\xfcN\xbbf\xdc\xef1\x88p\xceE\xea\xaa\xcd\xf0_?\xdc\xf5\xe2\x88\xe5S\xe3\xd7\x1f\xde\x14\x91\t\xd8\xfe\xc96E\xb4\xefٗ\xf4\x17\xa2\xbdR\x1b\x00\xb4\x1c\xd69\x1c\x05\xac㬠\x03\xc0\x99t\x85\xc5\x06WU\xb7\x8fؿ\xd1N\xf0\x17\x89\x1a]\x84\xd8\x16\a\xec\xdd\xe91|\x80\x0f6\\\xb8\n\xd9\xe0\x95?\xb1\xba B\x7fB5\xc1\f\x0e\x1e\x1b\x13x\xbc\x9d1\xecu\xe5\xe5\xbf\xfc\xf9X\xfb`\xae\xe2q\x8d_\xef\xe3\x1bѐT\xba\xe9\x90T\xbe\xea\xb16\x1c\xe7\xceZ\xd9R\xa1\x81\x93A\xa3\x13\xca\x12X\x00/\a\xd5+\x7f\xd7t:\x03\x15:\x1f\x8d3V\x13\xc8\xf1y\xd8Ǫ\xee\x81\x13H۫\xd6ش\x92qR\xa4l\xa0\x06\x19\x94`\xd7\xe6\x1d\xa0\x0f\xcaƃ\xeb\xa0z\xb65/\x02B\x00\xcaE\x1bՂ\x9d|3\xa3\"e8\xac\xd0\bFXK\x1dm}\x1fzjc\xa4\xb9ߎ4\xee\\F\xa0\"^}w\xb5\xf4>\xa2\x91Q\xc4\xc6\x1d\x1a\xe8\xc7\x12\xfcb\x96_\xcc\xf2\x8bY~1\xcb/f\xf9\xc5,\xbf\x98\xe5\x17\xb3\xfcb\x96G\xccr.J\"\x82\x18\xd8\xcd\xe2T>\x9a\xe5\xa1\x1e\xff\xfc<x\x7f\x90\xa9\x01\xd4\xd7\xc3\x03VpUU\xc8bBl\x84Q;ُ#\xdb\xf0\xa9\xbfΨ\x11\xb4\xc6\xe2\r\xc1\xf5q\x1b\x7f\x83\xe8\xf0\x81\xf3K\xe1}\x05.\xc7\fB\xdbਤ\x05>.\"\xad\x93?R\xc3\xd1:\xf3k%I\x83Ep\xad\xe8\xf0\xe3\x82\xd6G\x86\xa7#P\xbb\xe9\x98i\xda`o\x87o\x9f\\>\b\xdc\xebT\xd7\x18\n\xec\xe26\xe4u>\x12\x03z\x89\xb6\xbc\xaa\xf8\v\xdc~\xf5\xa6/\x1b\xe1:aG\xbf\xf1h\x83`f\x194\xbc4\xd5\xcc\xed\xc5*Vۓ7\xf3\x1c\xfc\x10\xe9ڷ\f\xc6\"\xa21\xad\xb8+\xe4\xaey\xc48\x0fݕ\r\xde\xe34z\xb5\xc4\x04\xbe\xdd\x1av1T\a1\xf3\x12\x88\x94\xbb\x1fn\xcd\xed\x19\xb87\x93kٽ\xb2\xbf2#\x10#W`\xf4.\xb0\xe8߂1\xb8\xef\"\x02W߂Ѳ\x8aH\xe9\ueec1~~\x02K/o\n,\xc90\xcf!\x02\xb6\x1b^,etR\xb1\x996\xd6\f'\xe9\xef\xfe\xd5\x12\xf1\x868\\\xa7\xe2\xb4\xf2\bȃ\x95k\xfc\x9b\xddVh\xf7T@\xe7pk\x8cB\xf4\x1b\x12\xbae\xd6\xd4\x1f\x8cU\xc3\"2\x8c\xb9Om\xfd\xb0tc \x18\xef ,\x8e\xb7\x05\x87\x93\x8b\xb7\x1c\x90aر\xbf\xa0\xfbc\x9e\x80y\x0ec\x7f\x86{Rx\xe88\x83\xff\xbdL\xfe\\\xa3?\xdd\xecO2\xfc\a\xc8:\x93\xe9\x9fc\xfc'\xe8I\xfeq\xf8͜\xd6\xd9\\\x00\xef\xe2\x048\xda\r\x90\x85\xba4W\xc0\x00q)\u0380Y\x88h\xccT\x9ft\a$\x80t\x16z\xa2C \x01b\xcfe\x90\xe4\x12H\x00z\xe048\xd1)\x90$\xff\xb2y#\xc5\xccNw\x0e̻\a\x12\x1d\x04\xb3\xcaj\xce胭~j\xf09\x06^\x16\x9e{\xeb*\xddY0\xf9\xea\xdbwp\x17\x1c\xe90\x98\x848U\xa8i\xdae0\t\xf6\xa0@\xd3\x11\xeaD\x02\x87\xcd6I\xb6\xbab\x1cj\xed\xe7\a\xb8\xb48\xcao=\x06\xfa\xd2\xef\xe1\x9d\x05K\xd4\x10\xd1)\xbc\x90qA\xc0h\x1c\x85\xe8\xae\x06נ\x90>X\xa8-\xd9\x17.\xbe\xc1-\x97\xd6\xe46\x87C\x92\xee\r@Z\xbf\xd6\x06\xaf\xbb\x82\xd9Xm\x9d\x06\xee\"Z\xc0b \xca\x02M!\x02\x91\xaa5\xfa\xd2\x1fcoX\x90Z\x1e\xc4{\x19wo\xb6\x90#`c\x9aɤ|\x1d\xd0\xc0\xcc)\xa4E\xa7>9\xacڱL\x18'@\x03\x8fq\xbe\xf5\xfaE\x87\xb4\xf5\xe2xU\xd0\f \xfe\xfb`R~\x16\xdd\xf9 {\xf3\xff\xdaNI\xda5?1%\xcfZv\x02זBTF\xef\x06O=f\xba\xd2\xd7\x1dO6\xf8\xb9\xa6\xb1\xb5\x9c,\xb0\xbb\xa1'c\xce{\xee\xb0 \xfd\xe9{\x15\xdaPc\x91\xa6:[W\xdd\xd01f\xee\xfdwͨ\xb2\xf5\xac'\x81βR\xa2j\x91\xb8\xd9\xcdo\xc8s\x8a\xc4j\x1aU+\x8f\xdc\xdfLjK\x82E\xb1\xbfg%y\xbdY̲\xc7W\xdf:p\xedv\x8b\x8c\xa3MK+\x1d7\xa6\xbaMty\xf58k鼛\xb0\x8bjK\xbc;TgW\\(\xb4c\xb6\bt6\x17\x1b\x83#H\xa7A@Y\x9b\xb0gw\xff\xbd\xff\x0e\x15\x98M\\\x98\xa8\xe7k峝p1廜;q'\xfb\x17ठ\xbc\xdfc\x1c\xed\n\x7f#\xa8\xa8x[vo\x88\xb1\x14\xc8f\xf6\x86\x1e\x9et.\x8a\xbe'\xa6\xf0\xfb\xa2\x15\xda\xd6Q\xd3en؟# \xa7rے\x19t\x02g\xfd۩Sp\xd6\xefa=$:\x0e\xe6\x942w\x9aܖ\x87\x1c\x85\t\xe7ƭ\x1bx\x00\xd0\xd7@\xb3\\\xe4\x1d\xb9\xf3\xe71\xa3\x82G\xa9*ar\xef\x99N\x19K\x81<f6ƅ\xea\xd8סN&\xcc\xf0i\xbcg\xe0\xb1K\xbfZ=\x06\vK\xc9\v\n\xe1\x17sRIג\x98\xd2\n'\xf7\x95\x19TL\v\xe1\t!\xafhM~\xe1l\xa4\x94S\x9f%l\xb3Ú\xa7Ds\t\x02\x18K\xefU\xbf\xbf\xfd<\xe6\xc3\xed\x9ava4{\xb7\xecW{\xc5>\xc0'`\xaah\xbcQf\xf7\xf6ۚ\bZ\xe0\x0f\x9f\xc9\xcb\xff\xfb\xbf\\\x8c\x96\xe3\xf0\xa9\x831`\x87W\x8c\xeb\xe4\xde\x02Wz\x0e#0aV\xebE\x06-\x9e\x89\xa0۷\xbbg\"\xdef0\xfa\xe4[\xea\xab$v\x82`{\x17:C\xbf\x10\xc1\x97\xa8\xc0\xad$0\x05p\xe1\x7fV{\xbb\x84\x0e\xe0\"T\xe8\xce]T\x03\xaeuw8\x80\x1b\xe8\x89\x19\x17\\\xe8\xcfYAz\xd7\xf6\xbb\x9b\xfaG\xa0*\xe7Ow\x02r\t\x116\x93\xeb\f럪 B\b{\xa8\xe2H\x16\x02\xcc\xe0\xe0\xfbE\xfc\x84)*IE|!X\xbbe\x83x[\xdbe%\x97\x10\n\x82\xff'BB\xe0\x89)\xbb\xecG\xc0\xea\x1b\x8b\xe5R\xa7\x8d\xd2\u0086~\xfaU\r\xbc>4\x7f\xbe=\x9e\x04:\xae\x95\xad:\xa4\x0f\xbeV\xa4n\xc0ŽHX\x93Ra\xd5\x0e\xa4@\x8fo\x1cs\x7f\xd5\r\x9d}g\v\x1b\xb5B_\xca\x06@\xf4mڸc\xf7\xb1\x91\xc5\xed\"#\xa6>\x82\xa9;\xc3\xc6?\xf8\x96\x9dl\xe8\xf2\x8b͏\xd6\xe2\xd4\xd5#5\xcbYn]D\xb2Z{\xfc\v9\xad.\xed\x17\xf4\x90\x92(\"jʈ\x8d\xb8\xb9W\x98me\x04d\xc8\xfc\xfa\xb0h\xb0\xf0\x00\xb0$*\x87\xf4\bUX*\xf3\xd6\x19\xd4|\xea\x1a:\xcc@W-j\xbam\x1f\xbd`\x9d\x94k\xabٌ:\x88:q6\xca^a\xe6y\x89\x15Y\x8d\x8a\xb2\x19%iB\xa2\xe9\v\xfeff\xfa\x00m\xdc$\x1d\x13\xea\x8en\x8fpsX\xa4ٱ+\xf4\x99\xbc\x8c|{\xc7`\x12\x87d65\x91H\xa9C\fx\xb4t\xcf\xc4\x14\x05y\xa6\x91P_o\x9a_\\\xbb\xcet\r\xeawx(\xc39\x8f\x9e~\xb0Z\x9d\x13\rKī\x92He\n~\xad\xd1m\a\x0e\xd0*H\x01\x99\x0f%\"\xb8\xd8\xc7\xf6*x\xa5\x03\a\x9d\x8a=f\xbb1\xb9\x16\xd53z\x93u\xa3w\x93\x06\x90؏J\v\x17?C+1F\x80\xa2nP\xebE\xbekƽo\xfc\xd7\b}\x1c'\xfa\x83\x0e\xf0\x97\x03\xb5\xeeڍ\xd1\xc5j\xe5\xc2\xf5v\xa9\x02\xdf/\x879(X\x05\x87u,}(\x1b\x9b\xe4\x9cD\xe9\xa3)i\xaa\x8f\xb6\xb1\x9b\xea\x01%:p\x90\xc6\x13\xb5>P\x1f3\x91VsD\xea\a\x04\x06\xa6\xd1D\x9f\xc1\x9cn\xa3 F\xf5\xf2\t\xb0\xf6\x80\x86߂\x0e\x00\x0em\xf4\xe8\xf6\xe1\x9f\r\xa4U5\xb0\x8d\x80#IW\xae\x0f\xf5(\xd9\x16P\xd9s\xdbV\xd5[\xa7T\xc5ݵn)ʡ)\x19c\xa0Y\vaV\xcce\xec\aif\x85\xfbX\x15+\x99ж\x16\u0558-Q\x13Xt\x0e\xe2\x1c\xfa@\xe8@F\x10\xe8\xcf\a\xba=l\xab\xa0\x94\xea\xb0\x11\xc3;\x13\xa7\xb1\x90'\xc1j\xe6\xe8\x8f\xc4e\xea\xed@\a\x15֤3\xc1\x14\xcbA3\xb1\x1d\xa3d\xa2\x96\xd9\x04\xb6\x0e\xf0\x87\xbf\xc2\xfc\xff\x86\x1aA\xb6\xf4\x15\xf0\x00La\x15\xeeI\x90\x95\xe3氢Q\x00\xda;\xa2zx\x99\x84\xe9p\x16\xa60\xae\x17'\xb2\x9b=\xbde5\xfaG\xfeE\xa7\x9e%\xb3ˏ\xa3\xddG\xdcTs\xde]\xcbc.\xf3jpDQ\x1b\x1ac\xf9r\x930\x83\\:0\x8e\xecT\x97\xb1l\xb4\xa5\xfb\xc7$\xd0\xc945\x93\x90\x16f\xb5M\xe6\x99Yɑ\x98\xad\xb7\xb2\x17\xc0\xca^\x82\xde$\xe8ӎ\xec\x1d!\x8c\xe2~\xbc\xfe\x1d\xb6\xfeP\xda\xcd\"\xf3\x02[\xdf\xd5m8\xdd\x06\x14\x96\x1f\x9b\xa6bg\xe5Buׁ\x9f\xd3\xd1\xe1\xdfO\xd8;\xecvq\x9cl\xe4v='\xeb'\xfd\x81Q\xdb\xf9\x12\xc3\xe4\xdfl4\x8fB\xb1i\x14#\xc9\xc5\x13\xe0mV\b\xb8|\x1e\x9e\xe4\x12\xd9«\x0fO\x1f\xad\"UT\x98\x82\xddNj\xeb\xceKP\xa72.\xf1\xef]\xd7?\t\xf4\xf8\xab\xfc3ə\xa2\x12w\x88\vrB\xa6[\x0f(9\xec|tB鹒J\x13\xd7\xce\xfb%\x97vk?'\xc14\x01\xa6MA\xcdN2\xcda\x85\x8cd\xd3\xf7K8\xcdM:\xcd\x10\x85\xeeq\xb8?b\x9agL@u\x19Z.Id&\t5\x11\xa2M\xc4<:\x11\xf5\bt\xa6&\xa4\x1e \xf3LI\xa9V\xc5;wbjnrj\"\xc8\xe1\x99ֹ\x04\xd5D\xb0\xe3'[\xa3I\xaa\x89P\x13SY3\xa4\xeeQ\x1c\x96\xa6\x9c\xb8\xcfx\xec\xe3\xb8\xf4\u058c\x14\u05c9\x90\xc9)3\n\xd2?\xe7&\x94\x9f\xf2\x9aI\x8b\xde\xea=S\xea\xeb\xfb\xa5\xbf\x1e\x97\x02;\v\x92\xca\xfc4\xd8Y\xa0\xf1\xbbJ\x8fT\x82\x1291\xa9\xd9P\xeb\xf7\xb6\xe7\xcd\"\x91Y\xee\xa2 \x10=\xc2p54{x\xea\xbc!\x19\xba\xfa$\xe0P\x8f?YWO\x10\x89\xbf\x85\x11\a\xd7Q\xa4S\x0e.i\x92^\xddAE+\x15\x14\xf6\x81\xcbL(\xe8'\xa9\xe6Tp\xf7\f\xc2\n\x95t\xbb%>\f7pv\xad\x17\xa7\xab\xb3] }\xba\xd9`\xbe\xde\xf6\a\xd2k\\\x85S\f\xa71\x03\x16\x8a_\x13\xa6'\x04{pۄ\xe5\x7f)\x93\n\xb3\x82\fN\x1c\xaf\x17g\xd9eg.\xdd\xfa\xda\x04\xb7UA\xaeK\x92f\xae\xebi\x1d\x02;\x1e=\xe0\x1e\x87\xc2jp\x82\x17\x04][\x11i\x87U\xf6Or˥\xa7f\xca9\xac.\x89\xb2\xb3<\xcem%9\x99\xe8\x05YJ\xaf\x88T\xf4@\x02\x9fq<\xd7p\xf8\xf1h\x82\xfc\x03WW\xc0\xde\v\xa4!\xa2\x92\xebӧj1\v\xac3\xc0\x12U\xcfD\x8e\xcc\x12wG\b\xbe<\x11xHG\xc7ѧ\x90\xb1\x83ѧ\xa2g\xdd$\xd0h\x96\x88\x7f \xf2P6\\!G\xd0\xe7\x9e\xfdZ\xcb\xcc:.\xd2H\x83\x82\xa0\x18U\x81\xd3cp\xe9\xc7\x1f\x8aЧ\xac\xc3\xfb!\x8cwZ\x87\x8e\xca=\xfa%\xc2\x1cP\xb9\x1b\xd2\x1f\x82\xc8\t\xf70L\x12\xb8\x17\x0e\xd09\xa9\x8e\xc0\xe5\xd2\xdeĐLې\x1fγ\x9e\x8f@_\x9e\xceq\x8c\xbb>\x82\xc9I\xc7}2H4P\xb2f\\\xf8\x19p\x93\xed\xdc#\xd7FV\x00 \x03&\xea\a\vb\xa1\x80,\x88\xb3u)2\xf6\xb0\x0ebV\x00\xe1\x14\x86\xedޘ\xd7!5\xbc\x90\t\x14\xf5\xc2\x11\xc1\x9e\x9b\x83\x84\xa3\x84\xab{\x1c\x05OB\xc7D\x18\"\x13,\xea\x85-\xe2\x01\x89l\xb0\a\x01\x8c\xc3\xd0D6\xcc\xfcP\xc6\x19\b\x96\x13ވ\x90k*Б\t\u05cdg=\x17\xf2Ȇ\x1b\x8dC\xf8\xe0G6\xcc3\x96\xf6L\x1en^]\x8f\xfe'%\x80\x92\r4\xab*ȉ{ډ\xbc\x9e\xabйOz\xc8%7\xf8rT\x18&ӿ}:\x0e\x82 E:\n\x8e\vלH\xe5\x9e\\J\b\xe1d\x8c\xc7\x15EM\v\xe6d\x00>\b\xfb$\x84u2\xc0G\x8b\xa6\x0eeW\x06\xcc3\x14Q\x1d>g\b\n\x9d\xb0.2;\x80K\xf2f\x91͐\xe0\xa19L\xba\xb6&\xd9z\xf1\x0e\xab\xa2\xe1R\x1d1\xd0\a.\x95q~\xf7\xc2W#\xde\xf1$\xd8\xda\xe7g\xfd\xe66s\xdf\x1dat\x15\x00\xd2\xc3N\xe1\xe7qO$ѩS\xce+o\xc1\x83\x8b\xe9\xcaK \x93\xcby\x95\b\xd5\xde\x16\x03E_\n{\x94X\x10\xa8g\a\xe7\vR\xd90ko\xeb!\xff\x10\xcb]0\x03\xe7]\xb6\x0eA\x86\xf4\xe0\xcb)F\x10\xa0?\xbd\xf5`\xc2w\xafA\xbc\x06\x04\x1f\xfc\x9dw\xe9\xff\U000666fd\xa3=\xaf\xd3`\x02\x1f\r\f\xb7\xb4s\xae}\x0f? O\xb1صF\xec\xf9%\xf3\xfbW\xabj\xca\xee5\xbf\xa3\xef\x7f\x15\x85\f\xb9mf\xfa\xd0K\x02\xe1,\x14O:\xfbE&Ԯ@2\x94\x9f\xf6\xa5#,'\xd8\xe8a6\xcc ڨ\r\"H\x03\b\\\x82\xb9~\x11[7\xf9\x1a*#A\xe5\xabn\xb2)g\x04\xce\xca1\x9c\xdd\tq\xa2\x93\xe0g\x03#p\x82\xc3\xe5a\xe6\xa4M&\\\xe4û\xfa\x904\x853@\x880]\xc6K\x9f=b\x8b$0\xc1C\xf4\xe0@.\xb6\xfaF\xf7\xcc}~\xee\x80\xee\xf4g\xa59\x9b\xb2D?\xad\x7f\xcc9\xde_\x8b\r\xe0\xa44o\xd5MV\xa7\x01\x1b<\x1a\x18\xdd\xfe\x11\\\xb4\x95\t\x16!\\\x03\xc1\xb5VFk\x7fj\xcb\xf0\xc6\vN\x8e\x82\xf8gkS\xf5a7\x03%\b\xee[\x83\x8a\v\xae*}\xc1\x19T0\xcaƜs\x11X\xfe\xe2\fa}\x83W\x1b+f}v\xea\x1dg\x13\xe7o\x8d٪|\xfe\xd0VZ\xca.\xdei<y;\\#\x8e1,\x1e\x04y/u\xbd\x11\x14x\x98\xc74\xf6D\x88V\xaf\x1f\xd3\xd8\xedR\xc0\xec-T\xd9\x13\xe1j]\xe7ꢲ_T\xf6\x8b\xca~Q\xd9/*\xfbEe\xbf\xa8\xec\x17\x95\xfd\xa2\xb2_T\xf6_IeO\x1f\xf9J'=.\xce8ڬ\x94\xac\xb4I%\xbd\xddf3\xda22N\xed\x9d\xd4_\xc62\x19\x87\xfdG*\x8a\xf4\x8b\a\xa6\x9d\x87\xf0;\x83?\xd4\x02\x8b\xda-\\[0v\xdez\x99\x8fE\x9c\xb9p\x86\x1d\xee\xdd3aJ\u07b2\U000815df\xf8.\x1b\xaf\xc3\xfe#x\x9d\xa9\xebc\x8b\x1a\x02\x86\xa0\xfa\xa5\xad\xb7\xef\xc2V6K\x98\xb2^\xe1\xe59\x191,צ\xaf\xf1\xb7P\xa14\nxP\xa9\xea\xaa|\xcd\\\\ogO\xf1\x8eq(f#QI\x85NJ\xd3\xc9\x11\xe6~:\xa8\xe1\xaeOZ(\xc1\xdbME\xe4\x9es5'3\xc1*\x98-My$e\x13\x13\xbd\xe7һ\xfbe;:\x92,fӻG\xae7צs\x98\xeb\xdb\xcfΞ\x849\x7f\xf3w\xb2u\x93\xb8\xa5e.\xb8ii\xe7\x86\xee\x04\x90&\xce\xd40\xf3S\x1a\x92\xec\xba\xe4\xed<M\x84\x8frOo\x8e\xe6r~ٹrdX\xfd%\xb1\xf0KW\x9d\xc9]O\b<\x03\x87\x9dH\xf6\xf5\x9aVi\x86K6%)\x04Q\xe9\xf7lV\xb3G\xd3\xcf}\xbb\xe6`\xb3Y\xda\xcc\xeaڧ>\xa0\x17Z\x95\x05\x16\xa5\xb4œq\xd3|(7\xab練\bB\xf7\a\x84\xe8\x90\rer\xed\xad\x8f\xdd_5UӋ\xaew\xe5\xbf#\xbf\xbf\xf1?\xb8\xea߂\xeeޛ\xbc\xe6\xfb\xb2z}\xbeu\x9b\xa6\x7f\xf8\x93\x107\xc7.\x87\xa1,\xcd)\x81\xe4Ei\x1f\r\x03Yڡ\x15P7\t\xd1Mk\xbd8I\xa0\xfc\x16\xa24\xf9\xe8C\xfc\xc0C\xbc2\xd2\\\x15Bs4\xc2\x14y\xd7gRAN\xb3]x\x1e\xd5mv\x8a\x87\x94\x9a\x84\xea\xa8\b\xb2\x88\xd1j\x19^\x90\xd9[\x05k\xf4\xb3\x9e\x0f\xae\xd6\xe7@w\xaaWt\x98\x136\xddz\x80\xf9a\xe7~\xe0\xa0\x7fV \xd5\x04\xbaT6\xbaT6\xbaT6\xbaT6\xbaT6\xbaT6\xbaT6\xbaT6\xbaT6\xfa\xad+\x1bU|\xf7\xf8\xf8\xe9f\x91\xc8\x18\x9f\xf8.\xf1\xe6\xa9\xc5<\xb3\xc1\xadTc\xf7O\xe9\x1b\x7fv\x815\xaf\xfd\x80s\xceCs\x15\xbe3\x8f\xc1)']\xce\xc72\xf0\xf7\xf6n\xb4B\xf7\xf1\xaa\xfb\xf0\xf8\xa2\xc9\xf6\x0e\x01\xdf\x17\x9c\x03p\xbb\x1c\xfc\xd7\x0f}\xbd8q1\xd6\xf8\xf5\x877Ed2E~\xb2\x1d\x10\xedEɐ\xa4\xbf\x10\xed)\xdd\x00\xb8\xe5ܡfo\xf2\\K\bp\x83\x06\x04\x95i\x14\x16\x1b\\U\xdd\xdeh\xffF;\xc1_\xa6\xb7\xc5\x06.R\xa1j\x19\x96\xf0\a\xbe\xd9p\x01)=\xb0\x94 \xb6%\xd7\xfd\xeaԓ0\xa3\x95\xabџPM0\x83\n/\xc6\xf11E\bw\x15\ve\xea/\x7f>\x87=6\x7fq\x84%\xed\xfd\xdcF:$\xed\xbd\xbbk4$\xad\xbf3\xc3_\x16\x9bH\xdc\xd0\t\xa5\x11_C\xbcҀyqK\xc4\xf8\xed&!\xb6M.]\xbb\xaa\xe3\x93pO\xa2\xeb\x99\xe9\xd5\xf9\xf9\x9c\x93!\x99p\x9f\x87=\xad\x91\x14\xb8\x13C?\xfb\x04Xd\xe5\xb7\x15e\x1b8\xf2M\xa8\xb0\xf7c\xc2\xc5K\xda8\x91\xbczvw'y\xb2L\u0085#\x9e-\xf32\u058c\x90\x94\xe1\x10{\x8e<}\x97\xe6$H\xed]9\x8cE\xacC/\xa3\xff\xbas3N\xc2\xe4\x02]}w\x15x\"\xe7\xc6y\"\x83\xfc7{W\xb0\x1b\xb7\rD\xef\xfa\n\xa2\x17\xb7\x85wa\xf4R`o>\x16-\x10#-|\tr`\xb4t\"X+\xaa\xa26N\xff\xbe\x18rH\x8a\xacD\x0ew\xb5@\x11\b\xce%\xb64\xe2\f\x87\xe4\xf0\xf1q\xa6lW\x1dG\x1c\xe9\xa7#/\xd9\x00\x96\r`\xd9\x00\x96\r`\xd9\x00\x96\r`\xd9\x00\x96\r`\xd9\x00\x96\xef\x11`\x81\xa2\x83\x03\xf1\x1c\xba\xdc\a\x89\xfe\x17\xf8\u07bb\xa8E\x13\ue5ef\x9d\b\x1c\x05\xc8\xef\x98\xfe\xfa,9$\xe4~ \xc1\xc1\xd6\xdc\xe7\xac\x1f\x9a\x13\x1fҽj+O\x03\xb1=\xa8sf\x19\xb6P\x7f\r\xe6\xe9\xa6\xe6\x18\xee\x02\x97$)3䙘ViRY\x8aH\x92\x94\xe8\x9c\x14\xaf\x04\xec\x94\xe89\xac\x14G\x9d\x0eA-\x12K\xd2\xed̐N\xbc\x12FqJe1\xdbG\xfe\xdaPD\xd0\xd1W\a\xc2\xf2\x99I\x89\x16\x113\x1f\xbfg/\xb2m\xe5\x9b8B}5\xe8\x1b\xa9)\x84\x1aWXeCF\x1aj\xbd<\x9a\f\xe7XP\x12\xe3fu\xa0\x8e\x8c\xa7\x05\x01\xe1\xcel\x8e\x7f\x90v=W\x9aI\xfb\x9b\x993ma8\x8fL\xfaޘ\x14\xacK\x87\xaeM\xe7\xe6\f\xcb[\xb0r\x91\xb1C-*\x97\xfc̴\xce\x1c{l[\xb0\x02\x0f\xb4\xbaS\xee\xc3ޱ\x922\xcf\xfdb\x01\xbe\xa0p^Xg/)r\xae\x06߹k\x85R\xb6\x9a:<ᕹ\xa7\xcdr\xb5\xae\x91\x1c1\xa1\\S\xd3\xd4|B`\x97\xdeJ\x1b\xcfӿ\xfb\xfb\f\xe5\xb0%\x94\xcd̥O\xc4]Q4S\x18\x84\xdc-\xe4\x18\x17\x80\xb1\xe3\x85=#\xde/\xa4\xec\xb13\xa1v\xdcn-Q\xa8)#&e'\xf8y\xd4\xd3\xc1\x92\xa0N\xd2\xe4\xd0\xf7\xeb\xb1ҹ磮\x8a_\x0f'\x89P\x8b\xac\xe4\xf5\x00\x1c\x92\xcfQ<\xef\x1a\x10\xe760\xceu@N)\x94S\x00\xe6D\xa6\\\x15\xce)\at\xc8Q\xa1\xff\xb1=q\x91\xba+\xc3:\xb7\x00vցv.0l\t\xbc\x13\x99uE\x80\xa7\x04\xe2!\x8b\f\xf1\x96$\xc8C\x96\x89\xddC\x83y\xc8R\x1d\x1c\x94\x03z\xc8\x12#@\xa8\x04\xea)\x98\x9f/\xf49:<R\n\xf9PA\x9f\"؇\x18ܗ\xeb6\tr\xf2\xaa\x95o\xbd/\xe8\x9d`|\xaf\b\x01\xdd\x12\x04\xba\t\ft# \xe8\x06PP\x81w\x12\x1f,\xdc\xf3\xa65G\f\xe4I\xb6M\x9d\xf1\xd8\xc0\xf9އ\xefy\b\xe8\x9e\xf5bp(\x01\x00|\x02\xb6\xf2\t\xb9\xb6\x92\x80\x16\xc8\xf4\xe5z\x8d|\xbc\xc9ᵕ\xfc\x88\x00\x89\xb9.XP;\f`y\x819\rz\xd0\xef\x1ft3\xb7\xab\xb1g\xbe\xe0\x9e0\xa9\xdaH)\xddXɚq\xcfއ\xed\r\x9a\b\xd7\x01=\xb3\x02\xa8\a\xe6\xfb\xd5\xf5Q\x1aa\xee\x8f\xfa\xc9\xe8:\xed/\x17\\Z\x9b\x13Z\xe7\n\xeb\xfb^\x91/>\xcar&\xddW\xeb\x04ЦI\xb9\xa7\"e\xbdv\xee\x0e*\x1c\xf8\xf7\xa2ޣ\xaa\x8a<\xbf\xa0\xbaN\xb1;\xec\xd1F\xe9+\x9f\xfbj\x9d\x04\r;\xf6\xbb\x10\xf9\xc8v\xc7ޝ\by\x06\n\x16\x13\xa7X\xa1\x8d=\xea\xcb\a\x11\x9a\xc8mQ\xb2\x12m\xffN\x9c\x1e\x01\xd5\x18Hݳ\xbb\x9f\xef\xecc\x04\xb9\xcdxA]\x18r@U\xb4XS\x03\rZ\xf8\xb4C\x93e\x1erZ\xff\xefV\x1a%\xf8P\x7f\xf9\xad;\x8ao\x87\x8a\xe8l\x7f\xfaw&\x87\fnpK\xf6\xe9ܴ@\x19\x83{X\xe2[U2\xa0\xef-\xaa\x0e\x91\x82F[\xdcUq\x1c\xe9\xd4\xdb\xfbFĹ\x87i\x11\x80BMt\x82\x94{\x93\x85\xea\x9e)I\x1b\x1fȈ\xaby\a\xdb\"c5\\S\xd0\x04u\x1e\x17\xa7\xdd\x18WaYOz\xb7\x84\xef\xcdw\xcd\xc8_\x05\xab[yN϶\xb6\r\xda\xf8\x90\x9f\xed\xe9Y\xb39te\xcbگ\xf7\xb8\xd0 \x84\x97\x14i\x99\\\xf6\xd5<\xb3\xb6\xd0ѳv\x1d\xe5\xc0?\x8b?d\xad))t\xbb\x86\xef!j\xa6\x89560\xc5\xecx\t\x89̥\xfd\xe6\xa8y,\xd6\xe7\x8eE_#\xe4\xa1\x02W$\xe6)\xc8L\x8f\xe3ئ\r\xa2\t\x9c\a\xf6\xeb/\x0f_\x1eN\x0f\x8aj<\"\xad\x1c_\xfa\x94j\"\xfc\x9b\xa1\x95/\x91\xc0\xaf\xb5\x889\n\xb0\x83\xcav\x13}8>Ͽ?\xc1\x93\xbd\xf3\x10\xf27ȗE\x89\\)Y7\xfa\x80R\x1f1\x8d\xb9\n!\xf9(\x9d\xb0\xf6\x92\x8cHY\x84\xb2\xcb\xde\u061c\x84\x1a\xf9\xa9?T\x04\xbb\xffe\x9fƈ\x13k\xb0\x8a\xaf\r\xecj\xd9\x1b\x87y\xac\x86\xe3\xf0\xc5Qc\x99\xe4G>\x8a\x1d|\xbd\xbab\x82\xca\xd8)\x15g\xec\\\xb3g\xff8\x8aS\x0f\xc0\xf9\xfc\x1f\xad\x19\xaaB\x8bg\x94Z\xeeϯPNI\xbb\xa4N\xcf<\xe3>\xe1\xf8\x88\x1e\x8f\xee\xe3C\xb0\xe8%\x9a\fms^\xfdc\xf3b\x02\xd1\x1a\x1a\xfdSE\xf6\xe4d\xbf,i9k\xb9\xff\xfcRs\xe2\x8f\x13\x03B\x06U\xfeٚT\x8d|<k\xf3\xf0\x1aNJ\x91>\r\xbf`\xec\xb5\xe9\x8e\a\xf6\x83\xa9Xз灷\xf8\xdfZv\x06\xccR\a\xf6\xe1c\xc5p\xea\x7f\x16\x83jd\xa7\x0e\xec\xc3\xc7\xea\xdf\x01\x00~\x90\xa3{\xe7,\x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xacV\xc1\x8e\xe36\f\xbd\xfb+\x88\xeda/\x8d\x83E/\x85oE\xdaà\xed`0Y\xcce\xb1\aE\xa6\x13ueI\x15\xa9Lӯ/(\xd9\x13'vvӢq.\x96D\xf2\xf1\x91zf\xb5Z\xad*\x15\xcc\vF2\xde5\xa0\x82\xc1\xbf\x18\x9d\xbcQ\xfd\xe5G\xaa\x8d_\x1f?T_\x8ck\x1b\xd8$b\xdf?#\xf9\x145\xfe\x8c\x9dq\x86\x8dwU\x8f\xacZŪ\xa9\x00tD%\x8b\x1fM\x8fĪ\x0f\r\xb8dm\x05\xe0T\x8f\r\x10\xc6#Fbŉ\"\xfe\x99\x90\x98\xea#Z\x8c\xbe6\xbe\xa2\x80Z\xdc\xec\xa3O\xa1\x81\xf3F\xb1'\xd9\x03(x\xb6\xd9\xd56\xbbz.\xae\xf2\xae5Ŀ\xde:\xf1\x9b\x19N\x05\x9b\xa2\xb2ˀ\xf2\x012n\x9f\xac\x8a\x8bG*\x00\xd2>`\x03\x8f\xaaG\nJc[\x01Hj\u009d\xc0\\\r\x19\x1f?\x14w\xfa\x80}\xa6H\xde|@\xf7\xd3\xd3\xc3\xcb\x0fۋe\x80\x16IG\x13\x84\xc2E\xfc`\b\x14\f(\x80=(\xad\x91\bt\x8a\x11\x1dCA\t\xc6u>\xf6\xb9\x12o\xae\x01\xd4\xce'\x06> \xbcdb\x87\xcc\xea\xb7#!\xfa\x80\x91\xcdH\xf4`vn\x91\xc9\xea\x15\xd6\xf7\x92NI\x1fZ\xe9\r\xa4\x1ci\xa0\x04ہ\x01\xf0\x1d\xf0\xc1\x10D\f\x11\t\x1d_\xa3\x94\xc7w\xa0\x1c\xf8\xdd\x1f\xa8\xb9\x1ex \xa0\x83O\xb6\x05\xed\xdd\x11#CD\xed\xf7\xce\xfc\xfd曄\x10\tj\x15\x8f\xddp\xfe\x19\xc7\x18\x9d\xb2pT6\xe1\xf7\xa0\\\v\xbd:AD\x89\x02\xc9M\xfc\xe5#T\xc3\xef>b&\xb3\x81\x03s\xa0f\xbd\xde\x1b\x1e\xaf\x86\xf6}\x9f\x9c\xe1\xd3Z{\xc7\xd1\xec\x12\xfbH\xeb\x16\x8fh\xd7*\x98UF\xea$?\xaa\xfb\xf6\xbb8\xdc\x1dz\x7f\x01\x8dO\xd2H\xc4Ѹ\xfdd#w\xf9W\b\x97\x1e/\xedPLK^g^\x8d\xdb\xe7\n<\xff\xb2\xfd\bc\xe8\xcc\xfd\x85S\x18h>\x1bҙq\xe1Ǹ\x0ec\xb6\x83.\xfa>\xfbD\xd7\x06o\\i&m\r\xbak\xb6)\xedz\xc34\xb6\xaa\x94\xa6\x86\x8dr\xce3\xec\x10Rh\x15c[Ã\x83\x8d\xea\xd1n\x14\xe1\xffͷ\x10K+\xe1\xf1>ƧBv\xfe\x89\x97f i\xb21JՍ\xf2,\xdc\xddm@-\x05\x13\xce\xc4\xdatF\xe7\xe6\x87\xceGPK&\xf5]H\xb2ſ\xc42\xe8DAs\xa5\x1e\xbe\xbb\aͲX\xc8\x13\x0e\x8a\xf0z\xf1\nӓ\x9c\xb9\x8eoM\x87\xfa\xa4-\x16\x17E+\xf0\xdbP\xe4A\x97\xfay\xcc\x15<\xe2\xeb\xc2\xeaS\xf4\xa2\x9bY\xb5\x01\xee\xe8\x8dᛱ7\x8e\xbe\x95Y9\x95\xbfCS!\x9e\xc8\xef\xe0\bbrN\xae\xe9L\xff\xe4?\xd3\xe9\xd9\x19\xc3\xd8/\xa0Y\xc4\xf3\xe0:/\xca\xc9J\x02+.r\x85C\xb1\x878\x05ׂ\xc3۵\xbe\xa5Uw\x11Z\xfe\xf9;\xf9ߌE]L\xc4\xc5ث<',nHą\x8d\x1b\xf7k@\x99\xacU;\x8b\rpLs\xebb\xabbT\xa7\xab\xbd0\xb6\xday&\xaa\xbe^\xb0\x99\x81ܓ\xd7\x03\xba[\xb7\x01^\x15\xcd|N\"\xc3\xeet\xcbt#_.o\xedt\f\x18\x7fe\x86h@\xa4z\xc5f\x81\xb3\xbbHY\xac^\x19=\x16\xe7\x8a\x19!\xdb\xe9\xd9Q3.\xae\xc68y\xd5\xf7CX,\xf6l1\xc3l'\xe9\x11\xfb\xa8\xf6c\xc2g\xe9\x959,0\xb6\x8f\xd7c\xea\xbbw\x17\xf3f~\xd5\u07b5yv\xa6\x06>}\x96a\x92}\xc4vȐ\x1a\xf8\xf4\xb9\xfag\x00\x9b(6\x8c\x9e\v\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcXˎ\xdb:\x12\xdd\xfb+\n\xf7.\xbc\xb1e\x04\xb3\x19h3ht\x06\x811\xe9\xa4\xd1\xee\xe9,\x82,h\xb1d1\xa6X\x1a\x16\xe9\x1e\xe7\xeb\aEI~J\xfd\x18\x04\xb7e \x10\x1f\x87U\xa7x\x0e\x19M\xe6\xf3\xf9D5\xe6\t=\x1br9\xa8\xc6\xe0\x7f\x03:y\xe3l\xfbw\xce\f-v\x1f&[\xe3t\x0e\xb7\x91\x03\xd5\x0f\xc8\x14}\x81\x1f\xb14\xce\x04CnRcPZ\x05\x95O\x00\n\x8fJ\x1a\x1fM\x8d\x1cT\xdd\xe4ࢵ\x13\x00\xa7j\xccaG6\xd6\xc8N5\\Q\xb0T\xa4ќ\xedТ\xa7\xccЄ\x1b,\x04i\xe3)69\x1c;Z\b\x96>\x806\xa4\xa7\x84\xb6\xea\xd0>whi\x805\x1c\xfe\xf5\u00a0φC\x1a\xd8\xd8\xe8\x95\x1d\x8d,\x8da\xe36\xd1*?6j\x02\xc0\x055\x98\xc3\x17U#7\xaa@=\x01صĦ\x90破N|){\xef\x8d\v\xe8o%0\xd7%4\a\x8d\\x\xd3Ȑ>h\xe8\x17\x82\xc6\xd3\xceh\xf4i,\xc0O&w\xafB\x95C&|e\x17\xddBT\x0e\xf7\xe7\x8da/\x01r\xf0\xc6m&\xc7Q\xbb\x0f酋\n\xebTBy\xa3\x06\xdd\xcd\xfd\xf2\xe9o\xab\xb3f\x18\n\xf2\x92Y0\f\nzj\xe0\xb9B\x8f\xf0\x94\xca\b\x1c\xc8#w,\x1e@\xe1\x90'g\x87\xc6\xc6S\x83>\x98\xbe\xe2\xeds\xb2]OZ/\xe2\x9aJ\xe8\xed(вO\x91!T\xd8\xd7\x03u\x97-P\t\xa12\f\x1e\x1b\x8f\x8c.\x1c\xf7\xcf\xf1\x8fJP\x0eh\xfd\x13\x8b\x90\xc1\n\xbd\xc0\x00W\x14\xad\x86\x82\xdc\x0e}\x00\x8f\x05m\x9c\xf9u\xc0f\b\x94\x16\xb5*`\xb7ՎO\xaa\xbfS\x16v\xcaF\x9c\x81r\x1aj\xb5\a\x8f\xb2\nDw\x82\x97\x86p\x06w\xe4\x11\x8c+)\x87*\x84\x86\xf3\xc5bcB/ӂ\xea::\x13\xf6\x8b\x82\\\xf0f\x1d\x03y^hܡ]\xa8\xc6\xccS\xa4N\xf2\xe3\xac\xd6\x7f\xfaN\xc7<=\v\xedj\x93\xb4\xbf$\xb7\x17\b\x17\xa5\xb5uo\xa7\xb6y\x1dy5n\x93\xc8x\xf8\xe7\xea\x11\xfa\xa5\x13\xf7g\xa0\xd0\xd1|\x9c\xc8Gƅ\x1f\xe3J\xf4i\x1e\x94\x9eꄉN7d\\H/\x855\xe8.\xd9渮M\x902\xff'\"\a)M\x06\xb7\xca9\n\xb0F\x88\x8dV\x01u\x06K\a\xb7\xaaF{\xab\x18\x7f7\xdfB,υǷ1~j\xaa\xc7?A\xc9;\x92N:z\xcf\x1c)ϰNW\r\x16g\xf2\x10\x14S\x9aN\xb7%\xf5\xc6\xd1\xff\xa9^\xc5\xc3xG\xe9\x8e\xcbW\x9e\x82\\i6\x97\xadp\xe6\x8fcs_ l \xef۴\x92\xec˒\xfc\xc1B\xe7}\x9e]$\xd1w\t\x1b\xb4\x9a\xb3+\xc8\x11\xce\xe5Wx\xd4\xe8\x82Q6\x7f%\x92\xc3@\x89F6\xea\x16\xf7b?\n\x18\v\x8f\x01\x8cK5\xe8}2\xb9̔\xafP\xbbCPN\x18\b\x95\nP\x91\xd5-\xe21\x18yW\xad\x1e\xfa\xa4\xa7\f\x8d\x8d\x1bsin\xf2\xa8\x18*\x99X\x88S\xf5\xb6\xb5\xeb\x0e\xa0@^m\x10\x9eM\xa8f\xc0ҧ\xc2\xc1\xdc\x19\n5\x84\xb8\x16\xa3\x02m\xca\x12=\xba\x00\xaa((&1/K0a\xca \xd2c\f\xb36\xc8\x14\x19DƫL\x06\xc0\x0f\xb9\x9dq%\xbc\xf6\xf5D\x9d\xe2\xbd.\xa5\\E\xd4\xdab\x0e\xc1G\xbc\xea\x1e߳\xf2lq?\xd4|Q\xe9\xc7cm%\xb5\xae\xba\x81\x80ъ\xb3\x89me\x00w\x91\x93\xf7\xa8AD\x10\xff4\xba\x9f\xbd\xc5\xfdu.\xafJ\xa1;\xe0_\x0fy*\x97\x96>`\x8fm\xcd\x06\xfdo\x1b\xd7\xe8\x1d\x06L7CM\x05\xcbiS`\x13xA;\xf4;\x83ϋg\xf2[\xe36s)\xc1\xbc\x95\r/$\x14^\xfc\x99\xfe\x19\x8c\b\xe0\xf1\xebǯ9\xdch\r\x14*\xf4\xb2\x1d\xcah{Y\x9e\x9c\xfc\xb3t\xfb\x9bA4\xfa\x1f\xd3\xc9\x00\xd2k\xbcP\xaa\x95\xb2o\xe0FLҔ{\xb9Ť\xa0\x84\xa2U[\x15\xf2 \x87\x8a\b\xb9\xee\xaaٺ\xa9\x1e\x84mcZ\x13Y\x1cЌ\x1cM\xc6\xe3\xc5!+\xbf\xb9l\xa7\xf7\x98\x92I\xda\t\x03\x9b\xf5,\xb3e7L\x84S\xd1\xf3\xb0[\\y\xc3\x15&\f\xb8\xc5_+\xf3\x19`\xb6\xc9@A-\x1e\x83\xbdj~\xb3\xfa\xc7Y\xbdbvzJ\xaddPX\x8a\xfaP\x97\x94\xd9tʠ\x98c=T\xf2Ζ\x1d,o\xee\xc0\x93E\xb8y\xf8\x92ΰ\x9bo\xab\xe5\xc3\xeaf\x06\n>\x11m\xac\x18\x8cߙ\x02{\x8b\x05\xac\x95\xb1#\x88\x82\xf0\xe9\xf6\xfe\x1b\xf9\xad%\xa5\xfb0g@\x1eTwu\x82\xe5\xc7v\xa5_\xd1\xe3\xe5\xc8a\x17\x02X\xa6|\xa2\x8b\x8c:\xcdn%\x92\xfd_\xea\xacI\xbfŵ\xeeH\xe3\xd9\xde\x1dذ\xc3\xf1\xa2\x8b\xf5\xf0\x02\xf3N\xdb#\x9d\x1d\xfb#\xbd\x03̎\xe1\fq\xfb~\xaa^\xf2\f!\xf1=\xa6\xd1+?\x9f\xbcHz\xff_\xca~g\xf7\xd3\xfa\xd3\xe3\xc2\a&o\xceg8\x97\xf9a\x81\xc9\x1b\xf2\xe0\xa0B\xbc\x10\xef[\xee\xc1iZ\x97\xe7\xba\xf7\xa6\xe8\xe5\x14\xec0\xcf A\x92\xfdMw\xe1\xa6R\x8c\xafp>\xbc½\xcc\xec\xcb`M\x89\xc5\xdeb\x8b\aT^!\xbe\xf3\xf6>.\x939\xdc\xec\x94I>:\xd0\xf7o\xa7F{Gk?XΫF1:\xd4'\xde\xddm\xb2\xae\xe5X|Uȅ\x04\xf5\x97˯E\x7f\xfcq\xf6\xc1'\xbd\x16\xe4گ2\x9c\xc3\xf7\x1f\xf2\x1dG\xbeP\xe8\xee\xa6\xc19|\xff1\xf9\xdf\x00\x9f]\x95\x94(\x13\x00\x00"),
 }
 
 var CRDs = crds()
 
-func crds() []*apiextv1beta1.CustomResourceDefinition {
-	apiextinstall.Install(scheme.Scheme)
-	decode := scheme.Codecs.UniversalDeserializer().Decode
-	var objs []*apiextv1beta1.CustomResourceDefinition
+// crds returns the CRDs as unstructured objects, since the apiextensions.k8s.io/v1
+// types aren't available to decode them into.
+func crds() []*unstructured.Unstructured {
+	var objs []*unstructured.Unstructured
 	for _, crd := range rawCRDs {
 		gzr, err := gzip.NewReader(bytes.NewReader(crd))
 		if err != nil {
@@ -59,11 +60,16 @@ func crds() []*apiextv1beta1.CustomResourceDefinition {
 		}
 		gzr.Close()
 
-		obj, _, err := decode(bytes, nil, nil)
+		json, err := yaml.YAMLToJSON(bytes)
 		if err != nil {
 			panic(err)
 		}
-		objs = append(objs, obj.(*apiextv1beta1.CustomResourceDefinition))
+
+		obj := new(unstructured.Unstructured)
+		if err := obj.UnmarshalJSON(json); err != nil {
+			panic(err)
+		}
+		objs = append(objs, obj)
 	}
 	return objs
 }
@@ -0,0 +1,35 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package generated contains the clientset, listers, and informers that are
+// generated for Velero's v1 API, which external controllers can use to read,
+// write, and watch Velero's custom resources:
+//
+//   - github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned is the
+//     typed clientset, and its fake package is a clientset for tests.
+//   - github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions
+//     is the shared informer factory.
+//   - github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1 are the
+//     listers of the informers' caches.
+//
+// Together with the API types in
+// github.com/vmware-tanzu/velero/pkg/apis/velero/v1, these packages are a
+// supported library surface, versioned with Velero's semver release tags.
+// Within a major version, types, functions, and methods aren't removed or
+// changed incompatibly; new fields and resources may be added in minor
+// versions. The other packages of the repository are internal to Velero,
+// and may change in any release.
+package generated
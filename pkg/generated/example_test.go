@@ -0,0 +1,116 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generated_test
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	"github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+)
+
+func newBackup(name string, phase velerov1api.BackupPhase) *velerov1api.Backup {
+	return &velerov1api.Backup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: name},
+		Status:     velerov1api.BackupStatus{Phase: phase},
+	}
+}
+
+// This example creates a clientset for the cluster in a kubeconfig, and
+// lists the backups in the velero namespace.
+func Example_clientset() {
+	config, err := clientcmd.BuildConfigFromFlags("", "/path/to/kubeconfig")
+	if err != nil {
+		panic(err)
+	}
+
+	client, err := versioned.NewForConfig(config)
+	if err != nil {
+		panic(err)
+	}
+
+	backups, err := client.VeleroV1().Backups("velero").List(metav1.ListOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, backup := range backups.Items {
+		fmt.Println(backup.Name, backup.Status.Phase)
+	}
+}
+
+// This example lists the backups in the velero namespace with the fake
+// clientset, which can stand in for a clientset in tests.
+func Example_fakeClientset() {
+	client := fake.NewSimpleClientset(
+		newBackup("backup-1", velerov1api.BackupPhaseCompleted),
+		newBackup("backup-2", velerov1api.BackupPhaseFailed),
+	)
+
+	backups, err := client.VeleroV1().Backups("velero").List(metav1.ListOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, backup := range backups.Items {
+		fmt.Println(backup.Name, backup.Status.Phase)
+	}
+
+	// Output:
+	// backup-1 Completed
+	// backup-2 Failed
+}
+
+// This example watches the backups in the velero namespace with an
+// informer, and gets them from its cache with a lister.
+func Example_informers() {
+	client := fake.NewSimpleClientset(newBackup("backup-1", velerov1api.BackupPhaseCompleted))
+
+	factory := externalversions.NewSharedInformerFactoryWithOptions(client, 10*time.Minute, externalversions.WithNamespace("velero"))
+	informer := factory.Velero().V1().Backups()
+
+	added := make(chan string)
+	informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			added <- obj.(*velerov1api.Backup).Name
+		},
+	})
+
+	stop := make(chan struct{})
+	defer close(stop)
+	factory.Start(stop)
+	factory.WaitForCacheSync(stop)
+
+	fmt.Println("added", <-added)
+
+	backup, err := informer.Lister().Backups("velero").Get("backup-1")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(backup.Name, backup.Status.Phase)
+
+	// Output:
+	// added backup-1
+	// backup-1 Completed
+}
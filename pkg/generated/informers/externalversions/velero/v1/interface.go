@@ -26,8 +26,12 @@ import (
 type Interface interface {
 	// Backups returns a BackupInformer.
 	Backups() BackupInformer
+	// BackupQuotas returns a BackupQuotaInformer.
+	BackupQuotas() BackupQuotaInformer
 	// BackupStorageLocations returns a BackupStorageLocationInformer.
 	BackupStorageLocations() BackupStorageLocationInformer
+	// DeleteBackupApprovals returns a DeleteBackupApprovalInformer.
+	DeleteBackupApprovals() DeleteBackupApprovalInformer
 	// DeleteBackupRequests returns a DeleteBackupRequestInformer.
 	DeleteBackupRequests() DeleteBackupRequestInformer
 	// DownloadRequests returns a DownloadRequestInformer.
@@ -64,11 +68,21 @@ func (v *version) Backups() BackupInformer {
 	return &backupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BackupQuotas returns a BackupQuotaInformer.
+func (v *version) BackupQuotas() BackupQuotaInformer {
+	return &backupQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // BackupStorageLocations returns a BackupStorageLocationInformer.
 func (v *version) BackupStorageLocations() BackupStorageLocationInformer {
 	return &backupStorageLocationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DeleteBackupApprovals returns a DeleteBackupApprovalInformer.
+func (v *version) DeleteBackupApprovals() DeleteBackupApprovalInformer {
+	return &deleteBackupApprovalInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // DeleteBackupRequests returns a DeleteBackupRequestInformer.
 func (v *version) DeleteBackupRequests() DeleteBackupRequestInformer {
 	return &deleteBackupRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
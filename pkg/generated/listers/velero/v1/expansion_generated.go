@@ -26,6 +26,14 @@ type BackupListerExpansion interface{}
 // BackupNamespaceLister.
 type BackupNamespaceListerExpansion interface{}
 
+// BackupQuotaListerExpansion allows custom methods to be added to
+// BackupQuotaLister.
+type BackupQuotaListerExpansion interface{}
+
+// BackupQuotaNamespaceListerExpansion allows custom methods to be added to
+// BackupQuotaNamespaceLister.
+type BackupQuotaNamespaceListerExpansion interface{}
+
 // BackupStorageLocationListerExpansion allows custom methods to be added to
 // BackupStorageLocationLister.
 type BackupStorageLocationListerExpansion interface{}
@@ -34,6 +42,14 @@ type BackupStorageLocationListerExpansion interface{}
 // BackupStorageLocationNamespaceLister.
 type BackupStorageLocationNamespaceListerExpansion interface{}
 
+// DeleteBackupApprovalListerExpansion allows custom methods to be added to
+// DeleteBackupApprovalLister.
+type DeleteBackupApprovalListerExpansion interface{}
+
+// DeleteBackupApprovalNamespaceListerExpansion allows custom methods to be added to
+// DeleteBackupApprovalNamespaceLister.
+type DeleteBackupApprovalNamespaceListerExpansion interface{}
+
 // DeleteBackupRequestListerExpansion allows custom methods to be added to
 // DeleteBackupRequestLister.
 type DeleteBackupRequestListerExpansion interface{}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package identity signs and verifies the requesters of DeleteBackupRequests
+// and the approvers of DeleteBackupApprovals. The server's admission webhook
+// signs the user that it verified created one of them into its
+// velero.io/identity-verified annotation, with a key that only the server
+// has, so that the backup deletion controller can tell the annotations that
+// the webhook set from the ones that users set themselves.
+package identity
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// SigningKeySecret is the name of the secret, in the server's namespace,
+	// with the key that verified identities are signed with.
+	SigningKeySecret = "velero-identity-key"
+
+	signingKeySecretKey = "key"
+	signingKeySize      = 32
+)
+
+// Signer signs and verifies the identities that the admission webhook
+// verified.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner returns a signer that signs identities with key.
+func NewSigner(key []byte) (*Signer, error) {
+	if len(key) < signingKeySize {
+		return nil, errors.Errorf("identity signing key must be at least %d bytes", signingKeySize)
+	}
+
+	return &Signer{key: key}, nil
+}
+
+// SigningKey returns the key in the identity signing key secret, creating the
+// secret with a random key if it doesn't exist. Every replica of the server
+// signs identities with the same key, so that the identities that the
+// webhook of any of them verified are valid on the leader.
+func SigningKey(secrets corev1client.SecretInterface) ([]byte, error) {
+	secret, err := secrets.Get(SigningKeySecret, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		key := make([]byte, signingKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, errors.Wrap(err, "error generating identity signing key")
+		}
+
+		secret, err = secrets.Create(&corev1api.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: SigningKeySecret},
+			Data:       map[string][]byte{signingKeySecretKey: key},
+		})
+		if apierrors.IsAlreadyExists(err) {
+			// another replica created it first
+			secret, err = secrets.Get(SigningKeySecret, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting identity signing key secret %s", SigningKeySecret)
+	}
+
+	return secret.Data[signingKeySecretKey], nil
+}
+
+// Sign returns the value of the velero.io/identity-verified annotation of an
+// object of the given kind, in namespace, for the deletion of backupName,
+// whose requester or approver was verified to be user at verifiedAt.
+func (s *Signer) Sign(kind, namespace, backupName, user string, verifiedAt time.Time) string {
+	timestamp := strconv.FormatInt(verifiedAt.Unix(), 10)
+	return timestamp + "." + s.mac(kind, namespace, backupName, user, timestamp)
+}
+
+// Verify returns an error if value isn't an annotation that Sign returned for
+// the same kind, namespace, backup name and user, or if the identity was
+// verified maxAge or more before now. Objects are only signed when they're
+// created, so checking the age at an object's creation time keeps an
+// annotation that's copied onto a new object from being valid for longer
+// than the original.
+func (s *Signer) Verify(kind, namespace, backupName, user, value string, now time.Time, maxAge time.Duration) error {
+	parts := strings.SplitN(value, ".", 2)
+	if len(parts) != 2 {
+		return errors.New("identity wasn't verified by the server")
+	}
+
+	if !hmac.Equal([]byte(parts[1]), []byte(s.mac(kind, namespace, backupName, user, parts[0]))) {
+		return errors.New("identity wasn't verified by the server")
+	}
+
+	verifiedAt, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid identity verification time")
+	}
+	if now.Sub(time.Unix(verifiedAt, 0)) >= maxAge {
+		return errors.Errorf("identity verification expired at %s", time.Unix(verifiedAt, 0).Add(maxAge).UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (s *Signer) mac(kind, namespace, backupName, user, timestamp string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(strings.Join([]string{kind, namespace, backupName, user, timestamp}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
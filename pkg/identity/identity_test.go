@@ -0,0 +1,152 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestNewSigner(t *testing.T) {
+	_, err := NewSigner(testKey)
+	assert.NoError(t, err)
+
+	_, err = NewSigner(testKey[:16])
+	assert.EqualError(t, err, "identity signing key must be at least 32 bytes")
+}
+
+func TestSigningKey(t *testing.T) {
+	secrets := fake.NewSimpleClientset().CoreV1().Secrets("velero")
+
+	// the secret is created with a random key if it doesn't exist, and its
+	// key is used after that.
+	key, err := SigningKey(secrets)
+	require.NoError(t, err)
+	assert.Len(t, key, 32)
+
+	again, err := SigningKey(secrets)
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+}
+
+func TestSignerVerify(t *testing.T) {
+	signer, err := NewSigner(testKey)
+	require.NoError(t, err)
+
+	verifiedAt := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	value := signer.Sign("DeleteBackupApproval", "velero", "backup-1", "bob", verifiedAt)
+
+	otherSigner, err := NewSigner([]byte("fedcba9876543210fedcba9876543210"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		signer     *Signer
+		kind       string
+		backupName string
+		user       string
+		value      string
+		now        time.Time
+		wantErr    string
+	}{
+		{
+			name:       "signed value is valid",
+			kind:       "DeleteBackupApproval",
+			backupName: "backup-1",
+			user:       "bob",
+			value:      value,
+			now:        verifiedAt.Add(time.Hour),
+		},
+		{
+			name:       "value that isn't signed is invalid",
+			kind:       "DeleteBackupApproval",
+			backupName: "backup-1",
+			user:       "bob",
+			value:      "true",
+			now:        verifiedAt,
+			wantErr:    "identity wasn't verified by the server",
+		},
+		{
+			name:       "value signed for another user is invalid",
+			kind:       "DeleteBackupApproval",
+			backupName: "backup-1",
+			user:       "alice",
+			value:      value,
+			now:        verifiedAt,
+			wantErr:    "identity wasn't verified by the server",
+		},
+		{
+			name:       "value signed for another backup is invalid",
+			kind:       "DeleteBackupApproval",
+			backupName: "backup-2",
+			user:       "bob",
+			value:      value,
+			now:        verifiedAt,
+			wantErr:    "identity wasn't verified by the server",
+		},
+		{
+			name:       "value signed for another kind is invalid",
+			kind:       "DeleteBackupRequest",
+			backupName: "backup-1",
+			user:       "bob",
+			value:      value,
+			now:        verifiedAt,
+			wantErr:    "identity wasn't verified by the server",
+		},
+		{
+			name:       "value signed with another key is invalid",
+			signer:     otherSigner,
+			kind:       "DeleteBackupApproval",
+			backupName: "backup-1",
+			user:       "bob",
+			value:      value,
+			now:        verifiedAt,
+			wantErr:    "identity wasn't verified by the server",
+		},
+		{
+			name:       "expired value is invalid",
+			kind:       "DeleteBackupApproval",
+			backupName: "backup-1",
+			user:       "bob",
+			value:      value,
+			now:        verifiedAt.Add(24 * time.Hour),
+			wantErr:    "identity verification expired at 2020-01-02T12:00:00Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := signer
+			if tc.signer != nil {
+				s = tc.signer
+			}
+
+			err := s.Verify(tc.kind, "velero", tc.backupName, tc.user, tc.value, tc.now, 24*time.Hour)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
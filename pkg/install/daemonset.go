@@ -17,11 +17,14 @@ limitations under the License.
 package install
 
 import (
+	"fmt"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/restic"
 )
 
 func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
@@ -61,7 +64,7 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 						"name":      "restic",
 						"component": "velero",
 					},
-					Annotations: c.annotations,
+					Annotations: podAnnotations(c.annotations),
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: "velero",
@@ -89,6 +92,7 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 							Name:            "restic",
 							Image:           c.image,
 							ImagePullPolicy: pullPolicy,
+							Ports:           containerPorts(),
 							Command: []string{
 								"/velero",
 							},
@@ -175,7 +179,41 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 		}...)
 	}
 
+	addIdentity(&daemonSet.Spec.Template.Spec, c)
+
 	daemonSet.Spec.Template.Spec.Containers[0].Env = append(daemonSet.Spec.Template.Spec.Containers[0].Env, c.envVars...)
 
+	if !c.scratchSizeLimit.IsZero() {
+		daemonSet.Spec.Template.Spec.Volumes[1].EmptyDir.SizeLimit = &c.scratchSizeLimit
+	}
+
+	daemonSet.Spec.Template.Spec.Containers[0].Args = append(daemonSet.Spec.Template.Spec.Containers[0].Args, resticServerArgs(c.resticCommandOptions)...)
+	if c.resticConcurrentBackups > 1 {
+		daemonSet.Spec.Template.Spec.Containers[0].Args = append(daemonSet.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--concurrent-backups=%d", c.resticConcurrentBackups))
+	}
+
 	return daemonSet
 }
+
+// resticServerArgs returns the restic server flags that set the given
+// options.
+func resticServerArgs(options restic.CommandOptions) []string {
+	var args []string
+	if options.LimitUpload > 0 {
+		args = append(args, fmt.Sprintf("--limit-upload=%d", options.LimitUpload))
+	}
+	if options.LimitDownload > 0 {
+		args = append(args, fmt.Sprintf("--limit-download=%d", options.LimitDownload))
+	}
+	if options.Nice != 0 {
+		args = append(args, fmt.Sprintf("--nice=%d", options.Nice))
+	}
+	if options.IONiceClass != "" {
+		args = append(args, fmt.Sprintf("--ionice-class=%s", options.IONiceClass))
+	}
+	// the priority only applies to the best-effort class.
+	if options.IONiceClass == "best-effort" {
+		args = append(args, fmt.Sprintf("--ionice-level=%d", options.IONiceLevel))
+	}
+	return args
+}
@@ -21,6 +21,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/velero/pkg/restic"
 )
 
 func TestDaemonSet(t *testing.T) {
@@ -28,6 +31,8 @@ func TestDaemonSet(t *testing.T) {
 
 	assert.Equal(t, "restic", ds.Spec.Template.Spec.Containers[0].Name)
 	assert.Equal(t, "velero", ds.ObjectMeta.Namespace)
+	assert.Equal(t, "8085", ds.Spec.Template.ObjectMeta.Annotations["prometheus.io/port"])
+	assert.Equal(t, int32(8085), ds.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort)
 
 	ds = DaemonSet("velero", WithImage("gcr.io/heptio-images/velero:v0.11"))
 	assert.Equal(t, "gcr.io/heptio-images/velero:v0.11", ds.Spec.Template.Spec.Containers[0].Image)
@@ -36,4 +41,13 @@ func TestDaemonSet(t *testing.T) {
 	ds = DaemonSet("velero", WithSecret(true))
 	assert.Equal(t, 6, len(ds.Spec.Template.Spec.Containers[0].Env))
 	assert.Equal(t, 3, len(ds.Spec.Template.Spec.Volumes))
+
+	ds = DaemonSet("velero", WithScratchSizeLimit(resource.MustParse("5Gi")))
+	assert.Equal(t, "5Gi", ds.Spec.Template.Spec.Volumes[1].EmptyDir.SizeLimit.String())
+
+	ds = DaemonSet("velero", WithResticCommandOptions(restic.CommandOptions{LimitUpload: 1024, Nice: 10, IONiceClass: "best-effort", IONiceLevel: 7}))
+	assert.Equal(t, []string{"restic", "server", "--limit-upload=1024", "--nice=10", "--ionice-class=best-effort", "--ionice-level=7"}, ds.Spec.Template.Spec.Containers[0].Args)
+
+	ds = DaemonSet("velero", WithResticConcurrentBackups(4))
+	assert.Equal(t, []string{"restic", "server", "--concurrent-backups=4"}, ds.Spec.Template.Spec.Containers[0].Args)
 }
@@ -23,9 +23,12 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 )
 
 type podTemplateOption func(*podTemplateConfig)
@@ -34,11 +37,17 @@ type podTemplateConfig struct {
 	image                             string
 	envVars                           []corev1.EnvVar
 	restoreOnly                       bool
+	defaultVolumesToRestic            bool
 	annotations                       map[string]string
 	resources                         corev1.ResourceRequirements
 	withSecret                        bool
 	defaultResticMaintenanceFrequency time.Duration
 	plugins                           []string
+	scratchSizeLimit                  resource.Quantity
+	resticCommandOptions              restic.CommandOptions
+	resticConcurrentBackups           int
+	identityMode                      v1.CloudIdentityMode
+	identity                          string
 }
 
 func WithImage(image string) podTemplateOption {
@@ -82,6 +91,12 @@ func WithRestoreOnly() podTemplateOption {
 	}
 }
 
+func WithDefaultVolumesToRestic() podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.defaultVolumesToRestic = true
+	}
+}
+
 func WithResources(resources corev1.ResourceRequirements) podTemplateOption {
 	return func(c *podTemplateConfig) {
 		c.resources = resources
@@ -94,6 +109,24 @@ func WithDefaultResticMaintenanceFrequency(val time.Duration) podTemplateOption
 	}
 }
 
+func WithScratchSizeLimit(limit resource.Quantity) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.scratchSizeLimit = limit
+	}
+}
+
+func WithResticCommandOptions(options restic.CommandOptions) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.resticCommandOptions = options
+	}
+}
+
+func WithResticConcurrentBackups(concurrentBackups int) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.resticConcurrentBackups = concurrentBackups
+	}
+}
+
 func WithPlugins(plugins []string) podTemplateOption {
 	return func(c *podTemplateConfig) {
 		c.plugins = plugins
@@ -235,12 +268,18 @@ func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment
 		}...)
 	}
 
+	addIdentity(&deployment.Spec.Template.Spec, c)
+
 	deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, c.envVars...)
 
 	if c.restoreOnly {
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--restore-only")
 	}
 
+	if c.defaultVolumesToRestic {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--default-volumes-to-restic")
+	}
+
 	if c.defaultResticMaintenanceFrequency > 0 {
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--default-restic-prune-frequency=%v", c.defaultResticMaintenanceFrequency))
 	}
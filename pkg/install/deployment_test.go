@@ -32,6 +32,9 @@ func TestDeployment(t *testing.T) {
 	deploy = Deployment("velero", WithRestoreOnly())
 	assert.Equal(t, "--restore-only", deploy.Spec.Template.Spec.Containers[0].Args[1])
 
+	deploy = Deployment("velero", WithDefaultVolumesToRestic())
+	assert.Equal(t, "--default-volumes-to-restic", deploy.Spec.Template.Spec.Containers[0].Args[1])
+
 	deploy = Deployment("velero", WithEnvFromSecretKey("my-var", "my-secret", "my-key"))
 	envSecret := deploy.Spec.Template.Spec.Containers[0].Env[3]
 	assert.Equal(t, "my-var", envSecret.Name)
@@ -0,0 +1,145 @@
+/*
+Copyright 2018, 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// identityToken describes the service account token that's projected into
+// the Velero pods for a cloud identity mode that exchanges one for cloud
+// credentials.
+type identityToken struct {
+	volumeName        string
+	mountPath         string
+	path              string
+	audience          string
+	expirationSeconds int64
+}
+
+// file returns the path of the token file in the Velero containers.
+func (t *identityToken) file() string {
+	return t.mountPath + "/" + t.path
+}
+
+var identityTokens = map[v1.CloudIdentityMode]identityToken{
+	v1.CloudIdentityModeAWSIRSA: {
+		volumeName:        "aws-iam-token",
+		mountPath:         "/var/run/secrets/eks.amazonaws.com/serviceaccount",
+		path:              "token",
+		audience:          "sts.amazonaws.com",
+		expirationSeconds: 86400,
+	},
+	v1.CloudIdentityModeAzureWorkloadIdentity: {
+		volumeName:        "azure-identity-token",
+		mountPath:         "/var/run/secrets/azure/tokens",
+		path:              "azure-identity-token",
+		audience:          "api://AzureADTokenExchange",
+		expirationSeconds: 3600,
+	},
+}
+
+func WithIdentity(mode v1.CloudIdentityMode, identity string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.identityMode = mode
+		c.identity = identity
+	}
+}
+
+// identityAnnotations are the service account annotations that bind the
+// Velero service account to a cloud identity.
+var identityAnnotations = map[v1.CloudIdentityMode]string{
+	v1.CloudIdentityModeAWSIRSA:               "eks.amazonaws.com/role-arn",
+	v1.CloudIdentityModeGCPWorkloadIdentity:   "iam.gke.io/gcp-service-account",
+	v1.CloudIdentityModeAzureWorkloadIdentity: "azure.workload.identity/client-id",
+}
+
+// serviceAccountAnnotations returns the annotations of the Velero service
+// account: the one that binds it to a cloud identity, if the mode uses one,
+// merged with the user's annotations, which take precedence.
+func serviceAccountAnnotations(mode v1.CloudIdentityMode, identity string, userAnnotations map[string]string) map[string]string {
+	key, ok := identityAnnotations[mode]
+	if !ok {
+		return userAnnotations
+	}
+
+	res := map[string]string{key: identity}
+	for k, v := range userAnnotations {
+		res[k] = v
+	}
+	return res
+}
+
+// identityEnvVars returns the environment variables that the cloud SDKs in
+// Velero and its plugins read a cloud identity from. Plugins inherit the
+// Velero server's environment, so they don't need to be configured
+// separately.
+func identityEnvVars(mode v1.CloudIdentityMode, identity string) []corev1.EnvVar {
+	token := identityTokens[mode]
+
+	switch mode {
+	case v1.CloudIdentityModeAWSIRSA:
+		return []corev1.EnvVar{
+			{Name: "AWS_ROLE_ARN", Value: identity},
+			{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: token.file()},
+		}
+	case v1.CloudIdentityModeAzureWorkloadIdentity:
+		return []corev1.EnvVar{
+			{Name: "AZURE_CLIENT_ID", Value: identity},
+			{Name: "AZURE_FEDERATED_TOKEN_FILE", Value: token.file()},
+			{Name: "AZURE_AUTHORITY_HOST", Value: "https://login.microsoftonline.com/"},
+		}
+	default:
+		return nil
+	}
+}
+
+// addIdentity configures a pod to authenticate as the cloud identity of c,
+// by projecting a service account token into it and pointing its first
+// container at the token and identity.
+func addIdentity(spec *corev1.PodSpec, c *podTemplateConfig) {
+	token, ok := identityTokens[c.identityMode]
+	if ok {
+		expirationSeconds := token.expirationSeconds
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: token.volumeName,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{
+							ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+								Audience:          token.audience,
+								ExpirationSeconds: &expirationSeconds,
+								Path:              token.path,
+							},
+						},
+					},
+				},
+			},
+		})
+
+		spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      token.volumeName,
+			MountPath: token.mountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	spec.Containers[0].Env = append(spec.Containers[0].Env, identityEnvVars(c.identityMode, c.identity)...)
+}
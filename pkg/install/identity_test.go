@@ -0,0 +1,82 @@
+/*
+Copyright 2018, 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func envValue(container corev1.Container, name string) string {
+	for _, env := range container.Env {
+		if env.Name == name {
+			return env.Value
+		}
+	}
+	return ""
+}
+
+func TestDeploymentWithIdentity(t *testing.T) {
+	deploy := Deployment("velero", WithIdentity(v1.CloudIdentityModeAWSIRSA, "arn:aws:iam::123456789012:role/velero"))
+	spec := deploy.Spec.Template.Spec
+
+	require.Len(t, spec.Volumes, 3)
+	assert.Equal(t, "aws-iam-token", spec.Volumes[2].Name)
+	require.NotNil(t, spec.Volumes[2].Projected)
+	token := spec.Volumes[2].Projected.Sources[0].ServiceAccountToken
+	assert.Equal(t, "sts.amazonaws.com", token.Audience)
+	assert.Equal(t, "token", token.Path)
+
+	container := spec.Containers[0]
+	assert.Equal(t, corev1.VolumeMount{Name: "aws-iam-token", MountPath: "/var/run/secrets/eks.amazonaws.com/serviceaccount", ReadOnly: true}, container.VolumeMounts[2])
+	assert.Equal(t, "arn:aws:iam::123456789012:role/velero", envValue(container, "AWS_ROLE_ARN"))
+	assert.Equal(t, "/var/run/secrets/eks.amazonaws.com/serviceaccount/token", envValue(container, "AWS_WEB_IDENTITY_TOKEN_FILE"))
+
+	deploy = Deployment("velero", WithIdentity(v1.CloudIdentityModeAzureWorkloadIdentity, "client-id"))
+	container = deploy.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "client-id", envValue(container, "AZURE_CLIENT_ID"))
+	assert.Equal(t, "/var/run/secrets/azure/tokens/azure-identity-token", envValue(container, "AZURE_FEDERATED_TOKEN_FILE"))
+
+	// GKE's metadata server provides workload identity credentials, so
+	// nothing is mounted.
+	deploy = Deployment("velero", WithIdentity(v1.CloudIdentityModeGCPWorkloadIdentity, "velero@project.iam.gserviceaccount.com"))
+	assert.Len(t, deploy.Spec.Template.Spec.Volumes, 2)
+	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Env, 3)
+
+	ds := DaemonSet("velero", WithIdentity(v1.CloudIdentityModeAWSIRSA, "arn:aws:iam::123456789012:role/velero"))
+	assert.Equal(t, "arn:aws:iam::123456789012:role/velero", envValue(ds.Spec.Template.Spec.Containers[0], "AWS_ROLE_ARN"))
+}
+
+func TestServiceAccountAnnotations(t *testing.T) {
+	assert.Equal(t, map[string]string{"foo": "bar"}, serviceAccountAnnotations("", "", map[string]string{"foo": "bar"}))
+
+	assert.Equal(t,
+		map[string]string{"iam.gke.io/gcp-service-account": "velero@project.iam.gserviceaccount.com", "foo": "bar"},
+		serviceAccountAnnotations(v1.CloudIdentityModeGCPWorkloadIdentity, "velero@project.iam.gserviceaccount.com", map[string]string{"foo": "bar"}),
+	)
+
+	// the user's annotations take precedence.
+	assert.Equal(t,
+		map[string]string{"eks.amazonaws.com/role-arn": "other"},
+		serviceAccountAnnotations(v1.CloudIdentityModeAWSIRSA, "arn:aws:iam::123456789012:role/velero", map[string]string{"eks.amazonaws.com/role-arn": "other"}),
+	)
+}
@@ -21,6 +21,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1beta1 "k8s.io/api/rbac/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,6 +30,7 @@ import (
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	"github.com/vmware-tanzu/velero/pkg/generated/crds"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 )
 
 // Use "latest" if the build process didn't supply a version
@@ -137,7 +139,7 @@ func Namespace(namespace string) *corev1.Namespace {
 	}
 }
 
-func BackupStorageLocation(namespace, provider, bucket, prefix string, config map[string]string) *v1.BackupStorageLocation {
+func BackupStorageLocation(namespace, provider, bucket, prefix string, config map[string]string, identity *v1.CloudIdentity) *v1.BackupStorageLocation {
 	return &v1.BackupStorageLocation{
 		ObjectMeta: objectMeta(namespace, "default"),
 		TypeMeta: metav1.TypeMeta{
@@ -152,12 +154,13 @@ func BackupStorageLocation(namespace, provider, bucket, prefix string, config ma
 					Prefix: prefix,
 				},
 			},
-			Config: config,
+			Config:   config,
+			Identity: identity,
 		},
 	}
 }
 
-func VolumeSnapshotLocation(namespace, provider string, config map[string]string) *v1.VolumeSnapshotLocation {
+func VolumeSnapshotLocation(namespace, provider string, config map[string]string, identity *v1.CloudIdentity) *v1.VolumeSnapshotLocation {
 	return &v1.VolumeSnapshotLocation{
 		ObjectMeta: objectMeta(namespace, "default"),
 		TypeMeta: metav1.TypeMeta{
@@ -167,6 +170,7 @@ func VolumeSnapshotLocation(namespace, provider string, config map[string]string
 		Spec: v1.VolumeSnapshotLocationSpec{
 			Provider: provider,
 			Config:   config,
+			Identity: identity,
 		},
 	}
 }
@@ -211,11 +215,17 @@ type VeleroOptions struct {
 	SecretData                        []byte
 	RestoreOnly                       bool
 	UseRestic                         bool
+	DefaultVolumesToRestic            bool
 	UseVolumeSnapshots                bool
 	BSLConfig                         map[string]string
 	VSLConfig                         map[string]string
 	DefaultResticMaintenanceFrequency time.Duration
 	Plugins                           []string
+	ResticCacheSizeLimit              resource.Quantity
+	ResticCommandOptions              restic.CommandOptions
+	ResticConcurrentBackups           int
+	IdentityMode                      v1.CloudIdentityMode
+	Identity                          string
 }
 
 // AllResources returns a list of all resources necessary to install Velero, in the appropriate order, into a Kubernetes cluster.
@@ -226,8 +236,11 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 	resources.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Version: "v1", Kind: "List"})
 
 	for _, crd := range crds.CRDs {
+		crd := crd.DeepCopy()
 		crd.SetLabels(labels())
-		appendUnstructured(resources, crd)
+		// Remove the status field so we're not sending blank data to the server.
+		unstructured.RemoveNestedField(crd.Object, "status")
+		resources.Items = append(resources.Items, *crd)
 	}
 
 	ns := Namespace(o.Namespace)
@@ -236,7 +249,7 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 	crb := ClusterRoleBinding(o.Namespace)
 	appendUnstructured(resources, crb)
 
-	sa := ServiceAccount(o.Namespace, o.ServiceAccountAnnotations)
+	sa := ServiceAccount(o.Namespace, serviceAccountAnnotations(o.IdentityMode, o.Identity, o.ServiceAccountAnnotations))
 	appendUnstructured(resources, sa)
 
 	if o.SecretData != nil {
@@ -244,12 +257,17 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 		appendUnstructured(resources, sec)
 	}
 
-	bsl := BackupStorageLocation(o.Namespace, o.ProviderName, o.Bucket, o.Prefix, o.BSLConfig)
+	var identity *v1.CloudIdentity
+	if o.IdentityMode != "" {
+		identity = &v1.CloudIdentity{Mode: o.IdentityMode, Identity: o.Identity}
+	}
+
+	bsl := BackupStorageLocation(o.Namespace, o.ProviderName, o.Bucket, o.Prefix, o.BSLConfig, identity)
 	appendUnstructured(resources, bsl)
 
 	// A snapshot location may not be desirable for users relying on restic
 	if o.UseVolumeSnapshots {
-		vsl := VolumeSnapshotLocation(o.Namespace, o.ProviderName, o.VSLConfig)
+		vsl := VolumeSnapshotLocation(o.Namespace, o.ProviderName, o.VSLConfig, identity)
 		appendUnstructured(resources, vsl)
 	}
 
@@ -261,12 +279,17 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 		WithResources(o.VeleroPodResources),
 		WithSecret(secretPresent),
 		WithDefaultResticMaintenanceFrequency(o.DefaultResticMaintenanceFrequency),
+		WithIdentity(o.IdentityMode, o.Identity),
 	}
 
 	if o.RestoreOnly {
 		deployOpts = append(deployOpts, WithRestoreOnly())
 	}
 
+	if o.DefaultVolumesToRestic {
+		deployOpts = append(deployOpts, WithDefaultVolumesToRestic())
+	}
+
 	if len(o.Plugins) > 0 {
 		deployOpts = append(deployOpts, WithPlugins(o.Plugins))
 	}
@@ -281,6 +304,10 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 			WithImage(o.Image),
 			WithResources(o.ResticPodResources),
 			WithSecret(secretPresent),
+			WithScratchSizeLimit(o.ResticCacheSizeLimit),
+			WithResticCommandOptions(o.ResticCommandOptions),
+			WithResticConcurrentBackups(o.ResticConcurrentBackups),
+			WithIdentity(o.IdentityMode, o.Identity),
 		)
 		appendUnstructured(resources, ds)
 	}
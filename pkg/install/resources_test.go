@@ -20,17 +20,21 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/generated/crds"
 )
 
 func TestResources(t *testing.T) {
-	bsl := BackupStorageLocation("velero", "test", "test", "", make(map[string]string))
+	bsl := BackupStorageLocation("velero", "test", "test", "", make(map[string]string), nil)
 
 	assert.Equal(t, "velero", bsl.ObjectMeta.Namespace)
 	assert.Equal(t, "test", bsl.Spec.Provider)
 	assert.Equal(t, "test", bsl.Spec.StorageType.ObjectStorage.Bucket)
 	assert.Equal(t, make(map[string]string), bsl.Spec.Config)
 
-	vsl := VolumeSnapshotLocation("velero", "test", make(map[string]string))
+	vsl := VolumeSnapshotLocation("velero", "test", make(map[string]string), nil)
 
 	assert.Equal(t, "velero", vsl.ObjectMeta.Namespace)
 	assert.Equal(t, "test", vsl.Spec.Provider)
@@ -49,3 +53,47 @@ func TestResources(t *testing.T) {
 	assert.Equal(t, "velero", sa.ObjectMeta.Namespace)
 	assert.Equal(t, "cbd", sa.ObjectMeta.Annotations["abcd"])
 }
+
+func TestAllResourcesCRDs(t *testing.T) {
+	resources, err := AllResources(&VeleroOptions{Namespace: "velero"})
+	require.NoError(t, err)
+
+	var found int
+	for _, item := range resources.Items {
+		if item.GetKind() != "CustomResourceDefinition" {
+			continue
+		}
+		found++
+
+		assert.Equal(t, "apiextensions.k8s.io/v1", item.GetAPIVersion())
+		assert.Equal(t, labels(), item.GetLabels())
+
+		scope, _, _ := unstructured.NestedString(item.Object, "spec", "scope")
+		assert.Equal(t, "Namespaced", scope)
+
+		_, hasStatus := item.Object["status"]
+		assert.False(t, hasStatus, "CRD %s has a status", item.GetName())
+	}
+	assert.Equal(t, len(crds.CRDs), found)
+
+	// the embedded CRDs aren't modified.
+	for _, crd := range crds.CRDs {
+		assert.Empty(t, crd.GetLabels())
+	}
+}
+
+func TestCRDIsReady(t *testing.T) {
+	crd := func(conditions ...interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{"conditions": conditions},
+		}}
+	}
+	condition := func(conditionType, status string) interface{} {
+		return map[string]interface{}{"type": conditionType, "status": status}
+	}
+
+	assert.False(t, crdIsReady(&unstructured.Unstructured{Object: map[string]interface{}{}}))
+	assert.False(t, crdIsReady(crd(condition("Established", "True"))))
+	assert.False(t, crdIsReady(crd(condition("Established", "True"), condition("NamesAccepted", "False"))))
+	assert.True(t, crdIsReady(crd(condition("Established", "True"), condition("NamesAccepted", "True"))))
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	rbacv1beta1 "k8s.io/api/rbac/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/generated/crds"
+)
+
+// uninstallTimeout is how long Uninstall waits for the resources it deletes
+// to be gone.
+const uninstallTimeout = 2 * time.Minute
+
+// UninstallOptions are the options for uninstalling Velero from a cluster.
+type UninstallOptions struct {
+	// Namespace is the namespace that Velero is installed in.
+	Namespace string
+
+	// DeleteCustomResources deletes Velero's CustomResourceDefinitions even
+	// if there are custom resources of them, e.g. backups and schedules,
+	// which are deleted along with them.
+	DeleteCustomResources bool
+
+	// Wait waits for the deleted resources to be gone.
+	Wait bool
+}
+
+// uninstallResource is a resource that's deleted when uninstalling Velero.
+type uninstallResource struct {
+	gv         schema.GroupVersion
+	kind       string
+	namespaced bool
+	name       string
+}
+
+func (r uninstallResource) String() string {
+	return fmt.Sprintf("%s/%s", r.kind, r.name)
+}
+
+func (r uninstallResource) client(factory client.DynamicFactory, namespace string) (client.Dynamic, error) {
+	apiResource := metav1.APIResource{
+		Name:       kindToResource[r.kind],
+		Namespaced: r.namespaced,
+	}
+	if !r.namespaced {
+		namespace = ""
+	}
+
+	c, err := factory.ClientForGroupVersionResource(r.gv, apiResource, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error creating client for resource %s", r)
+	}
+	return c, nil
+}
+
+// Uninstall deletes Velero from the cluster: its Deployment, restic DaemonSet,
+// ClusterRoleBinding and CustomResourceDefinitions. Deleting a
+// CustomResourceDefinition deletes all of its custom resources, so
+// CustomResourceDefinitions that still have custom resources, e.g. backups,
+// are only deleted if o.DeleteCustomResources is true.
+// An io.Writer can be used to output to a log or the console.
+func Uninstall(factory client.DynamicFactory, o UninstallOptions, w io.Writer) error {
+	resources := []uninstallResource{
+		{gv: appsv1.SchemeGroupVersion, kind: "Deployment", namespaced: true, name: "velero"},
+		{gv: appsv1.SchemeGroupVersion, kind: "DaemonSet", namespaced: true, name: "restic"},
+		{gv: rbacv1beta1.SchemeGroupVersion, kind: "ClusterRoleBinding", name: "velero"},
+	}
+
+	var kept []string
+	for _, crd := range crds.CRDs {
+		if !o.DeleteCustomResources {
+			count, err := countCustomResources(factory, crd)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				fmt.Fprintf(w, "CustomResourceDefinition/%s: has %d custom resource(s), keeping it\n", crd.GetName(), count)
+				kept = append(kept, crd.GetName())
+				continue
+			}
+		}
+
+		resources = append(resources, uninstallResource{
+			gv:   schema.GroupVersion{Group: "apiextensions.k8s.io", Version: "v1"},
+			kind: "CustomResourceDefinition",
+			name: crd.GetName(),
+		})
+	}
+
+	var deleted []uninstallResource
+	for _, r := range resources {
+		found, err := deleteResource(factory, r, o.Namespace, w)
+		if err != nil {
+			return err
+		}
+		if found {
+			deleted = append(deleted, r)
+		}
+	}
+
+	if o.Wait && len(deleted) > 0 {
+		fmt.Fprint(w, "Waiting for resources to be deleted from the cluster...\n")
+		if err := resourcesAreDeleted(factory, deleted, o.Namespace); err != nil {
+			return err
+		}
+	}
+
+	if len(kept) > 0 {
+		fmt.Fprintf(w, "\nKept %d CustomResourceDefinition(s) that have custom resources: %s. Use --delete-custom-resources to delete them and their custom resources.\n",
+			len(kept), strings.Join(kept, ", "))
+	}
+
+	return nil
+}
+
+// countCustomResources returns the number of custom resources of a CRD, in all
+// namespaces. CRDs that aren't in the cluster don't have any.
+func countCustomResources(factory client.DynamicFactory, crd *unstructured.Unstructured) (int, error) {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+
+	var version string
+	if len(versions) > 0 {
+		if v, ok := versions[0].(map[string]interface{}); ok {
+			version, _ = v["name"].(string)
+		}
+	}
+
+	apiResource := metav1.APIResource{
+		Name:       plural,
+		Namespaced: true,
+	}
+	c, err := factory.ClientForGroupVersionResource(schema.GroupVersion{Group: group, Version: version}, apiResource, "")
+	if err != nil {
+		return 0, errors.Wrapf(err, "Error creating client for custom resources of %s", crd.GetName())
+	}
+
+	list, err := c.List(metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrapf(err, "Error listing custom resources of %s", crd.GetName())
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Error listing custom resources of %s", crd.GetName())
+	}
+	return len(items), nil
+}
+
+// deleteResource attempts to delete a resource from the cluster, returning
+// whether it was found. If the resource doesn't exist, it's merely logged.
+func deleteResource(factory client.DynamicFactory, r uninstallResource, namespace string, w io.Writer) (bool, error) {
+	log := func(f string, a ...interface{}) {
+		format := strings.Join([]string{r.String(), ": ", f, "\n"}, "")
+		fmt.Fprintf(w, format, a...)
+	}
+	log("attempting to delete resource")
+
+	c, err := r.client(factory, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	if err := c.Delete(r.name, &metav1.DeleteOptions{}); apierrors.IsNotFound(err) {
+		log("not found, proceeding")
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrapf(err, "Error deleting resource %s", r)
+	}
+
+	log("deleted")
+	return true, nil
+}
+
+// resourcesAreDeleted polls the API server until none of the resources are
+// found, or the uninstall timeout is reached.
+func resourcesAreDeleted(factory client.DynamicFactory, resources []uninstallResource, namespace string) error {
+	err := wait.PollImmediate(time.Second, uninstallTimeout, func() (bool, error) {
+		for _, r := range resources {
+			c, err := r.client(factory, namespace)
+			if err != nil {
+				return false, err
+			}
+
+			if _, err := c.Get(r.name, metav1.GetOptions{}); err == nil {
+				return false, nil
+			} else if !apierrors.IsNotFound(err) {
+				return false, errors.Wrapf(err, "error waiting for %s to be deleted", r)
+			}
+		}
+		return true, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.Errorf("timeout reached, resources not deleted")
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/generated/crds"
+)
+
+func newUninstallTestClient(t *testing.T) *dynamicfake.FakeDynamicClient {
+	t.Helper()
+
+	objs := []runtime.Object{
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"namespace": "velero", "name": "velero"},
+		}},
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "velero.io/v1",
+			"kind":       "Backup",
+			"metadata":   map[string]interface{}{"namespace": "velero", "name": "backup-1"},
+		}},
+	}
+	for _, crd := range crds.CRDs {
+		objs = append(objs, crd.DeepCopy())
+	}
+
+	return dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objs...)
+}
+
+func crdExists(t *testing.T, dynamicClient *dynamicfake.FakeDynamicClient, name string) bool {
+	t.Helper()
+
+	gvr := schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	_, err := dynamicClient.Resource(gvr).Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	require.NoError(t, err)
+	return true
+}
+
+func TestUninstall(t *testing.T) {
+	dynamicClient := newUninstallTestClient(t)
+
+	w := new(bytes.Buffer)
+	require.NoError(t, Uninstall(client.NewDynamicFactory(dynamicClient), UninstallOptions{Namespace: "velero", Wait: true}, w))
+
+	_, err := dynamicClient.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Namespace("velero").Get("velero", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// CRDs that have custom resources are kept, the others are deleted.
+	assert.True(t, crdExists(t, dynamicClient, "backups.velero.io"))
+	assert.False(t, crdExists(t, dynamicClient, "schedules.velero.io"))
+
+	assert.Contains(t, w.String(), "Deployment/velero: deleted\n")
+	assert.Contains(t, w.String(), "DaemonSet/restic: not found, proceeding\n")
+	assert.Contains(t, w.String(), "CustomResourceDefinition/backups.velero.io: has 1 custom resource(s), keeping it\n")
+}
+
+func TestUninstallDeleteCustomResources(t *testing.T) {
+	dynamicClient := newUninstallTestClient(t)
+
+	w := new(bytes.Buffer)
+	require.NoError(t, Uninstall(client.NewDynamicFactory(dynamicClient), UninstallOptions{Namespace: "velero", DeleteCustomResources: true}, w))
+
+	for _, crd := range crds.CRDs {
+		assert.False(t, crdExists(t, dynamicClient, crd.GetName()), crd.GetName())
+	}
+	assert.NotContains(t, w.String(), "keeping it")
+}
@@ -21,12 +21,21 @@ import (
 )
 
 var (
-	ClusterRoleBindings    = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}
-	ClusterRoles           = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}
-	Jobs                   = schema.GroupResource{Group: "batch", Resource: "jobs"}
-	Namespaces             = schema.GroupResource{Group: "", Resource: "namespaces"}
-	PersistentVolumeClaims = schema.GroupResource{Group: "", Resource: "persistentvolumeclaims"}
-	PersistentVolumes      = schema.GroupResource{Group: "", Resource: "persistentvolumes"}
-	Pods                   = schema.GroupResource{Group: "", Resource: "pods"}
-	ServiceAccounts        = schema.GroupResource{Group: "", Resource: "serviceaccounts"}
+	ClusterRoleBindings             = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}
+	ConfigMaps                      = schema.GroupResource{Group: "", Resource: "configmaps"}
+	ClusterRoles                    = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}
+	CustomResourceDefinitions       = schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}
+	HorizontalPodAutoscalers        = schema.GroupResource{Group: "autoscaling", Resource: "horizontalpodautoscalers"}
+	Jobs                            = schema.GroupResource{Group: "batch", Resource: "jobs"}
+	MutatingWebhookConfigurations   = schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "mutatingwebhookconfigurations"}
+	Namespaces                      = schema.GroupResource{Group: "", Resource: "namespaces"}
+	PersistentVolumeClaims          = schema.GroupResource{Group: "", Resource: "persistentvolumeclaims"}
+	PersistentVolumes               = schema.GroupResource{Group: "", Resource: "persistentvolumes"}
+	Pods                            = schema.GroupResource{Group: "", Resource: "pods"}
+	Secrets                         = schema.GroupResource{Group: "", Resource: "secrets"}
+	ServiceAccounts                 = schema.GroupResource{Group: "", Resource: "serviceaccounts"}
+	ValidatingWebhookConfigurations = schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "validatingwebhookconfigurations"}
+	VolumeSnapshotClasses           = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotclasses"}
+	VolumeSnapshotContents          = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotcontents"}
+	VolumeSnapshots                 = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshots"}
 )
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package leaderelection elects a leader among the replicas of the Velero
+// server with a coordination.k8s.io Lease, so that only one replica runs the
+// server's controllers at a time.
+package leaderelection
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// ErrLeaseLost is returned by Run when the elector's lease wasn't renewed
+// before its renew deadline, e.g. because the API server couldn't be reached
+// or another candidate took the lease over.
+var ErrLeaseLost = errors.New("leader election lost")
+
+// Config is the configuration of an Elector.
+type Config struct {
+	// Namespace and Name are the namespace and name of the lease.
+	Namespace string
+	Name      string
+
+	// Identity is the unique identity of the candidate in the lease's
+	// holderIdentity.
+	Identity string
+
+	// LeaseDuration is how long other candidates wait after the lease was
+	// last renewed before they take it over.
+	LeaseDuration time.Duration
+
+	// RenewDeadline is how long the leader keeps trying to renew the lease
+	// before it gives up leadership. It must be less than LeaseDuration.
+	RenewDeadline time.Duration
+
+	// RetryPeriod is how long candidates wait between tries to acquire or
+	// renew the lease. It must be less than RenewDeadline.
+	RetryPeriod time.Duration
+}
+
+// Elector runs a function while it holds a lease. It acquires and renews the
+// lease with client-go's leader election.
+type Elector struct {
+	config Config
+	lock   *resourcelock.LeaseLock
+	logger logrus.FieldLogger
+}
+
+// NewElector returns an Elector for the lease in config, or an error if the
+// config isn't valid.
+func NewElector(client coordinationv1client.LeasesGetter, config Config, logger logrus.FieldLogger) (*Elector, error) {
+	if config.Namespace == "" || config.Name == "" {
+		return nil, errors.New("lease namespace and name must be specified")
+	}
+	if config.Identity == "" {
+		return nil, errors.New("identity must be specified")
+	}
+	if config.RetryPeriod <= 0 {
+		return nil, errors.New("retry period must be positive")
+	}
+	if config.RenewDeadline <= time.Duration(leaderelection.JitterFactor*float64(config.RetryPeriod)) {
+		return nil, errors.Errorf("renew deadline must be greater than %v times the retry period", leaderelection.JitterFactor)
+	}
+	if config.LeaseDuration <= config.RenewDeadline {
+		return nil, errors.New("lease duration must be greater than the renew deadline")
+	}
+
+	return &Elector{
+		config: config,
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Namespace: config.Namespace,
+				Name:      config.Name,
+			},
+			Client: client,
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: config.Identity,
+			},
+		},
+		logger: logger.WithFields(logrus.Fields{
+			"lease":    config.Namespace + "/" + config.Name,
+			"identity": config.Identity,
+		}),
+	}, nil
+}
+
+// Run waits until the elector acquires the lease, then calls run with a
+// context that's canceled when ctx is done or the lease is lost, and renews
+// the lease until run returns.
+//
+// If the lease is lost, Run returns ErrLeaseLost right away, without waiting
+// for run to return, since another candidate may already be leading. The
+// caller should exit rather than keep doing work that requires leadership.
+// Otherwise, Run releases the lease after run returns, so that another
+// candidate can take over without waiting for it to expire, and returns run's
+// error. If ctx is done before the lease is acquired, Run returns nil.
+func (e *Elector) Run(ctx context.Context, run func(ctx context.Context) error) error {
+	// the lease is renewed until the election is stopped, which happens
+	// once run returns rather than when ctx is done, so that no other
+	// candidate starts leading while run is still shutting down.
+	electionCtx, stopElection := context.WithCancel(context.Background())
+	defer stopElection()
+
+	// runCtx is also canceled when Run returns, so that run is told to
+	// stop by the time Run returns ErrLeaseLost.
+	runCtx, cancelRun := context.WithCancel(ctx)
+	defer cancelRun()
+
+	var (
+		started = make(chan struct{})
+		ran     = make(chan struct{})
+		runErr  error
+	)
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            e.lock,
+		LeaseDuration:   e.config.LeaseDuration,
+		RenewDeadline:   e.config.RenewDeadline,
+		RetryPeriod:     e.config.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            e.config.Name,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				close(started)
+				defer stopElection()
+				defer close(ran)
+
+				if ctx.Err() != nil {
+					// the election was stopped while the lease was
+					// being acquired.
+					return
+				}
+				e.logger.Info("Acquired leader election lease")
+
+				// leaderCtx is canceled when the lease is lost.
+				go func() {
+					select {
+					case <-leaderCtx.Done():
+					case <-runCtx.Done():
+					}
+					cancelRun()
+				}()
+
+				runErr = run(runCtx)
+			},
+			OnStoppedLeading: func() {},
+		},
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			select {
+			case <-started:
+			default:
+				e.logger.Info("Stopped waiting to acquire leader election lease")
+				stopElection()
+			}
+		case <-electionCtx.Done():
+		}
+	}()
+
+	e.logger.Info("Waiting to acquire leader election lease")
+	elector.Run(electionCtx)
+
+	select {
+	case <-started:
+	default:
+		// ctx was done before the lease was acquired.
+		return nil
+	}
+
+	if electionCtx.Err() == nil {
+		// the election stopped by itself, because the lease couldn't be
+		// renewed.
+		e.logger.Error("Unable to renew leader election lease before the renew deadline")
+		return ErrLeaseLost
+	}
+
+	<-ran
+	return runErr
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newTestConfig(identity string) Config {
+	return Config{
+		Namespace:     "velero",
+		Name:          "velero",
+		Identity:      identity,
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+	}
+}
+
+func TestNewElector(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{
+			name:   "valid config",
+			modify: func(*Config) {},
+		},
+		{
+			name:    "missing identity",
+			modify:  func(c *Config) { c.Identity = "" },
+			wantErr: "identity must be specified",
+		},
+		{
+			name:    "missing lease name",
+			modify:  func(c *Config) { c.Name = "" },
+			wantErr: "lease namespace and name must be specified",
+		},
+		{
+			name:    "renew deadline not greater than the jittered retry period",
+			modify:  func(c *Config) { c.RenewDeadline = c.RetryPeriod * 6 / 5 },
+			wantErr: "renew deadline must be greater than 1.2 times the retry period",
+		},
+		{
+			name:    "lease duration not greater than renew deadline",
+			modify:  func(c *Config) { c.LeaseDuration = c.RenewDeadline },
+			wantErr: "lease duration must be greater than the renew deadline",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := newTestConfig("a")
+			tc.modify(&config)
+
+			_, err := NewElector(fake.NewSimpleClientset().CoordinationV1(), config, velerotest.NewLogger())
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newRunTestElector(t *testing.T, client *fake.Clientset, identity string) *Elector {
+	e, err := NewElector(client.CoordinationV1(), Config{
+		Namespace:     "velero",
+		Name:          "velero",
+		Identity:      identity,
+		LeaseDuration: time.Minute,
+		RenewDeadline: 200 * time.Millisecond,
+		RetryPeriod:   10 * time.Millisecond,
+	}, velerotest.NewLogger())
+	require.NoError(t, err)
+	return e
+}
+
+func TestRunReleasesLease(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	a, b := newRunTestElector(t, client, "a"), newRunTestElector(t, client, "b")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		result <- a.Run(ctx, func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return nil
+		})
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for run to be called")
+	}
+
+	cancel()
+	select {
+	case err := <-result:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Run to return")
+	}
+
+	lease, err := client.CoordinationV1().Leases("velero").Get("velero", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, lease.Spec.HolderIdentity)
+	assert.Empty(t, *lease.Spec.HolderIdentity)
+
+	// b doesn't have to wait for the released lease to expire.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go b.Run(ctx, func(ctx context.Context) error {
+		cancel()
+		return nil
+	})
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for b to acquire the lease")
+	}
+}
+
+func TestRunLosesLease(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	a := newRunTestElector(t, client, "a")
+
+	failUpdates := make(chan struct{})
+	client.PrependReactor("update", "leases", func(action core.Action) (bool, runtime.Object, error) {
+		select {
+		case <-failUpdates:
+			return true, nil, errors.New("unavailable")
+		default:
+			return false, nil, nil
+		}
+	})
+
+	leaderCtx := make(chan context.Context, 1)
+	result := make(chan error, 1)
+	go func() {
+		result <- a.Run(context.Background(), func(ctx context.Context) error {
+			leaderCtx <- ctx
+			<-ctx.Done()
+			return nil
+		})
+	}()
+
+	var ctx context.Context
+	select {
+	case ctx = <-leaderCtx:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for run to be called")
+	}
+
+	// the API server stops accepting updates, so a can't renew the lease.
+	close(failUpdates)
+
+	select {
+	case err := <-result:
+		assert.Equal(t, ErrLeaseLost, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Run to return")
+	}
+	assert.Error(t, ctx.Err())
+}
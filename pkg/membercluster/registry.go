@@ -0,0 +1,140 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package membercluster gets the member clusters that are registered with a
+// Velero server running in a management cluster, so that it can back them up.
+package membercluster
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// KubeconfigKey is the key of a member cluster's kubeconfig in the secret
+// that registers it.
+const KubeconfigKey = "kubeconfig"
+
+// AllClusters selects all of the registered member clusters.
+const AllClusters = "*"
+
+// Registry gets the member clusters that are registered with a management
+// cluster by secrets in the Velero namespace that are labeled with
+// velero.io/member-cluster.
+type Registry interface {
+	// List returns the names of the registered member clusters, sorted.
+	List() ([]string, error)
+
+	// RESTConfig returns the client config of a registered member cluster.
+	RESTConfig(name string) (*rest.Config, error)
+}
+
+type registry struct {
+	secrets   corev1client.SecretsGetter
+	namespace string
+}
+
+// NewRegistry returns a Registry of the member clusters that are registered
+// by the secrets in namespace.
+func NewRegistry(secrets corev1client.SecretsGetter, namespace string) Registry {
+	return &registry{
+		secrets:   secrets,
+		namespace: namespace,
+	}
+}
+
+func (r *registry) List() ([]string, error) {
+	list, err := r.secrets.Secrets(r.namespace).List(metav1.ListOptions{LabelSelector: velerov1api.MemberClusterLabel})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing member cluster secrets")
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, secret := range list.Items {
+		name := secret.Labels[velerov1api.MemberClusterLabel]
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func (r *registry) RESTConfig(name string) (*rest.Config, error) {
+	selector := labels.Set{velerov1api.MemberClusterLabel: name}.AsSelector().String()
+	list, err := r.secrets.Secrets(r.namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting secret of member cluster %s", name)
+	}
+
+	switch len(list.Items) {
+	case 0:
+		return nil, errors.Errorf("member cluster %s isn't registered: there's no secret labeled %s=%s in namespace %s", name, velerov1api.MemberClusterLabel, name, r.namespace)
+	case 1:
+	default:
+		return nil, errors.Errorf("member cluster %s is registered more than once: there are %d secrets labeled %s=%s in namespace %s", name, len(list.Items), velerov1api.MemberClusterLabel, name, r.namespace)
+	}
+
+	secret := list.Items[0]
+	kubeconfig, ok := secret.Data[KubeconfigKey]
+	if !ok {
+		return nil, errors.Errorf("secret %s of member cluster %s doesn't have a %s key", secret.Name, name, KubeconfigKey)
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing kubeconfig of member cluster %s", name)
+	}
+
+	return config, nil
+}
+
+// Resolve returns the member clusters that a schedule's clusters select,
+// expanding AllClusters to all of the registered member clusters. Duplicates
+// are removed.
+func Resolve(registry Registry, clusters []string) ([]string, error) {
+	var res []string
+	seen := make(map[string]bool)
+	for _, cluster := range clusters {
+		names := []string{cluster}
+		if cluster == AllClusters {
+			var err error
+			if names, err = registry.List(); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				res = append(res, name)
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membercluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: member
+  cluster:
+    server: https://member.example.com
+contexts:
+- name: member
+  context:
+    cluster: member
+    user: member
+current-context: member
+users:
+- name: member
+  user:
+    token: secret-token
+`
+
+func newClusterSecret(name, cluster, kubeconfig string) runtime.Object {
+	secret := builder.ForSecret("velero", name).ObjectMeta(builder.WithLabels(velerov1api.MemberClusterLabel, cluster)).Result()
+	if kubeconfig != "" {
+		secret.Data = map[string][]byte{KubeconfigKey: []byte(kubeconfig)}
+	}
+	return secret
+}
+
+func TestRegistryList(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newClusterSecret("cluster-b", "cluster-b", testKubeconfig),
+		newClusterSecret("cluster-a", "cluster-a", testKubeconfig),
+		newClusterSecret("cluster-a-copy", "cluster-a", testKubeconfig),
+		builder.ForSecret("velero", "cloud-credentials").Result(),
+		builder.ForSecret("other", "cluster-c").ObjectMeta(builder.WithLabels(velerov1api.MemberClusterLabel, "cluster-c")).Result(),
+	)
+
+	clusters, err := NewRegistry(client.CoreV1(), "velero").List()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster-a", "cluster-b"}, clusters)
+}
+
+func TestRegistryRESTConfig(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newClusterSecret("cluster-1", "cluster-1", testKubeconfig),
+		newClusterSecret("cluster-2", "cluster-2", ""),
+		newClusterSecret("cluster-3", "cluster-3", testKubeconfig),
+		newClusterSecret("cluster-3-copy", "cluster-3", testKubeconfig),
+	)
+	registry := NewRegistry(client.CoreV1(), "velero")
+
+	config, err := registry.RESTConfig("cluster-1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://member.example.com", config.Host)
+	assert.Equal(t, "secret-token", config.BearerToken)
+
+	_, err = registry.RESTConfig("cluster-2")
+	assert.EqualError(t, err, "secret cluster-2 of member cluster cluster-2 doesn't have a kubeconfig key")
+
+	_, err = registry.RESTConfig("cluster-3")
+	assert.EqualError(t, err, "member cluster cluster-3 is registered more than once: there are 2 secrets labeled velero.io/member-cluster=cluster-3 in namespace velero")
+
+	_, err = registry.RESTConfig("cluster-4")
+	assert.EqualError(t, err, "member cluster cluster-4 isn't registered: there's no secret labeled velero.io/member-cluster=cluster-4 in namespace velero")
+}
+
+func TestResolve(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newClusterSecret("cluster-1", "cluster-1", testKubeconfig),
+		newClusterSecret("cluster-2", "cluster-2", testKubeconfig),
+	)
+	registry := NewRegistry(client.CoreV1(), "velero")
+
+	clusters, err := Resolve(registry, []string{"cluster-3", AllClusters, "cluster-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster-3", "cluster-1", "cluster-2"}, clusters)
+}
@@ -28,30 +28,51 @@ type ServerMetrics struct {
 }
 
 const (
-	metricNamespace               = "velero"
-	backupTarballSizeBytesGauge   = "backup_tarball_size_bytes"
-	backupTotal                   = "backup_total"
-	backupAttemptTotal            = "backup_attempt_total"
-	backupSuccessTotal            = "backup_success_total"
-	backupPartialFailureTotal     = "backup_partial_failure_total"
-	backupFailureTotal            = "backup_failure_total"
-	backupDurationSeconds         = "backup_duration_seconds"
-	backupDeletionAttemptTotal    = "backup_deletion_attempt_total"
-	backupDeletionSuccessTotal    = "backup_deletion_success_total"
-	backupDeletionFailureTotal    = "backup_deletion_failure_total"
-	backupLastSuccessfulTimestamp = "backup_last_successful_timestamp"
-	restoreTotal                  = "restore_total"
-	restoreAttemptTotal           = "restore_attempt_total"
-	restoreValidationFailedTotal  = "restore_validation_failed_total"
-	restoreSuccessTotal           = "restore_success_total"
-	restorePartialFailureTotal    = "restore_partial_failure_total"
-	restoreFailedTotal            = "restore_failed_total"
-	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
-	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
-	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
+	metricNamespace                = "velero"
+	backupTarballSizeBytesGauge    = "backup_tarball_size_bytes"
+	backupTotal                    = "backup_total"
+	backupAttemptTotal             = "backup_attempt_total"
+	backupSuccessTotal             = "backup_success_total"
+	backupPartialFailureTotal      = "backup_partial_failure_total"
+	backupFailureTotal             = "backup_failure_total"
+	backupDurationSeconds          = "backup_duration_seconds"
+	backupDeletionAttemptTotal     = "backup_deletion_attempt_total"
+	backupDeletionSuccessTotal     = "backup_deletion_success_total"
+	backupDeletionFailureTotal     = "backup_deletion_failure_total"
+	backupLastSuccessfulTimestamp  = "backup_last_successful_timestamp"
+	backupItemDurationSeconds      = "backup_item_duration_seconds_total"
+	backupItemActionSeconds        = "backup_item_action_duration_seconds_total"
+	restoreTotal                   = "restore_total"
+	restoreAttemptTotal            = "restore_attempt_total"
+	restoreValidationFailedTotal   = "restore_validation_failed_total"
+	restoreSuccessTotal            = "restore_success_total"
+	restorePartialFailureTotal     = "restore_partial_failure_total"
+	restoreFailedTotal             = "restore_failed_total"
+	volumeSnapshotAttemptTotal     = "volume_snapshot_attempt_total"
+	volumeSnapshotSuccessTotal     = "volume_snapshot_success_total"
+	volumeSnapshotFailureTotal     = "volume_snapshot_failure_total"
+	backupVerificationSuccessTotal = "backup_verification_success_total"
+	backupVerificationFailureTotal = "backup_verification_failure_total"
+	backupSpecDriftTotal           = "backup_spec_drift_total"
+	discoveryRefreshTotal          = "discovery_refresh_total"
+	discoveryRefreshFailureTotal   = "discovery_refresh_failure_total"
+	discoveryRefreshSkippedTotal   = "discovery_refresh_skipped_total"
+	discoveryRefreshSeconds        = "discovery_refresh_duration_seconds"
+
+	podVolumeBackupSuccessTotal       = "pod_volume_backup_success_total"
+	podVolumeBackupFailureTotal       = "pod_volume_backup_failure_total"
+	podVolumeBackupDurationSeconds    = "pod_volume_backup_duration_seconds"
+	podVolumeBackupUploadedBytesTotal = "pod_volume_backup_uploaded_bytes_total"
+	podVolumeBackupDedupeRatio        = "pod_volume_backup_dedupe_ratio"
 
 	scheduleLabel   = "schedule"
 	backupNameLabel = "backupName"
+	resourceLabel   = "resource"
+	actionLabel     = "action"
+	nodeLabel       = "node"
+	namespaceLabel  = "namespace"
+	pvcLabel        = "pvc"
+	reasonLabel     = "reason"
 
 	secondsInMinute = 60.0
 )
@@ -158,6 +179,22 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupItemDurationSeconds: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupItemDurationSeconds,
+					Help:      "Total time spent backing up items, including executing their hooks and backup item actions, in seconds",
+				},
+				[]string{resourceLabel},
+			),
+			backupItemActionSeconds: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupItemActionSeconds,
+					Help:      "Total time spent executing backup item actions, in seconds",
+				},
+				[]string{actionLabel},
+			),
 			restoreTotal: prometheus.NewGauge(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -229,6 +266,125 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupVerificationSuccessTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupVerificationSuccessTotal,
+					Help:      "Total number of successful backup verifications",
+				},
+				[]string{scheduleLabel},
+			),
+			backupVerificationFailureTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupVerificationFailureTotal,
+					Help:      "Total number of failed backup verifications",
+				},
+				[]string{scheduleLabel},
+			),
+			backupSpecDriftTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupSpecDriftTotal,
+					Help:      "Total number of backups whose spec was found to no longer match the spec in their backup storage location",
+				},
+				[]string{scheduleLabel},
+			),
+			discoveryRefreshTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      discoveryRefreshTotal,
+					Help:      "Total number of refreshes of the cluster's resources from the discovery API, by why they were refreshed",
+				},
+				[]string{reasonLabel},
+			),
+			discoveryRefreshFailureTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      discoveryRefreshFailureTotal,
+					Help:      "Total number of failed refreshes of the cluster's resources from the discovery API, by why they were refreshed",
+				},
+				[]string{reasonLabel},
+			),
+			discoveryRefreshSkippedTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      discoveryRefreshSkippedTotal,
+					Help:      "Total number of refreshes of the cluster's resources that were skipped because the cached resources were used, by why they were used",
+				},
+				[]string{reasonLabel},
+			),
+			discoveryRefreshSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      discoveryRefreshSeconds,
+					Help:      "Time taken to refresh the cluster's resources from the discovery API, in seconds",
+					Buckets:   []float64{0.1, 0.5, 1, 2, 5, 10, 30, 60, 120},
+				},
+				[]string{reasonLabel},
+			),
+		},
+	}
+}
+
+// NewResticServerMetrics returns new ServerMetrics for the restic server.
+// Pod volume backup metrics are labeled by the node that the restic server
+// runs on, and by the namespace and PVC of the backed up volume. The PVC is
+// empty for volumes that aren't PVCs.
+func NewResticServerMetrics() *ServerMetrics {
+	return &ServerMetrics{
+		metrics: map[string]prometheus.Collector{
+			podVolumeBackupSuccessTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      podVolumeBackupSuccessTotal,
+					Help:      "Total number of successful pod volume backups",
+				},
+				[]string{nodeLabel, namespaceLabel, pvcLabel},
+			),
+			podVolumeBackupFailureTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      podVolumeBackupFailureTotal,
+					Help:      "Total number of failed pod volume backups",
+				},
+				[]string{nodeLabel, namespaceLabel, pvcLabel},
+			),
+			podVolumeBackupDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      podVolumeBackupDurationSeconds,
+					Help:      "Time taken to complete pod volume backup, in seconds",
+					Buckets: []float64{
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(10 * time.Minute),
+						toSeconds(15 * time.Minute),
+						toSeconds(30 * time.Minute),
+						toSeconds(1 * time.Hour),
+						toSeconds(2 * time.Hour),
+						toSeconds(3 * time.Hour),
+						toSeconds(4 * time.Hour),
+					},
+				},
+				[]string{nodeLabel, namespaceLabel, pvcLabel},
+			),
+			podVolumeBackupUploadedBytesTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      podVolumeBackupUploadedBytesTotal,
+					Help:      "Total number of bytes that pod volume backups added to their restic repositories",
+				},
+				[]string{nodeLabel, namespaceLabel, pvcLabel},
+			),
+			podVolumeBackupDedupeRatio: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      podVolumeBackupDedupeRatio,
+					Help:      "Fraction, from 0 to 1, of the bytes read by the most recent successful pod volume backup that were already in its restic repository",
+				},
+				[]string{nodeLabel, namespaceLabel, pvcLabel},
+			),
 		},
 	}
 }
@@ -243,49 +399,58 @@ func (m *ServerMetrics) RegisterAllMetrics() {
 // InitSchedule initializes counter metrics of a schedule.
 func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupPartialFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupDeletionAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupDeletionSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupDeletionFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restoreAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restorePartialFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restoreFailedTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restoreSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restoreValidationFailedTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[volumeSnapshotSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[volumeSnapshotAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[volumeSnapshotFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Set(0)
+		c.WithLabelValues(scheduleName).Add(0)
+	}
+	if c, ok := m.metrics[backupVerificationSuccessTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
+	if c, ok := m.metrics[backupVerificationFailureTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
+	if c, ok := m.metrics[backupSpecDriftTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
 	}
 }
 
@@ -346,6 +511,22 @@ func (m *ServerMetrics) RegisterBackupDuration(backupSchedule string, seconds fl
 	}
 }
 
+// RegisterBackupItemDuration records the number of seconds spent backing up items
+// of a resource.
+func (m *ServerMetrics) RegisterBackupItemDuration(resource string, seconds float64) {
+	if c, ok := m.metrics[backupItemDurationSeconds].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(resource).Add(seconds)
+	}
+}
+
+// RegisterBackupItemActionDuration records the number of seconds spent executing a
+// backup item action.
+func (m *ServerMetrics) RegisterBackupItemActionDuration(action string, seconds float64) {
+	if c, ok := m.metrics[backupItemActionSeconds].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(action).Add(seconds)
+	}
+}
+
 // RegisterBackupDeletionAttempt records the number of attempted backup deletions
 func (m *ServerMetrics) RegisterBackupDeletionAttempt(backupSchedule string) {
 	if c, ok := m.metrics[backupDeletionAttemptTotal].(*prometheus.CounterVec); ok {
@@ -435,3 +616,77 @@ func (m *ServerMetrics) RegisterVolumeSnapshotFailures(backupSchedule string, vo
 		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsFailed))
 	}
 }
+
+// RegisterBackupVerificationSuccess records a backup that passed verification.
+func (m *ServerMetrics) RegisterBackupVerificationSuccess(backupSchedule string) {
+	if c, ok := m.metrics[backupVerificationSuccessTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule).Inc()
+	}
+}
+
+// RegisterBackupVerificationFailure records a backup that failed verification.
+func (m *ServerMetrics) RegisterBackupVerificationFailure(backupSchedule string) {
+	if c, ok := m.metrics[backupVerificationFailureTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule).Inc()
+	}
+}
+
+// RegisterBackupSpecDrift records a backup whose spec was found to no longer
+// match the spec in its backup storage location.
+func (m *ServerMetrics) RegisterBackupSpecDrift(backupSchedule string) {
+	if c, ok := m.metrics[backupSpecDriftTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule).Inc()
+	}
+}
+
+// RegisterDiscoveryRefresh records a refresh of the cluster's resources from
+// the discovery API, why it was refreshed, and the number of seconds it took.
+func (m *ServerMetrics) RegisterDiscoveryRefresh(reason string, seconds float64) {
+	if c, ok := m.metrics[discoveryRefreshTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(reason).Inc()
+	}
+	if h, ok := m.metrics[discoveryRefreshSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(reason).Observe(seconds)
+	}
+}
+
+// RegisterDiscoveryRefreshFailure records a failed refresh of the cluster's
+// resources from the discovery API.
+func (m *ServerMetrics) RegisterDiscoveryRefreshFailure(reason string) {
+	if c, ok := m.metrics[discoveryRefreshFailureTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(reason).Inc()
+	}
+}
+
+// RegisterDiscoveryRefreshSkipped records a refresh of the cluster's resources
+// that was skipped in favor of the cached ones.
+func (m *ServerMetrics) RegisterDiscoveryRefreshSkipped(reason string) {
+	if c, ok := m.metrics[discoveryRefreshSkippedTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(reason).Inc()
+	}
+}
+
+// RegisterPodVolumeBackupSuccess records a successful pod volume backup, the
+// number of seconds it took, and the number of bytes that it read and that it
+// added to its restic repository.
+func (m *ServerMetrics) RegisterPodVolumeBackupSuccess(node, namespace, pvc string, seconds float64, bytesRead, bytesAdded int64) {
+	if c, ok := m.metrics[podVolumeBackupSuccessTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(node, namespace, pvc).Inc()
+	}
+	if h, ok := m.metrics[podVolumeBackupDurationSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(node, namespace, pvc).Observe(seconds)
+	}
+	if c, ok := m.metrics[podVolumeBackupUploadedBytesTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(node, namespace, pvc).Add(float64(bytesAdded))
+	}
+	if g, ok := m.metrics[podVolumeBackupDedupeRatio].(*prometheus.GaugeVec); ok && bytesRead > 0 && bytesAdded <= bytesRead {
+		g.WithLabelValues(node, namespace, pvc).Set(1 - float64(bytesAdded)/float64(bytesRead))
+	}
+}
+
+// RegisterPodVolumeBackupFailure records a failed pod volume backup.
+func (m *ServerMetrics) RegisterPodVolumeBackupFailure(node, namespace, pvc string) {
+	if c, ok := m.metrics[podVolumeBackupFailureTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(node, namespace, pvc).Inc()
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+)
+
+// CopyBackupContents writes a backup's archive to w. If the archive is split
+// into a main archive and per-namespace sub-archives, only the sub-archives of
+// the namespaces for which includeNamespace returns true are downloaded, and
+// they're merged with the main archive into one archive. A nil includeNamespace
+// includes all namespaces.
+func CopyBackupContents(backupStore BackupStore, name string, includeNamespace func(namespace string) bool, w io.Writer) error {
+	index, err := backupStore.GetBackupIndex(name)
+	if err != nil {
+		return errors.Wrap(err, "error downloading backup index")
+	}
+
+	contents, err := backupStore.GetBackupContents(name)
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	// backups whose archives aren't split are copied as they are.
+	if index == nil {
+		_, err := io.Copy(w, contents)
+		return errors.WithStack(err)
+	}
+
+	srcs := []io.Reader{contents}
+	for _, ns := range index.Namespaces {
+		if includeNamespace != nil && !includeNamespace(ns.Name) {
+			continue
+		}
+
+		nsContents, err := backupStore.GetBackupNamespaceContents(name, ns.Name)
+		if err != nil {
+			return errors.Wrapf(err, "error downloading contents of namespace %s", ns.Name)
+		}
+		defer nsContents.Close()
+
+		srcs = append(srcs, nsContents)
+	}
+
+	return archive.Merge(w, srcs...)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+)
+
+func newTestTarball(t *testing.T, files ...string) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+
+	for _, file := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: file, Mode: 0644, Size: 2, Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte("{}"))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return buf
+}
+
+func tarballFileNames(t *testing.T, r io.Reader) []string {
+	gzr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+	defer gzr.Close()
+
+	var names []string
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+
+	return names
+}
+
+func TestCopyBackupContents(t *testing.T) {
+	tests := []struct {
+		name             string
+		split            bool
+		includeNamespace func(string) bool
+		want             []string
+	}{
+		{
+			name: "archive that isn't split is copied as it is",
+			want: []string{
+				"resources/persistentvolumes/cluster/pv-1.json",
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+			},
+		},
+		{
+			name:  "split archive with all namespaces included is merged",
+			split: true,
+			want: []string{
+				"resources/persistentvolumes/cluster/pv-1.json",
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+			},
+		},
+		{
+			name:             "split archive only includes the sub-archives of included namespaces",
+			split:            true,
+			includeNamespace: func(ns string) bool { return ns == "ns-2" },
+			want: []string{
+				"resources/persistentvolumes/cluster/pv-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+			tarball := newTestTarball(t,
+				"resources/persistentvolumes/cluster/pv-1.json",
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+			)
+
+			if !tc.split {
+				require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/backup-1/backup-1.tar.gz", tarball))
+			} else {
+				main := new(bytes.Buffer)
+				namespaces := make(map[string]io.Reader)
+				index, err := archive.Split(tarball, main, func(namespace string) (io.Writer, error) {
+					buf := new(bytes.Buffer)
+					namespaces[namespace] = buf
+					return buf, nil
+				})
+				require.NoError(t, err)
+
+				indexBuf := new(bytes.Buffer)
+				gzw := gzip.NewWriter(indexBuf)
+				require.NoError(t, json.NewEncoder(gzw).Encode(index))
+				require.NoError(t, gzw.Close())
+
+				require.NoError(t, harness.PutBackup(BackupInfo{
+					Name:              "backup-1",
+					Metadata:          newStringReadSeeker("metadata"),
+					Contents:          main,
+					Index:             indexBuf,
+					NamespaceContents: namespaces,
+				}))
+			}
+
+			buf := new(bytes.Buffer)
+			require.NoError(t, CopyBackupContents(harness.objectBackupStore, "backup-1", tc.includeNamespace, buf))
+
+			assert.Equal(t, tc.want, tarballFileNames(t, buf))
+		})
+	}
+}
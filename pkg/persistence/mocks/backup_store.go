@@ -2,10 +2,12 @@
 
 package mocks
 
+import archive "github.com/vmware-tanzu/velero/pkg/archive"
 import io "io"
 import mock "github.com/stretchr/testify/mock"
 import persistence "github.com/vmware-tanzu/velero/pkg/persistence"
 import v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+import velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 import volume "github.com/vmware-tanzu/velero/pkg/volume"
 
 // BackupStore is an autogenerated mock type for the BackupStore type
@@ -48,6 +50,20 @@ func (_m *BackupStore) DeleteBackup(name string) error {
 	return r0
 }
 
+// DeleteBackupLogs provides a mock function with given fields: backup, restores
+func (_m *BackupStore) DeleteBackupLogs(backup string, restores []string) error {
+	ret := _m.Called(backup, restores)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(backup, restores)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRestore provides a mock function with given fields: name
 func (_m *BackupStore) DeleteRestore(name string) error {
 	ret := _m.Called(name)
@@ -85,6 +101,29 @@ func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	return r0, r1
 }
 
+// GetBackupIndex provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupIndex(name string) (*archive.Index, error) {
+	ret := _m.Called(name)
+
+	var r0 *archive.Index
+	if rf, ok := ret.Get(0).(func(string) *archive.Index); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*archive.Index)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupMetadata provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	ret := _m.Called(name)
@@ -108,6 +147,52 @@ func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	return r0, r1
 }
 
+// GetBackupNamespaceContents provides a mock function with given fields: name, namespace
+func (_m *BackupStore) GetBackupNamespaceContents(name string, namespace string) (io.ReadCloser, error) {
+	ret := _m.Called(name, namespace)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string, string) io.ReadCloser); ok {
+		r0 = rf(name, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupResourceList provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupResourceList(name string) (map[string][]string, error) {
+	ret := _m.Called(name)
+
+	var r0 map[string][]string
+	if rf, ok := ret.Get(0).(func(string) map[string][]string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupVolumeSnapshots provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	ret := _m.Called(name)
@@ -131,6 +216,29 @@ func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot
 	return r0, r1
 }
 
+// GetDownload provides a mock function with given fields: target
+func (_m *BackupStore) GetDownload(target v1.DownloadTarget) (io.ReadCloser, error) {
+	ret := _m.Called(target)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(v1.DownloadTarget) io.ReadCloser); ok {
+		r0 = rf(target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(v1.DownloadTarget) error); ok {
+		r1 = rf(target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDownloadURL provides a mock function with given fields: target
 func (_m *BackupStore) GetDownloadURL(target v1.DownloadTarget) (string, error) {
 	ret := _m.Called(target)
@@ -152,6 +260,29 @@ func (_m *BackupStore) GetDownloadURL(target v1.DownloadTarget) (string, error)
 	return r0, r1
 }
 
+// GetEncryptionStatus provides a mock function with given fields:
+func (_m *BackupStore) GetEncryptionStatus() (*velero.EncryptionStatus, error) {
+	ret := _m.Called()
+
+	var r0 *velero.EncryptionStatus
+	if rf, ok := ret.Get(0).(func() *velero.EncryptionStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*velero.EncryptionStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPodVolumeBackups provides a mock function with given fields: name
 func (_m *BackupStore) GetPodVolumeBackups(name string) ([]*v1.PodVolumeBackup, error) {
 	ret := _m.Called(name)
@@ -175,6 +306,29 @@ func (_m *BackupStore) GetPodVolumeBackups(name string) ([]*v1.PodVolumeBackup,
 	return r0, r1
 }
 
+// GetRestoreResults provides a mock function with given fields: restore
+func (_m *BackupStore) GetRestoreResults(restore string) (io.ReadCloser, error) {
+	ret := _m.Called(restore)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(restore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(restore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsValid provides a mock function with given fields:
 func (_m *BackupStore) IsValid() error {
 	ret := _m.Called()
@@ -212,6 +366,52 @@ func (_m *BackupStore) ListBackups() ([]string, error) {
 	return r0, r1
 }
 
+// ListIncompleteBackups provides a mock function with given fields:
+func (_m *BackupStore) ListIncompleteBackups() ([]persistence.IncompleteBackup, error) {
+	ret := _m.Called()
+
+	var r0 []persistence.IncompleteBackup
+	if rf, ok := ret.Get(0).(func() []persistence.IncompleteBackup); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]persistence.IncompleteBackup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberClusters provides a mock function with given fields:
+func (_m *BackupStore) ListMemberClusters() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PutBackup provides a mock function with given fields: info
 func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	ret := _m.Called(info)
@@ -240,6 +440,20 @@ func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reade
 	return r0
 }
 
+// PutRestoreManifests provides a mock function with given fields: backup, restore, manifests
+func (_m *BackupStore) PutRestoreManifests(backup string, restore string, manifests io.Reader) error {
+	ret := _m.Called(backup, restore, manifests)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, restore, manifests)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreResults provides a mock function with given fields: backup, restore, results
 func (_m *BackupStore) PutRestoreResults(backup string, restore string, results io.Reader) error {
 	ret := _m.Called(backup, restore, results)
@@ -253,3 +467,17 @@ func (_m *BackupStore) PutRestoreResults(backup string, restore string, results
 
 	return r0
 }
+
+// UpdateBackupMetadata provides a mock function with given fields: name, metadata
+func (_m *BackupStore) UpdateBackupMetadata(name string, metadata io.Reader) error {
+	ret := _m.Called(name, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(name, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
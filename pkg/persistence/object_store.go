@@ -17,10 +17,13 @@ limitations under the License.
 package persistence
 
 import (
+	"bytes"
 	"compress/gzip"
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -29,6 +32,8 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -41,7 +46,15 @@ type BackupInfo struct {
 	Log,
 	PodVolumeBackups,
 	VolumeSnapshots,
-	BackupResourceList io.Reader
+	BackupResourceList,
+	SearchIndex,
+	Results io.Reader
+
+	// Index is the index of a backup whose archive is split into the main
+	// archive in Contents and the per-namespace sub-archives in
+	// NamespaceContents. It's nil if the backup's archive isn't split.
+	Index             io.Reader
+	NamespaceContents map[string]io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -51,22 +64,94 @@ type BackupStore interface {
 
 	ListBackups() ([]string, error)
 
+	// ListMemberClusters returns the names of the member clusters whose
+	// backups are stored in the backup store, under its clusters/<name>
+	// prefixes.
+	ListMemberClusters() ([]string, error)
+
+	// ListIncompleteBackups returns the backups whose upload didn't finish:
+	// backups that still have the marker that's uploaded when their upload
+	// starts, and backups that don't have metadata.
+	ListIncompleteBackups() ([]IncompleteBackup, error)
+
 	PutBackup(info BackupInfo) error
+
+	// UpdateBackupMetadata replaces the metadata of a backup that's already
+	// in the backup store, e.g. when its phase changes after it's uploaded.
+	UpdateBackupMetadata(name string, metadata io.Reader) error
+
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
+	GetBackupResourceList(name string) (map[string][]string, error)
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
+
+	// GetBackupContents returns a backup's archive, or its main archive if
+	// it's split into per-namespace sub-archives, in which case it only has
+	// the backup's cluster-scoped items.
 	GetBackupContents(name string) (io.ReadCloser, error)
 
+	// GetBackupIndex returns the index of a backup whose archive is split
+	// into per-namespace sub-archives, or nil if it isn't split.
+	GetBackupIndex(name string) (*archive.Index, error)
+
+	// GetBackupNamespaceContents returns the sub-archive of a namespace of a
+	// backup whose archive is split.
+	GetBackupNamespaceContents(name, namespace string) (io.ReadCloser, error)
+
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
 	DeleteBackup(name string) error
 
+	// DeleteBackupLogs deletes the log for the named backup, and the logs
+	// and results for the named restores from it.
+	DeleteBackupLogs(backup string, restores []string) error
+
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
+
+	// GetRestoreResults returns the gzipped JSON results of the named
+	// restore, or nil if it has none.
+	GetRestoreResults(restore string) (io.ReadCloser, error)
+
+	PutRestoreManifests(backup, restore string, manifests io.Reader) error
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+
+	// GetDownload returns the object that's downloaded for a download
+	// target, for serving it without a signed URL. It returns
+	// ErrDownloadNotFound if the object doesn't exist.
+	GetDownload(target velerov1api.DownloadTarget) (io.ReadCloser, error)
+
+	// GetEncryptionStatus returns the encryption at rest of the objects in the
+	// backup store, or nil if its object store plugin doesn't report it.
+	GetEncryptionStatus() (*velero.EncryptionStatus, error)
+}
+
+// ErrBackupExists is returned by PutBackup if there's already a backup with the
+// same name in the backup store, or one is being uploaded to it, e.g. because
+// the backup store's location is shared with another Velero server.
+var ErrBackupExists = errors.New("a backup with the same name already exists in the backup storage location, which may be shared with another Velero server")
+
+// ErrDownloadNotFound is returned by GetDownload if the object of the download
+// target doesn't exist in the backup store.
+var ErrDownloadNotFound = errors.New("the download target doesn't exist in the backup storage location")
+
+// IncompleteBackup is a backup in object storage whose upload didn't finish.
+type IncompleteBackup struct {
+	Name string
+
+	// UploadStarted is when the backup's upload started, or zero if the
+	// backup has no upload marker, in which case it's incomplete because it
+	// has no metadata.
+	UploadStarted time.Time
+}
+
+// uploadMarker is the contents of the marker that's uploaded for a backup
+// while it's being uploaded.
+type uploadMarker struct {
+	Started time.Time `json:"started"`
 }
 
 // DownloadURLTTL is how long a download URL is valid for.
@@ -85,6 +170,19 @@ type ObjectStoreGetter interface {
 	GetObjectStore(provider string) (velero.ObjectStore, error)
 }
 
+// MemberClusterLocation returns a copy of a backup storage location whose
+// prefix is the one that the backups of a member cluster are stored under in
+// the location, or the location itself if cluster is empty.
+func MemberClusterLocation(location *velerov1api.BackupStorageLocation, cluster string) *velerov1api.BackupStorageLocation {
+	if cluster == "" || location.Spec.ObjectStorage == nil {
+		return location
+	}
+
+	res := location.DeepCopy()
+	res.Spec.ObjectStorage.Prefix = path.Join(strings.Trim(location.Spec.ObjectStorage.Prefix, "/"), "clusters", cluster)
+	return res
+}
+
 func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
 	if location.Spec.ObjectStorage == nil {
 		return nil, errors.New("backup storage location does not use object storage")
@@ -121,7 +219,12 @@ func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectSto
 		return nil, err
 	}
 
-	if err := objectStore.Init(location.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentialsFile(velero.ConfigWithIdentity(location.Spec.Config, location.Spec.Identity), location.Spec.Credential, objectStoreGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := objectStore.Init(config); err != nil {
 		return nil, err
 	}
 
@@ -187,13 +290,134 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 	return output, nil
 }
 
+func (s *objectBackupStore) ListMemberClusters() ([]string, error) {
+	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.subdirs["clusters"], "/")
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		clusters = append(clusters, strings.TrimSuffix(strings.TrimPrefix(prefix, s.layout.subdirs["clusters"]), "/"))
+	}
+
+	return clusters, nil
+}
+
+func (s *objectBackupStore) ListIncompleteBackups() ([]IncompleteBackup, error) {
+	keys, err := s.objectStore.ListObjects(s.bucket, s.layout.subdirs["backups"])
+	if err != nil {
+		return nil, err
+	}
+
+	hasMetadata := make(map[string]bool)
+	hasMarker := make(map[string]bool)
+	for _, key := range keys {
+		// keys are of the form <backups dir><backup name>/<file>.
+		parts := strings.SplitN(strings.TrimPrefix(key, s.layout.subdirs["backups"]), "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		name := parts[0]
+
+		if _, ok := hasMetadata[name]; !ok {
+			hasMetadata[name] = false
+		}
+
+		switch key {
+		case s.layout.getBackupMetadataKey(name):
+			hasMetadata[name] = true
+		case s.layout.getBackupUploadMarkerKey(name):
+			hasMarker[name] = true
+		}
+	}
+
+	var names []string
+	for name := range hasMetadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var incomplete []IncompleteBackup
+	for _, name := range names {
+		if !hasMarker[name] {
+			if !hasMetadata[name] {
+				incomplete = append(incomplete, IncompleteBackup{Name: name})
+			}
+			continue
+		}
+
+		rc, err := s.objectStore.GetObject(s.bucket, s.layout.getBackupUploadMarkerKey(name))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		marker := new(uploadMarker)
+		err = json.NewDecoder(rc).Decode(marker)
+		rc.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decoding upload marker of backup %s", name)
+		}
+
+		incomplete = append(incomplete, IncompleteBackup{Name: name, UploadStarted: marker.Started})
+	}
+
+	return incomplete, nil
+}
+
+// PutBackup uploads a backup. A marker is uploaded before anything else and
+// deleted once the upload finishes, whether it succeeds or fails, so that
+// backups whose upload was interrupted can be found and cleaned up.
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
+	markerKey := s.layout.getBackupUploadMarkerKey(info.Name)
+
+	marker, err := json.Marshal(uploadMarker{Started: time.Now().UTC()})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// the marker is created conditionally so that two servers can't upload
+	// a backup with the same name at the same time.
+	created, err := putObjectIfNotExists(s.objectStore, s.bucket, markerKey, marker)
+	if err != nil {
+		return errors.Wrap(err, "error uploading backup's upload marker")
+	}
+	if !created {
+		return errors.WithStack(ErrBackupExists)
+	}
+
+	err = s.putBackup(info)
+
+	if deleteErr := s.objectStore.DeleteObject(s.bucket, markerKey); deleteErr != nil {
+		s.logger.WithError(deleteErr).WithField("backup", info.Name).Error("Error deleting backup's upload marker")
+	}
+
+	return err
+}
+
+func (s *objectBackupStore) UpdateBackupMetadata(name string, metadata io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupMetadataKey(name), metadata)
+}
+
+func (s *objectBackupStore) putBackup(info BackupInfo) error {
+	// the metadata is uploaded before anything else, and created
+	// conditionally, so that a backup that's already in the bucket is never
+	// overwritten.
+	created, metadataErr := s.putBackupMetadata(info)
+	if metadataErr == nil && !created {
+		return errors.WithStack(ErrBackupExists)
+	}
+
 	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
 	}
 
+	if metadataErr != nil {
+		// failure to upload metadata file is a hard-stop
+		return metadataErr
+	}
+
 	if info.Metadata == nil {
 		// If we don't have metadata, something failed, and there's no point in continuing. An object
 		// storage bucket that is missing the metadata file can't be restored, nor can its logs be
@@ -201,53 +425,78 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return nil
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
-		// failure to upload metadata file is a hard-stop
-		return err
+	// uploaded has the keys of the backup's required objects that have been
+	// uploaded, which are deleted if a later one fails to upload.
+	uploaded := []string{s.layout.getBackupMetadataKey(info.Name)}
+	putObject := func(key string, file io.Reader) error {
+		if err := seekAndPutObject(s.objectStore, s.bucket, key, file); err != nil {
+			return s.deleteUploadedObjects(uploaded, err)
+		}
+		if file != nil {
+			uploaded = append(uploaded, key)
+		}
+		return nil
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
-		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
-		return kerrors.NewAggregate([]error{err, deleteErr})
+	if err := putObject(s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getPodVolumeBackupsKey(info.Name), info.PodVolumeBackups); err != nil {
-		errs := []error{err}
-
-		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
-		errs = append(errs, deleteErr)
-
-		deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
-		errs = append(errs, deleteErr)
+	var namespaces []string
+	for namespace := range info.NamespaceContents {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
 
-		return kerrors.NewAggregate(errs)
+	for _, namespace := range namespaces {
+		if err := putObject(s.layout.getBackupNamespaceContentsKey(info.Name, namespace), info.NamespaceContents[namespace]); err != nil {
+			return err
+		}
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupVolumeSnapshotsKey(info.Name), info.VolumeSnapshots); err != nil {
-		errs := []error{err}
+	// the index is uploaded after the sub-archives, so that a backup with an
+	// index always has all of its sub-archives.
+	if err := putObject(s.layout.getBackupIndexKey(info.Name), info.Index); err != nil {
+		return err
+	}
 
-		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
-		errs = append(errs, deleteErr)
+	if err := putObject(s.layout.getPodVolumeBackupsKey(info.Name), info.PodVolumeBackups); err != nil {
+		return err
+	}
 
-		deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
-		errs = append(errs, deleteErr)
+	if err := putObject(s.layout.getBackupVolumeSnapshotsKey(info.Name), info.VolumeSnapshots); err != nil {
+		return err
+	}
 
-		return kerrors.NewAggregate(errs)
+	if err := putObject(s.layout.getBackupResourceListKey(info.Name), info.BackupResourceList); err != nil {
+		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupResourceListKey(info.Name), info.BackupResourceList); err != nil {
-		errs := []error{err}
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupSearchIndexKey(info.Name), info.SearchIndex); err != nil {
+		// Uploading the search index is best-effort; if it fails, the backup can still be
+		// restored, it just can't be searched.
+		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading search index")
+	}
 
-		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
-		errs = append(errs, deleteErr)
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupResultsKey(info.Name), info.Results); err != nil {
+		// Uploading the results is best-effort; they're only used to describe the backup.
+		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading backup results")
+	}
 
-		deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
-		errs = append(errs, deleteErr)
+	return nil
+}
 
-		return kerrors.NewAggregate(errs)
+// deleteUploadedObjects deletes the objects that were uploaded for a backup
+// before another of its objects failed to upload with err, in the reverse
+// order of their upload, so that its metadata is deleted last. It returns err
+// along with any errors deleting the objects.
+func (s *objectBackupStore) deleteUploadedObjects(keys []string, err error) error {
+	errs := []error{err}
+	for i := len(keys) - 1; i >= 0; i-- {
+		errs = append(errs, s.objectStore.DeleteObject(s.bucket, keys[i]))
 	}
 
-	return nil
+	return kerrors.NewAggregate(errs)
 }
 
 func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup, error) {
@@ -299,6 +548,26 @@ func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Sna
 	return volumeSnapshots, nil
 }
 
+// GetBackupResourceList returns the list of backed up items, keyed by their
+// group/version/kind, or nil if the backup predates the resource list.
+func (s *objectBackupStore) GetBackupResourceList(name string) (map[string][]string, error) {
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupResourceListKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	var resourceList map[string][]string
+	if err := decode(res, &resourceList); err != nil {
+		return nil, err
+	}
+
+	return resourceList, nil
+}
+
 // tryGet returns the object with the given key if it exists, nil if it does not exist,
 // or an error if it was unable to check existence or get the object.
 func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser, error) {
@@ -354,6 +623,30 @@ func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error
 	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
 }
 
+func (s *objectBackupStore) GetBackupIndex(name string) (*archive.Index, error) {
+	// if the index file doesn't exist, we don't want to return an error, since
+	// backups whose archives aren't split don't have it.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupIndexKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	index := new(archive.Index)
+	if err := decode(res, index); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+func (s *objectBackupStore) GetBackupNamespaceContents(name, namespace string) (io.ReadCloser, error) {
+	return s.objectStore.GetObject(s.bucket, s.layout.getBackupNamespaceContentsKey(name, namespace))
+}
+
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
 	return s.objectStore.ObjectExists(bucket, s.layout.getBackupMetadataKey(backupName))
 }
@@ -377,6 +670,36 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) DeleteBackupLogs(backup string, restores []string) error {
+	keys := []string{s.layout.getBackupLogKey(backup)}
+	for _, restore := range restores {
+		keys = append(keys, s.layout.getRestoreLogKey(restore), s.layout.getRestoreResultsKey(restore))
+	}
+
+	var errs []error
+	for _, key := range keys {
+		// not all object stores allow deleting objects that don't exist,
+		// and restores that failed validation don't have logs or results.
+		exists, err := s.objectStore.ObjectExists(s.bucket, key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !exists {
+			continue
+		}
+
+		s.logger.WithFields(logrus.Fields{
+			"key": key,
+		}).Debug("Trying to delete object")
+		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.WithStack(kerrors.NewAggregate(errs))
+}
+
 func (s *objectBackupStore) DeleteRestore(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRestoreDir(name))
 	if err != nil {
@@ -404,25 +727,83 @@ func (s *objectBackupStore) PutRestoreResults(backup string, restore string, res
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
 }
 
-func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
+func (s *objectBackupStore) GetRestoreResults(restore string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getRestoreResultsKey(restore))
+}
+
+func (s *objectBackupStore) PutRestoreManifests(backup string, restore string, manifests io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreManifestsKey(restore), manifests)
+}
+
+// downloadKey returns the key of the object that's downloaded for a download
+// target.
+func (s *objectBackupStore) downloadKey(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupContentsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupNamespaceContents:
+		if target.Namespace == "" {
+			return "", errors.New("download target of kind BackupNamespaceContents must have a namespace")
+		}
+		return s.layout.getBackupNamespaceContentsKey(target.Name, target.Namespace), nil
+	case velerov1api.DownloadTargetKindBackupIndex:
+		return s.layout.getBackupIndexKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupLogKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupLogKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupVolumeSnapshotsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupResourceListKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupSearchIndex:
+		return s.layout.getBackupSearchIndexKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupResults:
+		return s.layout.getBackupResultsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreLogKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreResults:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreResultsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreManifests:
+		return s.layout.getRestoreManifestsKey(target.Name), nil
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
 }
 
+func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
+	key, err := s.downloadKey(target)
+	if err != nil {
+		return "", err
+	}
+
+	return s.objectStore.CreateSignedURL(s.bucket, key, DownloadURLTTL)
+}
+
+func (s *objectBackupStore) GetDownload(target velerov1api.DownloadTarget) (io.ReadCloser, error) {
+	key, err := s.downloadKey(target)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return nil, errors.WithStack(ErrDownloadNotFound)
+	}
+
+	return s.objectStore.GetObject(s.bucket, key)
+}
+
+func (s *objectBackupStore) GetEncryptionStatus() (*velero.EncryptionStatus, error) {
+	getter, ok := s.objectStore.(velero.EncryptionStatusGetter)
+	if !ok {
+		return nil, nil
+	}
+
+	return getter.GetEncryptionStatus(s.bucket)
+}
+
 func seekToBeginning(r io.Reader) error {
 	seeker, ok := r.(io.Seeker)
 	if !ok {
@@ -433,6 +814,55 @@ func seekToBeginning(r io.Reader) error {
 	return err
 }
 
+// putBackupMetadata uploads a backup's metadata unless the bucket already has
+// a backup with the same name, in which case it returns false. For a backup
+// without metadata, it only checks whether the bucket has such a backup.
+func (s *objectBackupStore) putBackupMetadata(info BackupInfo) (bool, error) {
+	key := s.layout.getBackupMetadataKey(info.Name)
+
+	if info.Metadata == nil {
+		exists, err := s.objectStore.ObjectExists(s.bucket, key)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+		return !exists, nil
+	}
+
+	if err := seekToBeginning(info.Metadata); err != nil {
+		return false, errors.WithStack(err)
+	}
+	metadata, err := ioutil.ReadAll(info.Metadata)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return putObjectIfNotExists(s.objectStore, s.bucket, key, metadata)
+}
+
+// putObjectIfNotExists creates an object with the given data unless there's
+// already an object with the key, and returns whether it was created. If the
+// object store doesn't support conditional writes, it checks whether the
+// object exists before creating it instead, which doesn't stop concurrent
+// writers from overwriting each other's objects.
+func putObjectIfNotExists(objectStore velero.ObjectStore, bucket, key string, data []byte) (bool, error) {
+	if putter, ok := objectStore.(velero.ConditionalPutter); ok {
+		created, err := putter.PutObjectIfNotExists(bucket, key, bytes.NewReader(data))
+		if errors.Cause(err) != velero.ErrConditionalPutNotSupported {
+			return created, err
+		}
+	}
+
+	exists, err := objectStore.ObjectExists(bucket, key)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	return true, objectStore.PutObject(bucket, key, bytes.NewReader(data))
+}
+
 func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file io.Reader) error {
 	if file == nil {
 		return nil
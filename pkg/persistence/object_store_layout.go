@@ -39,6 +39,7 @@ func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 		"restores": path.Join(prefix, "restores") + "/",
 		"restic":   path.Join(prefix, "restic") + "/",
 		"metadata": path.Join(prefix, "metadata") + "/",
+		"clusters": path.Join(prefix, "clusters") + "/",
 	}
 
 	return &ObjectStoreLayout{
@@ -75,6 +76,18 @@ func (l *ObjectStoreLayout) getBackupContentsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s.tar.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupNamespaceContentsKey(backup, namespace string) string {
+	return path.Join(l.subdirs["backups"], backup, "namespaces", fmt.Sprintf("%s.tar.gz", namespace))
+}
+
+func (l *ObjectStoreLayout) getBackupIndexKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-index.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupUploadMarkerKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-upload-in-progress.json", backup))
+}
+
 func (l *ObjectStoreLayout) getBackupLogKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-logs.gz", backup))
 }
@@ -91,6 +104,14 @@ func (l *ObjectStoreLayout) getBackupResourceListKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-list.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupSearchIndexKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-search-index.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupResultsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-results.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getRestoreLogKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-logs.gz", restore))
 }
@@ -98,3 +119,7 @@ func (l *ObjectStoreLayout) getRestoreLogKey(restore string) string {
 func (l *ObjectStoreLayout) getRestoreResultsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-results.gz", restore))
 }
+
+func (l *ObjectStoreLayout) getRestoreManifestsKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-manifests.tar.gz", restore))
+}
@@ -26,6 +26,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/cloudprovider"
 	cloudprovidermocks "github.com/vmware-tanzu/velero/pkg/cloudprovider/mocks"
@@ -206,6 +208,41 @@ func TestListBackups(t *testing.T) {
 	}
 }
 
+func TestListMemberClusters(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "velero-backups/")
+
+	clusters, err := harness.ListMemberClusters()
+	require.NoError(t, err)
+	assert.Empty(t, clusters)
+
+	for _, key := range []string{
+		"velero-backups/backups/backup-1/velero-backup.json",
+		"velero-backups/clusters/cluster-1/backups/backup-2/velero-backup.json",
+		"velero-backups/clusters/cluster-2/backups/backup-3/velero-backup.json",
+	} {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, newStringReadSeeker("foo")))
+	}
+
+	clusters, err = harness.ListMemberClusters()
+	require.NoError(t, err)
+	sort.Strings(clusters)
+	assert.Equal(t, []string{"cluster-1", "cluster-2"}, clusters)
+}
+
+func TestMemberClusterLocation(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("aws").Bucket("bucket").Prefix("/velero-backups/").Result()
+
+	assert.Equal(t, location, MemberClusterLocation(location, ""))
+
+	res := MemberClusterLocation(location, "cluster-1")
+	assert.Equal(t, "velero-backups/clusters/cluster-1", res.Spec.ObjectStorage.Prefix)
+	assert.Equal(t, "bucket", res.Spec.ObjectStorage.Bucket)
+	assert.Equal(t, "/velero-backups/", location.Spec.ObjectStorage.Prefix)
+
+	noPrefix := builder.ForBackupStorageLocation("velero", "default").Provider("aws").Bucket("bucket").Result()
+	assert.Equal(t, "clusters/cluster-1", MemberClusterLocation(noPrefix, "cluster-1").Spec.ObjectStorage.Prefix)
+}
+
 func TestPutBackup(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -216,6 +253,10 @@ func TestPutBackup(t *testing.T) {
 		podVolumeBackup io.Reader
 		snapshots       io.Reader
 		resourceList    io.Reader
+		searchIndex     io.Reader
+		results         io.Reader
+		index           io.Reader
+		namespaces      map[string]io.Reader
 		expectedErr     string
 		expectedKeys    []string
 	}{
@@ -305,6 +346,155 @@ func TestPutBackup(t *testing.T) {
 			expectedErr:     "",
 			expectedKeys:    []string{"backups/backup-1/backup-1-logs.gz"},
 		},
+		{
+			name:            "search index is uploaded when present",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			searchIndex:     newStringReadSeeker("searchIndex"),
+			expectedErr:     "",
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.gz",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-podvolumebackups.json.gz",
+				"backups/backup-1/backup-1-volumesnapshots.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+				"backups/backup-1/backup-1-search-index.json.gz",
+			},
+		},
+		{
+			name:            "error on search index upload is ok",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			searchIndex:     new(errorReader),
+			expectedErr:     "",
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.gz",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-podvolumebackups.json.gz",
+				"backups/backup-1/backup-1-volumesnapshots.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+			},
+		},
+		{
+			name:            "results are uploaded when present",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			results:         newStringReadSeeker("results"),
+			expectedErr:     "",
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.gz",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-podvolumebackups.json.gz",
+				"backups/backup-1/backup-1-volumesnapshots.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+				"backups/backup-1/backup-1-results.json.gz",
+			},
+		},
+		{
+			name:            "index and namespace contents are uploaded when present",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			index:           newStringReadSeeker("index"),
+			namespaces: map[string]io.Reader{
+				"ns-1": newStringReadSeeker("ns-1"),
+				"ns-2": newStringReadSeeker("ns-2"),
+			},
+			expectedErr: "",
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.gz",
+				"backups/backup-1/backup-1-index.json.gz",
+				"backups/backup-1/namespaces/ns-1.tar.gz",
+				"backups/backup-1/namespaces/ns-2.tar.gz",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-podvolumebackups.json.gz",
+				"backups/backup-1/backup-1-volumesnapshots.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+			},
+		},
+		{
+			name:            "error on namespace contents upload deletes uploaded data",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			index:           newStringReadSeeker("index"),
+			namespaces: map[string]io.Reader{
+				"ns-1": newStringReadSeeker("ns-1"),
+				"ns-2": new(errorReader),
+			},
+			expectedErr:  "error readers return errors",
+			expectedKeys: []string{"backups/backup-1/backup-1-logs.gz"},
+		},
+		{
+			name:            "error on pod volume backups upload deletes uploaded data",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: new(errorReader),
+			snapshots:       newStringReadSeeker("snapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			index:           newStringReadSeeker("index"),
+			namespaces: map[string]io.Reader{
+				"ns-1": newStringReadSeeker("ns-1"),
+				"ns-2": newStringReadSeeker("ns-2"),
+			},
+			expectedErr:  "error readers return errors",
+			expectedKeys: []string{"backups/backup-1/backup-1-logs.gz"},
+		},
+		{
+			name:            "error on volume snapshots upload deletes uploaded data",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       new(errorReader),
+			resourceList:    newStringReadSeeker("resourceList"),
+			index:           newStringReadSeeker("index"),
+			namespaces: map[string]io.Reader{
+				"ns-1": newStringReadSeeker("ns-1"),
+				"ns-2": newStringReadSeeker("ns-2"),
+			},
+			expectedErr:  "error readers return errors",
+			expectedKeys: []string{"backups/backup-1/backup-1-logs.gz"},
+		},
+		{
+			name:            "error on resource list upload deletes uploaded data",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			resourceList:    new(errorReader),
+			index:           newStringReadSeeker("index"),
+			namespaces: map[string]io.Reader{
+				"ns-1": newStringReadSeeker("ns-1"),
+				"ns-2": newStringReadSeeker("ns-2"),
+			},
+			expectedErr:  "error readers return errors",
+			expectedKeys: []string{"backups/backup-1/backup-1-logs.gz"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -319,6 +509,10 @@ func TestPutBackup(t *testing.T) {
 				PodVolumeBackups:   tc.podVolumeBackup,
 				VolumeSnapshots:    tc.snapshots,
 				BackupResourceList: tc.resourceList,
+				SearchIndex:        tc.searchIndex,
+				Results:            tc.results,
+				Index:              tc.index,
+				NamespaceContents:  tc.namespaces,
 			}
 			err := harness.PutBackup(backupInfo)
 
@@ -331,6 +525,108 @@ func TestPutBackup(t *testing.T) {
 	}
 }
 
+func TestListIncompleteBackups(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "prefix-1/")
+
+	started := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// a complete backup
+	harness.objectStore.PutObject(harness.bucket, "prefix-1/backups/backup-1/velero-backup.json", newStringReadSeeker("metadata"))
+	harness.objectStore.PutObject(harness.bucket, "prefix-1/backups/backup-1/backup-1.tar.gz", newStringReadSeeker("contents"))
+	// a backup whose upload was interrupted
+	harness.objectStore.PutObject(harness.bucket, "prefix-1/backups/backup-2/velero-backup.json", newStringReadSeeker("metadata"))
+	harness.objectStore.PutObject(harness.bucket, "prefix-1/backups/backup-2/backup-2-upload-in-progress.json", newStringReadSeeker(`{"started":"2019-10-01T12:00:00Z"}`))
+	// a backup without metadata
+	harness.objectStore.PutObject(harness.bucket, "prefix-1/backups/backup-3/backup-3.tar.gz", newStringReadSeeker("contents"))
+
+	res, err := harness.ListIncompleteBackups()
+	require.NoError(t, err)
+
+	assert.Equal(t, []IncompleteBackup{
+		{Name: "backup-2", UploadStarted: started},
+		{Name: "backup-3"},
+	}, res)
+}
+
+func TestPutBackupRemovesUploadMarker(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: newStringReadSeeker("metadata"),
+		Contents: newStringReadSeeker("contents"),
+	}))
+
+	assert.NotContains(t, harness.objectStore.Data[harness.bucket], "backups/backup-1/backup-1-upload-in-progress.json")
+
+	res, err := harness.ListIncompleteBackups()
+	require.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+// unconditionalObjectStore hides an object store's support for conditional
+// writes.
+type unconditionalObjectStore struct {
+	velero.ObjectStore
+}
+
+func TestPutBackupDoesNotOverwriteExistingBackup(t *testing.T) {
+	tests := []struct {
+		name          string
+		existing      map[string]string
+		metadata      io.Reader
+		unconditional bool
+	}{
+		{
+			name:     "backup with metadata",
+			existing: map[string]string{"backups/backup-1/velero-backup.json": "other-metadata"},
+			metadata: newStringReadSeeker("metadata"),
+		},
+		{
+			name:     "backup being uploaded",
+			existing: map[string]string{"backups/backup-1/backup-1-upload-in-progress.json": "{}"},
+			metadata: newStringReadSeeker("metadata"),
+		},
+		{
+			name:     "failed backup without metadata doesn't overwrite logs",
+			existing: map[string]string{"backups/backup-1/velero-backup.json": "other-metadata"},
+		},
+		{
+			name:          "object store without conditional writes",
+			existing:      map[string]string{"backups/backup-1/velero-backup.json": "other-metadata"},
+			metadata:      newStringReadSeeker("metadata"),
+			unconditional: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("test-bucket", "")
+			if tc.unconditional {
+				harness.objectBackupStore.objectStore = unconditionalObjectStore{harness.objectStore}
+			}
+
+			for key, data := range tc.existing {
+				require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, newStringReadSeeker(data)))
+			}
+
+			err := harness.PutBackup(BackupInfo{
+				Name:     "backup-1",
+				Metadata: tc.metadata,
+				Contents: newStringReadSeeker("contents"),
+				Log:      newStringReadSeeker("log"),
+			})
+			assert.EqualError(t, err, ErrBackupExists.Error())
+
+			// the existing backup's objects are left as they were.
+			assert.Len(t, harness.objectStore.Data[harness.bucket], len(tc.existing))
+			for key, data := range tc.existing {
+				assert.Equal(t, data, string(harness.objectStore.Data[harness.bucket][key]))
+			}
+		})
+	}
+}
+
 func TestGetBackupMetadata(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -418,6 +714,37 @@ func TestGetBackupVolumeSnapshots(t *testing.T) {
 	assert.EqualValues(t, snapshots, res)
 }
 
+func TestGetBackupResourceList(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// resource list file not found should not error
+	res, err := harness.GetBackupResourceList("test-backup")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	// resource list file containing invalid data should error
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-resource-list.json.gz", newStringReadSeeker("foo"))
+	_, err = harness.GetBackupResourceList("test-backup")
+	assert.NotNil(t, err)
+
+	// resource list file containing gzipped json data should return correctly
+	resourceList := map[string][]string{
+		"v1/Pod":             {"ns-1/pod-1", "ns-1/pod-2"},
+		"apps/v1/Deployment": {"ns-1/deploy-1"},
+	}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(resourceList))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-resource-list.json.gz", obj))
+
+	res, err = harness.GetBackupResourceList("test-backup")
+	assert.NoError(t, err)
+	assert.Equal(t, resourceList, res)
+}
+
 func TestGetBackupContents(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -432,6 +759,33 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestGetBackupIndex(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// file not found should not error
+	index, err := harness.GetBackupIndex("test-backup")
+	assert.NoError(t, err)
+	assert.Nil(t, index)
+
+	// file containing index data should be returned
+	want := &archive.Index{
+		Version:      archive.SplitFormatVersion,
+		ClusterItems: 1,
+		Namespaces:   []archive.IndexNamespace{{Name: "ns-1", Items: 2}},
+	}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(want))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-index.json.gz", obj))
+
+	index, err = harness.GetBackupIndex("test-backup")
+	assert.NoError(t, err)
+	assert.Equal(t, want, index)
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -484,6 +838,78 @@ func TestDeleteBackup(t *testing.T) {
 	}
 }
 
+func TestDeleteBackupLogs(t *testing.T) {
+	tests := []struct {
+		name           string
+		prefix         string
+		existing       []string
+		deleteErrors   map[string]error
+		expectedDelete []string
+		expectedErr    string
+	}{
+		{
+			name:           "backup and restore logs are deleted",
+			existing:       []string{"backups/bak/bak-logs.gz", "restores/res/restore-res-logs.gz", "restores/res/restore-res-results.gz"},
+			expectedDelete: []string{"backups/bak/bak-logs.gz", "restores/res/restore-res-logs.gz", "restores/res/restore-res-results.gz"},
+		},
+		{
+			name:           "backup store prefix is used",
+			prefix:         "velero-backups/",
+			existing:       []string{"velero-backups/backups/bak/bak-logs.gz"},
+			expectedDelete: []string{"velero-backups/backups/bak/bak-logs.gz"},
+		},
+		{
+			name:           "objects that don't exist aren't deleted",
+			existing:       []string{"restores/res/restore-res-logs.gz"},
+			expectedDelete: []string{"restores/res/restore-res-logs.gz"},
+		},
+		{
+			name:           "delete errors are aggregated",
+			existing:       []string{"backups/bak/bak-logs.gz", "restores/res/restore-res-logs.gz", "restores/res/restore-res-results.gz"},
+			deleteErrors:   map[string]error{"backups/bak/bak-logs.gz": errors.New("a"), "restores/res/restore-res-results.gz": errors.New("c")},
+			expectedDelete: []string{"backups/bak/bak-logs.gz", "restores/res/restore-res-logs.gz", "restores/res/restore-res-results.gz"},
+			expectedErr:    "[a, c]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objectStore := cloudprovider.NewInMemoryObjectStore("test-bucket")
+			for _, key := range test.existing {
+				require.NoError(t, objectStore.PutObject("test-bucket", key, newStringReadSeeker("foo")))
+			}
+
+			backupStore := &objectBackupStore{
+				objectStore: &deleteTrackingObjectStore{ObjectStore: objectStore, errs: test.deleteErrors},
+				bucket:      "test-bucket",
+				layout:      NewObjectStoreLayout(test.prefix),
+				logger:      velerotest.NewLogger(),
+			}
+
+			err := backupStore.DeleteBackupLogs("bak", []string{"res"})
+			velerotest.AssertErrorMatches(t, test.expectedErr, err)
+
+			assert.Equal(t, test.expectedDelete, backupStore.objectStore.(*deleteTrackingObjectStore).deleted)
+		})
+	}
+}
+
+// deleteTrackingObjectStore is an object store that records the keys it's
+// asked to delete, and optionally returns errors for them.
+type deleteTrackingObjectStore struct {
+	velero.ObjectStore
+	errs    map[string]error
+	deleted []string
+}
+
+func (o *deleteTrackingObjectStore) DeleteObject(bucket, key string) error {
+	o.deleted = append(o.deleted, key)
+	if err := o.errs[key]; err != nil {
+		return err
+	}
+	return o.ObjectStore.DeleteObject(bucket, key)
+}
+
 func TestGetDownloadURL(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -498,6 +924,8 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupContents:        "backups/my-backup/my-backup.tar.gz",
 				velerov1api.DownloadTargetKindBackupLog:             "backups/my-backup/my-backup-logs.gz",
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupSearchIndex:     "backups/my-backup/my-backup-search-index.json.gz",
+				velerov1api.DownloadTargetKindBackupResults:         "backups/my-backup/my-backup-results.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup/my-backup-resource-list.json.gz",
 			},
 		},
@@ -547,8 +975,9 @@ func TestGetDownloadURL(t *testing.T) {
 			name:       "restore",
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:       "restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:   "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreManifests: "restores/my-backup/restore-my-backup-manifests.tar.gz",
 			},
 		},
 		{
@@ -556,16 +985,18 @@ func TestGetDownloadURL(t *testing.T) {
 			targetName: "my-backup",
 			prefix:     "velero-backups/",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:       "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:   "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreManifests: "velero-backups/restores/my-backup/restore-my-backup-manifests.tar.gz",
 			},
 		},
 		{
 			name:       "restore with multiple dashes",
 			targetName: "b-cool-20170913154901-20170913154902",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:       "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:   "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-results.gz",
+				velerov1api.DownloadTargetKindRestoreManifests: "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-manifests.tar.gz",
 			},
 		},
 	}
@@ -581,12 +1012,57 @@ func TestGetDownloadURL(t *testing.T) {
 					url, err := harness.GetDownloadURL(velerov1api.DownloadTarget{Kind: kind, Name: test.targetName})
 					require.NoError(t, err)
 					assert.Equal(t, "a-url", url)
+
+					rc, err := harness.GetDownload(velerov1api.DownloadTarget{Kind: kind, Name: test.targetName})
+					require.NoError(t, err)
+					defer rc.Close()
+					data, err := ioutil.ReadAll(rc)
+					require.NoError(t, err)
+					assert.Equal(t, "foo", string(data))
 				})
 			}
 		})
 	}
 }
 
+func TestGetDownloadNotFound(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	_, err := harness.GetDownload(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupLog, Name: "my-backup"})
+	assert.EqualError(t, err, ErrDownloadNotFound.Error())
+
+	_, err = harness.GetDownload(velerov1api.DownloadTarget{Kind: "Unknown", Name: "my-backup"})
+	assert.EqualError(t, err, `unsupported download target kind "Unknown"`)
+}
+
+// encryptionReportingObjectStore is an in-memory object store that
+// reports a fixed encryption status.
+type encryptionReportingObjectStore struct {
+	*cloudprovider.InMemoryObjectStore
+	status *velero.EncryptionStatus
+}
+
+func (o *encryptionReportingObjectStore) GetEncryptionStatus(bucket string) (*velero.EncryptionStatus, error) {
+	return o.status, nil
+}
+
+func TestGetEncryptionStatus(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// an object store that doesn't report its encryption status returns nil
+	res, err := harness.GetEncryptionStatus()
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	// an object store that reports its encryption status returns it
+	status := &velero.EncryptionStatus{Enabled: true, Algorithm: "aws:kms", KeyID: "key-1"}
+	harness.objectBackupStore.objectStore = &encryptionReportingObjectStore{InMemoryObjectStore: harness.objectStore, status: status}
+
+	res, err = harness.GetEncryptionStatus()
+	require.NoError(t, err)
+	assert.Equal(t, status, res)
+}
+
 type objectStoreGetter map[string]velero.ObjectStore
 
 func (osg objectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore, error) {
@@ -67,11 +67,14 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupItemAction):   framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupItemActionV2): framework.NewBackupItemActionV2Plugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindItemTransformer):    framework.NewItemTransformerPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindKeyWrapper):         framework.NewKeyWrapperPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindVolumeSnapshotter):  framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindObjectStore):        framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindPluginLister):       &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):  framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
 		},
 		Logger: b.pluginLogger,
 		Cmd:    exec.Command(b.commandName, b.commandArgs...),
@@ -79,7 +82,7 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 }
 
 // client creates a new go-plugin Client with support for all of Velero's plugin kinds (BackupItemAction, VolumeSnapshotter,
-// ObjectStore, PluginLister, RestoreItemAction).
+// ObjectStore, KeyWrapper, PluginLister, RestoreItemAction).
 func (b *clientBuilder) client() *hcplugin.Client {
 	return hcplugin.NewClient(b.clientConfig())
 }
@@ -60,11 +60,14 @@ func TestClientConfig(t *testing.T) {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupItemAction):   framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupItemActionV2): framework.NewBackupItemActionV2Plugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindItemTransformer):    framework.NewItemTransformerPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindKeyWrapper):         framework.NewKeyWrapperPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindVolumeSnapshotter):  framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindObjectStore):        framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPluginLister):       &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):  framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
 		},
 		Logger: cb.pluginLogger,
 		Cmd:    exec.Command(cb.commandName, cb.commandArgs...),
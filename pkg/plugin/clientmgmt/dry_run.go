@@ -0,0 +1,108 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const dryRunIDPrefix = "dry-run-"
+
+// dryRunManager is a Manager whose object stores and volume snapshotters log the
+// changes they would make instead of making them. Read-only calls are passed
+// through to the underlying plugins.
+type dryRunManager struct {
+	Manager
+	logger logrus.FieldLogger
+}
+
+// NewDryRunManager returns a Manager that gets plugins from manager, but whose object
+// stores and volume snapshotters don't write or delete any data.
+func NewDryRunManager(manager Manager, logger logrus.FieldLogger) Manager {
+	return &dryRunManager{
+		Manager: manager,
+		logger:  logger,
+	}
+}
+
+func (m *dryRunManager) GetObjectStore(name string) (velero.ObjectStore, error) {
+	objectStore, err := m.Manager.GetObjectStore(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dryRunObjectStore{
+		ObjectStore: objectStore,
+		log:         m.logger.WithField("objectStore", name),
+	}, nil
+}
+
+func (m *dryRunManager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error) {
+	volumeSnapshotter, err := m.Manager.GetVolumeSnapshotter(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dryRunVolumeSnapshotter{
+		VolumeSnapshotter: volumeSnapshotter,
+		log:               m.logger.WithField("volumeSnapshotter", name),
+	}, nil
+}
+
+// dryRunObjectStore is an object store that logs writes and deletes instead of
+// sending them to the underlying object store.
+type dryRunObjectStore struct {
+	velero.ObjectStore
+	log logrus.FieldLogger
+}
+
+func (o *dryRunObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	o.log.Infof("Dry run: not putting object %s in bucket %s", key, bucket)
+	return nil
+}
+
+func (o *dryRunObjectStore) DeleteObject(bucket, key string) error {
+	o.log.Infof("Dry run: not deleting object %s from bucket %s", key, bucket)
+	return nil
+}
+
+// dryRunVolumeSnapshotter is a volume snapshotter that logs the snapshots and
+// volumes it would create or delete instead of creating or deleting them. The IDs
+// it returns for snapshots and volumes are placeholders.
+type dryRunVolumeSnapshotter struct {
+	velero.VolumeSnapshotter
+	log logrus.FieldLogger
+}
+
+func (s *dryRunVolumeSnapshotter) CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ string, iops *int64) (string, error) {
+	s.log.Infof("Dry run: not creating volume from snapshot %s", snapshotID)
+	return dryRunIDPrefix + snapshotID, nil
+}
+
+func (s *dryRunVolumeSnapshotter) CreateSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, error) {
+	s.log.Infof("Dry run: not creating snapshot of volume %s", volumeID)
+	return dryRunIDPrefix + volumeID, nil
+}
+
+func (s *dryRunVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
+	s.log.Infof("Dry run: not deleting snapshot %s", snapshotID)
+	return nil
+}
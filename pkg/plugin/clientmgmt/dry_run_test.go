@@ -0,0 +1,98 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/cloudprovider"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// fakeManager is a Manager that returns a single object store and volume snapshotter.
+type fakeManager struct {
+	Manager
+	objectStore       velero.ObjectStore
+	volumeSnapshotter velero.VolumeSnapshotter
+}
+
+func (m *fakeManager) GetObjectStore(name string) (velero.ObjectStore, error) {
+	return m.objectStore, nil
+}
+
+func (m *fakeManager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error) {
+	return m.volumeSnapshotter, nil
+}
+
+func TestDryRunObjectStore(t *testing.T) {
+	objectStore := cloudprovider.NewInMemoryObjectStore("bucket")
+	objectStore.Data["bucket"]["existing"] = []byte("data")
+
+	manager := &fakeManager{objectStore: objectStore}
+
+	dryRunObjectStore, err := NewDryRunManager(manager, test.NewLogger()).GetObjectStore("aws")
+	require.NoError(t, err)
+
+	// writes and deletes are dropped
+	require.NoError(t, dryRunObjectStore.PutObject("bucket", "new", strings.NewReader("data")))
+	require.NoError(t, dryRunObjectStore.DeleteObject("bucket", "existing"))
+	assert.Equal(t, cloudprovider.BucketData{"existing": []byte("data")}, objectStore.Data["bucket"])
+
+	// reads are passed through
+	rc, err := dryRunObjectStore.GetObject("bucket", "existing")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	keys, err := dryRunObjectStore.ListObjects("bucket", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"existing"}, keys)
+}
+
+func TestDryRunVolumeSnapshotter(t *testing.T) {
+	volumeSnapshotter := &test.FakeVolumeSnapshotter{
+		SnapshotsTaken: sets.NewString("snap-1"),
+		SnapshottableVolumes: map[string]test.VolumeBackupInfo{
+			"vol-1": {SnapshotID: "snap-2"},
+		},
+	}
+
+	manager := &fakeManager{volumeSnapshotter: volumeSnapshotter}
+
+	dryRunVolumeSnapshotter, err := NewDryRunManager(manager, test.NewLogger()).GetVolumeSnapshotter("aws")
+	require.NoError(t, err)
+
+	snapshotID, err := dryRunVolumeSnapshotter.CreateSnapshot("vol-1", "zone-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "dry-run-vol-1", snapshotID)
+
+	volumeID, err := dryRunVolumeSnapshotter.CreateVolumeFromSnapshot("snap-1", "gp2", "zone-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "dry-run-snap-1", volumeID)
+
+	require.NoError(t, dryRunVolumeSnapshotter.DeleteSnapshot("snap-1"))
+
+	assert.Equal(t, sets.NewString("snap-1"), volumeSnapshotter.SnapshotsTaken)
+}
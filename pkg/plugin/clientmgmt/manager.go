@@ -17,11 +17,15 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"sort"
 	"strings"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
@@ -34,18 +38,41 @@ type Manager interface {
 	// GetVolumeSnapshotter returns the VolumeSnapshotter plugin for name.
 	GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error)
 
+	// GetKeyWrapper returns the KeyWrapper plugin for name.
+	GetKeyWrapper(name string) (velero.KeyWrapper, error)
+
 	// GetBackupItemActions returns all backup item action plugins.
 	GetBackupItemActions() ([]velero.BackupItemAction, error)
 
 	// GetBackupItemAction returns the backup item action plugin for name.
 	GetBackupItemAction(name string) (velero.BackupItemAction, error)
 
+	// GetBackupItemActionsV2 returns all backup item action plugins that can
+	// start asynchronous operations.
+	GetBackupItemActionsV2() ([]velero.BackupItemActionV2, error)
+
+	// GetBackupItemActionV2 returns the backup item action plugin that can
+	// start asynchronous operations for name.
+	GetBackupItemActionV2(name string) (velero.BackupItemActionV2, error)
+
+	// GetItemTransformers returns all item transformer plugins, sorted by
+	// name, which is the order that they're invoked in.
+	GetItemTransformers() ([]velero.ItemTransformer, error)
+
+	// GetItemTransformer returns the item transformer plugin for name.
+	GetItemTransformer(name string) (velero.ItemTransformer, error)
+
 	// GetRestoreItemActions returns all restore item action plugins.
 	GetRestoreItemActions() ([]velero.RestoreItemAction, error)
 
 	// GetRestoreItemAction returns the restore item action plugin for name.
 	GetRestoreItemAction(name string) (velero.RestoreItemAction, error)
 
+	// GetCredentialsFile returns the path of a file with the contents of the
+	// secret key that selector selects, for a location's ObjectStore or
+	// VolumeSnapshotter plugin to authenticate with.
+	GetCredentialsFile(selector *corev1api.SecretKeySelector) (string, error)
+
 	// CleanupClients terminates all of the Manager's running plugin processes.
 	CleanupClients()
 }
@@ -56,6 +83,8 @@ type manager struct {
 	logLevel logrus.Level
 	registry Registry
 
+	credentialFileStore credentials.FileStore
+
 	restartableProcessFactory RestartableProcessFactory
 
 	// lock guards restartableProcesses
@@ -63,19 +92,31 @@ type manager struct {
 	restartableProcesses map[string]RestartableProcess
 }
 
-// NewManager constructs a manager for getting plugins.
-func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry) Manager {
+// NewManager constructs a manager for getting plugins. credentialFileStore
+// gets the credentials files of locations that have a credential; it can be
+// nil if there aren't any.
+func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry, credentialFileStore credentials.FileStore) Manager {
 	return &manager{
 		logger:   logger,
 		logLevel: level,
 		registry: registry,
 
+		credentialFileStore: credentialFileStore,
+
 		restartableProcessFactory: newRestartableProcessFactory(),
 
 		restartableProcesses: make(map[string]RestartableProcess),
 	}
 }
 
+func (m *manager) GetCredentialsFile(selector *corev1api.SecretKeySelector) (string, error) {
+	if m.credentialFileStore == nil {
+		return "", errors.New("plugin manager has no credentials file store")
+	}
+
+	return m.credentialFileStore.Path(selector)
+}
+
 func (m *manager) CleanupClients() {
 	m.lock.Lock()
 
@@ -155,6 +196,18 @@ func (m *manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, e
 	return r, nil
 }
 
+// GetKeyWrapper returns a restartableKeyWrapper for name.
+func (m *manager) GetKeyWrapper(name string) (velero.KeyWrapper, error) {
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindKeyWrapper, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableKeyWrapper(name, restartableProcess)
+
+	return r, nil
+}
+
 // GetBackupItemActions returns all backup item actions as restartableBackupItemActions.
 func (m *manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
 	list := m.registry.List(framework.PluginKindBackupItemAction)
@@ -190,6 +243,73 @@ func (m *manager) GetBackupItemAction(name string) (velero.BackupItemAction, err
 	return r, nil
 }
 
+// GetBackupItemActionsV2 returns all backup item actions that can start asynchronous operations as
+// restartableBackupItemActionV2s.
+func (m *manager) GetBackupItemActionsV2() ([]velero.BackupItemActionV2, error) {
+	list := m.registry.List(framework.PluginKindBackupItemActionV2)
+
+	actions := make([]velero.BackupItemActionV2, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetBackupItemActionV2(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetBackupItemActionV2 returns a restartableBackupItemActionV2 for name.
+func (m *manager) GetBackupItemActionV2(name string) (velero.BackupItemActionV2, error) {
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindBackupItemActionV2, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableBackupItemActionV2(name, restartableProcess)
+	return r, nil
+}
+
+// GetItemTransformers returns all item transformers as restartableItemTransformers, sorted by name.
+func (m *manager) GetItemTransformers() ([]velero.ItemTransformer, error) {
+	list := m.registry.List(framework.PluginKindItemTransformer)
+
+	names := make([]string, 0, len(list))
+	for _, id := range list {
+		names = append(names, id.Name)
+	}
+	sort.Strings(names)
+
+	transformers := make([]velero.ItemTransformer, 0, len(names))
+
+	for _, name := range names {
+		r, err := m.GetItemTransformer(name)
+		if err != nil {
+			return nil, err
+		}
+
+		transformers = append(transformers, r)
+	}
+
+	return transformers, nil
+}
+
+// GetItemTransformer returns a restartableItemTransformer for name.
+func (m *manager) GetItemTransformer(name string) (velero.ItemTransformer, error) {
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindItemTransformer, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableItemTransformer(name, restartableProcess)
+	return r, nil
+}
+
 // GetRestoreItemActions returns all restore item actions as restartableRestoreItemActions.
 func (m *manager) GetRestoreItemActions() ([]velero.RestoreItemAction, error) {
 	list := m.registry.List(framework.PluginKindRestoreItemAction)
@@ -60,7 +60,7 @@ func TestNewManager(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, nil).(*manager)
 	assert.Equal(t, logger, m.logger)
 	assert.Equal(t, logLevel, m.logLevel)
 	assert.Equal(t, registry, m.registry)
@@ -115,7 +115,7 @@ func TestGetRestartableProcess(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, nil).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -161,7 +161,7 @@ func TestCleanupClients(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, nil).(*manager)
 
 	for i := 0; i < 5; i++ {
 		rp := &mockRestartableProcess{}
@@ -207,6 +207,23 @@ func TestGetVolumeSnapshotter(t *testing.T) {
 	)
 }
 
+func TestGetKeyWrapper(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindKeyWrapper,
+		"velero.io/aws-kms",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetKeyWrapper(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableKeyWrapper{
+				key:                 kindAndName{kind: framework.PluginKindKeyWrapper, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		true,
+	)
+}
+
 func TestGetBackupItemAction(t *testing.T) {
 	getPluginTest(t,
 		framework.PluginKindBackupItemAction,
@@ -255,7 +272,7 @@ func getPluginTest(
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, nil).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -292,6 +309,23 @@ func getPluginTest(
 	assert.Equal(t, expected, actual)
 }
 
+func TestGetItemTransformer(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindItemTransformer,
+		"velero.io/redact",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetItemTransformer(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableItemTransformer{
+				key:                 kindAndName{kind: framework.PluginKindItemTransformer, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
 func TestGetBackupItemActions(t *testing.T) {
 	tests := []struct {
 		name                       string
@@ -322,7 +356,7 @@ func TestGetBackupItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, nil).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -414,7 +448,7 @@ func TestGetRestoreItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, nil).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -87,3 +87,8 @@ func (r *restartableBackupItemAction) Execute(item runtime.Unstructured, backup
 
 	return delegate.Execute(item, backup)
 }
+
+// Name returns the name of the plugin that implements this backup item action.
+func (r *restartableBackupItemAction) Name() string {
+	return r.key.name
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartableItemTransformer is an item transformer for a given implementation. It is associated with a
+// restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartableItemTransformer asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartableItemTransformer struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+}
+
+// newRestartableItemTransformer returns a new restartableItemTransformer.
+func newRestartableItemTransformer(name string, sharedPluginProcess RestartableProcess) *restartableItemTransformer {
+	r := &restartableItemTransformer{
+		key:                 kindAndName{kind: framework.PluginKindItemTransformer, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getItemTransformer returns the item transformer for this restartableItemTransformer. It does *not* restart
+// the plugin process.
+func (r *restartableItemTransformer) getItemTransformer() (velero.ItemTransformer, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	itemTransformer, ok := plugin.(velero.ItemTransformer)
+	if !ok {
+		return nil, errors.Errorf("%T is not an ItemTransformer!", plugin)
+	}
+
+	return itemTransformer, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the item transformer for this
+// restartableItemTransformer.
+func (r *restartableItemTransformer) getDelegate() (velero.ItemTransformer, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getItemTransformer()
+}
+
+// AppliesTo restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemTransformer) AppliesTo() (velero.ResourceSelector, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.ResourceSelector{}, err
+	}
+
+	return delegate.AppliesTo()
+}
+
+// Transform restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemTransformer) Transform(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	return delegate.Transform(item, backup)
+}
+
+// Name returns the name of the plugin that implements this item transformer.
+func (r *restartableItemTransformer) Name() string {
+	return r.key.name
+}
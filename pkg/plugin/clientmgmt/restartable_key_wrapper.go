@@ -0,0 +1,120 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartableKeyWrapper is a key wrapper for a given implementation (such as "velero.io/aws-kms"). It is associated
+// with a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartableKeyWrapper asks its restartableProcess to restart itself if needed (e.g. if the process
+// terminated for any reason), then it proceeds with the actual call.
+type restartableKeyWrapper struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
+	// sharedPluginProcess gets restarted.
+	config map[string]string
+}
+
+// newRestartableKeyWrapper returns a new restartableKeyWrapper.
+func newRestartableKeyWrapper(name string, sharedPluginProcess RestartableProcess) *restartableKeyWrapper {
+	key := kindAndName{kind: framework.PluginKindKeyWrapper, name: name}
+	r := &restartableKeyWrapper{
+		key:                 key,
+		sharedPluginProcess: sharedPluginProcess,
+	}
+
+	// Register our reinitializer so we can reinitialize after a restart with r.config.
+	sharedPluginProcess.addReinitializer(key, r)
+
+	return r
+}
+
+// reinitialize reinitializes a re-dispensed plugin using the initial data passed to Init().
+func (r *restartableKeyWrapper) reinitialize(dispensed interface{}) error {
+	keyWrapper, ok := dispensed.(velero.KeyWrapper)
+	if !ok {
+		return errors.Errorf("%T is not a KeyWrapper!", dispensed)
+	}
+
+	return keyWrapper.Init(r.config)
+}
+
+// getKeyWrapper returns the key wrapper for this restartableKeyWrapper. It does *not* restart the plugin process.
+func (r *restartableKeyWrapper) getKeyWrapper() (velero.KeyWrapper, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyWrapper, ok := plugin.(velero.KeyWrapper)
+	if !ok {
+		return nil, errors.Errorf("%T is not a KeyWrapper!", plugin)
+	}
+
+	return keyWrapper, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the key wrapper for this restartableKeyWrapper.
+func (r *restartableKeyWrapper) getDelegate() (velero.KeyWrapper, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getKeyWrapper()
+}
+
+// Init initializes the key wrapper instance using config. If this is the first invocation, r stores config for future
+// reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
+func (r *restartableKeyWrapper) Init(config map[string]string) error {
+	if r.config != nil {
+		return errors.Errorf("already initialized")
+	}
+
+	// Not using getDelegate() to avoid possible infinite recursion
+	delegate, err := r.getKeyWrapper()
+	if err != nil {
+		return err
+	}
+
+	r.config = config
+
+	return delegate.Init(config)
+}
+
+// WrapKey restarts the plugin's process if needed, then delegates the call.
+func (r *restartableKeyWrapper) WrapKey(keyID string, dataKey []byte) ([]byte, string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, "", err
+	}
+	return delegate.WrapKey(keyID, dataKey)
+}
+
+// UnwrapKey restarts the plugin's process if needed, then delegates the call.
+func (r *restartableKeyWrapper) UnwrapKey(wrappingKeyID string, wrapped []byte) ([]byte, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.UnwrapKey(wrappingKeyID, wrapped)
+}
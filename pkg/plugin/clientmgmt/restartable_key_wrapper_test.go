@@ -0,0 +1,180 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudprovidermocks "github.com/vmware-tanzu/velero/pkg/cloudprovider/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+func TestRestartableGetKeyWrapper(t *testing.T) {
+	tests := []struct {
+		name          string
+		plugin        interface{}
+		getError      error
+		expectedError string
+	}{
+		{
+			name:          "error getting by kind and name",
+			getError:      errors.Errorf("get error"),
+			expectedError: "get error",
+		},
+		{
+			name:          "wrong type",
+			plugin:        3,
+			expectedError: "int is not a KeyWrapper!",
+		},
+		{
+			name:   "happy path",
+			plugin: new(cloudprovidermocks.KeyWrapper),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := new(mockRestartableProcess)
+			p.Test(t)
+			defer p.AssertExpectations(t)
+
+			name := "velero.io/aws-kms"
+			key := kindAndName{kind: framework.PluginKindKeyWrapper, name: name}
+			p.On("getByKindAndName", key).Return(tc.plugin, tc.getError)
+
+			r := &restartableKeyWrapper{
+				key:                 key,
+				sharedPluginProcess: p,
+			}
+			a, err := r.getKeyWrapper()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.plugin, a)
+		})
+	}
+}
+
+func TestRestartableKeyWrapperReinitialize(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "velero.io/aws-kms"
+	key := kindAndName{kind: framework.PluginKindKeyWrapper, name: name}
+	r := &restartableKeyWrapper{
+		key:                 key,
+		sharedPluginProcess: p,
+		config: map[string]string{
+			"region": "us-east-1",
+		},
+	}
+
+	err := r.reinitialize(3)
+	assert.EqualError(t, err, "int is not a KeyWrapper!")
+
+	keyWrapper := new(cloudprovidermocks.KeyWrapper)
+	keyWrapper.Test(t)
+	defer keyWrapper.AssertExpectations(t)
+
+	keyWrapper.On("Init", r.config).Return(errors.Errorf("init error")).Once()
+	err = r.reinitialize(keyWrapper)
+	assert.EqualError(t, err, "init error")
+
+	keyWrapper.On("Init", r.config).Return(nil)
+	err = r.reinitialize(keyWrapper)
+	assert.NoError(t, err)
+}
+
+func TestRestartableKeyWrapperInit(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	// getKeyWrapper error
+	name := "velero.io/aws-kms"
+	key := kindAndName{kind: framework.PluginKindKeyWrapper, name: name}
+	r := &restartableKeyWrapper{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+	p.On("getByKindAndName", key).Return(nil, errors.Errorf("getByKindAndName error")).Once()
+
+	config := map[string]string{
+		"region": "us-east-1",
+	}
+	err := r.Init(config)
+	assert.EqualError(t, err, "getByKindAndName error")
+
+	// Delegate returns error
+	keyWrapper := new(cloudprovidermocks.KeyWrapper)
+	keyWrapper.Test(t)
+	defer keyWrapper.AssertExpectations(t)
+	p.On("getByKindAndName", key).Return(keyWrapper, nil)
+	keyWrapper.On("Init", config).Return(errors.Errorf("Init error")).Once()
+
+	err = r.Init(config)
+	assert.EqualError(t, err, "Init error")
+
+	// wipe this out because the previous failed Init call set it
+	r.config = nil
+
+	// Happy path
+	keyWrapper.On("Init", config).Return(nil)
+	err = r.Init(config)
+	assert.NoError(t, err)
+	assert.Equal(t, config, r.config)
+
+	// Calling Init twice is forbidden
+	err = r.Init(config)
+	assert.EqualError(t, err, "already initialized")
+}
+
+func TestRestartableKeyWrapperDelegatedFunctions(t *testing.T) {
+	runRestartableDelegateTests(
+		t,
+		framework.PluginKindKeyWrapper,
+		func(key kindAndName, p RestartableProcess) interface{} {
+			return &restartableKeyWrapper{
+				key:                 key,
+				sharedPluginProcess: p,
+			}
+		},
+		func() mockable {
+			return new(cloudprovidermocks.KeyWrapper)
+		},
+		restartableDelegateTest{
+			function:                "WrapKey",
+			inputs:                  []interface{}{"key-id", []byte("data key")},
+			expectedErrorOutputs:    []interface{}{([]byte)(nil), "", errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{[]byte("wrapped"), "key-id/1", errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "UnwrapKey",
+			inputs:                  []interface{}{"key-id/1", []byte("wrapped")},
+			expectedErrorOutputs:    []interface{}{([]byte)(nil), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{[]byte("data key"), errors.Errorf("delegate error")},
+		},
+	)
+}
@@ -173,3 +173,33 @@ func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl
 	}
 	return delegate.CreateSignedURL(bucket, key, ttl)
 }
+
+// GetEncryptionStatus restarts the plugin's process if needed, then delegates the call
+// if the delegate implements velero.EncryptionStatusGetter.
+func (r *restartableObjectStore) GetEncryptionStatus(bucket string) (*velero.EncryptionStatus, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	getter, ok := delegate.(velero.EncryptionStatusGetter)
+	if !ok {
+		return nil, nil
+	}
+	return getter.GetEncryptionStatus(bucket)
+}
+
+// PutObjectIfNotExists restarts the plugin's process if needed, then delegates the call
+// if the delegate implements velero.ConditionalPutter.
+func (r *restartableObjectStore) PutObjectIfNotExists(bucket string, key string, body io.Reader) (bool, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return false, err
+	}
+
+	putter, ok := delegate.(velero.ConditionalPutter)
+	if !ok {
+		return false, velero.ErrConditionalPutNotSupported
+	}
+	return putter.PutObjectIfNotExists(bucket, key, body)
+}
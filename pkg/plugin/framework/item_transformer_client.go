@@ -0,0 +1,105 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// NewItemTransformerPlugin constructs an ItemTransformerPlugin.
+func NewItemTransformerPlugin(options ...PluginOption) *ItemTransformerPlugin {
+	return &ItemTransformerPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// ItemTransformerGRPCClient implements the ItemTransformer interface and uses a
+// gRPC client to make calls to the plugin server.
+type ItemTransformerGRPCClient struct {
+	*clientBase
+	grpcClient proto.ItemTransformerClient
+}
+
+func newItemTransformerGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &ItemTransformerGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewItemTransformerClient(clientConn),
+	}
+}
+
+func (c *ItemTransformerGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	req := &proto.ItemTransformerAppliesToRequest{
+		Plugin: c.plugin,
+	}
+
+	res, err := c.grpcClient.AppliesTo(context.Background(), req)
+	if err != nil {
+		return velero.ResourceSelector{}, fromGRPCError(err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+func (c *ItemTransformerGRPCClient) Transform(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, error) {
+	itemJSON, err := json.Marshal(item.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &proto.TransformRequest{
+		Plugin: c.plugin,
+		Item:   itemJSON,
+		Backup: backupJSON,
+	}
+
+	res, err := c.grpcClient.Transform(context.Background(), req)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	var transformedItem unstructured.Unstructured
+	if err := json.Unmarshal(res.Item, &transformedItem); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &transformedItem, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+)
+
+// NewKeyWrapperPlugin constructs a KeyWrapperPlugin.
+func NewKeyWrapperPlugin(options ...PluginOption) *KeyWrapperPlugin {
+	return &KeyWrapperPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// KeyWrapperGRPCClient implements the KeyWrapper interface and uses a
+// gRPC client to make calls to the plugin server.
+type KeyWrapperGRPCClient struct {
+	*clientBase
+	grpcClient proto.KeyWrapperClient
+}
+
+func newKeyWrapperGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &KeyWrapperGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewKeyWrapperClient(clientConn),
+	}
+}
+
+// Init prepares the KeyWrapper for usage using the provided map of
+// configuration key-value pairs. It returns an error if the KeyWrapper
+// cannot be initialized from the provided config.
+func (c *KeyWrapperGRPCClient) Init(config map[string]string) error {
+	req := &proto.KeyWrapperInitRequest{
+		Plugin: c.plugin,
+		Config: config,
+	}
+
+	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
+
+// WrapKey encrypts dataKey with the key identified by keyID, and returns
+// the wrapped data key and the fully-qualified ID of the key that wrapped it.
+func (c *KeyWrapperGRPCClient) WrapKey(keyID string, dataKey []byte) ([]byte, string, error) {
+	req := &proto.WrapKeyRequest{
+		Plugin:  c.plugin,
+		KeyID:   keyID,
+		DataKey: dataKey,
+	}
+
+	res, err := c.grpcClient.WrapKey(context.Background(), req)
+	if err != nil {
+		return nil, "", fromGRPCError(err)
+	}
+
+	return res.Wrapped, res.WrappingKeyID, nil
+}
+
+// UnwrapKey decrypts a data key that was wrapped by the key identified by
+// wrappingKeyID.
+func (c *KeyWrapperGRPCClient) UnwrapKey(wrappingKeyID string, wrapped []byte) ([]byte, error) {
+	req := &proto.UnwrapKeyRequest{
+		Plugin:        c.plugin,
+		WrappingKeyID: wrappingKeyID,
+		Wrapped:       wrapped,
+	}
+
+	res, err := c.grpcClient.UnwrapKey(context.Background(), req)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	return res.DataKey, nil
+}
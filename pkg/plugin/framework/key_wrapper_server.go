@@ -0,0 +1,111 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// KeyWrapperGRPCServer implements the proto-generated KeyWrapperServer interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type KeyWrapperGRPCServer struct {
+	mux *serverMux
+}
+
+func (s *KeyWrapperGRPCServer) getImpl(name string) (velero.KeyWrapper, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	keyWrapper, ok := impl.(velero.KeyWrapper)
+	if !ok {
+		return nil, errors.Errorf("%T is not a key wrapper", impl)
+	}
+
+	return keyWrapper, nil
+}
+
+// Init prepares the KeyWrapper for usage using the provided map of
+// configuration key-value pairs. It returns an error if the KeyWrapper
+// cannot be initialized from the provided config.
+func (s *KeyWrapperGRPCServer) Init(ctx context.Context, req *proto.KeyWrapperInitRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	if err := impl.Init(req.Config); err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// WrapKey encrypts a data key with the key identified by the request's key
+// ID, and returns the wrapped data key and the ID of the key that wrapped it.
+func (s *KeyWrapperGRPCServer) WrapKey(ctx context.Context, req *proto.WrapKeyRequest) (response *proto.WrapKeyResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	wrapped, wrappingKeyID, err := impl.WrapKey(req.KeyID, req.DataKey)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.WrapKeyResponse{Wrapped: wrapped, WrappingKeyID: wrappingKeyID}, nil
+}
+
+// UnwrapKey decrypts a data key that was wrapped by the key identified by the
+// request's wrapping key ID.
+func (s *KeyWrapperGRPCServer) UnwrapKey(ctx context.Context, req *proto.UnwrapKeyRequest) (response *proto.UnwrapKeyResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	dataKey, err := impl.UnwrapKey(req.WrappingKeyID, req.Wrapped)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.UnwrapKeyResponse{DataKey: dataKey}, nil
+}
@@ -23,8 +23,11 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -218,3 +221,73 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 
 	return res.Url, nil
 }
+
+// GetEncryptionStatus returns the encryption at rest of objects written to the
+// specified bucket. It returns a nil status if the plugin doesn't report it,
+// including if the plugin was built against an older version of Velero.
+func (c *ObjectStoreGRPCClient) GetEncryptionStatus(bucket string) (*velero.EncryptionStatus, error) {
+	req := &proto.GetEncryptionStatusRequest{
+		Plugin: c.plugin,
+		Bucket: bucket,
+	}
+
+	res, err := c.grpcClient.GetEncryptionStatus(context.Background(), req)
+	if status.Code(err) == codes.Unimplemented {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	return &velero.EncryptionStatus{
+		Enabled:   res.Enabled,
+		Algorithm: res.Algorithm,
+		KeyID:     res.KeyID,
+	}, nil
+}
+
+// PutObjectIfNotExists creates a new object using the data in body within the
+// specified object storage bucket with the given key, unless there's already an
+// object with the key. It returns velero.ErrConditionalPutNotSupported if the
+// plugin doesn't support conditional writes, including if the plugin was built
+// against an older version of Velero.
+func (c *ObjectStoreGRPCClient) PutObjectIfNotExists(bucket, key string, body io.Reader) (bool, error) {
+	stream, err := c.grpcClient.PutObjectIfNotExists(context.Background())
+	if err != nil {
+		return false, fromGRPCError(err)
+	}
+
+	closeAndRecv := func() (bool, error) {
+		res, err := stream.CloseAndRecv()
+		if status.Code(err) == codes.Unimplemented {
+			return false, velero.ErrConditionalPutNotSupported
+		}
+		if err != nil {
+			return false, fromGRPCError(err)
+		}
+		return res.Created, nil
+	}
+
+	// read from the provider io.Reader into chunks, and send each one over
+	// the gRPC stream
+	chunk := make([]byte, byteChunkSize)
+	for {
+		n, err := body.Read(chunk)
+		if err == io.EOF {
+			return closeAndRecv()
+		}
+		if err != nil {
+			stream.CloseSend()
+			return false, errors.WithStack(err)
+		}
+
+		if err := stream.Send(&proto.PutObjectRequest{Plugin: c.plugin, Bucket: bucket, Key: key, Body: chunk[0:n]}); err != nil {
+			if err == io.EOF {
+				// the server has ended the stream, e.g. because it doesn't
+				// support conditional writes, so get its status.
+				return closeAndRecv()
+			}
+			return false, fromGRPCError(err)
+		}
+	}
+}
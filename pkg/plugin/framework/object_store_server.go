@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -270,3 +271,108 @@ func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.
 
 	return &proto.CreateSignedURLResponse{Url: url}, nil
 }
+
+// GetEncryptionStatus returns the encryption at rest of objects written to the
+// specified bucket, if the ObjectStore implements velero.EncryptionStatusGetter.
+// Otherwise, it returns an error with a code of Unimplemented.
+func (s *ObjectStoreGRPCServer) GetEncryptionStatus(ctx context.Context, req *proto.GetEncryptionStatusRequest) (response *proto.GetEncryptionStatusResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	getter, ok := impl.(velero.EncryptionStatusGetter)
+	if !ok {
+		return nil, newGRPCErrorWithCode(errors.Errorf("%T doesn't report encryption status", impl), codes.Unimplemented)
+	}
+
+	encryptionStatus, err := getter.GetEncryptionStatus(req.Bucket)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+	if encryptionStatus == nil {
+		return nil, newGRPCErrorWithCode(errors.New("encryption status can't be determined"), codes.Unimplemented)
+	}
+
+	return &proto.GetEncryptionStatusResponse{
+		Enabled:   encryptionStatus.Enabled,
+		Algorithm: encryptionStatus.Algorithm,
+		KeyID:     encryptionStatus.KeyID,
+	}, nil
+}
+
+// PutObjectIfNotExists creates a new object using the data in body within the
+// specified object storage bucket with the given key, unless there's already an
+// object with the key, if the ObjectStore implements velero.ConditionalPutter.
+// Otherwise, it returns an error with a code of Unimplemented.
+func (s *ObjectStoreGRPCServer) PutObjectIfNotExists(stream proto.ObjectStore_PutObjectIfNotExistsServer) (err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	// we need to read the first chunk ahead of time to get the bucket and key;
+	// in our receive method, we'll use `first` on the first call
+	firstChunk, err := stream.Recv()
+	if err != nil {
+		return newGRPCError(errors.WithStack(err))
+	}
+
+	impl, err := s.getImpl(firstChunk.Plugin)
+	if err != nil {
+		return newGRPCError(err)
+	}
+
+	putter, ok := impl.(velero.ConditionalPutter)
+	if !ok {
+		return newGRPCErrorWithCode(errors.Errorf("%T doesn't support conditional writes", impl), codes.Unimplemented)
+	}
+
+	bucket := firstChunk.Bucket
+	key := firstChunk.Key
+
+	receive := func() ([]byte, error) {
+		if firstChunk != nil {
+			res := firstChunk.Body
+			firstChunk = nil
+			return res, nil
+		}
+
+		data, err := stream.Recv()
+		if err == io.EOF {
+			// we need to return io.EOF errors unwrapped so that
+			// calling code sees them as io.EOF and knows to stop
+			// reading.
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return data.Body, nil
+	}
+
+	close := func() error {
+		return nil
+	}
+
+	created, err := putter.PutObjectIfNotExists(bucket, key, &StreamReadCloser{receive: receive, close: close})
+	if err == velero.ErrConditionalPutNotSupported {
+		return newGRPCErrorWithCode(err, codes.Unimplemented)
+	}
+	if err != nil {
+		return newGRPCError(err)
+	}
+
+	if err := stream.SendAndClose(&proto.PutObjectIfNotExistsResponse{Created: created}); err != nil {
+		return newGRPCError(errors.WithStack(err))
+	}
+
+	return nil
+}
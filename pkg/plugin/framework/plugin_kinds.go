@@ -35,6 +35,16 @@ const (
 	// PluginKindBackupItemAction represents a backup item action plugin.
 	PluginKindBackupItemAction PluginKind = "BackupItemAction"
 
+	// PluginKindBackupItemActionV2 represents a backup item action plugin
+	// that can start asynchronous operations.
+	PluginKindBackupItemActionV2 PluginKind = "BackupItemActionV2"
+
+	// PluginKindItemTransformer represents an item transformer plugin.
+	PluginKindItemTransformer PluginKind = "ItemTransformer"
+
+	// PluginKindKeyWrapper represents a key wrapper plugin.
+	PluginKindKeyWrapper PluginKind = "KeyWrapper"
+
 	// PluginKindRestoreItemAction represents a restore item action plugin.
 	PluginKindRestoreItemAction PluginKind = "RestoreItemAction"
 
@@ -49,6 +59,9 @@ func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds[PluginKindObjectStore.String()] = PluginKindObjectStore
 	allPluginKinds[PluginKindVolumeSnapshotter.String()] = PluginKindVolumeSnapshotter
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
+	allPluginKinds[PluginKindBackupItemActionV2.String()] = PluginKindBackupItemActionV2
+	allPluginKinds[PluginKindItemTransformer.String()] = PluginKindItemTransformer
+	allPluginKinds[PluginKindKeyWrapper.String()] = PluginKindKeyWrapper
 	allPluginKinds[PluginKindRestoreItemAction.String()] = PluginKindRestoreItemAction
 	return allPluginKinds
 }
@@ -27,6 +27,7 @@ func TestPluginImplementationsAreGRPCPlugins(t *testing.T) {
 	pluginImpls := []interface{}{
 		new(VolumeSnapshotterPlugin),
 		new(BackupItemActionPlugin),
+		new(KeyWrapperPlugin),
 		new(ObjectStorePlugin),
 		new(PluginListerPlugin),
 		new(RestoreItemActionPlugin),
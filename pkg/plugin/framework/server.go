@@ -45,6 +45,29 @@ type Server interface {
 	// RegisterBackupItemActions registers multiple backup item actions.
 	RegisterBackupItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterBackupItemActionV2 registers a backup item action that can start
+	// asynchronous operations. Accepted format for the plugin name is
+	// <DNS subdomain>/<non-empty name>.
+	RegisterBackupItemActionV2(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterBackupItemActionsV2 registers multiple backup item actions that
+	// can start asynchronous operations.
+	RegisterBackupItemActionsV2(map[string]HandlerInitializer) Server
+
+	// RegisterItemTransformer registers an item transformer. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterItemTransformer(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterItemTransformers registers multiple item transformers.
+	RegisterItemTransformers(map[string]HandlerInitializer) Server
+
+	// RegisterKeyWrapper registers a key wrapper. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterKeyWrapper(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterKeyWrappers registers multiple key wrappers.
+	RegisterKeyWrappers(map[string]HandlerInitializer) Server
+
 	// RegisterVolumeSnapshotter registers a volume snapshotter. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterVolumeSnapshotter(pluginName string, initializer HandlerInitializer) Server
@@ -72,13 +95,16 @@ type Server interface {
 
 // server implements Server.
 type server struct {
-	log               *logrus.Logger
-	logLevelFlag      *logging.LevelFlag
-	flagSet           *pflag.FlagSet
-	backupItemAction  *BackupItemActionPlugin
-	volumeSnapshotter *VolumeSnapshotterPlugin
-	objectStore       *ObjectStorePlugin
-	restoreItemAction *RestoreItemActionPlugin
+	log                *logrus.Logger
+	logLevelFlag       *logging.LevelFlag
+	flagSet            *pflag.FlagSet
+	backupItemAction   *BackupItemActionPlugin
+	backupItemActionV2 *BackupItemActionV2Plugin
+	itemTransformer    *ItemTransformerPlugin
+	keyWrapper         *KeyWrapperPlugin
+	volumeSnapshotter  *VolumeSnapshotterPlugin
+	objectStore        *ObjectStorePlugin
+	restoreItemAction  *RestoreItemActionPlugin
 }
 
 // NewServer returns a new Server
@@ -86,12 +112,15 @@ func NewServer() Server {
 	log := newLogger()
 
 	return &server{
-		log:               log,
-		logLevelFlag:      logging.LogLevelFlag(log.Level),
-		backupItemAction:  NewBackupItemActionPlugin(serverLogger(log)),
-		volumeSnapshotter: NewVolumeSnapshotterPlugin(serverLogger(log)),
-		objectStore:       NewObjectStorePlugin(serverLogger(log)),
-		restoreItemAction: NewRestoreItemActionPlugin(serverLogger(log)),
+		log:                log,
+		logLevelFlag:       logging.LogLevelFlag(log.Level),
+		backupItemAction:   NewBackupItemActionPlugin(serverLogger(log)),
+		backupItemActionV2: NewBackupItemActionV2Plugin(serverLogger(log)),
+		itemTransformer:    NewItemTransformerPlugin(serverLogger(log)),
+		keyWrapper:         NewKeyWrapperPlugin(serverLogger(log)),
+		volumeSnapshotter:  NewVolumeSnapshotterPlugin(serverLogger(log)),
+		objectStore:        NewObjectStorePlugin(serverLogger(log)),
+		restoreItemAction:  NewRestoreItemActionPlugin(serverLogger(log)),
 	}
 }
 
@@ -114,6 +143,42 @@ func (s *server) RegisterBackupItemActions(m map[string]HandlerInitializer) Serv
 	return s
 }
 
+func (s *server) RegisterBackupItemActionV2(name string, initializer HandlerInitializer) Server {
+	s.backupItemActionV2.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterBackupItemActionsV2(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterBackupItemActionV2(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterItemTransformer(name string, initializer HandlerInitializer) Server {
+	s.itemTransformer.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterItemTransformers(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterItemTransformer(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterKeyWrapper(name string, initializer HandlerInitializer) Server {
+	s.keyWrapper.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterKeyWrappers(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterKeyWrapper(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterVolumeSnapshotter(name string, initializer HandlerInitializer) Server {
 	s.volumeSnapshotter.register(name, initializer)
 	return s
@@ -175,6 +240,9 @@ func (s *server) Serve() {
 
 	var pluginIdentifiers []PluginIdentifier
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemAction, s.backupItemAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemActionV2, s.backupItemActionV2)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindItemTransformer, s.itemTransformer)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindKeyWrapper, s.keyWrapper)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindVolumeSnapshotter, s.volumeSnapshotter)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindObjectStore, s.objectStore)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreItemAction, s.restoreItemAction)...)
@@ -184,11 +252,14 @@ func (s *server) Serve() {
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: Handshake(),
 		Plugins: map[string]plugin.Plugin{
-			string(PluginKindBackupItemAction):  s.backupItemAction,
-			string(PluginKindVolumeSnapshotter): s.volumeSnapshotter,
-			string(PluginKindObjectStore):       s.objectStore,
-			string(PluginKindPluginLister):      NewPluginListerPlugin(pluginLister),
-			string(PluginKindRestoreItemAction): s.restoreItemAction,
+			string(PluginKindBackupItemAction):   s.backupItemAction,
+			string(PluginKindBackupItemActionV2): s.backupItemActionV2,
+			string(PluginKindItemTransformer):    s.itemTransformer,
+			string(PluginKindKeyWrapper):         s.keyWrapper,
+			string(PluginKindVolumeSnapshotter):  s.volumeSnapshotter,
+			string(PluginKindObjectStore):        s.objectStore,
+			string(PluginKindPluginLister):       NewPluginListerPlugin(pluginLister),
+			string(PluginKindRestoreItemAction):  s.restoreItemAction,
 		},
 		GRPCServer: plugin.DefaultGRPCServer,
 	})
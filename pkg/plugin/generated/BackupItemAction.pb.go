@@ -6,6 +6,8 @@ Package generated is a generated protocol buffer package.
 
 It is generated from these files:
 	BackupItemAction.proto
+	ItemTransformer.proto
+	KeyWrapper.proto
 	ObjectStore.proto
 	PluginLister.proto
 	RestoreItemAction.proto
@@ -17,6 +19,20 @@ It has these top-level messages:
 	ExecuteResponse
 	BackupItemActionAppliesToRequest
 	BackupItemActionAppliesToResponse
+	BackupItemActionV2ExecuteResponse
+	OperationProgress
+	BackupItemActionProgressRequest
+	BackupItemActionProgressResponse
+	BackupItemActionCancelRequest
+	TransformRequest
+	TransformResponse
+	ItemTransformerAppliesToRequest
+	ItemTransformerAppliesToResponse
+	KeyWrapperInitRequest
+	WrapKeyRequest
+	WrapKeyResponse
+	UnwrapKeyRequest
+	UnwrapKeyResponse
 	PutObjectRequest
 	ObjectExistsRequest
 	ObjectExistsResponse
@@ -30,6 +46,8 @@ It has these top-level messages:
 	CreateSignedURLRequest
 	CreateSignedURLResponse
 	ObjectStoreInitRequest
+	GetEncryptionStatusRequest
+	GetEncryptionStatusResponse
 	PluginIdentifier
 	ListPluginsResponse
 	RestoreItemActionExecuteRequest
@@ -168,11 +186,192 @@ func (m *BackupItemActionAppliesToResponse) GetResourceSelector() *ResourceSelec
 	return nil
 }
 
+type BackupItemActionV2ExecuteResponse struct {
+	Item            []byte                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	AdditionalItems []*ResourceIdentifier `protobuf:"bytes,2,rep,name=additionalItems" json:"additionalItems,omitempty"`
+	OperationID     string                `protobuf:"bytes,3,opt,name=operationID" json:"operationID,omitempty"`
+}
+
+func (m *BackupItemActionV2ExecuteResponse) Reset()         { *m = BackupItemActionV2ExecuteResponse{} }
+func (m *BackupItemActionV2ExecuteResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionV2ExecuteResponse) ProtoMessage()    {}
+func (*BackupItemActionV2ExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{4}
+}
+
+func (m *BackupItemActionV2ExecuteResponse) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *BackupItemActionV2ExecuteResponse) GetAdditionalItems() []*ResourceIdentifier {
+	if m != nil {
+		return m.AdditionalItems
+	}
+	return nil
+}
+
+func (m *BackupItemActionV2ExecuteResponse) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type OperationProgress struct {
+	Completed      bool   `protobuf:"varint,1,opt,name=completed" json:"completed,omitempty"`
+	Err            string `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	NCompleted     int64  `protobuf:"varint,3,opt,name=nCompleted" json:"nCompleted,omitempty"`
+	NTotal         int64  `protobuf:"varint,4,opt,name=nTotal" json:"nTotal,omitempty"`
+	OperationUnits string `protobuf:"bytes,5,opt,name=operationUnits" json:"operationUnits,omitempty"`
+	Description    string `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
+}
+
+func (m *OperationProgress) Reset()                    { *m = OperationProgress{} }
+func (m *OperationProgress) String() string            { return proto.CompactTextString(m) }
+func (*OperationProgress) ProtoMessage()               {}
+func (*OperationProgress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *OperationProgress) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *OperationProgress) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetNCompleted() int64 {
+	if m != nil {
+		return m.NCompleted
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetNTotal() int64 {
+	if m != nil {
+		return m.NTotal
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetOperationUnits() string {
+	if m != nil {
+		return m.OperationUnits
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type BackupItemActionProgressRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+	Backup      []byte `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *BackupItemActionProgressRequest) Reset()         { *m = BackupItemActionProgressRequest{} }
+func (m *BackupItemActionProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionProgressRequest) ProtoMessage()    {}
+func (*BackupItemActionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{6}
+}
+
+func (m *BackupItemActionProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupItemActionProgressRequest) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *BackupItemActionProgressRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+type BackupItemActionProgressResponse struct {
+	Progress *OperationProgress `protobuf:"bytes,1,opt,name=progress" json:"progress,omitempty"`
+}
+
+func (m *BackupItemActionProgressResponse) Reset()         { *m = BackupItemActionProgressResponse{} }
+func (m *BackupItemActionProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionProgressResponse) ProtoMessage()    {}
+func (*BackupItemActionProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{7}
+}
+
+func (m *BackupItemActionProgressResponse) GetProgress() *OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type BackupItemActionCancelRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+	Backup      []byte `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *BackupItemActionCancelRequest) Reset()         { *m = BackupItemActionCancelRequest{} }
+func (m *BackupItemActionCancelRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionCancelRequest) ProtoMessage()    {}
+func (*BackupItemActionCancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{8}
+}
+
+func (m *BackupItemActionCancelRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupItemActionCancelRequest) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *BackupItemActionCancelRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ExecuteRequest)(nil), "generated.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "generated.ExecuteResponse")
 	proto.RegisterType((*BackupItemActionAppliesToRequest)(nil), "generated.BackupItemActionAppliesToRequest")
 	proto.RegisterType((*BackupItemActionAppliesToResponse)(nil), "generated.BackupItemActionAppliesToResponse")
+	proto.RegisterType((*BackupItemActionV2ExecuteResponse)(nil), "generated.BackupItemActionV2ExecuteResponse")
+	proto.RegisterType((*OperationProgress)(nil), "generated.OperationProgress")
+	proto.RegisterType((*BackupItemActionProgressRequest)(nil), "generated.BackupItemActionProgressRequest")
+	proto.RegisterType((*BackupItemActionProgressResponse)(nil), "generated.BackupItemActionProgressResponse")
+	proto.RegisterType((*BackupItemActionCancelRequest)(nil), "generated.BackupItemActionCancelRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -280,27 +479,204 @@ var _BackupItemAction_serviceDesc = grpc.ServiceDesc{
 	Metadata: "BackupItemAction.proto",
 }
 
+// Client API for BackupItemActionV2 service
+
+type BackupItemActionV2Client interface {
+	AppliesTo(ctx context.Context, in *BackupItemActionAppliesToRequest, opts ...grpc.CallOption) (*BackupItemActionAppliesToResponse, error)
+	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*BackupItemActionV2ExecuteResponse, error)
+	Progress(ctx context.Context, in *BackupItemActionProgressRequest, opts ...grpc.CallOption) (*BackupItemActionProgressResponse, error)
+	Cancel(ctx context.Context, in *BackupItemActionCancelRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type backupItemActionV2Client struct {
+	cc *grpc.ClientConn
+}
+
+func NewBackupItemActionV2Client(cc *grpc.ClientConn) BackupItemActionV2Client {
+	return &backupItemActionV2Client{cc}
+}
+
+func (c *backupItemActionV2Client) AppliesTo(ctx context.Context, in *BackupItemActionAppliesToRequest, opts ...grpc.CallOption) (*BackupItemActionAppliesToResponse, error) {
+	out := new(BackupItemActionAppliesToResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/AppliesTo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupItemActionV2Client) Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*BackupItemActionV2ExecuteResponse, error) {
+	out := new(BackupItemActionV2ExecuteResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/Execute", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupItemActionV2Client) Progress(ctx context.Context, in *BackupItemActionProgressRequest, opts ...grpc.CallOption) (*BackupItemActionProgressResponse, error) {
+	out := new(BackupItemActionProgressResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/Progress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupItemActionV2Client) Cancel(ctx context.Context, in *BackupItemActionCancelRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/Cancel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for BackupItemActionV2 service
+
+type BackupItemActionV2Server interface {
+	AppliesTo(context.Context, *BackupItemActionAppliesToRequest) (*BackupItemActionAppliesToResponse, error)
+	Execute(context.Context, *ExecuteRequest) (*BackupItemActionV2ExecuteResponse, error)
+	Progress(context.Context, *BackupItemActionProgressRequest) (*BackupItemActionProgressResponse, error)
+	Cancel(context.Context, *BackupItemActionCancelRequest) (*Empty, error)
+}
+
+func RegisterBackupItemActionV2Server(s *grpc.Server, srv BackupItemActionV2Server) {
+	s.RegisterService(&_BackupItemActionV2_serviceDesc, srv)
+}
+
+func _BackupItemActionV2_AppliesTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionAppliesToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).AppliesTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/AppliesTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).AppliesTo(ctx, req.(*BackupItemActionAppliesToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupItemActionV2_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).Execute(ctx, req.(*ExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupItemActionV2_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).Progress(ctx, req.(*BackupItemActionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupItemActionV2_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).Cancel(ctx, req.(*BackupItemActionCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BackupItemActionV2_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.BackupItemActionV2",
+	HandlerType: (*BackupItemActionV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AppliesTo",
+			Handler:    _BackupItemActionV2_AppliesTo_Handler,
+		},
+		{
+			MethodName: "Execute",
+			Handler:    _BackupItemActionV2_Execute_Handler,
+		},
+		{
+			MethodName: "Progress",
+			Handler:    _BackupItemActionV2_Progress_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _BackupItemActionV2_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "BackupItemAction.proto",
+}
+
 func init() { proto.RegisterFile("BackupItemAction.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0x49, 0x2b, 0x95, 0x4e, 0x8b, 0x2d, 0x7b, 0x28, 0x31, 0x22, 0xc4, 0x9c, 0x02, 0x4a,
-	0x0e, 0xf1, 0xe6, 0xc9, 0x0a, 0x52, 0x7a, 0xdd, 0xf6, 0x05, 0xd2, 0x64, 0x5a, 0x17, 0xd3, 0xdd,
-	0x75, 0x77, 0x03, 0x3e, 0x9c, 0x0f, 0x27, 0xd9, 0x6e, 0x43, 0x8c, 0xc5, 0x7a, 0xcb, 0x64, 0xe6,
-	0xff, 0xe7, 0xfb, 0xd9, 0x81, 0xd9, 0x4b, 0x96, 0xbf, 0x57, 0x72, 0x69, 0x70, 0x3f, 0xcf, 0x0d,
-	0x13, 0x3c, 0x91, 0x4a, 0x18, 0x41, 0x86, 0x3b, 0xe4, 0xa8, 0x32, 0x83, 0x45, 0x30, 0x5e, 0xbd,
-	0x65, 0x0a, 0x8b, 0x43, 0x23, 0x5a, 0xc3, 0xd5, 0xeb, 0x27, 0xe6, 0x95, 0x41, 0x8a, 0x1f, 0x15,
-	0x6a, 0x43, 0x66, 0x30, 0x90, 0x65, 0xb5, 0x63, 0xdc, 0xf7, 0x42, 0x2f, 0x1e, 0x52, 0x57, 0x11,
-	0x02, 0x17, 0xcc, 0xe0, 0xde, 0xef, 0x85, 0x5e, 0x3c, 0xa6, 0xf6, 0xbb, 0x9e, 0xdd, 0xd8, 0x85,
-	0x7e, 0xdf, 0xfe, 0x75, 0x55, 0xc4, 0x61, 0xd2, 0xb8, 0x6a, 0x29, 0xb8, 0xc6, 0x46, 0xee, 0xb5,
-	0xe4, 0x0b, 0x98, 0x64, 0x45, 0xc1, 0x6a, 0xce, 0xac, 0xac, 0x99, 0xb5, 0xdf, 0x0b, 0xfb, 0xf1,
-	0x28, 0xbd, 0x4d, 0x1a, 0xde, 0x84, 0xa2, 0x16, 0x95, 0xca, 0x71, 0x59, 0x20, 0x37, 0x6c, 0xcb,
-	0x50, 0xd1, 0xae, 0x2a, 0x7a, 0x82, 0xb0, 0x1b, 0x7c, 0x2e, 0x65, 0xc9, 0x50, 0xaf, 0xc5, 0x99,
-	0x5c, 0x51, 0x09, 0x77, 0x7f, 0x68, 0x1d, 0xfd, 0x02, 0xa6, 0x47, 0x8e, 0x15, 0x96, 0x98, 0x1b,
-	0xa1, 0xac, 0xcd, 0x28, 0xbd, 0x39, 0x81, 0x7a, 0x1c, 0xa1, 0xbf, 0x44, 0xe9, 0x97, 0x07, 0xd3,
-	0xee, 0x3a, 0xb2, 0x85, 0x61, 0xb3, 0x92, 0xdc, 0xb7, 0x0c, 0xcf, 0x85, 0x0a, 0x1e, 0xfe, 0x37,
-	0xec, 0x52, 0x3c, 0xc3, 0xa5, 0x7b, 0x16, 0x72, 0xdd, 0x12, 0xfe, 0x3c, 0x80, 0x20, 0x38, 0xd5,
-	0x3a, 0x38, 0x6c, 0x06, 0xf6, 0x6a, 0x1e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x45, 0xdb, 0x5d,
-	0x9f, 0x68, 0x02, 0x00, 0x00,
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x72, 0xd3, 0x3e,
+	0x10, 0x1e, 0x27, 0xfd, 0xe5, 0x17, 0x6f, 0x3a, 0x6d, 0xd0, 0xa1, 0x63, 0x4c, 0x0b, 0xc6, 0x07,
+	0x26, 0x43, 0x99, 0x1c, 0xc2, 0x85, 0xe1, 0x44, 0x69, 0x99, 0x4e, 0x4e, 0x30, 0x6a, 0xe0, 0xc4,
+	0xc5, 0xb5, 0xb7, 0x41, 0x83, 0x23, 0xa9, 0x92, 0x3c, 0x03, 0xaf, 0xc3, 0x73, 0x70, 0xe5, 0xa9,
+	0xb8, 0x30, 0x56, 0x14, 0xe3, 0xda, 0x6d, 0x92, 0x0b, 0xbd, 0x59, 0xab, 0xfd, 0x76, 0xbf, 0x6f,
+	0xff, 0xc8, 0x70, 0xf0, 0x36, 0x49, 0xbf, 0x16, 0x72, 0x6a, 0x70, 0x71, 0x92, 0x1a, 0x26, 0xf8,
+	0x58, 0x2a, 0x61, 0x04, 0xf1, 0xe7, 0xc8, 0x51, 0x25, 0x06, 0xb3, 0x70, 0xf7, 0xe2, 0x4b, 0xa2,
+	0x30, 0x5b, 0x5e, 0xc4, 0x33, 0xd8, 0x7b, 0xf7, 0x0d, 0xd3, 0xc2, 0x20, 0xc5, 0xeb, 0x02, 0xb5,
+	0x21, 0x07, 0xd0, 0x93, 0x79, 0x31, 0x67, 0x3c, 0xf0, 0x22, 0x6f, 0xe4, 0x53, 0x77, 0x22, 0x04,
+	0x76, 0x98, 0xc1, 0x45, 0xd0, 0x89, 0xbc, 0xd1, 0x2e, 0xb5, 0xdf, 0xa5, 0xef, 0xa5, 0x4d, 0x18,
+	0x74, 0xad, 0xd5, 0x9d, 0x62, 0x0e, 0xfb, 0x55, 0x54, 0x2d, 0x05, 0xd7, 0x58, 0xc1, 0xbd, 0x1a,
+	0xfc, 0x1c, 0xf6, 0x93, 0x2c, 0x63, 0x25, 0xcf, 0x24, 0x2f, 0x39, 0xeb, 0xa0, 0x13, 0x75, 0x47,
+	0x83, 0xc9, 0xd1, 0xb8, 0xe2, 0x3b, 0xa6, 0xa8, 0x45, 0xa1, 0x52, 0x9c, 0x66, 0xc8, 0x0d, 0xbb,
+	0x62, 0xa8, 0x68, 0x13, 0x15, 0xbf, 0x86, 0xa8, 0x29, 0xfc, 0x44, 0xca, 0x9c, 0xa1, 0x9e, 0x89,
+	0x0d, 0xba, 0xe2, 0x1c, 0x9e, 0xae, 0xc1, 0x3a, 0xf6, 0xe7, 0x30, 0x5c, 0xf1, 0xb8, 0xc0, 0x1c,
+	0x53, 0x23, 0x94, 0x0d, 0x33, 0x98, 0x3c, 0xba, 0x85, 0xea, 0xca, 0x85, 0xb6, 0x40, 0xf1, 0x0f,
+	0xaf, 0x9d, 0xee, 0xd3, 0xe4, 0x3e, 0x8b, 0x45, 0x22, 0x18, 0x08, 0x59, 0xfa, 0x33, 0xc1, 0xa7,
+	0x67, 0xb6, 0x73, 0x3e, 0xad, 0x9b, 0xe2, 0x5f, 0x1e, 0x3c, 0x78, 0xbf, 0x3a, 0x7f, 0x50, 0x62,
+	0xae, 0x50, 0x6b, 0x72, 0x08, 0x7e, 0x2a, 0x16, 0x32, 0x47, 0x83, 0x99, 0x65, 0xd6, 0xa7, 0x7f,
+	0x0d, 0x64, 0x08, 0x5d, 0x54, 0xca, 0x4e, 0x87, 0x4f, 0xcb, 0x4f, 0xf2, 0x18, 0x80, 0x9f, 0x56,
+	0x80, 0x32, 0x4d, 0x97, 0xd6, 0x2c, 0x65, 0x43, 0xf8, 0x4c, 0x98, 0x24, 0x0f, 0x76, 0xec, 0x9d,
+	0x3b, 0x91, 0x67, 0xb0, 0x57, 0x91, 0xf9, 0xc8, 0x99, 0xd1, 0xc1, 0x7f, 0x36, 0x68, 0xc3, 0x5a,
+	0xea, 0xc8, 0x50, 0xa7, 0x8a, 0xc9, 0xd2, 0x16, 0xf4, 0x96, 0x3a, 0x6a, 0xa6, 0x58, 0xc3, 0x93,
+	0x66, 0xad, 0x57, 0x6a, 0x36, 0x4d, 0x7b, 0xa3, 0x48, 0x9d, 0x56, 0x91, 0xee, 0x9c, 0xfd, 0xcf,
+	0x10, 0xdd, 0x9d, 0xd4, 0xf5, 0xf7, 0x15, 0xf4, 0xa5, 0xb3, 0xb9, 0x31, 0x3a, 0xac, 0x35, 0xb1,
+	0x55, 0x7a, 0x5a, 0x79, 0xc7, 0xd7, 0x70, 0xd4, 0x8c, 0x7e, 0x9a, 0xf0, 0x14, 0xf3, 0x7f, 0x26,
+	0x68, 0xf2, 0xd3, 0x83, 0x61, 0x33, 0x27, 0xb9, 0x02, 0xbf, 0xda, 0x12, 0x72, 0x5c, 0x23, 0xbf,
+	0x69, 0x0f, 0xc3, 0x17, 0xdb, 0x39, 0xbb, 0x4a, 0xbd, 0x81, 0xff, 0xdd, 0x72, 0x90, 0x87, 0x35,
+	0xe0, 0xcd, 0x37, 0x2b, 0x0c, 0x6f, 0xbb, 0x5a, 0x46, 0x98, 0xfc, 0xee, 0x00, 0x69, 0x6f, 0xdc,
+	0xbd, 0x09, 0xa0, 0x5b, 0x09, 0x58, 0x17, 0xb3, 0xfd, 0x3c, 0xa4, 0xd0, 0xaf, 0xb6, 0xf2, 0xf9,
+	0x1a, 0x64, 0x63, 0xd8, 0xc3, 0xe3, 0xad, 0x7c, 0x5d, 0x92, 0x33, 0xe8, 0x2d, 0x27, 0x8b, 0x8c,
+	0xd6, 0xc0, 0x6e, 0x0c, 0x5f, 0x38, 0xac, 0x2b, 0x5c, 0x48, 0xf3, 0xfd, 0xb2, 0x67, 0x7f, 0x33,
+	0x2f, 0xff, 0x0c, 0x00, 0x41, 0xa7, 0x71, 0x18, 0x99, 0x06, 0x00, 0x00,
 }
@@ -27,7 +27,7 @@ type PutObjectRequest struct {
 func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
 func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutObjectRequest) ProtoMessage()               {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 func (m *PutObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -66,7 +66,7 @@ type ObjectExistsRequest struct {
 func (m *ObjectExistsRequest) Reset()                    { *m = ObjectExistsRequest{} }
 func (m *ObjectExistsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ObjectExistsRequest) ProtoMessage()               {}
-func (*ObjectExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
+func (*ObjectExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
 func (m *ObjectExistsRequest) GetPlugin() string {
 	if m != nil {
@@ -96,7 +96,7 @@ type ObjectExistsResponse struct {
 func (m *ObjectExistsResponse) Reset()                    { *m = ObjectExistsResponse{} }
 func (m *ObjectExistsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ObjectExistsResponse) ProtoMessage()               {}
-func (*ObjectExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
+func (*ObjectExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
 
 func (m *ObjectExistsResponse) GetExists() bool {
 	if m != nil {
@@ -114,7 +114,7 @@ type GetObjectRequest struct {
 func (m *GetObjectRequest) Reset()                    { *m = GetObjectRequest{} }
 func (m *GetObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetObjectRequest) ProtoMessage()               {}
-func (*GetObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{3} }
+func (*GetObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
 
 func (m *GetObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -144,7 +144,7 @@ type Bytes struct {
 func (m *Bytes) Reset()                    { *m = Bytes{} }
 func (m *Bytes) String() string            { return proto.CompactTextString(m) }
 func (*Bytes) ProtoMessage()               {}
-func (*Bytes) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+func (*Bytes) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
 
 func (m *Bytes) GetData() []byte {
 	if m != nil {
@@ -163,7 +163,7 @@ type ListCommonPrefixesRequest struct {
 func (m *ListCommonPrefixesRequest) Reset()                    { *m = ListCommonPrefixesRequest{} }
 func (m *ListCommonPrefixesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListCommonPrefixesRequest) ProtoMessage()               {}
-func (*ListCommonPrefixesRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+func (*ListCommonPrefixesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
 
 func (m *ListCommonPrefixesRequest) GetPlugin() string {
 	if m != nil {
@@ -200,7 +200,7 @@ type ListCommonPrefixesResponse struct {
 func (m *ListCommonPrefixesResponse) Reset()                    { *m = ListCommonPrefixesResponse{} }
 func (m *ListCommonPrefixesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListCommonPrefixesResponse) ProtoMessage()               {}
-func (*ListCommonPrefixesResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+func (*ListCommonPrefixesResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
 
 func (m *ListCommonPrefixesResponse) GetPrefixes() []string {
 	if m != nil {
@@ -218,7 +218,7 @@ type ListObjectsRequest struct {
 func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
 func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsRequest) ProtoMessage()               {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
 
 func (m *ListObjectsRequest) GetPlugin() string {
 	if m != nil {
@@ -248,7 +248,7 @@ type ListObjectsResponse struct {
 func (m *ListObjectsResponse) Reset()                    { *m = ListObjectsResponse{} }
 func (m *ListObjectsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsResponse) ProtoMessage()               {}
-func (*ListObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+func (*ListObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
 
 func (m *ListObjectsResponse) GetKeys() []string {
 	if m != nil {
@@ -266,7 +266,7 @@ type DeleteObjectRequest struct {
 func (m *DeleteObjectRequest) Reset()                    { *m = DeleteObjectRequest{} }
 func (m *DeleteObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectRequest) ProtoMessage()               {}
-func (*DeleteObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
+func (*DeleteObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
 
 func (m *DeleteObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -299,7 +299,7 @@ type CreateSignedURLRequest struct {
 func (m *CreateSignedURLRequest) Reset()                    { *m = CreateSignedURLRequest{} }
 func (m *CreateSignedURLRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateSignedURLRequest) ProtoMessage()               {}
-func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
+func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
 
 func (m *CreateSignedURLRequest) GetPlugin() string {
 	if m != nil {
@@ -336,7 +336,7 @@ type CreateSignedURLResponse struct {
 func (m *CreateSignedURLResponse) Reset()                    { *m = CreateSignedURLResponse{} }
 func (m *CreateSignedURLResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateSignedURLResponse) ProtoMessage()               {}
-func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
 
 func (m *CreateSignedURLResponse) GetUrl() string {
 	if m != nil {
@@ -353,7 +353,7 @@ type ObjectStoreInitRequest struct {
 func (m *ObjectStoreInitRequest) Reset()                    { *m = ObjectStoreInitRequest{} }
 func (m *ObjectStoreInitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ObjectStoreInitRequest) ProtoMessage()               {}
-func (*ObjectStoreInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+func (*ObjectStoreInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
 
 func (m *ObjectStoreInitRequest) GetPlugin() string {
 	if m != nil {
@@ -369,6 +369,78 @@ func (m *ObjectStoreInitRequest) GetConfig() map[string]string {
 	return nil
 }
 
+type GetEncryptionStatusRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
+}
+
+func (m *GetEncryptionStatusRequest) Reset()                    { *m = GetEncryptionStatusRequest{} }
+func (m *GetEncryptionStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetEncryptionStatusRequest) ProtoMessage()               {}
+func (*GetEncryptionStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
+
+func (m *GetEncryptionStatusRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *GetEncryptionStatusRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+type GetEncryptionStatusResponse struct {
+	Enabled   bool   `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm" json:"algorithm,omitempty"`
+	KeyID     string `protobuf:"bytes,3,opt,name=keyID" json:"keyID,omitempty"`
+}
+
+func (m *GetEncryptionStatusResponse) Reset()                    { *m = GetEncryptionStatusResponse{} }
+func (m *GetEncryptionStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetEncryptionStatusResponse) ProtoMessage()               {}
+func (*GetEncryptionStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{14} }
+
+func (m *GetEncryptionStatusResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *GetEncryptionStatusResponse) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *GetEncryptionStatusResponse) GetKeyID() string {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+type PutObjectIfNotExistsResponse struct {
+	Created bool `protobuf:"varint,1,opt,name=created" json:"created,omitempty"`
+}
+
+func (m *PutObjectIfNotExistsResponse) Reset()                    { *m = PutObjectIfNotExistsResponse{} }
+func (m *PutObjectIfNotExistsResponse) String() string            { return proto.CompactTextString(m) }
+func (*PutObjectIfNotExistsResponse) ProtoMessage()               {}
+func (*PutObjectIfNotExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{15} }
+
+func (m *PutObjectIfNotExistsResponse) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*PutObjectRequest)(nil), "generated.PutObjectRequest")
 	proto.RegisterType((*ObjectExistsRequest)(nil), "generated.ObjectExistsRequest")
@@ -383,6 +455,9 @@ func init() {
 	proto.RegisterType((*CreateSignedURLRequest)(nil), "generated.CreateSignedURLRequest")
 	proto.RegisterType((*CreateSignedURLResponse)(nil), "generated.CreateSignedURLResponse")
 	proto.RegisterType((*ObjectStoreInitRequest)(nil), "generated.ObjectStoreInitRequest")
+	proto.RegisterType((*GetEncryptionStatusRequest)(nil), "generated.GetEncryptionStatusRequest")
+	proto.RegisterType((*GetEncryptionStatusResponse)(nil), "generated.GetEncryptionStatusResponse")
+	proto.RegisterType((*PutObjectIfNotExistsResponse)(nil), "generated.PutObjectIfNotExistsResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -404,6 +479,8 @@ type ObjectStoreClient interface {
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
 	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*Empty, error)
 	CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
+	GetEncryptionStatus(ctx context.Context, in *GetEncryptionStatusRequest, opts ...grpc.CallOption) (*GetEncryptionStatusResponse, error)
+	PutObjectIfNotExists(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectIfNotExistsClient, error)
 }
 
 type objectStoreClient struct {
@@ -534,6 +611,49 @@ func (c *objectStoreClient) CreateSignedURL(ctx context.Context, in *CreateSigne
 	return out, nil
 }
 
+func (c *objectStoreClient) GetEncryptionStatus(ctx context.Context, in *GetEncryptionStatusRequest, opts ...grpc.CallOption) (*GetEncryptionStatusResponse, error) {
+	out := new(GetEncryptionStatusResponse)
+	err := grpc.Invoke(ctx, "/generated.ObjectStore/GetEncryptionStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) PutObjectIfNotExists(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectIfNotExistsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectStore_serviceDesc.Streams[2], c.cc, "/generated.ObjectStore/PutObjectIfNotExists", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectStorePutObjectIfNotExistsClient{stream}
+	return x, nil
+}
+
+type ObjectStore_PutObjectIfNotExistsClient interface {
+	Send(*PutObjectRequest) error
+	CloseAndRecv() (*PutObjectIfNotExistsResponse, error)
+	grpc.ClientStream
+}
+
+type objectStorePutObjectIfNotExistsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectStorePutObjectIfNotExistsClient) Send(m *PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectIfNotExistsClient) CloseAndRecv() (*PutObjectIfNotExistsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PutObjectIfNotExistsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for ObjectStore service
 
 type ObjectStoreServer interface {
@@ -545,6 +665,8 @@ type ObjectStoreServer interface {
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
 	DeleteObject(context.Context, *DeleteObjectRequest) (*Empty, error)
 	CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
+	GetEncryptionStatus(context.Context, *GetEncryptionStatusRequest) (*GetEncryptionStatusResponse, error)
+	PutObjectIfNotExists(ObjectStore_PutObjectIfNotExistsServer) error
 }
 
 func RegisterObjectStoreServer(s *grpc.Server, srv ObjectStoreServer) {
@@ -706,6 +828,50 @@ func _ObjectStore_CreateSignedURL_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ObjectStore_GetEncryptionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEncryptionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).GetEncryptionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ObjectStore/GetEncryptionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).GetEncryptionStatus(ctx, req.(*GetEncryptionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_PutObjectIfNotExists_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectStoreServer).PutObjectIfNotExists(&objectStorePutObjectIfNotExistsServer{stream})
+}
+
+type ObjectStore_PutObjectIfNotExistsServer interface {
+	SendAndClose(*PutObjectIfNotExistsResponse) error
+	Recv() (*PutObjectRequest, error)
+	grpc.ServerStream
+}
+
+type objectStorePutObjectIfNotExistsServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectStorePutObjectIfNotExistsServer) SendAndClose(m *PutObjectIfNotExistsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectIfNotExistsServer) Recv() (*PutObjectRequest, error) {
+	m := new(PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.ObjectStore",
 	HandlerType: (*ObjectStoreServer)(nil),
@@ -734,6 +900,10 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSignedURL",
 			Handler:    _ObjectStore_CreateSignedURL_Handler,
 		},
+		{
+			MethodName: "GetEncryptionStatus",
+			Handler:    _ObjectStore_GetEncryptionStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -746,49 +916,60 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ObjectStore_GetObject_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PutObjectIfNotExists",
+			Handler:       _ObjectStore_PutObjectIfNotExists_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "ObjectStore.proto",
 }
 
-func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor1) }
-
-var fileDescriptor1 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xd6, 0xc6, 0x69, 0x54, 0x4f, 0x22, 0x61, 0xb6, 0x55, 0x30, 0x2e, 0x94, 0xb0, 0x02, 0x29,
-	0x08, 0x11, 0xa1, 0x72, 0x29, 0xd0, 0x03, 0x22, 0x44, 0x08, 0x29, 0x52, 0x2b, 0x07, 0x04, 0x07,
-	0x2e, 0x4e, 0x3c, 0x4d, 0x4d, 0x1c, 0x3b, 0xd8, 0x6b, 0x54, 0x1f, 0x79, 0x25, 0x9e, 0x89, 0x07,
-	0x41, 0xbb, 0xde, 0x26, 0xde, 0xfc, 0x10, 0xa9, 0xca, 0x6d, 0x66, 0x76, 0xe7, 0x9b, 0x6f, 0x66,
-	0xfd, 0x8d, 0xe1, 0xee, 0xf9, 0xf0, 0x07, 0x8e, 0xf8, 0x80, 0xc7, 0x09, 0x76, 0x66, 0x49, 0xcc,
-	0x63, 0x6a, 0x8e, 0x31, 0xc2, 0xc4, 0xe3, 0xe8, 0x3b, 0x8d, 0xc1, 0x95, 0x97, 0xa0, 0x5f, 0x1c,
-	0xb0, 0x2b, 0xb0, 0x2e, 0x32, 0x5e, 0x24, 0xb8, 0xf8, 0x33, 0xc3, 0x94, 0xd3, 0x26, 0xd4, 0x66,
-	0x61, 0x36, 0x0e, 0x22, 0x9b, 0xb4, 0x48, 0xdb, 0x74, 0x95, 0x27, 0xe2, 0xc3, 0x6c, 0x34, 0x41,
-	0x6e, 0x57, 0x8a, 0x78, 0xe1, 0x51, 0x0b, 0x8c, 0x09, 0xe6, 0xb6, 0x21, 0x83, 0xc2, 0xa4, 0x14,
-	0xaa, 0xc3, 0xd8, 0xcf, 0xed, 0x6a, 0x8b, 0xb4, 0x1b, 0xae, 0xb4, 0xd9, 0x57, 0x38, 0x28, 0xca,
-	0xf4, 0xae, 0x83, 0x94, 0xa7, 0x3b, 0x2b, 0xc6, 0x3a, 0x70, 0xa8, 0x03, 0xa7, 0xb3, 0x38, 0x4a,
-	0x51, 0x20, 0xa0, 0x8c, 0x48, 0xe4, 0x7d, 0x57, 0x79, 0xec, 0x33, 0x58, 0x1f, 0x71, 0xd7, 0x2d,
-	0xb3, 0x23, 0xd8, 0x7b, 0x9f, 0x73, 0x4c, 0x45, 0xef, 0xbe, 0xc7, 0x3d, 0x09, 0xd4, 0x70, 0xa5,
-	0xcd, 0x7e, 0x13, 0xb8, 0xdf, 0x0f, 0x52, 0xde, 0x8d, 0xa7, 0xd3, 0x38, 0xba, 0x48, 0xf0, 0x32,
-	0xb8, 0xc6, 0x5b, 0x8f, 0xe0, 0x01, 0x98, 0x3e, 0x86, 0xc1, 0x34, 0xe0, 0x98, 0x28, 0x0a, 0x8b,
-	0x80, 0x44, 0x93, 0x05, 0xe4, 0xf4, 0x05, 0x9a, 0xf4, 0xd8, 0x29, 0x38, 0xeb, 0x28, 0xa8, 0x61,
-	0x39, 0xb0, 0x3f, 0x53, 0x31, 0x9b, 0xb4, 0x8c, 0xb6, 0xe9, 0xce, 0x7d, 0xf6, 0x1d, 0xa8, 0xc8,
-	0x2c, 0x26, 0x76, 0x6b, 0xd6, 0x0b, 0x5e, 0x86, 0xc6, 0xeb, 0x19, 0x1c, 0x68, 0xe8, 0x8a, 0x10,
-	0x85, 0xea, 0x04, 0xf3, 0x1b, 0x32, 0xd2, 0x16, 0x9f, 0xd0, 0x07, 0x0c, 0x91, 0xe3, 0xae, 0x1f,
-	0x2f, 0x84, 0x66, 0x37, 0x41, 0x8f, 0xe3, 0x20, 0x18, 0x47, 0xe8, 0x7f, 0x71, 0xfb, 0xbb, 0xd3,
-	0x82, 0x05, 0x06, 0xe7, 0xa1, 0x7c, 0x0c, 0xc3, 0x15, 0x26, 0x7b, 0x0e, 0xf7, 0x56, 0xaa, 0xa9,
-	0xae, 0x2d, 0x30, 0xb2, 0x24, 0x54, 0xb5, 0x84, 0xc9, 0xfe, 0x10, 0x68, 0x96, 0xf4, 0xfc, 0x29,
-	0x0a, 0xb6, 0xf6, 0xdd, 0x83, 0xda, 0x28, 0x8e, 0x2e, 0x83, 0xb1, 0x5d, 0x69, 0x19, 0xed, 0xfa,
-	0xc9, 0x8b, 0xce, 0x5c, 0xfd, 0x9d, 0xf5, 0x50, 0x9d, 0xae, 0xbc, 0xdf, 0x8b, 0x78, 0x92, 0xbb,
-	0x2a, 0xd9, 0x79, 0x0d, 0xf5, 0x52, 0xf8, 0xa6, 0x33, 0xb2, 0xe8, 0xec, 0x10, 0xf6, 0x7e, 0x79,
-	0x61, 0x86, 0x6a, 0x04, 0x85, 0xf3, 0xa6, 0x72, 0x4a, 0x4e, 0xfe, 0x56, 0xa1, 0x5e, 0xaa, 0x44,
-	0xdf, 0x42, 0x55, 0x54, 0xa3, 0x8f, 0xb7, 0x32, 0x71, 0xac, 0xd2, 0x95, 0xde, 0x74, 0xc6, 0x73,
-	0x7a, 0x06, 0xe6, 0x7c, 0x45, 0xd1, 0xa3, 0xd2, 0xf1, 0xf2, 0xe2, 0x5a, 0xcd, 0x6d, 0x13, 0x7a,
-	0x0e, 0x8d, 0xf2, 0x76, 0xa0, 0xc7, 0x2b, 0x14, 0xb4, 0x7d, 0xe4, 0x3c, 0xda, 0x78, 0xae, 0x9e,
-	0xe8, 0x0c, 0xcc, 0xf9, 0xfa, 0xd0, 0xe8, 0x2c, 0x2f, 0x15, 0x8d, 0x8e, 0xdc, 0x0d, 0x2f, 0x09,
-	0xf5, 0x0a, 0x2d, 0xe9, 0x2a, 0xa4, 0x4f, 0x4a, 0x37, 0x37, 0xee, 0x09, 0xe7, 0xe9, 0x96, 0x5b,
-	0x8a, 0x60, 0x1f, 0xea, 0x25, 0x41, 0xd1, 0x87, 0x4b, 0x59, 0xba, 0x8c, 0x9d, 0xe3, 0x4d, 0xc7,
-	0x0a, 0xed, 0x1d, 0x34, 0xca, 0x9a, 0xd3, 0xe6, 0xb7, 0x46, 0x8c, 0x6b, 0xde, 0xef, 0x1b, 0xdc,
-	0x59, 0xfa, 0xdc, 0xb5, 0xef, 0x60, 0xbd, 0xf0, 0x1c, 0xf6, 0xbf, 0x2b, 0x05, 0xb7, 0x61, 0x4d,
-	0xfe, 0xc3, 0x5e, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x4e, 0x76, 0xa3, 0xf1, 0x06, 0x00,
-	0x00,
+func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor3) }
+
+var fileDescriptor3 = []byte{
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x95, 0xeb, 0x34, 0xad, 0x27, 0x91, 0x30, 0xdb, 0xaa, 0x18, 0xb7, 0x94, 0xb0, 0xa2, 0x60,
+	0x84, 0x88, 0x50, 0xb9, 0x14, 0xe8, 0x01, 0xd1, 0x46, 0x51, 0xa5, 0x88, 0x56, 0x0e, 0x08, 0x0e,
+	0x08, 0xc9, 0x89, 0xa7, 0xa9, 0x89, 0x63, 0x1b, 0x7b, 0x8d, 0xea, 0x23, 0xbf, 0xc3, 0x91, 0x2f,
+	0x44, 0x5e, 0x6f, 0x13, 0x3b, 0x71, 0x12, 0x29, 0xca, 0x6d, 0x67, 0x76, 0xf6, 0xcd, 0x9b, 0x19,
+	0xcf, 0x93, 0xe1, 0xfe, 0x65, 0xef, 0x27, 0xf6, 0x59, 0x97, 0xf9, 0x21, 0x36, 0x83, 0xd0, 0x67,
+	0x3e, 0x51, 0x06, 0xe8, 0x61, 0x68, 0x31, 0xb4, 0xf5, 0x7a, 0xf7, 0xc6, 0x0a, 0xd1, 0xce, 0x2e,
+	0xe8, 0x0d, 0xa8, 0x57, 0x31, 0xcb, 0x1e, 0x98, 0xf8, 0x2b, 0xc6, 0x88, 0x91, 0x3d, 0xa8, 0x06,
+	0x6e, 0x3c, 0x70, 0x3c, 0x4d, 0x6a, 0x48, 0x86, 0x62, 0x0a, 0x2b, 0xf5, 0xf7, 0xe2, 0xfe, 0x10,
+	0x99, 0xb6, 0x91, 0xf9, 0x33, 0x8b, 0xa8, 0x20, 0x0f, 0x31, 0xd1, 0x64, 0xee, 0x4c, 0x8f, 0x84,
+	0x40, 0xa5, 0xe7, 0xdb, 0x89, 0x56, 0x69, 0x48, 0x46, 0xdd, 0xe4, 0x67, 0xfa, 0x15, 0x76, 0xb2,
+	0x34, 0xad, 0x5b, 0x27, 0x62, 0xd1, 0xda, 0x92, 0xd1, 0x26, 0xec, 0x16, 0x81, 0xa3, 0xc0, 0xf7,
+	0x22, 0x4c, 0x11, 0x90, 0x7b, 0x38, 0xf2, 0xb6, 0x29, 0x2c, 0xfa, 0x19, 0xd4, 0x36, 0xae, 0xbb,
+	0x64, 0xba, 0x0f, 0x9b, 0x1f, 0x13, 0x86, 0x51, 0x5a, 0xbb, 0x6d, 0x31, 0x8b, 0x03, 0xd5, 0x4d,
+	0x7e, 0xa6, 0x7f, 0x24, 0x78, 0xd8, 0x71, 0x22, 0x76, 0xe6, 0x8f, 0x46, 0xbe, 0x77, 0x15, 0xe2,
+	0xb5, 0x73, 0x8b, 0x2b, 0xb7, 0xe0, 0x00, 0x14, 0x1b, 0x5d, 0x67, 0xe4, 0x30, 0x0c, 0x05, 0x85,
+	0x89, 0x83, 0xa3, 0xf1, 0x04, 0x5a, 0x45, 0xa0, 0x71, 0x8b, 0x9e, 0x80, 0x5e, 0x46, 0x41, 0x34,
+	0x4b, 0x87, 0xed, 0x40, 0xf8, 0x34, 0xa9, 0x21, 0x1b, 0x8a, 0x39, 0xb6, 0xe9, 0x77, 0x20, 0xe9,
+	0xcb, 0xac, 0x63, 0x2b, 0xb3, 0x9e, 0xf0, 0x92, 0x0b, 0xbc, 0x5e, 0xc0, 0x4e, 0x01, 0x5d, 0x10,
+	0x22, 0x50, 0x19, 0x62, 0x72, 0x47, 0x86, 0x9f, 0xd3, 0x4f, 0xe8, 0x1c, 0x5d, 0x64, 0xb8, 0xee,
+	0xe1, 0xb9, 0xb0, 0x77, 0x16, 0xa2, 0xc5, 0xb0, 0xeb, 0x0c, 0x3c, 0xb4, 0xbf, 0x98, 0x9d, 0xf5,
+	0xed, 0x82, 0x0a, 0x32, 0x63, 0x2e, 0x1f, 0x86, 0x6c, 0xa6, 0x47, 0xfa, 0x12, 0x1e, 0xcc, 0x64,
+	0x13, 0x55, 0xab, 0x20, 0xc7, 0xa1, 0x2b, 0x72, 0xa5, 0x47, 0xfa, 0x4f, 0x82, 0xbd, 0xdc, 0x3e,
+	0x5f, 0x78, 0xce, 0xd2, 0xba, 0x5b, 0x50, 0xed, 0xfb, 0xde, 0xb5, 0x33, 0xd0, 0x36, 0x1a, 0xb2,
+	0x51, 0x3b, 0x7e, 0xd5, 0x1c, 0x6f, 0x7f, 0xb3, 0x1c, 0xaa, 0x79, 0xc6, 0xe3, 0x5b, 0x1e, 0x0b,
+	0x13, 0x53, 0x3c, 0xd6, 0xdf, 0x42, 0x2d, 0xe7, 0xbe, 0xab, 0x4c, 0x9a, 0x54, 0xb6, 0x0b, 0x9b,
+	0xbf, 0x2d, 0x37, 0x46, 0xd1, 0x82, 0xcc, 0x78, 0xb7, 0x71, 0x22, 0xd1, 0x0e, 0xe8, 0x6d, 0x64,
+	0x2d, 0xaf, 0x1f, 0x26, 0x01, 0x73, 0x7c, 0xaf, 0xcb, 0x2c, 0x16, 0xaf, 0xfa, 0xe5, 0xd0, 0x21,
+	0xec, 0x97, 0xa2, 0x89, 0x9e, 0x69, 0xb0, 0x85, 0x9e, 0xd5, 0x73, 0xd1, 0x16, 0x8b, 0x7e, 0x67,
+	0xa6, 0x8b, 0x62, 0xb9, 0x03, 0x3f, 0x74, 0xd8, 0xcd, 0x48, 0x60, 0x4e, 0x1c, 0x29, 0xfd, 0x21,
+	0x26, 0x17, 0xe7, 0x62, 0x58, 0x99, 0x41, 0x4f, 0xe0, 0x60, 0x2c, 0x88, 0x17, 0xd7, 0x9f, 0xfc,
+	0x69, 0x55, 0xd1, 0x60, 0xab, 0xcf, 0x87, 0x37, 0xce, 0x26, 0xcc, 0xe3, 0xbf, 0x55, 0xa8, 0xe5,
+	0xda, 0x4b, 0xde, 0x43, 0x25, 0x6d, 0x31, 0x79, 0xb2, 0xb4, 0xfd, 0xba, 0x9a, 0x0b, 0x69, 0x8d,
+	0x02, 0x96, 0x90, 0x53, 0x50, 0xc6, 0x34, 0xc8, 0x7e, 0xee, 0x7a, 0x5a, 0xad, 0x67, 0xdf, 0x1a,
+	0x12, 0xb9, 0x84, 0x7a, 0x5e, 0x12, 0xc9, 0xe1, 0x0c, 0x85, 0x82, 0x08, 0xeb, 0x8f, 0xe7, 0xde,
+	0x8b, 0xaa, 0x4f, 0x41, 0x69, 0x63, 0x19, 0x9d, 0x36, 0x2e, 0xa0, 0xc3, 0x05, 0xf1, 0xb5, 0x44,
+	0x2c, 0x20, 0xb3, 0xd2, 0x43, 0x9e, 0xe6, 0x22, 0xe7, 0x8a, 0xa3, 0x7e, 0xb4, 0x24, 0x4a, 0x10,
+	0xec, 0x40, 0x2d, 0xa7, 0x22, 0xe4, 0xd1, 0xd4, 0xab, 0xa2, 0x76, 0xe9, 0x87, 0xf3, 0xae, 0x05,
+	0xda, 0x07, 0xa8, 0xe7, 0x85, 0xa6, 0xd0, 0xbf, 0x12, 0x05, 0x2a, 0x99, 0xdf, 0x37, 0xb8, 0x37,
+	0xb5, 0xe3, 0x85, 0xef, 0xa0, 0x5c, 0x6d, 0x74, 0xba, 0x28, 0x44, 0x70, 0xb3, 0x61, 0xa7, 0x64,
+	0x1b, 0xc8, 0x51, 0x71, 0x28, 0x73, 0x76, 0x4f, 0x7f, 0xb6, 0x2c, 0x4c, 0x64, 0xf9, 0x01, 0xbb,
+	0x65, 0x6b, 0xb0, 0xf8, 0x53, 0x7c, 0x5e, 0x76, 0x59, 0xb2, 0x44, 0x86, 0xd4, 0xab, 0xf2, 0xdf,
+	0x8f, 0x37, 0xff, 0x07, 0x00, 0xa7, 0x8e, 0x6f, 0x6a, 0xac, 0x08, 0x00, 0x00,
 }
@@ -18,6 +18,7 @@ package mocks
 
 import (
 	mock "github.com/stretchr/testify/mock"
+	v1 "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
@@ -78,6 +79,142 @@ func (_m *Manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
 	return r0, r1
 }
 
+// GetBackupItemActionV2 provides a mock function with given fields: name
+func (_m *Manager) GetBackupItemActionV2(name string) (velero.BackupItemActionV2, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.BackupItemActionV2
+	if rf, ok := ret.Get(0).(func(string) velero.BackupItemActionV2); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.BackupItemActionV2)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupItemActionsV2 provides a mock function with given fields:
+func (_m *Manager) GetBackupItemActionsV2() ([]velero.BackupItemActionV2, error) {
+	ret := _m.Called()
+
+	var r0 []velero.BackupItemActionV2
+	if rf, ok := ret.Get(0).(func() []velero.BackupItemActionV2); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.BackupItemActionV2)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCredentialsFile provides a mock function with given fields: selector
+func (_m *Manager) GetCredentialsFile(selector *v1.SecretKeySelector) (string, error) {
+	ret := _m.Called(selector)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*v1.SecretKeySelector) string); ok {
+		r0 = rf(selector)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.SecretKeySelector) error); ok {
+		r1 = rf(selector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemTransformer provides a mock function with given fields: name
+func (_m *Manager) GetItemTransformer(name string) (velero.ItemTransformer, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.ItemTransformer
+	if rf, ok := ret.Get(0).(func(string) velero.ItemTransformer); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.ItemTransformer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemTransformers provides a mock function with given fields:
+func (_m *Manager) GetItemTransformers() ([]velero.ItemTransformer, error) {
+	ret := _m.Called()
+
+	var r0 []velero.ItemTransformer
+	if rf, ok := ret.Get(0).(func() []velero.ItemTransformer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.ItemTransformer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyWrapper provides a mock function with given fields: name
+func (_m *Manager) GetKeyWrapper(name string) (velero.KeyWrapper, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.KeyWrapper
+	if rf, ok := ret.Get(0).(func(string) velero.KeyWrapper); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.KeyWrapper)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVolumeSnapshotter provides a mock function with given fields: name
 func (_m *Manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error) {
 	ret := _m.Called(name)
@@ -0,0 +1,69 @@
+/*
+Copyright 2017 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupItemActionV2 is a BackupItemAction that can start long-running operations, such as
+// uploading a snapshot, which the backup waits for after all of its items have been backed up
+// instead of blocking while the item is backed up.
+type BackupItemActionV2 interface {
+	// AppliesTo returns information about which resources this action should be invoked for.
+	// A BackupItemActionV2's Execute function will only be invoked on items that match the returned
+	// selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (ResourceSelector, error)
+
+	// Execute allows the ItemAction to perform arbitrary logic with the item being backed up,
+	// including mutating the item itself prior to backup. The item (unmodified or modified)
+	// should be returned, along with an optional slice of ResourceIdentifiers specifying
+	// additional related items that should be backed up, and the ID of the operation that
+	// the action started, if any. An empty operation ID means the action is done with the item.
+	Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []ResourceIdentifier, string, error)
+
+	// Progress returns the progress of an operation started by Execute. The backup waits for
+	// the operation until Progress reports that it's completed, or returns an error.
+	Progress(operationID string, backup *api.Backup) (OperationProgress, error)
+
+	// Cancel cancels an operation started by Execute, e.g. because the backup timed out
+	// waiting for it.
+	Cancel(operationID string, backup *api.Backup) error
+}
+
+// OperationProgress is the progress of an operation started by a BackupItemActionV2.
+type OperationProgress struct {
+	// Completed is true when the operation is done, whether or not it failed.
+	Completed bool
+
+	// Err is the operation's error, if it failed.
+	Err string
+
+	// NCompleted and NTotal are how many of the operation's units, e.g. bytes,
+	// are done, and how many there are in total, if the plugin knows them.
+	NCompleted int64
+	NTotal     int64
+
+	// OperationUnits are the units of NCompleted and NTotal, e.g. "bytes".
+	OperationUnits string
+
+	// Description is a short, human-readable description of the operation's
+	// current status.
+	Description string
+}
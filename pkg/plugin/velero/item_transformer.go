@@ -0,0 +1,43 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ItemTransformer rewrites the content of items before they're written to a
+// backup, e.g. to mask sensitive values or to normalize fields, so that
+// transformations can be applied to every item of a kind without changing the
+// plugins that back it up.
+//
+// Transformers are invoked after all of the BackupItemActions for an item,
+// and see the item as the actions left it. When several transformers apply to
+// an item, they're invoked one after another in the order of their names, and
+// each one is passed the item returned by the previous one.
+type ItemTransformer interface {
+	// AppliesTo returns information about which resources this transformer should be invoked for.
+	// An ItemTransformer's Transform function will only be invoked on items that match the returned
+	// selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (ResourceSelector, error)
+
+	// Transform returns the item to write to the backup in place of item. The transformed item
+	// must have the same kind, namespace and name as item.
+	Transform(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, error)
+}
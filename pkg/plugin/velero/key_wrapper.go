@@ -0,0 +1,37 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+// KeyWrapper wraps and unwraps the data keys used to encrypt backup artifacts
+// with a key that's managed by a key management service (KMS), so that data
+// keys never have to be stored in plain text alongside the backups they
+// protect.
+type KeyWrapper interface {
+	// Init prepares the KeyWrapper for usage using the provided map of
+	// configuration key-value pairs. It returns an error if the KeyWrapper
+	// cannot be initialized from the provided config.
+	Init(config map[string]string) error
+
+	// WrapKey encrypts dataKey with the key identified by keyID, and returns
+	// the wrapped data key and the fully-qualified ID of the key that wrapped
+	// it, e.g. a key version, which UnwrapKey is later called with.
+	WrapKey(keyID string, dataKey []byte) (wrapped []byte, wrappingKeyID string, err error)
+
+	// UnwrapKey decrypts a data key that was wrapped by the key identified by
+	// wrappingKeyID.
+	UnwrapKey(wrappingKeyID string, wrapped []byte) ([]byte, error)
+}
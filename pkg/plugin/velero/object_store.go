@@ -19,6 +19,8 @@ package velero
 import (
 	"io"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 // ObjectStore exposes basic object-storage operations required
@@ -63,3 +65,42 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// EncryptionStatus describes the provider-side encryption at rest of the
+// objects in a bucket.
+type EncryptionStatus struct {
+	// Enabled is whether objects written to the bucket are encrypted at rest.
+	Enabled bool
+
+	// Algorithm is the provider-specific encryption algorithm or scheme,
+	// e.g. "aws:kms".
+	Algorithm string
+
+	// KeyID identifies the key that objects are encrypted with, if the
+	// key is managed by the user rather than by the provider.
+	KeyID string
+}
+
+// EncryptionStatusGetter is an optional interface that an ObjectStore can
+// implement to report the encryption at rest of the objects in its buckets.
+type EncryptionStatusGetter interface {
+	// GetEncryptionStatus returns the encryption at rest of objects written
+	// to the specified bucket. It returns a nil status if the encryption
+	// can't be determined.
+	GetEncryptionStatus(bucket string) (*EncryptionStatus, error)
+}
+
+// ErrConditionalPutNotSupported is returned by PutObjectIfNotExists if the
+// object store doesn't support conditional writes.
+var ErrConditionalPutNotSupported = errors.New("object store doesn't support conditional writes")
+
+// ConditionalPutter is an optional interface that an ObjectStore can implement
+// to create objects only if there isn't already an object with the same key,
+// atomically.
+type ConditionalPutter interface {
+	// PutObjectIfNotExists creates a new object using the data in body within the
+	// specified object storage bucket with the given key, unless there's already an
+	// object with the key. It returns whether the object was created, or
+	// ErrConditionalPutNotSupported if conditional writes aren't supported.
+	PutObjectIfNotExists(bucket, key string, body io.Reader) (bool, error)
+}
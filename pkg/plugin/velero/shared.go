@@ -20,6 +20,10 @@ limitations under the License.
 // plugins of any type can be implemented.
 package velero
 
+import (
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
 // ResourceSelector is a collection of included/excluded namespaces,
 // included/excluded resources, and a label-selector that can be used
 // to match a set of items from a cluster.
@@ -48,3 +52,40 @@ type ResourceSelector struct {
 	// for details on syntax.
 	LabelSelector string
 }
+
+const (
+	// IdentityModeConfigKey is the config key that ObjectStore and
+	// VolumeSnapshotter plugins are passed the mode of their location's
+	// identity in, if it has one.
+	IdentityModeConfigKey = "identityMode"
+
+	// IdentityConfigKey is the config key that ObjectStore and
+	// VolumeSnapshotter plugins are passed their location's cloud
+	// identity in, if it has one.
+	IdentityConfigKey = "identity"
+
+	// CredentialsFileConfigKey is the config key that ObjectStore and
+	// VolumeSnapshotter plugins are passed the path of a file with their
+	// location's credential in, if it has one.
+	CredentialsFileConfigKey = "credentialsFile"
+)
+
+// ConfigWithIdentity returns a copy of a location's config with its identity
+// added under IdentityModeConfigKey and IdentityConfigKey, so that plugins
+// can authenticate as it. config is returned as it is if identity is nil.
+func ConfigWithIdentity(config map[string]string, identity *api.CloudIdentity) map[string]string {
+	if identity == nil {
+		return config
+	}
+
+	res := make(map[string]string, len(config)+2)
+	for k, v := range config {
+		res[k] = v
+	}
+	res[IdentityModeConfigKey] = string(identity.Mode)
+	if identity.Identity != "" {
+		res[IdentityConfigKey] = identity.Identity
+	}
+
+	return res
+}
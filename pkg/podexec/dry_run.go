@@ -0,0 +1,51 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podexec
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// dryRunPodCommandExecutor is a PodCommandExecutor that logs the commands it would
+// execute instead of executing them.
+type dryRunPodCommandExecutor struct{}
+
+// NewDryRunPodCommandExecutor returns a PodCommandExecutor that logs hook commands
+// instead of executing them.
+func NewDryRunPodCommandExecutor() PodCommandExecutor {
+	return dryRunPodCommandExecutor{}
+}
+
+func (dryRunPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error {
+	if hook == nil {
+		return errors.New("hook is required")
+	}
+
+	log.WithFields(logrus.Fields{
+		"hookName":  hookName,
+		"namespace": namespace,
+		"name":      name,
+		"container": hook.Container,
+	}).Infof("Dry run: not executing hook command %q", strings.Join(hook.Command, " "))
+
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -171,7 +172,39 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 	hookLog.Infof("stdout: %s", stdout.String())
 	hookLog.Infof("stderr: %s", stderr.String())
 
-	return err
+	if err != nil {
+		return &CommandError{Err: err, Stderr: stderr.String()}
+	}
+
+	return nil
+}
+
+// CommandError is the error returned by ExecutePodCommand when a command was
+// run in a pod's container but failed.
+type CommandError struct {
+	// Err is the error that the command failed with.
+	Err error
+
+	// Stderr is what the command wrote to stderr.
+	Stderr string
+}
+
+func (e *CommandError) Error() string {
+	return e.Err.Error()
+}
+
+// Cause returns the error that the command failed with.
+func (e *CommandError) Cause() error {
+	return e.Err
+}
+
+// ExitStatus returns the command's exit status, and whether it's known. It
+// isn't known if the command couldn't be run, or its stream was interrupted.
+func (e *CommandError) ExitStatus() (int, bool) {
+	if exitErr, ok := e.Err.(utilexec.ExitError); ok {
+		return exitErr.ExitStatus(), true
+	}
+	return 0, false
 }
 
 func ensureContainerExists(pod *corev1api.Pod, container string) error {
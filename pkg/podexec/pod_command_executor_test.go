@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -123,7 +124,10 @@ func TestExecutePodCommand(t *testing.T) {
 		timeout               time.Duration
 		expectedTimeout       time.Duration
 		hookError             error
+		hookStderr            string
 		expectedError         string
+		expectedStderr        string
+		expectedExitStatus    *int
 	}{
 		{
 			name:                  "validate defaults",
@@ -151,6 +155,18 @@ func TestExecutePodCommand(t *testing.T) {
 			hookError:             errors.New("hook error"),
 			expectedError:         "hook error",
 		},
+		{
+			name:                  "hook exits with a non-zero status",
+			command:               []string{"some", "command"},
+			expectedContainerName: "foo",
+			expectedErrorMode:     v1.HookErrorModeFail,
+			expectedTimeout:       30 * time.Second,
+			hookError:             utilexec.CodeExitError{Err: errors.New("command terminated with exit code 2"), Code: 2},
+			hookStderr:            "some: command not found",
+			expectedError:         "command terminated with exit code 2",
+			expectedStderr:        "some: command not found",
+			expectedExitStatus:    intPtr(2),
+		},
 	}
 
 	for _, test := range tests {
@@ -208,11 +224,25 @@ func TestExecutePodCommand(t *testing.T) {
 				Stdout: &stdout,
 				Stderr: &stderr,
 			}
-			streamExecutor.On("Stream", expectedStreamOptions).Return(test.hookError)
+			streamExecutor.On("Stream", expectedStreamOptions).Return(test.hookError).Run(func(args mock.Arguments) {
+				args.Get(0).(remotecommand.StreamOptions).Stderr.Write([]byte(test.hookStderr))
+			})
 
 			err = podCommandExecutor.ExecutePodCommand(velerotest.NewLogger(), pod, "namespace", "name", "hookName", &hook)
 			if test.expectedError != "" {
 				assert.EqualError(t, err, test.expectedError)
+
+				commandErr, ok := err.(*CommandError)
+				require.True(t, ok)
+				assert.Equal(t, test.expectedStderr, commandErr.Stderr)
+
+				exitStatus, known := commandErr.ExitStatus()
+				if test.expectedExitStatus == nil {
+					assert.False(t, known)
+				} else {
+					assert.True(t, known)
+					assert.Equal(t, *test.expectedExitStatus, exitStatus)
+				}
 				return
 			}
 
@@ -266,3 +296,7 @@ func (p *mockPoster) Post() *rest.Request {
 	args := p.Called()
 	return args.Get(0).(*rest.Request)
 }
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -20,10 +20,12 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -35,7 +37,7 @@ import (
 
 // Backupper can execute restic backups of volumes in a pod.
 type Backupper interface {
-	// BackupPodVolumes backs up all annotated volumes in a pod.
+	// BackupPodVolumes backs up all annotated or selected volumes in a pod.
 	BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error)
 }
 
@@ -45,6 +47,8 @@ type backupper struct {
 	repoEnsurer *repositoryEnsurer
 	pvcClient   corev1client.PersistentVolumeClaimsGetter
 	pvClient    corev1client.PersistentVolumesGetter
+	nodeClient  corev1client.NodesGetter
+	nodeLimiter *nodeLimiter
 
 	results     map[string]chan *velerov1api.PodVolumeBackup
 	resultsLock sync.Mutex
@@ -57,6 +61,8 @@ func newBackupper(
 	podVolumeBackupInformer cache.SharedIndexInformer,
 	pvcClient corev1client.PersistentVolumeClaimsGetter,
 	pvClient corev1client.PersistentVolumesGetter,
+	nodeClient corev1client.NodesGetter,
+	nodeLimiter *nodeLimiter,
 	log logrus.FieldLogger,
 ) *backupper {
 	b := &backupper{
@@ -65,6 +71,8 @@ func newBackupper(
 		repoEnsurer: repoEnsurer,
 		pvcClient:   pvcClient,
 		pvClient:    pvClient,
+		nodeClient:  nodeClient,
+		nodeLimiter: nodeLimiter,
 
 		results: make(map[string]chan *velerov1api.PodVolumeBackup),
 	}
@@ -92,17 +100,28 @@ func newBackupper(
 	return b
 }
 
+// nodeReadyCheckInterval is how often the node of a pod whose volumes are
+// being backed up is checked for being ready, to stop waiting for pod
+// volume backups that would never finish.
+const nodeReadyCheckInterval = time.Minute
+
 func resultsKey(ns, name string) string {
 	return fmt.Sprintf("%s/%s", ns, name)
 }
 
 func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error) {
-	// get volumes to backup from pod's annotations
-	volumesToBackup := GetVolumesToBackup(pod)
+	// get volumes to backup from the pod's annotations or the backup's selectors
+	volumesToBackup := GetPodVolumesToBackup(backup, pod)
 	if len(volumesToBackup) == 0 {
 		return nil, nil
 	}
 
+	// pod volume backups are run by the restic daemonset pod on the pod's
+	// node, so they'd never finish if the node is down.
+	if err := b.checkNodeReady(pod.Spec.NodeName, log); err != nil {
+		return nil, []error{err}
+	}
+
 	repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation)
 	if err != nil {
 		return nil, []error{err}
@@ -130,7 +149,56 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		podVolumes[podVolume.Name] = podVolume
 	}
 
-	var numVolumeSnapshots int
+	// if there's a limit on the number of pod volume backups that run on
+	// the pod's node at once, a slot on the node is acquired for each pod
+	// volume backup that's created, and released when it finishes.
+	slots := b.nodeLimiter.Slots(pod.Spec.NodeName)
+
+	nodeReadyTicker := time.NewTicker(nodeReadyCheckInterval)
+	defer nodeReadyTicker.Stop()
+
+	var numPending int
+
+	// next waits for one of the pending pod volume backups to finish, and,
+	// if acquire is true, for a slot to free up on the node. It returns
+	// true if it acquired a slot, and an error if the backup timed out or
+	// the node stopped being ready.
+	next := func(acquire bool) (bool, error) {
+		// sending to a nil channel blocks forever, so a slot is only
+		// acquired if acquire is true.
+		var acquireSlots chan struct{}
+		if acquire {
+			acquireSlots = slots
+		}
+
+		select {
+		case acquireSlots <- struct{}{}:
+			return true, nil
+		case res := <-resultsChan:
+			numPending--
+			if slots != nil {
+				<-slots
+			}
+
+			switch res.Status.Phase {
+			case velerov1api.PodVolumeBackupPhaseCompleted:
+				if res.Status.SnapshotID == "" { // when the volume is empty there is no restic snapshot, so best to exclude it
+					break
+				}
+				podVolumeBackups = append(podVolumeBackups, res)
+			case velerov1api.PodVolumeBackupPhaseFailed:
+				errs = append(errs, errors.Errorf("pod volume backup failed: %s", res.Status.Message))
+				podVolumeBackups = append(podVolumeBackups, res)
+			}
+			return false, nil
+		case <-nodeReadyTicker.C:
+			return false, b.checkNodeReady(pod.Spec.NodeName, log)
+		case <-b.ctx.Done():
+			return false, errors.New("timed out waiting for all PodVolumeBackups to complete")
+		}
+	}
+
+	var waitErr error
 	for _, volumeName := range volumesToBackup {
 		volume, ok := podVolumes[volumeName]
 		if !ok {
@@ -159,41 +227,100 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			continue
 		}
 
+		for acquired := slots == nil; !acquired && waitErr == nil; {
+			acquired, waitErr = next(true)
+		}
+		if waitErr != nil {
+			break
+		}
+
 		volumeBackup := newPodVolumeBackup(backup, pod, volume, repo.Spec.ResticIdentifier, pvc)
-		numVolumeSnapshots++
 		if volumeBackup, err = b.repoManager.veleroClient.VeleroV1().PodVolumeBackups(volumeBackup.Namespace).Create(volumeBackup); err != nil {
+			if slots != nil {
+				<-slots
+			}
 			errs = append(errs, err)
 			continue
 		}
+		numPending++
 	}
 
-ForEachVolume:
-	for i, count := 0, numVolumeSnapshots; i < count; i++ {
-		select {
-		case <-b.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
-			break ForEachVolume
-		case res := <-resultsChan:
-			switch res.Status.Phase {
-			case velerov1api.PodVolumeBackupPhaseCompleted:
-				if res.Status.SnapshotID == "" { // when the volume is empty there is no restic snapshot, so best to exclude it
-					break
-				}
-				podVolumeBackups = append(podVolumeBackups, res)
-			case velerov1api.PodVolumeBackupPhaseFailed:
-				errs = append(errs, errors.Errorf("pod volume backup failed: %s", res.Status.Message))
-				podVolumeBackups = append(podVolumeBackups, res)
+	for waitErr == nil && numPending > 0 {
+		_, waitErr = next(false)
+	}
+
+	if waitErr != nil {
+		errs = append(errs, waitErr)
+
+		// the pending pod volume backups are abandoned, so release their
+		// slots on the node.
+		if slots != nil {
+			for i := 0; i < numPending; i++ {
+				<-slots
 			}
 		}
 	}
 
+	// results of abandoned pod volume backups might still be sent on the
+	// channel while it's being removed, so discard them until it is.
+	removed := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-resultsChan:
+			case <-removed:
+				return
+			}
+		}
+	}()
+
 	b.resultsLock.Lock()
 	delete(b.results, resultsKey(pod.Namespace, pod.Name))
 	b.resultsLock.Unlock()
+	close(removed)
 
 	return podVolumeBackups, errs
 }
 
+// checkNodeReady returns an error if the node doesn't exist or isn't ready.
+// Cordoned nodes are still considered ready, since their pods' volumes can
+// only be backed up from them.
+func (b *backupper) checkNodeReady(name string, log logrus.FieldLogger) error {
+	if name == "" {
+		return errors.New("pod isn't scheduled on a node")
+	}
+
+	node, err := b.nodeClient.Nodes().Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return errors.Errorf("node %s doesn't exist", name)
+	}
+	if err != nil {
+		// don't fail pod volume backups because the node couldn't be
+		// checked.
+		log.WithError(errors.WithStack(err)).Warnf("Error getting node %s to check that it's ready", name)
+		return nil
+	}
+
+	if !isNodeReady(node) {
+		return errors.Errorf("node %s isn't ready", name)
+	}
+	if node.Spec.Unschedulable {
+		log.Warnf("Node %s is cordoned, but its pod volumes can only be backed up from it", name)
+	}
+
+	return nil
+}
+
+// isNodeReady returns whether a node's Ready condition is true.
+func isNodeReady(node *corev1api.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1api.NodeReady {
+			return condition.Status == corev1api.ConditionTrue
+		}
+	}
+	return false
+}
+
 type pvcGetter interface {
 	Get(name string, opts metav1.GetOptions) (*corev1api.PersistentVolumeClaim, error)
 }
@@ -239,6 +366,7 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 				velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
 				velerov1api.BackupUIDLabel:  string(backup.UID),
 			},
+			Annotations: correlationIDAnnotations(backup),
 		},
 		Spec: velerov1api.PodVolumeBackupSpec{
 			Node: pod.Spec.NodeName,
@@ -23,6 +23,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestIsHostPathVolume(t *testing.T) {
@@ -138,3 +143,71 @@ func (g *fakePVGetter) Get(name string, opts metav1.GetOptions) (*corev1api.Pers
 
 	return nil, errors.New("item not found")
 }
+
+func TestNewPodVolumeBackupCorrelationID(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").Result()
+	volume := corev1api.Volume{Name: "vol-1"}
+
+	// a backup without a correlation ID results in a pod volume backup without annotations
+	pvb := newPodVolumeBackup(builder.ForBackup("velero", "backup-1").Result(), pod, volume, "repo-1", nil)
+	assert.Nil(t, pvb.Annotations)
+
+	// a backup's correlation ID is copied to its pod volume backups
+	backup := builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithAnnotations(velerov1api.CorrelationIDAnnotation, "id-1")).Result()
+	pvb = newPodVolumeBackup(backup, pod, volume, "repo-1", nil)
+	assert.Equal(t, map[string]string{velerov1api.CorrelationIDAnnotation: "id-1"}, pvb.Annotations)
+}
+
+func TestCheckNodeReady(t *testing.T) {
+	newNode := func(name string, unschedulable bool, conditions ...corev1api.NodeCondition) *corev1api.Node {
+		return &corev1api.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1api.NodeSpec{Unschedulable: unschedulable},
+			Status:     corev1api.NodeStatus{Conditions: conditions},
+		}
+	}
+	ready := corev1api.NodeCondition{Type: corev1api.NodeReady, Status: corev1api.ConditionTrue}
+	notReady := corev1api.NodeCondition{Type: corev1api.NodeReady, Status: corev1api.ConditionUnknown}
+
+	b := &backupper{
+		nodeClient: fake.NewSimpleClientset(
+			newNode("ready", false, ready),
+			newNode("cordoned", true, ready),
+			newNode("not-ready", false, notReady),
+			newNode("no-conditions", false),
+		).CoreV1(),
+	}
+
+	tests := []struct {
+		node    string
+		wantErr string
+	}{
+		{node: "ready"},
+		{node: "cordoned"},
+		{node: "not-ready", wantErr: "node not-ready isn't ready"},
+		{node: "no-conditions", wantErr: "node no-conditions isn't ready"},
+		{node: "missing", wantErr: "node missing doesn't exist"},
+		{node: "", wantErr: "pod isn't scheduled on a node"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.node, func(t *testing.T) {
+			err := b.checkNodeReady(tc.node, velerotest.NewLogger())
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNodeLimiter(t *testing.T) {
+	assert.Nil(t, newNodeLimiter(0).Slots("node-1"))
+
+	nl := newNodeLimiter(2)
+	slots := nl.Slots("node-1")
+	assert.Equal(t, 2, cap(slots))
+	assert.True(t, slots == nl.Slots("node-1"), "a node's slots are shared")
+	assert.False(t, slots == nl.Slots("node-2"), "nodes have separate slots")
+}
@@ -21,7 +21,10 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
 // Command represents a restic command.
@@ -33,6 +36,10 @@ type Command struct {
 	Args           []string
 	ExtraFlags     []string
 	Env            []string
+
+	// Wrapper is a command, with its arguments, that restic is run by,
+	// e.g. to run it with a lower CPU or I/O priority.
+	Wrapper []string
 }
 
 func (c *Command) RepoName() string {
@@ -45,7 +52,8 @@ func (c *Command) RepoName() string {
 
 // StringSlice returns the command as a slice of strings.
 func (c *Command) StringSlice() []string {
-	res := []string{"restic"}
+	res := append([]string{}, c.Wrapper...)
+	res = append(res, "restic")
 
 	res = append(res, c.Command, repoFlag(c.RepoIdentifier))
 	if c.PasswordFile != "" {
@@ -94,3 +102,76 @@ func passwordFlag(file string) string {
 func cacheDirFlag(dir string) string {
 	return fmt.Sprintf("--cache-dir=%s", dir)
 }
+
+// CommandOptions are settings of the restic server that are applied to the
+// restic commands that it runs to back up and restore pod volumes, so that
+// restic doesn't starve the workloads running on the same node.
+type CommandOptions struct {
+	// LimitUpload and LimitDownload limit restic's upload and download
+	// bandwidth, in KiB/s. Zero means unlimited.
+	LimitUpload   int
+	LimitDownload int
+
+	// Nice is the niceness that restic runs with, from -20 to 19. Zero leaves
+	// restic's niceness as it is.
+	Nice int
+
+	// IONiceClass is the I/O scheduling class that restic runs with, either
+	// best-effort or idle. Empty leaves restic's class as it is.
+	IONiceClass string
+
+	// IONiceLevel is the priority, from 0 (highest) to 7 (lowest), within
+	// the best-effort I/O scheduling class that restic runs with.
+	IONiceLevel int
+}
+
+// DefaultIONiceLevel is the priority within the best-effort I/O scheduling
+// class that processes get when no priority is set for them.
+const DefaultIONiceLevel = 4
+
+// ioniceClasses maps the I/O scheduling classes of CommandOptions to the
+// class numbers of ionice.
+var ioniceClasses = map[string]string{
+	"best-effort": "2",
+	"idle":        "3",
+}
+
+// Validate returns an error if any of the options are invalid.
+func (o CommandOptions) Validate() error {
+	if o.LimitUpload < 0 {
+		return errors.New("upload limit must be non-negative")
+	}
+	if o.LimitDownload < 0 {
+		return errors.New("download limit must be non-negative")
+	}
+	if o.Nice < -20 || o.Nice > 19 {
+		return errors.New("nice must be between -20 and 19")
+	}
+	if _, ok := ioniceClasses[o.IONiceClass]; o.IONiceClass != "" && !ok {
+		return errors.Errorf("invalid ionice class %q, valid classes are best-effort, idle", o.IONiceClass)
+	}
+	if o.IONiceLevel < 0 || o.IONiceLevel > 7 {
+		return errors.New("ionice level must be between 0 and 7")
+	}
+	return nil
+}
+
+// Apply adds the options to a restic command.
+func (o CommandOptions) Apply(c *Command) {
+	if o.LimitUpload > 0 {
+		c.ExtraFlags = append(c.ExtraFlags, fmt.Sprintf("--limit-upload=%d", o.LimitUpload))
+	}
+	if o.LimitDownload > 0 {
+		c.ExtraFlags = append(c.ExtraFlags, fmt.Sprintf("--limit-download=%d", o.LimitDownload))
+	}
+
+	if o.Nice != 0 {
+		c.Wrapper = append(c.Wrapper, "nice", "-n", strconv.Itoa(o.Nice))
+	}
+	switch o.IONiceClass {
+	case "best-effort":
+		c.Wrapper = append(c.Wrapper, "ionice", "-c", ioniceClasses[o.IONiceClass], "-n", strconv.Itoa(o.IONiceLevel))
+	case "idle":
+		c.Wrapper = append(c.Wrapper, "ionice", "-c", ioniceClasses[o.IONiceClass])
+	}
+}
@@ -104,3 +104,86 @@ func TestCmd(t *testing.T) {
 	assert.Equal(t, c.StringSlice(), execCmd.Args)
 	assert.Equal(t, c.Dir, execCmd.Dir)
 }
+
+func TestCommandOptionsApply(t *testing.T) {
+	c := &Command{
+		Command:        "cmd",
+		RepoIdentifier: "repo-id",
+		ExtraFlags:     []string{"--foo=bar"},
+	}
+
+	CommandOptions{LimitUpload: 1024, LimitDownload: 2048, Nice: 10, IONiceClass: "best-effort", IONiceLevel: 7}.Apply(c)
+
+	require.NoError(t, os.Unsetenv("VELERO_SCRATCH_DIR"))
+	assert.Equal(t, []string{
+		"nice", "-n", "10",
+		"ionice", "-c", "2", "-n", "7",
+		"restic",
+		"cmd",
+		"--repo=repo-id",
+		"--foo=bar",
+		"--limit-upload=1024",
+		"--limit-download=2048",
+	}, c.StringSlice())
+
+	c = &Command{Command: "cmd", RepoIdentifier: "repo-id"}
+	CommandOptions{IONiceClass: "idle"}.Apply(c)
+	assert.Equal(t, "ionice -c 3 restic cmd --repo=repo-id", c.String())
+
+	c = &Command{Command: "cmd", RepoIdentifier: "repo-id"}
+	CommandOptions{}.Apply(c)
+	assert.Equal(t, "restic cmd --repo=repo-id", c.String())
+}
+
+func TestCommandOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options CommandOptions
+		wantErr string
+	}{
+		{
+			name:    "zero options are valid",
+			options: CommandOptions{},
+		},
+		{
+			name:    "all options set are valid",
+			options: CommandOptions{LimitUpload: 1, LimitDownload: 1, Nice: -20, IONiceClass: "best-effort", IONiceLevel: 7},
+		},
+		{
+			name:    "negative upload limit",
+			options: CommandOptions{LimitUpload: -1},
+			wantErr: "upload limit must be non-negative",
+		},
+		{
+			name:    "negative download limit",
+			options: CommandOptions{LimitDownload: -1},
+			wantErr: "download limit must be non-negative",
+		},
+		{
+			name:    "nice out of range",
+			options: CommandOptions{Nice: 20},
+			wantErr: "nice must be between -20 and 19",
+		},
+		{
+			name:    "invalid ionice class",
+			options: CommandOptions{IONiceClass: "realtime"},
+			wantErr: `invalid ionice class "realtime", valid classes are best-effort, idle`,
+		},
+		{
+			name:    "ionice level out of range",
+			options: CommandOptions{IONiceLevel: 8},
+			wantErr: "ionice level must be between 0 and 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.options.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -23,14 +23,17 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cloudprovider/azure"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -56,6 +59,11 @@ const (
 	podAnnotationPrefix = "snapshot.velero.io/"
 
 	volumesToBackupAnnotation = "backup.velero.io/backup-volumes"
+
+	// VolumesToExcludeAnnotation is the annotation on a pod listing the
+	// volumes that aren't backed up with restic when all of its volumes
+	// are by default.
+	VolumesToExcludeAnnotation = "backup.velero.io/backup-volumes-excludes"
 )
 
 // getPodSnapshotAnnotations returns a map, of volume name -> snapshot id,
@@ -82,6 +90,18 @@ func getPodSnapshotAnnotations(obj metav1.Object) map[string]string {
 	return res
 }
 
+// correlationIDAnnotations returns the annotations to put on a pod volume
+// backup or restore so that it carries the correlation ID of the backup or
+// restore it was created for, or nil if that doesn't have a correlation ID.
+func correlationIDAnnotations(owner metav1.Object) map[string]string {
+	id := owner.GetAnnotations()[velerov1api.CorrelationIDAnnotation]
+	if id == "" {
+		return nil
+	}
+
+	return map[string]string{velerov1api.CorrelationIDAnnotation: id}
+}
+
 // GetVolumeBackupsForPod returns a map, of volume name -> snapshot id,
 // of the PodVolumeBackups that exist for the provided pod.
 func GetVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod metav1.Object) map[string]string {
@@ -116,6 +136,58 @@ func GetVolumesToBackup(obj metav1.Object) []string {
 	return strings.Split(backupsValue, ",")
 }
 
+// GetPodVolumesToBackup returns a list of the names of a pod's volumes to
+// backup for the provided backup. If the pod's annotations list volumes to
+// backup, only they're backed up. Otherwise, if the backup backs up pod
+// volumes by default, or the pod matches any of the backup's pod volume
+// backup selectors, all of its volumes are backed up, except those listed in
+// its backup.velero.io/backup-volumes-excludes annotation, hostPath volumes,
+// and volumes projected from the Kubernetes API, whose contents are backed up
+// as API objects.
+func GetPodVolumesToBackup(backup *velerov1api.Backup, pod *corev1api.Pod) []string {
+	if volumes := GetVolumesToBackup(pod); len(volumes) > 0 {
+		return volumes
+	}
+
+	if !boolptr.IsSetToTrue(backup.Spec.DefaultVolumesToRestic) && !matchesAnySelector(backup.Spec.PodVolumeBackupSelectors, pod.Labels) {
+		return nil
+	}
+
+	excludes := sets.NewString()
+	if value := pod.Annotations[VolumesToExcludeAnnotation]; value != "" {
+		excludes.Insert(strings.Split(value, ",")...)
+	}
+
+	var volumes []string
+	for _, volume := range pod.Spec.Volumes {
+		if excludes.Has(volume.Name) {
+			continue
+		}
+		if volume.HostPath != nil || volume.Secret != nil || volume.ConfigMap != nil || volume.Projected != nil || volume.DownwardAPI != nil {
+			continue
+		}
+		volumes = append(volumes, volume.Name)
+	}
+
+	return volumes
+}
+
+// matchesAnySelector returns whether a set of labels matches any of a list
+// of label selectors. Invalid selectors don't match anything; backups with
+// them fail validation.
+func matchesAnySelector(selectors []metav1.LabelSelector, set map[string]string) bool {
+	for i := range selectors {
+		selector, err := metav1.LabelSelectorAsSelector(&selectors[i])
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(set)) {
+			return true
+		}
+	}
+	return false
+}
+
 // SnapshotIdentifier uniquely identifies a restic snapshot
 // taken by Velero.
 type SnapshotIdentifier struct {
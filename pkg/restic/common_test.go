@@ -160,6 +160,103 @@ func TestGetVolumesToBackup(t *testing.T) {
 	}
 }
 
+func TestGetPodVolumesToBackup(t *testing.T) {
+	volumes := []*corev1api.Volume{
+		{Name: "data", VolumeSource: corev1api.VolumeSource{PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-1"}}},
+		{Name: "scratch", VolumeSource: corev1api.VolumeSource{EmptyDir: &corev1api.EmptyDirVolumeSource{}}},
+		{Name: "host", VolumeSource: corev1api.VolumeSource{HostPath: &corev1api.HostPathVolumeSource{Path: "/var/log"}}},
+		{Name: "token", VolumeSource: corev1api.VolumeSource{Secret: &corev1api.SecretVolumeSource{SecretName: "token"}}},
+		{Name: "config", VolumeSource: corev1api.VolumeSource{ConfigMap: &corev1api.ConfigMapVolumeSource{}}},
+	}
+
+	tests := []struct {
+		name           string
+		selectors      []metav1.LabelSelector
+		defaultVolumes bool
+		pod            *corev1api.Pod
+		expected       []string
+	}{
+		{
+			name: "pod without annotation and no selectors",
+			pod:  builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "db")).Volumes(volumes...).Result(),
+		},
+		{
+			name:      "pod not matching any selector",
+			selectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "web"}}},
+			pod:       builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "db")).Volumes(volumes...).Result(),
+		},
+		{
+			name: "pod matching a selector has its data volumes backed up",
+			selectors: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"app": "web"}},
+				{MatchLabels: map[string]string{"app": "db"}},
+			},
+			pod:      builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "db")).Volumes(volumes...).Result(),
+			expected: []string{"data", "scratch"},
+		},
+		{
+			name:      "annotation takes precedence over selectors",
+			selectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "db"}}},
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithLabels("app", "db"), builder.WithAnnotations(volumesToBackupAnnotation, "scratch")).
+				Volumes(volumes...).
+				Result(),
+			expected: []string{"scratch"},
+		},
+		{
+			name:           "pod volumes are backed up by default",
+			defaultVolumes: true,
+			pod:            builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "db")).Volumes(volumes...).Result(),
+			expected:       []string{"data", "scratch"},
+		},
+		{
+			name:           "excluded volumes aren't backed up by default",
+			defaultVolumes: true,
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithAnnotations(VolumesToExcludeAnnotation, "scratch,host")).
+				Volumes(volumes...).
+				Result(),
+			expected: []string{"data"},
+		},
+		{
+			name:      "excluded volumes of pods matching a selector aren't backed up",
+			selectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "db"}}},
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithLabels("app", "db"), builder.WithAnnotations(VolumesToExcludeAnnotation, "data")).
+				Volumes(volumes...).
+				Result(),
+			expected: []string{"scratch"},
+		},
+		{
+			name:           "annotation takes precedence over backing up volumes by default",
+			defaultVolumes: true,
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithAnnotations(volumesToBackupAnnotation, "data", VolumesToExcludeAnnotation, "data")).
+				Volumes(volumes...).
+				Result(),
+			expected: []string{"data"},
+		},
+		{
+			name: "invalid selector doesn't match",
+			selectors: []metav1.LabelSelector{
+				{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bogus"}}},
+			},
+			pod: builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "db")).Volumes(volumes...).Result(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := builder.ForBackup("velero", "backup-1").
+				PodVolumeBackupSelectors(test.selectors...).
+				DefaultVolumesToRestic(test.defaultVolumes).
+				Result()
+
+			assert.Equal(t, test.expected, GetPodVolumesToBackup(backup, test.pod))
+		})
+	}
+}
+
 func TestGetSnapshotsInBackup(t *testing.T) {
 	tests := []struct {
 		name                  string
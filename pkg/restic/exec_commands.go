@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -35,6 +36,30 @@ const backupProgressCheckInterval = 10 * time.Second
 
 var fileSystem = filesystem.NewFileSystem()
 
+// syncBuffer is a bytes.Buffer that's safe to read while a command writes
+// its output to it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// Bytes returns a copy of the buffer's contents.
+func (b *syncBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte(nil), b.buf.Bytes()...)
+}
+
+func (b *syncBuffer) String() string {
+	return string(b.Bytes())
+}
+
 type backupStatusLine struct {
 	MessageType string `json:"message_type"`
 	// seen in status lines
@@ -42,6 +67,33 @@ type backupStatusLine struct {
 	BytesDone  int64 `json:"bytes_done"`
 	// seen in summary line at the end
 	TotalBytesProcessed int64 `json:"total_bytes_processed"`
+	DataAdded           int64 `json:"data_added"`
+}
+
+// BackupSummary is the summary of a completed restic backup.
+type BackupSummary struct {
+	// BytesRead is the number of bytes that restic read from the volume.
+	BytesRead int64
+
+	// BytesAdded is the number of bytes that restic added to the
+	// repository, i.e. the data that wasn't already in the repository.
+	BytesAdded int64
+}
+
+// ParseBackupSummary parses the summary line that RunBackup returns.
+func ParseBackupSummary(summary string) (BackupSummary, error) {
+	stat, err := decodeBackupStatusLine([]byte(summary))
+	if err != nil {
+		return BackupSummary{}, err
+	}
+	if stat.MessageType != "summary" {
+		return BackupSummary{}, errors.Errorf("not a restic backup summary: %s", summary)
+	}
+
+	return BackupSummary{
+		BytesRead:  stat.TotalBytesProcessed,
+		BytesAdded: stat.DataAdded,
+	}, nil
 }
 
 // GetSnapshotID runs a 'restic snapshots' command to get the ID of the snapshot
@@ -77,8 +129,9 @@ func GetSnapshotID(repoIdentifier, passwordFile string, tags map[string]string,
 // RunBackup runs a `restic backup` command and watches the output to provide
 // progress updates to the caller.
 func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
-	// buffers for copying command stdout/err output into
-	stdoutBuf := new(bytes.Buffer)
+	// buffers for copying command stdout/err output into. stdout is read
+	// while the command is running to get its progress.
+	stdoutBuf := new(syncBuffer)
 	stderrBuf := new(bytes.Buffer)
 
 	// create a channel to signal when to end the goroutine scanning for progress
@@ -89,7 +142,9 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(veler
 	cmd.Stdout = stdoutBuf
 	cmd.Stderr = stderrBuf
 
-	cmd.Start()
+	if err := cmd.Start(); err != nil {
+		return "", "", errors.Wrap(err, "error starting restic backup command")
+	}
 
 	go func() {
 		ticker := time.NewTicker(backupProgressCheckInterval)
@@ -97,6 +152,10 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(veler
 			select {
 			case <-ticker.C:
 				lastLine := getLastLine(stdoutBuf.Bytes())
+				if len(lastLine) == 0 {
+					// restic hasn't reported its progress yet
+					continue
+				}
 				stat, err := decodeBackupStatusLine(lastLine)
 				if err != nil {
 					log.WithError(err).Errorf("error getting restic backup progress")
@@ -149,13 +208,17 @@ func decodeBackupStatusLine(lastLine []byte) (backupStatusLine, error) {
 	return stat, nil
 }
 
-// getLastLine returns the last line of a byte array. The string is assumed to
-// have a newline at the end of it, so this returns the substring between the
-// last two newlines.
+// getLastLine returns the last complete line of a byte array, i.e. the
+// substring between the last two newlines. A line that's still being written,
+// without a newline at its end, is ignored. It returns nil if there are no
+// complete lines.
 func getLastLine(b []byte) []byte {
-	// subslice the byte array to ignore the newline at the end of the string
-	lastNewLineIdx := bytes.LastIndex(b[:len(b)-1], []byte("\n"))
-	return b[lastNewLineIdx+1 : len(b)-1]
+	end := bytes.LastIndex(b, []byte("\n"))
+	if end < 0 {
+		return nil
+	}
+	start := bytes.LastIndex(b[:end], []byte("\n"))
+	return b[start+1 : end]
 }
 
 // getSummaryLine looks for the summary JSON line
@@ -63,6 +63,18 @@ func Test_getSummaryLine(t *testing.T) {
 	}
 }
 
+func TestParseBackupSummary(t *testing.T) {
+	summary, err := ParseBackupSummary(`{"message_type":"summary","files_new":1,"files_changed":0,"files_unmodified":3,"dirs_new":0,"dirs_changed":0,"dirs_unmodified":0,"data_blobs":1,"tree_blobs":1,"data_added":1048576,"total_files_processed":4,"total_bytes_processed":13238272000,"total_duration":0.319265105,"snapshot_id":"38515bb5"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, BackupSummary{BytesRead: 13238272000, BytesAdded: 1048576}, summary)
+
+	_, err = ParseBackupSummary(`{"message_type":"status","percent_done":0,"total_files":1,"total_bytes":10485760000}`)
+	assert.Error(t, err)
+
+	_, err = ParseBackupSummary("")
+	assert.Error(t, err)
+}
+
 func Test_getLastLine(t *testing.T) {
 	tests := []struct {
 		output string
@@ -74,10 +86,15 @@ func Test_getLastLine(t *testing.T) {
 second line
 third line
 `, "third line"},
+		{`first line
+second line
+incomplete`, "second line"},
+		{"incomplete", ""},
+		{"", ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
-			assert.Equal(t, []byte(tt.want), getLastLine([]byte(tt.output)))
+			assert.Equal(t, tt.want, string(getLastLine([]byte(tt.output))))
 		})
 	}
 }
@@ -0,0 +1,54 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import "sync"
+
+// nodeLimiter limits the number of pod volume backups that the server
+// runs at once on each node. A limit of zero means there's no limit.
+type nodeLimiter struct {
+	limit int
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+func newNodeLimiter(limit int) *nodeLimiter {
+	return &nodeLimiter{
+		limit: limit,
+		slots: make(map[string]chan struct{}),
+	}
+}
+
+// Slots returns a channel with a buffer of the limit's size for the
+// specified node. Sending to the channel acquires a slot on the node, and
+// receiving from it releases one. Slots returns nil if there's no limit.
+func (nl *nodeLimiter) Slots(node string) chan struct{} {
+	if nl.limit <= 0 {
+		return nil
+	}
+
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+
+	slots, ok := nl.slots[node]
+	if !ok {
+		slots = make(chan struct{}, nl.limit)
+		nl.slots[node] = slots
+	}
+
+	return slots
+}
@@ -93,6 +93,8 @@ type repositoryManager struct {
 	ctx                          context.Context
 	pvcClient                    corev1client.PersistentVolumeClaimsGetter
 	pvClient                     corev1client.PersistentVolumesGetter
+	nodeClient                   corev1client.NodesGetter
+	nodeLimiter                  *nodeLimiter
 }
 
 // NewRepositoryManager constructs a RepositoryManager.
@@ -106,6 +108,8 @@ func NewRepositoryManager(
 	backupLocationInformer velerov1informers.BackupStorageLocationInformer,
 	pvcClient corev1client.PersistentVolumeClaimsGetter,
 	pvClient corev1client.PersistentVolumesGetter,
+	nodeClient corev1client.NodesGetter,
+	maxConcurrentBackupsPerNode int,
 	log logrus.FieldLogger,
 ) (RepositoryManager, error) {
 	rm := &repositoryManager{
@@ -118,6 +122,8 @@ func NewRepositoryManager(
 		backupLocationInformerSynced: backupLocationInformer.Informer().HasSynced,
 		pvcClient:                    pvcClient,
 		pvClient:                     pvClient,
+		nodeClient:                   nodeClient,
+		nodeLimiter:                  newNodeLimiter(maxConcurrentBackupsPerNode),
 		log:                          log,
 		ctx:                          ctx,
 
@@ -144,7 +150,7 @@ func (rm *repositoryManager) NewBackupper(ctx context.Context, backup *velerov1a
 		},
 	)
 
-	b := newBackupper(ctx, rm, rm.repoEnsurer, informer, rm.pvcClient, rm.pvClient, rm.log)
+	b := newBackupper(ctx, rm, rm.repoEnsurer, informer, rm.pvcClient, rm.pvClient, rm.nodeClient, rm.nodeLimiter, rm.log)
 
 	go informer.Run(ctx.Done())
 	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, rm.repoInformerSynced) {
@@ -234,6 +240,13 @@ func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdenti
 	return rm.exec(ForgetCommand(repo.Spec.ResticIdentifier, snapshot.SnapshotID), repo.Spec.BackupStorageLocation)
 }
 
+// IsRepoLockedError returns whether err is from running a restic command
+// against a repository that's locked by another restic process, e.g. a pod
+// volume backup running in the restic daemonset.
+func IsRepoLockedError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "repository is already locked")
+}
+
 func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 	file, err := TempCredentialsFile(rm.secretsLister, rm.namespace, cmd.RepoName(), rm.fileSystem)
 	if err != nil {
@@ -167,6 +167,7 @@ func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backu
 				velerov1api.RestoreUIDLabel:  string(restore.UID),
 				velerov1api.PodUIDLabel:      string(pod.UID),
 			},
+			Annotations: correlationIDAnnotations(restore),
 		},
 		Spec: velerov1api.PodVolumeRestoreSpec{
 			Pod: corev1api.ObjectReference{
@@ -0,0 +1,197 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// ignoreFailurePolicy is the failure policy that lets API requests through when
+// a webhook can't be called.
+const ignoreFailurePolicy = "Ignore"
+
+// isAdmissionWebhookConfiguration returns whether a resource is validating or
+// mutating admission webhook configurations.
+func isAdmissionWebhookConfiguration(resource schema.GroupResource) bool {
+	return resource == kuberesource.ValidatingWebhookConfigurations || resource == kuberesource.MutatingWebhookConfigurations
+}
+
+// restoreAdmissionWebhooksLast moves the admission webhook configurations in a
+// list of prioritized resources to the end of it, keeping the order of the
+// other resources.
+func restoreAdmissionWebhooksLast(resources []schema.GroupResource) []schema.GroupResource {
+	var ordered, webhooks []schema.GroupResource
+	for _, resource := range resources {
+		if isAdmissionWebhookConfiguration(resource) {
+			webhooks = append(webhooks, resource)
+		} else {
+			ordered = append(ordered, resource)
+		}
+	}
+
+	return append(ordered, webhooks...)
+}
+
+// backupHasAdmissionWebhooks returns whether a backup has any admission webhook
+// configurations.
+func backupHasAdmissionWebhooks(backupResources map[string]*archive.ResourceItems) bool {
+	for _, resource := range []schema.GroupResource{kuberesource.ValidatingWebhookConfigurations, kuberesource.MutatingWebhookConfigurations} {
+		if items := backupResources[resource.String()]; items != nil && len(items.ItemsByNamespace) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ignoreWebhookFailures sets the failure policies of the webhooks of an admission
+// webhook configuration that's about to be restored to Ignore, recording their
+// original failure policies, by webhook name, in an annotation. It returns the
+// original failure policies, or nil if they were all already Ignore. Webhooks
+// without a failure policy are recorded with an empty one.
+func ignoreWebhookFailures(obj *unstructured.Unstructured) (map[string]string, error) {
+	webhooks, _, err := unstructured.NestedSlice(obj.Object, "webhooks")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	policies := make(map[string]string)
+	for i := range webhooks {
+		webhook, ok := webhooks[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _, _ := unstructured.NestedString(webhook, "name")
+		policy, _, _ := unstructured.NestedString(webhook, "failurePolicy")
+		if policy == ignoreFailurePolicy {
+			continue
+		}
+
+		policies[name] = policy
+		webhook["failurePolicy"] = ignoreFailurePolicy
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	if err := unstructured.SetNestedSlice(obj.Object, webhooks, "webhooks"); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	policiesJSON, err := json.Marshal(policies)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[velerov1api.OriginalFailurePoliciesAnnotation] = string(policiesJSON)
+	obj.SetAnnotations(annotations)
+
+	return policies, nil
+}
+
+// ignoredWebhooks is an admission webhook configuration whose webhooks'
+// failure policies were set to Ignore while it was restored.
+type ignoredWebhooks struct {
+	resource schema.GroupResource
+	name     string
+	policies map[string]string
+}
+
+// revertIgnoredWebhooks sets the failure policies of the webhooks that were set to
+// Ignore while they were restored back to their original failure policies. Unlike
+// persistent volumes' reclaim policies, they're reverted even if the restore had
+// errors, since leaving webhooks' failures ignored would silently weaken the
+// policies they enforce.
+func (ctx *context) revertIgnoredWebhooks() Result {
+	errs := Result{}
+
+	for _, ignored := range ctx.ignoredWebhooks {
+		// the client was created when the admission webhook configuration was restored
+		client, ok := ctx.resourceClients[resourceClientKey{resource: ignored.resource}]
+		if !ok {
+			continue
+		}
+
+		obj, err := client.Get(ignored.name, metav1.GetOptions{})
+		if err != nil {
+			addToResult(&errs, "", errors.Wrapf(err, "error getting %s %s to set the failure policies of its webhooks back", ignored.resource, ignored.name))
+			continue
+		}
+
+		webhooks, _, err := unstructured.NestedSlice(obj.Object, "webhooks")
+		if err != nil {
+			addToResult(&errs, "", errors.WithStack(err))
+			continue
+		}
+
+		for i := range webhooks {
+			webhook, ok := webhooks[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name, _, _ := unstructured.NestedString(webhook, "name")
+			policy, ok := ignored.policies[name]
+			if !ok {
+				continue
+			}
+
+			if policy == "" {
+				delete(webhook, "failurePolicy")
+			} else {
+				webhook["failurePolicy"] = policy
+			}
+		}
+
+		patch := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					velerov1api.OriginalFailurePoliciesAnnotation: nil,
+				},
+			},
+			"webhooks": webhooks,
+		}
+
+		patchBytes, err := json.Marshal(patch)
+		if err != nil {
+			addToResult(&errs, "", errors.WithStack(err))
+			continue
+		}
+
+		ctx.log.Infof("Setting failure policies of the webhooks of %s %s back to their original values", ignored.resource, ignored.name)
+		if _, err := client.Patch(ignored.name, patchBytes); err != nil {
+			addToResult(&errs, "", errors.Wrapf(err, "error setting failure policies of the webhooks of %s %s back to their original values", ignored.resource, ignored.name))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newWebhookConfiguration(name string, webhooks ...map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "admissionregistration.k8s.io/v1beta1",
+			"kind":       "ValidatingWebhookConfiguration",
+		},
+	}
+	obj.SetName(name)
+
+	var list []interface{}
+	for _, webhook := range webhooks {
+		list = append(list, webhook)
+	}
+	obj.Object["webhooks"] = list
+
+	return obj
+}
+
+func webhookFailurePolicies(t *testing.T, obj *unstructured.Unstructured) map[string]interface{} {
+	webhooks, _, err := unstructured.NestedSlice(obj.Object, "webhooks")
+	require.NoError(t, err)
+
+	policies := make(map[string]interface{})
+	for _, webhook := range webhooks {
+		webhook := webhook.(map[string]interface{})
+		policies[webhook["name"].(string)] = webhook["failurePolicy"]
+	}
+	return policies
+}
+
+func TestRestoreAdmissionWebhooksLast(t *testing.T) {
+	resources := []schema.GroupResource{
+		kuberesource.CustomResourceDefinitions,
+		kuberesource.ValidatingWebhookConfigurations,
+		kuberesource.Pods,
+		kuberesource.MutatingWebhookConfigurations,
+		{Resource: "services"},
+	}
+
+	assert.Equal(t, []schema.GroupResource{
+		kuberesource.CustomResourceDefinitions,
+		kuberesource.Pods,
+		{Resource: "services"},
+		kuberesource.ValidatingWebhookConfigurations,
+		kuberesource.MutatingWebhookConfigurations,
+	}, restoreAdmissionWebhooksLast(resources))
+}
+
+func TestIgnoreWebhookFailures(t *testing.T) {
+	tests := []struct {
+		name                string
+		obj                 *unstructured.Unstructured
+		wantPolicies        map[string]string
+		wantAnnotation      string
+		wantWebhookPolicies map[string]interface{}
+	}{
+		{
+			name: "webhooks that fail closed or have no failure policy are set to Ignore",
+			obj: newWebhookConfiguration("config-1",
+				map[string]interface{}{"name": "fail.example.io", "failurePolicy": "Fail"},
+				map[string]interface{}{"name": "ignore.example.io", "failurePolicy": "Ignore"},
+				map[string]interface{}{"name": "unset.example.io"},
+			),
+			wantPolicies:   map[string]string{"fail.example.io": "Fail", "unset.example.io": ""},
+			wantAnnotation: `{"fail.example.io":"Fail","unset.example.io":""}`,
+			wantWebhookPolicies: map[string]interface{}{
+				"fail.example.io":   "Ignore",
+				"ignore.example.io": "Ignore",
+				"unset.example.io":  "Ignore",
+			},
+		},
+		{
+			name: "webhooks that are all already Ignore are left as they are",
+			obj: newWebhookConfiguration("config-1",
+				map[string]interface{}{"name": "ignore.example.io", "failurePolicy": "Ignore"},
+			),
+			wantWebhookPolicies: map[string]interface{}{
+				"ignore.example.io": "Ignore",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policies, err := ignoreWebhookFailures(tc.obj)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantPolicies, policies)
+			assert.Equal(t, tc.wantAnnotation, tc.obj.GetAnnotations()[velerov1api.OriginalFailurePoliciesAnnotation])
+			assert.Equal(t, tc.wantWebhookPolicies, webhookFailurePolicies(t, tc.obj))
+		})
+	}
+}
+
+func TestRevertIgnoredWebhooks(t *testing.T) {
+	apiServer := test.NewAPIServer(t)
+
+	webhookClient, err := client.NewDynamicFactory(apiServer.DynamicClient).ClientForGroupVersionResource(
+		schema.GroupVersion{Group: "admissionregistration.k8s.io", Version: "v1beta1"},
+		metav1.APIResource{Name: "validatingwebhookconfigurations"},
+		"",
+	)
+	require.NoError(t, err)
+
+	obj := newWebhookConfiguration("config-1",
+		map[string]interface{}{"name": "fail.example.io", "failurePolicy": "Fail"},
+		map[string]interface{}{"name": "ignore.example.io", "failurePolicy": "Ignore"},
+		map[string]interface{}{"name": "unset.example.io"},
+	)
+	policies, err := ignoreWebhookFailures(obj)
+	require.NoError(t, err)
+
+	_, err = webhookClient.Create(obj)
+	require.NoError(t, err)
+
+	ctx := &context{
+		log: test.NewLogger(),
+		resourceClients: map[resourceClientKey]client.Dynamic{
+			{resource: kuberesource.ValidatingWebhookConfigurations}: webhookClient,
+		},
+		ignoredWebhooks: []ignoredWebhooks{
+			{resource: kuberesource.ValidatingWebhookConfigurations, name: "config-1", policies: policies},
+		},
+	}
+
+	assert.Equal(t, Result{}, ctx.revertIgnoredWebhooks())
+
+	res, err := webhookClient.Get("config-1", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.NotContains(t, res.GetAnnotations(), velerov1api.OriginalFailurePoliciesAnnotation)
+	assert.Equal(t, map[string]interface{}{
+		"fail.example.io":   "Fail",
+		"ignore.example.io": "Ignore",
+		"unset.example.io":  nil,
+	}, webhookFailurePolicies(t, res))
+}
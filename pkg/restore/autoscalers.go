@@ -0,0 +1,127 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// defaultMinReplicas is the minimum replica count of horizontal pod
+// autoscalers that don't have one.
+const defaultMinReplicas = 1
+
+// autoscalerTarget identifies the scale target of a horizontal pod
+// autoscaler. The API group of the target isn't part of it, since workloads
+// can be backed up with a different group than their autoscaler refers to
+// them by, e.g. extensions and apps deployments.
+type autoscalerTarget struct {
+	namespace string
+	kind      string
+	name      string
+}
+
+// getAutoscalerTargets returns the minimum replica counts of the horizontal pod
+// autoscalers in the backup that are being restored, by their scale targets.
+func (ctx *context) getAutoscalerTargets(backupResources map[string]*archive.ResourceItems) map[autoscalerTarget]int64 {
+	targets := make(map[autoscalerTarget]int64)
+
+	resourceList := backupResources[kuberesource.HorizontalPodAutoscalers.String()]
+	if resourceList == nil {
+		return targets
+	}
+
+	for namespace, items := range resourceList.ItemsByNamespace {
+		if !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+			continue
+		}
+
+		for _, item := range items {
+			obj, err := ctx.unmarshal(getItemFilePath(ctx.restoreDir, kuberesource.HorizontalPodAutoscalers.String(), namespace, item))
+			if err != nil {
+				// restoreResource reports this error when it tries to restore the item
+				continue
+			}
+
+			target, minReplicas, err := autoscalerTargetOf(obj)
+			if err != nil {
+				ctx.log.WithError(err).Warnf("Unable to get the scale target of horizontal pod autoscaler %s/%s", namespace, item)
+				continue
+			}
+			targets[target] = minReplicas
+		}
+	}
+
+	return targets
+}
+
+// autoscalerTargetOf returns the scale target and minimum replica count of a
+// horizontal pod autoscaler.
+func autoscalerTargetOf(hpa *unstructured.Unstructured) (autoscalerTarget, int64, error) {
+	kind, _, err := unstructured.NestedString(hpa.Object, "spec", "scaleTargetRef", "kind")
+	if err != nil {
+		return autoscalerTarget{}, 0, errors.WithStack(err)
+	}
+	name, _, err := unstructured.NestedString(hpa.Object, "spec", "scaleTargetRef", "name")
+	if err != nil {
+		return autoscalerTarget{}, 0, errors.WithStack(err)
+	}
+	if kind == "" || name == "" {
+		return autoscalerTarget{}, 0, errors.New("spec.scaleTargetRef must have a kind and a name")
+	}
+
+	minReplicas, found, err := unstructured.NestedInt64(hpa.Object, "spec", "minReplicas")
+	if err != nil {
+		return autoscalerTarget{}, 0, errors.WithStack(err)
+	}
+	if !found {
+		minReplicas = defaultMinReplicas
+	}
+
+	return autoscalerTarget{namespace: hpa.GetNamespace(), kind: kind, name: name}, minReplicas, nil
+}
+
+// setAutoscaledReplicas sets the replica count of a workload that's the scale
+// target of a horizontal pod autoscaler in the backup according to policy, and
+// returns whether it was changed. The workload's namespace must be the one it
+// was backed up from.
+func setAutoscaledReplicas(obj *unstructured.Unstructured, targets map[autoscalerTarget]int64, policy velerov1api.AutoscaledReplicaPolicy) (bool, error) {
+	minReplicas, ok := targets[autoscalerTarget{namespace: obj.GetNamespace(), kind: obj.GetKind(), name: obj.GetName()}]
+	if !ok {
+		return false, nil
+	}
+
+	switch policy {
+	case velerov1api.AutoscaledReplicaPolicyOmit:
+		return kube.RemoveReplicas(obj.Object), nil
+	case velerov1api.AutoscaledReplicaPolicyMinReplicas:
+		if replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found && replicas == minReplicas {
+			return false, nil
+		}
+		if err := unstructured.SetNestedField(obj.Object, minReplicas, "spec", "replicas"); err != nil {
+			return false, errors.WithStack(err)
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
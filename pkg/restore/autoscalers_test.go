@@ -0,0 +1,139 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func newBackedUpHPA(spec map[string]interface{}) *unstructured.Unstructured {
+	hpa := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "HorizontalPodAutoscaler",
+		"spec":       spec,
+	}}
+	hpa.SetNamespace("ns-1")
+	hpa.SetName("hpa-1")
+
+	return hpa
+}
+
+func newBackedUpDeployment(name string, replicas interface{}) *unstructured.Unstructured {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec":       map[string]interface{}{},
+	}}
+	deployment.SetNamespace("ns-1")
+	deployment.SetName(name)
+	if replicas != nil {
+		deployment.Object["spec"].(map[string]interface{})["replicas"] = replicas
+	}
+
+	return deployment
+}
+
+func TestAutoscalerTargetOf(t *testing.T) {
+	target, minReplicas, err := autoscalerTargetOf(newBackedUpHPA(map[string]interface{}{
+		"scaleTargetRef": map[string]interface{}{"apiVersion": "extensions/v1beta1", "kind": "Deployment", "name": "deploy-1"},
+		"minReplicas":    int64(3),
+		"maxReplicas":    int64(10),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, autoscalerTarget{namespace: "ns-1", kind: "Deployment", name: "deploy-1"}, target)
+	assert.Equal(t, int64(3), minReplicas)
+
+	_, minReplicas, err = autoscalerTargetOf(newBackedUpHPA(map[string]interface{}{
+		"scaleTargetRef": map[string]interface{}{"apiVersion": "apps/v1", "kind": "StatefulSet", "name": "sts-1"},
+		"maxReplicas":    int64(10),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), minReplicas, "autoscalers without a minimum replica count default to 1")
+
+	_, _, err = autoscalerTargetOf(newBackedUpHPA(map[string]interface{}{"maxReplicas": int64(10)}))
+	assert.EqualError(t, err, "spec.scaleTargetRef must have a kind and a name")
+}
+
+func TestSetAutoscaledReplicas(t *testing.T) {
+	targets := map[autoscalerTarget]int64{
+		{namespace: "ns-1", kind: "Deployment", name: "deploy-1"}: 3,
+	}
+
+	tests := []struct {
+		name        string
+		obj         *unstructured.Unstructured
+		policy      velerov1api.AutoscaledReplicaPolicy
+		wantChanged bool
+		want        interface{}
+	}{
+		{
+			name:   "workloads aren't changed with the Keep policy",
+			obj:    newBackedUpDeployment("deploy-1", int64(7)),
+			policy: velerov1api.AutoscaledReplicaPolicyKeep,
+			want:   int64(7),
+		},
+		{
+			name:        "replica counts are removed with the Omit policy",
+			obj:         newBackedUpDeployment("deploy-1", int64(7)),
+			policy:      velerov1api.AutoscaledReplicaPolicyOmit,
+			wantChanged: true,
+		},
+		{
+			name:        "replica counts are set to the autoscaler's minimum with the MinReplicas policy",
+			obj:         newBackedUpDeployment("deploy-1", int64(7)),
+			policy:      velerov1api.AutoscaledReplicaPolicyMinReplicas,
+			wantChanged: true,
+			want:        int64(3),
+		},
+		{
+			name:        "replica counts are added with the MinReplicas policy",
+			obj:         newBackedUpDeployment("deploy-1", nil),
+			policy:      velerov1api.AutoscaledReplicaPolicyMinReplicas,
+			wantChanged: true,
+			want:        int64(3),
+		},
+		{
+			name:   "replica counts that are already the autoscaler's minimum aren't changed",
+			obj:    newBackedUpDeployment("deploy-1", int64(3)),
+			policy: velerov1api.AutoscaledReplicaPolicyMinReplicas,
+			want:   int64(3),
+		},
+		{
+			name:   "workloads that aren't autoscaled aren't changed",
+			obj:    newBackedUpDeployment("deploy-2", int64(7)),
+			policy: velerov1api.AutoscaledReplicaPolicyMinReplicas,
+			want:   int64(7),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			changed, err := setAutoscaledReplicas(tc.obj, targets, tc.policy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantChanged, changed)
+
+			replicas, _, _ := unstructured.NestedFieldNoCopy(tc.obj.Object, "spec", "replicas")
+			assert.Equal(t, tc.want, replicas)
+		})
+	}
+}
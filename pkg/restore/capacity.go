@@ -0,0 +1,443 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// noProvisioner is the provisioner of storage classes whose volumes are
+// created statically rather than provisioned on demand, e.g. local volumes.
+const noProvisioner = "kubernetes.io/no-provisioner"
+
+// capacityEstimate is the storage that the persistent volume claims and pods
+// being restored request, which is compared against the cluster's resource
+// quotas, storage classes and nodes before anything is restored.
+type capacityEstimate struct {
+	// namespaces are the storage requests by target namespace, keyed by the
+	// resource quota resources that they count towards.
+	namespaces map[string]corev1api.ResourceList
+
+	// storageClasses are the storage requests of the claims that need new
+	// volumes, i.e. whose volumes aren't in the backup, by storage class.
+	storageClasses map[string]resource.Quantity
+
+	// pods are the ephemeral storage requests of pods, by their target
+	// namespace and name.
+	pods map[string]resource.Quantity
+}
+
+// addRequests adds requests to the requests of a target namespace.
+func (e *capacityEstimate) addRequests(namespace string, requests corev1api.ResourceList) {
+	total, ok := e.namespaces[namespace]
+	if !ok {
+		total = corev1api.ResourceList{}
+		e.namespaces[namespace] = total
+	}
+
+	for name, quantity := range requests {
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
+
+// claimStorageRequests returns the storage that a persistent volume claim
+// requests, keyed by the resource quota resources that it counts towards.
+func claimStorageRequests(pvc *corev1api.PersistentVolumeClaim) corev1api.ResourceList {
+	storage := pvc.Spec.Resources.Requests[corev1api.ResourceStorage]
+
+	requests := corev1api.ResourceList{
+		corev1api.ResourceRequestsStorage:        storage,
+		corev1api.ResourcePersistentVolumeClaims: *resource.NewQuantity(1, resource.DecimalSI),
+	}
+
+	if class := storageClassOf(pvc); class != "" {
+		requests[corev1api.ResourceName(class+".storageclass.storage.k8s.io/"+string(corev1api.ResourceRequestsStorage))] = storage
+		requests[corev1api.ResourceName(class+".storageclass.storage.k8s.io/"+string(corev1api.ResourcePersistentVolumeClaims))] = *resource.NewQuantity(1, resource.DecimalSI)
+	}
+
+	return requests
+}
+
+// storageClassOf returns the storage class of a persistent volume claim, from
+// its spec or the beta annotation that preceded it.
+func storageClassOf(pvc *corev1api.PersistentVolumeClaim) string {
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+	return pvc.Annotations[corev1api.BetaStorageClassAnnotation]
+}
+
+// podEphemeralStorageRequest returns the ephemeral storage that a pod's
+// containers request.
+func podEphemeralStorageRequest(pod *corev1api.Pod) resource.Quantity {
+	var total resource.Quantity
+	for _, container := range pod.Spec.Containers {
+		if request, ok := container.Resources.Requests[corev1api.ResourceEphemeralStorage]; ok {
+			total.Add(request)
+		}
+	}
+	return total
+}
+
+// estimateCapacity returns the storage requested by the persistent volume
+// claims and pods in the backup that are being restored.
+func (ctx *context) estimateCapacity(backupResources map[string]*archive.ResourceItems) capacityEstimate {
+	estimate := capacityEstimate{
+		namespaces:     make(map[string]corev1api.ResourceList),
+		storageClasses: make(map[string]resource.Quantity),
+		pods:           make(map[string]resource.Quantity),
+	}
+
+	backedUpPVs := sets.NewString()
+	if pvs := backupResources[kuberesource.PersistentVolumes.String()]; pvs != nil && ctx.resourceIncludesExcludes.ShouldInclude(kuberesource.PersistentVolumes.String()) {
+		backedUpPVs.Insert(pvs.ItemsByNamespace[""]...)
+	}
+
+	ctx.forEachRestoringItem(backupResources, kuberesource.PersistentVolumeClaims, func(targetNamespace string, obj *unstructured.Unstructured) error {
+		pvc := new(corev1api.PersistentVolumeClaim)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+			return errors.WithStack(err)
+		}
+
+		estimate.addRequests(targetNamespace, claimStorageRequests(pvc))
+
+		if class := storageClassOf(pvc); class != "" && (pvc.Spec.VolumeName == "" || !backedUpPVs.Has(pvc.Spec.VolumeName)) {
+			total := estimate.storageClasses[class]
+			total.Add(pvc.Spec.Resources.Requests[corev1api.ResourceStorage])
+			estimate.storageClasses[class] = total
+		}
+		return nil
+	})
+
+	ctx.forEachRestoringItem(backupResources, kuberesource.Pods, func(targetNamespace string, obj *unstructured.Unstructured) error {
+		pod := new(corev1api.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+			return errors.WithStack(err)
+		}
+
+		request := podEphemeralStorageRequest(pod)
+		if request.IsZero() {
+			return nil
+		}
+
+		estimate.addRequests(targetNamespace, corev1api.ResourceList{corev1api.ResourceRequestsEphemeralStorage: request})
+		estimate.pods[targetNamespace+"/"+pod.Name] = request
+		return nil
+	})
+
+	return estimate
+}
+
+// forEachRestoringItem calls fn with each item of a namespaced resource in the
+// backup that's included in the restore by its resource, namespace, label
+// selector and retry filters, and the namespace that it's restored into.
+func (ctx *context) forEachRestoringItem(backupResources map[string]*archive.ResourceItems, resource schema.GroupResource, fn func(string, *unstructured.Unstructured) error) {
+	resourceList := backupResources[resource.String()]
+	if resourceList == nil || !ctx.resourceIncludesExcludes.ShouldInclude(resource.String()) {
+		return
+	}
+
+	for namespace, items := range resourceList.ItemsByNamespace {
+		if namespace == "" || !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+			continue
+		}
+
+		targetNamespace, _ := ctx.namespaceMapper.targetNamespace(namespace)
+
+		for _, item := range filterRetryItems(ctx.retryItems, resource, namespace, items) {
+			obj, err := ctx.unmarshal(getItemFilePath(ctx.restoreDir, resource.String(), namespace, item))
+			if err != nil {
+				// restoreResource reports this error when it tries to restore the item
+				continue
+			}
+
+			if !ctx.selector.Matches(labels.Set(obj.GetLabels())) {
+				continue
+			}
+
+			if err := fn(targetNamespace, obj); err != nil {
+				ctx.log.WithError(err).Debugf("Unable to estimate the storage requested by %s %s/%s", resource, namespace, item)
+			}
+		}
+	}
+}
+
+// checkCapacity compares the storage that the items being restored request
+// against the resource quotas of the namespaces they're restored into, the
+// storage classes of their persistent volume claims and the allocatable
+// ephemeral storage of the cluster's nodes, and returns a warning for each
+// request that won't fit. Nothing is checked if the cluster's objects can't
+// be listed.
+func (ctx *context) checkCapacity(estimate capacityEstimate) Result {
+	warnings := Result{}
+
+	var namespaces []string
+	for namespace := range estimate.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	for _, namespace := range namespaces {
+		list, err := ctx.listItems(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: "resourcequotas", Namespaced: true}, namespace)
+		if err != nil {
+			ctx.log.WithError(err).Debugf("Unable to list resource quotas in namespace %s", namespace)
+			continue
+		}
+
+		for _, item := range list {
+			quota := new(corev1api.ResourceQuota)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), quota); err != nil {
+				ctx.log.WithError(errors.WithStack(err)).Debugf("Unable to decode resource quota %s/%s", namespace, item.GetName())
+				continue
+			}
+
+			for _, err := range quotaWarnings(quota, estimate.namespaces[namespace]) {
+				addToResult(&warnings, namespace, err)
+			}
+		}
+	}
+
+	if len(estimate.storageClasses) > 0 {
+		if err := ctx.checkStorageClasses(estimate.storageClasses, &warnings); err != nil {
+			ctx.log.WithError(err).Debug("Unable to check the capacity of storage classes")
+		}
+	}
+
+	if len(estimate.pods) > 0 {
+		if err := ctx.checkNodes(estimate.pods, &warnings); err != nil {
+			ctx.log.WithError(err).Debug("Unable to check the allocatable ephemeral storage of nodes")
+		}
+	}
+
+	return warnings
+}
+
+// quotaWarnings returns an error for each resource of a resource quota whose
+// limit would be exceeded if requests were added to its usage.
+func quotaWarnings(quota *corev1api.ResourceQuota, requests corev1api.ResourceList) []error {
+	var errs []error
+
+	var names []string
+	for name := range quota.Spec.Hard {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		requested, ok := requests[corev1api.ResourceName(name)]
+		if !ok {
+			continue
+		}
+
+		hard := quota.Spec.Hard[corev1api.ResourceName(name)]
+		used := quota.Status.Used[corev1api.ResourceName(name)]
+
+		total := used.DeepCopy()
+		total.Add(requested)
+		if total.Cmp(hard) > 0 {
+			errs = append(errs, errors.Errorf("the restore requests %s of %s, which would exceed resource quota %s's limit of %s, of which %s is used", requested.String(), name, quota.Name, hard.String(), used.String()))
+		}
+	}
+
+	return errs
+}
+
+// checkStorageClasses adds a warning for each storage class that claims
+// being restored request volumes of, but that doesn't exist, or whose
+// volumes aren't provisioned on demand and whose available volumes don't
+// have enough capacity for the claims.
+func (ctx *context) checkStorageClasses(requests map[string]resource.Quantity, warnings *Result) error {
+	classList, err := ctx.listItems(storagev1api.SchemeGroupVersion, metav1.APIResource{Name: "storageclasses"}, "")
+	if err != nil {
+		return err
+	}
+
+	classes := make(map[string]*storagev1api.StorageClass)
+	for _, item := range classList {
+		class := new(storagev1api.StorageClass)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), class); err != nil {
+			return errors.WithStack(err)
+		}
+		classes[class.Name] = class
+	}
+
+	var names []string
+	for name := range requests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var available map[string]resource.Quantity
+	for _, name := range names {
+		requested := requests[name]
+
+		class := classes[name]
+		if class == nil {
+			addToResult(warnings, "", errors.Errorf("the restore requests %s of volumes of storage class %s, which doesn't exist", requested.String(), name))
+			continue
+		}
+
+		if class.Provisioner != noProvisioner {
+			continue
+		}
+
+		if available == nil {
+			if available, err = ctx.availablePVCapacity(); err != nil {
+				return err
+			}
+		}
+
+		capacity := available[name]
+		if requested.Cmp(capacity) > 0 {
+			addToResult(warnings, "", errors.Errorf("the restore requests %s of volumes of storage class %s, but the class's volumes aren't provisioned on demand and only %s of them are available", requested.String(), name, capacity.String()))
+		}
+	}
+
+	return nil
+}
+
+// availablePVCapacity returns the total capacity of the persistent volumes in
+// the cluster that are available to be bound, by storage class.
+func (ctx *context) availablePVCapacity() (map[string]resource.Quantity, error) {
+	list, err := ctx.listItems(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: "persistentvolumes"}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]resource.Quantity)
+	for _, item := range list {
+		pv := new(corev1api.PersistentVolume)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pv); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if pv.Status.Phase != corev1api.VolumeAvailable || pv.Spec.ClaimRef != nil {
+			continue
+		}
+
+		total := available[pv.Spec.StorageClassName]
+		total.Add(pv.Spec.Capacity[corev1api.ResourceStorage])
+		available[pv.Spec.StorageClassName] = total
+	}
+
+	return available, nil
+}
+
+// checkNodes adds a warning for each pod being restored that requests more
+// ephemeral storage than any schedulable node can allocate, and one if the
+// pods together request more than all of the schedulable nodes can.
+func (ctx *context) checkNodes(pods map[string]resource.Quantity, warnings *Result) error {
+	list, err := ctx.listItems(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: "nodes"}, "")
+	if err != nil {
+		return err
+	}
+
+	var nodes []*corev1api.Node
+	for _, item := range list {
+		node := new(corev1api.Node)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), node); err != nil {
+			return errors.WithStack(err)
+		}
+		nodes = append(nodes, node)
+	}
+
+	for _, err := range nodeWarnings(nodes, pods) {
+		addToResult(warnings, "", err)
+	}
+
+	return nil
+}
+
+// nodeWarnings returns an error for each pod whose ephemeral storage request
+// is more than any schedulable node can allocate, and one if the pods'
+// requests add up to more than all of the schedulable nodes can. Nothing is
+// returned if none of the nodes report their allocatable ephemeral storage.
+func nodeWarnings(nodes []*corev1api.Node, pods map[string]resource.Quantity) []error {
+	var largest, total resource.Quantity
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			continue
+		}
+
+		allocatable := node.Status.Allocatable[corev1api.ResourceEphemeralStorage]
+		if allocatable.Cmp(largest) > 0 {
+			largest = allocatable.DeepCopy()
+		}
+		total.Add(allocatable)
+	}
+
+	if total.IsZero() {
+		return nil
+	}
+
+	var names []string
+	for name := range pods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	var requested resource.Quantity
+	for _, pod := range names {
+		request := pods[pod]
+		if request.Cmp(largest) > 0 {
+			errs = append(errs, errors.Errorf("pod %s requests %s of ephemeral storage, but no schedulable node has more than %s allocatable", pod, request.String(), largest.String()))
+		}
+		requested.Add(request)
+	}
+
+	if requested.Cmp(total) > 0 {
+		errs = append(errs, errors.Errorf("the restore's pods request %s of ephemeral storage, but the schedulable nodes only have %s allocatable", requested.String(), total.String()))
+	}
+
+	return errs
+}
+
+// listItems lists the items of a resource in the cluster.
+func (ctx *context) listItems(gv schema.GroupVersion, resource metav1.APIResource, namespace string) ([]unstructured.Unstructured, error) {
+	client, err := ctx.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	list, ok := res.(*unstructured.UnstructuredList)
+	if !ok {
+		return nil, errors.Errorf("unexpected list type %T", res)
+	}
+	return list.Items, nil
+}
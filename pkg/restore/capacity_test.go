@@ -0,0 +1,178 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClaimStorageRequests(t *testing.T) {
+	class := "fast"
+	pvc := &corev1api.PersistentVolumeClaim{
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			StorageClassName: &class,
+			Resources: corev1api.ResourceRequirements{
+				Requests: corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+	}
+
+	assert.Equal(t, corev1api.ResourceList{
+		"requests.storage":                                        resource.MustParse("10Gi"),
+		"persistentvolumeclaims":                                  *resource.NewQuantity(1, resource.DecimalSI),
+		"fast.storageclass.storage.k8s.io/requests.storage":       resource.MustParse("10Gi"),
+		"fast.storageclass.storage.k8s.io/persistentvolumeclaims": *resource.NewQuantity(1, resource.DecimalSI),
+	}, claimStorageRequests(pvc))
+
+	pvc.Spec.StorageClassName = nil
+	assert.Len(t, claimStorageRequests(pvc), 2, "claims without a storage class only count towards the total")
+
+	pvc.Annotations = map[string]string{corev1api.BetaStorageClassAnnotation: "slow"}
+	assert.Contains(t, claimStorageRequests(pvc), corev1api.ResourceName("slow.storageclass.storage.k8s.io/requests.storage"))
+}
+
+func TestPodEphemeralStorageRequest(t *testing.T) {
+	pod := &corev1api.Pod{
+		Spec: corev1api.PodSpec{
+			Containers: []corev1api.Container{
+				{Resources: corev1api.ResourceRequirements{Requests: corev1api.ResourceList{corev1api.ResourceEphemeralStorage: resource.MustParse("1Gi")}}},
+				{Resources: corev1api.ResourceRequirements{Requests: corev1api.ResourceList{corev1api.ResourceCPU: resource.MustParse("1")}}},
+				{Resources: corev1api.ResourceRequirements{Requests: corev1api.ResourceList{corev1api.ResourceEphemeralStorage: resource.MustParse("512Mi")}}},
+			},
+		},
+	}
+
+	request := podEphemeralStorageRequest(pod)
+	assert.Equal(t, "1536Mi", request.String())
+}
+
+func TestQuotaWarnings(t *testing.T) {
+	quota := &corev1api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage"},
+		Spec: corev1api.ResourceQuotaSpec{
+			Hard: corev1api.ResourceList{
+				"requests.storage":       resource.MustParse("100Gi"),
+				"persistentvolumeclaims": resource.MustParse("5"),
+				"requests.cpu":           resource.MustParse("4"),
+			},
+		},
+		Status: corev1api.ResourceQuotaStatus{
+			Used: corev1api.ResourceList{
+				"requests.storage":       resource.MustParse("60Gi"),
+				"persistentvolumeclaims": resource.MustParse("2"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		requests corev1api.ResourceList
+		want     []string
+	}{
+		{
+			name: "requests within the quota",
+			requests: corev1api.ResourceList{
+				"requests.storage":       resource.MustParse("40Gi"),
+				"persistentvolumeclaims": resource.MustParse("3"),
+			},
+		},
+		{
+			name: "requests over the quota",
+			requests: corev1api.ResourceList{
+				"requests.storage":       resource.MustParse("50Gi"),
+				"persistentvolumeclaims": resource.MustParse("4"),
+			},
+			want: []string{
+				"the restore requests 4 of persistentvolumeclaims, which would exceed resource quota storage's limit of 5, of which 2 is used",
+				"the restore requests 50Gi of requests.storage, which would exceed resource quota storage's limit of 100Gi, of which 60Gi is used",
+			},
+		},
+		{
+			name:     "requests of resources the quota doesn't limit",
+			requests: corev1api.ResourceList{"requests.ephemeral-storage": resource.MustParse("1Ti")},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, err := range quotaWarnings(quota, tc.requests) {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNodeWarnings(t *testing.T) {
+	newNode := func(allocatable string, unschedulable bool) *corev1api.Node {
+		return &corev1api.Node{
+			Spec:   corev1api.NodeSpec{Unschedulable: unschedulable},
+			Status: corev1api.NodeStatus{Allocatable: corev1api.ResourceList{corev1api.ResourceEphemeralStorage: resource.MustParse(allocatable)}},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		nodes []*corev1api.Node
+		pods  map[string]resource.Quantity
+		want  []string
+	}{
+		{
+			name:  "pods that fit",
+			nodes: []*corev1api.Node{newNode("20Gi", false), newNode("20Gi", false)},
+			pods:  map[string]resource.Quantity{"ns-1/pod-1": resource.MustParse("15Gi"), "ns-1/pod-2": resource.MustParse("15Gi")},
+		},
+		{
+			name:  "pod larger than any schedulable node",
+			nodes: []*corev1api.Node{newNode("20Gi", false), newNode("100Gi", true)},
+			pods:  map[string]resource.Quantity{"ns-1/pod-1": resource.MustParse("30Gi")},
+			want: []string{
+				"pod ns-1/pod-1 requests 30Gi of ephemeral storage, but no schedulable node has more than 20Gi allocatable",
+				"the restore's pods request 30Gi of ephemeral storage, but the schedulable nodes only have 20Gi allocatable",
+			},
+		},
+		{
+			name:  "pods larger than all schedulable nodes together",
+			nodes: []*corev1api.Node{newNode("20Gi", false), newNode("20Gi", false)},
+			pods:  map[string]resource.Quantity{"ns-1/pod-1": resource.MustParse("15Gi"), "ns-1/pod-2": resource.MustParse("15Gi"), "ns-2/pod-1": resource.MustParse("15Gi")},
+			want: []string{
+				"the restore's pods request 45Gi of ephemeral storage, but the schedulable nodes only have 40Gi allocatable",
+			},
+		},
+		{
+			name:  "nodes that don't report their allocatable ephemeral storage",
+			nodes: []*corev1api.Node{{}},
+			pods:  map[string]resource.Quantity{"ns-1/pod-1": resource.MustParse("1Gi")},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, err := range nodeWarnings(tc.nodes, tc.pods) {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// CheckCompatibility compares the API versions of the items in a backup, as
+// recorded in its resource list, against the ones served by the cluster it's
+// being restored into, and returns the kinds of items that can't be restored.
+// Only the items in the namespaces included in the restore are considered.
+func CheckCompatibility(resourceList map[string][]string, restore *velerov1api.Restore, discoveryHelper discovery.Helper) []velerov1api.RestoreCompatibilityIssue {
+	// the API groups and versions, and the group kinds, served by the cluster
+	servedVersions := make(map[string]sets.String)
+	for _, group := range discoveryHelper.APIGroups() {
+		servedVersions[group.Name] = sets.NewString()
+		for _, version := range group.Versions {
+			servedVersions[group.Name].Insert(version.Version)
+		}
+	}
+	servedKinds := make(map[schema.GroupKind]bool)
+	for _, resourceGroup := range discoveryHelper.Resources() {
+		gv, err := schema.ParseGroupVersion(resourceGroup.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceGroup.APIResources {
+			servedKinds[gv.WithKind(resource.Kind).GroupKind()] = true
+		}
+	}
+
+	// the API groups of the custom resource definitions in the backup, which
+	// are restored before their custom resources.
+	backedUpCRDGroups := sets.NewString()
+	for key, items := range resourceList {
+		gvk := parseResourceListKey(key)
+		if gvk.Group != "apiextensions.k8s.io" || gvk.Kind != "CustomResourceDefinition" {
+			continue
+		}
+		for _, name := range items {
+			if i := strings.Index(name, "."); i >= 0 {
+				backedUpCRDGroups.Insert(name[i+1:])
+			}
+		}
+	}
+
+	namespaces := collections.NewIncludesExcludes().Includes(restore.Spec.IncludedNamespaces...).Excludes(restore.Spec.ExcludedNamespaces...)
+	includeClusterResources := restore.Spec.IncludeClusterResources == nil || *restore.Spec.IncludeClusterResources
+
+	var issues []velerov1api.RestoreCompatibilityIssue
+	for key, items := range resourceList {
+		gvk := parseResourceListKey(key)
+
+		if servedVersions[gvk.Group].Has(gvk.Version) && servedKinds[gvk.GroupKind()] {
+			continue
+		}
+		if backedUpCRDGroups.Has(gvk.Group) {
+			continue
+		}
+
+		var count int
+		for _, item := range items {
+			if i := strings.Index(item, "/"); i >= 0 {
+				if namespaces.ShouldInclude(item[:i]) {
+					count++
+				}
+			} else if includeClusterResources {
+				count++
+			}
+		}
+		if count == 0 {
+			continue
+		}
+
+		issueType := velerov1api.RestoreCompatibilityIssueRemovedAPIVersion
+		if _, ok := servedVersions[gvk.Group]; !ok && gvk.Group != "" {
+			issueType = velerov1api.RestoreCompatibilityIssueMissingCRD
+		}
+
+		issues = append(issues, velerov1api.RestoreCompatibilityIssue{
+			Type:       issueType,
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Items:      count,
+		})
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].APIVersion != issues[j].APIVersion {
+			return issues[i].APIVersion < issues[j].APIVersion
+		}
+		return issues[i].Kind < issues[j].Kind
+	})
+
+	return issues
+}
+
+// CompatibilityIssueMessage returns a human-readable description of a
+// compatibility issue.
+func CompatibilityIssueMessage(issue velerov1api.RestoreCompatibilityIssue) string {
+	switch issue.Type {
+	case velerov1api.RestoreCompatibilityIssueMissingCRD:
+		return fmt.Sprintf("%d %s %s item(s) can't be restored because the cluster doesn't serve their API group, and the backup doesn't include its custom resource definition", issue.Items, issue.APIVersion, issue.Kind)
+	default:
+		return fmt.Sprintf("%d %s %s item(s) can't be restored because the cluster doesn't serve their API version", issue.Items, issue.APIVersion, issue.Kind)
+	}
+}
+
+// parseResourceListKey parses a key of a backup's resource list, which is a
+// group/version/kind, or a version/kind for the core API group.
+func parseResourceListKey(key string) schema.GroupVersionKind {
+	i := strings.LastIndex(key, "/")
+	if i < 0 {
+		return schema.GroupVersionKind{Kind: key}
+	}
+
+	gv, err := schema.ParseGroupVersion(key[:i])
+	if err != nil {
+		return schema.GroupVersionKind{Kind: key[i+1:]}
+	}
+
+	return gv.WithKind(key[i+1:])
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	discoveryHelper := &velerotest.FakeDiscoveryHelper{
+		ResourceList: []*metav1.APIResourceList{
+			{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod"}, {Name: "persistentvolumes", Kind: "PersistentVolume"}}},
+			{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment"}}},
+			{GroupVersion: "extensions/v1beta1", APIResources: []metav1.APIResource{{Name: "ingresses", Kind: "Ingress"}}},
+			{GroupVersion: "apiextensions.k8s.io/v1beta1", APIResources: []metav1.APIResource{{Name: "customresourcedefinitions", Kind: "CustomResourceDefinition"}}},
+		},
+		APIGroupsList: []metav1.APIGroup{
+			{Name: "", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "v1", Version: "v1"}}},
+			{Name: "apps", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "apps/v1", Version: "v1"}}},
+			{Name: "extensions", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "extensions/v1beta1", Version: "v1beta1"}}},
+			{Name: "apiextensions.k8s.io", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "apiextensions.k8s.io/v1beta1", Version: "v1beta1"}}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		resourceList map[string][]string
+		restore      *velerov1api.Restore
+		want         []velerov1api.RestoreCompatibilityIssue
+	}{
+		{
+			name: "served API versions have no issues",
+			resourceList: map[string][]string{
+				"v1/Pod":                     {"ns-1/pod-1"},
+				"apps/v1/Deployment":         {"ns-1/deploy-1"},
+				"extensions/v1beta1/Ingress": {"ns-1/ingress-1"},
+			},
+			restore: builder.ForRestore("velero", "restore-1").Result(),
+		},
+		{
+			name: "API versions that aren't served are reported as removed",
+			resourceList: map[string][]string{
+				"apps/v1beta1/Deployment":       {"ns-1/deploy-1", "ns-2/deploy-2"},
+				"extensions/v1beta1/Deployment": {"ns-1/deploy-3"},
+			},
+			restore: builder.ForRestore("velero", "restore-1").Result(),
+			want: []velerov1api.RestoreCompatibilityIssue{
+				{Type: velerov1api.RestoreCompatibilityIssueRemovedAPIVersion, APIVersion: "apps/v1beta1", Kind: "Deployment", Items: 2},
+				{Type: velerov1api.RestoreCompatibilityIssueRemovedAPIVersion, APIVersion: "extensions/v1beta1", Kind: "Deployment", Items: 1},
+			},
+		},
+		{
+			name: "API groups that aren't served are reported as missing custom resource definitions",
+			resourceList: map[string][]string{
+				"example.io/v1/Widget": {"ns-1/widget-1"},
+			},
+			restore: builder.ForRestore("velero", "restore-1").Result(),
+			want: []velerov1api.RestoreCompatibilityIssue{
+				{Type: velerov1api.RestoreCompatibilityIssueMissingCRD, APIVersion: "example.io/v1", Kind: "Widget", Items: 1},
+			},
+		},
+		{
+			name: "custom resources whose definitions are in the backup have no issues",
+			resourceList: map[string][]string{
+				"apiextensions.k8s.io/v1beta1/CustomResourceDefinition": {"widgets.example.io"},
+				"example.io/v1/Widget": {"ns-1/widget-1"},
+			},
+			restore: builder.ForRestore("velero", "restore-1").Result(),
+		},
+		{
+			name: "items in namespaces that aren't restored aren't reported",
+			resourceList: map[string][]string{
+				"apps/v1beta1/Deployment": {"ns-1/deploy-1", "ns-2/deploy-2"},
+				"example.io/v1/Widget":    {"ns-2/widget-1"},
+			},
+			restore: builder.ForRestore("velero", "restore-1").IncludedNamespaces("ns-1").Result(),
+			want: []velerov1api.RestoreCompatibilityIssue{
+				{Type: velerov1api.RestoreCompatibilityIssueRemovedAPIVersion, APIVersion: "apps/v1beta1", Kind: "Deployment", Items: 1},
+			},
+		},
+		{
+			name: "cluster-scoped items aren't reported if cluster resources aren't restored",
+			resourceList: map[string][]string{
+				"example.io/v1/Gadget": {"gadget-1"},
+			},
+			restore: builder.ForRestore("velero", "restore-1").IncludeClusterResources(false).Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, CheckCompatibility(tc.resourceList, tc.restore, discoveryHelper))
+		})
+	}
+}
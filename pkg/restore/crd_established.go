@@ -0,0 +1,128 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// waitForCRDsEstablished waits for the custom resource definitions that have been
+// restored so far to be established, so that their custom resources can be created.
+// A warning is returned for each one that isn't established within the restorer's
+// CRD established timeout.
+func (ctx *context) waitForCRDsEstablished() Result {
+	warnings := Result{}
+
+	pending := sets.NewString(ctx.restoredCRDs...)
+	ctx.restoredCRDs = nil
+
+	// the client was created when the custom resource definitions were restored
+	crdClient, ok := ctx.resourceClients[resourceClientKey{resource: kuberesource.CustomResourceDefinitions}]
+	if !ok {
+		return warnings
+	}
+
+	ctx.log.Infof("Waiting for %d restored custom resource definitions to be established", pending.Len())
+
+	err := wait.PollImmediate(time.Second, ctx.crdEstablishedTimeout, func() (bool, error) {
+		for _, name := range pending.List() {
+			unstructuredCRD, err := crdClient.Get(name, metav1.GetOptions{})
+			if err != nil {
+				ctx.log.WithError(err).Debugf("Error getting custom resource definition %s, retrying", name)
+				continue
+			}
+
+			crd := new(apiextv1beta1.CustomResourceDefinition)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredCRD.Object, crd); err != nil {
+				return false, errors.Wrapf(err, "error converting custom resource definition %s from unstructured", name)
+			}
+
+			if crdIsEstablished(crd) {
+				pending.Delete(name)
+			}
+		}
+
+		return pending.Len() == 0, nil
+	})
+
+	if err == wait.ErrWaitTimeout {
+		for _, name := range pending.List() {
+			addToResult(&warnings, "", errors.Errorf("custom resource definition %s wasn't established within %s, so its custom resources may fail to restore", name, ctx.crdEstablishedTimeout))
+		}
+	} else if err != nil {
+		addToResult(&warnings, "", err)
+	}
+
+	return warnings
+}
+
+// crdIsEstablished returns whether a custom resource definition is established,
+// so that its custom resources can be created.
+func crdIsEstablished(crd *apiextv1beta1.CustomResourceDefinition) bool {
+	for _, cond := range crd.Status.Conditions {
+		if cond.Type == apiextv1beta1.Established && cond.Status == apiextv1beta1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
+
+// refreshPrioritizedResources refreshes discovery, so that the resources of newly
+// established custom resource definitions are known, and replaces the resources
+// after the first n in ctx.prioritizedResources with the ones that are left to
+// restore.
+func (ctx *context) refreshPrioritizedResources(n int) error {
+	if err := ctx.discoveryHelper.Refresh(); err != nil {
+		return errors.Wrap(err, "error refreshing discovery after restoring custom resource definitions")
+	}
+
+	ctx.resourceIncludesExcludes = getResourceIncludesExcludes(ctx.discoveryHelper, ctx.restore.Spec.IncludedResources, ctx.restore.Spec.ExcludedResources)
+
+	resources, err := prioritizeResources(ctx.discoveryHelper, ctx.resourcePriorities, ctx.resourceIncludesExcludes, ctx.log)
+	if err != nil {
+		return err
+	}
+	if ctx.restore.Spec.AdmissionWebhooks == velerov1api.AdmissionWebhookPolicyRestoreLast {
+		resources = restoreAdmissionWebhooksLast(resources)
+	}
+
+	restored := sets.NewString()
+	for _, resource := range ctx.prioritizedResources[:n] {
+		restored.Insert(resource.String())
+	}
+
+	remaining := ctx.prioritizedResources[:n:n]
+	for _, resource := range resources {
+		if !restored.Has(resource.String()) {
+			remaining = append(remaining, resource)
+		}
+	}
+	ctx.prioritizedResources = remaining
+
+	return nil
+}
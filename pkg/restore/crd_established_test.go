@@ -0,0 +1,143 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newRestoredCRD(name string, conditions ...map[string]interface{}) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1beta1",
+			"kind":       "CustomResourceDefinition",
+		},
+	}
+	crd.SetName(name)
+
+	var conds []interface{}
+	for _, cond := range conditions {
+		conds = append(conds, cond)
+	}
+	if conds != nil {
+		crd.Object["status"] = map[string]interface{}{"conditions": conds}
+	}
+
+	return crd
+}
+
+func TestWaitForCRDsEstablished(t *testing.T) {
+	tests := []struct {
+		name         string
+		crds         []*unstructured.Unstructured
+		wantWarnings Result
+	}{
+		{
+			name: "established custom resource definitions don't warn",
+			crds: []*unstructured.Unstructured{
+				newRestoredCRD("widgets.example.io", map[string]interface{}{"type": "Established", "status": "True"}),
+			},
+		},
+		{
+			name: "custom resource definitions that aren't established in time warn",
+			crds: []*unstructured.Unstructured{
+				newRestoredCRD("widgets.example.io", map[string]interface{}{"type": "Established", "status": "True"}),
+				newRestoredCRD("gadgets.example.io", map[string]interface{}{"type": "Established", "status": "False"}),
+				newRestoredCRD("gizmos.example.io"),
+			},
+			wantWarnings: Result{
+				Cluster: []string{
+					"custom resource definition gadgets.example.io wasn't established within 10ms, so its custom resources may fail to restore",
+					"custom resource definition gizmos.example.io wasn't established within 10ms, so its custom resources may fail to restore",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			apiServer := test.NewAPIServer(t)
+
+			crdClient, err := client.NewDynamicFactory(apiServer.DynamicClient).ClientForGroupVersionResource(
+				schema.GroupVersion{Group: "apiextensions.k8s.io", Version: "v1beta1"},
+				metav1.APIResource{Name: "customresourcedefinitions"},
+				"",
+			)
+			require.NoError(t, err)
+
+			ctx := &context{
+				log:                   test.NewLogger(),
+				crdEstablishedTimeout: 10 * time.Millisecond,
+				resourceClients: map[resourceClientKey]client.Dynamic{
+					{resource: kuberesource.CustomResourceDefinitions}: crdClient,
+				},
+			}
+
+			for _, crd := range tc.crds {
+				_, err := crdClient.Create(crd)
+				require.NoError(t, err)
+				ctx.restoredCRDs = append(ctx.restoredCRDs, crd.GetName())
+			}
+
+			warnings := ctx.waitForCRDsEstablished()
+			assert.Equal(t, tc.wantWarnings, warnings)
+			assert.Empty(t, ctx.restoredCRDs)
+		})
+	}
+}
+
+func TestRefreshPrioritizedResources(t *testing.T) {
+	discoveryHelper := &test.FakeDiscoveryHelper{
+		ResourceList: []*metav1.APIResourceList{
+			{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "pods"}, {Name: "services"}}},
+			{GroupVersion: "apiextensions.k8s.io/v1beta1", APIResources: []metav1.APIResource{{Name: "customresourcedefinitions"}}},
+			{GroupVersion: "example.io/v1", APIResources: []metav1.APIResource{{Name: "widgets"}}},
+		},
+	}
+
+	ctx := &context{
+		log:             test.NewLogger(),
+		restore:         builder.ForRestore("velero", "restore-1").Result(),
+		discoveryHelper: discoveryHelper,
+		prioritizedResources: []schema.GroupResource{
+			kuberesource.Pods,
+			kuberesource.CustomResourceDefinitions,
+			{Resource: "services"},
+		},
+	}
+
+	require.NoError(t, ctx.refreshPrioritizedResources(2))
+
+	assert.Equal(t, []schema.GroupResource{
+		kuberesource.Pods,
+		kuberesource.CustomResourceDefinitions,
+		{Resource: "services"},
+		{Group: "example.io", Resource: "widgets"},
+	}, ctx.prioritizedResources)
+}
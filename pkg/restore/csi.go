@@ -0,0 +1,124 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// hasCSIVolumeSnapshot returns whether a persistent volume or claim was
+// backed up with a CSI volume snapshot, in which case the claim is restored
+// from the snapshot rather than bound to the volume.
+func hasCSIVolumeSnapshot(obj *unstructured.Unstructured) bool {
+	return obj.GetAnnotations()[velerov1api.VolumeSnapshotNameAnnotation] != ""
+}
+
+// restorePVCFromCSISnapshot sets the data source of a persistent volume claim
+// to the CSI volume snapshot that was taken of its volume, so that it's bound
+// to a volume that's dynamically provisioned from the snapshot.
+func restorePVCFromCSISnapshot(obj *unstructured.Unstructured) error {
+	resetPVCBinding(obj)
+
+	return unstructured.SetNestedMap(obj.Object, map[string]interface{}{
+		"apiGroup": kuberesource.VolumeSnapshots.Group,
+		"kind":     "VolumeSnapshot",
+		"name":     obj.GetAnnotations()[velerov1api.VolumeSnapshotNameAnnotation],
+	}, "spec", "dataSource")
+}
+
+// prepareCSIVolumeSnapshot creates a VolumeSnapshotContent for a CSI volume
+// snapshot that's being restored into namespace, from the driver and snapshot
+// handle it was annotated with when it was backed up, and binds the volume
+// snapshot to it. The content's deletion policy is Retain, so that deleting
+// the restored volume snapshot doesn't delete the backup's snapshot. Volume
+// snapshots that already exist in the namespace are returned as they are.
+func (ctx *context) prepareCSIVolumeSnapshot(obj *unstructured.Unstructured, snapshotClient client.Dynamic, namespace string) (*unstructured.Unstructured, error) {
+	_, err := snapshotClient.Get(obj.GetName(), metav1.GetOptions{})
+	switch {
+	case err == nil:
+		return obj, nil
+	case !apierrors.IsNotFound(err):
+		return nil, errors.Wrapf(err, "error getting volume snapshot %s", obj.GetName())
+	}
+
+	content, err := csiVolumeSnapshotContent(obj, namespace)
+	if err != nil {
+		return nil, err
+	}
+	addRestoreLabels(content, ctx.restore.Name, ctx.restore.Spec.BackupName)
+
+	contentClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(obj.GroupVersionKind().GroupVersion(), metav1.APIResource{Name: kuberesource.VolumeSnapshotContents.Resource}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.log.Infof("Creating volume snapshot content for volume snapshot %s/%s", namespace, obj.GetName())
+	if content, err = contentClient.Create(content); err != nil {
+		return nil, errors.Wrapf(err, "error creating volume snapshot content for volume snapshot %s", obj.GetName())
+	}
+
+	if err := unstructured.SetNestedMap(obj.Object, map[string]interface{}{
+		"volumeSnapshotContentName": content.GetName(),
+	}, "spec", "source"); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return obj, nil
+}
+
+// csiVolumeSnapshotContent returns a VolumeSnapshotContent that pre-binds a
+// CSI volume snapshot being restored into namespace to the snapshot it was
+// bound to when it was backed up.
+func csiVolumeSnapshotContent(snapshot *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	annotations := snapshot.GetAnnotations()
+	handle := annotations[velerov1api.VolumeSnapshotHandleAnnotation]
+	driver := annotations[velerov1api.CSIDriverNameAnnotation]
+	if handle == "" || driver == "" {
+		return nil, errors.Errorf("volume snapshot %s can't be restored because its snapshot handle wasn't recorded when it was backed up", snapshot.GetName())
+	}
+
+	spec := map[string]interface{}{
+		"deletionPolicy": "Retain",
+		"driver":         driver,
+		"source": map[string]interface{}{
+			"snapshotHandle": handle,
+		},
+		"volumeSnapshotRef": map[string]interface{}{
+			"apiVersion": snapshot.GetAPIVersion(),
+			"kind":       "VolumeSnapshot",
+			"namespace":  namespace,
+			"name":       snapshot.GetName(),
+		},
+	}
+	if class, _, _ := unstructured.NestedString(snapshot.Object, "spec", "volumeSnapshotClassName"); class != "" {
+		spec["volumeSnapshotClassName"] = class
+	}
+
+	content := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	content.SetAPIVersion(snapshot.GetAPIVersion())
+	content.SetKind("VolumeSnapshotContent")
+	content.SetGenerateName("velero-" + snapshot.GetName() + "-")
+
+	return content, nil
+}
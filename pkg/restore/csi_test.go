@@ -0,0 +1,97 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func newBackedUpVolumeSnapshot(annotations map[string]string) *unstructured.Unstructured {
+	snapshot := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+			"kind":       "VolumeSnapshot",
+			"spec": map[string]interface{}{
+				"volumeSnapshotClassName": "csi-snapclass",
+				"source":                  map[string]interface{}{"persistentVolumeClaimName": "pvc-1"},
+			},
+		},
+	}
+	snapshot.SetNamespace("ns-1")
+	snapshot.SetName("velero-pvc-1-abcde")
+	snapshot.SetAnnotations(annotations)
+
+	return snapshot
+}
+
+func TestRestorePVCFromCSISnapshot(t *testing.T) {
+	pvc := newBackedUpPVC("Bound", map[string]string{
+		"pv.kubernetes.io/bind-completed":        "yes",
+		velerov1api.VolumeSnapshotNameAnnotation: "velero-pvc-1-abcde",
+	})
+	require.True(t, hasCSIVolumeSnapshot(pvc))
+
+	require.NoError(t, restorePVCFromCSISnapshot(pvc))
+
+	assert.Equal(t, map[string]interface{}{
+		"dataSource": map[string]interface{}{
+			"apiGroup": "snapshot.storage.k8s.io",
+			"kind":     "VolumeSnapshot",
+			"name":     "velero-pvc-1-abcde",
+		},
+	}, pvc.Object["spec"])
+	assert.Equal(t, map[string]string{velerov1api.VolumeSnapshotNameAnnotation: "velero-pvc-1-abcde"}, pvc.GetAnnotations())
+
+	assert.False(t, hasCSIVolumeSnapshot(newBackedUpPVC("Bound", nil)))
+}
+
+func TestCSIVolumeSnapshotContent(t *testing.T) {
+	snapshot := newBackedUpVolumeSnapshot(map[string]string{
+		velerov1api.VolumeSnapshotHandleAnnotation: "snap-handle-1",
+		velerov1api.CSIDriverNameAnnotation:        "csi.example.com",
+	})
+
+	content, err := csiVolumeSnapshotContent(snapshot, "ns-2")
+	require.NoError(t, err)
+
+	assert.Equal(t, "snapshot.storage.k8s.io/v1beta1", content.GetAPIVersion())
+	assert.Equal(t, "VolumeSnapshotContent", content.GetKind())
+	assert.Equal(t, "velero-velero-pvc-1-abcde-", content.GetGenerateName())
+	assert.Equal(t, map[string]interface{}{
+		"deletionPolicy":          "Retain",
+		"driver":                  "csi.example.com",
+		"volumeSnapshotClassName": "csi-snapclass",
+		"source":                  map[string]interface{}{"snapshotHandle": "snap-handle-1"},
+		"volumeSnapshotRef": map[string]interface{}{
+			"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+			"kind":       "VolumeSnapshot",
+			"namespace":  "ns-2",
+			"name":       "velero-pvc-1-abcde",
+		},
+	}, content.Object["spec"])
+
+	_, err = csiVolumeSnapshotContent(newBackedUpVolumeSnapshot(map[string]string{
+		velerov1api.CSIDriverNameAnnotation: "csi.example.com",
+	}), "ns-1")
+	assert.EqualError(t, err, "volume snapshot velero-pvc-1-abcde can't be restored because its snapshot handle wasn't recorded when it was backed up")
+}
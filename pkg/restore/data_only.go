@@ -0,0 +1,296 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+const (
+	// dataOnlyVolumeName is the name of the volume in a data-only restore's
+	// helper pod that the existing PVC is mounted as.
+	dataOnlyVolumeName = "data"
+
+	// dataOnlyContainerName is the name of the main container in a data-only
+	// restore's helper pod.
+	dataOnlyContainerName = "velero-data-restore"
+)
+
+// dataOnlyClaim is an existing PVC that a data-only restore restores a
+// restic backup of a volume into.
+type dataOnlyClaim struct {
+	name            string
+	sourceNamespace string
+	targetNamespace string
+	podVolumeBackup *velerov1api.PodVolumeBackup
+}
+
+// executeDataOnly restores the volume data in the backup into existing PVCs without
+// restoring any Kubernetes objects. Each restic backup of a PVC is restored into the
+// PVC with the same name in the target namespace, using a short-lived helper pod that
+// mounts the PVC and is deleted once the data has been restored. Volume snapshots
+// can't be restored into existing PVCs, so they're skipped with a warning.
+func (ctx *context) executeDataOnly() (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	ctx.log.Infof("Starting data-only restore of backup %s", kube.NamespaceAndName(ctx.backup))
+
+	for _, snapshot := range ctx.volumeSnapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseCompleted {
+			continue
+		}
+		addToResult(&warnings, "", errors.Errorf("volume snapshot of persistent volume %s can't be restored into an existing PVC, skipping", snapshot.Spec.PersistentVolumeName))
+	}
+
+	claims := ctx.dataOnlyClaims()
+	if len(claims) == 0 {
+		ctx.log.Info("No restic backups of PVCs found in backup")
+		return warnings, errs
+	}
+
+	if ctx.resticRestorer == nil {
+		addVeleroError(&errs, errors.New("restic is not enabled, unable to restore volume data"))
+		return warnings, errs
+	}
+
+	helperImage, helperResources, err := ctx.dataOnlyHelperConfig()
+	if err != nil {
+		addVeleroError(&errs, err)
+		return warnings, errs
+	}
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+	)
+
+	for _, claim := range claims {
+		log := ctx.log.WithField("persistentVolumeClaim", fmt.Sprintf("%s/%s", claim.targetNamespace, claim.name))
+
+		podClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, metav1.APIResource{Name: "pods", Namespaced: true}, claim.targetNamespace)
+		if err != nil {
+			addToResult(&errs, claim.targetNamespace, err)
+			continue
+		}
+
+		pvc, err := ctx.getExistingClaim(claim)
+		if err != nil {
+			addToResult(&errs, claim.targetNamespace, err)
+			continue
+		}
+		if pvc == nil {
+			addToResult(&warnings, claim.targetNamespace, errors.Errorf("not restoring volume data into PVC %s because it does not exist", claim.name))
+			continue
+		}
+		if !ctx.selector.Matches(labels.Set(pvc.GetLabels())) {
+			log.Info("Not restoring volume data into PVC because it does not match the label selector")
+			continue
+		}
+
+		pod, err := createDataOnlyHelperPod(podClient, ctx.restore, claim, helperImage, helperResources)
+		if err != nil {
+			addToResult(&errs, claim.targetNamespace, errors.Wrapf(err, "error creating helper pod for PVC %s", claim.name))
+			continue
+		}
+
+		log.Infof("Restoring volume data using helper pod %s", pod.Name)
+
+		wg.Add(1)
+		go func(claim dataOnlyClaim, pod *corev1api.Pod) {
+			defer wg.Done()
+
+			// point the pod volume backup at the helper pod's volume so the restorer
+			// restores it into the mounted PVC.
+			pvb := claim.podVolumeBackup.DeepCopy()
+			pvb.Spec.Pod.Namespace = pod.Namespace
+			pvb.Spec.Pod.Name = pod.Name
+			pvb.Spec.Volume = dataOnlyVolumeName
+
+			data := restic.RestoreData{
+				Restore:          ctx.restore,
+				Pod:              pod,
+				PodVolumeBackups: []*velerov1api.PodVolumeBackup{pvb},
+				SourceNamespace:  claim.sourceNamespace,
+				BackupLocation:   ctx.backup.Spec.StorageLocation,
+			}
+			restoreErrs := ctx.resticRestorer.RestorePodVolumes(data)
+
+			if err := podClient.Delete(pod.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				log.WithError(err).Warnf("Error deleting helper pod %s", pod.Name)
+			}
+
+			if len(restoreErrs) > 0 {
+				lock.Lock()
+				addToResult(&errs, claim.targetNamespace, errors.Wrapf(kubeerrs.NewAggregate(restoreErrs), "error restoring volume data into PVC %s", claim.name))
+				lock.Unlock()
+			}
+		}(claim, pod)
+	}
+
+	wg.Wait()
+
+	return warnings, errs
+}
+
+// dataOnlyClaims returns the existing PVCs to restore volume data into, one for each
+// PVC with a completed restic backup in an included namespace.
+func (ctx *context) dataOnlyClaims() []dataOnlyClaim {
+	var claims []dataOnlyClaim
+	seen := make(map[string]bool)
+
+	for _, pvb := range ctx.podVolumeBackups {
+		pvcName := pvb.Annotations[restic.PVCNameAnnotation]
+		if pvcName == "" || pvb.Status.SnapshotID == "" {
+			continue
+		}
+
+		sourceNamespace := pvb.Spec.Pod.Namespace
+		if !ctx.namespaceIncludesExcludes.ShouldInclude(sourceNamespace) {
+			continue
+		}
+
+		targetNamespace, _ := ctx.namespaceMapper.targetNamespace(sourceNamespace)
+
+		// a PVC that's mounted by multiple pods is backed up once per pod,
+		// but its data only needs to be restored once.
+		key := targetNamespace + "/" + pvcName
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		claims = append(claims, dataOnlyClaim{
+			name:            pvcName,
+			sourceNamespace: sourceNamespace,
+			targetNamespace: targetNamespace,
+			podVolumeBackup: pvb,
+		})
+	}
+
+	return claims
+}
+
+// getExistingClaim returns the in-cluster PVC to restore volume data into, or nil if
+// it doesn't exist.
+func (ctx *context) getExistingClaim(claim dataOnlyClaim) (*unstructured.Unstructured, error) {
+	pvcClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, metav1.APIResource{Name: "persistentvolumeclaims", Namespaced: true}, claim.targetNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	pvc, err := pvcClient.Get(claim.name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, errors.Wrapf(err, "error getting PVC %s", claim.name)
+	}
+
+	return pvc, nil
+}
+
+// dataOnlyHelperConfig returns the image and resource requirements to use for data-only
+// restore helper pods, using the restic restore item action's plugin config, if any.
+func (ctx *context) dataOnlyHelperConfig() (string, corev1api.ResourceRequirements, error) {
+	configMapClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, metav1.APIResource{Name: "configmaps", Namespaced: true}, ctx.restore.Namespace)
+	if err != nil {
+		return "", corev1api.ResourceRequirements{}, err
+	}
+
+	res, err := configMapClient.List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("velero.io/plugin-config,%s=%s", "velero.io/restic", framework.PluginKindRestoreItemAction),
+	})
+	if err != nil {
+		return "", corev1api.ResourceRequirements{}, errors.Wrap(err, "error getting restic plugin config")
+	}
+
+	var config *corev1api.ConfigMap
+	if list, ok := res.(*unstructured.UnstructuredList); ok && len(list.Items) > 0 {
+		config = new(corev1api.ConfigMap)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[0].Object, config); err != nil {
+			return "", corev1api.ResourceRequirements{}, errors.Wrap(err, "error converting restic plugin config")
+		}
+	}
+
+	return getImage(ctx.log, config), getResourceRequirements(ctx.log, config), nil
+}
+
+// createDataOnlyHelperPod creates a pod that mounts a PVC and waits for restic to restore
+// volume data into it, and returns the created pod.
+func createDataOnlyHelperPod(podClient client.Dynamic, restore *velerov1api.Restore, claim dataOnlyClaim, image string, resources corev1api.ResourceRequirements) (*corev1api.Pod, error) {
+	mount := builder.ForVolumeMount(dataOnlyVolumeName, "/restores/"+dataOnlyVolumeName).Result()
+
+	initContainer := newResticInitContainerBuilder(image, string(restore.UID)).
+		Resources(&resources).
+		VolumeMounts(mount).
+		Result()
+
+	// the restore helper exits immediately once the volume has been restored, so
+	// it's also used as the pod's main container.
+	container := builder.ForContainer(dataOnlyContainerName, image).
+		Args(string(restore.UID)).
+		Resources(&resources).
+		VolumeMounts(mount).
+		Result()
+
+	pod := builder.ForPod(claim.targetNamespace, "").
+		ObjectMeta(
+			builder.WithGenerateName(fmt.Sprintf("%s-", restore.Name)),
+			builder.WithLabels(velerov1api.RestoreNameLabel, label.GetValidName(restore.Name)),
+		).
+		Volumes(builder.ForVolume(dataOnlyVolumeName).PersistentVolumeClaimSource(claim.name).Result()).
+		InitContainers(initContainer).
+		Containers(container).
+		RestartPolicy(corev1api.RestartPolicyNever).
+		Result()
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	created, err := podClient.Create(&unstructured.Unstructured{Object: obj})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res := new(corev1api.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(created.Object, res); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	resticmocks "github.com/vmware-tanzu/velero/pkg/restic/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func pvcBackup(name, podNamespace, pvcName, snapshotID string) *velerov1api.PodVolumeBackup {
+	b := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).
+		PodNamespace(podNamespace).
+		PodName("pod-1").
+		Volume("vol-1").
+		SnapshotID(snapshotID)
+
+	if pvcName != "" {
+		b.ObjectMeta(builder.WithAnnotations(restic.PVCNameAnnotation, pvcName))
+	}
+
+	return b.Result()
+}
+
+func TestDataOnlyClaims(t *testing.T) {
+	tests := []struct {
+		name               string
+		podVolumeBackups   []*velerov1api.PodVolumeBackup
+		includedNamespaces []string
+		namespaceMapping   map[string]string
+		want               []dataOnlyClaim
+	}{
+		{
+			name: "backups of PVCs are restored into PVCs with the same name",
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", "ns-1", "pvc-1", "snap-1"),
+				pvcBackup("pvb-2", "ns-2", "pvc-2", "snap-2"),
+			},
+			want: []dataOnlyClaim{
+				{name: "pvc-1", sourceNamespace: "ns-1", targetNamespace: "ns-1"},
+				{name: "pvc-2", sourceNamespace: "ns-2", targetNamespace: "ns-2"},
+			},
+		},
+		{
+			name: "backups of non-PVC volumes and incomplete backups are skipped",
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", "ns-1", "", "snap-1"),
+				pvcBackup("pvb-2", "ns-1", "pvc-2", ""),
+			},
+			want: nil,
+		},
+		{
+			name: "a PVC backed up from multiple pods is only restored once",
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", "ns-1", "pvc-1", "snap-1"),
+				pvcBackup("pvb-2", "ns-1", "pvc-1", "snap-2"),
+			},
+			want: []dataOnlyClaim{
+				{name: "pvc-1", sourceNamespace: "ns-1", targetNamespace: "ns-1"},
+			},
+		},
+		{
+			name: "excluded namespaces are skipped and mapped namespaces are remapped",
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", "ns-1", "pvc-1", "snap-1"),
+				pvcBackup("pvb-2", "ns-2", "pvc-2", "snap-2"),
+			},
+			includedNamespaces: []string{"ns-1"},
+			namespaceMapping:   map[string]string{"ns-1": "ns-1-restored"},
+			want: []dataOnlyClaim{
+				{name: "pvc-1", sourceNamespace: "ns-1", targetNamespace: "ns-1-restored"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mapper, err := newNamespaceMapper(tc.namespaceMapping)
+			require.NoError(t, err)
+
+			ctx := &context{
+				podVolumeBackups:          tc.podVolumeBackups,
+				namespaceIncludesExcludes: collections.NewIncludesExcludes().Includes(tc.includedNamespaces...),
+				namespaceMapper:           mapper,
+			}
+
+			got := ctx.dataOnlyClaims()
+			for i := range got {
+				got[i].podVolumeBackup = nil
+			}
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestExecuteDataOnly(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").DataOnly(true).ObjectMeta(builder.WithUID("restore-uid")).Result()
+	backup := defaultBackup().Result()
+
+	dynamicFactory := &velerotest.FakeDynamicFactory{}
+	configMapClient := &velerotest.FakeDynamicClient{}
+	pvcClient := &velerotest.FakeDynamicClient{}
+	podClient := &velerotest.FakeDynamicClient{}
+
+	dynamicFactory.On("ClientForGroupVersionResource", corev1api.SchemeGroupVersion, metav1.APIResource{Name: "configmaps", Namespaced: true}, velerov1api.DefaultNamespace).Return(configMapClient, nil)
+	dynamicFactory.On("ClientForGroupVersionResource", corev1api.SchemeGroupVersion, metav1.APIResource{Name: "persistentvolumeclaims", Namespaced: true}, "ns-1").Return(pvcClient, nil)
+	dynamicFactory.On("ClientForGroupVersionResource", corev1api.SchemeGroupVersion, metav1.APIResource{Name: "pods", Namespaced: true}, "ns-1").Return(podClient, nil)
+
+	configMapClient.On("List", mock.Anything).Return(&unstructured.UnstructuredList{}, nil)
+
+	existingPVC := &unstructured.Unstructured{}
+	existingPVC.SetNamespace("ns-1")
+	existingPVC.SetName("pvc-1")
+	pvcClient.On("Get", "pvc-1", metav1.GetOptions{}).Return(existingPVC, nil)
+	pvcClient.On("Get", "pvc-2", metav1.GetOptions{}).Return((*unstructured.Unstructured)(nil), apierrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumeclaims"}, "pvc-2"))
+
+	createdPod := &unstructured.Unstructured{}
+	createdPod.SetNamespace("ns-1")
+	createdPod.SetName("restore-1-abcde")
+	podClient.On("Create", mock.Anything).Return(createdPod, nil)
+	podClient.On("Delete", "restore-1-abcde", &metav1.DeleteOptions{}).Return(nil)
+
+	restorer := &resticmocks.Restorer{}
+	restorer.On("RestorePodVolumes", mock.Anything).Return(nil)
+
+	ctx := &context{
+		backup:                    backup,
+		restore:                   restore,
+		log:                       velerotest.NewLogger(),
+		dynamicFactory:            dynamicFactory,
+		resticRestorer:            restorer,
+		selector:                  labels.Everything(),
+		namespaceIncludesExcludes: collections.NewIncludesExcludes(),
+		podVolumeBackups: []*velerov1api.PodVolumeBackup{
+			pvcBackup("pvb-1", "ns-1", "pvc-1", "snap-1"),
+			pvcBackup("pvb-2", "ns-1", "pvc-2", "snap-2"),
+		},
+		volumeSnapshots: []*volume.Snapshot{
+			{
+				Spec:   volume.SnapshotSpec{PersistentVolumeName: "pv-1"},
+				Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseCompleted},
+			},
+		},
+	}
+
+	warnings, errs := ctx.executeDataOnly()
+
+	assert.Empty(t, errs.Velero)
+	assert.Empty(t, errs.Namespaces)
+	assert.Len(t, warnings.Cluster, 1)
+	assert.Len(t, warnings.Namespaces["ns-1"], 1)
+
+	// the helper pod mounts the existing PVC and waits for the restore
+	podClient.AssertCalled(t, "Create", mock.MatchedBy(func(obj *unstructured.Unstructured) bool {
+		volumes, _, _ := unstructured.NestedSlice(obj.Object, "spec", "volumes")
+		initContainers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "initContainers")
+		if len(volumes) != 1 || len(initContainers) != 1 {
+			return false
+		}
+		claimName, _, _ := unstructured.NestedString(volumes[0].(map[string]interface{}), "persistentVolumeClaim", "claimName")
+		initContainerName, _, _ := unstructured.NestedString(initContainers[0].(map[string]interface{}), "name")
+		return claimName == "pvc-1" && initContainerName == restic.InitContainer
+	}))
+
+	// the volume data is restored into the helper pod's volume, and the helper pod is deleted
+	restorer.AssertNumberOfCalls(t, "RestorePodVolumes", 1)
+	data := restorer.Calls[0].Arguments.Get(0).(restic.RestoreData)
+	assert.Equal(t, "restore-1-abcde", data.Pod.Name)
+	assert.Equal(t, "ns-1", data.SourceNamespace)
+	require.Len(t, data.PodVolumeBackups, 1)
+	assert.Equal(t, "restore-1-abcde", data.PodVolumeBackups[0].Spec.Pod.Name)
+	assert.Equal(t, dataOnlyVolumeName, data.PodVolumeBackups[0].Spec.Volume)
+	assert.Equal(t, "snap-1", data.PodVolumeBackups[0].Status.SnapshotID)
+
+	podClient.AssertCalled(t, "Delete", "restore-1-abcde", &metav1.DeleteOptions{})
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+// updatesExistingResources returns whether a restore with the given
+// existing resource policy changes items that already exist in the cluster.
+func updatesExistingResources(policy velerov1api.ExistingResourcePolicy) bool {
+	return policy == velerov1api.ExistingResourcePolicyUpdate || policy == velerov1api.ExistingResourcePolicyPatch
+}
+
+// updateExistingItem makes an item that already exists in the cluster match
+// its backed-up version, by replacing it or by merge-patching the backed-up
+// version into it. fromCluster is the item as it's stored in the cluster,
+// with its metadata intact, and fromBackup is the item as it would have been
+// created.
+func updateExistingItem(resourceClient client.Dynamic, fromCluster, fromBackup *unstructured.Unstructured, policy velerov1api.ExistingResourcePolicy) error {
+	switch policy {
+	case velerov1api.ExistingResourcePolicyUpdate:
+		// the backed-up item's metadata was reset to its name, namespace,
+		// labels and annotations, so keep the rest of the in-cluster item's
+		// metadata (e.g. its finalizers and owner references), including the
+		// resource version that the update is checked against.
+		desired := fromBackup.DeepCopy()
+		desired.Object["metadata"] = fromCluster.DeepCopy().Object["metadata"]
+		desired.SetLabels(fromBackup.GetLabels())
+		desired.SetAnnotations(fromBackup.GetAnnotations())
+
+		_, err := resourceClient.Update(desired)
+		return errors.WithStack(err)
+	case velerov1api.ExistingResourcePolicyPatch:
+		patch, err := json.Marshal(fromBackup.Object)
+		if err != nil {
+			return errors.Wrap(err, "unable to marshal backed-up item")
+		}
+
+		_, err = resourceClient.Patch(fromBackup.GetName(), patch)
+		return errors.WithStack(err)
+	default:
+		return errors.Errorf("existing resource policy %q doesn't update items", policy)
+	}
+}
+
+// addIdempotencyTokenLabel labels obj with a restore's idempotency token, if
+// it has one.
+func addIdempotencyTokenLabel(obj metav1.Object, token string) {
+	if token == "" {
+		return
+	}
+
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[velerov1api.RestoreIdempotencyTokenLabel] = token
+	obj.SetLabels(labels)
+}
+
+// restoredWithIdempotencyToken returns whether an item in the cluster was
+// created by a restore with the given idempotency token.
+func restoredWithIdempotencyToken(obj metav1.Object, token string) bool {
+	return token != "" && obj.GetLabels()[velerov1api.RestoreIdempotencyTokenLabel] == token
+}
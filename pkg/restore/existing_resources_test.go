@@ -0,0 +1,118 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestUpdateExistingItem(t *testing.T) {
+	fromCluster := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace":       "ns-1",
+			"name":            "cm-1",
+			"resourceVersion": "123",
+			"finalizers":      []interface{}{"finalizer-1"},
+			"labels":          map[string]interface{}{"app": "new"},
+		},
+		"data": map[string]interface{}{"a": "changed", "b": "added"},
+	}}
+	fromBackup := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "cm-1",
+			"labels":    map[string]interface{}{"app": "old", velerov1api.RestoreNameLabel: "restore-1"},
+		},
+		"data": map[string]interface{}{"a": "original"},
+	}}
+
+	t.Run("update replaces the item, keeping the in-cluster metadata other than labels and annotations", func(t *testing.T) {
+		resourceClient := new(test.FakeDynamicClient)
+		defer resourceClient.AssertExpectations(t)
+
+		resourceClient.On("Update", mock.Anything).Return(fromBackup, nil).Run(func(args mock.Arguments) {
+			updated := args.Get(0).(*unstructured.Unstructured)
+
+			assert.Equal(t, "123", updated.GetResourceVersion())
+			assert.Equal(t, []string{"finalizer-1"}, updated.GetFinalizers())
+			assert.Equal(t, map[string]string{"app": "old", velerov1api.RestoreNameLabel: "restore-1"}, updated.GetLabels())
+			assert.Equal(t, map[string]interface{}{"a": "original"}, updated.Object["data"])
+		})
+
+		require.NoError(t, updateExistingItem(resourceClient, fromCluster, fromBackup, velerov1api.ExistingResourcePolicyUpdate))
+
+		// the items aren't modified.
+		assert.Equal(t, map[string]string{"app": "new"}, fromCluster.GetLabels())
+		assert.Equal(t, "", fromBackup.GetResourceVersion())
+	})
+
+	t.Run("patch merges the backed-up item into the in-cluster one", func(t *testing.T) {
+		resourceClient := new(test.FakeDynamicClient)
+		defer resourceClient.AssertExpectations(t)
+
+		resourceClient.On("Patch", "cm-1", mock.Anything).Return(fromBackup, nil).Run(func(args mock.Arguments) {
+			assert.JSONEq(t, `{
+				"apiVersion": "v1",
+				"kind": "ConfigMap",
+				"metadata": {"namespace": "ns-1", "name": "cm-1", "labels": {"app": "old", "velero.io/restore-name": "restore-1"}},
+				"data": {"a": "original"}
+			}`, string(args.Get(1).([]byte)))
+		})
+
+		require.NoError(t, updateExistingItem(resourceClient, fromCluster, fromBackup, velerov1api.ExistingResourcePolicyPatch))
+	})
+
+	t.Run("none is an error", func(t *testing.T) {
+		assert.Error(t, updateExistingItem(new(test.FakeDynamicClient), fromCluster, fromBackup, velerov1api.ExistingResourcePolicyNone))
+	})
+}
+
+func TestRestoredWithIdempotencyToken(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "cm-1",
+		},
+	}}
+
+	// items without the label weren't restored with any token.
+	assert.False(t, restoredWithIdempotencyToken(obj, "token-1"))
+
+	// restores without a token don't label items.
+	addIdempotencyTokenLabel(obj, "")
+	assert.Empty(t, obj.GetLabels())
+	assert.False(t, restoredWithIdempotencyToken(obj, ""))
+
+	addIdempotencyTokenLabel(obj, "token-1")
+	assert.Equal(t, map[string]string{velerov1api.RestoreIdempotencyTokenLabel: "token-1"}, obj.GetLabels())
+	assert.True(t, restoredWithIdempotencyToken(obj, "token-1"))
+	assert.False(t, restoredWithIdempotencyToken(obj, "token-2"))
+}
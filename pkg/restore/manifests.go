@@ -0,0 +1,110 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"archive/tar"
+	"io"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+const (
+	// manifestsNamespacedDir is the directory in a restore's manifests tarball
+	// that namespaced items are written to, under a directory for their namespace.
+	manifestsNamespacedDir = "namespaces"
+
+	// manifestsClusterDir is the directory in a restore's manifests tarball that
+	// cluster-scoped items are written to.
+	manifestsClusterDir = "cluster"
+)
+
+// manifestWriter writes the manifests of the items in a restore to a tarball,
+// instead of creating them in the cluster, for restores that have spec.noApply
+// set. Each item is written as YAML to namespaces/<namespace>/<resource>/<name>.yaml
+// if it's namespaced, or cluster/<resource>/<name>.yaml if it's cluster-scoped.
+type manifestWriter struct {
+	tarWriter *tar.Writer
+}
+
+func newManifestWriter(w io.Writer) *manifestWriter {
+	return &manifestWriter{tarWriter: tar.NewWriter(w)}
+}
+
+// writeItem writes an item's manifest to the tarball.
+func (w *manifestWriter) writeItem(obj *unstructured.Unstructured, groupResource schema.GroupResource) error {
+	itemBytes, err := encode.Encode(obj, "yaml")
+	if err != nil {
+		return errors.Wrapf(err, "error encoding manifest of %s %s", groupResource, obj.GetName())
+	}
+
+	filePath := path.Join(manifestsClusterDir, groupResource.String(), obj.GetName()+".yaml")
+	if obj.GetNamespace() != "" {
+		filePath = path.Join(manifestsNamespacedDir, obj.GetNamespace(), groupResource.String(), obj.GetName()+".yaml")
+	}
+
+	hdr := &tar.Header{
+		Name:     filePath,
+		Size:     int64(len(itemBytes)),
+		Typeflag: tar.TypeReg,
+		Mode:     0644,
+		ModTime:  time.Now(),
+	}
+
+	if err := w.tarWriter.WriteHeader(hdr); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := w.tarWriter.Write(itemBytes); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// writeNamespace writes a namespace's manifest to the tarball.
+func (w *manifestWriter) writeNamespace(ns *corev1api.Namespace) error {
+	ns = ns.DeepCopy()
+	ns.APIVersion = "v1"
+	ns.Kind = "Namespace"
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(ns)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	obj, err := resetMetadataAndStatus(&unstructured.Unstructured{Object: content})
+	if err != nil {
+		return err
+	}
+
+	return w.writeItem(obj, kuberesource.Namespaces)
+}
+
+// close writes the end of the tarball.
+func (w *manifestWriter) close() error {
+	return errors.WithStack(w.tarWriter.Close())
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// namespaceMappingPattern is a namespace mapping whose source is a wildcard
+// or a regular expression rather than a literal namespace name.
+type namespaceMappingPattern struct {
+	source *regexp.Regexp
+	target string
+}
+
+// namespaceMapper resolves the namespace that items from a backed-up namespace
+// should be restored into, according to a restore's namespace mapping.
+//
+// Keys in the mapping can be:
+//   - a literal namespace name, e.g. "team-a" -> "team-a-restored"
+//   - a wildcard pattern, where each '*' in the key matches any sequence of
+//     characters and is substituted for the corresponding '*' in the value,
+//     e.g. "team-*" -> "team-*-restored"
+//   - a regular expression surrounded by '/', whose value may reference
+//     capture groups, e.g. "/^team-(.*)$/" -> "${1}-restored"
+//
+// Literal mappings take precedence over patterns. If multiple patterns match a
+// namespace, the one with the lexicographically-first key is used.
+type namespaceMapper struct {
+	literals map[string]string
+	patterns []namespaceMappingPattern
+}
+
+// newNamespaceMapper returns a namespaceMapper for the given namespace mapping, or
+// an error if any of the mapping's patterns are invalid.
+func newNamespaceMapper(mapping map[string]string) (*namespaceMapper, error) {
+	m := &namespaceMapper{
+		literals: make(map[string]string),
+	}
+
+	keys := make([]string, 0, len(mapping))
+	for key := range mapping {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		target := mapping[key]
+
+		switch {
+		case isRegexMapping(key):
+			source, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", key[1:len(key)-1]))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid namespace mapping %q", key)
+			}
+			m.patterns = append(m.patterns, namespaceMappingPattern{source: source, target: target})
+		case strings.Contains(key, "*"):
+			m.patterns = append(m.patterns, wildcardMapping(key, target))
+		default:
+			m.literals[key] = target
+		}
+	}
+
+	return m, nil
+}
+
+// isRegexMapping returns true if a namespace mapping key is a regular expression,
+// i.e. it's surrounded by '/'.
+func isRegexMapping(key string) bool {
+	return len(key) > 2 && strings.HasPrefix(key, "/") && strings.HasSuffix(key, "/")
+}
+
+// wildcardMapping converts a wildcard namespace mapping into a regular expression
+// mapping, where each '*' in the source becomes a capture group that's substituted
+// for the corresponding '*' in the target.
+func wildcardMapping(source, target string) namespaceMappingPattern {
+	sourceParts := strings.Split(source, "*")
+	for i := range sourceParts {
+		sourceParts[i] = regexp.QuoteMeta(sourceParts[i])
+	}
+
+	targetParts := strings.Split(target, "*")
+	var expandedTarget strings.Builder
+	for i, part := range targetParts {
+		// escape any '$' in the target so it isn't treated as a capture group reference
+		expandedTarget.WriteString(strings.Replace(part, "$", "$$", -1))
+		if i < len(targetParts)-1 {
+			expandedTarget.WriteString(fmt.Sprintf("${%d}", i+1))
+		}
+	}
+
+	return namespaceMappingPattern{
+		source: regexp.MustCompile("^" + strings.Join(sourceParts, "(.*)") + "$"),
+		target: expandedTarget.String(),
+	}
+}
+
+// targetNamespace returns the namespace that items in the given backed-up namespace
+// should be restored into, and whether the namespace is remapped.
+func (m *namespaceMapper) targetNamespace(namespace string) (string, bool) {
+	if m == nil {
+		return namespace, false
+	}
+
+	if target, ok := m.literals[namespace]; ok {
+		return target, true
+	}
+
+	for _, pattern := range m.patterns {
+		if pattern.source.MatchString(namespace) {
+			return pattern.source.ReplaceAllString(namespace, pattern.target), true
+		}
+	}
+
+	return namespace, false
+}
+
+// isEmpty returns true if the mapper doesn't remap any namespaces.
+func (m *namespaceMapper) isEmpty() bool {
+	return m == nil || (len(m.literals) == 0 && len(m.patterns) == 0)
+}
+
+// ValidateNamespaceMapping returns an error if any of the wildcard or regular
+// expression keys in a restore's namespace mapping are invalid.
+func ValidateNamespaceMapping(mapping map[string]string) error {
+	_, err := newNamespaceMapper(mapping)
+	return err
+}
+
+// PatternsMappingTo returns the wildcard and regular expression keys of a
+// restore's namespace mapping whose targets can resolve to the given
+// namespace. Since the backed-up namespaces aren't known until the restore
+// runs, each '*' or capture group reference in a target is assumed to match
+// any sequence of characters.
+func PatternsMappingTo(mapping map[string]string, namespace string) []string {
+	var keys []string
+	for key, target := range mapping {
+		var pattern string
+		switch {
+		case isRegexMapping(key):
+			pattern = templatePattern(target)
+		case strings.Contains(key, "*"):
+			parts := strings.Split(target, "*")
+			for i := range parts {
+				parts[i] = regexp.QuoteMeta(parts[i])
+			}
+			pattern = strings.Join(parts, ".*")
+		default:
+			continue
+		}
+
+		if regexp.MustCompile("^" + pattern + "$").MatchString(namespace) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// templatePattern converts the target of a regular expression mapping, which
+// is a template for regexp.Expand, into a regular expression that matches
+// every namespace it can expand to.
+func templatePattern(template string) string {
+	var pattern strings.Builder
+	for len(template) > 0 {
+		i := strings.Index(template, "$")
+		if i < 0 {
+			pattern.WriteString(regexp.QuoteMeta(template))
+			break
+		}
+		pattern.WriteString(regexp.QuoteMeta(template[:i]))
+		template = template[i+1:]
+
+		switch {
+		case strings.HasPrefix(template, "$"):
+			pattern.WriteString(regexp.QuoteMeta("$"))
+			template = template[1:]
+		case strings.HasPrefix(template, "{") && strings.Contains(template, "}"):
+			pattern.WriteString(".*")
+			template = template[strings.Index(template, "}")+1:]
+		default:
+			name := strings.IndexFunc(template, func(r rune) bool {
+				return !(r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+			})
+			if name < 0 {
+				name = len(template)
+			}
+			if name == 0 {
+				// a '$' that isn't a reference is expanded as is
+				pattern.WriteString(regexp.QuoteMeta("$"))
+				continue
+			}
+			pattern.WriteString(".*")
+			template = template[name:]
+		}
+	}
+	return pattern.String()
+}
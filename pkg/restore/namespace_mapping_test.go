@@ -0,0 +1,145 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceMapperTargetNamespace(t *testing.T) {
+	tests := []struct {
+		name         string
+		mapping      map[string]string
+		namespace    string
+		wantTarget   string
+		wantRemapped bool
+	}{
+		{
+			name:         "nil mapping doesn't remap",
+			namespace:    "ns-1",
+			wantTarget:   "ns-1",
+			wantRemapped: false,
+		},
+		{
+			name:         "literal mapping remaps matching namespace",
+			mapping:      map[string]string{"ns-1": "ns-2"},
+			namespace:    "ns-1",
+			wantTarget:   "ns-2",
+			wantRemapped: true,
+		},
+		{
+			name:         "literal mapping doesn't remap other namespaces",
+			mapping:      map[string]string{"ns-1": "ns-2"},
+			namespace:    "ns-3",
+			wantTarget:   "ns-3",
+			wantRemapped: false,
+		},
+		{
+			name:         "wildcard mapping substitutes matched text into target",
+			mapping:      map[string]string{"team-*": "team-*-restored"},
+			namespace:    "team-a",
+			wantTarget:   "team-a-restored",
+			wantRemapped: true,
+		},
+		{
+			name:         "wildcard mapping with multiple wildcards substitutes each in order",
+			mapping:      map[string]string{"*-team-*": "*-*"},
+			namespace:    "prod-team-a",
+			wantTarget:   "prod-a",
+			wantRemapped: true,
+		},
+		{
+			name:         "wildcard mapping without wildcard in target maps to a single namespace",
+			mapping:      map[string]string{"team-*": "all-teams"},
+			namespace:    "team-b",
+			wantTarget:   "all-teams",
+			wantRemapped: true,
+		},
+		{
+			name:         "wildcard mapping doesn't match partial names",
+			mapping:      map[string]string{"team-*": "team-*-restored"},
+			namespace:    "my-team-a",
+			wantTarget:   "my-team-a",
+			wantRemapped: false,
+		},
+		{
+			name:         "regex mapping substitutes capture groups into target",
+			mapping:      map[string]string{"/team-(a|b)/": "${1}-restored"},
+			namespace:    "team-b",
+			wantTarget:   "b-restored",
+			wantRemapped: true,
+		},
+		{
+			name:         "regex mapping must match the whole namespace name",
+			mapping:      map[string]string{"/team-(a|b)/": "${1}-restored"},
+			namespace:    "team-bb",
+			wantTarget:   "team-bb",
+			wantRemapped: false,
+		},
+		{
+			name:         "literal mapping takes precedence over patterns",
+			mapping:      map[string]string{"team-*": "team-*-restored", "team-a": "special"},
+			namespace:    "team-a",
+			wantTarget:   "special",
+			wantRemapped: true,
+		},
+		{
+			name:         "first matching pattern by key is used",
+			mapping:      map[string]string{"team-*": "wildcard-*", "/team-.*/": "regex"},
+			namespace:    "team-a",
+			wantTarget:   "regex",
+			wantRemapped: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mapper, err := newNamespaceMapper(tc.mapping)
+			require.NoError(t, err)
+
+			target, remapped := mapper.targetNamespace(tc.namespace)
+			assert.Equal(t, tc.wantTarget, target)
+			assert.Equal(t, tc.wantRemapped, remapped)
+		})
+	}
+}
+
+func TestValidateNamespaceMapping(t *testing.T) {
+	assert.NoError(t, ValidateNamespaceMapping(map[string]string{"ns-1": "ns-2", "team-*": "*", "/^(.*)$/": "${1}"}))
+	assert.Error(t, ValidateNamespaceMapping(map[string]string{"/team-(/": "team"}))
+}
+
+func TestPatternsMappingTo(t *testing.T) {
+	mapping := map[string]string{
+		"kube-system":     "kube-system-copy",
+		"app":             "kube-system",
+		"team-*":          "kube-*",
+		"old-*":           "restored-*",
+		"/^x-(.*)$/":      "kube-${1}",
+		"/^y-(.*)$/":      "$1",
+		"/^z-(?P<n>.*)$/": "z-$n",
+		"/^w-(.*)$/":      "$$kube-system",
+	}
+
+	assert.Equal(t, []string{"/^x-(.*)$/", "/^y-(.*)$/", "team-*"}, PatternsMappingTo(mapping, "kube-system"))
+	assert.Equal(t, []string{"/^y-(.*)$/", "old-*"}, PatternsMappingTo(mapping, "restored-app"))
+	assert.Equal(t, []string{"/^w-(.*)$/", "/^y-(.*)$/"}, PatternsMappingTo(mapping, "$kube-system"))
+	assert.Empty(t, PatternsMappingTo(map[string]string{"app": "kube-system"}, "kube-system"))
+}
@@ -17,12 +17,19 @@ limitations under the License.
 package restore
 
 import (
+	"strconv"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -39,6 +46,9 @@ type pvRestorer struct {
 	volumeSnapshots         []*volume.Snapshot
 	volumeSnapshotterGetter VolumeSnapshotterGetter
 	snapshotLocationLister  listers.VolumeSnapshotLocationLister
+	zoneMapping             map[string]string
+	volumeOverrides         *api.VolumeOverrides
+	span                    *trace.Span
 }
 
 func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
@@ -87,11 +97,41 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 		return nil, errors.WithStack(err)
 	}
 
-	if err := volumeSnapshotter.Init(snapshotInfo.location.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentialsFile(velero.ConfigWithIdentity(snapshotInfo.location.Spec.Config, snapshotInfo.location.Spec.Identity), snapshotInfo.location.Spec.Credential, r.volumeSnapshotterGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	volumeID, err := volumeSnapshotter.CreateVolumeFromSnapshot(snapshotInfo.providerSnapshotID, snapshotInfo.volumeType, snapshotInfo.volumeAZ, snapshotInfo.volumeIOPS)
+	volumeAZ := snapshotInfo.volumeAZ
+	if targetAZ, ok := r.zoneMapping[volumeAZ]; ok {
+		log.Infof("Restoring persistent volume from snapshot into zone %s instead of %s", targetAZ, volumeAZ)
+		if err := remapPVZone(obj, volumeAZ, targetAZ); err != nil {
+			return nil, err
+		}
+		volumeAZ = targetAZ
+	}
+
+	volumeType, volumeIOPS := snapshotInfo.volumeType, snapshotInfo.volumeIOPS
+	if r.volumeOverrides != nil {
+		if r.volumeOverrides.VolumeType != "" {
+			volumeType, volumeIOPS = r.volumeOverrides.VolumeType, nil
+		}
+		if r.volumeOverrides.IOPS != nil {
+			volumeIOPS = r.volumeOverrides.IOPS
+		}
+		log.Infof("Restoring persistent volume from snapshot with volume type %q and IOPS %s instead of %q and %s", volumeType, iopsString(volumeIOPS), snapshotInfo.volumeType, iopsString(snapshotInfo.volumeIOPS))
+	}
+
+	span := tracing.StartSpan(r.span, "VolumeSnapshotter.CreateVolumeFromSnapshot",
+		trace.StringAttribute("persistentVolume", pvName),
+		trace.StringAttribute("volumeSnapshotLocation", snapshotInfo.location.Name),
+	)
+	volumeID, err := volumeSnapshotter.CreateVolumeFromSnapshot(snapshotInfo.providerSnapshotID, volumeType, volumeAZ, volumeIOPS)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -109,6 +149,85 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 	return updated2, nil
 }
 
+func iopsString(iops *int64) string {
+	if iops == nil {
+		return "<none>"
+	}
+	return strconv.FormatInt(*iops, 10)
+}
+
+// zoneLabels are the keys of the labels, and node affinity requirements, that
+// identify the zone of a persistent volume.
+var zoneLabels = []string{corev1api.LabelZoneFailureDomain, "topology.kubernetes.io/zone"}
+
+// remapPVZone changes the zone of a persistent volume from one zone to another in
+// its zone labels and the node affinity requirements on them, so that pods using
+// it are scheduled in the zone its volume is restored into.
+func remapPVZone(obj *unstructured.Unstructured, from, to string) error {
+	labels := obj.GetLabels()
+	for _, key := range zoneLabels {
+		if labels[key] == from {
+			labels[key] = to
+		}
+	}
+	obj.SetLabels(labels)
+
+	terms, found, err := unstructured.NestedSlice(obj.Object, "spec", "nodeAffinity", "required", "nodeSelectorTerms")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !found {
+		return nil
+	}
+
+	for _, term := range terms {
+		termMap, ok := term.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		expressions, _, err := unstructured.NestedSlice(termMap, "matchExpressions")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for _, expression := range expressions {
+			expressionMap, ok := expression.(map[string]interface{})
+			if !ok || !isZoneLabel(expressionMap["key"]) {
+				continue
+			}
+
+			values, _, err := unstructured.NestedStringSlice(expressionMap, "values")
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			for i := range values {
+				if values[i] == from {
+					values[i] = to
+				}
+			}
+			if err := unstructured.SetNestedStringSlice(expressionMap, values, "values"); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(termMap, expressions, "matchExpressions"); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.WithStack(unstructured.SetNestedSlice(obj.Object, terms, "spec", "nodeAffinity", "required", "nodeSelectorTerms"))
+}
+
+func isZoneLabel(key interface{}) bool {
+	for _, label := range zoneLabels {
+		if key == label {
+			return true
+		}
+	}
+	return false
+}
+
 type snapshotInfo struct {
 	providerSnapshotID string
 	volumeType         string
@@ -23,7 +23,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -194,6 +196,74 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 			expectedVolumeAZ:   "az-1",
 			expectedVolumeIOPS: int64Ptr(1),
 		},
+		{
+			name:    "volume snapshotted in a mapped zone is restored into the target zone",
+			obj:     NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured,
+			restore: builder.ForRestore(api.DefaultNamespace, "").RestorePVs(true).ZoneMappings("az-1", "az-3").Result(),
+			backup:  defaultBackup().Result(),
+			locations: []*api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1),
+			},
+			expectedProvider:   "provider-1",
+			expectedSnapshotID: "snap-1",
+			expectedVolumeType: "type-1",
+			expectedVolumeAZ:   "az-3",
+			expectedVolumeIOPS: int64Ptr(1),
+		},
+		{
+			name:    "volume type override replaces the snapshot's volume type and drops its IOPS",
+			obj:     NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured,
+			restore: builder.ForRestore(api.DefaultNamespace, "").RestorePVs(true).VolumeOverrides("type-2", nil).Result(),
+			backup:  defaultBackup().Result(),
+			locations: []*api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1000),
+			},
+			expectedProvider:   "provider-1",
+			expectedSnapshotID: "snap-1",
+			expectedVolumeType: "type-2",
+			expectedVolumeAZ:   "az-1",
+			expectedVolumeIOPS: nil,
+		},
+		{
+			name:    "volume type and IOPS overrides replace the snapshot's volume type and IOPS",
+			obj:     NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured,
+			restore: builder.ForRestore(api.DefaultNamespace, "").RestorePVs(true).VolumeOverrides("type-2", int64Ptr(100)).Result(),
+			backup:  defaultBackup().Result(),
+			locations: []*api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1000),
+			},
+			expectedProvider:   "provider-1",
+			expectedSnapshotID: "snap-1",
+			expectedVolumeType: "type-2",
+			expectedVolumeAZ:   "az-1",
+			expectedVolumeIOPS: int64Ptr(100),
+		},
+		{
+			name:    "IOPS override keeps the snapshot's volume type",
+			obj:     NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured,
+			restore: builder.ForRestore(api.DefaultNamespace, "").RestorePVs(true).VolumeOverrides("", int64Ptr(100)).Result(),
+			backup:  defaultBackup().Result(),
+			locations: []*api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1000),
+			},
+			expectedProvider:   "provider-1",
+			expectedSnapshotID: "snap-1",
+			expectedVolumeType: "type-1",
+			expectedVolumeAZ:   "az-1",
+			expectedVolumeIOPS: int64Ptr(100),
+		},
 	}
 
 	for _, tc := range tests {
@@ -216,6 +286,8 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 				volumeSnapshots:         tc.volumeSnapshots,
 				snapshotLocationLister:  locationsInformer.Lister(),
 				volumeSnapshotterGetter: volumeSnapshotterGetter,
+				zoneMapping:             tc.restore.Spec.ZoneMapping,
+				volumeOverrides:         tc.restore.Spec.VolumeOverrides,
 			}
 
 			volumeSnapshotter.On("Init", mock.Anything).Return(nil)
@@ -230,6 +302,47 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 	}
 }
 
+func TestRemapPVZone(t *testing.T) {
+	pv := builder.ForPersistentVolume("pv-1").
+		ObjectMeta(builder.WithLabels(
+			corev1api.LabelZoneFailureDomain, "az-1",
+			"topology.kubernetes.io/zone", "az-1",
+			"app", "az-1",
+		)).
+		Result()
+	pv.Spec.NodeAffinity = &corev1api.VolumeNodeAffinity{
+		Required: &corev1api.NodeSelector{
+			NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+				{
+					MatchExpressions: []corev1api.NodeSelectorRequirement{
+						{Key: corev1api.LabelZoneFailureDomain, Operator: corev1api.NodeSelectorOpIn, Values: []string{"az-1", "az-2"}},
+						{Key: "app", Operator: corev1api.NodeSelectorOpIn, Values: []string{"az-1"}},
+					},
+				},
+			},
+		},
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+	require.NoError(t, err)
+	obj := &unstructured.Unstructured{Object: content}
+
+	require.NoError(t, remapPVZone(obj, "az-1", "az-3"))
+
+	res := new(corev1api.PersistentVolume)
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, res))
+
+	assert.Equal(t, map[string]string{
+		corev1api.LabelZoneFailureDomain: "az-3",
+		"topology.kubernetes.io/zone":    "az-3",
+		"app":                            "az-1",
+	}, res.Labels)
+
+	expressions := res.Spec.NodeAffinity.Required.NodeSelectorTerms[0].MatchExpressions
+	assert.Equal(t, []string{"az-3", "az-2"}, expressions[0].Values)
+	assert.Equal(t, []string{"az-1"}, expressions[1].Values)
+}
+
 type providerToVolumeSnapshotterMap map[string]velero.VolumeSnapshotter
 
 func (g providerToVolumeSnapshotterMap) GetVolumeSnapshotter(provider string) (velero.VolumeSnapshotter, error) {
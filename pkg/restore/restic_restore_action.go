@@ -75,6 +75,11 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 
 	log := a.logger.WithField("pod", kube.NamespaceAndName(&pod))
 
+	if input.Restore.Spec.NoApply {
+		log.Debug("Not adding restic restore init container because the restore has spec.noApply set")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
 	opts := restic.NewPodVolumeBackupListOptions(input.Restore.Spec.BackupName)
 	podVolumeBackupList, err := a.podVolumeBackupClient.List(opts)
 	if err != nil {
@@ -104,17 +109,7 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	image := getImage(log, config)
 	log.Infof("Using image %q", image)
 
-	cpuRequest, memRequest := getResourceRequests(log, config)
-	cpuLimit, memLimit := getResourceLimits(log, config)
-
-	resourceReqs, err := kube.ParseResourceRequirements(cpuRequest, memRequest, cpuLimit, memLimit)
-	if err != nil {
-		log.Errorf("Using default resource values, couldn't parse resource requirements: %s.", err)
-		resourceReqs, _ = kube.ParseResourceRequirements(
-			defaultCPURequestLimit, defaultMemRequestLimit, // requests
-			defaultCPURequestLimit, defaultMemRequestLimit, // limits
-		)
-	}
+	resourceReqs := getResourceRequirements(log, config)
 
 	initContainerBuilder := newResticInitContainerBuilder(image, string(input.Restore.UID))
 	initContainerBuilder.Resources(&resourceReqs)
@@ -195,6 +190,24 @@ func getResourceLimits(log logrus.FieldLogger, config *corev1.ConfigMap) (string
 	return config.Data["cpuLimit"], config.Data["memLimit"]
 }
 
+// getResourceRequirements returns the resource requirements for the restic restore
+// helper container from a ConfigMap, falling back to the defaults if they can't be parsed.
+func getResourceRequirements(log logrus.FieldLogger, config *corev1.ConfigMap) corev1.ResourceRequirements {
+	cpuRequest, memRequest := getResourceRequests(log, config)
+	cpuLimit, memLimit := getResourceLimits(log, config)
+
+	resourceReqs, err := kube.ParseResourceRequirements(cpuRequest, memRequest, cpuLimit, memLimit)
+	if err != nil {
+		log.Errorf("Using default resource values, couldn't parse resource requirements: %s.", err)
+		resourceReqs, _ = kube.ParseResourceRequirements(
+			defaultCPURequestLimit, defaultMemRequestLimit, // requests
+			defaultCPURequestLimit, defaultMemRequestLimit, // limits
+		)
+	}
+
+	return resourceReqs
+}
+
 // TODO eventually this can move to pkg/plugin/framework since it'll be used across multiple
 // plugins.
 func getPluginConfig(kind framework.PluginKind, name string, client corev1client.ConfigMapInterface) (*corev1.ConfigMap, error) {
@@ -109,6 +109,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 		name             string
 		pod              *corev1api.Pod
 		podVolumeBackups []*velerov1api.PodVolumeBackup
+		noApply          bool
 		want             *corev1api.Pod
 	}{
 		{
@@ -179,6 +180,16 @@ func TestResticRestoreActionExecute(t *testing.T) {
 					builder.ForContainer("first-container", "").Result()).
 				Result(),
 		},
+		{
+			name: "Restoring pod with spec.noApply set doesn't add the restic initContainer",
+			pod: builder.ForPod("ns-1", "my-pod").ObjectMeta(
+				builder.WithAnnotations("snapshot.velero.io/myvol", "")).
+				Result(),
+			noApply: true,
+			want: builder.ForPod("ns-1", "my-pod").ObjectMeta(
+				builder.WithAnnotations("snapshot.velero.io/myvol", "")).
+				Result(),
+		},
 	}
 
 	for _, tc := range tests {
@@ -201,6 +212,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 				Restore: builder.ForRestore(veleroNs, restoreName).
 					Backup(backupName).
 					Phase(velerov1api.RestorePhaseInProgress).
+					NoApply(tc.noApply).
 					Result(),
 			}
 
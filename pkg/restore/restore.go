@@ -30,9 +30,11 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -47,11 +49,13 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -72,6 +76,29 @@ type Request struct {
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	VolumeSnapshots  []*volume.Snapshot
 	BackupReader     io.Reader
+
+	// ManifestsWriter is where the manifests of the items in the restore
+	// are written, as a tarball, if the restore's spec.noApply is true.
+	ManifestsWriter io.Writer
+
+	// SkippedItems is where the items that restore item actions chose not to
+	// restore are recorded. If it's nil, they're only logged.
+	SkippedItems *Result
+
+	// FailedItems is where the items that failed to restore are recorded, by
+	// their resource IDs in the backup, so that they can be retried. If it's
+	// nil, they aren't recorded.
+	FailedItems *Result
+
+	// RetryItems are the resource IDs in the backup of the items that are
+	// restored, if the restore is retrying another restore's failed items.
+	// If it's nil, all of the items that the restore's filters include are
+	// restored.
+	RetryItems sets.String
+
+	// Span is the restore's trace span. The spans of the plugin calls made
+	// while restoring items are recorded as its children.
+	Span *trace.Span
 }
 
 // Restorer knows how to restore a backup.
@@ -92,6 +119,8 @@ type kubernetesRestorer struct {
 	resticRestorerFactory      restic.RestorerFactory
 	resticTimeout              time.Duration
 	resourceTerminatingTimeout time.Duration
+	crdEstablishedTimeout      time.Duration
+	pvcBindingTimeout          time.Duration
 	resourcePriorities         []string
 	fileSystem                 filesystem.Interface
 	pvRenamer                  func(string) string
@@ -106,9 +135,15 @@ func prioritizeResources(helper discovery.Helper, priorities []string, includedR
 	// set keeps track of resolved GroupResource names
 	set := sets.NewString()
 
-	// start by resolving priorities into GroupResources and adding them to ret
+	// start by resolving priorities into GroupResources and adding them to ret.
+	// Resources that the cluster doesn't serve, such as those of custom resource
+	// definitions that haven't been restored yet, are skipped.
 	for _, r := range priorities {
 		gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(r).WithVersion(""))
+		if meta.IsNoMatchError(err) {
+			logger.WithError(err).WithField("resource", r).Warn("Skipping prioritized resource that isn't served by the cluster")
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -165,6 +200,8 @@ func NewKubernetesRestorer(
 	resticRestorerFactory restic.RestorerFactory,
 	resticTimeout time.Duration,
 	resourceTerminatingTimeout time.Duration,
+	crdEstablishedTimeout time.Duration,
+	pvcBindingTimeout time.Duration,
 	logger logrus.FieldLogger,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
@@ -174,6 +211,8 @@ func NewKubernetesRestorer(
 		resticRestorerFactory:      resticRestorerFactory,
 		resticTimeout:              resticTimeout,
 		resourceTerminatingTimeout: resourceTerminatingTimeout,
+		crdEstablishedTimeout:      crdEstablishedTimeout,
+		pvcBindingTimeout:          pvcBindingTimeout,
 		resourcePriorities:         resourcePriorities,
 		logger:                     logger,
 		pvRenamer:                  func(string) string { return "velero-clone-" + uuid.NewV4().String() },
@@ -206,16 +245,31 @@ func (kr *kubernetesRestorer) Restore(
 
 	// get resource includes-excludes
 	resourceIncludesExcludes := getResourceIncludesExcludes(kr.discoveryHelper, req.Restore.Spec.IncludedResources, req.Restore.Spec.ExcludedResources)
-	prioritizedResources, err := prioritizeResources(kr.discoveryHelper, kr.resourcePriorities, resourceIncludesExcludes, req.Log)
+
+	// the restore's resource priorities override the server's.
+	resourcePriorities := kr.resourcePriorities
+	if len(req.Restore.Spec.ResourcePriorities) > 0 {
+		resourcePriorities = req.Restore.Spec.ResourcePriorities
+	}
+
+	prioritizedResources, err := prioritizeResources(kr.discoveryHelper, resourcePriorities, resourceIncludesExcludes, req.Log)
 	if err != nil {
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
+	if req.Restore.Spec.AdmissionWebhooks == velerov1api.AdmissionWebhookPolicyRestoreLast {
+		prioritizedResources = restoreAdmissionWebhooksLast(prioritizedResources)
+	}
 
 	// get namespace includes-excludes
 	namespaceIncludesExcludes := collections.NewIncludesExcludes().
 		Includes(req.Restore.Spec.IncludedNamespaces...).
 		Excludes(req.Restore.Spec.ExcludedNamespaces...)
 
+	namespaceMapper, err := newNamespaceMapper(req.Restore.Spec.NamespaceMapping)
+	if err != nil {
+		return Result{}, Result{Velero: []string{err.Error()}}
+	}
+
 	resolvedActions, err := resolveActions(actions, kr.discoveryHelper)
 	if err != nil {
 		return Result{}, Result{Velero: []string{err.Error()}}
@@ -250,6 +304,9 @@ func (kr *kubernetesRestorer) Restore(
 		volumeSnapshots:         req.VolumeSnapshots,
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
 		snapshotLocationLister:  snapshotLocationLister,
+		zoneMapping:             req.Restore.Spec.ZoneMapping,
+		volumeOverrides:         req.Restore.Spec.VolumeOverrides,
+		span:                    req.Span,
 	}
 
 	restoreCtx := &context{
@@ -258,7 +315,9 @@ func (kr *kubernetesRestorer) Restore(
 		restore:                    req.Restore,
 		resourceIncludesExcludes:   resourceIncludesExcludes,
 		namespaceIncludesExcludes:  namespaceIncludesExcludes,
+		namespaceMapper:            namespaceMapper,
 		prioritizedResources:       prioritizedResources,
+		replicaPolicies:            kube.NewReplicaPolicies(req.Restore.Spec.ReplicaPolicies, groupResourceResolver(kr.discoveryHelper)),
 		selector:                   selector,
 		log:                        req.Log,
 		dynamicFactory:             kr.dynamicFactory,
@@ -272,10 +331,36 @@ func (kr *kubernetesRestorer) Restore(
 		volumeSnapshots:            req.VolumeSnapshots,
 		podVolumeBackups:           req.PodVolumeBackups,
 		resourceTerminatingTimeout: kr.resourceTerminatingTimeout,
+		crdEstablishedTimeout:      kr.crdEstablishedTimeout,
+		pvcBindingTimeout:          kr.pvcBindingTimeout,
+		discoveryHelper:            kr.discoveryHelper,
+		resourcePriorities:         resourcePriorities,
 		resourceClients:            make(map[resourceClientKey]client.Dynamic),
 		restoredItems:              make(map[velero.ResourceIdentifier]struct{}),
 		renamedPVs:                 make(map[string]string),
+		retainedPVs:                make(map[string]string),
 		pvRenamer:                  kr.pvRenamer,
+		skippedItems:               req.SkippedItems,
+		failedItems:                req.FailedItems,
+		retryItems:                 req.RetryItems,
+		span:                       req.Span,
+	}
+
+	if req.Restore.Spec.DataOnly {
+		return restoreCtx.executeDataOnly()
+	}
+
+	if req.Restore.Spec.NoApply {
+		if req.ManifestsWriter == nil {
+			return Result{}, Result{Velero: []string{"no manifests writer provided for restore with spec.noApply set"}}
+		}
+		restoreCtx.manifests = newManifestWriter(req.ManifestsWriter)
+
+		warnings, errs := restoreCtx.execute()
+		if err := restoreCtx.manifests.close(); err != nil {
+			addVeleroError(&errs, err)
+		}
+		return warnings, errs
 	}
 
 	return restoreCtx.execute()
@@ -288,20 +373,27 @@ func getResourceIncludesExcludes(helper discovery.Helper, includes, excludes []s
 	resources := collections.GenerateIncludesExcludes(
 		includes,
 		excludes,
-		func(item string) string {
-			gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
-			if err != nil {
-				return ""
-			}
-
-			gr := gvr.GroupResource()
-			return gr.String()
-		},
+		groupResourceResolver(helper),
 	)
 
 	return resources
 }
 
+// groupResourceResolver returns a function that uses the discovery helper to
+// resolve a resource name to its fully-qualified group-resource name, or to ""
+// if it isn't a known resource.
+func groupResourceResolver(helper discovery.Helper) func(string) string {
+	return func(item string) string {
+		gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
+		if err != nil {
+			return ""
+		}
+
+		gr := gvr.GroupResource()
+		return gr.String()
+	}
+}
+
 type resolvedAction struct {
 	velero.RestoreItemAction
 
@@ -349,7 +441,9 @@ type context struct {
 	restoreDir                 string
 	resourceIncludesExcludes   *collections.IncludesExcludes
 	namespaceIncludesExcludes  *collections.IncludesExcludes
+	namespaceMapper            *namespaceMapper
 	prioritizedResources       []schema.GroupResource
+	replicaPolicies            kube.ReplicaPolicies
 	selector                   labels.Selector
 	log                        logrus.FieldLogger
 	dynamicFactory             client.DynamicFactory
@@ -364,10 +458,61 @@ type context struct {
 	volumeSnapshots            []*volume.Snapshot
 	podVolumeBackups           []*velerov1api.PodVolumeBackup
 	resourceTerminatingTimeout time.Duration
+	crdEstablishedTimeout      time.Duration
+	pvcBindingTimeout          time.Duration
+	discoveryHelper            discovery.Helper
+	resourcePriorities         []string
+	apiResources               *archive.APIResources
+	unservedResources          sets.String
 	resourceClients            map[resourceClientKey]client.Dynamic
 	restoredItems              map[velero.ResourceIdentifier]struct{}
 	renamedPVs                 map[string]string
 	pvRenamer                  func(string) string
+
+	// manifests is where the manifests of items are written, instead of
+	// creating them in the cluster, if the restore's spec.noApply is true.
+	manifests *manifestWriter
+
+	// skippedItems is where the items that restore item actions chose not
+	// to restore are recorded, if it isn't nil.
+	skippedItems *Result
+
+	// failedItems is where the items that failed to restore are recorded,
+	// if it isn't nil.
+	failedItems *Result
+
+	// retryItems are the only items that are restored, if it isn't nil.
+	retryItems sets.String
+
+	// restoringUIDs are the UIDs of the items in the backup that are being
+	// restored, if the restore's spec.skipOwnerManaged is true.
+	restoringUIDs sets.String
+
+	// autoscalerTargets are the minimum replica counts of the horizontal pod
+	// autoscalers in the backup, by their scale targets, if the restore's
+	// spec.autoscaledReplicas is Omit or MinReplicas.
+	autoscalerTargets map[autoscalerTarget]int64
+
+	// retainedPVs maps the names of restored persistent volumes whose reclaim
+	// policy was set to Retain, if the restore's spec.retainRestoredPVs is
+	// true, to their original reclaim policy.
+	retainedPVs map[string]string
+	// ignoredWebhooks are the restored admission webhook configurations whose
+	// webhooks' failure policies were set to Ignore while they were restored.
+	ignoredWebhooks []ignoredWebhooks
+
+	// restoredCRDs are the names of the custom resource definitions that
+	// have been created by the restore, and that haven't been waited on to
+	// be established yet.
+	restoredCRDs []string
+
+	// unboundPVCs are the persistent volume claims that have been restored
+	// unbound, if the restore's spec.waitForUnboundPVCs is true, and that
+	// haven't been waited on to be bound yet.
+	unboundPVCs []unboundPVC
+
+	// span is the restore's trace span.
+	span *trace.Span
 }
 
 type resourceClientKey struct {
@@ -391,62 +536,87 @@ func (ctx *context) execute() (Result, Result) {
 	// need to set this for additionalItems to be restored
 	ctx.restoreDir = dir
 
-	backupResources, err := archive.NewParser(ctx.log, ctx.fileSystem).Parse(ctx.restoreDir)
+	parser := archive.NewParser(ctx.log, ctx.fileSystem)
+	backupResources, err := parser.Parse(ctx.restoreDir)
 	if err != nil {
 		addVeleroError(&errs, errors.Wrap(err, "error parsing backup contents"))
 		return warnings, errs
 	}
 
+	// backups taken before the API resources were stored in them don't have
+	// them, in which case the resources that the cluster's discovery doesn't
+	// list can't be restored.
+	apiResources, err := parser.ParseAPIResources(ctx.restoreDir)
+	if err != nil {
+		ctx.log.WithError(err).Warn("Error parsing the backup's API resources")
+	}
+	ctx.apiResources = apiResources
+
+	if ctx.restore.Spec.SkipOwnerManaged {
+		ctx.restoringUIDs = ctx.getRestoringUIDs(backupResources)
+	}
+
+	if policy := ctx.restore.Spec.AutoscaledReplicas; policy != "" && policy != velerov1api.AutoscaledReplicaPolicyKeep {
+		ctx.autoscalerTargets = ctx.getAutoscalerTargets(backupResources)
+	}
+
+	// check whether the storage that's being restored will fit before anything
+	// is created, so that restores that are going to run out of it are flagged
+	// up front rather than by claims and pods that are stuck pending.
+	if ctx.manifests == nil {
+		w := ctx.checkCapacity(ctx.estimateCapacity(backupResources))
+		merge(&warnings, &w)
+	}
+
 	existingNamespaces := sets.NewString()
 
-	for _, resource := range ctx.prioritizedResources {
+	// ctx.prioritizedResources is refreshed after custom resource definitions
+	// are restored, so index into it instead of ranging over it.
+	for i := 0; i < len(ctx.prioritizedResources); i++ {
+		resource := ctx.prioritizedResources[i]
+
 		// we don't want to explicitly restore namespace API objs because we'll handle
 		// them as a special case prior to restoring anything into them
 		if resource == kuberesource.Namespaces {
 			continue
 		}
 
-		resourceList := backupResources[resource.String()]
-		if resourceList == nil {
-			continue
-		}
-
-		for namespace, items := range resourceList.ItemsByNamespace {
-			if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
-				ctx.log.Infof("Skipping namespace %s", namespace)
-				continue
-			}
-
-			// get target namespace to restore into, if different
-			// from source namespace
-			targetNamespace := namespace
-			if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
-				targetNamespace = target
-			}
+		w, e := ctx.restoreResourceItems(resource, backupResources[resource.String()], existingNamespaces)
+		merge(&warnings, &w)
+		merge(&errs, &e)
 
-			// if we don't know whether this namespace exists yet, attempt to create
-			// it in order to ensure it exists. Try to get it from the backup tarball
-			// (in order to get any backed-up metadata), but if we don't find it there,
-			// create a blank one.
-			if namespace != "" && !existingNamespaces.Has(targetNamespace) {
-				logger := ctx.log.WithField("namespace", namespace)
-				ns := getNamespace(logger, getItemFilePath(ctx.restoreDir, "namespaces", "", namespace), targetNamespace)
-				if _, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
-					addVeleroError(&errs, err)
-					continue
-				}
+		// custom resources can't be created until their definitions are
+		// established, and their resources aren't known to discovery until
+		// then either, so wait for the restored definitions and refresh the
+		// resources that are left to restore.
+		if resource == kuberesource.CustomResourceDefinitions && len(ctx.restoredCRDs) > 0 {
+			w := ctx.waitForCRDsEstablished()
+			merge(&warnings, &w)
 
-				// keep track of namespaces that we know exist so we don't
-				// have to try to create them multiple times
-				existingNamespaces.Insert(targetNamespace)
+			if err := ctx.refreshPrioritizedResources(i + 1); err != nil {
+				addVeleroError(&errs, err)
 			}
+		}
 
-			w, e := ctx.restoreResource(resource.String(), targetNamespace, namespace, items)
+		// pods can't start until their claims' volumes are provisioned, so
+		// wait for the claims that were restored unbound to be bound.
+		if resource == kuberesource.PersistentVolumeClaims && len(ctx.unboundPVCs) > 0 {
+			w := ctx.waitForUnboundPVCs()
 			merge(&warnings, &w)
-			merge(&errs, &e)
 		}
 	}
 
+	// the resources in the backup that the cluster's discovery doesn't list
+	// are restored last, as the versions they were backed up as.
+	unserved, w := ctx.resolveUnservedResources(backupResources)
+	merge(&warnings, &w)
+
+	for _, resource := range unserved {
+		w, e := ctx.restoreResourceItems(resource, backupResources[resource.String()], existingNamespaces)
+		merge(&warnings, &w)
+		merge(&errs, &e)
+	}
+
 	// TODO timeout?
 	ctx.log.Debug("Waiting on global wait group")
 	waitErrs := ctx.globalWaitGroup.Wait()
@@ -459,9 +629,72 @@ func (ctx *context) execute() (Result, Result) {
 		errs.Velero = append(errs.Velero, err.Error())
 	}
 
+	restoreFailed := len(errs.Velero) > 0 || len(errs.Cluster) > 0 || len(errs.Namespaces) > 0
+	w, e := ctx.revertRetainedPVs(restoreFailed)
+	merge(&warnings, &w)
+	merge(&errs, &e)
+
+	e = ctx.revertIgnoredWebhooks()
+	merge(&errs, &e)
+
+	// admission webhook configurations are restored before most of the
+	// resources they apply to, so if their backends weren't running yet,
+	// they may have rejected them.
+	policy := ctx.restore.Spec.AdmissionWebhooks
+	if restoreFailed && (policy == "" || policy == velerov1api.AdmissionWebhookPolicyInOrder) && backupHasAdmissionWebhooks(backupResources) {
+		addToResult(&warnings, "", errors.New("the backup has admission webhook configurations, which may have rejected items that were restored while their backends weren't running; to avoid this, restore with an admission webhooks policy of RestoreLast or IgnoreFailures"))
+	}
+
 	return warnings, errs
 }
 
+// getRestoringUIDs returns the UIDs of the items in the backup that are included
+// in the restore by its resource, namespace, and label selector filters.
+func (ctx *context) getRestoringUIDs(backupResources map[string]*archive.ResourceItems) sets.String {
+	uids := sets.NewString()
+
+	for _, resource := range ctx.prioritizedResources {
+		resourceList := backupResources[resource.String()]
+		if resourceList == nil {
+			continue
+		}
+
+		for namespace, items := range resourceList.ItemsByNamespace {
+			if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+				continue
+			}
+			if namespace == "" && boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
+				continue
+			}
+
+			for _, item := range items {
+				obj, err := ctx.unmarshal(getItemFilePath(ctx.restoreDir, resource.String(), namespace, item))
+				if err != nil {
+					// restoreResource reports this error when it tries to restore the item
+					continue
+				}
+
+				if ctx.selector.Matches(labels.Set(obj.GetLabels())) {
+					uids.Insert(string(obj.GetUID()))
+				}
+			}
+		}
+	}
+
+	return uids
+}
+
+// getRestoringOwner returns the owner reference of obj to an item that's being
+// restored, if it has one.
+func (ctx *context) getRestoringOwner(obj *unstructured.Unstructured) *metav1.OwnerReference {
+	for _, owner := range obj.GetOwnerReferences() {
+		if ctx.restoringUIDs.Has(string(owner.UID)) {
+			return &owner
+		}
+	}
+	return nil
+}
+
 func getItemFilePath(rootDir, groupResource, namespace, name string) string {
 	switch namespace {
 	case "":
@@ -655,6 +888,61 @@ func (ctx *context) shouldRestore(name string, pvClient client.Dynamic) (bool, e
 
 // restoreResource restores the specified cluster or namespace scoped resource. If namespace is
 // empty we are restoring a cluster level resource, otherwise into the specified namespace.
+// restoreResourceItems restores the items of a resource in the backup into
+// their target namespaces, creating the namespaces that don't exist yet and
+// recording them in existingNamespaces.
+func (ctx *context) restoreResourceItems(resource schema.GroupResource, resourceList *archive.ResourceItems, existingNamespaces sets.String) (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	if resourceList == nil {
+		return warnings, errs
+	}
+
+	for namespace, items := range resourceList.ItemsByNamespace {
+		if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+			ctx.log.Infof("Skipping namespace %s", namespace)
+			continue
+		}
+
+		items = filterRetryItems(ctx.retryItems, resource, namespace, items)
+		if len(items) == 0 {
+			continue
+		}
+
+		// get target namespace to restore into, if different
+		// from source namespace
+		targetNamespace, _ := ctx.namespaceMapper.targetNamespace(namespace)
+
+		// if we don't know whether this namespace exists yet, attempt to create
+		// it in order to ensure it exists. Try to get it from the backup tarball
+		// (in order to get any backed-up metadata), but if we don't find it there,
+		// create a blank one.
+		if namespace != "" && !existingNamespaces.Has(targetNamespace) {
+			logger := ctx.log.WithField("namespace", namespace)
+			ns := getNamespace(logger, getItemFilePath(ctx.restoreDir, "namespaces", "", namespace), targetNamespace)
+			if ctx.manifests != nil {
+				if err := ctx.manifests.writeNamespace(ns); err != nil {
+					addVeleroError(&errs, err)
+					continue
+				}
+			} else if _, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
+				addVeleroError(&errs, err)
+				continue
+			}
+
+			// keep track of namespaces that we know exist so we don't
+			// have to try to create them multiple times
+			existingNamespaces.Insert(targetNamespace)
+		}
+
+		w, e := ctx.restoreResource(resource.String(), targetNamespace, namespace, items)
+		merge(&warnings, &w)
+		merge(&errs, &e)
+	}
+
+	return warnings, errs
+}
+
 func (ctx *context) restoreResource(resource, targetNamespace, originalNamespace string, items []string) (Result, Result) {
 	warnings, errs := Result{}, Result{}
 
@@ -681,6 +969,7 @@ func (ctx *context) restoreResource(resource, targetNamespace, originalNamespace
 		obj, err := ctx.unmarshal(itemPath)
 		if err != nil {
 			addToResult(&errs, targetNamespace, fmt.Errorf("error decoding %q: %v", strings.Replace(itemPath, ctx.restoreDir+"/", "", -1), err))
+			ctx.addFailedItem(groupResource, originalNamespace, item)
 			continue
 		}
 
@@ -689,6 +978,9 @@ func (ctx *context) restoreResource(resource, targetNamespace, originalNamespace
 		}
 
 		w, e := ctx.restoreItem(obj, groupResource, targetNamespace)
+		if len(e.Velero) > 0 || len(e.Cluster) > 0 || len(e.Namespaces) > 0 {
+			ctx.addFailedItem(groupResource, originalNamespace, item)
+		}
 		merge(&warnings, &w)
 		merge(&errs, &e)
 	}
@@ -696,6 +988,30 @@ func (ctx *context) restoreResource(resource, targetNamespace, originalNamespace
 	return warnings, errs
 }
 
+// addFailedItem records an item that failed to restore in ctx.failedItems,
+// if it isn't nil, by its resource ID in the backup.
+func (ctx *context) addFailedItem(groupResource schema.GroupResource, namespace, name string) {
+	if ctx.failedItems != nil {
+		addToResult(ctx.failedItems, namespace, errors.New(getResourceID(groupResource, namespace, name)))
+	}
+}
+
+// filterRetryItems returns the items of a resource in a namespace of the
+// backup that are in retryItems, or all of them if retryItems is nil.
+func filterRetryItems(retryItems sets.String, resource schema.GroupResource, namespace string, items []string) []string {
+	if retryItems == nil {
+		return items
+	}
+
+	var res []string
+	for _, item := range items {
+		if retryItems.Has(getResourceID(resource, namespace, item)) {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
 func (ctx *context) getResourceClient(groupResource schema.GroupResource, obj *unstructured.Unstructured, namespace string) (client.Dynamic, error) {
 	key := resourceClientKey{
 		resource:  groupResource,
@@ -710,12 +1026,22 @@ func (ctx *context) getResourceClient(groupResource schema.GroupResource, obj *u
 	// metadata from an object to do this.
 	ctx.log.Infof("Getting client for %v", obj.GroupVersionKind())
 
+	gv := obj.GroupVersionKind().GroupVersion()
 	resource := metav1.APIResource{
 		Namespaced: len(namespace) > 0,
 		Name:       groupResource.Resource,
 	}
 
-	client, err := ctx.dynamicFactory.ClientForGroupVersionResource(obj.GroupVersionKind().GroupVersion(), resource, namespace)
+	// resources that the cluster's discovery doesn't list are resolved from
+	// the API resources of the cluster that the backup was taken from.
+	if ctx.unservedResources.Has(groupResource.String()) {
+		if gvr, apiResource, ok := ctx.apiResources.ResourceFor(groupResource); ok {
+			gv = gvr.GroupVersion()
+			resource.Name = apiResource.Name
+		}
+	}
+
+	client, err := ctx.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -802,6 +1128,18 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 	}
 	ctx.restoredItems[itemKey] = struct{}{}
 
+	if ctx.restoringUIDs != nil {
+		if owner := ctx.getRestoringOwner(obj); owner != nil {
+			// pods with restic backups are restored, so that their volumes are
+			// restored before the owner's controller adopts them.
+			if groupResource != kuberesource.Pods || len(restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, obj)) == 0 {
+				ctx.log.Infof("Not restoring %s because its owner %s %s is being restored", resourceID, owner.Kind, owner.Name)
+				addToResult(&warnings, namespace, errors.Errorf("owner-managed-skip: %s wasn't restored because its owner %s %s is being restored and will recreate it", resourceID, owner.Kind, owner.Name))
+				return warnings, errs
+			}
+		}
+	}
+
 	// TODO: move to restore item action if/when we add a ShouldRestore() method to the interface
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
 		ctx.log.Infof("Not restoring pod because it's a mirror pod")
@@ -839,7 +1177,11 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 				shouldRestoreSnapshot = true
 			}
 
-			if shouldRestoreSnapshot {
+			if shouldRestoreSnapshot && ctx.manifests != nil {
+				ctx.log.Infof("Not restoring persistent volume from snapshot because the restore has spec.noApply set.")
+				addToResult(&warnings, namespace, errors.Errorf("persistent volume %s wasn't restored from its snapshot because the restore has spec.noApply set; its manifest refers to the original volume", name))
+				unstructured.RemoveNestedField(obj.Object, "spec", "claimRef")
+			} else if shouldRestoreSnapshot {
 				// even if we're renaming the PV, obj still has the old name here, because the pvRestorer
 				// uses the original name to look up metadata about the snapshot.
 				ctx.log.Infof("Restoring persistent volume from snapshot.")
@@ -868,6 +1210,13 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 				obj.SetAnnotations(annotations)
 			}
 
+		case hasCSIVolumeSnapshot(obj) && features.IsEnabled(velerov1api.CSIFeatureFlag):
+			ctx.log.Infof("Dynamically re-provisioning persistent volume because its claim is restored from a CSI volume snapshot.")
+			ctx.pvsToProvision.Insert(name)
+
+			// return early because we don't want to restore the PV itself, we want to dynamically re-provision it.
+			return warnings, errs
+
 		case hasResticBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a restic backup to be restored.")
 			ctx.pvsToProvision.Insert(name)
@@ -896,12 +1245,33 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 		}
 	}
 
+	if groupResource == kuberesource.VolumeSnapshotContents && features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		ctx.log.Infof("Not restoring volume snapshot content because volume snapshot contents are created for the volume snapshots that are restored.")
+		return warnings, errs
+	}
+
+	// the binding state of persistent volume claims is read before their
+	// status is cleared, for backups taken before it was recorded.
+	var unboundPhase string
+	if groupResource == kuberesource.PersistentVolumeClaims {
+		unboundPhase = unboundPVCPhase(obj)
+	}
+
 	// clear out non-core metadata fields & status
 	if obj, err = resetMetadataAndStatus(obj); err != nil {
 		addToResult(&errs, namespace, err)
 		return warnings, errs
 	}
 
+	if groupResource == kuberesource.VolumeSnapshots && features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		if ctx.manifests != nil {
+			addToResult(&warnings, namespace, errors.Errorf("volume snapshot %s wasn't bound to a volume snapshot content because the restore has spec.noApply set", name))
+		} else if obj, err = ctx.prepareCSIVolumeSnapshot(obj, resourceClient, namespace); err != nil {
+			addToResult(&errs, namespace, err)
+			return warnings, errs
+		}
+	}
+
 	for _, action := range ctx.getApplicableActions(groupResource, namespace) {
 		if !action.selector.Matches(labels.Set(obj.GetLabels())) {
 			return warnings, errs
@@ -909,11 +1279,17 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 
 		ctx.log.Infof("Executing item action for %v", &groupResource)
 
+		span := tracing.StartSpan(ctx.span, "RestoreItemAction.Execute",
+			trace.StringAttribute("resource", groupResource.String()),
+			trace.StringAttribute("namespace", namespace),
+			trace.StringAttribute("name", name),
+		)
 		executeOutput, err := action.Execute(&velero.RestoreItemActionExecuteInput{
 			Item:           obj,
 			ItemFromBackup: itemFromBackup,
 			Restore:        ctx.restore,
 		})
+		tracing.EndSpan(span, err)
 		if err != nil {
 			addToResult(&errs, namespace, fmt.Errorf("error preparing %s: %v", resourceID, err))
 			return warnings, errs
@@ -921,6 +1297,9 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
+			if ctx.skippedItems != nil {
+				addToResult(ctx.skippedItems, namespace, errors.Errorf("%s was skipped by a restore item action", resourceID))
+			}
 			return warnings, errs
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
@@ -953,9 +1332,7 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 
 			additionalItemNamespace := additionalItem.Namespace
 			if additionalItemNamespace != "" {
-				if remapped, ok := ctx.restore.Spec.NamespaceMapping[additionalItemNamespace]; ok {
-					additionalItemNamespace = remapped
-				}
+				additionalItemNamespace, _ = ctx.namespaceMapper.targetNamespace(additionalItemNamespace)
 			}
 
 			w, e := ctx.restoreItem(additionalObj, additionalItem.GroupResource, additionalItemNamespace)
@@ -964,6 +1341,21 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 		}
 	}
 
+	if ctx.replicaPolicies.OmitsReplicas(groupResource.String()) && kube.RemoveReplicas(obj.Object) {
+		ctx.log.Infof("Omitting replica count of %s so that it's scaled by its defaults or autoscaler", resourceID)
+	}
+
+	if len(ctx.autoscalerTargets) > 0 {
+		changed, err := setAutoscaledReplicas(obj, ctx.autoscalerTargets, ctx.restore.Spec.AutoscaledReplicas)
+		if err != nil {
+			addToResult(&errs, namespace, errors.Wrapf(err, "error setting replica count of autoscaled %s", resourceID))
+			return warnings, errs
+		}
+		if changed {
+			ctx.log.Infof("Restoring autoscaled %s with the %s replica policy so that its autoscaler resumes control of it", resourceID, ctx.restore.Spec.AutoscaledReplicas)
+		}
+	}
+
 	// This comes after running item actions because we have built-in actions that restore
 	// a PVC's associated PV (if applicable). As part of the PV being restored, the 'pvsToProvision'
 	// set may be inserted into, and this needs to happen *before* running the following block of logic.
@@ -979,17 +1371,15 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 			return warnings, errs
 		}
 
-		if pvc.Spec.VolumeName != "" && ctx.pvsToProvision.Has(pvc.Spec.VolumeName) {
+		if hasCSIVolumeSnapshot(obj) && features.IsEnabled(velerov1api.CSIFeatureFlag) {
+			ctx.log.Infof("Restoring PersistentVolumeClaim %s/%s from CSI volume snapshot %s", namespace, name, obj.GetAnnotations()[velerov1api.VolumeSnapshotNameAnnotation])
+			if err := restorePVCFromCSISnapshot(obj); err != nil {
+				addToResult(&errs, namespace, err)
+				return warnings, errs
+			}
+		} else if pvc.Spec.VolumeName != "" && ctx.pvsToProvision.Has(pvc.Spec.VolumeName) {
 			ctx.log.Infof("Resetting PersistentVolumeClaim %s/%s for dynamic provisioning because its PV %v has a reclaim policy of Delete", namespace, name, pvc.Spec.VolumeName)
-
-			// use the unstructured helpers here since we're only deleting and
-			// the unstructured converter will add back (empty) fields for metadata
-			// and status that we removed earlier.
-			unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
-			annotations := obj.GetAnnotations()
-			delete(annotations, "pv.kubernetes.io/bind-completed")
-			delete(annotations, "pv.kubernetes.io/bound-by-controller")
-			obj.SetAnnotations(annotations)
+			resetPVCBinding(obj)
 		}
 
 		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
@@ -999,6 +1389,12 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 				return warnings, errs
 			}
 		}
+
+		if unboundPhase != "" {
+			ctx.log.Infof("Restoring PersistentVolumeClaim %s/%s unbound because it was %s when it was backed up", namespace, name, unboundPhase)
+			resetUnboundPVC(obj, unboundPhase)
+			addToResult(&warnings, namespace, errors.Errorf("persistent volume claim %s was %s when it was backed up, so it was restored unbound; it will be bound to a matching or dynamically provisioned volume", name, unboundPhase))
+		}
 	}
 
 	// necessary because we may have remapped the namespace
@@ -1012,6 +1408,33 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 	// for easy identification of all cluster resources created by this restore
 	// and which backup they came from
 	addRestoreLabels(obj, ctx.restore.Name, ctx.restore.Spec.BackupName)
+	addIdempotencyTokenLabel(obj, ctx.restore.Spec.IdempotencyToken)
+
+	if ctx.manifests != nil {
+		ctx.log.Infof("Writing manifest of %s: %v", obj.GroupVersionKind().Kind, name)
+		if err := ctx.manifests.writeItem(obj, groupResource); err != nil {
+			addToResult(&errs, namespace, err)
+		}
+		return warnings, errs
+	}
+
+	var originalReclaimPolicy string
+	if groupResource == kuberesource.PersistentVolumes && ctx.restore.Spec.RetainRestoredPVs {
+		originalReclaimPolicy, err = retainPV(obj)
+		if err != nil {
+			addToResult(&errs, namespace, err)
+			return warnings, errs
+		}
+	}
+
+	var originalFailurePolicies map[string]string
+	if isAdmissionWebhookConfiguration(groupResource) && ctx.restore.Spec.AdmissionWebhooks == velerov1api.AdmissionWebhookPolicyIgnoreFailures {
+		originalFailurePolicies, err = ignoreWebhookFailures(obj)
+		if err != nil {
+			addToResult(&errs, namespace, err)
+			return warnings, errs
+		}
+	}
 
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 	createdObj, restoreErr := resourceClient.Create(obj)
@@ -1022,6 +1445,7 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 			addToResult(&warnings, namespace, err)
 			return warnings, errs
 		}
+		inCluster := fromCluster.DeepCopy()
 		// Remove insubstantial metadata
 		fromCluster, err = resetMetadataAndStatus(fromCluster)
 		if err != nil {
@@ -1034,8 +1458,29 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 		// copy them from the object we attempted to restore.
 		labels := obj.GetLabels()
 		addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+		addIdempotencyTokenLabel(fromCluster, labels[velerov1api.RestoreIdempotencyTokenLabel])
 
 		if !equality.Semantic.DeepEqual(fromCluster, obj) {
+			policy := ctx.restore.Spec.ExistingResourcePolicy
+			if !updatesExistingResources(policy) && restoredWithIdempotencyToken(inCluster, ctx.restore.Spec.IdempotencyToken) {
+				// the item was created by a restore with the same idempotency
+				// token, e.g. the restore that this one retries, so it's made
+				// to match its backed-up version.
+				ctx.log.Infof("%s %v was restored by a restore with the same idempotency token", obj.GroupVersionKind().Kind, name)
+				policy = velerov1api.ExistingResourcePolicyPatch
+			}
+
+			if updatesExistingResources(policy) {
+				ctx.log.Infof("Attempting to %s existing %s: %v", policy, obj.GroupVersionKind().Kind, name)
+				if err := updateExistingItem(resourceClient, inCluster, obj, policy); err != nil {
+					ctx.log.Infof("error trying to %s existing %s: %v", policy, kube.NamespaceAndName(obj), err)
+					addToResult(&warnings, namespace, errors.Errorf("could not %s existing %s to match the backed-up version: %v", policy, resourceID, err))
+				} else {
+					ctx.log.Infof("Existing %s %s successfully made to match the backed-up version with policy %s", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj), policy)
+				}
+				return warnings, errs
+			}
+
 			switch groupResource {
 			case kuberesource.ServiceAccounts:
 				desired, err := mergeServiceAccounts(fromCluster, obj)
@@ -1081,6 +1526,27 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 		return warnings, errs
 	}
 
+	if groupResource == kuberesource.CustomResourceDefinitions {
+		ctx.restoredCRDs = append(ctx.restoredCRDs, createdObj.GetName())
+	}
+
+	if unboundPhase != "" && ctx.restore.Spec.WaitForUnboundPVCs {
+		// the created claim's storage class is used, since the cluster's
+		// default storage class is set on claims that don't have one.
+		storageClass, _, _ := unstructured.NestedString(createdObj.Object, "spec", "storageClassName")
+		ctx.unboundPVCs = append(ctx.unboundPVCs, unboundPVC{namespace: createdObj.GetNamespace(), name: createdObj.GetName(), storageClass: storageClass})
+	}
+
+	if originalReclaimPolicy != "" {
+		ctx.log.Infof("Set reclaim policy of persistent volume %s to Retain until the restore completes", createdObj.GetName())
+		ctx.retainedPVs[createdObj.GetName()] = originalReclaimPolicy
+	}
+
+	if originalFailurePolicies != nil {
+		ctx.log.Infof("Set failure policies of the webhooks of %s %s to Ignore until the restore completes", groupResource, createdObj.GetName())
+		ctx.ignoredWebhooks = append(ctx.ignoredWebhooks, ignoredWebhooks{resource: groupResource, name: createdObj.GetName(), policies: originalFailurePolicies})
+	}
+
 	if groupResource == kuberesource.Pods && len(restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, obj)) > 0 {
 		restorePodVolumeBackups(ctx, createdObj, originalNamespace)
 	}
@@ -1094,7 +1560,7 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 // (b) in the backup, the PV is claimed by a PVC in a namespace that's being remapped during the
 // restore.
 func shouldRenamePV(ctx *context, obj *unstructured.Unstructured, client client.Dynamic) (bool, error) {
-	if len(ctx.restore.Spec.NamespaceMapping) == 0 {
+	if ctx.namespaceMapper.isEmpty() {
 		ctx.log.Debugf("Persistent volume does not need to be renamed because restore is not remapping any namespaces")
 		return false, nil
 	}
@@ -1109,7 +1575,7 @@ func shouldRenamePV(ctx *context, obj *unstructured.Unstructured, client client.
 		return false, nil
 	}
 
-	if _, ok := ctx.restore.Spec.NamespaceMapping[pv.Spec.ClaimRef.Namespace]; !ok {
+	if _, ok := ctx.namespaceMapper.targetNamespace(pv.Spec.ClaimRef.Namespace); !ok {
 		ctx.log.Debugf("Persistent volume does not need to be renamed because it's not claimed by a PVC in a namespace that's being remapped")
 		return false, nil
 	}
@@ -1221,6 +1687,19 @@ func resetMetadataAndStatus(obj *unstructured.Unstructured) (*unstructured.Unstr
 	return obj, nil
 }
 
+// resetPVCBinding clears the volume a persistent volume claim is bound to, so
+// that it's bound to a dynamically provisioned one.
+func resetPVCBinding(obj *unstructured.Unstructured) {
+	// use the unstructured helpers here since we're only deleting and
+	// the unstructured converter will add back (empty) fields for metadata
+	// and status that we removed earlier.
+	unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+	annotations := obj.GetAnnotations()
+	delete(annotations, "pv.kubernetes.io/bind-completed")
+	delete(annotations, "pv.kubernetes.io/bound-by-controller")
+	obj.SetAnnotations(annotations)
+}
+
 // addRestoreLabels labels the provided object with the restore name and
 // the restored backup's name.
 func addRestoreLabels(obj metav1.Object, restoreName, backupName string) {
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"sort"
 	"testing"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	discoveryfake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
@@ -612,6 +614,200 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 	}
 }
 
+// TestRestoreNoApply runs a restore with spec.noApply set, and verifies that the
+// manifests of the restored items are written to the manifests tarball instead of
+// being created in the cluster.
+func TestRestoreNoApply(t *testing.T) {
+	h := newHarness(t)
+	h.DiscoveryClient.WithAPIResource(test.Pods()).WithAPIResource(test.PVs())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	manifests := new(bytes.Buffer)
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().NoApply(true).NamespaceMappings("ns-1", "mapped-ns-1").Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: newTarWriter(t).
+			addItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-2", "pod-2").Result(),
+			).
+			addItems("persistentvolumes",
+				builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result(),
+			).
+			done(),
+		ManifestsWriter: manifests,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // actions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assertAPIContents(t, h, map[*test.APIResource][]string{
+		test.Pods(): {},
+		test.PVs():  {},
+	})
+
+	namespaces, err := h.KubeClient.CoreV1().Namespaces().List(metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, namespaces.Items)
+
+	files := map[string]string{}
+	tr := tar.NewReader(manifests)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		contents, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(contents)
+	}
+
+	assert.Equal(t, sets.NewString(
+		"cluster/namespaces/mapped-ns-1.yaml",
+		"cluster/namespaces/ns-2.yaml",
+		"cluster/persistentvolumes/pv-1.yaml",
+		"namespaces/mapped-ns-1/pods/pod-1.yaml",
+		"namespaces/ns-2/pods/pod-2.yaml",
+	), sets.StringKeySet(files))
+
+	assert.Contains(t, files["namespaces/mapped-ns-1/pods/pod-1.yaml"], "namespace: mapped-ns-1")
+	assert.Contains(t, files["cluster/namespaces/mapped-ns-1.yaml"], "kind: Namespace")
+}
+
+// withOwner returns an ObjectMetaOpt that adds an owner reference to the
+// object with the given kind, name, and UID.
+func withOwner(kind, name, uid string) builder.ObjectMetaOpt {
+	return func(obj metav1.Object) {
+		obj.SetOwnerReferences(append(obj.GetOwnerReferences(), metav1.OwnerReference{
+			APIVersion: "apps/v1",
+			Kind:       kind,
+			Name:       name,
+			UID:        types.UID(uid),
+		}))
+	}
+}
+
+// TestRestoreSkipOwnerManaged runs restores with spec.skipOwnerManaged set, and
+// verifies that items owned by other items being restored are skipped and reported.
+func TestRestoreSkipOwnerManaged(t *testing.T) {
+	tests := []struct {
+		name             string
+		restore          *velerov1api.Restore
+		podVolumeBackups []*velerov1api.PodVolumeBackup
+		tarball          io.Reader
+		want             map[*test.APIResource][]string
+		wantWarnings     Result
+	}{
+		{
+			name:    "items owned by restored items are skipped and reported",
+			restore: defaultRestore().SkipOwnerManaged(true).Result(),
+			tarball: newTarWriter(t).
+				addItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				addItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(withOwner("Deployment", "deploy-1", "deploy-1-uid")).Result(),
+					builder.ForPod("ns-1", "pod-2").ObjectMeta(withOwner("Deployment", "deploy-2", "deploy-2-uid")).Result(),
+					builder.ForPod("ns-1", "pod-3").Result(),
+				).
+				done(),
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {"ns-1/deploy-1"},
+				test.Pods():        {"ns-1/pod-2", "ns-1/pod-3"},
+			},
+			wantWarnings: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"owner-managed-skip: pods/ns-1/pod-1 wasn't restored because its owner Deployment deploy-1 is being restored and will recreate it"},
+				},
+			},
+		},
+		{
+			name:    "items are restored if their owner is excluded by the label selector",
+			restore: defaultRestore().SkipOwnerManaged(true).LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"restore": "true"}}).Result(),
+			tarball: newTarWriter(t).
+				addItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				addItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("restore", "true"), withOwner("Deployment", "deploy-1", "deploy-1-uid")).Result(),
+				).
+				done(),
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {},
+				test.Pods():        {"ns-1/pod-1"},
+			},
+		},
+		{
+			name:    "owned pods with restic backups are restored",
+			restore: defaultRestore().SkipOwnerManaged(true).Result(),
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodName("pod-1").PodNamespace("ns-1").Volume("vol-1").Result(),
+			},
+			tarball: newTarWriter(t).
+				addItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				addItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(withOwner("Deployment", "deploy-1", "deploy-1-uid")).Result(),
+				).
+				done(),
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {"ns-1/deploy-1"},
+				test.Pods():        {"ns-1/pod-1"},
+			},
+		},
+		{
+			name:    "owned items are restored if skipOwnerManaged isn't set",
+			restore: defaultRestore().Result(),
+			tarball: newTarWriter(t).
+				addItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				addItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(withOwner("Deployment", "deploy-1", "deploy-1-uid")).Result(),
+				).
+				done(),
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {"ns-1/deploy-1"},
+				test.Pods():        {"ns-1/pod-1"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.DiscoveryClient.WithAPIResource(test.Pods()).WithAPIResource(test.Deployments())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := Request{
+				Log:              h.log,
+				Restore:          tc.restore,
+				Backup:           defaultBackup().Result(),
+				PodVolumeBackups: tc.podVolumeBackups,
+				BackupReader:     tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assert.Equal(t, tc.wantWarnings, warnings)
+			assertEmptyResults(t, errs)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+}
+
 // TestRestoreResourcePriorities runs restores with resource priorities specified,
 // and verifies that the set of items created in the API are created in the expected
 // order. Validation is done by adding a Reactor to the fake dynamic client that records
@@ -656,15 +852,43 @@ func TestRestoreResourcePriorities(t *testing.T) {
 				test.PVs(),
 				test.Deployments(),
 				test.ServiceAccounts(),
+				test.PVCs(),
 			},
 			resourcePriorities: []string{"persistentvolumes", "serviceaccounts", "pods", "deployments.apps"},
 		},
+		{
+			name:    "the restore's resource priorities override the server's",
+			restore: defaultRestore().ResourcePriorities("deployments.apps", "serviceaccounts", "pods").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: newTarWriter(t).
+				addItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+				).
+				addItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").Result(),
+				).
+				addItems("serviceaccounts",
+					builder.ForServiceAccount("ns-1", "sa-1").Result(),
+				).
+				done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.Deployments(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: []string{"pods", "serviceaccounts", "deployments.apps"},
+		},
 	}
 
 	for _, tc := range tests {
 		h := newHarness(t)
 		h.restorer.resourcePriorities = tc.resourcePriorities
 
+		want := tc.resourcePriorities
+		if len(tc.restore.Spec.ResourcePriorities) > 0 {
+			want = tc.restore.Spec.ResourcePriorities
+		}
+
 		recorder := &createRecorder{t: t}
 		h.DynamicClient.PrependReactor("create", "*", recorder.reactor())
 
@@ -689,7 +913,7 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		)
 
 		assertEmptyResults(t, warnings, errs)
-		assertResourceCreationOrder(t, tc.resourcePriorities, recorder.resources)
+		assertResourceCreationOrder(t, want, recorder.resources)
 	}
 }
 
@@ -1319,6 +1543,49 @@ func TestRestoreActionModifications(t *testing.T) {
 	}
 }
 
+// TestRestoreActionSkippedItems runs restores with a restore item action that
+// skips some items, and verifies that they're not created in the API and are
+// recorded as skipped.
+func TestRestoreActionSkippedItems(t *testing.T) {
+	skipPod1 := &pluggableAction{
+		selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			output := velero.NewRestoreItemActionExecuteOutput(input.Item)
+			if input.Item.(*unstructured.Unstructured).GetName() == "pod-1" {
+				return output.WithoutRestore(), nil
+			}
+			return output, nil
+		},
+	}
+
+	h := newHarness(t)
+	h.addItems(t, test.Pods())
+
+	skipped := new(Result)
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: newTarWriter(t).addItems("pods",
+			builder.ForPod("ns-1", "pod-1").Result(),
+			builder.ForPod("ns-1", "pod-2").Result(),
+		).done(),
+		SkippedItems: skipped,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		[]velero.RestoreItemAction{skipPod1},
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assertAPIContents(t, h, map[*test.APIResource][]string{
+		test.Pods(): {"ns-1/pod-2"},
+	})
+	assert.Equal(t, Result{Namespaces: map[string][]string{"ns-1": {"pods/ns-1/pod-1 was skipped by a restore item action"}}}, *skipped)
+}
+
 // TestRestoreActionAdditionalItems runs restores with restore item actions that return additional items
 // to be restored, and verifies that that the correct set of items is created in the API. Verification is
 // done by looking at the namespaces/names of the items in the API; contents are not checked.
@@ -1865,6 +2132,53 @@ func TestRestorePersistentVolumes(t *testing.T) {
 				),
 			},
 		},
+		{
+			name:    "when a PV with a reclaim policy of delete has a snapshot and the restore has retainRestoredPVs set, the PV's reclaim policy is set back to delete after the restore",
+			restore: defaultRestore().RetainRestoredPVs(true).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: newTarWriter(t).
+				addItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).AWSEBSVolumeID("old-volume").ObjectMeta(builder.WithAnnotations("foo", "bar")).Result(),
+				).
+				done(),
+			apiResources: []*test.APIResource{
+				test.PVs(),
+				test.PVCs(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				{
+					Spec: volume.SnapshotSpec{
+						BackupName:           "backup-1",
+						Location:             "default",
+						PersistentVolumeName: "pv-1",
+					},
+					Status: volume.SnapshotStatus{
+						Phase:              volume.SnapshotPhaseCompleted,
+						ProviderSnapshotID: "snapshot-1",
+					},
+				},
+			},
+			volumeSnapshotLocations: []*velerov1api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "default").Provider("provider-1").Result(),
+			},
+			volumeSnapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"provider-1": &volumeSnapshotter{
+					snapshotVolumes: map[string]string{"snapshot-1": "new-volume"},
+				},
+			},
+			want: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").
+						ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).
+						AWSEBSVolumeID("new-volume").
+						ObjectMeta(
+							builder.WithAnnotations("foo", "bar"),
+							builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+						).
+						Result(),
+				),
+			},
+		},
 		{
 			name:    "when a PV with a reclaim policy of retain has a snapshot and does not exist in-cluster, the snapshot and PV are restored",
 			restore: defaultRestore().Result(),
@@ -2414,6 +2728,15 @@ func TestPrioritizeResources(t *testing.T) {
 			excludes:   []string{"ooo", "pods"},
 			expected:   []string{"namespaces", "configmaps", "aaa", "bbb", "ddd", "sss"},
 		},
+		{
+			name: "priorities that aren't served are skipped",
+			apiResources: map[string][]string{
+				"v1": {"aaa", "configmaps", "namespaces", "pods"},
+			},
+			priorities: []string{"namespaces", "volumesnapshots.snapshot.storage.k8s.io", "configmaps", "pods"},
+			includes:   []string{"*"},
+			expected:   []string{"namespaces", "configmaps", "pods", "aaa"},
+		},
 	}
 
 	logger := testutil.NewLogger()
@@ -2573,6 +2896,19 @@ func TestGetItemFilePath(t *testing.T) {
 	assert.Equal(t, "root/resources/resource/namespaces/namespace/item.json", res)
 }
 
+func TestFilterRetryItems(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	persistentVolumes := schema.GroupResource{Resource: "persistentvolumes"}
+	retryItems := sets.NewString("deployments.apps/ns-1/app-2", "persistentvolumes/pv-1")
+
+	// restores that aren't retries restore every item
+	assert.Equal(t, []string{"app-1", "app-2"}, filterRetryItems(nil, deployments, "ns-1", []string{"app-1", "app-2"}))
+
+	assert.Equal(t, []string{"app-2"}, filterRetryItems(retryItems, deployments, "ns-1", []string{"app-1", "app-2"}))
+	assert.Empty(t, filterRetryItems(retryItems, deployments, "ns-2", []string{"app-1", "app-2"}))
+	assert.Equal(t, []string{"pv-1"}, filterRetryItems(retryItems, persistentVolumes, "", []string{"pv-1", "pv-2"}))
+}
+
 // assertResourceCreationOrder ensures that resources were created in the expected
 // order. Any resources *not* in resourcePriorities are required to come *after* all
 // resources in any order.
@@ -0,0 +1,182 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kubediscovery "k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+const (
+	// DefaultResticTimeout is how long restic restores of pod volumes are allowed
+	// to run before timing out, if RestorerConfig.ResticTimeout isn't set.
+	DefaultResticTimeout = 60 * time.Minute
+
+	// DefaultResourceTerminatingTimeout is how long to wait on persistent volumes
+	// and namespaces to terminate, if RestorerConfig.ResourceTerminatingTimeout
+	// isn't set.
+	DefaultResourceTerminatingTimeout = 10 * time.Minute
+
+	// DefaultCRDEstablishedTimeout is how long to wait on restored custom
+	// resource definitions to be established before restoring their custom
+	// resources, if RestorerConfig.CRDEstablishedTimeout isn't set.
+	DefaultCRDEstablishedTimeout = time.Minute
+
+	// DefaultPVCBindingTimeout is how long to wait on persistent volume claims
+	// that were restored unbound to be bound, if RestorerConfig.PVCBindingTimeout
+	// isn't set.
+	DefaultPVCBindingTimeout = 5 * time.Minute
+)
+
+// DefaultResourcePriorities is the default order that resources are restored in:
+//
+//   - Namespaces go first because all namespaced resources depend on them.
+//   - Storage Classes are needed to create PVs and PVCs correctly.
+//   - Volume Snapshot Classes, Contents and Volume Snapshots go before PVCs so
+//     that PVCs can be restored from CSI volume snapshots.
+//   - PVs go before PVCs because PVCs depend on them.
+//   - PVCs go before pods or controllers so they can be mounted as volumes.
+//   - Secrets and config maps go before pods or controllers so they can be mounted
+//     as volumes.
+//   - Service accounts go before pods or controllers so pods can use them.
+//   - Limit ranges go before pods or controllers so pods can use them.
+//   - Pods go before controllers so they can be explicitly restored and potentially
+//     have restic restores run before controllers adopt the pods.
+//   - Custom Resource Definitions come before Custom Resource so that they can be
+//     restored with their corresponding CRD.
+var DefaultResourcePriorities = []string{
+	"namespaces",
+	"storageclasses",
+	"volumesnapshotclasses.snapshot.storage.k8s.io",
+	"volumesnapshotcontents.snapshot.storage.k8s.io",
+	"volumesnapshots.snapshot.storage.k8s.io",
+	"persistentvolumes",
+	"persistentvolumeclaims",
+	"secrets",
+	"configmaps",
+	"serviceaccounts",
+	"limitranges",
+	"pods",
+	"replicaset",
+	"customresourcedefinitions",
+}
+
+// RestorerConfig contains the dependencies of a Restorer created by NewRestorer.
+// Only the Kubernetes clients are required, so that Velero's restore engine can be
+// embedded in other programs without running the Velero server.
+type RestorerConfig struct {
+	// DiscoveryClient is used to find the resources in the cluster that can
+	// be restored. Required.
+	DiscoveryClient kubediscovery.DiscoveryInterface
+
+	// DynamicClient is used to create the restored items. Required.
+	DynamicClient dynamic.Interface
+
+	// NamespaceClient is used to create the namespaces that items are restored
+	// into. Required.
+	NamespaceClient corev1.NamespaceInterface
+
+	// ResourcePriorities is the order that resources are restored in. Defaults
+	// to DefaultResourcePriorities.
+	ResourcePriorities []string
+
+	// ResticRestorerFactory creates restic restorers for pod volumes. If it's
+	// nil, pod volumes aren't restored with restic.
+	ResticRestorerFactory restic.RestorerFactory
+
+	// ResticTimeout is how long restic restores of pod volumes are allowed to
+	// run before timing out. Defaults to DefaultResticTimeout.
+	ResticTimeout time.Duration
+
+	// ResourceTerminatingTimeout is how long to wait on persistent volumes and
+	// namespaces to terminate. Defaults to DefaultResourceTerminatingTimeout.
+	ResourceTerminatingTimeout time.Duration
+
+	// CRDEstablishedTimeout is how long to wait on restored custom resource
+	// definitions to be established before restoring their custom resources.
+	// Defaults to DefaultCRDEstablishedTimeout.
+	CRDEstablishedTimeout time.Duration
+
+	// PVCBindingTimeout is how long to wait on persistent volume claims that
+	// were restored unbound to be bound, for restores that wait for them.
+	// Defaults to DefaultPVCBindingTimeout.
+	PVCBindingTimeout time.Duration
+
+	// Logger is used while discovering the cluster's resources and waiting for
+	// restic restores. Defaults to the logrus standard logger.
+	Logger logrus.FieldLogger
+}
+
+// NewRestorer creates a Restorer from the given config. Restore item actions
+// are passed to each call of the Restorer's Restore method, rather than here.
+func NewRestorer(config RestorerConfig) (Restorer, error) {
+	if config.DiscoveryClient == nil {
+		return nil, errors.New("a discovery client is required")
+	}
+	if config.DynamicClient == nil {
+		return nil, errors.New("a dynamic client is required")
+	}
+	if config.NamespaceClient == nil {
+		return nil, errors.New("a namespace client is required")
+	}
+
+	if config.ResourcePriorities == nil {
+		config.ResourcePriorities = DefaultResourcePriorities
+	}
+	if config.ResticTimeout == 0 {
+		config.ResticTimeout = DefaultResticTimeout
+	}
+	if config.ResourceTerminatingTimeout == 0 {
+		config.ResourceTerminatingTimeout = DefaultResourceTerminatingTimeout
+	}
+	if config.CRDEstablishedTimeout == 0 {
+		config.CRDEstablishedTimeout = DefaultCRDEstablishedTimeout
+	}
+	if config.PVCBindingTimeout == 0 {
+		config.PVCBindingTimeout = DefaultPVCBindingTimeout
+	}
+	if config.Logger == nil {
+		config.Logger = logrus.StandardLogger()
+	}
+
+	discoveryHelper, err := discovery.NewHelper(config.DiscoveryClient, config.Logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "error discovering the cluster's resources")
+	}
+
+	return NewKubernetesRestorer(
+		discoveryHelper,
+		client.NewDynamicFactory(config.DynamicClient),
+		config.ResourcePriorities,
+		config.NamespaceClient,
+		config.ResticRestorerFactory,
+		config.ResticTimeout,
+		config.ResourceTerminatingTimeout,
+		config.CRDEstablishedTimeout,
+		config.PVCBindingTimeout,
+		config.Logger,
+	)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewRestorer(t *testing.T) {
+	apiServer := test.NewAPIServer(t)
+	namespaceClient := apiServer.KubeClient.CoreV1().Namespaces()
+
+	tests := []struct {
+		name                           string
+		config                         RestorerConfig
+		wantErr                        string
+		wantResourcePriorities         []string
+		wantResticTimeout              time.Duration
+		wantResourceTerminatingTimeout time.Duration
+	}{
+		{
+			name:    "missing discovery client returns an error",
+			config:  RestorerConfig{DynamicClient: apiServer.DynamicClient, NamespaceClient: namespaceClient},
+			wantErr: "a discovery client is required",
+		},
+		{
+			name:    "missing dynamic client returns an error",
+			config:  RestorerConfig{DiscoveryClient: apiServer.DiscoveryClient, NamespaceClient: namespaceClient},
+			wantErr: "a dynamic client is required",
+		},
+		{
+			name:    "missing namespace client returns an error",
+			config:  RestorerConfig{DiscoveryClient: apiServer.DiscoveryClient, DynamicClient: apiServer.DynamicClient},
+			wantErr: "a namespace client is required",
+		},
+		{
+			name: "unset optional fields get defaults",
+			config: RestorerConfig{
+				DiscoveryClient: apiServer.DiscoveryClient,
+				DynamicClient:   apiServer.DynamicClient,
+				NamespaceClient: namespaceClient,
+			},
+			wantResourcePriorities:         DefaultResourcePriorities,
+			wantResticTimeout:              DefaultResticTimeout,
+			wantResourceTerminatingTimeout: DefaultResourceTerminatingTimeout,
+		},
+		{
+			name: "set optional fields are used",
+			config: RestorerConfig{
+				DiscoveryClient:            apiServer.DiscoveryClient,
+				DynamicClient:              apiServer.DynamicClient,
+				NamespaceClient:            namespaceClient,
+				ResourcePriorities:         []string{"namespaces", "pods"},
+				ResticTimeout:              time.Minute,
+				ResourceTerminatingTimeout: time.Second,
+			},
+			wantResourcePriorities:         []string{"namespaces", "pods"},
+			wantResticTimeout:              time.Minute,
+			wantResourceTerminatingTimeout: time.Second,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restorer, err := NewRestorer(tc.config)
+			if tc.wantErr != "" {
+				assert.Nil(t, restorer)
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			kr := restorer.(*kubernetesRestorer)
+			assert.Equal(t, tc.wantResourcePriorities, kr.resourcePriorities)
+			assert.Equal(t, tc.wantResticTimeout, kr.resticTimeout)
+			assert.Equal(t, tc.wantResourceTerminatingTimeout, kr.resourceTerminatingTimeout)
+			assert.NotNil(t, kr.logger)
+		})
+	}
+}
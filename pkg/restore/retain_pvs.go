@@ -0,0 +1,110 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// retainPV sets the reclaim policy of a persistent volume that's about to be
+// restored to Retain, recording its original reclaim policy in an annotation.
+// It returns the original reclaim policy, or an empty string if the reclaim
+// policy was already Retain.
+func retainPV(obj *unstructured.Unstructured) (string, error) {
+	policy, _, err := unstructured.NestedString(obj.Object, "spec", "persistentVolumeReclaimPolicy")
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if policy == "" || policy == string(corev1api.PersistentVolumeReclaimRetain) {
+		return "", nil
+	}
+
+	if err := unstructured.SetNestedField(obj.Object, string(corev1api.PersistentVolumeReclaimRetain), "spec", "persistentVolumeReclaimPolicy"); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[velerov1api.OriginalReclaimPolicyAnnotation] = policy
+	obj.SetAnnotations(annotations)
+
+	return policy, nil
+}
+
+// revertRetainedPVs sets the reclaim policies of the persistent volumes that were
+// set to Retain while they were restored back to their original reclaim policies.
+// If the restore had errors, the persistent volumes are left with a reclaim policy
+// of Retain, and a warning is returned for each one.
+func (ctx *context) revertRetainedPVs(restoreFailed bool) (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	if len(ctx.retainedPVs) == 0 {
+		return warnings, errs
+	}
+
+	if restoreFailed {
+		for name := range ctx.retainedPVs {
+			addToResult(&warnings, "", errors.Errorf("persistent volume %s was left with a reclaim policy of Retain because the restore had errors; its original reclaim policy is in its %s annotation", name, velerov1api.OriginalReclaimPolicyAnnotation))
+		}
+		return warnings, errs
+	}
+
+	pvResource := metav1.APIResource{Name: "persistentvolumes", Namespaced: false}
+	pvClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Group: "", Version: "v1"}, pvResource, "")
+	if err != nil {
+		addVeleroError(&errs, errors.Wrap(err, "error getting persistent volume client"))
+		return warnings, errs
+	}
+
+	for name, policy := range ctx.retainedPVs {
+		patch := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					velerov1api.OriginalReclaimPolicyAnnotation: nil,
+				},
+			},
+			"spec": map[string]interface{}{
+				"persistentVolumeReclaimPolicy": policy,
+			},
+		}
+
+		patchBytes, err := json.Marshal(patch)
+		if err != nil {
+			addToResult(&errs, "", errors.WithStack(err))
+			continue
+		}
+
+		ctx.log.Infof("Setting reclaim policy of persistent volume %s back to %s", name, policy)
+		if _, err := pvClient.Patch(name, patchBytes); err != nil {
+			addToResult(&errs, "", fmt.Errorf("error setting reclaim policy of persistent volume %s back to %s: %v", name, policy, err))
+		}
+	}
+
+	return warnings, errs
+}
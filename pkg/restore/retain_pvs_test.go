@@ -0,0 +1,130 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRetainPV(t *testing.T) {
+	tests := []struct {
+		name            string
+		pv              *corev1api.PersistentVolume
+		wantPolicy      string
+		wantAnnotations map[string]string
+	}{
+		{
+			name:            "PV with a reclaim policy of Delete is set to Retain",
+			pv:              builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).Result(),
+			wantPolicy:      string(corev1api.PersistentVolumeReclaimDelete),
+			wantAnnotations: map[string]string{velerov1api.OriginalReclaimPolicyAnnotation: "Delete"},
+		},
+		{
+			name:       "PV with a reclaim policy of Retain isn't changed",
+			pv:         builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result(),
+			wantPolicy: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.pv)
+			require.NoError(t, err)
+			obj := &unstructured.Unstructured{Object: content}
+
+			policy, err := retainPV(obj)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPolicy, policy)
+
+			res, _, err := unstructured.NestedString(obj.Object, "spec", "persistentVolumeReclaimPolicy")
+			require.NoError(t, err)
+			assert.Equal(t, string(corev1api.PersistentVolumeReclaimRetain), res)
+			assert.Equal(t, tc.wantAnnotations, obj.GetAnnotations())
+		})
+	}
+}
+
+func TestRevertRetainedPVs(t *testing.T) {
+	tests := []struct {
+		name            string
+		restoreFailed   bool
+		wantPolicy      corev1api.PersistentVolumeReclaimPolicy
+		wantAnnotations map[string]string
+		wantWarnings    Result
+	}{
+		{
+			name:            "reclaim policy is set back if the restore succeeded",
+			restoreFailed:   false,
+			wantPolicy:      corev1api.PersistentVolumeReclaimDelete,
+			wantAnnotations: map[string]string{"foo": "bar"},
+		},
+		{
+			name:            "reclaim policy is left as Retain if the restore failed",
+			restoreFailed:   true,
+			wantPolicy:      corev1api.PersistentVolumeReclaimRetain,
+			wantAnnotations: map[string]string{"foo": "bar", velerov1api.OriginalReclaimPolicyAnnotation: "Delete"},
+			wantWarnings: Result{
+				Cluster: []string{"persistent volume pv-1 was left with a reclaim policy of Retain because the restore had errors; its original reclaim policy is in its velero.io/original-reclaim-policy annotation"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			apiServer := test.NewAPIServer(t)
+
+			pv := builder.ForPersistentVolume("pv-1").
+				ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).
+				ObjectMeta(builder.WithAnnotations("foo", "bar", velerov1api.OriginalReclaimPolicyAnnotation, "Delete")).
+				Result()
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+			require.NoError(t, err)
+			_, err = apiServer.DynamicClient.Resource(test.PVs().GVR()).Create(&unstructured.Unstructured{Object: content}, metav1.CreateOptions{})
+			require.NoError(t, err)
+
+			ctx := &context{
+				log:            test.NewLogger(),
+				dynamicFactory: client.NewDynamicFactory(apiServer.DynamicClient),
+				retainedPVs:    map[string]string{"pv-1": "Delete"},
+			}
+
+			warnings, errs := ctx.revertRetainedPVs(tc.restoreFailed)
+			assert.Equal(t, tc.wantWarnings, warnings)
+			assertEmptyResults(t, errs)
+
+			res, err := apiServer.DynamicClient.Resource(test.PVs().GVR()).Get("pv-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			restored := new(corev1api.PersistentVolume)
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, restored))
+			assert.Equal(t, tc.wantPolicy, restored.Spec.PersistentVolumeReclaimPolicy)
+			assert.Equal(t, tc.wantAnnotations, restored.Annotations)
+		})
+	}
+}
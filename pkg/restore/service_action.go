@@ -18,6 +18,8 @@ package restore
 
 import (
 	"encoding/json"
+	"regexp"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -26,11 +28,24 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const annotationLastAppliedConfig = "kubectl.kubernetes.io/last-applied-configuration"
 
+// loadBalancerProviderAnnotations are the keys of the annotations that cloud
+// providers, and the controllers that manage load balancers and their DNS
+// names, configure Services of type LoadBalancer with.
+var loadBalancerProviderAnnotations = []string{
+	"service.beta.kubernetes.io/*",
+	"service.kubernetes.io/*",
+	"cloud.google.com/*",
+	"networking.gke.io/*",
+	"external-dns.alpha.kubernetes.io/*",
+	"metallb.universe.tf/*",
+}
+
 type ServiceAction struct {
 	log logrus.FieldLogger
 }
@@ -59,6 +74,10 @@ func (a *ServiceAction) Execute(input *velero.RestoreItemActionExecuteInput) (*v
 		return nil, err
 	}
 
+	if input.Restore != nil && input.Restore.Spec.LoadBalancerServices != nil && service.Spec.Type == corev1api.ServiceTypeLoadBalancer {
+		filterLoadBalancerAnnotations(service, input.Restore.Spec.LoadBalancerServices, a.log)
+	}
+
 	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(service)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -98,3 +117,43 @@ func deleteNodePorts(service *corev1api.Service) error {
 
 	return nil
 }
+
+// filterLoadBalancerAnnotations removes the annotations of a Service of type
+// LoadBalancer that options don't keep: its provider annotations, and load
+// balancer IP, if the policy is Strip, unless they're allowed, and any denied
+// annotations.
+func filterLoadBalancerAnnotations(service *corev1api.Service, options *api.LoadBalancerServiceOptions, log logrus.FieldLogger) {
+	strip := options.AnnotationPolicy == api.LoadBalancerAnnotationPolicyStrip
+
+	for key := range service.Annotations {
+		switch {
+		case matchesAnyKey(key, options.DeniedAnnotations):
+		case strip && matchesAnyKey(key, loadBalancerProviderAnnotations) && !matchesAnyKey(key, options.AllowedAnnotations):
+		default:
+			continue
+		}
+
+		log.Infof("Removing annotation %s from service %s/%s", key, service.Namespace, service.Name)
+		delete(service.Annotations, key)
+	}
+
+	if strip && service.Spec.LoadBalancerIP != "" {
+		log.Infof("Removing load balancer IP %s from service %s/%s", service.Spec.LoadBalancerIP, service.Namespace, service.Name)
+		service.Spec.LoadBalancerIP = ""
+	}
+}
+
+// matchesAnyKey returns whether an annotation key matches any of patterns,
+// where each '*' in a pattern matches any sequence of characters.
+func matchesAnyKey(key string, patterns []string) bool {
+	for _, pattern := range patterns {
+		parts := strings.Split(pattern, "*")
+		for i := range parts {
+			parts[i] = regexp.QuoteMeta(parts[i])
+		}
+		if regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
@@ -27,6 +27,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -290,3 +292,117 @@ func TestServiceActionExecute(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceActionLoadBalancerAnnotations(t *testing.T) {
+	newService := func(serviceType corev1api.ServiceType) *corev1api.Service {
+		return &corev1api.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-1",
+				Name:      "svc-1",
+				Annotations: map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eipalloc-1",
+					"service.beta.kubernetes.io/aws-load-balancer-type":            "nlb",
+					"external-dns.alpha.kubernetes.io/hostname":                    "app.example.com",
+					"app.example.com/owner":                                        "team-1",
+				},
+			},
+			Spec: corev1api.ServiceSpec{
+				Type:           serviceType,
+				LoadBalancerIP: "1.2.3.4",
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		serviceType     corev1api.ServiceType
+		options         *api.LoadBalancerServiceOptions
+		wantAnnotations []string
+		wantIP          string
+	}{
+		{
+			name:        "no options keeps all annotations",
+			serviceType: corev1api.ServiceTypeLoadBalancer,
+			wantAnnotations: []string{
+				"app.example.com/owner",
+				"external-dns.alpha.kubernetes.io/hostname",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations",
+				"service.beta.kubernetes.io/aws-load-balancer-type",
+			},
+			wantIP: "1.2.3.4",
+		},
+		{
+			name:        "keep removes denied annotations",
+			serviceType: corev1api.ServiceTypeLoadBalancer,
+			options: &api.LoadBalancerServiceOptions{
+				AnnotationPolicy:  api.LoadBalancerAnnotationPolicyKeep,
+				DeniedAnnotations: []string{"*eip-allocations"},
+			},
+			wantAnnotations: []string{
+				"app.example.com/owner",
+				"external-dns.alpha.kubernetes.io/hostname",
+				"service.beta.kubernetes.io/aws-load-balancer-type",
+			},
+			wantIP: "1.2.3.4",
+		},
+		{
+			name:            "strip removes provider annotations and the load balancer IP",
+			serviceType:     corev1api.ServiceTypeLoadBalancer,
+			options:         &api.LoadBalancerServiceOptions{AnnotationPolicy: api.LoadBalancerAnnotationPolicyStrip},
+			wantAnnotations: []string{"app.example.com/owner"},
+		},
+		{
+			name:        "strip keeps allowed annotations unless they're denied",
+			serviceType: corev1api.ServiceTypeLoadBalancer,
+			options: &api.LoadBalancerServiceOptions{
+				AnnotationPolicy:   api.LoadBalancerAnnotationPolicyStrip,
+				AllowedAnnotations: []string{"service.beta.kubernetes.io/aws-load-balancer-*"},
+				DeniedAnnotations:  []string{"service.beta.kubernetes.io/aws-load-balancer-eip-allocations"},
+			},
+			wantAnnotations: []string{
+				"app.example.com/owner",
+				"service.beta.kubernetes.io/aws-load-balancer-type",
+			},
+		},
+		{
+			name:        "services that aren't load balancers are unchanged",
+			serviceType: corev1api.ServiceTypeClusterIP,
+			options:     &api.LoadBalancerServiceOptions{AnnotationPolicy: api.LoadBalancerAnnotationPolicyStrip},
+			wantAnnotations: []string{
+				"app.example.com/owner",
+				"external-dns.alpha.kubernetes.io/hostname",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations",
+				"service.beta.kubernetes.io/aws-load-balancer-type",
+			},
+			wantIP: "1.2.3.4",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			action := NewServiceAction(velerotest.NewLogger())
+
+			unstructuredSvc, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newService(tc.serviceType))
+			require.NoError(t, err)
+
+			restore := builder.ForRestore(api.DefaultNamespace, "restore-1").LoadBalancerServices(tc.options).Result()
+
+			res, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           &unstructured.Unstructured{Object: unstructuredSvc},
+				ItemFromBackup: &unstructured.Unstructured{Object: unstructuredSvc},
+				Restore:        restore,
+			})
+			require.NoError(t, err)
+
+			var svc corev1api.Service
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.UpdatedItem.UnstructuredContent(), &svc))
+
+			var annotations []string
+			for key := range svc.Annotations {
+				annotations = append(annotations, key)
+			}
+			assert.ElementsMatch(t, tc.wantAnnotations, annotations)
+			assert.Equal(t, tc.wantIP, svc.Spec.LoadBalancerIP)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// unboundPVC is a persistent volume claim that was restored unbound.
+type unboundPVC struct {
+	namespace    string
+	name         string
+	storageClass string
+}
+
+// unboundPVCPhase returns the phase of a backed up persistent volume claim if
+// it wasn't bound when it was backed up, or "" if it was. The phase is recorded
+// in an annotation by the backup's PVC action, or, for backups taken before it
+// was recorded, in the claim's status.
+func unboundPVCPhase(obj *unstructured.Unstructured) string {
+	if phase := obj.GetAnnotations()[velerov1api.PVCPhaseAnnotation]; phase != "" {
+		return phase
+	}
+
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase == string(corev1api.ClaimPending) || phase == string(corev1api.ClaimLost) {
+		return phase
+	}
+
+	return ""
+}
+
+// resetUnboundPVC clears the binding state of a persistent volume claim that
+// wasn't bound when it was backed up, so that it's bound to a matching or
+// dynamically provisioned volume in the cluster it's restored into. A lost
+// claim's volume no longer exists, so the claim's reference to it is removed
+// too.
+func resetUnboundPVC(obj *unstructured.Unstructured, phase string) {
+	if phase == string(corev1api.ClaimLost) {
+		unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+	}
+
+	annotations := obj.GetAnnotations()
+	delete(annotations, "pv.kubernetes.io/bind-completed")
+	delete(annotations, "pv.kubernetes.io/bound-by-controller")
+	// the node that the scheduler picked for the claim's volume in the
+	// backed up cluster, which may not exist in this one.
+	delete(annotations, "volume.kubernetes.io/selected-node")
+	delete(annotations, velerov1api.PVCPhaseAnnotation)
+	obj.SetAnnotations(annotations)
+}
+
+// waitForUnboundPVCs waits for the persistent volume claims that have been restored
+// unbound so far to be bound, so that the pods that use them can start. Claims whose
+// storage class waits for a pod to use them before binding aren't waited for. A
+// warning is returned for each one that isn't bound within the restorer's PVC
+// binding timeout.
+func (ctx *context) waitForUnboundPVCs() Result {
+	warnings := Result{}
+
+	restored := ctx.unboundPVCs
+	ctx.unboundPVCs = nil
+
+	var pending []unboundPVC
+	for _, pvc := range restored {
+		if ctx.waitsForFirstConsumer(pvc.storageClass) {
+			ctx.log.Infof("Not waiting for PersistentVolumeClaim %s/%s to be bound because its storage class waits for a pod to use it", pvc.namespace, pvc.name)
+			continue
+		}
+		pending = append(pending, pvc)
+	}
+
+	if len(pending) == 0 {
+		return warnings
+	}
+
+	ctx.log.Infof("Waiting for %d persistent volume claims that were restored unbound to be bound", len(pending))
+
+	err := wait.PollImmediate(time.Second, ctx.pvcBindingTimeout, func() (bool, error) {
+		var remaining []unboundPVC
+		for _, pvc := range pending {
+			if !ctx.pvcIsBound(pvc) {
+				remaining = append(remaining, pvc)
+			}
+		}
+		pending = remaining
+
+		return len(pending) == 0, nil
+	})
+
+	if err == wait.ErrWaitTimeout {
+		for _, pvc := range pending {
+			addToResult(&warnings, pvc.namespace, errors.Errorf("persistent volume claim %s wasn't bound within %s, so the pods that use it may not start until it is", pvc.name, ctx.pvcBindingTimeout))
+		}
+	} else if err != nil {
+		addToResult(&warnings, "", err)
+	}
+
+	return warnings
+}
+
+// waitsForFirstConsumer returns whether a storage class delays binding and
+// provisioning its claims' volumes until a pod uses them.
+func (ctx *context) waitsForFirstConsumer(storageClass string) bool {
+	if storageClass == "" {
+		return false
+	}
+
+	client, err := ctx.dynamicFactory.ClientForGroupVersionResource(storagev1api.SchemeGroupVersion, metav1.APIResource{Name: "storageclasses"}, "")
+	if err != nil {
+		ctx.log.WithError(err).Debug("Error getting storage class client")
+		return false
+	}
+
+	obj, err := client.Get(storageClass, metav1.GetOptions{})
+	if err != nil {
+		ctx.log.WithError(err).Debugf("Error getting storage class %s", storageClass)
+		return false
+	}
+
+	mode, _, _ := unstructured.NestedString(obj.Object, "volumeBindingMode")
+	return mode == string(storagev1api.VolumeBindingWaitForFirstConsumer)
+}
+
+// pvcIsBound returns whether a restored persistent volume claim is bound.
+func (ctx *context) pvcIsBound(pvc unboundPVC) bool {
+	client, err := ctx.dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: "persistentvolumeclaims", Namespaced: true}, pvc.namespace)
+	if err != nil {
+		ctx.log.WithError(err).Debug("Error getting persistent volume claim client, retrying")
+		return false
+	}
+
+	obj, err := client.Get(pvc.name, metav1.GetOptions{})
+	if err != nil {
+		ctx.log.WithError(err).Debugf("Error getting persistent volume claim %s/%s, retrying", pvc.namespace, pvc.name)
+		return false
+	}
+
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	return phase == string(corev1api.ClaimBound)
+}
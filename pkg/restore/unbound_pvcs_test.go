@@ -0,0 +1,129 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newBackedUpPVC(phase string, annotations map[string]string) *unstructured.Unstructured {
+	pvc := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "PersistentVolumeClaim",
+			"spec":       map[string]interface{}{"volumeName": "pv-1"},
+		},
+	}
+	pvc.SetNamespace("ns-1")
+	pvc.SetName("pvc-1")
+	pvc.SetAnnotations(annotations)
+	if phase != "" {
+		pvc.Object["status"] = map[string]interface{}{"phase": phase}
+	}
+
+	return pvc
+}
+
+func TestUnboundPVCPhase(t *testing.T) {
+	assert.Equal(t, "", unboundPVCPhase(newBackedUpPVC("Bound", nil)))
+	assert.Equal(t, "", unboundPVCPhase(newBackedUpPVC("", nil)))
+	assert.Equal(t, "Pending", unboundPVCPhase(newBackedUpPVC("Pending", nil)))
+	assert.Equal(t, "Lost", unboundPVCPhase(newBackedUpPVC("", map[string]string{velerov1api.PVCPhaseAnnotation: "Lost"})))
+}
+
+func TestResetUnboundPVC(t *testing.T) {
+	annotations := map[string]string{
+		"pv.kubernetes.io/bind-completed":      "yes",
+		"pv.kubernetes.io/bound-by-controller": "yes",
+		"volume.kubernetes.io/selected-node":   "node-1",
+		velerov1api.PVCPhaseAnnotation:         "Pending",
+		"foo":                                  "bar",
+	}
+
+	pending := newBackedUpPVC("", annotations)
+	resetUnboundPVC(pending, "Pending")
+	assert.Equal(t, map[string]string{"foo": "bar"}, pending.GetAnnotations())
+	volumeName, _, _ := unstructured.NestedString(pending.Object, "spec", "volumeName")
+	assert.Equal(t, "pv-1", volumeName, "a pending claim's volume name is kept, since it may be pre-bound")
+
+	lost := newBackedUpPVC("", map[string]string{velerov1api.PVCPhaseAnnotation: "Lost"})
+	resetUnboundPVC(lost, "Lost")
+	_, found, _ := unstructured.NestedString(lost.Object, "spec", "volumeName")
+	assert.False(t, found, "a lost claim's volume name is removed")
+}
+
+func TestWaitForUnboundPVCs(t *testing.T) {
+	apiServer := test.NewAPIServer(t)
+	dynamicFactory := client.NewDynamicFactory(apiServer.DynamicClient)
+
+	scClient, err := dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Group: "storage.k8s.io", Version: "v1"}, metav1.APIResource{Name: "storageclasses"}, "")
+	require.NoError(t, err)
+	for name, mode := range map[string]string{"immediate": "Immediate", "wait": "WaitForFirstConsumer"} {
+		sc := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion":        "storage.k8s.io/v1",
+			"kind":              "StorageClass",
+			"volumeBindingMode": mode,
+		}}
+		sc.SetName(name)
+		_, err := scClient.Create(sc)
+		require.NoError(t, err)
+	}
+
+	pvcClient, err := dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: "persistentvolumeclaims", Namespaced: true}, "ns-1")
+	require.NoError(t, err)
+	for name, phase := range map[string]string{"bound": "Bound", "pending": "Pending", "waits-for-pod": "Pending"} {
+		pvc := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "PersistentVolumeClaim",
+			"status":     map[string]interface{}{"phase": phase},
+		}}
+		pvc.SetNamespace("ns-1")
+		pvc.SetName(name)
+		_, err := pvcClient.Create(pvc)
+		require.NoError(t, err)
+	}
+
+	ctx := &context{
+		log:               test.NewLogger(),
+		dynamicFactory:    dynamicFactory,
+		pvcBindingTimeout: 10 * time.Millisecond,
+		unboundPVCs: []unboundPVC{
+			{namespace: "ns-1", name: "bound", storageClass: "immediate"},
+			{namespace: "ns-1", name: "pending", storageClass: "immediate"},
+			{namespace: "ns-1", name: "waits-for-pod", storageClass: "wait"},
+		},
+	}
+
+	warnings := ctx.waitForUnboundPVCs()
+	assert.Equal(t, Result{
+		Namespaces: map[string][]string{
+			"ns-1": {"persistent volume claim pending wasn't bound within 10ms, so the pods that use it may not start until it is"},
+		},
+	}, warnings)
+	assert.Empty(t, ctx.unboundPVCs)
+}
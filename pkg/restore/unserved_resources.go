@@ -0,0 +1,90 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+)
+
+// resolveUnservedResources returns the resources in the backup, with items to
+// restore, that the cluster's discovery doesn't list and that the API
+// resources of the cluster the backup was taken from resolve, so that they can
+// be restored as the versions that they were backed up as. Warnings are
+// returned for the ones that can't be resolved, which aren't restored.
+func (ctx *context) resolveUnservedResources(backupResources map[string]*archive.ResourceItems) ([]schema.GroupResource, Result) {
+	var (
+		resolved []schema.GroupResource
+		warnings Result
+	)
+
+	served := sets.NewString()
+	for _, resource := range ctx.prioritizedResources {
+		served.Insert(resource.String())
+	}
+
+	includeClusterResources := ctx.restore.Spec.IncludeClusterResources == nil || *ctx.restore.Spec.IncludeClusterResources
+
+	keys := make([]string, 0, len(backupResources))
+	for key := range backupResources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	ctx.unservedResources = sets.NewString()
+
+	for _, key := range keys {
+		if served.Has(key) || !ctx.resourceIncludesExcludes.ShouldInclude(key) {
+			continue
+		}
+
+		var count int
+		for namespace, items := range backupResources[key].ItemsByNamespace {
+			if namespace == "" && !includeClusterResources {
+				continue
+			}
+			if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+				continue
+			}
+			count += len(items)
+		}
+		if count == 0 {
+			continue
+		}
+
+		groupResource := schema.ParseGroupResource(key)
+
+		if ctx.apiResources != nil {
+			if gvr, _, ok := ctx.apiResources.ResourceFor(groupResource); ok {
+				ctx.log.Infof("Restoring %s as %s, which it was backed up as, because the cluster's discovery doesn't list it", key, gvr.GroupVersion())
+				ctx.unservedResources.Insert(key)
+				resolved = append(resolved, groupResource)
+				continue
+			}
+		}
+
+		ctx.log.Warnf("Not restoring %d items of %s because the cluster doesn't serve it", count, key)
+		addToResult(&warnings, "", errors.Errorf("%d items of %s weren't restored because the cluster doesn't serve it", count, key))
+	}
+
+	return resolved, warnings
+}
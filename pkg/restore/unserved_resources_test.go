@@ -0,0 +1,151 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+func TestResolveUnservedResources(t *testing.T) {
+	backupResources := map[string]*archive.ResourceItems{
+		"pods": {
+			GroupResource:    "pods",
+			ItemsByNamespace: map[string][]string{"ns-1": {"pod-1"}},
+		},
+		"widgets.example.com": {
+			GroupResource:    "widgets.example.com",
+			ItemsByNamespace: map[string][]string{"ns-1": {"widget-1", "widget-2"}, "ns-2": {"widget-3"}},
+		},
+		"gadgets.example.com": {
+			GroupResource:    "gadgets.example.com",
+			ItemsByNamespace: map[string][]string{"": {"gadget-1"}},
+		},
+	}
+	apiResources := &archive.APIResources{
+		Resources: []metav1.APIResourceList{
+			{
+				GroupVersion: "example.com/v1beta1",
+				APIResources: []metav1.APIResource{{Name: "widgets", Namespaced: true, Kind: "Widget"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		restore      *builder.RestoreBuilder
+		namespaces   *collections.IncludesExcludes
+		apiResources *archive.APIResources
+		want         []schema.GroupResource
+		wantWarnings []string
+	}{
+		{
+			name:         "unserved resources are resolved from the backup's API resources, and the rest are warned about",
+			restore:      builder.ForRestore("velero", "restore-1"),
+			namespaces:   collections.NewIncludesExcludes(),
+			apiResources: apiResources,
+			want:         []schema.GroupResource{{Group: "example.com", Resource: "widgets"}},
+			wantWarnings: []string{
+				"1 items of gadgets.example.com weren't restored because the cluster doesn't serve it",
+			},
+		},
+		{
+			name:       "unserved resources of backups without API resources are warned about",
+			restore:    builder.ForRestore("velero", "restore-1"),
+			namespaces: collections.NewIncludesExcludes(),
+			wantWarnings: []string{
+				"1 items of gadgets.example.com weren't restored because the cluster doesn't serve it",
+				"3 items of widgets.example.com weren't restored because the cluster doesn't serve it",
+			},
+		},
+		{
+			name:         "resources without items in included namespaces, or only cluster items when cluster resources aren't included, are skipped",
+			restore:      builder.ForRestore("velero", "restore-1").IncludeClusterResources(false),
+			namespaces:   collections.NewIncludesExcludes().Includes("ns-3"),
+			apiResources: apiResources,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &context{
+				restore:                   tc.restore.Result(),
+				log:                       test.NewLogger(),
+				prioritizedResources:      []schema.GroupResource{kuberesource.Pods},
+				resourceIncludesExcludes:  collections.NewIncludesExcludes(),
+				namespaceIncludesExcludes: tc.namespaces,
+				apiResources:              tc.apiResources,
+			}
+
+			resolved, warnings := ctx.resolveUnservedResources(backupResources)
+			assert.Equal(t, tc.want, resolved)
+			assert.Equal(t, tc.wantWarnings, warnings.Cluster)
+			assert.Empty(t, warnings.Namespaces)
+		})
+	}
+}
+
+func TestGetResourceClientForUnservedResource(t *testing.T) {
+	dynamicFactory := &test.FakeDynamicFactory{}
+	defer dynamicFactory.AssertExpectations(t)
+
+	ctx := &context{
+		log:             test.NewLogger(),
+		dynamicFactory:  dynamicFactory,
+		resourceClients: make(map[resourceClientKey]client.Dynamic),
+		apiResources: &archive.APIResources{
+			Resources: []metav1.APIResourceList{
+				{
+					GroupVersion: "example.com/v1beta1",
+					APIResources: []metav1.APIResource{{Name: "widgets", Namespaced: true, Kind: "Widget"}},
+				},
+			},
+		},
+		unservedResources: sets.NewString("widgets.example.com"),
+	}
+
+	// the item's version isn't the one that the resource was backed up as, so
+	// the client is for the backed up version.
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("example.com/v1")
+	obj.SetKind("Widget")
+
+	resourceClient := &test.FakeDynamicClient{}
+	dynamicFactory.On(
+		"ClientForGroupVersionResource",
+		schema.GroupVersion{Group: "example.com", Version: "v1beta1"},
+		metav1.APIResource{Name: "widgets", Namespaced: true},
+		"ns-1",
+	).Return(resourceClient, nil)
+
+	res, err := ctx.getResourceClient(schema.GroupResource{Group: "example.com", Resource: "widgets"}, obj, "ns-1")
+	require.NoError(t, err)
+	assert.Equal(t, resourceClient, res)
+}
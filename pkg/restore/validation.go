@@ -0,0 +1,71 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// ValidateSpec validates the parts of a restore spec that don't depend on
+// the state of the cluster, returning a message for each problem found.
+func ValidateSpec(spec velerov1api.RestoreSpec) []string {
+	var errs []string
+
+	for _, err := range collections.ValidateIncludesExcludes(spec.IncludedResources, spec.ExcludedResources) {
+		errs = append(errs, fmt.Sprintf("Invalid included/excluded resource lists: %v", err))
+	}
+
+	for _, err := range collections.ValidateIncludesExcludes(spec.IncludedNamespaces, spec.ExcludedNamespaces) {
+		errs = append(errs, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
+	}
+
+	if err := ValidateNamespaceMapping(spec.NamespaceMapping); err != nil {
+		errs = append(errs, fmt.Sprintf("Invalid namespace mapping: %v", err))
+	}
+
+	if spec.DataOnly && spec.NoApply {
+		errs = append(errs, "A data-only restore can't have spec.noApply set")
+	}
+
+	if spec.DataOnly && spec.SkipOwnerManaged {
+		errs = append(errs, "A data-only restore can't have spec.skipOwnerManaged set")
+	}
+
+	if spec.DataOnly && spec.RetryOf != "" {
+		errs = append(errs, "A data-only restore can't have spec.retryOf set")
+	}
+
+	if labelErrs := validation.IsValidLabelValue(spec.IdempotencyToken); len(labelErrs) > 0 {
+		errs = append(errs, fmt.Sprintf("Invalid idempotency token %q: %s", spec.IdempotencyToken, strings.Join(labelErrs, "; ")))
+	}
+
+	if (spec.BackupName == "") == (spec.ScheduleName == "") {
+		errs = append(errs, "Either a backup or schedule must be specified as a source for the restore, but not both")
+	}
+
+	if spec.PointInTime != nil && spec.ScheduleName == "" {
+		errs = append(errs, "A point in time can only be specified for a restore from a schedule")
+	}
+
+	return errs
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateSpec(t *testing.T) {
+	tests := []struct {
+		name string
+		spec velerov1api.RestoreSpec
+		want []string
+	}{
+		{
+			name: "spec with a backup is valid",
+			spec: velerov1api.RestoreSpec{BackupName: "backup-1"},
+			want: nil,
+		},
+		{
+			name: "namespace in both includes and excludes is invalid",
+			spec: velerov1api.RestoreSpec{
+				BackupName:         "backup-1",
+				IncludedNamespaces: []string{"ns-1"},
+				ExcludedNamespaces: []string{"ns-1"},
+			},
+			want: []string{"Invalid included/excluded namespace lists: excludes list cannot contain an item in the includes list: ns-1"},
+		},
+		{
+			name: "data-only restore with noApply, skipOwnerManaged and retryOf is invalid",
+			spec: velerov1api.RestoreSpec{
+				BackupName:       "backup-1",
+				DataOnly:         true,
+				NoApply:          true,
+				SkipOwnerManaged: true,
+				RetryOf:          "restore-1",
+			},
+			want: []string{
+				"A data-only restore can't have spec.noApply set",
+				"A data-only restore can't have spec.skipOwnerManaged set",
+				"A data-only restore can't have spec.retryOf set",
+			},
+		},
+		{
+			name: "spec without a backup or schedule is invalid",
+			spec: velerov1api.RestoreSpec{},
+			want: []string{"Either a backup or schedule must be specified as a source for the restore, but not both"},
+		},
+		{
+			name: "spec with a backup and a schedule is invalid",
+			spec: velerov1api.RestoreSpec{BackupName: "backup-1", ScheduleName: "daily"},
+			want: []string{"Either a backup or schedule must be specified as a source for the restore, but not both"},
+		},
+		{
+			name: "spec with a schedule and a point in time is valid",
+			spec: velerov1api.RestoreSpec{ScheduleName: "daily", PointInTime: &metav1.Time{Time: time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)}},
+			want: nil,
+		},
+		{
+			name: "spec with a backup and a point in time is invalid",
+			spec: velerov1api.RestoreSpec{BackupName: "backup-1", PointInTime: &metav1.Time{Time: time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)}},
+			want: []string{"A point in time can only be specified for a restore from a schedule"},
+		},
+		{
+			name: "idempotency token that isn't a valid label value is invalid",
+			spec: velerov1api.RestoreSpec{BackupName: "backup-1", IdempotencyToken: "retry/1"},
+			want: []string{`Invalid idempotency token "retry/1": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ValidateSpec(tc.spec))
+		})
+	}
+}
@@ -17,10 +17,8 @@ limitations under the License.
 package serverstatusrequest
 
 import (
-	"encoding/json"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 const ttl = time.Minute
@@ -71,29 +70,14 @@ func Process(req *velerov1api.ServerStatusRequest, client velerov1client.ServerS
 }
 
 func patch(client velerov1client.ServerStatusRequestsGetter, req *velerov1api.ServerStatusRequest, updateFunc func(*velerov1api.ServerStatusRequest)) error {
-	originalJSON, err := json.Marshal(req)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-
+	original := req.DeepCopy()
 	updateFunc(req)
 
-	updatedJSON, err := json.Marshal(req)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-
-	patchBytes, err := jsonpatch.CreateMergePatch(originalJSON, updatedJSON)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-
-	_, err = client.ServerStatusRequests(req.Namespace).Patch(req.Name, types.MergePatchType, patchBytes)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-
-	return nil
+	err := kube.Patch(original, req, kube.PatchOptions{}, func(patchType types.PatchType, data []byte) error {
+		_, err := client.ServerStatusRequests(original.Namespace).Patch(original.Name, patchType, data)
+		return err
+	})
+	return errors.WithStack(err)
 }
 
 func plugins(pluginLister PluginLister) []velerov1api.PluginInfo {
@@ -0,0 +1,50 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"sort"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// IsDefaultBackupLocation returns whether a backup storage location is
+// annotated as the default location.
+func IsDefaultBackupLocation(location *velerov1api.BackupStorageLocation) bool {
+	return location.Annotations[velerov1api.DefaultBackupLocationAnnotation] == "true"
+}
+
+// DefaultBackupLocationName returns the name of the backup storage location
+// that backups without a storage location are stored in, which is the one of
+// locations that's annotated as the default, or serverDefault, the server's
+// --default-backup-storage-location, if none of them are. If several are
+// annotated, the first by name is used.
+func DefaultBackupLocationName(locations []*velerov1api.BackupStorageLocation, serverDefault string) string {
+	var names []string
+	for _, location := range locations {
+		if IsDefaultBackupLocation(location) {
+			names = append(names, location.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return serverDefault
+	}
+
+	sort.Strings(names)
+	return names[0]
+}
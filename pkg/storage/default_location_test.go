@@ -0,0 +1,68 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestDefaultBackupLocationName(t *testing.T) {
+	newLocation := func(name string, isDefault string) *velerov1api.BackupStorageLocation {
+		location := builder.ForBackupStorageLocation("velero", name)
+		if isDefault != "" {
+			location.ObjectMeta(builder.WithAnnotations(velerov1api.DefaultBackupLocationAnnotation, isDefault))
+		}
+		return location.Result()
+	}
+
+	tests := []struct {
+		name      string
+		locations []*velerov1api.BackupStorageLocation
+		want      string
+	}{
+		{
+			name: "no locations uses the server's default",
+			want: "default",
+		},
+		{
+			name:      "no annotated locations uses the server's default",
+			locations: []*velerov1api.BackupStorageLocation{newLocation("loc-1", ""), newLocation("loc-2", "false")},
+			want:      "default",
+		},
+		{
+			name:      "annotated location takes precedence over the server's default",
+			locations: []*velerov1api.BackupStorageLocation{newLocation("default", ""), newLocation("loc-2", "true")},
+			want:      "loc-2",
+		},
+		{
+			name:      "first of several annotated locations by name",
+			locations: []*velerov1api.BackupStorageLocation{newLocation("loc-3", "true"), newLocation("loc-2", "true")},
+			want:      "loc-2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, DefaultBackupLocationName(tc.locations, "default"))
+		})
+	}
+}
@@ -69,6 +69,10 @@ func (fs *FakeFileSystem) Stat(path string) (os.FileInfo, error) {
 	return fs.fs.Stat(path)
 }
 
+func (fs *FakeFileSystem) Rename(oldpath, newpath string) error {
+	return fs.fs.Rename(oldpath, newpath)
+}
+
 func (fs *FakeFileSystem) WithFile(path string, data []byte) *FakeFileSystem {
 	file, _ := fs.fs.Create(path)
 	file.Write(data)
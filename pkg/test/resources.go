@@ -129,3 +129,55 @@ func ServiceAccounts(items ...metav1.Object) *APIResource {
 		Items:      items,
 	}
 }
+
+func ConfigMaps(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "",
+		Version:    "v1",
+		Name:       "configmaps",
+		ShortName:  "cm",
+		Namespaced: true,
+		Items:      items,
+	}
+}
+
+func CRDs(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "apiextensions.k8s.io",
+		Version:    "v1beta1",
+		Name:       "customresourcedefinitions",
+		ShortName:  "crd",
+		Namespaced: false,
+		Items:      items,
+	}
+}
+
+func VolumeSnapshotClasses(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "snapshot.storage.k8s.io",
+		Version:    "v1beta1",
+		Name:       "volumesnapshotclasses",
+		Namespaced: false,
+		Items:      items,
+	}
+}
+
+func VolumeSnapshotContents(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "snapshot.storage.k8s.io",
+		Version:    "v1beta1",
+		Name:       "volumesnapshotcontents",
+		Namespaced: false,
+		Items:      items,
+	}
+}
+
+func VolumeSnapshots(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "snapshot.storage.k8s.io",
+		Version:    "v1beta1",
+		Name:       "volumesnapshots",
+		Namespaced: true,
+		Items:      items,
+	}
+}
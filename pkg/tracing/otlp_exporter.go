@@ -0,0 +1,274 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// maxBatchSize is the number of spans at which a batch is exported without
+// waiting for the flush interval.
+const maxBatchSize = 512
+
+// OTLPExporter is a trace exporter that sends spans, in batches, to the
+// OTLP/HTTP endpoint of an OpenTelemetry collector, using OTLP's JSON encoding.
+type OTLPExporter struct {
+	url         string
+	serviceName string
+	client      *http.Client
+	log         logrus.FieldLogger
+
+	lock  sync.Mutex
+	spans []*trace.SpanData
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewOTLPExporter creates an OTLPExporter that exports the spans it's been
+// given every flushInterval, until it's stopped.
+func NewOTLPExporter(endpoint, serviceName string, flushInterval time.Duration, logger logrus.FieldLogger) *OTLPExporter {
+	e := &OTLPExporter{
+		url:         strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		serviceName: serviceName,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		log:         logger.WithField("exporter", "otlp"),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+
+	go func() {
+		defer close(e.done)
+
+		ticker := time.NewTicker(flushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				e.Flush()
+			case <-e.stop:
+				e.Flush()
+				return
+			}
+		}
+	}()
+
+	return e
+}
+
+// ExportSpan adds a span to the batch to be exported.
+func (e *OTLPExporter) ExportSpan(span *trace.SpanData) {
+	e.lock.Lock()
+	e.spans = append(e.spans, span)
+	full := len(e.spans) >= maxBatchSize
+	e.lock.Unlock()
+
+	if full {
+		go e.Flush()
+	}
+}
+
+// Flush exports the spans that haven't been exported yet. Spans that can't be
+// exported are dropped.
+func (e *OTLPExporter) Flush() {
+	e.lock.Lock()
+	spans := e.spans
+	e.spans = nil
+	e.lock.Unlock()
+
+	if len(spans) == 0 {
+		return
+	}
+
+	if err := e.export(spans); err != nil {
+		e.log.WithError(err).Warnf("Error exporting %d trace spans", len(spans))
+	}
+}
+
+// Stop exports the spans that haven't been exported yet, and stops exporting
+// spans periodically.
+func (e *OTLPExporter) Stop() {
+	close(e.stop)
+	<-e.done
+}
+
+func (e *OTLPExporter) export(spans []*trace.SpanData) error {
+	body, err := json.Marshal(e.request(spans))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	res, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errors.Errorf("unexpected response status from %s: %s", e.url, res.Status)
+	}
+
+	return nil
+}
+
+// The types below are the parts of OTLP's ExportTraceServiceRequest message
+// that are exported, in its JSON encoding.
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+
+	otlpStatusCodeError = 2
+)
+
+func (e *OTLPExporter) request(spans []*trace.SpanData) *otlpRequest {
+	scopeSpans := otlpScopeSpans{Scope: otlpScope{Name: "github.com/vmware-tanzu/velero"}}
+
+	for _, span := range spans {
+		res := otlpSpan{
+			TraceID:           hex.EncodeToString(span.TraceID[:]),
+			SpanID:            hex.EncodeToString(span.SpanID[:]),
+			Name:              span.Name,
+			Kind:              otlpSpanKind(span.SpanKind),
+			StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+			Attributes:        otlpAttributes(span.Attributes),
+		}
+		if span.ParentSpanID != (trace.SpanID{}) {
+			res.ParentSpanID = hex.EncodeToString(span.ParentSpanID[:])
+		}
+		if span.Code != trace.StatusCodeOK {
+			res.Status = otlpStatus{Code: otlpStatusCodeError, Message: span.Message}
+		}
+
+		scopeSpans.Spans = append(scopeSpans.Spans, res)
+	}
+
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: otlpAttributes(map[string]interface{}{"service.name": e.serviceName}),
+				},
+				ScopeSpans: []otlpScopeSpans{scopeSpans},
+			},
+		},
+	}
+}
+
+func otlpSpanKind(kind int) int {
+	switch kind {
+	case trace.SpanKindServer:
+		return otlpSpanKindServer
+	case trace.SpanKindClient:
+		return otlpSpanKindClient
+	default:
+		return otlpSpanKindInternal
+	}
+}
+
+func otlpAttributes(attributes map[string]interface{}) []otlpAttribute {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var res []otlpAttribute
+	for _, key := range keys {
+		var value otlpValue
+		switch v := attributes[key].(type) {
+		case string:
+			value.StringValue = &v
+		case bool:
+			value.BoolValue = &v
+		case int64:
+			s := strconv.FormatInt(v, 10)
+			value.IntValue = &s
+		default:
+			s := fmt.Sprintf("%v", v)
+			value.StringValue = &s
+		}
+		res = append(res, otlpAttribute{Key: key, Value: value})
+	}
+	return res
+}
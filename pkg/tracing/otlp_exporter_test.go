@@ -0,0 +1,113 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestOTLPExporter(t *testing.T) {
+	var (
+		requests []map[string]interface{}
+		paths    []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		body := make(map[string]interface{})
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, body)
+	}))
+	defer server.Close()
+
+	// a long flush interval so that spans are only exported when the
+	// exporter is stopped.
+	exporter := NewOTLPExporter(server.URL+"/", "velero", time.Hour, velerotest.NewLogger())
+
+	start := time.Unix(1, 0)
+	exporter.ExportSpan(&trace.SpanData{
+		SpanContext: trace.SpanContext{
+			TraceID: trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		ParentSpanID: trace.SpanID{8, 7, 6, 5, 4, 3, 2, 1},
+		Name:         "BackupItemAction.Execute",
+		StartTime:    start,
+		EndTime:      start.Add(time.Second),
+		Attributes:   map[string]interface{}{"resource": "pods", "count": int64(3)},
+		Status:       trace.Status{Code: trace.StatusCodeUnknown, Message: "oops"},
+	})
+	exporter.Stop()
+
+	assert.Equal(t, []string{"/v1/traces"}, paths)
+
+	want := map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": []interface{}{
+						map[string]interface{}{"key": "service.name", "value": map[string]interface{}{"stringValue": "velero"}},
+					},
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": "github.com/vmware-tanzu/velero"},
+						"spans": []interface{}{
+							map[string]interface{}{
+								"traceId":           "0102030405060708090a0b0c0d0e0f10",
+								"spanId":            "0102030405060708",
+								"parentSpanId":      "0807060504030201",
+								"name":              "BackupItemAction.Execute",
+								"kind":              float64(1),
+								"startTimeUnixNano": "1000000000",
+								"endTimeUnixNano":   "2000000000",
+								"attributes": []interface{}{
+									map[string]interface{}{"key": "count", "value": map[string]interface{}{"intValue": "3"}},
+									map[string]interface{}{"key": "resource", "value": map[string]interface{}{"stringValue": "pods"}},
+								},
+								"status": map[string]interface{}{"code": float64(2), "message": "oops"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.Len(t, requests, 1)
+	assert.Equal(t, want, requests[0])
+}
+
+func TestOTLPExporterFlushWithoutSpans(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPExporter(server.URL, "velero", time.Hour, velerotest.NewLogger())
+	exporter.Flush()
+	exporter.Stop()
+}
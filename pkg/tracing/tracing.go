@@ -0,0 +1,67 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing records trace spans for the work the Velero server does, and
+// exports them to an OpenTelemetry collector using OTLP.
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// Init registers an exporter that sends all trace spans to the OTLP/HTTP
+// endpoint of an OpenTelemetry collector, e.g. http://otel-collector:4318.
+// It returns a func that flushes any spans that haven't been exported yet
+// and stops the exporter.
+func Init(endpoint, serviceName string, logger logrus.FieldLogger) func() {
+	exporter := NewOTLPExporter(endpoint, serviceName, defaultFlushInterval, logger)
+
+	trace.RegisterExporter(exporter)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+
+	return func() {
+		trace.UnregisterExporter(exporter)
+		exporter.Stop()
+	}
+}
+
+// StartSpan starts a span with the given name and attributes, as a child of
+// parent, or as the root span of a new trace if parent is nil.
+func StartSpan(parent *trace.Span, name string, attributes ...trace.Attribute) *trace.Span {
+	ctx := context.Background()
+	if parent != nil {
+		ctx = trace.NewContext(ctx, parent)
+	}
+
+	_, span := trace.StartSpan(ctx, name)
+	span.AddAttributes(attributes...)
+
+	return span
+}
+
+// EndSpan ends a span, recording err as its status if it isn't nil.
+func EndSpan(span *trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: err.Error()})
+	}
+	span.End()
+}
+
+const defaultFlushInterval = 5 * time.Second
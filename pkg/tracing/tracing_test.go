@@ -0,0 +1,60 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+)
+
+type fakeExporter struct {
+	spans []*trace.SpanData
+}
+
+func (e *fakeExporter) ExportSpan(span *trace.SpanData) {
+	e.spans = append(e.spans, span)
+}
+
+func TestStartAndEndSpan(t *testing.T) {
+	exporter := new(fakeExporter)
+	trace.RegisterExporter(exporter)
+	defer trace.UnregisterExporter(exporter)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+
+	parent := StartSpan(nil, "parent", trace.StringAttribute("backup", "velero/backup-1"))
+	child := StartSpan(parent, "child")
+	EndSpan(child, errors.New("oops"))
+	EndSpan(parent, nil)
+
+	require.Len(t, exporter.spans, 2)
+
+	childData, parentData := exporter.spans[0], exporter.spans[1]
+
+	assert.Equal(t, "parent", parentData.Name)
+	assert.Equal(t, trace.SpanID{}, parentData.ParentSpanID)
+	assert.Equal(t, map[string]interface{}{"backup": "velero/backup-1"}, parentData.Attributes)
+	assert.Equal(t, trace.Status{}, parentData.Status)
+
+	assert.Equal(t, "child", childData.Name)
+	assert.Equal(t, parentData.TraceID, childData.TraceID)
+	assert.Equal(t, parentData.SpanID, childData.ParentSpanID)
+	assert.Equal(t, trace.Status{Code: trace.StatusCodeUnknown, Message: "oops"}, childData.Status)
+}
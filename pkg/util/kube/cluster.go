@@ -0,0 +1,55 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// ClusterIdentity identifies the cluster that Velero is running in.
+type ClusterIdentity struct {
+	// Name is the name given to the cluster, which may be empty.
+	Name string
+
+	// UID is the UID of the cluster's kube-system namespace, which is
+	// unique to the cluster and doesn't change for its lifetime.
+	UID string
+}
+
+// String returns the cluster's name, or its UID if it doesn't have a name.
+func (id ClusterIdentity) String() string {
+	if id.Name != "" {
+		return id.Name
+	}
+	return id.UID
+}
+
+// GetClusterIdentity returns the identity of the cluster with the given name,
+// getting its UID from the cluster's kube-system namespace.
+func GetClusterIdentity(name string, client corev1client.NamespacesGetter) (ClusterIdentity, error) {
+	id := ClusterIdentity{Name: name}
+
+	ns, err := client.Namespaces().Get(metav1.NamespaceSystem, metav1.GetOptions{})
+	if err != nil {
+		return id, errors.Wrapf(err, "error getting namespace %s", metav1.NamespaceSystem)
+	}
+	id.UID = string(ns.UID)
+
+	return id, nil
+}
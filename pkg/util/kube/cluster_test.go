@@ -0,0 +1,46 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetClusterIdentity(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1api.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "kube-system", UID: types.UID("uid-1")},
+	})
+
+	id, err := GetClusterIdentity("cluster-1", client.CoreV1())
+	require.NoError(t, err)
+	assert.Equal(t, ClusterIdentity{Name: "cluster-1", UID: "uid-1"}, id)
+	assert.Equal(t, "cluster-1", id.String())
+
+	id, err = GetClusterIdentity("", client.CoreV1())
+	require.NoError(t, err)
+	assert.Equal(t, "uid-1", id.String())
+
+	_, err = GetClusterIdentity("cluster-1", fake.NewSimpleClientset().CoreV1())
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// RecordEvent creates an event about the object referenced by involvedObject,
+// reported by component. Events are named the same way as client-go's event
+// recorder names them, so that they sort by time.
+func RecordEvent(
+	client corev1client.EventsGetter,
+	component string,
+	involvedObject corev1api.ObjectReference,
+	eventType, reason, message string,
+	now time.Time,
+) error {
+	timestamp := metav1.NewTime(now)
+
+	event := &corev1api.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: involvedObject.Namespace,
+			Name:      fmt.Sprintf("%s.%x", involvedObject.Name, now.UnixNano()),
+		},
+		InvolvedObject: involvedObject,
+		Type:           eventType,
+		Reason:         reason,
+		Message:        message,
+		Source:         corev1api.EventSource{Component: component},
+		FirstTimestamp: timestamp,
+		LastTimestamp:  timestamp,
+		Count:          1,
+	}
+
+	if _, err := client.Events(involvedObject.Namespace).Create(event); err != nil {
+		return errors.Wrapf(err, "error creating event for %s %s/%s", involvedObject.Kind, involvedObject.Namespace, involvedObject.Name)
+	}
+
+	return nil
+}
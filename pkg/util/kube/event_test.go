@@ -0,0 +1,50 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRecordEvent(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	ref := corev1api.ObjectReference{Kind: "Backup", Namespace: "velero", Name: "backup-1", UID: "uid-1"}
+
+	require.NoError(t, RecordEvent(client.CoreV1(), "velero", ref, corev1api.EventTypeNormal, "DeletionRequested", "requested by alice", now))
+
+	events, err := client.CoreV1().Events("velero").List(metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, events.Items, 1)
+
+	event := events.Items[0]
+	assert.Equal(t, "backup-1.15c9841bc9cd8000", event.Name)
+	assert.Equal(t, ref, event.InvolvedObject)
+	assert.Equal(t, corev1api.EventTypeNormal, event.Type)
+	assert.Equal(t, "DeletionRequested", event.Reason)
+	assert.Equal(t, "requested by alice", event.Message)
+	assert.Equal(t, "velero", event.Source.Component)
+	assert.Equal(t, int32(1), event.Count)
+	assert.Equal(t, now, event.FirstTimestamp.Time)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"encoding/json"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultPatchBackoff is the backoff used to retry patches that fail with a
+// conflict or a transient API server error.
+var DefaultPatchBackoff = wait.Backoff{
+	Steps:    5,
+	Duration: 100 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// PatchFunc sends a patch of the given type to the API server.
+type PatchFunc func(patchType types.PatchType, data []byte) error
+
+// PatchOptions configures how Patch sends a patch.
+type PatchOptions struct {
+	// ServerSideApply specifies whether to send the updated object as a
+	// server-side apply patch, rather than a JSON merge patch from the
+	// original object. The PatchFunc is responsible for setting the field
+	// manager that's required for apply patches.
+	ServerSideApply bool
+
+	// Backoff is the backoff to use when retrying the patch. If nil,
+	// DefaultPatchBackoff is used.
+	Backoff *wait.Backoff
+}
+
+// Patch creates a patch that changes original into updated and sends it using
+// patchFn. If sending the patch fails with a conflict or a transient API server
+// error, it's retried with exponential backoff.
+func Patch(original, updated interface{}, opts PatchOptions, patchFn PatchFunc) error {
+	patchType, data, err := createPatch(original, updated, opts.ServerSideApply)
+	if err != nil {
+		return err
+	}
+
+	backoff := DefaultPatchBackoff
+	if opts.Backoff != nil {
+		backoff = *opts.Backoff
+	}
+
+	var lastErr error
+	err = wait.ExponentialBackoff(backoff, func() (bool, error) {
+		lastErr = patchFn(patchType, data)
+		switch {
+		case lastErr == nil:
+			return true, nil
+		case isRetriablePatchError(lastErr):
+			return false, nil
+		default:
+			return false, lastErr
+		}
+	})
+
+	if err == wait.ErrWaitTimeout {
+		return lastErr
+	}
+	return err
+}
+
+// createPatch returns the type and contents of a patch that changes original into updated.
+func createPatch(original, updated interface{}, serverSideApply bool) (types.PatchType, []byte, error) {
+	updatedBytes, err := json.Marshal(updated)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error marshalling updated object")
+	}
+
+	if serverSideApply {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(updatedBytes, &obj); err != nil {
+			return "", nil, errors.Wrap(err, "error unmarshalling updated object")
+		}
+
+		// the resource version would make the apply fail with a conflict if the
+		// object has changed, and managed fields can't be applied.
+		if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "resourceVersion")
+			delete(metadata, "managedFields")
+		}
+
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "error marshalling apply patch")
+		}
+
+		return types.ApplyPatchType, data, nil
+	}
+
+	originalBytes, err := json.Marshal(original)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error marshalling original object")
+	}
+
+	data, err := jsonpatch.CreateMergePatch(originalBytes, updatedBytes)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error creating json merge patch")
+	}
+
+	return types.MergePatchType, data, nil
+}
+
+// isRetriablePatchError returns true if a patch that failed with the given
+// error may succeed if it's retried.
+func isRetriablePatchError(err error) bool {
+	return apierrors.IsConflict(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsInternalError(err) ||
+		apierrors.IsServiceUnavailable(err)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestPatch(t *testing.T) {
+	backoff := wait.Backoff{Steps: 3, Duration: time.Millisecond, Factor: 1}
+	conflict := k8serrors.NewConflict(schema.GroupResource{Resource: "backups"}, "backup-1", errors.New("conflict"))
+
+	tests := []struct {
+		name            string
+		serverSideApply bool
+		errs            []error
+		wantType        types.PatchType
+		wantData        string
+		wantCalls       int
+		wantErr         bool
+	}{
+		{
+			name:      "merge patch is sent once if it succeeds",
+			wantType:  types.MergePatchType,
+			wantData:  `{"status":{"phase":"Completed"}}`,
+			wantCalls: 1,
+		},
+		{
+			name:      "patch is retried after a conflict",
+			errs:      []error{conflict},
+			wantType:  types.MergePatchType,
+			wantData:  `{"status":{"phase":"Completed"}}`,
+			wantCalls: 2,
+		},
+		{
+			name:      "patch is retried until the backoff is exhausted",
+			errs:      []error{conflict, conflict, conflict},
+			wantType:  types.MergePatchType,
+			wantData:  `{"status":{"phase":"Completed"}}`,
+			wantCalls: 3,
+			wantErr:   true,
+		},
+		{
+			name:      "patch is not retried after a non-retriable error",
+			errs:      []error{k8serrors.NewBadRequest("bad request")},
+			wantType:  types.MergePatchType,
+			wantData:  `{"status":{"phase":"Completed"}}`,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:            "server-side apply sends the updated object without its resource version",
+			serverSideApply: true,
+			wantType:        types.ApplyPatchType,
+			wantCalls:       1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			original := builder.ForBackup("velero", "backup-1").Result()
+			original.ResourceVersion = "1"
+			updated := original.DeepCopy()
+			updated.Status.Phase = "Completed"
+
+			var calls int
+			err := Patch(original, updated, PatchOptions{ServerSideApply: tc.serverSideApply, Backoff: &backoff}, func(patchType types.PatchType, data []byte) error {
+				calls++
+				assert.Equal(t, tc.wantType, patchType)
+				if tc.serverSideApply {
+					assert.NotContains(t, string(data), "resourceVersion")
+					assert.Contains(t, string(data), `"phase":"Completed"`)
+				} else {
+					assert.JSONEq(t, tc.wantData, string(data))
+				}
+
+				if calls <= len(tc.errs) {
+					return tc.errs[calls-1]
+				}
+				return nil
+			})
+
+			assert.Equal(t, tc.wantCalls, calls)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
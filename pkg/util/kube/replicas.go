@@ -0,0 +1,68 @@
+/*
+Copyright 2017 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// ReplicaPolicies are the replica policies of a backup or restore, with
+// their resources resolved to fully-qualified group-resource names.
+type ReplicaPolicies []resolvedReplicaPolicy
+
+type resolvedReplicaPolicy struct {
+	resources *collections.IncludesExcludes
+	policy    velerov1api.ReplicaPolicy
+}
+
+// NewReplicaPolicies resolves the resources of policies with resolve, which
+// maps a resource name to its fully-qualified group-resource name.
+func NewReplicaPolicies(policies []velerov1api.ResourceReplicaPolicy, resolve func(string) string) ReplicaPolicies {
+	var res ReplicaPolicies
+	for _, policy := range policies {
+		res = append(res, resolvedReplicaPolicy{
+			resources: collections.GenerateIncludesExcludes(policy.Resources, nil, resolve),
+			policy:    policy.Policy,
+		})
+	}
+	return res
+}
+
+// OmitsReplicas returns whether the replica counts of a resource's items are
+// omitted, according to the first policy whose resources include it.
+func (p ReplicaPolicies) OmitsReplicas(groupResource string) bool {
+	for _, policy := range p {
+		if policy.resources.ShouldInclude(groupResource) {
+			return policy.policy == velerov1api.ReplicaPolicyOmit
+		}
+	}
+	return false
+}
+
+// RemoveReplicas removes the spec.replicas field of an item's unstructured
+// content, and returns whether it had one.
+func RemoveReplicas(content map[string]interface{}) bool {
+	if _, found, _ := unstructured.NestedFieldNoCopy(content, "spec", "replicas"); !found {
+		return false
+	}
+
+	unstructured.RemoveNestedField(content, "spec", "replicas")
+	return true
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2017 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestReplicaPoliciesOmitsReplicas(t *testing.T) {
+	// resolve fully qualifies the resources of the apps group.
+	resolve := func(resource string) string {
+		switch resource {
+		case "deployments", "deployments.apps":
+			return "deployments.apps"
+		case "statefulsets", "statefulsets.apps":
+			return "statefulsets.apps"
+		default:
+			return ""
+		}
+	}
+
+	policies := NewReplicaPolicies([]velerov1api.ResourceReplicaPolicy{
+		{Resources: []string{"statefulsets"}, Policy: velerov1api.ReplicaPolicyKeep},
+		{Resources: []string{"*"}, Policy: velerov1api.ReplicaPolicyOmit},
+	}, resolve)
+
+	assert.True(t, policies.OmitsReplicas("deployments.apps"))
+	assert.False(t, policies.OmitsReplicas("statefulsets.apps"))
+
+	policies = NewReplicaPolicies([]velerov1api.ResourceReplicaPolicy{
+		{Resources: []string{"deployments"}, Policy: velerov1api.ReplicaPolicyOmit},
+	}, resolve)
+
+	assert.True(t, policies.OmitsReplicas("deployments.apps"))
+	assert.False(t, policies.OmitsReplicas("statefulsets.apps"))
+
+	// replica counts are kept if there are no policies.
+	assert.False(t, ReplicaPolicies(nil).OmitsReplicas("deployments.apps"))
+}
+
+func TestRemoveReplicas(t *testing.T) {
+	content := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"paused":   true,
+		},
+	}
+
+	assert.True(t, RemoveReplicas(content))
+	assert.Equal(t, map[string]interface{}{"spec": map[string]interface{}{"paused": true}}, content)
+
+	assert.False(t, RemoveReplicas(content))
+	assert.False(t, RemoveReplicas(map[string]interface{}{}))
+}
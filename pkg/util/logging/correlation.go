@@ -0,0 +1,47 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// CorrelationIDField is the name of the log field that holds the
+// correlation ID of the backup or restore that a log entry belongs to.
+const CorrelationIDField = "correlationID"
+
+// NewCorrelationID returns a new, random correlation ID.
+func NewCorrelationID() string {
+	return uuid.NewV4().String()
+}
+
+// WithCorrelationID returns a logger that adds the correlation ID
+// recorded in the object's velero.io/correlation-id annotation to
+// every log entry. If the object doesn't have a correlation ID, the
+// logger is returned unchanged.
+func WithCorrelationID(log logrus.FieldLogger, obj metav1.Object) logrus.FieldLogger {
+	id := obj.GetAnnotations()[velerov1api.CorrelationIDAnnotation]
+	if id == "" {
+		return log
+	}
+
+	return log.WithField(CorrelationIDField, id)
+}
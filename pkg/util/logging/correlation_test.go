@@ -0,0 +1,61 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestWithCorrelationID(t *testing.T) {
+	tests := []struct {
+		name       string
+		obj        *velerov1api.Backup
+		wantFields logrus.Fields
+	}{
+		{
+			name:       "object without a correlation ID doesn't add a field",
+			obj:        builder.ForBackup("velero", "backup-1").Result(),
+			wantFields: logrus.Fields{"backup": "velero/backup-1"},
+		},
+		{
+			name:       "object with a correlation ID adds a field",
+			obj:        builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithAnnotations(velerov1api.CorrelationIDAnnotation, "id-1")).Result(),
+			wantFields: logrus.Fields{"backup": "velero/backup-1", CorrelationIDField: "id-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			log := logrus.New().WithField("backup", "velero/backup-1")
+
+			res := WithCorrelationID(log, tc.obj)
+
+			assert.Equal(t, tc.wantFields, res.(*logrus.Entry).Data)
+		})
+	}
+}
+
+func TestNewCorrelationID(t *testing.T) {
+	assert.NotEmpty(t, NewCorrelationID())
+	assert.NotEqual(t, NewCorrelationID(), NewCorrelationID())
+}
@@ -53,6 +53,10 @@ type SnapshotSpec struct {
 	// VolumeIOPS is the optional value of provisioned IOPS for the
 	// disk/volume in the cloud provider API.
 	VolumeIOPS *int64 `json:"volumeIOPS,omitempty"`
+
+	// VolumeSizeBytes is the provisioned size of the volume, in bytes,
+	// at the time it was snapshotted.
+	VolumeSizeBytes int64 `json:"volumeSizeBytes,omitempty"`
 }
 
 type SnapshotStatus struct {
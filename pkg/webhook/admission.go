@@ -0,0 +1,69 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// admissionReview, admissionRequest and admissionResponse are the parts of
+// the admission.k8s.io/v1beta1 AdmissionReview API that the webhook uses.
+// They're defined here because the admission API isn't vendored, and the
+// webhook only needs a few of its fields.
+type admissionReview struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Request  *admissionRequest  `json:"request,omitempty"`
+	Response *admissionResponse `json:"response,omitempty"`
+}
+
+type admissionRequest struct {
+	// UID identifies the request, and is copied to its response.
+	UID types.UID `json:"uid"`
+
+	// Kind is the kind of the object being admitted.
+	Kind metav1.GroupVersionKind `json:"kind"`
+
+	// Namespace is the namespace of the object being admitted.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Operation is the operation being admitted, e.g. CREATE.
+	Operation string `json:"operation"`
+
+	// UserInfo is the authenticated user that made the request.
+	UserInfo authenticationv1.UserInfo `json:"userInfo"`
+
+	// Object is the object being admitted.
+	Object runtime.RawExtension `json:"object,omitempty"`
+
+	// OldObject is the existing object, for UPDATE operations.
+	OldObject runtime.RawExtension `json:"oldObject,omitempty"`
+}
+
+type admissionResponse struct {
+	UID     types.UID      `json:"uid"`
+	Allowed bool           `json:"allowed"`
+	Result  *metav1.Status `json:"status,omitempty"`
+
+	// Patch is a JSON patch that the mutating webhook applies to the object,
+	// and PatchType is "JSONPatch" if it's set.
+	Patch     []byte  `json:"patch,omitempty"`
+	PatchType *string `json:"patchType,omitempty"`
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/identity"
+)
+
+// identityFields are the spec fields, by kind, that record who created an
+// object. The webhook sets them to the authenticated user that creates the
+// object, rejects objects that set them to anyone else, and signs the user
+// into the object's velero.io/identity-verified annotation, so that the
+// backup deletion controller can rely on them.
+var identityFields = map[string]string{
+	"DeleteBackupRequest":  "requester",
+	"DeleteBackupApproval": "approver",
+}
+
+// jsonPatchOperation is an operation of a JSON patch (RFC 6902).
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// identityObject is the part of an object that identityPatch and
+// validateIdentity need.
+type identityObject struct {
+	Metadata struct {
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"metadata"`
+	Spec map[string]interface{} `json:"spec,omitempty"`
+}
+
+func decodeIdentityObject(kind string, raw []byte) (*identityObject, error) {
+	obj := new(identityObject)
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return nil, errors.Wrapf(err, "error decoding %s", kind)
+	}
+	return obj, nil
+}
+
+func (obj *identityObject) identity(field string) string {
+	value, _ := obj.Spec[field].(string)
+	return value
+}
+
+// identityPatch returns a JSON patch that sets the identity field of the
+// object being created to the authenticated user, if it isn't set, and sets
+// the object's velero.io/identity-verified annotation to the user, signed by
+// signer. It returns nil for kinds without an identity field, for other
+// operations, and for requests without an authenticated user.
+func identityPatch(request *admissionRequest, signer *identity.Signer, now time.Time) ([]byte, error) {
+	field, ok := identityFields[request.Kind.Kind]
+	if !ok || request.Operation != "CREATE" || request.UserInfo.Username == "" {
+		return nil, nil
+	}
+
+	obj, err := decodeIdentityObject(request.Kind.Kind, request.Object.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var patch []jsonPatchOperation
+
+	if obj.identity(field) == "" {
+		if obj.Spec == nil {
+			patch = append(patch, jsonPatchOperation{Op: "add", Path: "/spec", Value: map[string]interface{}{field: request.UserInfo.Username}})
+		} else {
+			patch = append(patch, jsonPatchOperation{Op: "add", Path: "/spec/" + field, Value: request.UserInfo.Username})
+		}
+	}
+
+	// an identity field that's set to someone other than the user is
+	// rejected by validateIdentity, so the user is the one that's signed.
+	backupName, _ := obj.Spec["backupName"].(string)
+	verified := signer.Sign(request.Kind.Kind, request.Namespace, backupName, request.UserInfo.Username, now)
+
+	if obj.Metadata.Annotations == nil {
+		patch = append(patch, jsonPatchOperation{Op: "add", Path: "/metadata/annotations", Value: map[string]string{velerov1api.IdentityVerifiedAnnotation: verified}})
+	} else {
+		// "/" is escaped as "~1" in JSON pointers.
+		patch = append(patch, jsonPatchOperation{Op: "add", Path: "/metadata/annotations/" + strings.Replace(velerov1api.IdentityVerifiedAnnotation, "/", "~1", -1), Value: verified})
+	}
+
+	return json.Marshal(patch)
+}
+
+// validateIdentity returns a validation error if the identity field of an
+// object being created is set to someone other than the authenticated user,
+// or if the identity field or the verified annotation of an object being
+// updated is changed. Updates are made by the server too, e.g. to the
+// status of DeleteBackupRequests, so they aren't checked against the user.
+func validateIdentity(request *admissionRequest) ([]string, error) {
+	field, ok := identityFields[request.Kind.Kind]
+	if !ok {
+		return nil, nil
+	}
+
+	obj, err := decodeIdentityObject(request.Kind.Kind, request.Object.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch request.Operation {
+	case "CREATE":
+		if value := obj.identity(field); value != "" && value != request.UserInfo.Username {
+			return []string{fmt.Sprintf("spec.%s %s must be the user creating the %s, %s", field, value, request.Kind.Kind, request.UserInfo.Username)}, nil
+		}
+	case "UPDATE":
+		oldObj, err := decodeIdentityObject(request.Kind.Kind, request.OldObject.Raw)
+		if err != nil {
+			return nil, err
+		}
+
+		var errs []string
+		if obj.identity(field) != oldObj.identity(field) {
+			errs = append(errs, fmt.Sprintf("spec.%s can't be changed", field))
+		}
+		if obj.Metadata.Annotations[velerov1api.IdentityVerifiedAnnotation] != oldObj.Metadata.Annotations[velerov1api.IdentityVerifiedAnnotation] {
+			errs = append(errs, fmt.Sprintf("annotation %s can't be changed", velerov1api.IdentityVerifiedAnnotation))
+		}
+		return errs, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+)
+
+// validator validates Velero's custom resources, returning a message for
+// each problem found, like the controllers do in their validation errors.
+type validator struct {
+	backupLocationLister   velerov1listers.BackupStorageLocationLister
+	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister
+}
+
+// validate decodes an object of a kind and validates it. Kinds that aren't
+// validated have no validation errors.
+func (v *validator) validate(kind, namespace string, raw []byte) ([]string, error) {
+	var obj interface{}
+	switch kind {
+	case "Backup":
+		obj = new(velerov1api.Backup)
+	case "Restore":
+		obj = new(velerov1api.Restore)
+	case "Schedule":
+		obj = new(velerov1api.Schedule)
+	case "BackupStorageLocation":
+		obj = new(velerov1api.BackupStorageLocation)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return nil, errors.Wrapf(err, "error decoding %s", kind)
+	}
+
+	switch obj := obj.(type) {
+	case *velerov1api.Backup:
+		return v.validateBackupSpec(namespace, obj.Spec), nil
+	case *velerov1api.Restore:
+		return v.validateRestore(namespace, obj), nil
+	case *velerov1api.Schedule:
+		return v.validateSchedule(namespace, obj), nil
+	case *velerov1api.BackupStorageLocation:
+		return validateBackupStorageLocation(obj), nil
+	}
+	return nil, nil
+}
+
+// validateBackupSpec validates a backup spec, or a schedule's template, and
+// checks that the storage locations that it references exist. Locations that
+// aren't set are defaulted by the server, so they aren't checked.
+func (v *validator) validateBackupSpec(namespace string, spec velerov1api.BackupSpec) []string {
+	errs := pkgbackup.ValidateSpec(spec)
+
+	if spec.StorageLocation != "" {
+		errs = append(errs, v.checkBackupStorageLocation(namespace, "storage location", spec.StorageLocation)...)
+	}
+
+	for _, name := range spec.AdditionalStorageLocations {
+		if name == spec.StorageLocation {
+			errs = append(errs, fmt.Sprintf("additional storage location %s is the backup's storage location", name))
+			continue
+		}
+		errs = append(errs, v.checkBackupStorageLocation(namespace, "additional storage location", name)...)
+	}
+
+	for _, name := range spec.VolumeSnapshotLocations {
+		_, err := v.snapshotLocationLister.VolumeSnapshotLocations(namespace).Get(name)
+		if apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Sprintf("volume snapshot location %s does not exist", name))
+		}
+	}
+
+	return errs
+}
+
+// checkBackupStorageLocation returns a validation error if a backup storage
+// location doesn't exist. Errors other than the location not being found
+// are left for the controllers to report, so that objects aren't rejected
+// because of a problem with the server's informers.
+func (v *validator) checkBackupStorageLocation(namespace, description, name string) []string {
+	_, err := v.backupLocationLister.BackupStorageLocations(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return []string{fmt.Sprintf("%s %s does not exist", description, name)}
+	}
+	return nil
+}
+
+func (v *validator) validateRestore(namespace string, restore *velerov1api.Restore) []string {
+	errs := pkgrestore.ValidateSpec(restore.Spec)
+
+	if restore.Spec.StorageLocation != "" {
+		errs = append(errs, v.checkBackupStorageLocation(namespace, "storage location", restore.Spec.StorageLocation)...)
+	}
+
+	return errs
+}
+
+func (v *validator) validateSchedule(namespace string, schedule *velerov1api.Schedule) []string {
+	var errs []string
+
+	if _, err := pkgbackup.ParseCronSchedule(schedule.Spec.Schedule); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if _, err := pkgbackup.ParseTimezone(schedule.Spec.Timezone); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if schedule.Spec.VerifyEvery < 0 {
+		errs = append(errs, "VerifyEvery must be zero or a positive number")
+	}
+
+	if policy := schedule.Spec.Retention; policy != nil && (policy.KeepLast < 0 || policy.KeepDaily < 0 || policy.KeepWeekly < 0) {
+		errs = append(errs, "Retention policy counts must not be negative")
+	}
+
+	for _, err := range v.validateBackupSpec(namespace, schedule.Spec.Template) {
+		errs = append(errs, "Invalid template: "+err)
+	}
+
+	return errs
+}
+
+func validateBackupStorageLocation(location *velerov1api.BackupStorageLocation) []string {
+	var errs []string
+
+	if location.Spec.Provider == "" {
+		errs = append(errs, "Provider must not be empty")
+	}
+
+	if location.Spec.ObjectStorage == nil || strings.Trim(location.Spec.ObjectStorage.Bucket, "/") == "" {
+		errs = append(errs, "Object storage bucket must not be empty")
+	} else if bucket := strings.Trim(location.Spec.ObjectStorage.Bucket, "/"); strings.Contains(bucket, "/") {
+		errs = append(errs, fmt.Sprintf("Bucket name %q must not contain a '/' (if using a prefix, put it in the 'Prefix' field instead)", location.Spec.ObjectStorage.Bucket))
+	}
+
+	switch location.Spec.AccessMode {
+	case "", velerov1api.BackupStorageLocationAccessModeReadWrite, velerov1api.BackupStorageLocationAccessModeReadOnly:
+	default:
+		errs = append(errs, fmt.Sprintf("Invalid access mode %q, must be %s or %s", location.Spec.AccessMode,
+			velerov1api.BackupStorageLocationAccessModeReadWrite, velerov1api.BackupStorageLocationAccessModeReadOnly))
+	}
+
+	switch location.Spec.OrphanedBackupPolicy {
+	case "", velerov1api.OrphanedBackupPolicyDelete, velerov1api.OrphanedBackupPolicyMark, velerov1api.OrphanedBackupPolicyIgnore:
+	default:
+		errs = append(errs, fmt.Sprintf("Invalid orphaned backup policy %q, must be %s, %s or %s", location.Spec.OrphanedBackupPolicy,
+			velerov1api.OrphanedBackupPolicyDelete, velerov1api.OrphanedBackupPolicyMark, velerov1api.OrphanedBackupPolicyIgnore))
+	}
+
+	if credential := location.Spec.Credential; credential != nil && (credential.Name == "" || credential.Key == "") {
+		errs = append(errs, "Credential must have a secret name and key")
+	}
+
+	if frequency := location.Spec.ValidationFrequency; frequency != nil && frequency.Duration < 0 {
+		errs = append(errs, fmt.Sprintf("Validation frequency %s must not be negative", frequency.Duration))
+	}
+
+	return errs
+}
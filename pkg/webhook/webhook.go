@@ -0,0 +1,203 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements a validating admission webhook for Velero's
+// custom resources. It rejects Backups, Restores, Schedules and
+// BackupStorageLocations with problems that the controllers would otherwise
+// only report minutes later, by setting their phase to FailedValidation,
+// such as invalid cron expressions, overlapping included and excluded lists
+// and unknown storage locations. It also sets the requester of
+// DeleteBackupRequests and the approver of DeleteBackupApprovals to the user
+// that creates them, so that deleting a protected backup takes two people.
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/identity"
+)
+
+// ValidatePath is the path that the webhook serves validation requests at.
+const ValidatePath = "/validate"
+
+// MutatePath is the path that the webhook serves mutation requests at, which
+// set the requester of DeleteBackupRequests and the approver of
+// DeleteBackupApprovals to the user that creates them.
+const MutatePath = "/mutate"
+
+type handler struct {
+	validator *validator
+	mutate    bool
+	signer    *identity.Signer
+	clock     clock.Clock
+	logger    logrus.FieldLogger
+}
+
+// NewHandler returns a handler that serves admission reviews of Velero's
+// custom resources at ValidatePath, using the listers to check that the
+// storage locations that they reference exist, and at MutatePath, using
+// signer to sign the requesters and approvers that it verifies.
+func NewHandler(
+	backupLocationLister velerov1listers.BackupStorageLocationLister,
+	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
+	signer *identity.Signer,
+	logger logrus.FieldLogger,
+) http.Handler {
+	return newHandler(backupLocationLister, snapshotLocationLister, signer, clock.RealClock{}, logger)
+}
+
+func newHandler(
+	backupLocationLister velerov1listers.BackupStorageLocationLister,
+	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
+	signer *identity.Signer,
+	clock clock.Clock,
+	logger logrus.FieldLogger,
+) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(ValidatePath, &handler{
+		validator: &validator{
+			backupLocationLister:   backupLocationLister,
+			snapshotLocationLister: snapshotLocationLister,
+		},
+		logger: logger,
+	})
+	mux.Handle(MutatePath, &handler{
+		mutate: true,
+		signer: signer,
+		clock:  clock,
+		logger: logger,
+	})
+	return mux
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	review := new(admissionReview)
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+		http.Error(w, "invalid admission review: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "admission review doesn't have a request", http.StatusBadRequest)
+		return
+	}
+
+	if h.mutate {
+		review.Response = h.patch(review.Request)
+	} else {
+		review.Response = h.admit(review.Request)
+	}
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		h.logger.WithError(errors.WithStack(err)).Error("Error writing admission review response")
+	}
+}
+
+// admit returns the response to an admission request, which is allowed
+// unless the object has validation errors.
+func (h *handler) admit(request *admissionRequest) *admissionResponse {
+	response := &admissionResponse{UID: request.UID, Allowed: true}
+
+	// objects of other groups and kinds aren't validated, so that the
+	// webhook doesn't reject them if it's registered for them by mistake.
+	if request.Kind.Group != velerov1api.GroupName {
+		return response
+	}
+
+	log := h.logger.WithFields(logrus.Fields{
+		"kind":      request.Kind.Kind,
+		"namespace": request.Namespace,
+		"operation": request.Operation,
+	})
+
+	errs, err := h.validator.validate(request.Kind.Kind, request.Namespace, request.Object.Raw)
+	if err == nil {
+		var identityErrs []string
+		identityErrs, err = validateIdentity(request)
+		errs = append(errs, identityErrs...)
+	}
+	if err != nil {
+		log.WithError(err).Info("Rejected invalid object")
+		response.Allowed = false
+		response.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusBadRequest,
+			Reason:  metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		}
+		return response
+	}
+
+	if len(errs) > 0 {
+		log.WithField("validationErrors", errs).Info("Rejected object with validation errors")
+		response.Allowed = false
+		response.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusUnprocessableEntity,
+			Reason:  metav1.StatusReasonInvalid,
+			Message: "validation failed: " + strings.Join(errs, "; "),
+		}
+	}
+
+	return response
+}
+
+// patch returns the response to a mutation request, which patches the
+// requester or approver of DeleteBackupRequests and DeleteBackupApprovals,
+// and allows other objects unchanged.
+func (h *handler) patch(request *admissionRequest) *admissionResponse {
+	response := &admissionResponse{UID: request.UID, Allowed: true}
+
+	if request.Kind.Group != velerov1api.GroupName {
+		return response
+	}
+
+	patch, err := identityPatch(request, h.signer, h.clock.Now())
+	if err != nil {
+		h.logger.WithError(err).WithField("kind", request.Kind.Kind).Info("Rejected invalid object")
+		response.Allowed = false
+		response.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusBadRequest,
+			Reason:  metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		}
+		return response
+	}
+
+	if patch != nil {
+		patchType := "JSONPatch"
+		response.Patch = patch
+		response.PatchType = &patchType
+	}
+
+	return response
+}
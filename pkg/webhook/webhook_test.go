@@ -0,0 +1,348 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/identity"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newTestHandler(t *testing.T) http.Handler {
+	sharedInformers := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+
+	require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(
+		builder.ForBackupStorageLocation("velero", "default").Provider("aws").Bucket("bucket").Result(),
+	))
+	require.NoError(t, sharedInformers.Velero().V1().VolumeSnapshotLocations().Informer().GetStore().Add(
+		builder.ForVolumeSnapshotLocation("velero", "aws-default").Result(),
+	))
+
+	return newHandler(
+		sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+		sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		testSigner(t),
+		clock.NewFakeClock(testNow),
+		velerotest.NewLogger(),
+	)
+}
+
+var testNow = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func testSigner(t *testing.T) *identity.Signer {
+	signer, err := identity.NewSigner([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	return signer
+}
+
+// review sends an admission review of obj to the handler and returns its
+// response.
+func review(t *testing.T, h http.Handler, kind metav1.GroupVersionKind, obj interface{}) *admissionResponse {
+	return reviewAs(t, h, ValidatePath, "", kind, obj)
+}
+
+// reviewAs sends an admission review of obj, created by a user, to the
+// handler at a path and returns its response.
+func reviewAs(t *testing.T, h http.Handler, path, username string, kind metav1.GroupVersionKind, obj interface{}) *admissionResponse {
+	return reviewRequest(t, h, path, &admissionRequest{
+		Kind:      kind,
+		Operation: "CREATE",
+		UserInfo:  authenticationv1.UserInfo{Username: username},
+		Object:    rawExtension(t, obj),
+	})
+}
+
+func reviewUpdate(t *testing.T, h http.Handler, path, username string, kind metav1.GroupVersionKind, oldObj, obj interface{}) *admissionResponse {
+	return reviewRequest(t, h, path, &admissionRequest{
+		Kind:      kind,
+		Operation: "UPDATE",
+		UserInfo:  authenticationv1.UserInfo{Username: username},
+		Object:    rawExtension(t, obj),
+		OldObject: rawExtension(t, oldObj),
+	})
+}
+
+func rawExtension(t *testing.T, obj interface{}) runtime.RawExtension {
+	raw, err := json.Marshal(obj)
+	require.NoError(t, err)
+	return runtime.RawExtension{Raw: raw}
+}
+
+func reviewRequest(t *testing.T, h http.Handler, path string, request *admissionRequest) *admissionResponse {
+	request.UID = "request-uid"
+	request.Namespace = "velero"
+
+	body, err := json.Marshal(&admissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1beta1", Kind: "AdmissionReview"},
+		Request:  request,
+	})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	res := new(admissionReview)
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(res))
+	assert.Equal(t, "AdmissionReview", res.Kind)
+	assert.Nil(t, res.Request)
+	require.NotNil(t, res.Response)
+	assert.Equal(t, "request-uid", string(res.Response.UID))
+
+	return res.Response
+}
+
+func veleroKind(kind string) metav1.GroupVersionKind {
+	return metav1.GroupVersionKind{Group: velerov1api.GroupName, Version: "v1", Kind: kind}
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		kind        metav1.GroupVersionKind
+		obj         interface{}
+		wantMessage string
+	}{
+		{
+			name: "valid backup",
+			kind: veleroKind("Backup"),
+			obj:  builder.ForBackup("velero", "backup-1").StorageLocation("default").VolumeSnapshotLocations("aws-default").Result(),
+		},
+		{
+			name: "backup without a storage location",
+			kind: veleroKind("Backup"),
+			obj:  builder.ForBackup("velero", "backup-1").Result(),
+		},
+		{
+			name: "backup with overlapping namespaces and unknown locations",
+			kind: veleroKind("Backup"),
+			obj: builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1").ExcludedNamespaces("ns-1").
+				StorageLocation("secondary").VolumeSnapshotLocations("gcp-default").Result(),
+			wantMessage: "validation failed: Invalid included/excluded namespace lists: excludes list cannot contain an item in the includes list: ns-1; " +
+				"storage location secondary does not exist; volume snapshot location gcp-default does not exist",
+		},
+		{
+			name: "valid restore",
+			kind: veleroKind("Restore"),
+			obj:  builder.ForRestore("velero", "restore-1").Backup("backup-1").StorageLocation("default").Result(),
+		},
+		{
+			name:        "restore without a backup or schedule and with an unknown location",
+			kind:        veleroKind("Restore"),
+			obj:         builder.ForRestore("velero", "restore-1").StorageLocation("secondary").Result(),
+			wantMessage: "validation failed: Either a backup or schedule must be specified as a source for the restore, but not both; storage location secondary does not exist",
+		},
+		{
+			name: "valid schedule",
+			kind: veleroKind("Schedule"),
+			obj:  builder.ForSchedule("velero", "daily").CronSchedule("0 1 * * *").Timezone("America/New_York").Result(),
+		},
+		{
+			name: "schedule with an invalid cron expression and an unknown template location",
+			kind: veleroKind("Schedule"),
+			obj: builder.ForSchedule("velero", "daily").CronSchedule("0 25 * * *").
+				Template(velerov1api.BackupSpec{StorageLocation: "secondary"}).Result(),
+			wantMessage: "validation failed: invalid schedule: End of range (25) above maximum (23): 25; Invalid template: storage location secondary does not exist",
+		},
+		{
+			name: "schedule with a negative retention policy",
+			kind: veleroKind("Schedule"),
+			obj: builder.ForSchedule("velero", "daily").CronSchedule("@daily").
+				Retention(velerov1api.RetentionPolicy{KeepLast: -1}).Result(),
+			wantMessage: "validation failed: Retention policy counts must not be negative",
+		},
+		{
+			name: "valid backup storage location",
+			kind: veleroKind("BackupStorageLocation"),
+			obj:  builder.ForBackupStorageLocation("velero", "secondary").Provider("aws").Bucket("bucket").Prefix("prefix").Result(),
+		},
+		{
+			name:        "backup storage location without a provider and with a prefix in its bucket",
+			kind:        veleroKind("BackupStorageLocation"),
+			obj:         builder.ForBackupStorageLocation("velero", "secondary").Bucket("bucket/prefix").AccessMode("Write").Result(),
+			wantMessage: `validation failed: Provider must not be empty; Bucket name "bucket/prefix" must not contain a '/' (if using a prefix, put it in the 'Prefix' field instead); Invalid access mode "Write", must be ReadWrite or ReadOnly`,
+		},
+		{
+			name:        "backup storage location with an invalid orphaned backup policy",
+			kind:        veleroKind("BackupStorageLocation"),
+			obj:         builder.ForBackupStorageLocation("velero", "secondary").Provider("aws").Bucket("bucket").OrphanedBackupPolicy("Keep").Result(),
+			wantMessage: `validation failed: Invalid orphaned backup policy "Keep", must be Delete, Mark or Ignore`,
+		},
+		{
+			name:        "backup storage location with a credential without a secret name",
+			kind:        veleroKind("BackupStorageLocation"),
+			obj:         builder.ForBackupStorageLocation("velero", "secondary").Provider("aws").Bucket("bucket").Credential("", "cloud").Result(),
+			wantMessage: "validation failed: Credential must have a secret name and key",
+		},
+		{
+			name:        "backup storage location with a negative validation frequency",
+			kind:        veleroKind("BackupStorageLocation"),
+			obj:         builder.ForBackupStorageLocation("velero", "secondary").Provider("aws").Bucket("bucket").ValidationFrequency(-time.Minute).Result(),
+			wantMessage: "validation failed: Validation frequency -1m0s must not be negative",
+		},
+		{
+			name: "kinds that aren't validated are allowed",
+			kind: veleroKind("DownloadRequest"),
+			obj:  builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1").ExcludedNamespaces("ns-1").Result(),
+		},
+		{
+			name: "objects of other groups are allowed",
+			kind: metav1.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Backup"},
+			obj:  builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1").ExcludedNamespaces("ns-1").Result(),
+		},
+		{
+			name:        "objects that can't be decoded are rejected",
+			kind:        veleroKind("Backup"),
+			obj:         map[string]interface{}{"spec": "not a spec"},
+			wantMessage: "error decoding Backup: json: cannot unmarshal string into Go struct field Backup.spec of type v1.BackupSpec",
+		},
+	}
+
+	h := newTestHandler(t)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := review(t, h, tc.kind, tc.obj)
+
+			if tc.wantMessage == "" {
+				assert.True(t, res.Allowed)
+				assert.Nil(t, res.Result)
+				return
+			}
+
+			assert.False(t, res.Allowed)
+			require.NotNil(t, res.Result)
+			assert.Equal(t, tc.wantMessage, res.Result.Message)
+		})
+	}
+}
+
+func TestHandlerInvalidRequests(t *testing.T) {
+	h := newTestHandler(t)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ValidatePath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewBufferString("not json")))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewBufferString(`{"kind":"AdmissionReview"}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/other", bytes.NewBufferString(`{}`)))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandlerIdentity(t *testing.T) {
+	h := newTestHandler(t)
+
+	signer := testSigner(t)
+
+	// the mutating webhook sets the requester and approver to the user, and
+	// annotates the objects with the user signed by the server.
+	res := reviewAs(t, h, MutatePath, "alice", veleroKind("DeleteBackupRequest"), pkgbackup.NewDeleteBackupRequest("backup-1", "backup-uid"))
+	assert.True(t, res.Allowed)
+	require.NotNil(t, res.PatchType)
+	assert.Equal(t, "JSONPatch", *res.PatchType)
+	assert.JSONEq(t, `[
+		{"op": "add", "path": "/spec/requester", "value": "alice"},
+		{"op": "add", "path": "/metadata/annotations", "value": {"velero.io/identity-verified": "`+signer.Sign("DeleteBackupRequest", "velero", "backup-1", "alice", testNow)+`"}}
+	]`, string(res.Patch))
+
+	approval := builder.ForDeleteBackupApproval("velero", "approval-1").BackupName("backup-1").
+		ObjectMeta(builder.WithAnnotations("foo", "bar")).Result()
+	res = reviewAs(t, h, MutatePath, "bob", veleroKind("DeleteBackupApproval"), approval)
+	assert.True(t, res.Allowed)
+	assert.JSONEq(t, `[
+		{"op": "add", "path": "/spec/approver", "value": "bob"},
+		{"op": "add", "path": "/metadata/annotations/velero.io~1identity-verified", "value": "`+signer.Sign("DeleteBackupApproval", "velero", "backup-1", "bob", testNow)+`"}
+	]`, string(res.Patch))
+
+	// an approver that's already set isn't changed by the mutating webhook,
+	// and is rejected by the validating webhook unless it's the user.
+	approval = builder.ForDeleteBackupApproval("velero", "approval-1").BackupName("backup-1").Approver("bob").Result()
+	res = reviewAs(t, h, MutatePath, "alice", veleroKind("DeleteBackupApproval"), approval)
+	assert.True(t, res.Allowed)
+	assert.JSONEq(t, `[{"op": "add", "path": "/metadata/annotations", "value": {"velero.io/identity-verified": "`+signer.Sign("DeleteBackupApproval", "velero", "backup-1", "alice", testNow)+`"}}]`, string(res.Patch))
+
+	res = reviewAs(t, h, ValidatePath, "alice", veleroKind("DeleteBackupApproval"), approval)
+	assert.False(t, res.Allowed)
+	require.NotNil(t, res.Result)
+	assert.Equal(t, "validation failed: spec.approver bob must be the user creating the DeleteBackupApproval, alice", res.Result.Message)
+
+	res = reviewAs(t, h, ValidatePath, "bob", veleroKind("DeleteBackupApproval"), approval)
+	assert.True(t, res.Allowed)
+
+	// updates by other users, e.g. the server processing a request, are
+	// allowed as long as they don't change the requester or the annotation.
+	original := pkgbackup.NewDeleteBackupRequest("backup-1", "backup-uid")
+	original.Spec.Requester = "alice"
+	original.Annotations = map[string]string{velerov1api.IdentityVerifiedAnnotation: signer.Sign("DeleteBackupRequest", "velero", "backup-1", "alice", testNow)}
+
+	updated := original.DeepCopy()
+	updated.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+	res = reviewUpdate(t, h, ValidatePath, "system:serviceaccount:velero:velero", veleroKind("DeleteBackupRequest"), original, updated)
+	assert.True(t, res.Allowed)
+
+	res = reviewUpdate(t, h, MutatePath, "system:serviceaccount:velero:velero", veleroKind("DeleteBackupRequest"), original, updated)
+	assert.True(t, res.Allowed)
+	assert.Nil(t, res.Patch)
+
+	updated.Spec.Requester = "bob"
+	res = reviewUpdate(t, h, ValidatePath, "bob", veleroKind("DeleteBackupRequest"), original, updated)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, "validation failed: spec.requester can't be changed", res.Result.Message)
+
+	unverified := original.DeepCopy()
+	unverified.Annotations = nil
+	res = reviewUpdate(t, h, ValidatePath, "alice", veleroKind("DeleteBackupRequest"), unverified, original)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, "validation failed: annotation velero.io/identity-verified can't be changed", res.Result.Message)
+
+	// requests without an authenticated user aren't signed.
+	res = reviewAs(t, h, MutatePath, "", veleroKind("DeleteBackupApproval"), approval)
+	assert.True(t, res.Allowed)
+	assert.Nil(t, res.Patch)
+
+	// other kinds aren't patched.
+	res = reviewAs(t, h, MutatePath, "alice", veleroKind("Backup"), builder.ForBackup("velero", "backup-1").Result())
+	assert.True(t, res.Allowed)
+	assert.Nil(t, res.Patch)
+	assert.Nil(t, res.PatchType)
+}